@@ -0,0 +1,94 @@
+// Package audit keeps tamper-evident audit trails. Every entry of the trail
+// of a subject commits to the hash of the entry appended before it, so
+// rewriting, reordering or removing an entry breaks the hash chain of the
+// entries after it.
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Entry is an entry of an audit trail, as stored by the chains
+type Entry interface {
+	GetId() uint64
+	GetSubjectId() string
+	GetAction() string
+	GetDetail() string
+	GetTimestamp() int64
+	// GetPrevHash returns the hash of the previous entry of the subject,
+	// empty for its first entry
+	GetPrevHash() []byte
+	GetHash() []byte
+}
+
+// Hash returns the hash of an audit entry, committing to the hash of the
+// previous entry of its subject
+func Hash(prevHash []byte, id uint64, subjectId, action, detail string, timestamp int64) []byte {
+	h := sha256.New()
+	writeBytes := func(b []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(b)))
+		h.Write(b)
+	}
+	writeBytes(prevHash)
+	_ = binary.Write(h, binary.BigEndian, id)
+	writeBytes([]byte(subjectId))
+	writeBytes([]byte(action))
+	writeBytes([]byte(detail))
+	_ = binary.Write(h, binary.BigEndian, timestamp)
+	return h.Sum(nil)
+}
+
+// EntryHash recomputes the hash of an entry from its fields
+func EntryHash(e Entry) []byte {
+	return Hash(e.GetPrevHash(), e.GetId(), e.GetSubjectId(), e.GetAction(), e.GetDetail(), e.GetTimestamp())
+}
+
+// Verify re-walks the hash chains of entries, which may mix subjects but
+// must list the entries of each subject in the order they were appended. It
+// returns the error of the first entry breaking the chain of its subject.
+func Verify[T any, E interface {
+	*T
+	Entry
+}](entries []T) error {
+	v := newVerifier()
+	for i := range entries {
+		if err := v.next(E(&entries[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifier walks hash chains one entry at a time, keeping the last entry
+// of each subject
+type verifier struct {
+	heads map[string][]byte
+	ids   map[string]uint64
+}
+
+func newVerifier() *verifier {
+	return &verifier{heads: make(map[string][]byte), ids: make(map[string]uint64)}
+}
+
+func (v *verifier) next(e Entry) error {
+	subject := e.GetSubjectId()
+	if subject == "" {
+		return errorsmod.Wrapf(ErrEmptySubject, "entry %d", e.GetId())
+	}
+	if last, ok := v.ids[subject]; ok && e.GetId() <= last {
+		return errorsmod.Wrapf(ErrBrokenChain, "entry %d of %s is out of order", e.GetId(), subject)
+	}
+	if !bytes.Equal(e.GetPrevHash(), v.heads[subject]) {
+		return errorsmod.Wrapf(ErrBrokenChain, "entry %d of %s does not follow the previous entry", e.GetId(), subject)
+	}
+	if !bytes.Equal(e.GetHash(), EntryHash(e)) {
+		return errorsmod.Wrapf(ErrBrokenChain, "entry %d of %s does not match its hash", e.GetId(), subject)
+	}
+	v.heads[subject] = e.GetHash()
+	v.ids[subject] = e.GetId()
+	return nil
+}
@@ -0,0 +1,92 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/cosmos-multichain/audit"
+)
+
+// entry is an audit entry as a chain would store it
+type entry struct {
+	id                      uint64
+	subject, action, detail string
+	timestamp               int64
+	prevHash, hash          []byte
+}
+
+func (e entry) GetId() uint64        { return e.id }
+func (e entry) GetSubjectId() string { return e.subject }
+func (e entry) GetAction() string    { return e.action }
+func (e entry) GetDetail() string    { return e.detail }
+func (e entry) GetTimestamp() int64  { return e.timestamp }
+func (e entry) GetPrevHash() []byte  { return e.prevHash }
+func (e entry) GetHash() []byte      { return e.hash }
+
+// trail chains entries of the subjects as they are appended
+func trail(subjects ...string) []entry {
+	heads := map[string][]byte{}
+	var entries []entry
+	for id, subject := range subjects {
+		e := entry{id: uint64(id), subject: subject, action: "log", detail: "detail", timestamp: 1_700_000_000, prevHash: heads[subject]}
+		e.hash = audit.EntryHash(e)
+		heads[subject] = e.hash
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestHash(t *testing.T) {
+	h := audit.Hash(nil, 1, "tx-1", "log", "detail", 1)
+	require.Len(t, h, 32)
+	require.Equal(t, h, audit.Hash([]byte{}, 1, "tx-1", "log", "detail", 1))
+	// fields are length prefixed so they cannot be shifted into each other
+	require.NotEqual(t, h, audit.Hash(nil, 1, "tx-1l", "og", "detail", 1))
+	require.NotEqual(t, h, audit.Hash([]byte{1}, 1, "tx-1", "log", "detail", 1))
+}
+
+func TestVerify(t *testing.T) {
+	require.NoError(t, audit.Verify(trail("a", "b", "a", "a", "b")))
+	require.NoError(t, audit.Verify([]entry{}))
+
+	for _, tc := range []struct {
+		desc   string
+		tamper func([]entry) []entry
+		err    error
+	}{
+		{
+			desc:   "rewritten detail",
+			tamper: func(es []entry) []entry { es[1].detail = "rewritten"; return es },
+			err:    audit.ErrBrokenChain,
+		},
+		{
+			desc: "rewritten and rehashed entry",
+			tamper: func(es []entry) []entry {
+				es[1].detail = "rewritten"
+				es[1].hash = audit.EntryHash(es[1])
+				return es
+			},
+			err: audit.ErrBrokenChain,
+		},
+		{
+			desc:   "removed entry",
+			tamper: func(es []entry) []entry { return append(es[:1], es[2:]...) },
+			err:    audit.ErrBrokenChain,
+		},
+		{
+			desc:   "reordered entries",
+			tamper: func(es []entry) []entry { es[0], es[1] = es[1], es[0]; return es },
+			err:    audit.ErrBrokenChain,
+		},
+		{
+			desc:   "empty subject",
+			tamper: func(es []entry) []entry { es[0].subject = ""; return es },
+			err:    audit.ErrEmptySubject,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.ErrorIs(t, audit.Verify(tc.tamper(trail("a", "a", "a"))), tc.err)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the audit trail errors
+const Codespace = "audit"
+
+// audit trail sentinel errors
+var (
+	ErrEmptySubject = sdkerrors.Register(Codespace, 1100, "audit entry subject cannot be empty")
+	ErrBrokenChain  = sdkerrors.Register(Codespace, 1101, "audit trail hash chain is broken")
+)
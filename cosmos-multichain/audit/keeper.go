@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// EntryKeyPrefix is the prefix of the audit entries in the module store
+	EntryKeyPrefix = "AuditEntry/value/"
+
+	// CountKey is the key of the number of audit entries ever appended
+	CountKey = "AuditEntry/count/"
+)
+
+// TrailKey returns the prefix of the audit entries of a subject
+func TrailKey(subjectId string) []byte {
+	return append([]byte(subjectId), '/')
+}
+
+// EntryKey returns the store key of an audit entry, ordered by id within its subject
+func EntryKey(subjectId string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(TrailKey(subjectId), id)
+}
+
+// storedEntry is the proto message of the entries kept by a chain, T
+type storedEntry[T any] interface {
+	*T
+	Entry
+	codec.ProtoMarshaler
+}
+
+// Keeper appends hash chained entries to the audit trails kept in a module
+// store. T is the audit entry message of the module, built by NewEntry.
+type Keeper[T any, E storedEntry[T]] struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	newEntry     NewEntry[T]
+}
+
+// NewEntry builds the audit entry message of a module
+type NewEntry[T any] func(id uint64, subjectId, action, detail string, timestamp int64, prevHash, hash []byte) T
+
+func NewKeeper[T any, E storedEntry[T]](cdc codec.BinaryCodec, storeService store.KVStoreService, newEntry NewEntry[T]) Keeper[T, E] {
+	return Keeper[T, E]{cdc: cdc, storeService: storeService, newEntry: newEntry}
+}
+
+func (k Keeper[T, E]) entryStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, []byte(EntryKeyPrefix))
+}
+
+func (k Keeper[T, E]) trailStore(ctx context.Context, subjectId string) prefix.Store {
+	return prefix.NewStore(k.entryStore(ctx), TrailKey(subjectId))
+}
+
+// GetCount returns the number of audit entries ever appended
+func (k Keeper[T, E]) GetCount(ctx context.Context) uint64 {
+	bz := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Get([]byte(CountKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCount sets the number of audit entries ever appended
+func (k Keeper[T, E]) SetCount(ctx context.Context, count uint64) {
+	runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Set([]byte(CountKey), binary.BigEndian.AppendUint64(nil, count))
+}
+
+// Append appends an entry to the audit trail of its subject at the current
+// block time, chained to the last entry of the subject
+func (k Keeper[T, E]) Append(ctx context.Context, subjectId, action, detail string) (T, error) {
+	if subjectId == "" {
+		var entry T
+		return entry, errorsmod.Wrapf(ErrEmptySubject, "cannot log %s", action)
+	}
+
+	var prevHash []byte
+	if head, found := k.Head(ctx, subjectId); found {
+		prevHash = E(&head).GetHash()
+	}
+	id := k.GetCount(ctx)
+	timestamp := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	entry := k.newEntry(id, subjectId, action, detail, timestamp, prevHash, Hash(prevHash, id, subjectId, action, detail, timestamp))
+	k.Set(ctx, entry)
+	k.SetCount(ctx, id+1)
+	return entry, nil
+}
+
+// Set stores an audit entry as is, as when importing a genesis state
+func (k Keeper[T, E]) Set(ctx context.Context, entry T) {
+	e := E(&entry)
+	k.entryStore(ctx).Set(EntryKey(e.GetSubjectId(), e.GetId()), k.cdc.MustMarshal(e))
+}
+
+// Head returns the last entry of the audit trail of a subject
+func (k Keeper[T, E]) Head(ctx context.Context, subjectId string) (entry T, found bool) {
+	iterator := storetypes.KVStoreReversePrefixIterator(k.trailStore(ctx, subjectId), []byte{})
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return entry, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), E(&entry))
+	return entry, true
+}
+
+// GetTrail returns the audit entries of a subject in the order they were appended
+func (k Keeper[T, E]) GetTrail(ctx context.Context, subjectId string) []T {
+	return k.collect(k.trailStore(ctx, subjectId))
+}
+
+// GetAll returns the audit entries of every subject
+func (k Keeper[T, E]) GetAll(ctx context.Context) []T {
+	return k.collect(k.entryStore(ctx))
+}
+
+func (k Keeper[T, E]) collect(store prefix.Store) (list []T) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry T
+		k.cdc.MustUnmarshal(iterator.Value(), E(&entry))
+		list = append(list, entry)
+	}
+	return
+}
+
+// PaginateTrail returns a page of the audit entries of a subject
+func (k Keeper[T, E]) PaginateTrail(ctx context.Context, subjectId string, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	var entries []T
+	pageRes, err := query.Paginate(k.trailStore(ctx, subjectId), pageReq, func(_ []byte, value []byte) error {
+		var entry T
+		if err := k.cdc.Unmarshal(value, E(&entry)); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	return entries, pageRes, err
+}
+
+// VerifyTrail re-walks the hash chain of the audit trail of a subject. It
+// returns the number of entries of the trail and the hash of its last
+// entry, or the error of the first entry breaking the chain.
+func (k Keeper[T, E]) VerifyTrail(ctx context.Context, subjectId string) (uint64, []byte, error) {
+	iterator := storetypes.KVStorePrefixIterator(k.trailStore(ctx, subjectId), []byte{})
+	defer iterator.Close()
+
+	var (
+		length uint64
+		head   []byte
+		v      = newVerifier()
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry T
+		k.cdc.MustUnmarshal(iterator.Value(), E(&entry))
+		e := E(&entry)
+		if e.GetSubjectId() != subjectId {
+			return length, head, errorsmod.Wrapf(ErrBrokenChain, "entry %d is stored under %s", e.GetId(), subjectId)
+		}
+		if err := v.next(e); err != nil {
+			return length, head, err
+		}
+		length++
+		head = e.GetHash()
+	}
+	return length, head, nil
+}
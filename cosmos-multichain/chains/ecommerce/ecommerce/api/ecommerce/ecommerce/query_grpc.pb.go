@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: ecommerce/ecommerce/query.proto
+
+package ecommerce
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName         = "/ecommerce.ecommerce.Query/Params"
+	Query_Transaction_FullMethodName    = "/ecommerce.ecommerce.Query/Transaction"
+	Query_TransactionAll_FullMethodName = "/ecommerce.ecommerce.Query/TransactionAll"
+	Query_ContractRecord_FullMethodName = "/ecommerce.ecommerce.Query/ContractRecord"
+	Query_AuditTrail_FullMethodName     = "/ecommerce.ecommerce.Query/AuditTrail"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
+	TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
+	out := new(QueryGetTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_Transaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
+	out := new(QueryAllTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_TransactionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error) {
+	out := new(QueryGetContractRecordResponse)
+	err := c.cc.Invoke(ctx, Query_ContractRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error) {
+	out := new(QueryAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_AuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
+	TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedQueryServer) TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionAll not implemented")
+}
+func (UnimplementedQueryServer) ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRecord not implemented")
+}
+func (UnimplementedQueryServer) AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTrail not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Transaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transaction(ctx, req.(*QueryGetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransactionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransactionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionAll(ctx, req.(*QueryAllTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContractRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRecord(ctx, req.(*QueryGetContractRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditTrail(ctx, req.(*QueryAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ecommerce.ecommerce.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _Query_Transaction_Handler,
+		},
+		{
+			MethodName: "TransactionAll",
+			Handler:    _Query_TransactionAll_Handler,
+		},
+		{
+			MethodName: "ContractRecord",
+			Handler:    _Query_ContractRecord_Handler,
+		},
+		{
+			MethodName: "AuditTrail",
+			Handler:    _Query_AuditTrail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ecommerce/ecommerce/query.proto",
+}
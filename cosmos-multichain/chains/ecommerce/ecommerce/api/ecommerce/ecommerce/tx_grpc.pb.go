@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: ecommerce/ecommerce/tx.proto
+
+package ecommerce
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateParams_FullMethodName            = "/ecommerce.ecommerce.Msg/UpdateParams"
+	Msg_InitiateTransaction_FullMethodName     = "/ecommerce.ecommerce.Msg/InitiateTransaction"
+	Msg_UpdateTransactionStatus_FullMethodName = "/ecommerce.ecommerce.Msg/UpdateTransactionStatus"
+	Msg_FinalizeTransaction_FullMethodName     = "/ecommerce.ecommerce.Msg/FinalizeTransaction"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	InitiateTransaction(ctx context.Context, in *MsgInitiateTransaction, opts ...grpc.CallOption) (*MsgInitiateTransactionResponse, error)
+	UpdateTransactionStatus(ctx context.Context, in *MsgUpdateTransactionStatus, opts ...grpc.CallOption) (*MsgUpdateTransactionStatusResponse, error)
+	FinalizeTransaction(ctx context.Context, in *MsgFinalizeTransaction, opts ...grpc.CallOption) (*MsgFinalizeTransactionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateTransaction(ctx context.Context, in *MsgInitiateTransaction, opts ...grpc.CallOption) (*MsgInitiateTransactionResponse, error) {
+	out := new(MsgInitiateTransactionResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTransactionStatus(ctx context.Context, in *MsgUpdateTransactionStatus, opts ...grpc.CallOption) (*MsgUpdateTransactionStatusResponse, error) {
+	out := new(MsgUpdateTransactionStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTransactionStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeTransaction(ctx context.Context, in *MsgFinalizeTransaction, opts ...grpc.CallOption) (*MsgFinalizeTransactionResponse, error) {
+	out := new(MsgFinalizeTransactionResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	InitiateTransaction(context.Context, *MsgInitiateTransaction) (*MsgInitiateTransactionResponse, error)
+	UpdateTransactionStatus(context.Context, *MsgUpdateTransactionStatus) (*MsgUpdateTransactionStatusResponse, error)
+	FinalizeTransaction(context.Context, *MsgFinalizeTransaction) (*MsgFinalizeTransactionResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) InitiateTransaction(context.Context, *MsgInitiateTransaction) (*MsgInitiateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateTransaction not implemented")
+}
+func (UnimplementedMsgServer) UpdateTransactionStatus(context.Context, *MsgUpdateTransactionStatus) (*MsgUpdateTransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransactionStatus not implemented")
+}
+func (UnimplementedMsgServer) FinalizeTransaction(context.Context, *MsgFinalizeTransaction) (*MsgFinalizeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTransaction not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateTransaction(ctx, req.(*MsgInitiateTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTransactionStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTransactionStatus(ctx, req.(*MsgUpdateTransactionStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeTransaction(ctx, req.(*MsgFinalizeTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ecommerce.ecommerce.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "InitiateTransaction",
+			Handler:    _Msg_InitiateTransaction_Handler,
+		},
+		{
+			MethodName: "UpdateTransactionStatus",
+			Handler:    _Msg_UpdateTransactionStatus_Handler,
+		},
+		{
+			MethodName: "FinalizeTransaction",
+			Handler:    _Msg_FinalizeTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ecommerce/ecommerce/tx.proto",
+}
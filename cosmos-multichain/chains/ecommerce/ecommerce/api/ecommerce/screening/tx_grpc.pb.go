@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: ecommerce/screening/tx.proto
+
+package screening
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateParams_FullMethodName         = "/ecommerce.screening.Msg/UpdateParams"
+	Msg_AddScreeningEntry_FullMethodName    = "/ecommerce.screening.Msg/AddScreeningEntry"
+	Msg_RemoveScreeningEntry_FullMethodName = "/ecommerce.screening.Msg/RemoveScreeningEntry"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddScreeningEntry and RemoveScreeningEntry define (governance)
+	// operations for listing and delisting a screened party.
+	AddScreeningEntry(ctx context.Context, in *MsgAddScreeningEntry, opts ...grpc.CallOption) (*MsgAddScreeningEntryResponse, error)
+	RemoveScreeningEntry(ctx context.Context, in *MsgRemoveScreeningEntry, opts ...grpc.CallOption) (*MsgRemoveScreeningEntryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddScreeningEntry(ctx context.Context, in *MsgAddScreeningEntry, opts ...grpc.CallOption) (*MsgAddScreeningEntryResponse, error) {
+	out := new(MsgAddScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, Msg_AddScreeningEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveScreeningEntry(ctx context.Context, in *MsgRemoveScreeningEntry, opts ...grpc.CallOption) (*MsgRemoveScreeningEntryResponse, error) {
+	out := new(MsgRemoveScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveScreeningEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddScreeningEntry and RemoveScreeningEntry define (governance)
+	// operations for listing and delisting a screened party.
+	AddScreeningEntry(context.Context, *MsgAddScreeningEntry) (*MsgAddScreeningEntryResponse, error)
+	RemoveScreeningEntry(context.Context, *MsgRemoveScreeningEntry) (*MsgRemoveScreeningEntryResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) AddScreeningEntry(context.Context, *MsgAddScreeningEntry) (*MsgAddScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScreeningEntry not implemented")
+}
+func (UnimplementedMsgServer) RemoveScreeningEntry(context.Context, *MsgRemoveScreeningEntry) (*MsgRemoveScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveScreeningEntry not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddScreeningEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddScreeningEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddScreeningEntry(ctx, req.(*MsgAddScreeningEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveScreeningEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveScreeningEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveScreeningEntry(ctx, req.(*MsgRemoveScreeningEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ecommerce.screening.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddScreeningEntry",
+			Handler:    _Msg_AddScreeningEntry_Handler,
+		},
+		{
+			MethodName: "RemoveScreeningEntry",
+			Handler:    _Msg_RemoveScreeningEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ecommerce/screening/tx.proto",
+}
@@ -31,6 +31,12 @@ import (
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/nft"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	ecommercemodulev1 "ecommerce/api/ecommerce/ecommerce/module"
+	screeningmodulev1 "ecommerce/api/ecommerce/screening/module"
+	_ "ecommerce/x/ecommerce/module" // import for side-effects
+	ecommercemoduletypes "ecommerce/x/ecommerce/types"
+	_ "ecommerce/x/screening/module" // import for side-effects
+	screeningmoduletypes "ecommerce/x/screening/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -89,6 +95,8 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		ecommercemoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +121,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		ecommercemoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +141,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		ecommercemoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -232,8 +244,11 @@ var (
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				Config: appconfig.WrapAny(&txconfigv1.Config{
+					// the ante handler screening the transactions is set in app.go
+					SkipAnteHandler: true,
+				}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -286,6 +301,14 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   ecommercemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&ecommercemodulev1.Module{}),
+			},
+			{
+				Name:   screeningmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&screeningmodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
@@ -35,6 +35,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/example/cosmos-multichain/screening"
 	// this line is used by starport scaffolding # ibc/app/import
 )
 
@@ -143,7 +144,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
 	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Inbound transfers from or to screened parties are rejected under the
+	// ibcfee middleware, which wraps their error acknowledgement
+	transferIBCModule := ibcfee.NewIBCMiddleware(
+		screening.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.ScreeningKeeper.Screener()),
+		app.IBCFeeKeeper,
+	)
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
@@ -2,47 +2,54 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/ecommerce/contracts/interfaces"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/example/cosmos-multichain/screening"
+
+	"ecommerce/contracts/interfaces"
 )
 
+// MessageTypePayment is the interchain message type of order payments
+const MessageTypePayment = "payment"
+
 // Product represents an e-commerce product
 type Product struct {
-	ProductID     string            `json:"product_id"`
-	Name          string            `json:"name"`
-	Description   string            `json:"description"`
-	Price         sdk.Int           `json:"price"`
-	Currency      string            `json:"currency"`
-	Inventory     int64             `json:"inventory"`
-	Category      string            `json:"category"`
-	Attributes    map[string]string `json:"attributes"`
-	Images        []string          `json:"images"`
-	Status        string            `json:"status"`
-	LastModified  time.Time         `json:"last_modified"`
+	ProductID    string            `json:"product_id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Price        sdkmath.Int       `json:"price"`
+	Currency     string            `json:"currency"`
+	Inventory    int64             `json:"inventory"`
+	Category     string            `json:"category"`
+	Attributes   map[string]string `json:"attributes"`
+	Images       []string          `json:"images"`
+	Status       string            `json:"status"`
+	LastModified time.Time         `json:"last_modified"`
 }
 
 // Order represents an e-commerce order
 type Order struct {
-	OrderID       string         `json:"order_id"`
-	CustomerID    string         `json:"customer_id"`
-	Items         []OrderItem    `json:"items"`
-	TotalAmount   sdk.Int        `json:"total_amount"`
-	Status        string         `json:"status"`
-	PaymentStatus string         `json:"payment_status"`
-	ShippingInfo  ShippingInfo   `json:"shipping_info"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
+	OrderID       string       `json:"order_id"`
+	CustomerID    string       `json:"customer_id"`
+	Items         []OrderItem  `json:"items"`
+	TotalAmount   sdkmath.Int  `json:"total_amount"`
+	Status        string       `json:"status"`
+	PaymentStatus string       `json:"payment_status"`
+	ShippingInfo  ShippingInfo `json:"shipping_info"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
 }
 
 // OrderItem represents an item in an order
 type OrderItem struct {
-	ProductID  string  `json:"product_id"`
-	Quantity   int64   `json:"quantity"`
-	UnitPrice  sdk.Int `json:"unit_price"`
-	Subtotal   sdk.Int `json:"subtotal"`
+	ProductID string      `json:"product_id"`
+	Quantity  int64       `json:"quantity"`
+	UnitPrice sdkmath.Int `json:"unit_price"`
+	Subtotal  sdkmath.Int `json:"subtotal"`
 }
 
 // ShippingInfo contains shipping details
@@ -57,86 +64,91 @@ type ShippingInfo struct {
 	EstDelivery time.Time `json:"est_delivery"`
 }
 
+// Payment is the payment of an order, settled on the finance chain
+type Payment struct {
+	TransactionID string      `json:"transaction_id"`
+	OrderID       string      `json:"order_id"`
+	CustomerID    string      `json:"customer_id"`
+	FromAddress   string      `json:"from_address"`
+	ToAddress     string      `json:"to_address"`
+	Amount        sdkmath.Int `json:"amount"`
+	Currency      string      `json:"currency"`
+}
+
 // EcommerceContract implements the IEcommerceContract interface
 type EcommerceContract struct {
 	productManager  interfaces.IProductManager
-	orderProcessor interfaces.IOrderProcessor
+	orderProcessor  interfaces.IOrderProcessor
 	shippingManager interfaces.IShippingManager
+	screener        screening.Screener
+	router          *interchain.Router
 }
 
 func NewEcommerceContract(
 	productManager interfaces.IProductManager,
 	orderProcessor interfaces.IOrderProcessor,
 	shippingManager interfaces.IShippingManager,
+	screener screening.Screener,
 ) *EcommerceContract {
-	return &EcommerceContract{
+	c := &EcommerceContract{
 		productManager:  productManager,
-		orderProcessor: orderProcessor,
+		orderProcessor:  orderProcessor,
 		shippingManager: shippingManager,
+		screener:        screener,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleFinanceMessage,
+			Prepare:  c.prepareFinanceMessage,
+			Callback: c.handleFinanceCallback,
+		}).
+		AddRoute(interchain.ChainSupplyChain, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleSupplyChainMessage,
+			Prepare:  c.prepareSupplyChainMessage,
+			Callback: c.handleSupplyChainCallback,
+		}).
+		AddRoute(interchain.ChainRetail, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleRetailMessage,
+			Prepare:  c.prepareRetailMessage,
+			Callback: c.handleRetailCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IEcommerceContract
 func (c *EcommerceContract) ValidateInterchainData(ctx sdk.Context, data []byte) error {
 	var order Order
 	if err := json.Unmarshal(data, &order); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid order format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid order format")
 	}
 
 	// Validate required fields
 	if order.OrderID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "order ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order ID is required")
 	}
 	if order.CustomerID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "customer ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "customer ID is required")
 	}
 	if len(order.Items) == 0 {
-		return errors.Wrap(errors.ErrInvalidRequest, "order must contain items")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order must contain items")
 	}
 
 	return nil
 }
 
 // ProcessInterchainMessage implements IEcommerceContract
-func (c *EcommerceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceMessage(ctx, message)
-	case "supplychain":
-		return c.handleSupplyChainMessage(ctx, message)
-	case "retail":
-		return c.handleRetailMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+func (c *EcommerceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IEcommerceContract
-func (c *EcommerceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	switch targetChain {
-	case "finance":
-		return c.prepareFinanceMessage(data)
-	case "supplychain":
-		return c.prepareSupplyChainMessage(data)
-	case "retail":
-		return c.prepareRetailMessage(data)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+func (c *EcommerceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IEcommerceContract
-func (c *EcommerceContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceCallback(ctx, response)
-	case "supplychain":
-		return c.handleSupplyChainCallback(ctx, response)
-	case "retail":
-		return c.handleRetailCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+func (c *EcommerceContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ProcessOrder implements IEcommerceContract
@@ -144,6 +156,11 @@ func (c *EcommerceContract) ProcessOrder(ctx sdk.Context, order []byte) error {
 	return c.orderProcessor.ProcessOrder(ctx, order)
 }
 
+// CancelOrder cancels an order that was not shipped yet
+func (c *EcommerceContract) CancelOrder(ctx sdk.Context, orderID string) error {
+	return c.orderProcessor.CancelOrder(ctx, orderID)
+}
+
 // UpdateInventory implements IEcommerceContract
 func (c *EcommerceContract) UpdateInventory(ctx sdk.Context, productID string, quantity int64) error {
 	return c.productManager.UpdateInventory(ctx, productID, quantity)
@@ -153,33 +170,48 @@ func (c *EcommerceContract) UpdateInventory(ctx sdk.Context, productID string, q
 func (c *EcommerceContract) ValidateProduct(ctx sdk.Context, product []byte) error {
 	var p Product
 	if err := json.Unmarshal(product, &p); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid product format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid product format")
 	}
 
 	// Validate required fields
 	if p.ProductID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "product ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "product ID is required")
 	}
 	if p.Name == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "product name is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "product name is required")
 	}
 	if p.Price.IsNil() || p.Price.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid price")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid price")
 	}
 
 	return nil
 }
 
-// ProcessPayment implements IEcommerceContract
+// ProcessPayment implements IEcommerceContract. Payments of a screened
+// customer or between screened parties are rejected.
 func (c *EcommerceContract) ProcessPayment(ctx sdk.Context, payment []byte) error {
+	var p Payment
+	if err := json.Unmarshal(payment, &p); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	if p.TransactionID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
+	}
+	for _, party := range []string{p.CustomerID, p.FromAddress, p.ToAddress} {
+		if c.screener.IsScreened(ctx, party) {
+			c.screener.LogBlocked(ctx, p.TransactionID, party)
+			return errorsmod.Wrap(screening.ErrScreened, party)
+		}
+	}
+
 	// Prepare payment message for finance chain
-	paymentMsg, err := c.prepareFinanceMessage(payment)
+	paymentMsg, err := c.PrepareInterchainMessage(ctx, interchain.ChainFinance, MessageTypePayment, payment)
 	if err != nil {
 		return err
 	}
 
 	// Send payment to finance chain
-	return c.ProcessInterchainMessage(ctx, "finance", paymentMsg)
+	return c.ProcessInterchainMessage(ctx, interchain.ChainFinance, MessageTypePayment, paymentMsg)
 }
 
 // Internal handlers for chain-specific messages
@@ -199,17 +231,17 @@ func (c *EcommerceContract) handleRetailMessage(ctx sdk.Context, message []byte)
 }
 
 // Internal message preparation
-func (c *EcommerceContract) prepareFinanceMessage(data []byte) ([]byte, error) {
+func (c *EcommerceContract) prepareFinanceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for finance chain
 	return data, nil
 }
 
-func (c *EcommerceContract) prepareSupplyChainMessage(data []byte) ([]byte, error) {
+func (c *EcommerceContract) prepareSupplyChainMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for supply chain
 	return data, nil
 }
 
-func (c *EcommerceContract) prepareRetailMessage(data []byte) ([]byte, error) {
+func (c *EcommerceContract) prepareRetailMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for retail chain
 	return data, nil
 }
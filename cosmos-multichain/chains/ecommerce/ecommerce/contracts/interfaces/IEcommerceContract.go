@@ -1,16 +1,15 @@
 package interfaces
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IEcommerceContract extends the base interchain contract with e-commerce features
 type IEcommerceContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// E-commerce specific functionality
 	ProcessOrder(ctx sdk.Context, order []byte) error
@@ -64,5 +63,5 @@ type IShippingManager interface {
 	TrackShipment(ctx sdk.Context, shipmentID string) ([]byte, error)
 
 	// CalculateShipping calculates shipping costs
-	CalculateShipping(ctx sdk.Context, orderID string, destination string) (sdk.Int, error)
+	CalculateShipping(ctx sdk.Context, orderID string, destination string) (sdkmath.Int, error)
 }
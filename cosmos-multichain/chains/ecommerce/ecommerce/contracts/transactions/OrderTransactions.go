@@ -2,20 +2,24 @@ package transactions
 
 import (
 	"encoding/json"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/ecommerce/contracts"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"ecommerce/contracts"
 )
 
 // TransactionType defines the type of order transaction
 type TransactionType string
 
 const (
-	NewOrder     TransactionType = "NEW_ORDER"
-	UpdateOrder  TransactionType = "UPDATE_ORDER"
-	CancelOrder  TransactionType = "CANCEL_ORDER"
-	RefundOrder  TransactionType = "REFUND_ORDER"
+	NewOrder    TransactionType = "NEW_ORDER"
+	UpdateOrder TransactionType = "UPDATE_ORDER"
+	CancelOrder TransactionType = "CANCEL_ORDER"
+	RefundOrder TransactionType = "REFUND_ORDER"
 )
 
 // OrderTransactionRequest represents an order transaction request
@@ -25,7 +29,7 @@ type OrderTransactionRequest struct {
 	CustomerID      string          `json:"customer_id"`
 	TransactionType TransactionType `json:"transaction_type"`
 	Items           []OrderItem     `json:"items"`
-	TotalAmount     sdk.Int         `json:"total_amount"`
+	TotalAmount     sdkmath.Int     `json:"total_amount"`
 	ShippingInfo    ShippingInfo    `json:"shipping_info"`
 	Timestamp       time.Time       `json:"timestamp"`
 	Metadata        Metadata        `json:"metadata"`
@@ -33,10 +37,10 @@ type OrderTransactionRequest struct {
 
 // OrderItem represents an item in the order
 type OrderItem struct {
-	ProductID  string  `json:"product_id"`
-	Quantity   int64   `json:"quantity"`
-	UnitPrice  sdk.Int `json:"unit_price"`
-	Subtotal   sdk.Int `json:"subtotal"`
+	ProductID string      `json:"product_id"`
+	Quantity  int64       `json:"quantity"`
+	UnitPrice sdkmath.Int `json:"unit_price"`
+	Subtotal  sdkmath.Int `json:"subtotal"`
 }
 
 // ShippingInfo contains shipping details
@@ -86,26 +90,26 @@ func (h *OrderTransactionHandler) InitiateTransaction(ctx sdk.Context, req Order
 	case RefundOrder:
 		return h.processRefundOrder(ctx, req)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported transaction type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported transaction type")
 	}
 }
 
 // ValidateRequest validates the transaction request
 func (h *OrderTransactionHandler) validateRequest(req OrderTransactionRequest) error {
 	if req.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 	if req.OrderID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "order ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order ID is required")
 	}
 	if req.CustomerID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "customer ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "customer ID is required")
 	}
 	if len(req.Items) == 0 {
-		return errors.Wrap(errors.ErrInvalidRequest, "order must contain items")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order must contain items")
 	}
 	if req.TotalAmount.IsNil() || req.TotalAmount.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid total amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid total amount")
 	}
 
 	return nil
@@ -116,7 +120,7 @@ func (h *OrderTransactionHandler) processNewOrder(ctx sdk.Context, req OrderTran
 	// Convert request to order format
 	orderData, err := json.Marshal(req)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal order data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal order data")
 	}
 
 	// Process the order
@@ -129,8 +133,7 @@ func (h *OrderTransactionHandler) processUpdateOrder(ctx sdk.Context, req OrderT
 }
 
 func (h *OrderTransactionHandler) processCancelOrder(ctx sdk.Context, req OrderTransactionRequest) error {
-	// Handle order cancellation logic
-	return nil
+	return h.contract.CancelOrder(ctx, req.OrderID)
 }
 
 func (h *OrderTransactionHandler) processRefundOrder(ctx sdk.Context, req OrderTransactionRequest) error {
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"ecommerce/x/ecommerce/keeper"
+	"ecommerce/x/ecommerce/types"
+	screeningkeeper "ecommerce/x/screening/keeper"
+	screeningtypes "ecommerce/x/screening/types"
+)
+
+func EcommerceKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := EcommerceKeeperWithScreening(t)
+	return k, ctx
+}
+
+// EcommerceKeeperWithScreening returns an e-commerce keeper screening the
+// parties of payments with a screening keeper
+func EcommerceKeeperWithScreening(t testing.TB) (keeper.Keeper, screeningkeeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	screeningStoreKey := storetypes.NewKVStoreKey(screeningtypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(screeningStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	screeningKeeper := screeningkeeper.NewKeeper(cdc, runtime.NewKVStoreService(screeningStoreKey), log.NewNopLogger(), authority.String())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		screeningKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+	if err := screeningKeeper.SetParams(ctx, screeningtypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, screeningKeeper, ctx
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"ecommerce/contracts"
+	"ecommerce/x/ecommerce/types"
+)
+
+// ContractRecord kinds kept by the e-commerce contract
+const (
+	RecordKindProduct  = "product"
+	RecordKindOrder    = "order"
+	RecordKindShipment = "shipment"
+)
+
+const (
+	ProductStatusActive  = "ACTIVE"
+	ProductStatusDeleted = "DELETED"
+
+	OrderStatusPlaced    = "PLACED"
+	OrderStatusShipped   = "SHIPPED"
+	OrderStatusCancelled = "CANCELLED"
+
+	ShipmentStatusCreated = "CREATED"
+)
+
+var (
+	// ShippingBaseRate is the shipping cost of an order
+	ShippingBaseRate = sdkmath.NewInt(5)
+	// ShippingItemRate is the shipping cost added by each ordered unit
+	ShippingItemRate = sdkmath.NewInt(1)
+)
+
+// newContract builds the e-commerce contract on top of the module state
+func newContract(k Keeper) *contracts.EcommerceContract {
+	products := productManager{k}
+	return contracts.NewEcommerceContract(products, orderProcessor{k, products}, shippingManager{k}, k.screeningKeeper.Screener())
+}
+
+// setRecord stores data as the contract record of the given kind and id
+func (k Keeper) setRecord(ctx sdk.Context, kind, id string, data []byte, status string) {
+	k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:      kind,
+		RecordId:  id,
+		Data:      data,
+		Status:    status,
+		UpdatedAt: ctx.BlockTime().Unix(),
+	})
+}
+
+// productManager keeps the product catalog as contract records
+type productManager struct {
+	k Keeper
+}
+
+func unmarshalProduct(product []byte) (contracts.Product, error) {
+	var p contracts.Product
+	if err := json.Unmarshal(product, &p); err != nil {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "invalid product format")
+	}
+	if p.ProductID == "" {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "product ID is required")
+	}
+	return p, nil
+}
+
+func (m productManager) AddProduct(ctx sdk.Context, product []byte) error {
+	p, err := unmarshalProduct(product)
+	if err != nil {
+		return err
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindProduct, p.ProductID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "product %s already exists", p.ProductID)
+	}
+	m.k.setRecord(ctx, RecordKindProduct, p.ProductID, product, ProductStatusActive)
+	return nil
+}
+
+func (m productManager) UpdateProduct(ctx sdk.Context, product []byte) error {
+	p, err := unmarshalProduct(product)
+	if err != nil {
+		return err
+	}
+	if _, err := m.activeProduct(ctx, p.ProductID); err != nil {
+		return err
+	}
+	m.k.setRecord(ctx, RecordKindProduct, p.ProductID, product, ProductStatusActive)
+	return nil
+}
+
+func (m productManager) DeleteProduct(ctx sdk.Context, productID string) error {
+	record, found := m.k.GetContractRecord(ctx, RecordKindProduct, productID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, productID)
+	}
+	m.k.setRecord(ctx, RecordKindProduct, productID, record.Data, ProductStatusDeleted)
+	return nil
+}
+
+func (m productManager) GetProduct(ctx sdk.Context, productID string) ([]byte, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindProduct, productID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRecordNotFound, productID)
+	}
+	return record.Data, nil
+}
+
+// UpdateInventory adds quantity, which may be negative, to the inventory of a product
+func (m productManager) UpdateInventory(ctx sdk.Context, productID string, quantity int64) error {
+	p, err := m.activeProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if p.Inventory+quantity < 0 {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "insufficient inventory of product %s", productID)
+	}
+	p.Inventory += quantity
+	p.LastModified = ctx.BlockTime()
+
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	m.k.setRecord(ctx, RecordKindProduct, productID, bz, ProductStatusActive)
+	return nil
+}
+
+func (m productManager) activeProduct(ctx sdk.Context, productID string) (contracts.Product, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindProduct, productID)
+	if !found {
+		return contracts.Product{}, errorsmod.Wrap(types.ErrRecordNotFound, productID)
+	}
+	if record.Status != ProductStatusActive {
+		return contracts.Product{}, errorsmod.Wrapf(interchain.ErrInvalidData, "product %s is not active", productID)
+	}
+	return unmarshalProduct(record.Data)
+}
+
+// orderProcessor keeps orders as contract records and reserves their inventory
+type orderProcessor struct {
+	k        Keeper
+	products productManager
+}
+
+func unmarshalOrder(order []byte) (contracts.Order, error) {
+	var o contracts.Order
+	if err := json.Unmarshal(order, &o); err != nil {
+		return o, errorsmod.Wrap(interchain.ErrInvalidData, "invalid order format")
+	}
+	return o, nil
+}
+
+func (p orderProcessor) ValidateOrder(ctx sdk.Context, order []byte) error {
+	o, err := unmarshalOrder(order)
+	if err != nil {
+		return err
+	}
+	return validateOrder(o)
+}
+
+func validateOrder(o contracts.Order) error {
+	if o.OrderID == "" || o.CustomerID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order and customer IDs are required")
+	}
+	if len(o.Items) == 0 {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order must contain items")
+	}
+	for _, item := range o.Items {
+		if item.ProductID == "" || item.Quantity <= 0 {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "order items need a product and a positive quantity")
+		}
+	}
+	return nil
+}
+
+func (p orderProcessor) ProcessOrder(ctx sdk.Context, order []byte) error {
+	o, err := unmarshalOrder(order)
+	if err != nil {
+		return err
+	}
+	if err := validateOrder(o); err != nil {
+		return err
+	}
+	if _, found := p.k.GetContractRecord(ctx, RecordKindOrder, o.OrderID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "order %s already exists", o.OrderID)
+	}
+
+	for _, item := range o.Items {
+		if err := p.products.UpdateInventory(ctx, item.ProductID, -item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	p.k.setRecord(ctx, RecordKindOrder, o.OrderID, order, OrderStatusPlaced)
+	return nil
+}
+
+func (p orderProcessor) UpdateOrderStatus(ctx sdk.Context, orderID string, status string) error {
+	record, found := p.k.GetContractRecord(ctx, RecordKindOrder, orderID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, orderID)
+	}
+	if record.Status == OrderStatusCancelled {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "order %s is cancelled", orderID)
+	}
+	p.k.setRecord(ctx, RecordKindOrder, orderID, record.Data, status)
+	return nil
+}
+
+// CancelOrder cancels an order that was not shipped yet and restocks its items
+func (p orderProcessor) CancelOrder(ctx sdk.Context, orderID string) error {
+	record, found := p.k.GetContractRecord(ctx, RecordKindOrder, orderID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, orderID)
+	}
+	if record.Status == OrderStatusCancelled || record.Status == OrderStatusShipped {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "order %s cannot be cancelled once %s", orderID, record.Status)
+	}
+
+	o, err := unmarshalOrder(record.Data)
+	if err != nil {
+		return err
+	}
+	for _, item := range o.Items {
+		if err := p.products.UpdateInventory(ctx, item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	p.k.setRecord(ctx, RecordKindOrder, orderID, record.Data, OrderStatusCancelled)
+	return nil
+}
+
+// shippingManager keeps one shipment per order as contract records
+type shippingManager struct {
+	k Keeper
+}
+
+func (m shippingManager) CreateShipment(ctx sdk.Context, orderID string, shippingDetails []byte) error {
+	record, found := m.k.GetContractRecord(ctx, RecordKindOrder, orderID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, orderID)
+	}
+	if record.Status == OrderStatusCancelled {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "order %s is cancelled", orderID)
+	}
+	var info contracts.ShippingInfo
+	if err := json.Unmarshal(shippingDetails, &info); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid shipping details format")
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindShipment, orderID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "order %s is already shipped", orderID)
+	}
+
+	m.k.setRecord(ctx, RecordKindShipment, orderID, shippingDetails, ShipmentStatusCreated)
+	m.k.setRecord(ctx, RecordKindOrder, orderID, record.Data, OrderStatusShipped)
+	return nil
+}
+
+func (m shippingManager) UpdateShipmentStatus(ctx sdk.Context, shipmentID string, status string) error {
+	record, found := m.k.GetContractRecord(ctx, RecordKindShipment, shipmentID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, shipmentID)
+	}
+	m.k.setRecord(ctx, RecordKindShipment, shipmentID, record.Data, status)
+	return nil
+}
+
+func (m shippingManager) TrackShipment(ctx sdk.Context, shipmentID string) ([]byte, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindShipment, shipmentID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRecordNotFound, shipmentID)
+	}
+	return json.Marshal(record)
+}
+
+// CalculateShipping charges the base rate plus the item rate for every ordered unit
+func (m shippingManager) CalculateShipping(ctx sdk.Context, orderID string, destination string) (sdkmath.Int, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindOrder, orderID)
+	if !found {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrRecordNotFound, orderID)
+	}
+	o, err := unmarshalOrder(record.Data)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	cost := ShippingBaseRate
+	for _, item := range o.Items {
+		cost = cost.Add(ShippingItemRate.MulRaw(item.Quantity))
+	}
+	return cost, nil
+}
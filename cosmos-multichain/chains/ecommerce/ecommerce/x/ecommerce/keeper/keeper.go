@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"ecommerce/contracts"
+	"ecommerce/contracts/transactions"
+	"ecommerce/x/ecommerce/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		screeningKeeper types.ScreeningKeeper
+
+		contract     *contracts.EcommerceContract
+		transactions *transactions.OrderTransactionHandler
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	screeningKeeper types.ScreeningKeeper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+
+		screeningKeeper: screeningKeeper,
+	}
+	k.contract = newContract(k)
+	k.transactions = transactions.NewOrderTransactionHandler(k.contract)
+	return k
+}
+
+// Contract returns the e-commerce contract backed by the module state.
+func (k Keeper) Contract() *contracts.EcommerceContract {
+	return k.contract
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"ecommerce/contracts/transactions"
+	"ecommerce/x/ecommerce/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.OrderTransactionRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	// Orders are placed, updated, cancelled and refunded by their customer,
+	// who must sign them
+	if req.CustomerID != msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the customer can initiate a %s", req.TransactionType)
+	}
+	if record, found := k.GetContractRecord(ctx, RecordKindOrder, req.OrderID); found && req.TransactionType != transactions.NewOrder {
+		if order, err := unmarshalOrder(record.Data); err == nil && order.CustomerID != msg.Creator {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "order %s belongs to another customer", req.OrderID)
+		}
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TransactionType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TransactionType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/example/cosmos-multichain/screening"
+	"github.com/stretchr/testify/require"
+
+	"ecommerce/contracts"
+	"ecommerce/contracts/transactions"
+	keepertest "ecommerce/testutil/keeper"
+	"ecommerce/testutil/sample"
+	"ecommerce/x/ecommerce/keeper"
+	"ecommerce/x/ecommerce/types"
+	screeningtypes "ecommerce/x/screening/types"
+)
+
+// customer places the orders of the tests
+var customer = sample.AccAddress()
+
+func orderData(t *testing.T, id string, txType transactions.TransactionType, quantity int64) []byte {
+	return customerOrderData(t, id, customer, txType, quantity)
+}
+
+func customerOrderData(t *testing.T, id, customerID string, txType transactions.TransactionType, quantity int64) []byte {
+	data, err := json.Marshal(transactions.OrderTransactionRequest{
+		TransactionID:   id,
+		OrderID:         "order-1",
+		CustomerID:      customerID,
+		TransactionType: txType,
+		Items: []transactions.OrderItem{
+			{ProductID: "product-1", Quantity: quantity, UnitPrice: sdkmath.NewInt(10), Subtotal: sdkmath.NewInt(10 * quantity)},
+		},
+		TotalAmount: sdkmath.NewInt(10 * quantity),
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func TestOrderInventory(t *testing.T) {
+	k, ctx := keepertest.EcommerceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := customer
+
+	stock := func() int64 {
+		record, found := k.GetContractRecord(ctx, keeper.RecordKindProduct, "product-1")
+		require.True(t, found)
+		var p contracts.Product
+		require.NoError(t, json.Unmarshal(record.Data, &p))
+		return p.Inventory
+	}
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-0", transactions.NewOrder, 1)))
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	product, err := json.Marshal(contracts.Product{ProductID: "product-1", Name: "Lamp", Price: sdkmath.NewInt(10), Inventory: 5})
+	require.NoError(t, err)
+	k.SetContractRecord(ctx, types.ContractRecord{Kind: keeper.RecordKindProduct, RecordId: "product-1", Data: product, Status: keeper.ProductStatusActive})
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-1", transactions.NewOrder, 6)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-2", transactions.NewOrder, 3)))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stock())
+
+	// Only the customer of the order signs it, and cancels it
+	other := sample.AccAddress()
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(other, orderData(t, "tx-5", transactions.CancelOrder, 3)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(other, customerOrderData(t, "tx-5", other, transactions.CancelOrder, 3)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, int64(2), stock())
+	_, found := k.GetTransaction(ctx, "tx-5")
+	require.False(t, found)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-3", transactions.CancelOrder, 3)))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), stock())
+	order, _ := k.GetContractRecord(ctx, keeper.RecordKindOrder, "order-1")
+	require.Equal(t, keeper.OrderStatusCancelled, order.Status)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-4", transactions.CancelOrder, 3)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	k, ctx := keepertest.EcommerceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := customer
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, orderData(t, "tx-1", transactions.UpdateOrder, 1)))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
+
+func TestScreenedPayment(t *testing.T) {
+	k, screeningKeeper, ctx := keepertest.EcommerceKeeperWithScreening(t)
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	payer, merchant := sample.AccAddress(), sample.AccAddress()
+	pay := func(id, customerID string) error {
+		payment, err := json.Marshal(contracts.Payment{
+			TransactionID: id,
+			OrderID:       "order-1",
+			CustomerID:    customerID,
+			FromAddress:   payer,
+			ToAddress:     merchant,
+			Amount:        sdkmath.NewInt(30),
+			Currency:      "stake",
+		})
+		require.NoError(t, err)
+		return k.Contract().ProcessPayment(ctx, payment)
+	}
+
+	require.NoError(t, pay("tx-1", "customer-1"))
+
+	// customers are listed by the hash of their ID
+	require.NoError(t, screeningKeeper.ListEntry(ctx, screeningtypes.EntryKindIdentifier, screening.HashIdentifier("customer-1"), "sanctioned"))
+	require.ErrorIs(t, pay("tx-2", "customer-1"), screening.ErrScreened)
+	require.NoError(t, pay("tx-3", "customer-2"))
+
+	require.NoError(t, screeningKeeper.ListEntry(ctx, screeningtypes.EntryKindAddress, merchant, "sanctioned"))
+	require.ErrorIs(t, pay("tx-4", "customer-2"), screening.ErrScreened)
+
+	// the blocked payments are recorded at the end of the block, out of
+	// their reverted state
+	require.Equal(t, 2, screeningKeeper.Journal().Len())
+	screeningKeeper.Journal().Flush(ctx)
+	var blocked []string
+	for _, record := range screeningKeeper.GetAllScreeningRecord(ctx) {
+		if record.Action == screeningtypes.RecordActionBlocked {
+			blocked = append(blocked, record.Detail)
+		}
+	}
+	require.Equal(t, []string{"tx-2", "tx-4"}, blocked)
+}
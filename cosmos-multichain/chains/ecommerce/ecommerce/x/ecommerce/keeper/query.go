@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"ecommerce/x/ecommerce/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,76 @@
+package ecommerce
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "ecommerce/api/ecommerce/ecommerce"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "TransactionAll",
+					Use:       "list-transaction",
+					Short:     "List all transaction",
+				},
+				{
+					RpcMethod:      "Transaction",
+					Use:            "show-transaction [transaction-id]",
+					Short:          "Shows a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "ContractRecord",
+					Use:            "show-contract-record [kind] [record-id]",
+					Short:          "Shows a record kept by the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kind"}, {ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "AuditTrail",
+					Use:            "audit-trail [subject-id]",
+					Short:          "List the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "InitiateTransaction",
+					Use:            "initiate-transaction [data]",
+					Short:          "Run a JSON encoded e-commerce transaction through the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data"}},
+				},
+				{
+					RpcMethod:      "UpdateTransactionStatus",
+					Use:            "update-transaction-status [transaction-id] [status]",
+					Short:          "Update the status of a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "FinalizeTransaction",
+					Use:            "finalize-transaction [transaction-id]",
+					Short:          "Finalize a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,553 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ecommerce/ecommerce/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the ecommerce module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransactionList    []Transaction    `protobuf:"bytes,2,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	ContractRecordList []ContractRecord `protobuf:"bytes,3,rep,name=contract_record_list,json=contractRecordList,proto3" json:"contract_record_list"`
+	AuditEntryList     []AuditEntry     `protobuf:"bytes,4,rep,name=audit_entry_list,json=auditEntryList,proto3" json:"audit_entry_list"`
+	AuditEntryCount    uint64           `protobuf:"varint,5,opt,name=audit_entry_count,json=auditEntryCount,proto3" json:"audit_entry_count,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a13658480efdaa5, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTransactionList() []Transaction {
+	if m != nil {
+		return m.TransactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRecordList() []ContractRecord {
+	if m != nil {
+		return m.ContractRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryList() []AuditEntry {
+	if m != nil {
+		return m.AuditEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryCount() uint64 {
+	if m != nil {
+		return m.AuditEntryCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ecommerce.ecommerce.GenesisState")
+}
+
+func init() { proto.RegisterFile("ecommerce/ecommerce/genesis.proto", fileDescriptor_7a13658480efdaa5) }
+
+var fileDescriptor_7a13658480efdaa5 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4b, 0x3a, 0x41,
+	0x14, 0xc7, 0x77, 0xd4, 0x9f, 0xf0, 0x1b, 0x23, 0x75, 0xf3, 0x20, 0x0a, 0xeb, 0x56, 0x04, 0xe2,
+	0x61, 0x05, 0xa3, 0x6b, 0x90, 0x12, 0x5d, 0x82, 0x6a, 0xeb, 0x54, 0x07, 0x99, 0xc6, 0x41, 0x16,
+	0xda, 0x19, 0x99, 0x7d, 0x42, 0xfe, 0x17, 0xfd, 0x19, 0x1d, 0xfb, 0x33, 0x3c, 0x7a, 0xec, 0x14,
+	0xa1, 0x87, 0xfe, 0x88, 0x2e, 0xb1, 0x33, 0x83, 0xbb, 0xc1, 0xd0, 0x65, 0x79, 0x7c, 0xf7, 0xf3,
+	0x3e, 0xef, 0x0d, 0x0f, 0xef, 0x33, 0x2a, 0xe2, 0x98, 0x49, 0xca, 0xfa, 0x59, 0x35, 0x65, 0x9c,
+	0x25, 0x51, 0x12, 0xcc, 0xa4, 0x00, 0xe1, 0xee, 0x6d, 0x7f, 0x04, 0xdb, 0xaa, 0x55, 0x27, 0x71,
+	0xc4, 0x45, 0x5f, 0x7d, 0x35, 0xd7, 0x6a, 0x4c, 0xc5, 0x54, 0xa8, 0xb2, 0x9f, 0x56, 0x26, 0xf5,
+	0x6d, 0x03, 0x66, 0x44, 0x92, 0xd8, 0xf8, 0x5b, 0x47, 0x36, 0x02, 0x24, 0xe1, 0x09, 0xa1, 0x10,
+	0x09, 0xfe, 0x97, 0x48, 0x32, 0x2a, 0xe4, 0x44, 0x13, 0x07, 0xdf, 0x05, 0xbc, 0x73, 0xa1, 0x57,
+	0xbf, 0x05, 0x02, 0xcc, 0x3d, 0xc5, 0x65, 0x3d, 0xa9, 0x89, 0x7c, 0xd4, 0xad, 0x0c, 0xda, 0x81,
+	0xe5, 0x29, 0xc1, 0xb5, 0x42, 0x86, 0xff, 0x97, 0x1f, 0x1d, 0xe7, 0xf5, 0xeb, 0xad, 0x87, 0x42,
+	0xd3, 0xe5, 0xde, 0xe0, 0x5a, 0x6e, 0x8f, 0xf1, 0x53, 0x94, 0x40, 0xb3, 0xe0, 0x17, 0xbb, 0x95,
+	0x81, 0x6f, 0x35, 0xdd, 0x65, 0xf0, 0xb0, 0x94, 0xea, 0xc2, 0x6a, 0xae, 0xff, 0x32, 0x4a, 0xc0,
+	0x7d, 0xc0, 0x0d, 0x2a, 0x38, 0x48, 0x42, 0x61, 0xac, 0x97, 0xd7, 0xda, 0xa2, 0xd2, 0x1e, 0x5a,
+	0xb5, 0x23, 0xd3, 0x10, 0x2a, 0xde, 0x98, 0x5d, 0xfa, 0x2b, 0x55, 0xf2, 0x2b, 0x5c, 0x23, 0xf3,
+	0x49, 0x04, 0x63, 0xc6, 0x41, 0x2e, 0xb4, 0xb8, 0xa4, 0xc4, 0x1d, 0xab, 0xf8, 0x2c, 0x85, 0xcf,
+	0x53, 0xd6, 0x48, 0x77, 0xc9, 0x36, 0x51, 0xc2, 0x1e, 0xae, 0xe7, 0x85, 0x54, 0xcc, 0x39, 0x34,
+	0xff, 0xf9, 0xa8, 0x5b, 0x0a, 0xab, 0x19, 0x3a, 0x4a, 0xe3, 0xe1, 0xc9, 0x72, 0xed, 0xa1, 0xd5,
+	0xda, 0x43, 0x9f, 0x6b, 0x0f, 0xbd, 0x6c, 0x3c, 0x67, 0xb5, 0xf1, 0x9c, 0xf7, 0x8d, 0xe7, 0xdc,
+	0xb7, 0xb3, 0x7b, 0x3d, 0xe7, 0x4f, 0xbc, 0x98, 0xb1, 0xe4, 0xb1, 0xac, 0x6e, 0x77, 0xfc, 0x33,
+	0x00, 0x9f, 0xd4, 0x89, 0x48, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuditEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditEntryCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuditEntryList) > 0 {
+		for iNdEx := len(m.AuditEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for iNdEx := len(m.ContractRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for _, e := range m.ContractRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntryList) > 0 {
+		for _, e := range m.AuditEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditEntryCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRecordList = append(m.ContractRecordList, ContractRecord{})
+			if err := m.ContractRecordList[len(m.ContractRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntryList = append(m.AuditEntryList, AuditEntry{})
+			if err := m.AuditEntryList[len(m.AuditEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryCount", wireType)
+			}
+			m.AuditEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"ecommerce/x/ecommerce/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "1"},
+				},
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "b", RecordId: "0"},
+				},
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0, SubjectId: "0"},
+					{Id: 1, SubjectId: "0"},
+				},
+				AuditEntryCount: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated transaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contractRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "a", RecordId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auditEntry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0},
+					{Id: 0},
+				},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auditEntry count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 1},
+				},
+				AuditEntryCount: 0,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
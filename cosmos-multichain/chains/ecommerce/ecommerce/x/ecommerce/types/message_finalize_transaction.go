@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFinalizeTransaction{}
+
+func NewMsgFinalizeTransaction(
+	creator string,
+	transactionId string,
+) *MsgFinalizeTransaction {
+	return &MsgFinalizeTransaction{
+		Creator:       creator,
+		TransactionId: transactionId,
+	}
+}
+
+func (msg *MsgFinalizeTransaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TransactionId == "" {
+		return errorsmod.Wrap(ErrInvalidTransaction, "transaction id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package types
+
+// Transaction statuses set by the module. Other statuses are set by the
+// transaction creator through MsgUpdateTransactionStatus.
+const (
+	TransactionStatusInitiated = "INITIATED"
+	TransactionStatusFinalized = "FINALIZED"
+)
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"ecommerce/x/screening/types"
+)
+
+func (k msgServer) RemoveScreeningEntry(goCtx context.Context, msg *types.MsgRemoveScreeningEntry) (*types.MsgRemoveScreeningEntryResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.DelistEntry(goCtx, msg.Value, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveScreeningEntryResponse{}, nil
+}
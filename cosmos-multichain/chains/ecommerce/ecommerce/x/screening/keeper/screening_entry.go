@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"ecommerce/x/screening/types"
+)
+
+// SetScreeningEntry set a specific screeningEntry in the store from its index
+func (k Keeper) SetScreeningEntry(ctx context.Context, screeningEntry types.ScreeningEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningEntryKeyPrefix))
+	b := k.cdc.MustMarshal(&screeningEntry)
+	store.Set(types.ScreeningEntryKey(
+		screeningEntry.Value,
+	), b)
+}
+
+// GetScreeningEntry returns a screeningEntry from its index
+func (k Keeper) GetScreeningEntry(
+	ctx context.Context,
+	value string,
+) (val types.ScreeningEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningEntryKeyPrefix))
+
+	b := store.Get(types.ScreeningEntryKey(
+		value,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveScreeningEntry removes a screeningEntry from the store
+func (k Keeper) RemoveScreeningEntry(
+	ctx context.Context,
+	value string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningEntryKeyPrefix))
+	store.Delete(types.ScreeningEntryKey(
+		value,
+	))
+}
+
+// GetAllScreeningEntry returns all screeningEntry
+func (k Keeper) GetAllScreeningEntry(ctx context.Context) (list []types.ScreeningEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningEntryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ScreeningEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
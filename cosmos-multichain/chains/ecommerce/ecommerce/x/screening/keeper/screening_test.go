@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/example/cosmos-multichain/screening"
+	"github.com/stretchr/testify/require"
+
+	keepertest "ecommerce/testutil/keeper"
+	"ecommerce/testutil/sample"
+	"ecommerce/x/screening/keeper"
+	"ecommerce/x/screening/types"
+)
+
+func TestScreeningEntries(t *testing.T) {
+	k, ctx := keepertest.ScreeningKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	listed, other := sample.AccAddress(), sample.AccAddress()
+	identifier := screening.HashIdentifier("passport:X123")
+
+	_, err := srv.AddScreeningEntry(ctx, types.NewMsgAddScreeningEntry(listed, types.EntryKindAddress, listed, "sanctioned"))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.AddScreeningEntry(ctx, types.NewMsgAddScreeningEntry(authority, types.EntryKindIdentifier, "passport:X123", "sanctioned"))
+	require.ErrorIs(t, err, types.ErrInvalidEntry)
+
+	_, err = srv.AddScreeningEntry(ctx, types.NewMsgAddScreeningEntry(authority, types.EntryKindAddress, listed, "sanctioned"))
+	require.NoError(t, err)
+	_, err = srv.AddScreeningEntry(ctx, types.NewMsgAddScreeningEntry(authority, types.EntryKindIdentifier, identifier, "sanctioned"))
+	require.NoError(t, err)
+	_, err = srv.AddScreeningEntry(ctx, types.NewMsgAddScreeningEntry(authority, types.EntryKindAddress, listed, "again"))
+	require.ErrorIs(t, err, types.ErrEntryExists)
+
+	require.True(t, k.IsScreened(ctx, listed))
+	require.True(t, k.IsScreened(ctx, "passport:X123"))
+	require.False(t, k.IsScreened(ctx, other))
+	require.False(t, k.IsScreened(ctx, ""))
+
+	k.LogBlocked(ctx, "tx-1", listed)
+
+	_, err = srv.RemoveScreeningEntry(ctx, types.NewMsgRemoveScreeningEntry(listed, listed, "cleared"))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RemoveScreeningEntry(ctx, types.NewMsgRemoveScreeningEntry(authority, listed, "cleared"))
+	require.NoError(t, err)
+	_, err = srv.RemoveScreeningEntry(ctx, types.NewMsgRemoveScreeningEntry(authority, listed, "cleared"))
+	require.ErrorIs(t, err, types.ErrEntryNotFound)
+	require.False(t, k.IsScreened(ctx, listed))
+
+	var history []string
+	for _, record := range k.GetAllScreeningRecord(ctx) {
+		history = append(history, record.Action+" "+record.Value+" "+record.Detail)
+	}
+	require.Equal(t, []string{
+		"listed " + listed + " sanctioned",
+		"listed " + identifier + " sanctioned",
+		"blocked " + listed + " tx-1",
+		"delisted " + listed + " cleared",
+	}, history)
+}
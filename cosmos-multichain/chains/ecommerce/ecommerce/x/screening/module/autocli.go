@@ -0,0 +1,65 @@
+package screening
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "ecommerce/api/ecommerce/screening"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ScreeningEntryAll",
+					Use:       "list-screening-entry",
+					Short:     "List all ScreeningEntry",
+				},
+				{
+					RpcMethod:      "ScreeningEntry",
+					Use:            "show-screening-entry [value]",
+					Short:          "Shows a ScreeningEntry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "value"}},
+				},
+				{
+					RpcMethod:      "Screened",
+					Use:            "screened [party]",
+					Short:          "Query whether a party is screened, by its address or external identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "party"}},
+				},
+				{
+					RpcMethod: "ScreeningHistory",
+					Use:       "history",
+					Short:     "Shows the listings, delistings and blocked attempts",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AddScreeningEntry",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveScreeningEntry",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
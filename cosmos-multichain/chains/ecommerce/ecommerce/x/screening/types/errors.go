@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/screening module sentinel errors
+var (
+	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidEntry  = sdkerrors.Register(ModuleName, 1101, "invalid screening entry")
+	ErrEntryExists   = sdkerrors.Register(ModuleName, 1102, "screening entry already exists")
+	ErrEntryNotFound = sdkerrors.Register(ModuleName, 1103, "screening entry not found")
+)
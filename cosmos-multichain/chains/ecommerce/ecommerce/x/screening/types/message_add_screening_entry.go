@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAddScreeningEntry{}
+
+func NewMsgAddScreeningEntry(authority string, kind string, value string, reason string) *MsgAddScreeningEntry {
+	return &MsgAddScreeningEntry{
+		Authority: authority,
+		Kind:      kind,
+		Value:     value,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgAddScreeningEntry) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return ValidateEntry(msg.Kind, msg.Value)
+}
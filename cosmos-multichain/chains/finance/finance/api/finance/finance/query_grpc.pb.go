@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: finance/finance/query.proto
+
+package finance
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName           = "/finance.finance.Query/Params"
+	Query_Transaction_FullMethodName      = "/finance.finance.Query/Transaction"
+	Query_TransactionAll_FullMethodName   = "/finance.finance.Query/TransactionAll"
+	Query_ContractRecord_FullMethodName   = "/finance.finance.Query/ContractRecord"
+	Query_AuditTrail_FullMethodName       = "/finance.finance.Query/AuditTrail"
+	Query_VerifyAuditTrail_FullMethodName = "/finance.finance.Query/VerifyAuditTrail"
+	Query_Attestation_FullMethodName      = "/finance.finance.Query/Attestation"
+	Query_AttestationAll_FullMethodName   = "/finance.finance.Query/AttestationAll"
+	Query_RiskProfile_FullMethodName      = "/finance.finance.Query/RiskProfile"
+	Query_RiskProfileAll_FullMethodName   = "/finance.finance.Query/RiskProfileAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
+	TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error)
+	// Re-walks the hash chain of the audit trail of a subject.
+	VerifyAuditTrail(ctx context.Context, in *QueryVerifyAuditTrailRequest, opts ...grpc.CallOption) (*QueryVerifyAuditTrailResponse, error)
+	// Queries the compliance attestation of an address.
+	Attestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error)
+	AttestationAll(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error)
+	// Queries the risk profile of an address.
+	RiskProfile(ctx context.Context, in *QueryGetRiskProfileRequest, opts ...grpc.CallOption) (*QueryGetRiskProfileResponse, error)
+	RiskProfileAll(ctx context.Context, in *QueryAllRiskProfileRequest, opts ...grpc.CallOption) (*QueryAllRiskProfileResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
+	out := new(QueryGetTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_Transaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
+	out := new(QueryAllTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_TransactionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error) {
+	out := new(QueryGetContractRecordResponse)
+	err := c.cc.Invoke(ctx, Query_ContractRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error) {
+	out := new(QueryAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_AuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyAuditTrail(ctx context.Context, in *QueryVerifyAuditTrailRequest, opts ...grpc.CallOption) (*QueryVerifyAuditTrailResponse, error) {
+	out := new(QueryVerifyAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyAuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestation(ctx context.Context, in *QueryGetAttestationRequest, opts ...grpc.CallOption) (*QueryGetAttestationResponse, error) {
+	out := new(QueryGetAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_Attestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationAll(ctx context.Context, in *QueryAllAttestationRequest, opts ...grpc.CallOption) (*QueryAllAttestationResponse, error) {
+	out := new(QueryAllAttestationResponse)
+	err := c.cc.Invoke(ctx, Query_AttestationAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskProfile(ctx context.Context, in *QueryGetRiskProfileRequest, opts ...grpc.CallOption) (*QueryGetRiskProfileResponse, error) {
+	out := new(QueryGetRiskProfileResponse)
+	err := c.cc.Invoke(ctx, Query_RiskProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RiskProfileAll(ctx context.Context, in *QueryAllRiskProfileRequest, opts ...grpc.CallOption) (*QueryAllRiskProfileResponse, error) {
+	out := new(QueryAllRiskProfileResponse)
+	err := c.cc.Invoke(ctx, Query_RiskProfileAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
+	TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error)
+	// Re-walks the hash chain of the audit trail of a subject.
+	VerifyAuditTrail(context.Context, *QueryVerifyAuditTrailRequest) (*QueryVerifyAuditTrailResponse, error)
+	// Queries the compliance attestation of an address.
+	Attestation(context.Context, *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error)
+	AttestationAll(context.Context, *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error)
+	// Queries the risk profile of an address.
+	RiskProfile(context.Context, *QueryGetRiskProfileRequest) (*QueryGetRiskProfileResponse, error)
+	RiskProfileAll(context.Context, *QueryAllRiskProfileRequest) (*QueryAllRiskProfileResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedQueryServer) TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionAll not implemented")
+}
+func (UnimplementedQueryServer) ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRecord not implemented")
+}
+func (UnimplementedQueryServer) AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTrail not implemented")
+}
+func (UnimplementedQueryServer) VerifyAuditTrail(context.Context, *QueryVerifyAuditTrailRequest) (*QueryVerifyAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditTrail not implemented")
+}
+func (UnimplementedQueryServer) Attestation(context.Context, *QueryGetAttestationRequest) (*QueryGetAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestation not implemented")
+}
+func (UnimplementedQueryServer) AttestationAll(context.Context, *QueryAllAttestationRequest) (*QueryAllAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationAll not implemented")
+}
+func (UnimplementedQueryServer) RiskProfile(context.Context, *QueryGetRiskProfileRequest) (*QueryGetRiskProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskProfile not implemented")
+}
+func (UnimplementedQueryServer) RiskProfileAll(context.Context, *QueryAllRiskProfileRequest) (*QueryAllRiskProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskProfileAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Transaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transaction(ctx, req.(*QueryGetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransactionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransactionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionAll(ctx, req.(*QueryAllTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContractRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRecord(ctx, req.(*QueryGetContractRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditTrail(ctx, req.(*QueryAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyAuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyAuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyAuditTrail(ctx, req.(*QueryVerifyAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestation(ctx, req.(*QueryGetAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttestationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationAll(ctx, req.(*QueryAllAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRiskProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RiskProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskProfile(ctx, req.(*QueryGetRiskProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RiskProfileAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRiskProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RiskProfileAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RiskProfileAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskProfileAll(ctx, req.(*QueryAllRiskProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.finance.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _Query_Transaction_Handler,
+		},
+		{
+			MethodName: "TransactionAll",
+			Handler:    _Query_TransactionAll_Handler,
+		},
+		{
+			MethodName: "ContractRecord",
+			Handler:    _Query_ContractRecord_Handler,
+		},
+		{
+			MethodName: "AuditTrail",
+			Handler:    _Query_AuditTrail_Handler,
+		},
+		{
+			MethodName: "VerifyAuditTrail",
+			Handler:    _Query_VerifyAuditTrail_Handler,
+		},
+		{
+			MethodName: "Attestation",
+			Handler:    _Query_Attestation_Handler,
+		},
+		{
+			MethodName: "AttestationAll",
+			Handler:    _Query_AttestationAll_Handler,
+		},
+		{
+			MethodName: "RiskProfile",
+			Handler:    _Query_RiskProfile_Handler,
+		},
+		{
+			MethodName: "RiskProfileAll",
+			Handler:    _Query_RiskProfileAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance/finance/query.proto",
+}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: finance/payments/query.proto
+
+package payments
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                         = "/finance.payments.Query/Params"
+	Query_FinancialTransaction_FullMethodName           = "/finance.payments.Query/FinancialTransaction"
+	Query_FinancialTransactionAll_FullMethodName        = "/finance.payments.Query/FinancialTransactionAll"
+	Query_FinancialTransactionsByAddress_FullMethodName = "/finance.payments.Query/FinancialTransactionsByAddress"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of FinancialTransaction items.
+	FinancialTransaction(ctx context.Context, in *QueryGetFinancialTransactionRequest, opts ...grpc.CallOption) (*QueryGetFinancialTransactionResponse, error)
+	FinancialTransactionAll(ctx context.Context, in *QueryAllFinancialTransactionRequest, opts ...grpc.CallOption) (*QueryAllFinancialTransactionResponse, error)
+	// Queries the transactions sent or received by an address.
+	FinancialTransactionsByAddress(ctx context.Context, in *QueryFinancialTransactionsByAddressRequest, opts ...grpc.CallOption) (*QueryFinancialTransactionsByAddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinancialTransaction(ctx context.Context, in *QueryGetFinancialTransactionRequest, opts ...grpc.CallOption) (*QueryGetFinancialTransactionResponse, error) {
+	out := new(QueryGetFinancialTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_FinancialTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinancialTransactionAll(ctx context.Context, in *QueryAllFinancialTransactionRequest, opts ...grpc.CallOption) (*QueryAllFinancialTransactionResponse, error) {
+	out := new(QueryAllFinancialTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_FinancialTransactionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinancialTransactionsByAddress(ctx context.Context, in *QueryFinancialTransactionsByAddressRequest, opts ...grpc.CallOption) (*QueryFinancialTransactionsByAddressResponse, error) {
+	out := new(QueryFinancialTransactionsByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_FinancialTransactionsByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of FinancialTransaction items.
+	FinancialTransaction(context.Context, *QueryGetFinancialTransactionRequest) (*QueryGetFinancialTransactionResponse, error)
+	FinancialTransactionAll(context.Context, *QueryAllFinancialTransactionRequest) (*QueryAllFinancialTransactionResponse, error)
+	// Queries the transactions sent or received by an address.
+	FinancialTransactionsByAddress(context.Context, *QueryFinancialTransactionsByAddressRequest) (*QueryFinancialTransactionsByAddressResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) FinancialTransaction(context.Context, *QueryGetFinancialTransactionRequest) (*QueryGetFinancialTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinancialTransaction not implemented")
+}
+func (UnimplementedQueryServer) FinancialTransactionAll(context.Context, *QueryAllFinancialTransactionRequest) (*QueryAllFinancialTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinancialTransactionAll not implemented")
+}
+func (UnimplementedQueryServer) FinancialTransactionsByAddress(context.Context, *QueryFinancialTransactionsByAddressRequest) (*QueryFinancialTransactionsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinancialTransactionsByAddress not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinancialTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFinancialTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinancialTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FinancialTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinancialTransaction(ctx, req.(*QueryGetFinancialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinancialTransactionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFinancialTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinancialTransactionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FinancialTransactionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinancialTransactionAll(ctx, req.(*QueryAllFinancialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinancialTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinancialTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinancialTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FinancialTransactionsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinancialTransactionsByAddress(ctx, req.(*QueryFinancialTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.payments.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FinancialTransaction",
+			Handler:    _Query_FinancialTransaction_Handler,
+		},
+		{
+			MethodName: "FinancialTransactionAll",
+			Handler:    _Query_FinancialTransactionAll_Handler,
+		},
+		{
+			MethodName: "FinancialTransactionsByAddress",
+			Handler:    _Query_FinancialTransactionsByAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance/payments/query.proto",
+}
@@ -75,6 +75,10 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	financemodulekeeper "finance/x/finance/keeper"
+	lendingmodulekeeper "finance/x/lending/keeper"
+	paymentsmodulekeeper "finance/x/payments/keeper"
+	screeningmodulekeeper "finance/x/screening/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"finance/docs"
@@ -140,6 +144,10 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	FinanceKeeper   financemodulekeeper.Keeper
+	PaymentsKeeper  paymentsmodulekeeper.Keeper
+	LendingKeeper   lendingmodulekeeper.Keeper
+	ScreeningKeeper screeningmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -243,6 +251,10 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.FinanceKeeper,
+		&app.PaymentsKeeper,
+		&app.LendingKeeper,
+		&app.ScreeningKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -255,6 +267,12 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// set the ante handler screening the parties of the transactions, the
+	// default one being skipped in the app config
+	if err := app.setAnteHandler(app.FinanceKeeper.Screener()); err != nil {
+		return nil, err
+	}
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		return nil, err
@@ -31,6 +31,18 @@ import (
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/nft"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	financemodulev1 "finance/api/finance/finance/module"
+	lendingmodulev1 "finance/api/finance/lending/module"
+	paymentsmodulev1 "finance/api/finance/payments/module"
+	screeningmodulev1 "finance/api/finance/screening/module"
+	_ "finance/x/finance/module" // import for side-effects
+	financemoduletypes "finance/x/finance/types"
+	_ "finance/x/lending/module" // import for side-effects
+	lendingmoduletypes "finance/x/lending/types"
+	_ "finance/x/payments/module" // import for side-effects
+	paymentsmoduletypes "finance/x/payments/types"
+	_ "finance/x/screening/module" // import for side-effects
+	screeningmoduletypes "finance/x/screening/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -89,6 +101,10 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		financemoduletypes.ModuleName,
+		paymentsmoduletypes.ModuleName,
+		lendingmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +129,10 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		financemoduletypes.ModuleName,
+		paymentsmoduletypes.ModuleName,
+		lendingmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +151,10 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		financemoduletypes.ModuleName,
+		paymentsmoduletypes.ModuleName,
+		lendingmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -232,8 +256,11 @@ var (
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				Config: appconfig.WrapAny(&txconfigv1.Config{
+					// the ante handler screening the transactions is set in app.go
+					SkipAnteHandler: true,
+				}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -286,6 +313,22 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   financemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&financemodulev1.Module{}),
+			},
+			{
+				Name:   paymentsmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&paymentsmodulev1.Module{}),
+			},
+			{
+				Name:   lendingmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&lendingmodulev1.Module{}),
+			},
+			{
+				Name:   screeningmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&screeningmodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
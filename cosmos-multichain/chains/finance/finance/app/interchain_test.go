@@ -0,0 +1,89 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"finance/app"
+	"finance/contracts"
+	"finance/testutil/sample"
+	financetypes "finance/x/finance/types"
+	lendingtypes "finance/x/lending/types"
+)
+
+// TestRealEstateMortgagePacket delivers a mortgage packet of the real estate
+// chain through the IBC router of the app.
+func TestRealEstateMortgagePacket(t *testing.T) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+	require.NoError(t, err)
+	ctx := bApp.NewUncachedContext(false, cmtproto.Header{Time: time.Unix(1_700_000_000, 0)})
+	require.NoError(t, bApp.FinanceKeeper.SetParams(ctx, financetypes.DefaultParams()))
+	require.NoError(t, bApp.LendingKeeper.SetParams(ctx, lendingtypes.DefaultParams()))
+
+	// channel-0 is open with the real estate chain
+	ibcKeeper := bApp.GetIBCKeeper()
+	ibcKeeper.ClientKeeper.SetClientState(ctx, "07-tendermint-0", &ibctm.ClientState{ChainId: "bloqz-realestate-1"})
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, "connection-0", connectiontypes.ConnectionEnd{
+		ClientId: "07-tendermint-0",
+		State:    connectiontypes.OPEN,
+	})
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, interchain.PortID, "channel-0", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(interchain.PortID, "channel-0"),
+		[]string{"connection-0"}, interchain.Version,
+	))
+	ibcModule, found := ibcKeeper.Router.GetRoute(interchain.PortID)
+	require.True(t, found)
+
+	recv := func(collateralValue int64) channeltypes.Acknowledgement {
+		application, err := json.Marshal(contracts.LoanApplication{
+			LoanID:          "mortgage-1",
+			Borrower:        sample.AccAddress(),
+			Principal:       sdkmath.NewInt(100_000),
+			Currency:        "ufin",
+			RateBps:         400,
+			Term:            12,
+			PaymentInterval: 30 * 24 * time.Hour,
+			CollateralRefs:  []string{"property-1"},
+			CollateralValue: sdkmath.NewInt(collateralValue),
+		})
+		require.NoError(t, err)
+		data, err := interchain.NewMessagePacket("mortgage", application).GetBytes()
+		require.NoError(t, err)
+		packet := channeltypes.NewPacket(data, 1, interchain.PortID, "channel-0", interchain.PortID, "channel-0", clienttypes.NewHeight(0, 100), 0)
+		ack, ok := ibcModule.OnRecvPacket(ctx, packet, nil).(channeltypes.Acknowledgement)
+		require.True(t, ok)
+		return ack
+	}
+
+	require.False(t, recv(100_000).Success())
+	_, found = bApp.LendingKeeper.GetLoan(ctx, "mortgage-1")
+	require.False(t, found)
+
+	ack := recv(150_000)
+	require.True(t, ack.Success())
+	var packetAck interchain.PacketAck
+	require.NoError(t, json.Unmarshal(ack.GetResult(), &packetAck))
+	require.Empty(t, packetAck.Response)
+	loan, found := bApp.LendingKeeper.GetLoan(ctx, "mortgage-1")
+	require.True(t, found)
+	require.Equal(t, interchain.ChainRealEstate, loan.SourceChain)
+	require.Equal(t, lendingtypes.LoanStatusPending, loan.Status)
+	require.Equal(t, []string{"property-1"}, loan.CollateralRefs)
+}
@@ -0,0 +1,96 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/example/cosmos-multichain/screening"
+	"github.com/stretchr/testify/require"
+
+	"finance/app"
+	"finance/contracts"
+	screeningtypes "finance/x/screening/types"
+)
+
+// TestScreenedTransactionRecord rejects the transaction of a screened party
+// in a block and keeps the record of the attempt once the block is committed.
+func TestScreenedTransactionRecord(t *testing.T) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+	require.NoError(t, err)
+
+	// the sender is funded and screened from genesis
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderKey := secp256k1.GenPrivKey()
+	sender := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000)))
+	genesis, err := simtestutil.GenesisStateWithValSet(bApp.AppCodec(), bApp.DefaultGenesis(), valSet,
+		[]authtypes.GenesisAccount{sender}, banktypes.Balance{Address: sender.GetAddress().String(), Coins: coins})
+	require.NoError(t, err)
+	screeningGenesis := screeningtypes.DefaultGenesis()
+	screeningGenesis.ScreeningEntryList = []screeningtypes.ScreeningEntry{
+		{Value: sender.GetAddress().String(), Kind: screeningtypes.EntryKindAddress, Reason: "sanctioned"},
+	}
+	genesis[screeningtypes.ModuleName] = bApp.AppCodec().MustMarshalJSON(screeningGenesis)
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	_, err = bApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	require.NoError(t, err)
+
+	// the screening decorator runs before the signatures are verified
+	builder := bApp.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(sender.GetAddress(), sdk.AccAddress(pubKey.Address()), coins)))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: senderKey.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	builder.SetGasLimit(200_000)
+	txBytes, err := bApp.TxConfig().TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	res, err := bApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	require.Equal(t, screening.ErrScreened.ABCICode(), res.TxResults[0].Code)
+	_, err = bApp.Commit()
+	require.NoError(t, err)
+
+	ctx := bApp.NewUncachedContext(false, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	ref := screening.TxRef(sdk.Context{}.WithTxBytes(txBytes))
+	var blocked []screeningtypes.ScreeningRecord
+	for _, record := range bApp.ScreeningKeeper.GetAllScreeningRecord(ctx) {
+		if record.Action == screeningtypes.RecordActionBlocked {
+			blocked = append(blocked, record)
+		}
+	}
+	require.Len(t, blocked, 1)
+	require.Equal(t, sender.GetAddress().String(), blocked[0].Value)
+	require.Equal(t, ref, blocked[0].Detail)
+
+	trail := bApp.FinanceKeeper.GetAuditTrail(ctx, ref)
+	require.Len(t, trail, 1)
+	require.Equal(t, "compliance", trail[0].Action)
+	require.Equal(t, contracts.ComplianceTypeScreening+" passed=false", trail[0].Detail)
+	require.Zero(t, bApp.ScreeningKeeper.Journal().Len())
+}
@@ -2,24 +2,29 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/finance/contracts/interfaces"
+	"errors"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/example/cosmos-multichain/screening"
+
+	"finance/contracts/interfaces"
 )
 
 // FinancialTransaction represents a financial transaction
 type FinancialTransaction struct {
-	TransactionID string    `json:"transaction_id"`
-	FromAddress   string    `json:"from_address"`
-	ToAddress     string    `json:"to_address"`
-	Amount        sdk.Int   `json:"amount"`
-	Currency      string    `json:"currency"`
-	TxType        string    `json:"tx_type"` // Payment, Transfer, Investment, etc.
-	Status        string    `json:"status"`
-	Timestamp     time.Time `json:"timestamp"`
-	Metadata      Metadata  `json:"metadata"`
+	TransactionID string      `json:"transaction_id"`
+	FromAddress   string      `json:"from_address"`
+	ToAddress     string      `json:"to_address"`
+	Amount        sdkmath.Int `json:"amount"`
+	Currency      string      `json:"currency"`
+	TxType        string      `json:"tx_type"` // Payment, Transfer, Investment, etc.
+	Status        string      `json:"status"`
+	Timestamp     time.Time   `json:"timestamp"`
+	Metadata      Metadata    `json:"metadata"`
 }
 
 // Metadata contains additional transaction information
@@ -50,41 +55,99 @@ type RiskData struct {
 	Restrictions []string  `json:"restrictions"`
 }
 
+// LoanApplication represents a request to borrow principal from a lender,
+// repaid in term installments every payment interval
+type LoanApplication struct {
+	LoanID          string        `json:"loan_id"`
+	Borrower        string        `json:"borrower"`
+	Lender          string        `json:"lender"`
+	Principal       sdkmath.Int   `json:"principal"`
+	Currency        string        `json:"currency"`
+	RateBps         uint32        `json:"rate_bps"`
+	Term            uint32        `json:"term"`
+	PaymentInterval time.Duration `json:"payment_interval"`
+	CollateralRefs  []string      `json:"collateral_refs"`
+	CollateralValue sdkmath.Int   `json:"collateral_value"`
+	SourceChain     string        `json:"source_chain"`
+}
+
+// MessageTypePayment is the interchain message type of the payments sent
+// back to the chain that requested them
+const MessageTypePayment = "payment"
+
+// Statuses of a payment received from another chain, as sent back to it
+const (
+	PaymentStatusFinalized = "FINALIZED"
+	PaymentStatusHeld      = "HELD"
+	PaymentStatusRejected  = "REJECTED"
+)
+
+// ComplianceTypeScreening is the compliance check of the parties against the
+// screening list
+const ComplianceTypeScreening = "screening"
+
 // FinanceContract implements the IFinanceContract interface
 type FinanceContract struct {
 	validator interfaces.IFinanceValidator
 	auditor   interfaces.IFinanceAudit
 	risk      interfaces.IRiskAssessment
+	payments  interfaces.IPaymentProcessor
+	loans     interfaces.ILoanManager
+	screener  screening.Screener
+	router    *interchain.Router
 }
 
 func NewFinanceContract(
 	validator interfaces.IFinanceValidator,
 	auditor interfaces.IFinanceAudit,
 	risk interfaces.IRiskAssessment,
+	payments interfaces.IPaymentProcessor,
+	loans interfaces.ILoanManager,
+	screener screening.Screener,
 ) *FinanceContract {
-	return &FinanceContract{
+	c := &FinanceContract{
 		validator: validator,
 		auditor:   auditor,
 		risk:      risk,
+		payments:  payments,
+		loans:     loans,
+		screener:  screener,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainRealEstate, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleRealEstateMessage,
+			Prepare:  c.prepareRealEstateMessage,
+			Callback: c.handleRealEstateCallback,
+		}).
+		AddRoute(interchain.ChainInsurance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleInsuranceMessage,
+			Prepare:  c.prepareInsuranceMessage,
+			Callback: c.handleInsuranceCallback,
+		}).
+		AddRoute(interchain.ChainRetail, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleRetailMessage,
+			Prepare:  c.prepareRetailMessage,
+			Callback: c.handleRetailCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IFinanceContract
 func (c *FinanceContract) ValidateInterchainData(ctx sdk.Context, data []byte) error {
 	var tx FinancialTransaction
 	if err := json.Unmarshal(data, &tx); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid transaction format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid transaction format")
 	}
 
 	// Validate required fields
 	if tx.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 	if tx.FromAddress == "" || tx.ToAddress == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "from and to addresses are required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "from and to addresses are required")
 	}
 	if tx.Amount.IsNil() || tx.Amount.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid amount")
 	}
 
 	// Validate compliance
@@ -92,57 +155,39 @@ func (c *FinanceContract) ValidateInterchainData(ctx sdk.Context, data []byte) e
 		return err
 	}
 
+	// Hold high risk transactions for review
+	riskLevel, err := c.risk.AssessTransactionRisk(ctx, data)
+	if err != nil {
+		return err
+	}
+	if err := c.auditor.LogRiskAssessment(ctx, tx.TransactionID, riskLevel); err != nil {
+		return err
+	}
+	if riskLevel == interfaces.RiskLevelHigh {
+		return errorsmod.Wrapf(interchain.ErrHeldForReview, "%s is of high risk", tx.TransactionID)
+	}
+
 	return nil
 }
 
 // ProcessInterchainMessage implements IFinanceContract
-func (c *FinanceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	// Process based on source chain
-	switch sourceChain {
-	case "realestate":
-		return c.handleRealEstateMessage(ctx, message)
-	case "insurance":
-		return c.handleInsuranceMessage(ctx, message)
-	case "retail":
-		return c.handleRetailMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+func (c *FinanceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IFinanceContract
-func (c *FinanceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	// Validate and prepare message for target chain
-	switch targetChain {
-	case "realestate":
-		return c.prepareRealEstateMessage(data)
-	case "insurance":
-		return c.prepareInsuranceMessage(data)
-	case "retail":
-		return c.prepareRetailMessage(data)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+func (c *FinanceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IFinanceContract
-func (c *FinanceContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
-	// Log the callback
-	if err := c.auditor.LogTransaction(ctx, "", "callback", sdk.NewInt(0)); err != nil {
+func (c *FinanceContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	// Log the callback in the audit trail of its source chain
+	if err := c.auditor.LogTransaction(ctx, sourceChain, "callback "+messageType, sdkmath.ZeroInt()); err != nil {
 		return err
 	}
 
-	// Process chain-specific callbacks
-	switch sourceChain {
-	case "realestate":
-		return c.handleRealEstateCallback(ctx, response)
-	case "insurance":
-		return c.handleInsuranceCallback(ctx, response)
-	case "retail":
-		return c.handleRetailCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ValidateTransaction implements IFinanceContract
@@ -151,50 +196,183 @@ func (c *FinanceContract) ValidateTransaction(ctx sdk.Context, tx []byte) error
 }
 
 // CalculateFees implements IFinanceContract
-func (c *FinanceContract) CalculateFees(ctx sdk.Context, amount sdk.Int, txType string) (sdk.Int, error) {
-	// Implement fee calculation logic
-	return sdk.NewInt(0), nil
+func (c *FinanceContract) CalculateFees(ctx sdk.Context, amount sdkmath.Int, txType string) (sdkmath.Int, error) {
+	return c.payments.CalculateFees(ctx, amount, txType)
 }
 
 // ValidateCompliance implements IFinanceContract
 func (c *FinanceContract) ValidateCompliance(ctx sdk.Context, tx []byte) error {
-	return c.validator.ValidateCompliance(tx)
+	return c.validator.ValidateCompliance(ctx, tx)
 }
 
 // ProcessPayment implements IFinanceContract
 func (c *FinanceContract) ProcessPayment(ctx sdk.Context, payment []byte) error {
-	// Implement payment processing logic
+	return c.processPayment(ctx, payment, true)
+}
+
+// ReleasePayment moves the funds of a payment held for review once a risk
+// officer approved it, without assessing its risk again
+func (c *FinanceContract) ReleasePayment(ctx sdk.Context, payment []byte) error {
+	return c.processPayment(ctx, payment, false)
+}
+
+func (c *FinanceContract) processPayment(ctx sdk.Context, payment []byte, assessRisk bool) error {
+	var tx FinancialTransaction
+	if err := json.Unmarshal(payment, &tx); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+
+	if err := c.ValidateTransaction(ctx, payment); err != nil {
+		return err
+	}
+	if err := c.validator.ValidateAmount(tx.Amount, tx.TxType); err != nil {
+		return err
+	}
+	if err := c.validator.ValidateParties([]string{tx.FromAddress, tx.ToAddress}); err != nil {
+		return err
+	}
+	if err := c.screenParties(ctx, tx.TransactionID, tx.FromAddress, tx.ToAddress); err != nil {
+		return err
+	}
+	if err := c.ValidateCompliance(ctx, payment); err != nil {
+		return err
+	}
+	if err := c.auditor.LogCompliance(ctx, tx.TransactionID, "kyc_aml", true); err != nil {
+		return err
+	}
+
+	// Check the payment against the risk thresholds
+	if assessRisk {
+		riskLevel, err := c.risk.AssessTransactionRisk(ctx, payment)
+		if err != nil {
+			return err
+		}
+		if err := c.auditor.LogRiskAssessment(ctx, tx.TransactionID, riskLevel); err != nil {
+			return err
+		}
+		if err := c.risk.ValidateRiskThresholds(ctx, tx.Amount, riskLevel); err != nil {
+			return err
+		}
+	}
+
+	// Move the funds
+	if err := c.payments.ProcessPayment(ctx, payment); err != nil {
+		return err
+	}
+
+	return c.auditor.LogTransaction(ctx, tx.TransactionID, tx.TxType, tx.Amount)
+}
+
+// SettlePayment settles a processed payment once its transaction is finalized
+func (c *FinanceContract) SettlePayment(ctx sdk.Context, txID string) error {
+	return c.payments.SettlePayment(ctx, txID)
+}
+
+// OpenLoan opens a loan from a loan application
+func (c *FinanceContract) OpenLoan(ctx sdk.Context, application []byte) error {
+	var loan LoanApplication
+	if err := json.Unmarshal(application, &loan); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid loan application format")
+	}
+	if loan.LoanID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "loan ID is required")
+	}
+	if err := c.validator.ValidateAmount(loan.Principal, "LOAN"); err != nil {
+		return err
+	}
+	parties := []string{loan.Borrower}
+	if loan.Lender != "" {
+		parties = append(parties, loan.Lender)
+	}
+	if err := c.validator.ValidateParties(parties); err != nil {
+		return err
+	}
+	if err := c.screenParties(ctx, loan.LoanID, parties...); err != nil {
+		return err
+	}
+
+	if err := c.loans.OpenLoan(ctx, application); err != nil {
+		return err
+	}
+
+	return c.auditor.LogTransaction(ctx, loan.LoanID, "LOAN", loan.Principal)
+}
+
+// screenParties rejects a transaction with a screened party, which the
+// screener records as a failed screening in its audit trail
+func (c *FinanceContract) screenParties(ctx sdk.Context, txID string, parties ...string) error {
+	for _, party := range parties {
+		if c.screener.IsScreened(ctx, party) {
+			c.screener.LogBlocked(ctx, txID, party)
+			return errorsmod.Wrap(screening.ErrScreened, party)
+		}
+	}
 	return nil
 }
 
 // Internal handlers for chain-specific messages
 func (c *FinanceContract) handleRealEstateMessage(ctx sdk.Context, message []byte) error {
-	// Handle real estate payments and transactions
-	return nil
+	// Real estate mortgages open a loan secured by the mortgaged properties
+	var loan LoanApplication
+	if err := json.Unmarshal(message, &loan); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid loan application format")
+	}
+	loan.SourceChain = interchain.ChainRealEstate
+
+	application, err := json.Marshal(loan)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal loan application")
+	}
+	return c.OpenLoan(ctx, application)
 }
 
 func (c *FinanceContract) handleInsuranceMessage(ctx sdk.Context, message []byte) error {
-	// Handle insurance payments and claims
-	return nil
+	// Insurance premiums and claim payouts are paid through finance
+	return c.processInterchainPayment(ctx, interchain.ChainInsurance, message)
 }
 
 func (c *FinanceContract) handleRetailMessage(ctx sdk.Context, message []byte) error {
-	// Handle retail payments and refunds
-	return nil
+	// Retail payments and refunds are paid through finance
+	return c.processInterchainPayment(ctx, interchain.ChainRetail, message)
+}
+
+// processInterchainPayment moves the funds of a payment received from
+// another chain, unless its risk holds it for review. A chain only pays from
+// its own account on this chain, and its payments are settled right away.
+func (c *FinanceContract) processInterchainPayment(ctx sdk.Context, sourceChain string, payment []byte) error {
+	var tx FinancialTransaction
+	if err := json.Unmarshal(payment, &tx); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	if payer := interchain.ChainAccount(sourceChain).String(); tx.FromAddress != payer {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "%s only pays from %s", sourceChain, payer)
+	}
+
+	err := c.ValidateInterchainData(ctx, payment)
+	if errors.Is(err, interchain.ErrHeldForReview) {
+		return c.payments.HoldPayment(ctx, sourceChain, payment)
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.ProcessPayment(ctx, payment); err != nil {
+		return err
+	}
+	return c.SettlePayment(ctx, tx.TransactionID)
 }
 
 // Internal message preparation
-func (c *FinanceContract) prepareRealEstateMessage(data []byte) ([]byte, error) {
+func (c *FinanceContract) prepareRealEstateMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for real estate chain
 	return data, nil
 }
 
-func (c *FinanceContract) prepareInsuranceMessage(data []byte) ([]byte, error) {
+func (c *FinanceContract) prepareInsuranceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for insurance chain
 	return data, nil
 }
 
-func (c *FinanceContract) prepareRetailMessage(data []byte) ([]byte, error) {
+func (c *FinanceContract) prepareRetailMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for retail chain
 	return data, nil
 }
@@ -1,20 +1,19 @@
 package interfaces
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IFinanceContract extends the base interchain contract with finance-specific features
 type IFinanceContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// Finance-specific functionality
 	ValidateTransaction(ctx sdk.Context, tx []byte) error
-	CalculateFees(ctx sdk.Context, amount sdk.Int, txType string) (sdk.Int, error)
+	CalculateFees(ctx sdk.Context, amount sdkmath.Int, txType string) (sdkmath.Int, error)
 	ValidateCompliance(ctx sdk.Context, tx []byte) error
 	ProcessPayment(ctx sdk.Context, payment []byte) error
 }
@@ -25,19 +24,19 @@ type IFinanceValidator interface {
 	ValidateTransaction(tx []byte) error
 
 	// ValidateAmount checks if the amount is valid for the transaction type
-	ValidateAmount(amount sdk.Int, txType string) error
+	ValidateAmount(amount sdkmath.Int, txType string) error
 
 	// ValidateParties checks if all parties in the transaction are valid
 	ValidateParties(parties []string) error
 
 	// ValidateCompliance ensures regulatory compliance
-	ValidateCompliance(tx []byte) error
+	ValidateCompliance(ctx sdk.Context, tx []byte) error
 }
 
 // IFinanceAudit defines the interface for financial audit logging
 type IFinanceAudit interface {
 	// LogTransaction records transaction events
-	LogTransaction(ctx sdk.Context, txID string, txType string, amount sdk.Int) error
+	LogTransaction(ctx sdk.Context, txID string, txType string, amount sdkmath.Int) error
 
 	// LogCompliance records compliance checks
 	LogCompliance(ctx sdk.Context, txID string, complianceType string, result bool) error
@@ -49,13 +48,20 @@ type IFinanceAudit interface {
 	GetAuditTrail(ctx sdk.Context, txID string) ([]byte, error)
 }
 
+// Risk levels assessed by IRiskAssessment
+const (
+	RiskLevelLow    = "low"
+	RiskLevelMedium = "medium"
+	RiskLevelHigh   = "high"
+)
+
 // IRiskAssessment defines the interface for financial risk assessment
 type IRiskAssessment interface {
 	// AssessTransactionRisk evaluates the risk level of a transaction
 	AssessTransactionRisk(ctx sdk.Context, tx []byte) (string, error)
 
 	// ValidateRiskThresholds checks if transaction meets risk thresholds
-	ValidateRiskThresholds(ctx sdk.Context, amount sdk.Int, riskLevel string) error
+	ValidateRiskThresholds(ctx sdk.Context, amount sdkmath.Int, riskLevel string) error
 
 	// UpdateRiskProfile updates the risk profile of an entity
 	UpdateRiskProfile(ctx sdk.Context, entityID string, riskData []byte) error
@@ -63,3 +69,25 @@ type IRiskAssessment interface {
 	// GetRiskReport generates a risk report for an entity
 	GetRiskReport(ctx sdk.Context, entityID string) ([]byte, error)
 }
+
+// IPaymentProcessor defines the interface for moving the funds of financial transactions
+type IPaymentProcessor interface {
+	// ProcessPayment moves the funds of a payment or transfer and records it
+	ProcessPayment(ctx sdk.Context, payment []byte) error
+
+	// CalculateFees calculates the fees charged on an amount for a transaction type
+	CalculateFees(ctx sdk.Context, amount sdkmath.Int, txType string) (sdkmath.Int, error)
+
+	// SettlePayment marks a processed payment as settled
+	SettlePayment(ctx sdk.Context, txID string) error
+
+	// HoldPayment keeps a payment received from another chain for review
+	// instead of moving its funds
+	HoldPayment(ctx sdk.Context, sourceChain string, payment []byte) error
+}
+
+// ILoanManager defines the interface for originating loans
+type ILoanManager interface {
+	// OpenLoan records a loan application awaiting funding by its lender
+	OpenLoan(ctx sdk.Context, application []byte) error
+}
@@ -2,10 +2,14 @@ package transactions
 
 import (
 	"encoding/json"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/finance/contracts"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"finance/contracts"
 )
 
 // TransactionType defines the type of financial transaction
@@ -23,20 +27,31 @@ type FinancialTransactionRequest struct {
 	TransactionID string          `json:"transaction_id"`
 	FromAddress   string          `json:"from_address"`
 	ToAddress     string          `json:"to_address"`
-	Amount        sdk.Int         `json:"amount"`
+	Amount        sdkmath.Int     `json:"amount"`
 	Currency      string          `json:"currency"`
 	TxType        TransactionType `json:"tx_type"`
 	Purpose       string          `json:"purpose"`
+	LoanTerms     *LoanTerms      `json:"loan_terms,omitempty"`
 	Timestamp     time.Time       `json:"timestamp"`
 	Metadata      Metadata        `json:"metadata"`
 }
 
+// LoanTerms contains the terms of a loan to the sender, the borrower, funded by
+// the recipient, the lender
+type LoanTerms struct {
+	RateBps         uint32        `json:"rate_bps"`
+	Term            uint32        `json:"term"`
+	PaymentInterval time.Duration `json:"payment_interval"`
+	CollateralRefs  []string      `json:"collateral_refs"`
+	CollateralValue sdkmath.Int   `json:"collateral_value"`
+}
+
 // Metadata contains additional transaction information
 type Metadata struct {
-	References  []string          `json:"references"`
-	Documents   []Document        `json:"documents"`
-	Compliance  ComplianceInfo    `json:"compliance"`
-	Extra       map[string]string `json:"extra"`
+	References []string          `json:"references"`
+	Documents  []Document        `json:"documents"`
+	Compliance ComplianceInfo    `json:"compliance"`
+	Extra      map[string]string `json:"extra"`
 }
 
 // Document represents supporting documentation
@@ -85,26 +100,26 @@ func (h *FinancialTransactionHandler) InitiateTransaction(ctx sdk.Context, req F
 	case Loan:
 		return h.processLoan(ctx, req)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported transaction type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported transaction type")
 	}
 }
 
 // ValidateRequest validates the transaction request
 func (h *FinancialTransactionHandler) validateRequest(req FinancialTransactionRequest) error {
 	if req.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 	if req.FromAddress == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "from address is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "from address is required")
 	}
 	if req.ToAddress == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "to address is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "to address is required")
 	}
 	if req.Amount.IsNil() || req.Amount.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid amount")
 	}
 	if req.Currency == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "currency is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "currency is required")
 	}
 
 	return nil
@@ -114,14 +129,17 @@ func (h *FinancialTransactionHandler) validateRequest(req FinancialTransactionRe
 func (h *FinancialTransactionHandler) processPayment(ctx sdk.Context, req FinancialTransactionRequest) error {
 	data, err := json.Marshal(req)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal payment data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal payment data")
 	}
 	return h.contract.ProcessPayment(ctx, data)
 }
 
 func (h *FinancialTransactionHandler) processTransfer(ctx sdk.Context, req FinancialTransactionRequest) error {
-	// Handle transfer logic
-	return nil
+	data, err := json.Marshal(req)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal transfer data")
+	}
+	return h.contract.ProcessPayment(ctx, data)
 }
 
 func (h *FinancialTransactionHandler) processInvestment(ctx sdk.Context, req FinancialTransactionRequest) error {
@@ -129,9 +147,27 @@ func (h *FinancialTransactionHandler) processInvestment(ctx sdk.Context, req Fin
 	return nil
 }
 
+// processLoan applies for a loan of the sender, funded by the recipient
 func (h *FinancialTransactionHandler) processLoan(ctx sdk.Context, req FinancialTransactionRequest) error {
-	// Handle loan logic
-	return nil
+	if req.LoanTerms == nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "loan terms are required")
+	}
+	data, err := json.Marshal(contracts.LoanApplication{
+		LoanID:          req.TransactionID,
+		Borrower:        req.FromAddress,
+		Lender:          req.ToAddress,
+		Principal:       req.Amount,
+		Currency:        req.Currency,
+		RateBps:         req.LoanTerms.RateBps,
+		Term:            req.LoanTerms.Term,
+		PaymentInterval: req.LoanTerms.PaymentInterval,
+		CollateralRefs:  req.LoanTerms.CollateralRefs,
+		CollateralValue: req.LoanTerms.CollateralValue,
+	})
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal loan data")
+	}
+	return h.contract.OpenLoan(ctx, data)
 }
 
 // UpdateTransactionStatus updates the status of a financial transaction
@@ -142,6 +178,5 @@ func (h *FinancialTransactionHandler) UpdateTransactionStatus(ctx sdk.Context, t
 
 // FinalizeTransaction completes the financial transaction process
 func (h *FinancialTransactionHandler) FinalizeTransaction(ctx sdk.Context, txID string) error {
-	// Finalize the transaction and update all relevant chains
-	return nil
+	return h.contract.SettlePayment(ctx, txID)
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper is an in-memory bank keeper for the keeper tests
+type BankKeeper struct {
+	balances map[string]sdk.Coins
+	blocked  map[string]bool
+}
+
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{
+		balances: make(map[string]sdk.Coins),
+		blocked:  make(map[string]bool),
+	}
+}
+
+// Fund adds coins to the balance of addr
+func (b *BankKeeper) Fund(addr sdk.AccAddress, coins sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(coins...)
+}
+
+// Block prevents addr from receiving funds
+func (b *BankKeeper) Block(addr sdk.AccAddress) {
+	b.blocked[addr.String()] = true
+}
+
+// Balance returns the balance of addr
+func (b *BankKeeper) Balance(addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+// ModuleBalance returns the balance of a module account
+func (b *BankKeeper) ModuleBalance(module string) sdk.Coins {
+	return b.Balance(authtypes.NewModuleAddress(module))
+}
+
+func (b *BankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balance(addr)
+}
+
+func (b *BankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.Balance(fromAddr).SafeSub(amt...)
+	if negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.Balance(fromAddr), amt)
+	}
+	b.balances[fromAddr.String()] = balance
+	b.Fund(toAddr, amt)
+	return nil
+}
+
+func (b *BankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *BankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"finance/x/finance/keeper"
+	"finance/x/finance/types"
+	lendingkeeper "finance/x/lending/keeper"
+	lendingtypes "finance/x/lending/types"
+	paymentskeeper "finance/x/payments/keeper"
+	paymentstypes "finance/x/payments/types"
+	screeningkeeper "finance/x/screening/keeper"
+	screeningtypes "finance/x/screening/types"
+)
+
+func FinanceKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, _, _, _, ctx := financeKeeperWithModules(t)
+	return k, ctx
+}
+
+// FinanceKeeperWithPayments returns a finance keeper backed by a payments
+// keeper that moves funds through an in-memory bank keeper
+func FinanceKeeperWithPayments(t testing.TB) (keeper.Keeper, paymentskeeper.Keeper, *BankKeeper, sdk.Context) {
+	k, paymentsKeeper, _, _, bankKeeper, ctx := financeKeeperWithModules(t)
+	return k, paymentsKeeper, bankKeeper, ctx
+}
+
+// FinanceKeeperWithLending returns a finance keeper backed by a lending
+// keeper that moves funds through an in-memory bank keeper
+func FinanceKeeperWithLending(t testing.TB) (keeper.Keeper, lendingkeeper.Keeper, *BankKeeper, sdk.Context) {
+	k, _, lendingKeeper, _, bankKeeper, ctx := financeKeeperWithModules(t)
+	return k, lendingKeeper, bankKeeper, ctx
+}
+
+// FinanceKeeperWithScreening returns a finance keeper backed by a screening
+// keeper and payments moving funds through an in-memory bank keeper
+func FinanceKeeperWithScreening(t testing.TB) (keeper.Keeper, screeningkeeper.Keeper, *BankKeeper, sdk.Context) {
+	k, _, _, screeningKeeper, bankKeeper, ctx := financeKeeperWithModules(t)
+	return k, screeningKeeper, bankKeeper, ctx
+}
+
+func financeKeeperWithModules(t testing.TB) (keeper.Keeper, paymentskeeper.Keeper, lendingkeeper.Keeper, screeningkeeper.Keeper, *BankKeeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	paymentsStoreKey := storetypes.NewKVStoreKey(paymentstypes.StoreKey)
+	lendingStoreKey := storetypes.NewKVStoreKey(lendingtypes.StoreKey)
+	screeningStoreKey := storetypes.NewKVStoreKey(screeningtypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paymentsStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(lendingStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(screeningStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	bankKeeper := NewBankKeeper()
+	paymentsKeeper := paymentskeeper.NewKeeper(cdc, runtime.NewKVStoreService(paymentsStoreKey), log.NewNopLogger(), authority.String(), bankKeeper)
+	lendingKeeper := lendingkeeper.NewKeeper(cdc, runtime.NewKVStoreService(lendingStoreKey), log.NewNopLogger(), authority.String(), bankKeeper)
+	screeningKeeper := screeningkeeper.NewKeeper(cdc, runtime.NewKVStoreService(screeningStoreKey), log.NewNopLogger(), authority.String())
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		nil,
+		nil,
+		paymentsKeeper,
+		lendingKeeper,
+		screeningKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+	if err := paymentsKeeper.SetParams(ctx, paymentstypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	if err := lendingKeeper.SetParams(ctx, lendingtypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	if err := screeningKeeper.SetParams(ctx, screeningtypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, paymentsKeeper, lendingKeeper, screeningKeeper, bankKeeper, ctx
+}
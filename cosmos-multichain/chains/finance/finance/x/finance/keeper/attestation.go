@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/finance/types"
+)
+
+// SetAttestation set a specific attestation in the store from its index
+func (k Keeper) SetAttestation(ctx context.Context, attestation types.Attestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	b := k.cdc.MustMarshal(&attestation)
+	store.Set(types.AttestationKey(
+		attestation.Address,
+	), b)
+}
+
+// GetAttestation returns an attestation from its index
+func (k Keeper) GetAttestation(
+	ctx context.Context,
+	address string,
+) (val types.Attestation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+
+	b := store.Get(types.AttestationKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAttestation returns all attestation
+func (k Keeper) GetAllAttestation(ctx context.Context) (list []types.Attestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CheckCompliance returns an error unless every party holds a valid
+// attestation recorded by a currently accredited attestor
+func (k Keeper) CheckCompliance(ctx context.Context, parties ...string) error {
+	params := k.GetParams(ctx)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	for _, party := range parties {
+		attestation, found := k.GetAttestation(ctx, party)
+		if !found {
+			return errorsmod.Wrapf(types.ErrNotCompliant, "%s has no attestation", party)
+		}
+		if !params.IsAttestor(attestation.Attestor) {
+			return errorsmod.Wrapf(types.ErrNotCompliant, "attestor %s of %s is not accredited", attestation.Attestor, party)
+		}
+		if !attestation.IsValid(now, params.AttestationValidity) {
+			return errorsmod.Wrapf(types.ErrNotCompliant, "attestation of %s is missing KYC/AML or stale", party)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"finance/x/finance/types"
+)
+
+// GetAuditEntryCount get the total number of auditEntry
+func (k Keeper) GetAuditEntryCount(ctx context.Context) uint64 {
+	return k.audit.GetCount(ctx)
+}
+
+// SetAuditEntryCount set the total number of auditEntry
+func (k Keeper) SetAuditEntryCount(ctx context.Context, count uint64) {
+	k.audit.SetCount(ctx, count)
+}
+
+// AppendAuditEntry appends an entry to the audit trail of its subject at the
+// current block time, chained to the previous entry of the subject
+func (k Keeper) AppendAuditEntry(ctx context.Context, subjectId, action, detail string) error {
+	_, err := k.audit.Append(ctx, subjectId, action, detail)
+	return err
+}
+
+// SetAuditEntry set a specific auditEntry in the store
+func (k Keeper) SetAuditEntry(ctx context.Context, auditEntry types.AuditEntry) {
+	k.audit.Set(ctx, auditEntry)
+}
+
+// GetAuditTrail returns the audit entries of a subject in the order they were appended
+func (k Keeper) GetAuditTrail(ctx context.Context, subjectId string) []types.AuditEntry {
+	return k.audit.GetTrail(ctx, subjectId)
+}
+
+// GetAllAuditEntry returns all auditEntry
+func (k Keeper) GetAllAuditEntry(ctx context.Context) []types.AuditEntry {
+	return k.audit.GetAll(ctx)
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/example/cosmos-multichain/audit"
+	"github.com/stretchr/testify/require"
+
+	keepertest "finance/testutil/keeper"
+	"finance/x/finance/types"
+)
+
+func TestAuditTrail(t *testing.T) {
+	k, ctx := keepertest.FinanceKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	require.ErrorIs(t, k.AppendAuditEntry(ctx, "", "log", "no subject"), audit.ErrEmptySubject)
+	for _, detail := range []string{"first", "second", "third"} {
+		require.NoError(t, k.AppendAuditEntry(ctx, "subject-1", "log", detail))
+		require.NoError(t, k.AppendAuditEntry(ctx, "subject-2", "log", detail))
+	}
+
+	trail := k.GetAuditTrail(ctx, "subject-1")
+	require.Len(t, trail, 3)
+	require.Empty(t, trail[0].PrevHash)
+	require.Equal(t, trail[0].Hash, trail[1].PrevHash)
+	require.Equal(t, trail[1].Hash, trail[2].PrevHash)
+
+	page, err := k.AuditTrail(ctx, &types.QueryAuditTrailRequest{SubjectId: "subject-1", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, trail[:2], page.AuditEntry)
+	require.Equal(t, uint64(3), page.Pagination.Total)
+
+	res, err := k.VerifyAuditTrail(ctx, &types.QueryVerifyAuditTrailRequest{SubjectId: "subject-1"})
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+	require.Equal(t, uint64(3), res.Length)
+	require.Equal(t, trail[2].Hash, res.HeadHash)
+
+	// Rewriting an entry breaks the chain even when its hash is recomputed
+	rewritten := trail[1]
+	rewritten.Detail = "rewritten"
+	rewritten.Hash = audit.EntryHash(&rewritten)
+	k.SetAuditEntry(ctx, rewritten)
+	res, err = k.VerifyAuditTrail(ctx, &types.QueryVerifyAuditTrailRequest{SubjectId: "subject-1"})
+	require.NoError(t, err)
+	require.False(t, res.Valid)
+	require.Equal(t, uint64(2), res.Length)
+	require.Contains(t, res.Error, "entry 4 of subject-1")
+
+	// Other subjects keep their own chain
+	res, err = k.VerifyAuditTrail(ctx, &types.QueryVerifyAuditTrailRequest{SubjectId: "subject-2"})
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+}
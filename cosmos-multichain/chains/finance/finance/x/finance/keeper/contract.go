@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"finance/contracts"
+	"finance/contracts/interfaces"
+	"finance/x/finance/types"
+	lendingtypes "finance/x/lending/types"
+	paymentstypes "finance/x/payments/types"
+)
+
+// newContract builds the finance contract on top of the module state
+func newContract(k Keeper) *contracts.FinanceContract {
+	return contracts.NewFinanceContract(financeValidator{k}, financeAudit{k}, riskAssessment{k}, paymentProcessor{k}, loanManager{k}, k.Screener())
+}
+
+// financeValidator checks financial transactions, and their parties against
+// the compliance attestations
+type financeValidator struct {
+	k Keeper
+}
+
+func (financeValidator) ValidateTransaction(tx []byte) error {
+	var t contracts.FinancialTransaction
+	if err := json.Unmarshal(tx, &t); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid transaction format")
+	}
+	if t.TransactionID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
+	}
+	if t.TxType == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction type is required")
+	}
+	return nil
+}
+
+func (financeValidator) ValidateAmount(amount sdkmath.Int, txType string) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "invalid %s amount", txType)
+	}
+	return nil
+}
+
+func (financeValidator) ValidateParties(parties []string) error {
+	seen := make(map[string]struct{}, len(parties))
+	for _, party := range parties {
+		if party == "" {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "party address is required")
+		}
+		if _, ok := seen[party]; ok {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "duplicated party %s", party)
+		}
+		seen[party] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateCompliance requires attested parties for transactions above the
+// compliance threshold of their type. The compliance metadata claimed by the
+// transaction is not trusted.
+func (v financeValidator) ValidateCompliance(ctx sdk.Context, tx []byte) error {
+	var t contracts.FinancialTransaction
+	if err := json.Unmarshal(tx, &t); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid transaction format")
+	}
+	amount := t.Amount
+	if amount.IsNil() {
+		amount = sdkmath.ZeroInt()
+	}
+	if !v.k.GetParams(ctx).RequiresAttestation(t.TxType, amount) {
+		return nil
+	}
+	return v.k.CheckCompliance(ctx, t.FromAddress, t.ToAddress)
+}
+
+// financeAudit appends the contract audit events to the module audit trail
+type financeAudit struct {
+	k Keeper
+}
+
+func (a financeAudit) LogTransaction(ctx sdk.Context, txID string, txType string, amount sdkmath.Int) error {
+	return a.k.AppendAuditEntry(ctx, txID, "transaction", fmt.Sprintf("%s %s", txType, amount))
+}
+
+func (a financeAudit) LogCompliance(ctx sdk.Context, txID string, complianceType string, result bool) error {
+	return a.k.AppendAuditEntry(ctx, txID, "compliance", fmt.Sprintf("%s passed=%t", complianceType, result))
+}
+
+func (a financeAudit) LogRiskAssessment(ctx sdk.Context, txID string, riskLevel string) error {
+	return a.k.AppendAuditEntry(ctx, txID, "risk_assessment", riskLevel)
+}
+
+func (a financeAudit) GetAuditTrail(ctx sdk.Context, txID string) ([]byte, error) {
+	return json.Marshal(a.k.GetAuditTrail(ctx, txID))
+}
+
+// riskAssessment scores transactions against the risk profiles of their
+// parties and their payment history
+type riskAssessment struct {
+	k Keeper
+}
+
+// AssessTransactionRisk scores a transaction with the risk scorer. The risk
+// score claimed by the transaction may raise its level but never lower it.
+func (r riskAssessment) AssessTransactionRisk(ctx sdk.Context, tx []byte) (string, error) {
+	var t contracts.FinancialTransaction
+	if err := json.Unmarshal(tx, &t); err != nil {
+		return "", errorsmod.Wrap(interchain.ErrInvalidData, "invalid transaction format")
+	}
+	claimed, err := riskScore(t.Metadata.Risk.RiskScore)
+	if err != nil {
+		return "", err
+	}
+
+	score, _ := r.k.AssessRisk(ctx, t.FromAddress, t.ToAddress, t.Amount)
+	return types.RiskLevel(max(score, claimed)), nil
+}
+
+func (r riskAssessment) ValidateRiskThresholds(ctx sdk.Context, amount sdkmath.Int, riskLevel string) error {
+	limit, ok := r.k.GetParams(ctx).RiskLimit(riskLevel)
+	if ok && amount.GT(limit) {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "%s risk transactions are limited to %s", riskLevel, limit)
+	}
+	return nil
+}
+
+func (r riskAssessment) UpdateRiskProfile(ctx sdk.Context, entityID string, riskData []byte) error {
+	var data contracts.RiskData
+	if err := json.Unmarshal(riskData, &data); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid risk profile format")
+	}
+	score, err := riskScore(data.RiskScore)
+	if err != nil {
+		return err
+	}
+	profile := types.RiskProfile{
+		Address:      entityID,
+		Score:        score,
+		Factors:      data.RiskFactors,
+		AssessedAt:   ctx.BlockTime().Unix(),
+		Restrictions: data.Restrictions,
+	}
+	if !data.ValidUntil.IsZero() {
+		profile.ValidUntil = data.ValidUntil.Unix()
+	}
+	r.k.SetRiskProfile(ctx, profile)
+	return nil
+}
+
+func (r riskAssessment) GetRiskReport(ctx sdk.Context, entityID string) ([]byte, error) {
+	profile, found := r.k.GetRiskProfile(ctx, entityID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRecordNotFound, entityID)
+	}
+	report := contracts.RiskData{
+		RiskScore:    float64(profile.Score) / float64(types.RiskScoreMax),
+		RiskFactors:  profile.Factors,
+		AssessedAt:   time.Unix(profile.AssessedAt, 0).UTC(),
+		Restrictions: profile.Restrictions,
+	}
+	if profile.ValidUntil != 0 {
+		report.ValidUntil = time.Unix(profile.ValidUntil, 0).UTC()
+	}
+	return json.Marshal(report)
+}
+
+// riskScore converts a contract risk score, from 0 to 1, to basis points
+func riskScore(score float64) (uint32, error) {
+	if score < 0 || score > 1 {
+		return 0, errorsmod.Wrapf(interchain.ErrInvalidData, "risk score %v is not between 0 and 1", score)
+	}
+	return uint32(math.Round(score * float64(types.RiskScoreMax))), nil
+}
+
+// paymentProcessor moves the funds of payments and transfers through the payments module
+type paymentProcessor struct {
+	k Keeper
+}
+
+func (p paymentProcessor) ProcessPayment(ctx sdk.Context, payment []byte) error {
+	var t contracts.FinancialTransaction
+	if err := json.Unmarshal(payment, &t); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	if _, err := p.k.paymentsKeeper.ProcessFinancialTransaction(ctx, paymentstypes.FinancialTransaction{
+		TransactionId: t.TransactionID,
+		FromAddress:   t.FromAddress,
+		ToAddress:     t.ToAddress,
+		Amount:        sdk.Coin{Denom: t.Currency, Amount: t.Amount},
+		TxType:        t.TxType,
+	}); err != nil {
+		return err
+	}
+	p.k.RecordPayment(ctx, t.FromAddress, t.ToAddress)
+	return nil
+}
+
+func (p paymentProcessor) CalculateFees(ctx sdk.Context, amount sdkmath.Int, txType string) (sdkmath.Int, error) {
+	return p.k.paymentsKeeper.CalculateFee(ctx, txType, amount), nil
+}
+
+// SettlePayment settles the payment of a transaction, if it moved funds
+func (p paymentProcessor) SettlePayment(ctx sdk.Context, txID string) error {
+	if _, found := p.k.paymentsKeeper.GetFinancialTransaction(ctx, txID); !found {
+		return nil
+	}
+	return p.k.paymentsKeeper.SettleFinancialTransaction(ctx, txID)
+}
+
+// HoldPayment records a payment received from another chain as a
+// transaction held for review by a risk officer
+func (p paymentProcessor) HoldPayment(ctx sdk.Context, sourceChain string, payment []byte) error {
+	var t contracts.FinancialTransaction
+	if err := json.Unmarshal(payment, &t); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	if _, found := p.k.GetTransaction(ctx, t.TransactionID); found {
+		return errorsmod.Wrap(types.ErrTransactionExists, t.TransactionID)
+	}
+
+	now := ctx.BlockTime().Unix()
+	p.k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   t.TransactionID,
+		Creator:         sourceChain,
+		TransactionType: t.TxType,
+		Status:          types.TransactionStatusHeld,
+		Data:            payment,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionHeld,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, t.TransactionID),
+			sdk.NewAttribute(types.AttributeKeySourceChain, sourceChain),
+			sdk.NewAttribute(types.AttributeKeyRiskLevel, interfaces.RiskLevelHigh),
+		),
+	)
+	return nil
+}
+
+// loanManager originates loans in the lending module
+type loanManager struct {
+	k Keeper
+}
+
+func (m loanManager) OpenLoan(ctx sdk.Context, application []byte) error {
+	var a contracts.LoanApplication
+	if err := json.Unmarshal(application, &a); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid loan application format")
+	}
+	return m.k.lendingKeeper.OpenLoan(ctx, lendingtypes.Loan{
+		LoanId:          a.LoanID,
+		Borrower:        a.Borrower,
+		Lender:          a.Lender,
+		Principal:       sdk.Coin{Denom: a.Currency, Amount: a.Principal},
+		RateBps:         a.RateBps,
+		Term:            a.Term,
+		PaymentInterval: a.PaymentInterval,
+		CollateralRefs:  a.CollateralRefs,
+		CollateralValue: a.CollateralValue,
+		SourceChain:     a.SourceChain,
+	})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"finance/contracts"
+	"finance/x/finance/types"
+)
+
+var _ interchain.PacketHandler = Keeper{}
+
+// counterpartyChain resolves a counterparty chain ID from the params
+func (k Keeper) counterpartyChain(ctx sdk.Context, chainId string) (string, bool) {
+	return k.GetParams(ctx).CounterpartyChain(chainId)
+}
+
+// OnRecvMessage processes a message of a counterparty chain with the finance
+// contract. The payments of the insurance and retail chains are answered with
+// their status: FINALIZED, or HELD until a risk officer reviews them.
+func (k Keeper) OnRecvMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) ([]byte, error) {
+	if _, err := k.ContractHandler.OnRecvMessage(ctx, sourceChain, messageType, message); err != nil {
+		return nil, err
+	}
+	if sourceChain != interchain.ChainInsurance && sourceChain != interchain.ChainRetail {
+		return nil, nil
+	}
+
+	var payment contracts.FinancialTransaction
+	if err := json.Unmarshal(message, &payment); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+
+	status := contracts.PaymentStatusFinalized
+	if transaction, found := k.GetTransaction(ctx, payment.TransactionID); found && transaction.Status == types.TransactionStatusHeld {
+		status = contracts.PaymentStatusHeld
+	}
+	return paymentWithStatus(message, status)
+}
+
+// queuePaymentStatus sends the status of a payment held for review back to
+// the chain it was received from
+func (k Keeper) queuePaymentStatus(ctx sdk.Context, transaction types.Transaction, status string) error {
+	response, err := paymentWithStatus(transaction.Data, status)
+	if err != nil {
+		return err
+	}
+	return k.QueuePacket(ctx, transaction.Creator, interchain.NewCallbackPacket(contracts.MessageTypePayment, response))
+}
+
+// paymentWithStatus returns a payment of another chain with its status on
+// this chain
+func paymentWithStatus(data []byte, status string) ([]byte, error) {
+	var payment contracts.FinancialTransaction
+	if err := json.Unmarshal(data, &payment); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	payment.Status = status
+	response, err := json.Marshal(payment)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal payment")
+	}
+	return response, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/x/finance/types"
+)
+
+func (k msgServer) AttestCompliance(goCtx context.Context, msg *types.MsgAttestCompliance) (*types.MsgAttestComplianceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsAttestor(msg.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an accredited attestor", msg.Creator)
+	}
+
+	k.SetAttestation(ctx, types.Attestation{
+		Address:      msg.Address,
+		Attestor:     msg.Creator,
+		KycVerified:  msg.KycVerified,
+		AmlChecked:   msg.AmlChecked,
+		Jurisdiction: msg.Jurisdiction,
+		LastChecked:  ctx.BlockTime().Unix(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeComplianceAttested,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyAttestor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyKycVerified, strconv.FormatBool(msg.KycVerified)),
+			sdk.NewAttribute(types.AttributeKeyAmlChecked, strconv.FormatBool(msg.AmlChecked)),
+			sdk.NewAttribute(types.AttributeKeyJurisdiction, msg.Jurisdiction),
+		),
+	)
+
+	return &types.MsgAttestComplianceResponse{}, nil
+}
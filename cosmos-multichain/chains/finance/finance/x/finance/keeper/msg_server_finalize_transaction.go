@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/finance/types"
+)
+
+func (k msgServer) FinalizeTransaction(goCtx context.Context, msg *types.MsgFinalizeTransaction) (*types.MsgFinalizeTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transaction, err := k.openTransaction(ctx, msg.Creator, msg.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transactions.FinalizeTransaction(ctx, msg.TransactionId); err != nil {
+		return nil, err
+	}
+
+	transaction.Status = types.TransactionStatusFinalized
+	transaction.Finalized = true
+	transaction.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, transaction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionFinalized,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, msg.TransactionId),
+		),
+	)
+
+	return &types.MsgFinalizeTransactionResponse{}, nil
+}
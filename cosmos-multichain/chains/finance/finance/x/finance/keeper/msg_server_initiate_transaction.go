@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/contracts/transactions"
+	"finance/x/finance/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.FinancialTransactionRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	// Payments and transfers move the funds of the sender and loans are
+	// borrowed by the sender, who must sign them
+	if (req.TxType == transactions.Payment || req.TxType == transactions.Transfer || req.TxType == transactions.Loan) && req.FromAddress != msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the sender can initiate a %s", req.TxType)
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TxType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TxType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
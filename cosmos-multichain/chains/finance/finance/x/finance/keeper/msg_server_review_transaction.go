@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/contracts"
+	"finance/x/finance/types"
+)
+
+// ReviewTransaction releases or rejects a transaction held because of its
+// risk. Released payments move their funds and are settled right away, as
+// there is no creator on this chain to finalize them. Either way, the status
+// of the payment is sent back to the chain it came from.
+func (k msgServer) ReviewTransaction(goCtx context.Context, msg *types.MsgReviewTransaction) (*types.MsgReviewTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsRiskOfficer(msg.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an accredited risk officer", msg.Creator)
+	}
+	transaction, found := k.GetTransaction(ctx, msg.TransactionId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrTransactionNotFound, msg.TransactionId)
+	}
+	if transaction.Status != types.TransactionStatusHeld {
+		return nil, errorsmod.Wrapf(types.ErrTransactionNotHeld, "%s is %s", msg.TransactionId, transaction.Status)
+	}
+
+	transaction.Status = types.TransactionStatusRejected
+	paymentStatus := contracts.PaymentStatusRejected
+	if msg.Approve {
+		if err := k.contract.ReleasePayment(ctx, transaction.Data); err != nil {
+			return nil, err
+		}
+		if err := k.contract.SettlePayment(ctx, msg.TransactionId); err != nil {
+			return nil, err
+		}
+		transaction.Status = types.TransactionStatusReleased
+		paymentStatus = contracts.PaymentStatusFinalized
+	}
+	transaction.Finalized = true
+	transaction.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, transaction)
+	if err := k.queuePaymentStatus(ctx, transaction, paymentStatus); err != nil {
+		return nil, err
+	}
+	if err := k.AppendAuditEntry(ctx, msg.TransactionId, "risk_review", fmt.Sprintf("approved=%t by %s", msg.Approve, msg.Creator)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionReviewed,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, msg.TransactionId),
+			sdk.NewAttribute(types.AttributeKeyRiskOfficer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyApproved, strconv.FormatBool(msg.Approve)),
+		),
+	)
+
+	return &types.MsgReviewTransactionResponse{}, nil
+}
@@ -0,0 +1,406 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	screeningpkg "github.com/example/cosmos-multichain/screening"
+	"github.com/stretchr/testify/require"
+
+	"finance/contracts"
+	"finance/contracts/interfaces"
+	"finance/contracts/transactions"
+	keepertest "finance/testutil/keeper"
+	"finance/testutil/sample"
+	"finance/x/finance/keeper"
+	"finance/x/finance/types"
+	lendingtypes "finance/x/lending/types"
+	paymentstypes "finance/x/payments/types"
+	screeningtypes "finance/x/screening/types"
+)
+
+func paymentData(t *testing.T, id, from, to string, amount int64) []byte {
+	data, err := json.Marshal(transactions.FinancialTransactionRequest{
+		TransactionID: id,
+		FromAddress:   from,
+		ToAddress:     to,
+		Amount:        sdkmath.NewInt(amount),
+		Currency:      "ufin",
+		TxType:        transactions.Payment,
+		// Claimed compliance is ignored in favor of the attestations
+		Metadata: transactions.Metadata{
+			Compliance: transactions.ComplianceInfo{KYCVerified: true, AMLChecked: true},
+		},
+	})
+	require.NoError(t, err)
+	return data
+}
+
+// attest accredits a new attestor and records its attestation of every party
+func attest(t *testing.T, k keeper.Keeper, ctx sdk.Context, parties ...string) {
+	attestor := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Attestors = []string{attestor}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	srv := keeper.NewMsgServerImpl(k)
+	for _, party := range parties {
+		_, err := srv.AttestCompliance(ctx, types.NewMsgAttestCompliance(attestor, party, true, true, "US"))
+		require.NoError(t, err)
+	}
+}
+
+// fundedAccount returns a new address holding 10000ufin
+func fundedAccount(bank *keepertest.BankKeeper) string {
+	addr := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(addr), sdk.NewCoins(sdk.NewInt64Coin("ufin", 10_000)))
+	return addr
+}
+
+func TestInitiatePayment(t *testing.T) {
+	k, payments, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	creator, recipient := fundedAccount(bank), sample.AccAddress()
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(recipient, paymentData(t, "tx-1", creator, recipient, 1000)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-1", creator, recipient, 1000)))
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", res.TransactionId)
+
+	require.Equal(t, int64(8_999), bank.Balance(sdk.MustAccAddressFromBech32(creator)).AmountOf("ufin").Int64())
+	require.Equal(t, int64(1_000), bank.Balance(sdk.MustAccAddressFromBech32(recipient)).AmountOf("ufin").Int64())
+	payment, found := payments.GetFinancialTransaction(ctx, "tx-1")
+	require.True(t, found)
+	require.Equal(t, paymentstypes.TransactionStatusCompleted, payment.Status)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 1), payment.Fee)
+
+	tx, found := k.GetTransaction(ctx, "tx-1")
+	require.True(t, found)
+	require.Equal(t, types.TransactionStatusInitiated, tx.Status)
+	require.Equal(t, string(transactions.Payment), tx.TransactionType)
+
+	var actions []string
+	for _, entry := range k.GetAuditTrail(ctx, "tx-1") {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []string{"compliance", "risk_assessment", "transaction"}, actions)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-1", creator, recipient, 100)))
+	require.ErrorIs(t, err, types.ErrTransactionExists)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, []byte("{")))
+	require.ErrorIs(t, err, types.ErrInvalidTransaction)
+}
+
+func TestHighRiskPaymentLimit(t *testing.T) {
+	k, _, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator, recipient := fundedAccount(bank), sample.AccAddress()
+
+	k.SetRiskProfile(ctx, types.RiskProfile{Address: creator, Score: 9_000})
+	attest(t, k, ctx, creator, recipient)
+
+	limit, _ := types.DefaultParams().RiskLimit(interfaces.RiskLevelHigh)
+	over := limit.AddRaw(1).Int64()
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-1", creator, recipient, over)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-2", creator, recipient, 10)))
+	require.NoError(t, err)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	k, payments, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator, other := fundedAccount(bank), sample.AccAddress()
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-1", creator, other, 100)))
+	require.NoError(t, err)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(other, "tx-1", "SETTLING"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(creator, "missing", "SETTLING"))
+	require.ErrorIs(t, err, types.ErrTransactionNotFound)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(creator, "tx-1", "SETTLING"))
+	require.NoError(t, err)
+	tx, _ := k.GetTransaction(ctx, "tx-1")
+	require.Equal(t, "SETTLING", tx.Status)
+
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.NoError(t, err)
+	tx, _ = k.GetTransaction(ctx, "tx-1")
+	require.True(t, tx.Finalized)
+	require.Equal(t, types.TransactionStatusFinalized, tx.Status)
+	payment, _ := payments.GetFinancialTransaction(ctx, "tx-1")
+	require.Equal(t, paymentstypes.TransactionStatusSettled, payment.Status)
+
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
+
+func TestInitiateLoan(t *testing.T) {
+	k, lending, _, ctx := keepertest.FinanceKeeperWithLending(t)
+	srv := keeper.NewMsgServerImpl(k)
+	borrower, lender := sample.AccAddress(), sample.AccAddress()
+	loanData := func(id string, terms *transactions.LoanTerms) []byte {
+		data, err := json.Marshal(transactions.FinancialTransactionRequest{
+			TransactionID: id,
+			FromAddress:   borrower,
+			ToAddress:     lender,
+			Amount:        sdkmath.NewInt(1_000),
+			Currency:      "ufin",
+			TxType:        transactions.Loan,
+			LoanTerms:     terms,
+		})
+		require.NoError(t, err)
+		return data
+	}
+	terms := &transactions.LoanTerms{
+		RateBps:         500,
+		Term:            12,
+		PaymentInterval: 30 * 24 * time.Hour,
+		CollateralRefs:  []string{"vehicle-1"},
+		CollateralValue: sdkmath.NewInt(2_000),
+	}
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(lender, loanData("loan-1", terms)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(borrower, loanData("loan-1", nil)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(borrower, loanData("loan-1", terms)))
+	require.NoError(t, err)
+	loan, found := lending.GetLoan(ctx, "loan-1")
+	require.True(t, found)
+	require.Equal(t, lendingtypes.LoanStatusPending, loan.Status)
+	require.Equal(t, borrower, loan.Borrower)
+	require.Equal(t, lender, loan.Lender)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 1_000), loan.Principal)
+	require.Empty(t, loan.SourceChain)
+}
+
+func TestRealEstateMortgage(t *testing.T) {
+	k, lending, _, ctx := keepertest.FinanceKeeperWithLending(t)
+	mortgage := func(collateralValue int64) []byte {
+		data, err := json.Marshal(contracts.LoanApplication{
+			LoanID:          "mortgage-1",
+			Borrower:        sample.AccAddress(),
+			Principal:       sdkmath.NewInt(100_000),
+			Currency:        "ufin",
+			RateBps:         400,
+			Term:            12,
+			PaymentInterval: 30 * 24 * time.Hour,
+			CollateralRefs:  []string{"property-1"},
+			CollateralValue: sdkmath.NewInt(collateralValue),
+		})
+		require.NoError(t, err)
+		return data
+	}
+
+	_, err := k.OnRecvMessage(ctx, interchain.ChainRealEstate, "mortgage", mortgage(100_000))
+	require.ErrorIs(t, err, lendingtypes.ErrInsufficientCollateral)
+
+	response, err := k.OnRecvMessage(ctx, interchain.ChainRealEstate, "mortgage", mortgage(150_000))
+	require.NoError(t, err)
+	require.Nil(t, response)
+	loan, found := lending.GetLoan(ctx, "mortgage-1")
+	require.True(t, found)
+	require.Equal(t, interchain.ChainRealEstate, loan.SourceChain)
+	require.Equal(t, []string{"property-1"}, loan.CollateralRefs)
+	require.Empty(t, loan.Lender)
+}
+
+func TestComplianceAttestation(t *testing.T) {
+	k, _, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	creator, recipient := sample.AccAddress(), sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(sdk.NewInt64Coin("ufin", 100_000)))
+	over := types.DefaultComplianceThresholds[0].Amount.AddRaw(1).Int64()
+	pay := func(ctx sdk.Context, id string) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, id, creator, recipient, over)))
+		return err
+	}
+
+	_, err := srv.AttestCompliance(ctx, types.NewMsgAttestCompliance(creator, creator, true, true, "US"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, pay(ctx, "tx-1"), types.ErrNotCompliant)
+
+	attest(t, k, ctx, creator)
+	require.ErrorIs(t, pay(ctx, "tx-2"), types.ErrNotCompliant)
+
+	attest(t, k, ctx, creator, recipient)
+	attestation, found := k.GetAttestation(ctx, recipient)
+	require.True(t, found)
+	require.Equal(t, "US", attestation.Jurisdiction)
+	require.Equal(t, ctx.BlockTime().Unix(), attestation.LastChecked)
+	require.NoError(t, pay(ctx, "tx-3"))
+
+	stale := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultAttestationValidity))
+	require.ErrorIs(t, pay(stale, "tx-4"), types.ErrNotCompliant)
+
+	// Revoking the accreditation of the attestor invalidates its attestations
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.ErrorIs(t, pay(ctx, "tx-5"), types.ErrNotCompliant)
+}
+
+func TestScreenedPayment(t *testing.T) {
+	k, screening, bank, ctx := keepertest.FinanceKeeperWithScreening(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0)).WithExecMode(sdk.ExecModeFinalize)
+	srv := keeper.NewMsgServerImpl(k)
+	creator, recipient := fundedAccount(bank), sample.AccAddress()
+	require.NoError(t, screening.ListEntry(ctx, screeningtypes.EntryKindAddress, recipient, "sanctioned"))
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-1", creator, recipient, 1000)))
+	require.ErrorIs(t, err, screeningpkg.ErrScreened)
+	require.Equal(t, int64(10_000), bank.Balance(sdk.MustAccAddressFromBech32(creator)).AmountOf("ufin").Int64())
+
+	// the blocked attempt is audited at the end of the block, out of its
+	// reverted state
+	require.Empty(t, k.GetAuditTrail(ctx, "tx-1"))
+	screening.Journal().Flush(ctx)
+	trail := k.GetAuditTrail(ctx, "tx-1")
+	require.Len(t, trail, 1)
+	require.Equal(t, "compliance", trail[0].Action)
+	require.Equal(t, "screening passed=false", trail[0].Detail)
+
+	// the screener of the ante handler and IBC middleware audits its blocked attempts
+	require.True(t, k.Screener().IsScreened(ctx, recipient))
+	k.Screener().LogBlocked(ctx, "transfer/channel-0/1", recipient)
+	screening.Journal().Flush(ctx)
+	require.Len(t, k.GetAuditTrail(ctx, "transfer/channel-0/1"), 1)
+
+	require.NoError(t, screening.DelistEntry(ctx, recipient, "cleared"))
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, paymentData(t, "tx-2", creator, recipient, 1000)))
+	require.NoError(t, err)
+}
+
+func TestInterchainPaymentReview(t *testing.T) {
+	k, payments, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	officer, recipient := sample.AccAddress(), sample.AccAddress()
+	sender := interchain.ChainAccount(interchain.ChainRetail).String()
+	bank.Fund(sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewInt64Coin("ufin", 10_000)))
+	params := types.DefaultParams()
+	params.RiskOfficers = []string{officer}
+	require.NoError(t, k.SetParams(ctx, params))
+	retailPayment := func(id, from string) []byte {
+		data, err := json.Marshal(contracts.FinancialTransaction{
+			TransactionID: id,
+			FromAddress:   from,
+			ToAddress:     recipient,
+			Amount:        sdkmath.NewInt(1_000),
+			Currency:      "ufin",
+			TxType:        "PAYMENT",
+		})
+		require.NoError(t, err)
+		return data
+	}
+	paymentStatus := func(response []byte) string {
+		var payment contracts.FinancialTransaction
+		require.NoError(t, json.Unmarshal(response, &payment))
+		return payment.Status
+	}
+	balance := func(addr string) int64 {
+		return bank.Balance(sdk.MustAccAddressFromBech32(addr)).AmountOf("ufin").Int64()
+	}
+
+	// The retail chain only pays from its own account
+	_, err := k.OnRecvMessage(ctx, interchain.ChainRetail, "payment", retailPayment("retail-0", fundedAccount(bank)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	response, err := k.OnRecvMessage(ctx, interchain.ChainRetail, "payment", retailPayment("retail-1", sender))
+	require.NoError(t, err)
+	require.Equal(t, contracts.PaymentStatusFinalized, paymentStatus(response))
+	require.Equal(t, int64(1_000), balance(recipient))
+	payment, _ := payments.GetFinancialTransaction(ctx, "retail-1")
+	require.Equal(t, paymentstypes.TransactionStatusSettled, payment.Status)
+
+	_, err = srv.UpdateRiskProfile(ctx, types.NewMsgUpdateRiskProfile(sender, sender, 0, nil, 0, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateRiskProfile(ctx, types.NewMsgUpdateRiskProfile(officer, sender, 8_000, []string{"adverse_media"}, 0, nil))
+	require.NoError(t, err)
+
+	// High risk payments are held instead of moving funds
+	response, err = k.OnRecvMessage(ctx, interchain.ChainRetail, "payment", retailPayment("retail-2", sender))
+	require.NoError(t, err)
+	require.Equal(t, contracts.PaymentStatusHeld, paymentStatus(response))
+	require.Equal(t, int64(1_000), balance(recipient))
+	held, found := k.GetTransaction(ctx, "retail-2")
+	require.True(t, found)
+	require.Equal(t, types.TransactionStatusHeld, held.Status)
+	require.Equal(t, interchain.ChainRetail, held.Creator)
+	require.Empty(t, k.GetQueuedPackets(ctx))
+
+	_, err = srv.ReviewTransaction(ctx, types.NewMsgReviewTransaction(sender, "retail-2", true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ReviewTransaction(ctx, types.NewMsgReviewTransaction(officer, "retail-2", true))
+	require.NoError(t, err)
+	require.Equal(t, int64(2_000), balance(recipient))
+	released, _ := k.GetTransaction(ctx, "retail-2")
+	require.Equal(t, types.TransactionStatusReleased, released.Status)
+	require.True(t, released.Finalized)
+	payment, _ = payments.GetFinancialTransaction(ctx, "retail-2")
+	require.Equal(t, paymentstypes.TransactionStatusSettled, payment.Status)
+
+	// The review is sent back to the retail chain, until a channel opens
+	queued := k.GetQueuedPackets(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, interchain.ChainRetail, queued[0].Chain)
+	require.Equal(t, interchain.PacketKindCallback, queued[0].Packet.Kind)
+	require.Equal(t, contracts.MessageTypePayment, queued[0].Packet.MessageType)
+	require.Equal(t, contracts.PaymentStatusFinalized, paymentStatus(queued[0].Packet.Payload))
+	k.SendQueuedPackets(ctx)
+	require.Len(t, k.GetQueuedPackets(ctx), 1)
+
+	_, err = srv.ReviewTransaction(ctx, types.NewMsgReviewTransaction(officer, "retail-2", true))
+	require.ErrorIs(t, err, types.ErrTransactionNotHeld)
+
+	_, err = k.OnRecvMessage(ctx, interchain.ChainRetail, "payment", retailPayment("retail-3", sender))
+	require.NoError(t, err)
+	_, err = srv.ReviewTransaction(ctx, types.NewMsgReviewTransaction(officer, "retail-3", false))
+	require.NoError(t, err)
+	require.Equal(t, int64(2_000), balance(recipient))
+	rejected, _ := k.GetTransaction(ctx, "retail-3")
+	require.Equal(t, types.TransactionStatusRejected, rejected.Status)
+	require.Len(t, k.GetQueuedPackets(ctx), 2)
+}
+
+func TestInsuranceClaimPayout(t *testing.T) {
+	k, _, bank, ctx := keepertest.FinanceKeeperWithPayments(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	insurer := interchain.ChainAccount(interchain.ChainInsurance).String()
+	bank.Fund(sdk.MustAccAddressFromBech32(insurer), sdk.NewCoins(sdk.NewInt64Coin("ufin", 10_000)))
+	claimant := sample.AccAddress()
+	payout, err := json.Marshal(contracts.FinancialTransaction{
+		TransactionID: "claim-tx-1",
+		FromAddress:   insurer,
+		ToAddress:     claimant,
+		Amount:        sdkmath.NewInt(500),
+		Currency:      "ufin",
+		TxType:        "PAYMENT",
+	})
+	require.NoError(t, err)
+
+	// The insurance chain cannot pay from the account of another chain
+	_, err = k.OnRecvMessage(ctx, interchain.ChainRetail, "claim_payout", payout)
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	response, err := k.OnRecvMessage(ctx, interchain.ChainInsurance, "claim_payout", payout)
+	require.NoError(t, err)
+	var payment contracts.FinancialTransaction
+	require.NoError(t, json.Unmarshal(response, &payment))
+	require.Equal(t, "claim-tx-1", payment.TransactionID)
+	require.Equal(t, contracts.PaymentStatusFinalized, payment.Status)
+	require.Equal(t, int64(500), bank.Balance(sdk.MustAccAddressFromBech32(claimant)).AmountOf("ufin").Int64())
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/x/finance/types"
+)
+
+func (k msgServer) UpdateRiskProfile(goCtx context.Context, msg *types.MsgUpdateRiskProfile) (*types.MsgUpdateRiskProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsRiskOfficer(msg.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an accredited risk officer", msg.Creator)
+	}
+
+	k.SetRiskProfile(ctx, types.RiskProfile{
+		Address:      msg.Address,
+		Score:        msg.Score,
+		Factors:      msg.Factors,
+		AssessedAt:   ctx.BlockTime().Unix(),
+		ValidUntil:   msg.ValidUntil,
+		Restrictions: msg.Restrictions,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRiskProfileUpdated,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRiskOfficer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyRiskScore, strconv.FormatUint(uint64(msg.Score), 10)),
+		),
+	)
+
+	return &types.MsgUpdateRiskProfileResponse{}, nil
+}
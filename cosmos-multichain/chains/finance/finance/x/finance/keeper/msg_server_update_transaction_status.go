@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/x/finance/types"
+)
+
+func (k msgServer) UpdateTransactionStatus(goCtx context.Context, msg *types.MsgUpdateTransactionStatus) (*types.MsgUpdateTransactionStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	transaction, err := k.openTransaction(ctx, msg.Creator, msg.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transactions.UpdateTransactionStatus(ctx, msg.TransactionId, msg.Status); err != nil {
+		return nil, err
+	}
+
+	transaction.Status = msg.Status
+	transaction.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, transaction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionStatusUpdated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, msg.TransactionId),
+			sdk.NewAttribute(types.AttributeKeyStatus, msg.Status),
+		),
+	)
+
+	return &types.MsgUpdateTransactionStatusResponse{}, nil
+}
+
+// openTransaction returns a transaction that the creator may still change
+func (k Keeper) openTransaction(ctx sdk.Context, creator, transactionId string) (types.Transaction, error) {
+	transaction, found := k.GetTransaction(ctx, transactionId)
+	if !found {
+		return transaction, errorsmod.Wrap(types.ErrTransactionNotFound, transactionId)
+	}
+	if transaction.Creator != creator {
+		return transaction, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the transaction creator can change it")
+	}
+	if transaction.Finalized {
+		return transaction, errorsmod.Wrap(types.ErrTransactionFinalized, transactionId)
+	}
+	return transaction, nil
+}
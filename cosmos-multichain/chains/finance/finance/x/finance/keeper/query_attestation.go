@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/finance/types"
+)
+
+func (k Keeper) AttestationAll(ctx context.Context, req *types.QueryAllAttestationRequest) (*types.QueryAllAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var attestations []types.Attestation
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	attestationStore := prefix.NewStore(store, types.KeyPrefix(types.AttestationKeyPrefix))
+
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.Attestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttestationResponse{Attestation: attestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Attestation(ctx context.Context, req *types.QueryGetAttestationRequest) (*types.QueryGetAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetAttestation(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAttestationResponse{Attestation: val}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/finance/types"
+)
+
+func (k Keeper) AuditTrail(ctx context.Context, req *types.QueryAuditTrailRequest) (*types.QueryAuditTrailResponse, error) {
+	if req == nil || req.SubjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entries, pageRes, err := k.audit.PaginateTrail(ctx, req.SubjectId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuditTrailResponse{AuditEntry: entries, Pagination: pageRes}, nil
+}
+
+// VerifyAuditTrail re-walks the hash chain of the audit trail of a subject,
+// reporting the first entry breaking it
+func (k Keeper) VerifyAuditTrail(ctx context.Context, req *types.QueryVerifyAuditTrailRequest) (*types.QueryVerifyAuditTrailResponse, error) {
+	if req == nil || req.SubjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	length, head, err := k.audit.VerifyTrail(ctx, req.SubjectId)
+	res := &types.QueryVerifyAuditTrailResponse{Valid: err == nil, Length: length, HeadHash: head}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res, nil
+}
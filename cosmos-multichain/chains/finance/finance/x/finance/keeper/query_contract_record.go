@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/finance/types"
+)
+
+func (k Keeper) ContractRecord(ctx context.Context, req *types.QueryGetContractRecordRequest) (*types.QueryGetContractRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetContractRecord(
+		ctx,
+		req.Kind,
+		req.RecordId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetContractRecordResponse{ContractRecord: val}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/finance/types"
+)
+
+func (k Keeper) RiskProfileAll(ctx context.Context, req *types.QueryAllRiskProfileRequest) (*types.QueryAllRiskProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var riskProfiles []types.RiskProfile
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	riskProfileStore := prefix.NewStore(store, types.KeyPrefix(types.RiskProfileKeyPrefix))
+
+	pageRes, err := query.Paginate(riskProfileStore, req.Pagination, func(key []byte, value []byte) error {
+		var riskProfile types.RiskProfile
+		if err := k.cdc.Unmarshal(value, &riskProfile); err != nil {
+			return err
+		}
+
+		riskProfiles = append(riskProfiles, riskProfile)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRiskProfileResponse{RiskProfile: riskProfiles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RiskProfile(ctx context.Context, req *types.QueryGetRiskProfileRequest) (*types.QueryGetRiskProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetRiskProfile(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRiskProfileResponse{RiskProfile: val}, nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/finance/types"
+)
+
+// Factors of the risk scorer and the score they add to a transaction
+const (
+	RiskFactorLargeAmount     = "large_amount"
+	RiskFactorNewCounterparty = "new_counterparty"
+	RiskFactorHighVelocity    = "high_velocity"
+	RiskFactorManualReview    = types.RestrictionManualReview
+
+	largeAmountScore     uint32 = 3_000
+	newCounterpartyScore uint32 = 2_000
+	highVelocityScore    uint32 = 3_000
+)
+
+// AssessRisk scores a payment of amount from a sender to a recipient. The
+// score starts from the highest valid risk profile of the parties and is
+// raised by the amount, a recipient the sender never paid and the number of
+// payments the sender recently made. It returns the score and the factors
+// that raised it.
+func (k Keeper) AssessRisk(ctx context.Context, from, to string, amount sdkmath.Int) (uint32, []string) {
+	params := k.GetParams(ctx)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var (
+		score   uint32
+		factors []string
+	)
+	for _, party := range []string{from, to} {
+		profile, found := k.GetRiskProfile(ctx, party)
+		if !found || !profile.IsValid(now) {
+			continue
+		}
+		if profile.Score > score {
+			score = profile.Score
+		}
+		if profile.HasRestriction(types.RestrictionManualReview) {
+			score = max(score, types.RiskScoreHigh)
+			factors = append(factors, RiskFactorManualReview)
+		}
+	}
+
+	if !amount.IsNil() && amount.GT(params.LargeAmount) {
+		score += largeAmountScore
+		factors = append(factors, RiskFactorLargeAmount)
+	}
+	if _, found := k.GetCounterpartyHistory(ctx, from, to); !found {
+		score += newCounterpartyScore
+		factors = append(factors, RiskFactorNewCounterparty)
+	}
+	activity, found := k.GetPaymentActivity(ctx, from)
+	if found && activity.InWindow(now, params.VelocityWindow) && activity.Count >= params.VelocityLimit {
+		score += highVelocityScore
+		factors = append(factors, RiskFactorHighVelocity)
+	}
+
+	if score > types.RiskScoreMax {
+		score = types.RiskScoreMax
+	}
+	return score, factors
+}
+
+// RecordPayment adds a payment from a sender to a recipient to the history
+// and velocity of the sender
+func (k Keeper) RecordPayment(ctx context.Context, from, to string) {
+	params := k.GetParams(ctx)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	activity, found := k.GetPaymentActivity(ctx, from)
+	if !found || !activity.InWindow(now, params.VelocityWindow) {
+		activity = types.PaymentActivity{Address: from, WindowStart: now.Unix()}
+	}
+	activity.Count++
+	k.SetPaymentActivity(ctx, activity)
+
+	history, found := k.GetCounterpartyHistory(ctx, from, to)
+	if !found {
+		history = types.CounterpartyHistory{FromAddress: from, ToAddress: to}
+	}
+	history.Count++
+	history.LastPaidAt = now.Unix()
+	k.SetCounterpartyHistory(ctx, history)
+}
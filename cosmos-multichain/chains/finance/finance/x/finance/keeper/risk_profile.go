@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"finance/x/finance/types"
+)
+
+// SetRiskProfile set a specific riskProfile in the store from its index
+func (k Keeper) SetRiskProfile(ctx context.Context, riskProfile types.RiskProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RiskProfileKeyPrefix))
+	b := k.cdc.MustMarshal(&riskProfile)
+	store.Set(types.RiskProfileKey(
+		riskProfile.Address,
+	), b)
+}
+
+// GetRiskProfile returns a riskProfile from its index
+func (k Keeper) GetRiskProfile(
+	ctx context.Context,
+	address string,
+) (val types.RiskProfile, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RiskProfileKeyPrefix))
+
+	b := store.Get(types.RiskProfileKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRiskProfile returns all riskProfile
+func (k Keeper) GetAllRiskProfile(ctx context.Context) (list []types.RiskProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RiskProfileKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RiskProfile
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetPaymentActivity set a specific paymentActivity in the store from its index
+func (k Keeper) SetPaymentActivity(ctx context.Context, paymentActivity types.PaymentActivity) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentActivityKeyPrefix))
+	b := k.cdc.MustMarshal(&paymentActivity)
+	store.Set(types.PaymentActivityKey(
+		paymentActivity.Address,
+	), b)
+}
+
+// GetPaymentActivity returns a paymentActivity from its index
+func (k Keeper) GetPaymentActivity(
+	ctx context.Context,
+	address string,
+) (val types.PaymentActivity, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentActivityKeyPrefix))
+
+	b := store.Get(types.PaymentActivityKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPaymentActivity returns all paymentActivity
+func (k Keeper) GetAllPaymentActivity(ctx context.Context) (list []types.PaymentActivity) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentActivityKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PaymentActivity
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetCounterpartyHistory set a specific counterpartyHistory in the store from its index
+func (k Keeper) SetCounterpartyHistory(ctx context.Context, counterpartyHistory types.CounterpartyHistory) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CounterpartyHistoryKeyPrefix))
+	b := k.cdc.MustMarshal(&counterpartyHistory)
+	store.Set(types.CounterpartyHistoryKey(
+		counterpartyHistory.FromAddress,
+		counterpartyHistory.ToAddress,
+	), b)
+}
+
+// GetCounterpartyHistory returns a counterpartyHistory from its index
+func (k Keeper) GetCounterpartyHistory(
+	ctx context.Context,
+	fromAddress string,
+	toAddress string,
+) (val types.CounterpartyHistory, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CounterpartyHistoryKeyPrefix))
+
+	b := store.Get(types.CounterpartyHistoryKey(
+		fromAddress,
+		toAddress,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCounterpartyHistory returns all counterpartyHistory
+func (k Keeper) GetAllCounterpartyHistory(ctx context.Context) (list []types.CounterpartyHistory) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CounterpartyHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CounterpartyHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
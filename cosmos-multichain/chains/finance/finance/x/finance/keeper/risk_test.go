@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "finance/testutil/keeper"
+	"finance/testutil/sample"
+	"finance/x/finance/keeper"
+	"finance/x/finance/types"
+)
+
+func TestAssessRisk(t *testing.T) {
+	k, ctx := keepertest.FinanceKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	params := types.DefaultParams()
+	params.VelocityLimit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+	from, to := sample.AccAddress(), sample.AccAddress()
+	small, large := sdkmath.NewInt(10), params.LargeAmount.AddRaw(1)
+
+	score, factors := k.AssessRisk(ctx, from, to, small)
+	require.Equal(t, uint32(2_000), score)
+	require.Equal(t, []string{keeper.RiskFactorNewCounterparty}, factors)
+
+	k.RecordPayment(ctx, from, to)
+	score, factors = k.AssessRisk(ctx, from, to, large)
+	require.Equal(t, uint32(3_000), score)
+	require.Equal(t, []string{keeper.RiskFactorLargeAmount}, factors)
+
+	k.RecordPayment(ctx, from, to)
+	score, factors = k.AssessRisk(ctx, from, to, small)
+	require.Equal(t, uint32(3_000), score)
+	require.Equal(t, []string{keeper.RiskFactorHighVelocity}, factors)
+
+	// The velocity is counted over the velocity window
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(params.VelocityWindow))
+	score, _ = k.AssessRisk(later, from, to, small)
+	require.Zero(t, score)
+
+	k.SetRiskProfile(ctx, types.RiskProfile{Address: to, Score: 1_000, Restrictions: []string{types.RestrictionManualReview}})
+	score, factors = k.AssessRisk(later, from, to, large)
+	require.Equal(t, types.RiskScoreMax, score)
+	require.Equal(t, []string{keeper.RiskFactorManualReview, keeper.RiskFactorLargeAmount}, factors)
+
+	// Expired profiles are not applied
+	k.SetRiskProfile(ctx, types.RiskProfile{Address: to, Score: 9_000, ValidUntil: later.BlockTime().Unix()})
+	score, _ = k.AssessRisk(later, from, to, small)
+	require.Zero(t, score)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/screening"
+
+	"finance/contracts"
+)
+
+// Screener returns the screener of the transactions, inbound transfers and
+// contract calls of the chain, which records the blocked attempts in the
+// finance audit trail as failed screening compliance checks. The records are
+// deferred to the end of the block, out of the reverted state of the
+// attempts.
+func (k Keeper) Screener() screening.Screener {
+	return k.screeningKeeper.Journal().Defer(auditedScreener{k})
+}
+
+type auditedScreener struct {
+	k Keeper
+}
+
+func (s auditedScreener) IsScreened(ctx context.Context, party string) bool {
+	return s.k.screeningKeeper.IsScreened(ctx, party)
+}
+
+func (s auditedScreener) LogBlocked(ctx context.Context, ref, party string) {
+	s.k.screeningKeeper.LogBlocked(ctx, ref, party)
+	// the audit only fails on an empty reference, which TxRef and PacketRef never are
+	_ = financeAudit{s.k}.LogCompliance(sdk.UnwrapSDKContext(ctx), ref, contracts.ComplianceTypeScreening, false)
+}
@@ -0,0 +1,122 @@
+package finance
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "finance/api/finance/finance"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "TransactionAll",
+					Use:       "list-transaction",
+					Short:     "List all transaction",
+				},
+				{
+					RpcMethod:      "Transaction",
+					Use:            "show-transaction [transaction-id]",
+					Short:          "Shows a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "ContractRecord",
+					Use:            "show-contract-record [kind] [record-id]",
+					Short:          "Shows a record kept by the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kind"}, {ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "AuditTrail",
+					Use:            "audit-trail [subject-id]",
+					Short:          "List the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				{
+					RpcMethod:      "VerifyAuditTrail",
+					Use:            "verify-audit-trail [subject-id]",
+					Short:          "Verify the hash chain of the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				{
+					RpcMethod: "AttestationAll",
+					Use:       "list-attestation",
+					Short:     "List all compliance attestations",
+				},
+				{
+					RpcMethod:      "Attestation",
+					Use:            "show-attestation [address]",
+					Short:          "Shows the compliance attestation of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "RiskProfileAll",
+					Use:       "list-risk-profile",
+					Short:     "List all risk profiles",
+				},
+				{
+					RpcMethod:      "RiskProfile",
+					Use:            "show-risk-profile [address]",
+					Short:          "Shows the risk profile of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "InitiateTransaction",
+					Use:            "initiate-transaction [data]",
+					Short:          "Run a JSON encoded finance transaction through the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data"}},
+				},
+				{
+					RpcMethod:      "UpdateTransactionStatus",
+					Use:            "update-transaction-status [transaction-id] [status]",
+					Short:          "Update the status of a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "FinalizeTransaction",
+					Use:            "finalize-transaction [transaction-id]",
+					Short:          "Finalize a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "AttestCompliance",
+					Use:            "attest-compliance [address] [kyc-verified] [aml-checked] [jurisdiction]",
+					Short:          "Record the KYC/AML status of an address as an accredited attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "kyc_verified"}, {ProtoField: "aml_checked"}, {ProtoField: "jurisdiction"}},
+				},
+				{
+					RpcMethod:      "UpdateRiskProfile",
+					Use:            "update-risk-profile [address] [score]",
+					Short:          "Record the risk profile of an address as an accredited risk officer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "score"}},
+				},
+				{
+					RpcMethod:      "ReviewTransaction",
+					Use:            "review-transaction [transaction-id] [approve]",
+					Short:          "Release or reject a transaction held for review as an accredited risk officer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}, {ProtoField: "approve"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package finance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/finance/keeper"
+	"finance/x/finance/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the transaction
+	for _, elem := range genState.TransactionList {
+		k.SetTransaction(ctx, elem)
+	}
+	// Set all the contractRecord
+	for _, elem := range genState.ContractRecordList {
+		k.SetContractRecord(ctx, elem)
+	}
+	// Set all the auditEntry
+	for _, elem := range genState.AuditEntryList {
+		k.SetAuditEntry(ctx, elem)
+	}
+
+	// Set all the attestation
+	for _, elem := range genState.AttestationList {
+		k.SetAttestation(ctx, elem)
+	}
+
+	// Set all the riskProfile
+	for _, elem := range genState.RiskProfileList {
+		k.SetRiskProfile(ctx, elem)
+	}
+	// Set all the paymentActivity
+	for _, elem := range genState.PaymentActivityList {
+		k.SetPaymentActivity(ctx, elem)
+	}
+	// Set all the counterpartyHistory
+	for _, elem := range genState.CounterpartyHistoryList {
+		k.SetCounterpartyHistory(ctx, elem)
+	}
+
+	// Set auditEntry count
+	k.SetAuditEntryCount(ctx, genState.AuditEntryCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	if err := k.Port().BindPort(ctx); err != nil {
+		panic("could not claim port capability: " + err.Error())
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.TransactionList = k.GetAllTransaction(ctx)
+	genesis.ContractRecordList = k.GetAllContractRecord(ctx)
+	genesis.AuditEntryList = k.GetAllAuditEntry(ctx)
+	genesis.AuditEntryCount = k.GetAuditEntryCount(ctx)
+	genesis.AttestationList = k.GetAllAttestation(ctx)
+	genesis.RiskProfileList = k.GetAllRiskProfile(ctx)
+	genesis.PaymentActivityList = k.GetAllPaymentActivity(ctx)
+	genesis.CounterpartyHistoryList = k.GetAllCounterpartyHistory(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
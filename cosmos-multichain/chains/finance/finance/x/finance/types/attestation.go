@@ -0,0 +1,9 @@
+package types
+
+import "time"
+
+// IsValid reports whether the attestation clears the address for KYC and
+// AML and was last checked within validity of now
+func (a Attestation) IsValid(now time.Time, validity time.Duration) bool {
+	return a.KycVerified && a.AmlChecked && now.Before(time.Unix(a.LastChecked, 0).Add(validity))
+}
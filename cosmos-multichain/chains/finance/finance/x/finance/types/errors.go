@@ -0,0 +1,21 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/finance module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTransaction   = sdkerrors.Register(ModuleName, 1101, "invalid transaction")
+	ErrTransactionExists    = sdkerrors.Register(ModuleName, 1102, "transaction already exists")
+	ErrTransactionNotFound  = sdkerrors.Register(ModuleName, 1103, "transaction not found")
+	ErrTransactionFinalized = sdkerrors.Register(ModuleName, 1104, "transaction is finalized")
+	ErrRecordNotFound       = sdkerrors.Register(ModuleName, 1105, "contract record not found")
+	ErrInvalidAttestation   = sdkerrors.Register(ModuleName, 1106, "invalid attestation")
+	ErrNotCompliant         = sdkerrors.Register(ModuleName, 1107, "party is not attested as compliant")
+	ErrInvalidRiskProfile   = sdkerrors.Register(ModuleName, 1108, "invalid risk profile")
+	ErrTransactionNotHeld   = sdkerrors.Register(ModuleName, 1109, "transaction is not held for review")
+)
@@ -0,0 +1,27 @@
+package types
+
+// finance module event types
+const (
+	EventTypeTransactionInitiated     = "transaction_initiated"
+	EventTypeTransactionStatusUpdated = "transaction_status_updated"
+	EventTypeTransactionFinalized     = "transaction_finalized"
+	EventTypeComplianceAttested       = "compliance_attested"
+	EventTypeRiskProfileUpdated       = "risk_profile_updated"
+	EventTypeTransactionHeld          = "transaction_held"
+	EventTypeTransactionReviewed      = "transaction_reviewed"
+
+	AttributeKeyTransactionId   = "transaction_id"
+	AttributeKeyTransactionType = "transaction_type"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyStatus          = "status"
+	AttributeKeyAddress         = "address"
+	AttributeKeyAttestor        = "attestor"
+	AttributeKeyKycVerified     = "kyc_verified"
+	AttributeKeyAmlChecked      = "aml_checked"
+	AttributeKeyJurisdiction    = "jurisdiction"
+	AttributeKeyRiskOfficer     = "risk_officer"
+	AttributeKeyRiskScore       = "risk_score"
+	AttributeKeyRiskLevel       = "risk_level"
+	AttributeKeySourceChain     = "source_chain"
+	AttributeKeyApproved        = "approved"
+)
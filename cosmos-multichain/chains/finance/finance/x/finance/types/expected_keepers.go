@@ -0,0 +1,50 @@
+package types
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/screening"
+
+	lendingtypes "finance/x/lending/types"
+	paymentstypes "finance/x/payments/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	// Methods imported from account should be defined here
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	// Methods imported from bank should be defined here
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
+
+// PaymentsKeeper defines the expected interface for the payments module.
+type PaymentsKeeper interface {
+	CalculateFee(ctx context.Context, txType string, amount sdkmath.Int) sdkmath.Int
+	ProcessFinancialTransaction(ctx context.Context, tx paymentstypes.FinancialTransaction) (paymentstypes.FinancialTransaction, error)
+	GetFinancialTransaction(ctx context.Context, transactionId string) (paymentstypes.FinancialTransaction, bool)
+	SettleFinancialTransaction(ctx context.Context, transactionId string) error
+}
+
+// ScreeningKeeper defines the expected interface for the screening module.
+type ScreeningKeeper interface {
+	IsScreened(ctx context.Context, party string) bool
+	LogBlocked(ctx context.Context, ref, party string)
+	Journal() screening.Journal
+}
+
+// LendingKeeper defines the expected interface for the lending module.
+type LendingKeeper interface {
+	OpenLoan(ctx context.Context, loan lendingtypes.Loan) error
+}
@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/finance/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the finance module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                  Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransactionList         []Transaction         `protobuf:"bytes,2,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	ContractRecordList      []ContractRecord      `protobuf:"bytes,3,rep,name=contract_record_list,json=contractRecordList,proto3" json:"contract_record_list"`
+	AuditEntryList          []AuditEntry          `protobuf:"bytes,4,rep,name=audit_entry_list,json=auditEntryList,proto3" json:"audit_entry_list"`
+	AuditEntryCount         uint64                `protobuf:"varint,5,opt,name=audit_entry_count,json=auditEntryCount,proto3" json:"audit_entry_count,omitempty"`
+	AttestationList         []Attestation         `protobuf:"bytes,6,rep,name=attestation_list,json=attestationList,proto3" json:"attestation_list"`
+	RiskProfileList         []RiskProfile         `protobuf:"bytes,7,rep,name=risk_profile_list,json=riskProfileList,proto3" json:"risk_profile_list"`
+	PaymentActivityList     []PaymentActivity     `protobuf:"bytes,8,rep,name=payment_activity_list,json=paymentActivityList,proto3" json:"payment_activity_list"`
+	CounterpartyHistoryList []CounterpartyHistory `protobuf:"bytes,9,rep,name=counterparty_history_list,json=counterpartyHistoryList,proto3" json:"counterparty_history_list"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_276a52e67edc17b5, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTransactionList() []Transaction {
+	if m != nil {
+		return m.TransactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRecordList() []ContractRecord {
+	if m != nil {
+		return m.ContractRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryList() []AuditEntry {
+	if m != nil {
+		return m.AuditEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryCount() uint64 {
+	if m != nil {
+		return m.AuditEntryCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttestationList() []Attestation {
+	if m != nil {
+		return m.AttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRiskProfileList() []RiskProfile {
+	if m != nil {
+		return m.RiskProfileList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymentActivityList() []PaymentActivity {
+	if m != nil {
+		return m.PaymentActivityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCounterpartyHistoryList() []CounterpartyHistory {
+	if m != nil {
+		return m.CounterpartyHistoryList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "finance.finance.GenesisState")
+}
+
+func init() { proto.RegisterFile("finance/finance/genesis.proto", fileDescriptor_276a52e67edc17b5) }
+
+var fileDescriptor_276a52e67edc17b5 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0x34, 0x0d, 0xd4, 0x45, 0xa4, 0x59, 0x8a, 0x12, 0x42, 0xd9, 0x06, 0xc4, 0x21,
+	0xea, 0x21, 0x11, 0xe5, 0xc6, 0x2d, 0xa9, 0x10, 0x48, 0xfc, 0x51, 0xb5, 0x20, 0x21, 0xf5, 0xb2,
+	0x32, 0xae, 0x13, 0xac, 0x36, 0xf6, 0xca, 0x9e, 0x22, 0xf2, 0x16, 0x3c, 0x06, 0x47, 0x1e, 0xa3,
+	0x27, 0xd4, 0x23, 0x27, 0x84, 0x92, 0x03, 0xaf, 0x81, 0x3c, 0xf6, 0xb2, 0xdb, 0x6c, 0xf6, 0xb2,
+	0x6b, 0x7d, 0xf3, 0xcd, 0x6f, 0xec, 0x19, 0x9b, 0x3c, 0x9c, 0x08, 0x49, 0x25, 0xe3, 0xc3, 0xec,
+	0x3f, 0xe5, 0x92, 0x1b, 0x61, 0x06, 0xa9, 0x56, 0xa0, 0xc2, 0xa6, 0x97, 0x07, 0xfe, 0xdf, 0x6d,
+	0xd1, 0x99, 0x90, 0x6a, 0x88, 0x5f, 0xe7, 0xe9, 0xee, 0x4e, 0xd5, 0x54, 0xe1, 0x72, 0x68, 0x57,
+	0x5e, 0xdd, 0x5b, 0x05, 0xa7, 0x54, 0xd3, 0x99, 0xe7, 0x76, 0x1f, 0xad, 0x46, 0x41, 0x53, 0x69,
+	0x28, 0x03, 0xa1, 0x64, 0x15, 0x40, 0x73, 0xa6, 0xf4, 0x69, 0x15, 0x80, 0x02, 0x70, 0x03, 0xb4,
+	0x00, 0xe8, 0x96, 0x00, 0xc2, 0x9c, 0xb9, 0xd8, 0xe3, 0x9f, 0x9b, 0xe4, 0xf6, 0x4b, 0x77, 0xd2,
+	0xf7, 0x40, 0x81, 0x87, 0xcf, 0x49, 0xc3, 0x6d, 0xb0, 0x13, 0xf4, 0x82, 0xfe, 0xf6, 0x61, 0x7b,
+	0xb0, 0x72, 0xf2, 0xc1, 0x31, 0x86, 0xc7, 0x5b, 0x97, 0xbf, 0xf7, 0x6b, 0xdf, 0xff, 0xfe, 0x38,
+	0x08, 0x62, 0x9f, 0x11, 0xbe, 0x25, 0x3b, 0x85, 0xed, 0x27, 0xe7, 0xc2, 0x40, 0xe7, 0x46, 0x6f,
+	0xa3, 0xbf, 0x7d, 0xb8, 0x57, 0xa2, 0x7c, 0xc8, 0x8d, 0xe3, 0xba, 0x45, 0xc5, 0xcd, 0x42, 0xee,
+	0x1b, 0x61, 0x20, 0xfc, 0x48, 0x76, 0x99, 0x92, 0xa0, 0x29, 0x83, 0xc4, 0x9d, 0xd9, 0x21, 0x37,
+	0x10, 0xb9, 0x5f, 0x42, 0x1e, 0x79, 0x73, 0x8c, 0x5e, 0x4f, 0x0d, 0xd9, 0x35, 0x15, 0xc1, 0xaf,
+	0xc9, 0x0e, 0xbd, 0x38, 0x15, 0x90, 0x70, 0x09, 0x7a, 0xee, 0xa0, 0x75, 0x84, 0x3e, 0x28, 0x41,
+	0x47, 0xd6, 0xf8, 0xc2, 0xfa, 0x3c, 0xf0, 0x0e, 0xfd, 0xaf, 0x20, 0xec, 0x80, 0xb4, 0x8a, 0x30,
+	0xa6, 0x2e, 0x24, 0x74, 0x36, 0x7b, 0x41, 0xbf, 0x1e, 0x37, 0x73, 0xeb, 0x91, 0x95, 0x6d, 0x83,
+	0x0a, 0xe3, 0x71, 0x85, 0x1b, 0x15, 0x0d, 0x1a, 0xe5, 0xc6, 0xac, 0x41, 0x85, 0x5c, 0x2c, 0xfd,
+	0x8e, 0xb4, 0xec, 0x28, 0x93, 0x54, 0xab, 0x89, 0x38, 0xe7, 0x8e, 0x77, 0xb3, 0x82, 0x17, 0x0b,
+	0x73, 0x76, 0xec, 0x8c, 0x19, 0x4f, 0xe7, 0x12, 0xf2, 0x4e, 0xc8, 0xbd, 0x94, 0xce, 0x67, 0x5c,
+	0x42, 0x62, 0xc7, 0xf0, 0x45, 0x80, 0x6f, 0xce, 0x2d, 0x64, 0xf6, 0xd6, 0x5c, 0x05, 0x74, 0x8f,
+	0xbc, 0xd9, 0x73, 0xef, 0xa6, 0xd7, 0x65, 0x64, 0x4f, 0xc8, 0x7d, 0x6c, 0x0d, 0xd7, 0x29, 0xd5,
+	0x30, 0x4f, 0x3e, 0x0b, 0x03, 0x2a, 0x6b, 0xfe, 0x16, 0xf2, 0x9f, 0xac, 0x99, 0x68, 0x9e, 0xf1,
+	0xca, 0x25, 0xf8, 0x1a, 0x6d, 0x56, 0x0e, 0xd9, 0x3a, 0xe3, 0xa7, 0x97, 0x8b, 0x28, 0xb8, 0x5a,
+	0x44, 0xc1, 0x9f, 0x45, 0x14, 0x7c, 0x5b, 0x46, 0xb5, 0xab, 0x65, 0x54, 0xfb, 0xb5, 0x8c, 0x6a,
+	0x27, 0xed, 0xec, 0xfa, 0x7f, 0xcd, 0x1f, 0xdb, 0x3c, 0xe5, 0xe6, 0x53, 0x03, 0x9f, 0xc2, 0xb3,
+	0x7f, 0x03, 0x00, 0xab, 0x07, 0xc8, 0x12, 0x03, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyHistoryList) > 0 {
+		for iNdEx := len(m.CounterpartyHistoryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyHistoryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PaymentActivityList) > 0 {
+		for iNdEx := len(m.PaymentActivityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentActivityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RiskProfileList) > 0 {
+		for iNdEx := len(m.RiskProfileList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RiskProfileList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for iNdEx := len(m.AttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditEntryCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuditEntryList) > 0 {
+		for iNdEx := len(m.AuditEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for iNdEx := len(m.ContractRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for _, e := range m.ContractRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntryList) > 0 {
+		for _, e := range m.AuditEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditEntryCount))
+	}
+	if len(m.AttestationList) > 0 {
+		for _, e := range m.AttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RiskProfileList) > 0 {
+		for _, e := range m.RiskProfileList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PaymentActivityList) > 0 {
+		for _, e := range m.PaymentActivityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CounterpartyHistoryList) > 0 {
+		for _, e := range m.CounterpartyHistoryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRecordList = append(m.ContractRecordList, ContractRecord{})
+			if err := m.ContractRecordList[len(m.ContractRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntryList = append(m.AuditEntryList, AuditEntry{})
+			if err := m.AuditEntryList[len(m.AuditEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryCount", wireType)
+			}
+			m.AuditEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationList = append(m.AttestationList, Attestation{})
+			if err := m.AttestationList[len(m.AttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskProfileList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskProfileList = append(m.RiskProfileList, RiskProfile{})
+			if err := m.RiskProfileList[len(m.RiskProfileList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentActivityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentActivityList = append(m.PaymentActivityList, PaymentActivity{})
+			if err := m.PaymentActivityList[len(m.PaymentActivityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyHistoryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyHistoryList = append(m.CounterpartyHistoryList, CounterpartyHistory{})
+			if err := m.CounterpartyHistoryList[len(m.CounterpartyHistoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
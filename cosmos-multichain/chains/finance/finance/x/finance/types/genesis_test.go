@@ -0,0 +1,176 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/example/cosmos-multichain/audit"
+	"github.com/stretchr/testify/require"
+
+	"finance/x/finance/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	first := types.NewAuditEntry(0, "0", "", "", 0, nil, audit.Hash(nil, 0, "0", "", "", 0))
+	second := types.NewAuditEntry(1, "0", "", "", 0, first.Hash, audit.Hash(first.Hash, 1, "0", "", "", 0))
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "1"},
+				},
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "b", RecordId: "0"},
+				},
+				AuditEntryList:  []types.AuditEntry{first, second},
+				AuditEntryCount: 2,
+				AttestationList: []types.Attestation{
+					{Address: "0"},
+					{Address: "1"},
+				},
+				RiskProfileList: []types.RiskProfile{
+					{Address: "0", Score: types.RiskScoreMax},
+					{Address: "1"},
+				},
+				PaymentActivityList: []types.PaymentActivity{
+					{Address: "0"},
+					{Address: "1"},
+				},
+				CounterpartyHistoryList: []types.CounterpartyHistory{
+					{FromAddress: "0", ToAddress: "1"},
+					{FromAddress: "1", ToAddress: "0"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated transaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contractRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "a", RecordId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auditEntry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0},
+					{Id: 0},
+				},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auditEntry count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 1},
+				},
+				AuditEntryCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated attestation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AttestationList: []types.Attestation{
+					{Address: "0"},
+					{Address: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid attestor",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"invalid"}, types.DefaultAttestationValidity, types.DefaultComplianceThresholds,
+					types.DefaultRiskOfficers, types.DefaultRiskThresholds, types.DefaultLargeAmount, types.DefaultVelocityWindow, types.DefaultVelocityLimit,
+					types.DefaultCounterparties),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated riskProfile",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RiskProfileList: []types.RiskProfile{
+					{Address: "0"},
+					{Address: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid risk score",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RiskProfileList: []types.RiskProfile{
+					{Address: "0", Score: types.RiskScoreMax + 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated counterpartyHistory",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CounterpartyHistoryList: []types.CounterpartyHistory{
+					{FromAddress: "0", ToAddress: "1"},
+					{FromAddress: "0", ToAddress: "1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rewritten auditEntry",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				AuditEntryList:  []types.AuditEntry{first, types.NewAuditEntry(1, "0", "", "rewritten", 0, first.Hash, second.Hash)},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
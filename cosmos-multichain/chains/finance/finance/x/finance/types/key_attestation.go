@@ -0,0 +1,19 @@
+package types
+
+const (
+	// AttestationKeyPrefix is the prefix to retrieve all Attestation
+	AttestationKeyPrefix = "Attestation/value/"
+)
+
+// AttestationKey returns the store key to retrieve an Attestation from the index fields
+func AttestationKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
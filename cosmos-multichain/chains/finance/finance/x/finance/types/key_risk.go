@@ -0,0 +1,56 @@
+package types
+
+const (
+	// RiskProfileKeyPrefix is the prefix to retrieve all RiskProfile
+	RiskProfileKeyPrefix = "RiskProfile/value/"
+
+	// PaymentActivityKeyPrefix is the prefix to retrieve all PaymentActivity
+	PaymentActivityKeyPrefix = "PaymentActivity/value/"
+
+	// CounterpartyHistoryKeyPrefix is the prefix to retrieve all CounterpartyHistory
+	CounterpartyHistoryKeyPrefix = "CounterpartyHistory/value/"
+)
+
+// RiskProfileKey returns the store key to retrieve a RiskProfile from the index fields
+func RiskProfileKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PaymentActivityKey returns the store key to retrieve a PaymentActivity from the index fields
+func PaymentActivityKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CounterpartyHistoryKey returns the store key to retrieve a CounterpartyHistory from the index fields
+func CounterpartyHistoryKey(
+	fromAddress string,
+	toAddress string,
+) []byte {
+	var key []byte
+
+	fromAddressBytes := []byte(fromAddress)
+	key = append(key, fromAddressBytes...)
+	key = append(key, []byte("/")...)
+
+	toAddressBytes := []byte(toAddress)
+	key = append(key, toAddressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestCompliance{}
+
+func NewMsgAttestCompliance(
+	creator string,
+	address string,
+	kycVerified bool,
+	amlChecked bool,
+	jurisdiction string,
+) *MsgAttestCompliance {
+	return &MsgAttestCompliance{
+		Creator:      creator,
+		Address:      address,
+		KycVerified:  kycVerified,
+		AmlChecked:   amlChecked,
+		Jurisdiction: jurisdiction,
+	}
+}
+
+func (msg *MsgAttestCompliance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attested address (%s)", err)
+	}
+	if msg.Jurisdiction == "" {
+		return errorsmod.Wrap(ErrInvalidAttestation, "jurisdiction cannot be empty")
+	}
+	return nil
+}
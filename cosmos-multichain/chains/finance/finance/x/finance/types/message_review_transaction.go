@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReviewTransaction{}
+
+func NewMsgReviewTransaction(
+	creator string,
+	transactionId string,
+	approve bool,
+) *MsgReviewTransaction {
+	return &MsgReviewTransaction{
+		Creator:       creator,
+		TransactionId: transactionId,
+		Approve:       approve,
+	}
+}
+
+func (msg *MsgReviewTransaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TransactionId == "" {
+		return errorsmod.Wrap(ErrInvalidTransaction, "transaction id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateRiskProfile{}
+
+func NewMsgUpdateRiskProfile(
+	creator string,
+	address string,
+	score uint32,
+	factors []string,
+	validUntil int64,
+	restrictions []string,
+) *MsgUpdateRiskProfile {
+	return &MsgUpdateRiskProfile{
+		Creator:      creator,
+		Address:      address,
+		Score:        score,
+		Factors:      factors,
+		ValidUntil:   validUntil,
+		Restrictions: restrictions,
+	}
+}
+
+func (msg *MsgUpdateRiskProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Address == "" {
+		return errorsmod.Wrap(ErrInvalidRiskProfile, "address cannot be empty")
+	}
+	if msg.Score > RiskScoreMax {
+		return errorsmod.Wrapf(ErrInvalidRiskProfile, "score cannot exceed %d", RiskScoreMax)
+	}
+	if msg.ValidUntil < 0 {
+		return errorsmod.Wrap(ErrInvalidRiskProfile, "valid until cannot be negative")
+	}
+	return nil
+}
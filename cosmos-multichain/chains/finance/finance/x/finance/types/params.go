@@ -0,0 +1,374 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"finance/contracts/interfaces"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyAttestors = []byte("Attestors")
+	// DefaultAttestors is empty; attestor accounts are accredited at genesis or by governance
+	DefaultAttestors = []string{}
+)
+
+var (
+	KeyAttestationValidity = []byte("AttestationValidity")
+	// DefaultAttestationValidity requires parties to be checked again every year
+	DefaultAttestationValidity = 8760 * time.Hour
+)
+
+var (
+	KeyComplianceThresholds = []byte("ComplianceThresholds")
+	// DefaultComplianceThresholds let payments and transfers of up to 10000
+	// move between unattested parties
+	DefaultComplianceThresholds = []ComplianceThreshold{
+		{TxType: "PAYMENT", Amount: sdkmath.NewInt(10_000)},
+		{TxType: "TRANSFER", Amount: sdkmath.NewInt(10_000)},
+	}
+)
+
+var (
+	KeyRiskOfficers = []byte("RiskOfficers")
+	// DefaultRiskOfficers is empty; risk officers are accredited at genesis or by governance
+	DefaultRiskOfficers = []string{}
+)
+
+var (
+	KeyRiskThresholds = []byte("RiskThresholds")
+	// DefaultRiskThresholds limit high risk transactions to 10000 and medium
+	// risk ones to 1000000
+	DefaultRiskThresholds = []RiskThreshold{
+		{RiskLevel: interfaces.RiskLevelMedium, Amount: sdkmath.NewInt(1_000_000)},
+		{RiskLevel: interfaces.RiskLevelHigh, Amount: sdkmath.NewInt(10_000)},
+	}
+)
+
+var (
+	KeyLargeAmount = []byte("LargeAmount")
+	// DefaultLargeAmount scores transactions above 100000 as large
+	DefaultLargeAmount = sdkmath.NewInt(100_000)
+)
+
+var (
+	KeyVelocityWindow = []byte("VelocityWindow")
+	// DefaultVelocityWindow counts the payments of a sender over a day
+	DefaultVelocityWindow = 24 * time.Hour
+)
+
+var (
+	KeyVelocityLimit = []byte("VelocityLimit")
+	// DefaultVelocityLimit lets a sender make 10 payments a day before they
+	// are scored as high velocity
+	DefaultVelocityLimit uint64 = 10
+)
+
+var (
+	KeyCounterparties = []byte("Counterparties")
+	// DefaultCounterparties are the chains of the network the finance
+	// contract exchanges messages with
+	DefaultCounterparties = []Counterparty{
+		{ChainId: "bloqz-insurance-1", Chain: interchain.ChainInsurance},
+		{ChainId: "bloqz-realestate-1", Chain: interchain.ChainRealEstate},
+		{ChainId: "bloqz-retail-1", Chain: interchain.ChainRetail},
+	}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	attestors []string,
+	attestationValidity time.Duration,
+	complianceThresholds []ComplianceThreshold,
+	riskOfficers []string,
+	riskThresholds []RiskThreshold,
+	largeAmount sdkmath.Int,
+	velocityWindow time.Duration,
+	velocityLimit uint64,
+	counterparties []Counterparty,
+) Params {
+	return Params{
+		Attestors:            attestors,
+		AttestationValidity:  attestationValidity,
+		ComplianceThresholds: complianceThresholds,
+		RiskOfficers:         riskOfficers,
+		RiskThresholds:       riskThresholds,
+		LargeAmount:          largeAmount,
+		VelocityWindow:       velocityWindow,
+		VelocityLimit:        velocityLimit,
+		Counterparties:       counterparties,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultAttestors,
+		DefaultAttestationValidity,
+		DefaultComplianceThresholds,
+		DefaultRiskOfficers,
+		DefaultRiskThresholds,
+		DefaultLargeAmount,
+		DefaultVelocityWindow,
+		DefaultVelocityLimit,
+		DefaultCounterparties,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAttestors, &p.Attestors, validateAttestors),
+		paramtypes.NewParamSetPair(KeyAttestationValidity, &p.AttestationValidity, validateAttestationValidity),
+		paramtypes.NewParamSetPair(KeyComplianceThresholds, &p.ComplianceThresholds, validateComplianceThresholds),
+		paramtypes.NewParamSetPair(KeyRiskOfficers, &p.RiskOfficers, validateRiskOfficers),
+		paramtypes.NewParamSetPair(KeyRiskThresholds, &p.RiskThresholds, validateRiskThresholds),
+		paramtypes.NewParamSetPair(KeyLargeAmount, &p.LargeAmount, validateLargeAmount),
+		paramtypes.NewParamSetPair(KeyVelocityWindow, &p.VelocityWindow, validateVelocityWindow),
+		paramtypes.NewParamSetPair(KeyVelocityLimit, &p.VelocityLimit, validateVelocityLimit),
+		paramtypes.NewParamSetPair(KeyCounterparties, &p.Counterparties, validateCounterparties),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateAttestors(p.Attestors); err != nil {
+		return err
+	}
+	if err := validateAttestationValidity(p.AttestationValidity); err != nil {
+		return err
+	}
+	if err := validateComplianceThresholds(p.ComplianceThresholds); err != nil {
+		return err
+	}
+	if err := validateRiskOfficers(p.RiskOfficers); err != nil {
+		return err
+	}
+	if err := validateRiskThresholds(p.RiskThresholds); err != nil {
+		return err
+	}
+	if err := validateLargeAmount(p.LargeAmount); err != nil {
+		return err
+	}
+	if err := validateVelocityWindow(p.VelocityWindow); err != nil {
+		return err
+	}
+	if err := validateVelocityLimit(p.VelocityLimit); err != nil {
+		return err
+	}
+	return validateCounterparties(p.Counterparties)
+}
+
+// IsAttestor reports whether addr is accredited to attest compliance
+func (p Params) IsAttestor(addr string) bool {
+	for _, a := range p.Attestors {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRiskOfficer reports whether addr is accredited to record risk profiles
+// and review held transactions
+func (p Params) IsRiskOfficer(addr string) bool {
+	for _, o := range p.RiskOfficers {
+		if o == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// RiskLimit returns the largest amount a transaction of riskLevel may move,
+// and false if the risk level is not limited
+func (p Params) RiskLimit(riskLevel string) (sdkmath.Int, bool) {
+	for _, threshold := range p.RiskThresholds {
+		if threshold.RiskLevel == riskLevel {
+			return threshold.Amount, true
+		}
+	}
+	return sdkmath.Int{}, false
+}
+
+// RequiresAttestation reports whether a transaction of txType moving amount
+// needs attested parties. Transaction types without a threshold always do.
+func (p Params) RequiresAttestation(txType string, amount sdkmath.Int) bool {
+	for _, threshold := range p.ComplianceThresholds {
+		if threshold.TxType == txType {
+			return amount.GT(threshold.Amount)
+		}
+	}
+	return true
+}
+
+// CounterpartyChain returns the chain of a counterparty chain ID
+func (p Params) CounterpartyChain(chainId string) (string, bool) {
+	for _, counterparty := range p.Counterparties {
+		if counterparty.ChainId == chainId {
+			return counterparty.Chain, true
+		}
+	}
+	return "", false
+}
+
+// validateAttestors validates the Attestors param
+func validateAttestors(v interface{}) error {
+	attestors, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(attestors))
+	for _, addr := range attestors {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid attestor address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicated attestor %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// validateRiskOfficers validates the RiskOfficers param
+func validateRiskOfficers(v interface{}) error {
+	officers, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(officers))
+	for _, addr := range officers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid risk officer address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicated risk officer %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// validateAttestationValidity validates the AttestationValidity param
+func validateAttestationValidity(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("attestation validity must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateComplianceThresholds validates the ComplianceThresholds param
+func validateComplianceThresholds(v interface{}) error {
+	thresholds, ok := v.([]ComplianceThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	txTypes := make(map[string]struct{}, len(thresholds))
+	for _, threshold := range thresholds {
+		if threshold.TxType == "" {
+			return fmt.Errorf("compliance threshold transaction type cannot be empty")
+		}
+		if _, ok := txTypes[threshold.TxType]; ok {
+			return fmt.Errorf("duplicated compliance threshold for %s", threshold.TxType)
+		}
+		txTypes[threshold.TxType] = struct{}{}
+		if threshold.Amount.IsNil() || threshold.Amount.IsNegative() {
+			return fmt.Errorf("compliance threshold of %s cannot be negative", threshold.TxType)
+		}
+	}
+	return nil
+}
+
+// validateRiskThresholds validates the RiskThresholds param
+func validateRiskThresholds(v interface{}) error {
+	thresholds, ok := v.([]RiskThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	levels := make(map[string]struct{}, len(thresholds))
+	for _, threshold := range thresholds {
+		if !IsRiskLevel(threshold.RiskLevel) {
+			return fmt.Errorf("invalid risk threshold level %q", threshold.RiskLevel)
+		}
+		if _, ok := levels[threshold.RiskLevel]; ok {
+			return fmt.Errorf("duplicated risk threshold for %s", threshold.RiskLevel)
+		}
+		levels[threshold.RiskLevel] = struct{}{}
+		if threshold.Amount.IsNil() || threshold.Amount.IsNegative() {
+			return fmt.Errorf("risk threshold of %s cannot be negative", threshold.RiskLevel)
+		}
+	}
+	return nil
+}
+
+// validateLargeAmount validates the LargeAmount param
+func validateLargeAmount(v interface{}) error {
+	amount, ok := v.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return fmt.Errorf("large amount must be positive")
+	}
+	return nil
+}
+
+// validateVelocityWindow validates the VelocityWindow param
+func validateVelocityWindow(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("velocity window must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateVelocityLimit validates the VelocityLimit param
+func validateVelocityLimit(v interface{}) error {
+	limit, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if limit == 0 {
+		return fmt.Errorf("velocity limit must be positive")
+	}
+	return nil
+}
+
+// validateCounterparties validates the Counterparties param
+func validateCounterparties(v interface{}) error {
+	counterparties, ok := v.([]Counterparty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chainIds := make(map[string]struct{}, len(counterparties))
+	for _, counterparty := range counterparties {
+		if counterparty.ChainId == "" || counterparty.Chain == "" {
+			return fmt.Errorf("counterparty needs a chain id and a chain")
+		}
+		if _, ok := chainIds[counterparty.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty %s", counterparty.ChainId)
+		}
+		chainIds[counterparty.ChainId] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,1614 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/finance/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// attestors are the accounts accredited to attest KYC/AML compliance.
+	Attestors []string `protobuf:"bytes,1,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// attestation_validity is how long an attestation stays valid after it
+	// was last checked.
+	AttestationValidity time.Duration `protobuf:"bytes,2,opt,name=attestation_validity,json=attestationValidity,proto3,stdduration" json:"attestation_validity"`
+	// compliance_thresholds are the amounts above which both parties of a
+	// transaction need a valid attestation. Transaction types without a
+	// threshold always need one.
+	ComplianceThresholds []ComplianceThreshold `protobuf:"bytes,3,rep,name=compliance_thresholds,json=complianceThresholds,proto3" json:"compliance_thresholds"`
+	// risk_officers are the accounts accredited to record risk profiles and
+	// to review the transactions held because of their risk.
+	RiskOfficers []string `protobuf:"bytes,4,rep,name=risk_officers,json=riskOfficers,proto3" json:"risk_officers,omitempty"`
+	// risk_thresholds are the largest amounts transactions may move at each
+	// risk level. Risk levels without a threshold are not limited.
+	RiskThresholds []RiskThreshold `protobuf:"bytes,5,rep,name=risk_thresholds,json=riskThresholds,proto3" json:"risk_thresholds"`
+	// large_amount is the amount above which a transaction is scored as large.
+	LargeAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=large_amount,json=largeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"large_amount"`
+	// velocity_window is the period over which the payments of a sender are
+	// counted.
+	VelocityWindow time.Duration `protobuf:"bytes,7,opt,name=velocity_window,json=velocityWindow,proto3,stdduration" json:"velocity_window"`
+	// velocity_limit is the number of payments a sender may make in a
+	// velocity window before its payments are scored as high velocity.
+	VelocityLimit uint64 `protobuf:"varint,8,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`
+	// counterparties are the chains allowed to open interchain channels with
+	// the finance contract.
+	Counterparties []Counterparty `protobuf:"bytes,9,rep,name=counterparties,proto3" json:"counterparties"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc844704ba7954f9, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+func (m *Params) GetAttestationValidity() time.Duration {
+	if m != nil {
+		return m.AttestationValidity
+	}
+	return 0
+}
+
+func (m *Params) GetComplianceThresholds() []ComplianceThreshold {
+	if m != nil {
+		return m.ComplianceThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetRiskOfficers() []string {
+	if m != nil {
+		return m.RiskOfficers
+	}
+	return nil
+}
+
+func (m *Params) GetRiskThresholds() []RiskThreshold {
+	if m != nil {
+		return m.RiskThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetVelocityWindow() time.Duration {
+	if m != nil {
+		return m.VelocityWindow
+	}
+	return 0
+}
+
+func (m *Params) GetVelocityLimit() uint64 {
+	if m != nil {
+		return m.VelocityLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCounterparties() []Counterparty {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
+}
+
+// ComplianceThreshold is the largest amount of a transaction type that may
+// move without attested parties.
+type ComplianceThreshold struct {
+	TxType string                `protobuf:"bytes,1,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ComplianceThreshold) Reset()         { *m = ComplianceThreshold{} }
+func (m *ComplianceThreshold) String() string { return proto.CompactTextString(m) }
+func (*ComplianceThreshold) ProtoMessage()    {}
+func (*ComplianceThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc844704ba7954f9, []int{1}
+}
+func (m *ComplianceThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComplianceThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComplianceThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComplianceThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplianceThreshold.Merge(m, src)
+}
+func (m *ComplianceThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComplianceThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplianceThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplianceThreshold proto.InternalMessageInfo
+
+func (m *ComplianceThreshold) GetTxType() string {
+	if m != nil {
+		return m.TxType
+	}
+	return ""
+}
+
+// RiskThreshold is the largest amount a transaction assessed at a risk level
+// may move.
+type RiskThreshold struct {
+	RiskLevel string                `protobuf:"bytes,1,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RiskThreshold) Reset()         { *m = RiskThreshold{} }
+func (m *RiskThreshold) String() string { return proto.CompactTextString(m) }
+func (*RiskThreshold) ProtoMessage()    {}
+func (*RiskThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc844704ba7954f9, []int{2}
+}
+func (m *RiskThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RiskThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RiskThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RiskThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RiskThreshold.Merge(m, src)
+}
+func (m *RiskThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *RiskThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_RiskThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RiskThreshold proto.InternalMessageInfo
+
+func (m *RiskThreshold) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+// Counterparty maps the chain ID of a counterparty to the chain its messages
+// are routed for.
+type Counterparty struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Chain   string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *Counterparty) Reset()         { *m = Counterparty{} }
+func (m *Counterparty) String() string { return proto.CompactTextString(m) }
+func (*Counterparty) ProtoMessage()    {}
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc844704ba7954f9, []int{3}
+}
+func (m *Counterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Counterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Counterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Counterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Counterparty.Merge(m, src)
+}
+func (m *Counterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Counterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Counterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Counterparty proto.InternalMessageInfo
+
+func (m *Counterparty) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Counterparty) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "finance.finance.Params")
+	proto.RegisterType((*ComplianceThreshold)(nil), "finance.finance.ComplianceThreshold")
+	proto.RegisterType((*RiskThreshold)(nil), "finance.finance.RiskThreshold")
+	proto.RegisterType((*Counterparty)(nil), "finance.finance.Counterparty")
+}
+
+func init() { proto.RegisterFile("finance/finance/params.proto", fileDescriptor_bc844704ba7954f9) }
+
+var fileDescriptor_bc844704ba7954f9 = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x42, 0x29, 0x74, 0xa0, 0x90, 0xdf, 0x50, 0xc2, 0x42, 0x7e, 0x6c, 0x2b, 0xd1, 0xa4,
+	0x21, 0x61, 0xab, 0x98, 0x78, 0x20, 0xf1, 0x40, 0xd5, 0x44, 0x12, 0x8c, 0xb8, 0x10, 0x4d, 0xf4,
+	0xb0, 0x19, 0x76, 0xa7, 0xdb, 0x09, 0xbb, 0x3b, 0x9b, 0x99, 0xe1, 0x4f, 0x2f, 0x7c, 0x00, 0x4f,
+	0x1e, 0x3d, 0x7a, 0xf4, 0xc8, 0x81, 0x0f, 0xc1, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x01, 0x3f,
+	0x86, 0x99, 0x3f, 0x5b, 0xcb, 0x9f, 0xc4, 0x68, 0xbc, 0x74, 0xf7, 0x7d, 0xe6, 0x79, 0xdf, 0xe7,
+	0xe9, 0x33, 0xef, 0x82, 0xff, 0xdb, 0x24, 0x45, 0x69, 0x80, 0x9b, 0xf9, 0x33, 0x43, 0x0c, 0x25,
+	0xdc, 0xcd, 0x18, 0x15, 0x14, 0x4e, 0x18, 0xd4, 0x35, 0xcf, 0xd9, 0xff, 0x50, 0x42, 0x52, 0xda,
+	0x54, 0xbf, 0x9a, 0x33, 0x3b, 0x13, 0x50, 0x9e, 0x50, 0xee, 0xab, 0xaa, 0xa9, 0x0b, 0x73, 0x54,
+	0x8d, 0x68, 0x44, 0x35, 0x2e, 0xdf, 0x0c, 0xea, 0x44, 0x94, 0x46, 0x31, 0x6e, 0xaa, 0x6a, 0x6b,
+	0xa7, 0xdd, 0x0c, 0x77, 0x18, 0x12, 0x84, 0xa6, 0xfa, 0x7c, 0xfe, 0x64, 0x08, 0x94, 0xd6, 0x95,
+	0x0b, 0xf8, 0x08, 0x94, 0x91, 0x10, 0x98, 0x0b, 0xca, 0xb8, 0x6d, 0xd5, 0x07, 0x1b, 0xe5, 0x96,
+	0x7d, 0x7a, 0xb4, 0x58, 0x35, 0x2a, 0x2b, 0x61, 0xc8, 0x30, 0xe7, 0x1b, 0x82, 0x91, 0x34, 0xf2,
+	0x7e, 0x51, 0xe1, 0x3b, 0x50, 0xd5, 0x85, 0x9a, 0xeb, 0xef, 0xa2, 0x98, 0x84, 0x44, 0x74, 0xed,
+	0x81, 0xba, 0xd5, 0x18, 0x5d, 0x9a, 0x71, 0xb5, 0x03, 0x37, 0x77, 0xe0, 0x3e, 0x35, 0x0e, 0x5a,
+	0x95, 0xe3, 0xb3, 0x5a, 0xe1, 0xe3, 0xb7, 0x9a, 0xf5, 0xf9, 0xf2, 0x70, 0xc1, 0xf2, 0x26, 0xfb,
+	0xa6, 0xbc, 0x36, 0x43, 0xa0, 0x0f, 0xa6, 0x02, 0x9a, 0x64, 0x31, 0x91, 0x89, 0xf8, 0xa2, 0xc3,
+	0x30, 0xef, 0xd0, 0x38, 0xe4, 0xf6, 0x60, 0x7d, 0xb0, 0x31, 0xba, 0x74, 0xd7, 0xbd, 0x16, 0x9a,
+	0xfb, 0xa4, 0xc7, 0xde, 0xcc, 0xc9, 0xad, 0xa2, 0x14, 0xf2, 0xaa, 0xc1, 0xcd, 0x23, 0x0e, 0x1f,
+	0x83, 0x0a, 0x23, 0x7c, 0xdb, 0xa7, 0xed, 0x36, 0x09, 0x30, 0xe3, 0x76, 0xf1, 0x37, 0xff, 0x7c,
+	0x4c, 0xd2, 0x5f, 0x1a, 0x36, 0x7c, 0x01, 0x26, 0x54, 0x7b, 0x9f, 0xb3, 0x21, 0xe5, 0xcc, 0xb9,
+	0xe1, 0xcc, 0x23, 0x7c, 0xfb, 0xba, 0xa7, 0x71, 0xd6, 0x0f, 0x72, 0xb8, 0x01, 0xc6, 0x62, 0xc4,
+	0x22, 0xec, 0xa3, 0x84, 0xee, 0xa4, 0xc2, 0x2e, 0xd5, 0xad, 0x46, 0xb9, 0x75, 0x5f, 0x72, 0xbf,
+	0x9e, 0xd5, 0xa6, 0xb4, 0x21, 0x1e, 0x6e, 0xbb, 0x84, 0x36, 0x13, 0x24, 0x3a, 0xee, 0x6a, 0x2a,
+	0x4e, 0x8f, 0x16, 0x81, 0x71, 0xba, 0x9a, 0x0a, 0x9d, 0xe5, 0xa8, 0x9a, 0xb2, 0xa2, 0x86, 0xc0,
+	0x57, 0x60, 0x62, 0x17, 0xc7, 0x34, 0x20, 0xa2, 0xeb, 0xef, 0x91, 0x34, 0xa4, 0x7b, 0xf6, 0xf0,
+	0x1f, 0xde, 0xcd, 0x78, 0x3e, 0xe0, 0x8d, 0xea, 0x87, 0xf7, 0x40, 0x0f, 0xf1, 0x63, 0x92, 0x10,
+	0x61, 0x8f, 0xd4, 0xad, 0x46, 0xd1, 0xab, 0xe4, 0xe8, 0x9a, 0x04, 0xe1, 0x3a, 0x18, 0x0f, 0xa4,
+	0x05, 0xcc, 0x32, 0xc4, 0x04, 0xc1, 0xdc, 0x2e, 0xab, 0x70, 0xe6, 0x6e, 0xb9, 0xb6, 0x1e, 0xad,
+	0xdb, 0x2a, 0x4b, 0x71, 0x23, 0x7c, 0xb5, 0x7f, 0xf9, 0xce, 0x8f, 0x4f, 0x35, 0xeb, 0xfd, 0xe5,
+	0xe1, 0x82, 0x9d, 0x7f, 0x43, 0xfb, 0xbd, 0xaf, 0x49, 0xef, 0xf1, 0xfc, 0x01, 0x98, 0xbc, 0x65,
+	0x09, 0xe0, 0x34, 0x18, 0x16, 0xfb, 0xbe, 0xe8, 0x66, 0xd8, 0xb6, 0x64, 0xaa, 0x5e, 0x49, 0xec,
+	0x6f, 0x76, 0x33, 0x0c, 0x9f, 0x83, 0x92, 0x49, 0x7b, 0xe0, 0x2f, 0xd3, 0x36, 0xfd, 0xcb, 0x45,
+	0x69, 0x6e, 0xfe, 0x00, 0x54, 0xae, 0x5c, 0x35, 0x9c, 0x03, 0x40, 0xed, 0x48, 0x8c, 0x77, 0x71,
+	0x6c, 0xc4, 0xcb, 0x12, 0x59, 0x93, 0xc0, 0x3f, 0xd7, 0x7f, 0x06, 0xc6, 0xfa, 0xd3, 0x84, 0x33,
+	0x60, 0x24, 0xe8, 0x20, 0x92, 0xfa, 0x24, 0x34, 0xe2, 0xc3, 0xaa, 0x5e, 0x0d, 0x61, 0x15, 0x0c,
+	0xa9, 0x57, 0xad, 0xec, 0xe9, 0x42, 0x8f, 0x69, 0x3d, 0x38, 0x3e, 0x77, 0xac, 0x93, 0x73, 0xc7,
+	0xfa, 0x7e, 0xee, 0x58, 0x1f, 0x2e, 0x9c, 0xc2, 0xc9, 0x85, 0x53, 0xf8, 0x72, 0xe1, 0x14, 0xde,
+	0x4e, 0xdf, 0x8c, 0x5e, 0xc6, 0xca, 0xb7, 0x4a, 0x6a, 0x8f, 0x1e, 0xfe, 0x1c, 0x00, 0x9f, 0x16,
+	0x5a, 0x7c, 0xe8, 0x04, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Attestors) != len(that1.Attestors) {
+		return false
+	}
+	for i := range this.Attestors {
+		if this.Attestors[i] != that1.Attestors[i] {
+			return false
+		}
+	}
+	if this.AttestationValidity != that1.AttestationValidity {
+		return false
+	}
+	if len(this.ComplianceThresholds) != len(that1.ComplianceThresholds) {
+		return false
+	}
+	for i := range this.ComplianceThresholds {
+		if !this.ComplianceThresholds[i].Equal(&that1.ComplianceThresholds[i]) {
+			return false
+		}
+	}
+	if len(this.RiskOfficers) != len(that1.RiskOfficers) {
+		return false
+	}
+	for i := range this.RiskOfficers {
+		if this.RiskOfficers[i] != that1.RiskOfficers[i] {
+			return false
+		}
+	}
+	if len(this.RiskThresholds) != len(that1.RiskThresholds) {
+		return false
+	}
+	for i := range this.RiskThresholds {
+		if !this.RiskThresholds[i].Equal(&that1.RiskThresholds[i]) {
+			return false
+		}
+	}
+	if !this.LargeAmount.Equal(that1.LargeAmount) {
+		return false
+	}
+	if this.VelocityWindow != that1.VelocityWindow {
+		return false
+	}
+	if this.VelocityLimit != that1.VelocityLimit {
+		return false
+	}
+	if len(this.Counterparties) != len(that1.Counterparties) {
+		return false
+	}
+	for i := range this.Counterparties {
+		if !this.Counterparties[i].Equal(&that1.Counterparties[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ComplianceThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ComplianceThreshold)
+	if !ok {
+		that2, ok := that.(ComplianceThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxType != that1.TxType {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *RiskThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RiskThreshold)
+	if !ok {
+		that2, ok := that.(RiskThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RiskLevel != that1.RiskLevel {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *Counterparty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Counterparty)
+	if !ok {
+		that2, ok := that.(Counterparty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if this.Chain != that1.Chain {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counterparties) > 0 {
+		for iNdEx := len(m.Counterparties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counterparties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.VelocityLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VelocityLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VelocityWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VelocityWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LargeAmount.Size()
+		i -= size
+		if _, err := m.LargeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.RiskThresholds) > 0 {
+		for iNdEx := len(m.RiskThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RiskThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RiskOfficers) > 0 {
+		for iNdEx := len(m.RiskOfficers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RiskOfficers[iNdEx])
+			copy(dAtA[i:], m.RiskOfficers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RiskOfficers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ComplianceThresholds) > 0 {
+		for iNdEx := len(m.ComplianceThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComplianceThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AttestationValidity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationValidity):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComplianceThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComplianceThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComplianceThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RiskThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RiskThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RiskThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RiskLevel) > 0 {
+		i -= len(m.RiskLevel)
+		copy(dAtA[i:], m.RiskLevel)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RiskLevel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Counterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationValidity)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ComplianceThresholds) > 0 {
+		for _, e := range m.ComplianceThresholds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RiskOfficers) > 0 {
+		for _, s := range m.RiskOfficers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RiskThresholds) > 0 {
+		for _, e := range m.RiskThresholds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.LargeAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VelocityWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.VelocityLimit != 0 {
+		n += 1 + sovParams(uint64(m.VelocityLimit))
+	}
+	if len(m.Counterparties) > 0 {
+		for _, e := range m.Counterparties {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ComplianceThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RiskThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RiskLevel)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationValidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AttestationValidity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceThresholds = append(m.ComplianceThresholds, ComplianceThreshold{})
+			if err := m.ComplianceThresholds[len(m.ComplianceThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskOfficers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskOfficers = append(m.RiskOfficers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskThresholds = append(m.RiskThresholds, RiskThreshold{})
+			if err := m.RiskThresholds[len(m.RiskThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LargeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VelocityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimit", wireType)
+			}
+			m.VelocityLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VelocityLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparties = append(m.Counterparties, Counterparty{})
+			if err := m.Counterparties[len(m.Counterparties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComplianceThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RiskThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RiskThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RiskThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
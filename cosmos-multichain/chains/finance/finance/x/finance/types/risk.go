@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"finance/contracts/interfaces"
+)
+
+// Risk scores are in basis points. Transactions scored from RiskScoreMedium
+// are of medium risk and from RiskScoreHigh of high risk.
+const (
+	RiskScoreMax    uint32 = 10_000
+	RiskScoreMedium uint32 = 3_000
+	RiskScoreHigh   uint32 = 7_000
+)
+
+// RestrictionManualReview is the restriction putting every transaction of an
+// entity at high risk
+const RestrictionManualReview = "manual_review"
+
+// RiskLevel returns the risk level of a score
+func RiskLevel(score uint32) string {
+	switch {
+	case score >= RiskScoreHigh:
+		return interfaces.RiskLevelHigh
+	case score >= RiskScoreMedium:
+		return interfaces.RiskLevelMedium
+	default:
+		return interfaces.RiskLevelLow
+	}
+}
+
+// IsRiskLevel reports whether level is a known risk level
+func IsRiskLevel(level string) bool {
+	return level == interfaces.RiskLevelLow || level == interfaces.RiskLevelMedium || level == interfaces.RiskLevelHigh
+}
+
+// IsValid reports whether the profile still applies at now
+func (p RiskProfile) IsValid(now time.Time) bool {
+	return p.ValidUntil == 0 || now.Before(time.Unix(p.ValidUntil, 0))
+}
+
+// HasRestriction reports whether the profile carries restriction
+func (p RiskProfile) HasRestriction(restriction string) bool {
+	for _, r := range p.Restrictions {
+		if r == restriction {
+			return true
+		}
+	}
+	return false
+}
+
+// InWindow reports whether the activity counts payments of the velocity
+// window that includes now
+func (a PaymentActivity) InWindow(now time.Time, window time.Duration) bool {
+	return now.Before(time.Unix(a.WindowStart, 0).Add(window))
+}
@@ -0,0 +1,1080 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/finance/risk.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RiskProfile is the risk assessment of an entity recorded by a risk
+// officer.
+type RiskProfile struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// score is the risk score of the entity in basis points, from 0 to 10000.
+	Score      uint32   `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Factors    []string `protobuf:"bytes,3,rep,name=factors,proto3" json:"factors,omitempty"`
+	AssessedAt int64    `protobuf:"varint,4,opt,name=assessed_at,json=assessedAt,proto3" json:"assessed_at,omitempty"`
+	// valid_until is when the profile stops being applied, 0 if it never
+	// expires.
+	ValidUntil   int64    `protobuf:"varint,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Restrictions []string `protobuf:"bytes,6,rep,name=restrictions,proto3" json:"restrictions,omitempty"`
+}
+
+func (m *RiskProfile) Reset()         { *m = RiskProfile{} }
+func (m *RiskProfile) String() string { return proto.CompactTextString(m) }
+func (*RiskProfile) ProtoMessage()    {}
+func (*RiskProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4d6e111ed0e16e6, []int{0}
+}
+func (m *RiskProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RiskProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RiskProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RiskProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RiskProfile.Merge(m, src)
+}
+func (m *RiskProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *RiskProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_RiskProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RiskProfile proto.InternalMessageInfo
+
+func (m *RiskProfile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RiskProfile) GetScore() uint32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *RiskProfile) GetFactors() []string {
+	if m != nil {
+		return m.Factors
+	}
+	return nil
+}
+
+func (m *RiskProfile) GetAssessedAt() int64 {
+	if m != nil {
+		return m.AssessedAt
+	}
+	return 0
+}
+
+func (m *RiskProfile) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *RiskProfile) GetRestrictions() []string {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
+// PaymentActivity counts the payments sent by an address during the velocity
+// window started at window_start.
+type PaymentActivity struct {
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	WindowStart int64  `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Count       uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *PaymentActivity) Reset()         { *m = PaymentActivity{} }
+func (m *PaymentActivity) String() string { return proto.CompactTextString(m) }
+func (*PaymentActivity) ProtoMessage()    {}
+func (*PaymentActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4d6e111ed0e16e6, []int{1}
+}
+func (m *PaymentActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentActivity.Merge(m, src)
+}
+func (m *PaymentActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentActivity proto.InternalMessageInfo
+
+func (m *PaymentActivity) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaymentActivity) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *PaymentActivity) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// CounterpartyHistory counts the payments a sender made to a recipient.
+type CounterpartyHistory struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Count       uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	LastPaidAt  int64  `protobuf:"varint,4,opt,name=last_paid_at,json=lastPaidAt,proto3" json:"last_paid_at,omitempty"`
+}
+
+func (m *CounterpartyHistory) Reset()         { *m = CounterpartyHistory{} }
+func (m *CounterpartyHistory) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyHistory) ProtoMessage()    {}
+func (*CounterpartyHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4d6e111ed0e16e6, []int{2}
+}
+func (m *CounterpartyHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyHistory.Merge(m, src)
+}
+func (m *CounterpartyHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyHistory proto.InternalMessageInfo
+
+func (m *CounterpartyHistory) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *CounterpartyHistory) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *CounterpartyHistory) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CounterpartyHistory) GetLastPaidAt() int64 {
+	if m != nil {
+		return m.LastPaidAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RiskProfile)(nil), "finance.finance.RiskProfile")
+	proto.RegisterType((*PaymentActivity)(nil), "finance.finance.PaymentActivity")
+	proto.RegisterType((*CounterpartyHistory)(nil), "finance.finance.CounterpartyHistory")
+}
+
+func init() { proto.RegisterFile("finance/finance/risk.proto", fileDescriptor_e4d6e111ed0e16e6) }
+
+var fileDescriptor_e4d6e111ed0e16e6 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x3b, 0x4d, 0xdb, 0x4b, 0x27, 0xbd, 0x14, 0x72, 0x2f, 0xdc, 0xe1, 0x82, 0x31, 0x66,
+	0x95, 0x55, 0x45, 0x7c, 0x82, 0xe8, 0xc6, 0x65, 0x89, 0xb8, 0x71, 0x13, 0xc6, 0x64, 0x02, 0x43,
+	0xd3, 0x4c, 0x98, 0x73, 0xda, 0x9a, 0xa7, 0xd0, 0x17, 0x72, 0xef, 0xb2, 0x4b, 0x97, 0xd2, 0xbe,
+	0x88, 0x4c, 0xfe, 0x54, 0x8b, 0xe0, 0xea, 0xe4, 0xfb, 0xf1, 0x91, 0x8f, 0xef, 0xcc, 0xa1, 0xff,
+	0x33, 0x59, 0xf0, 0x22, 0x11, 0xe7, 0xdd, 0xd4, 0x12, 0x16, 0xb3, 0x52, 0x2b, 0x54, 0xce, 0xb4,
+	0x65, 0xb3, 0x76, 0xfa, 0x2f, 0x84, 0xda, 0x91, 0x84, 0xc5, 0x5c, 0xab, 0x4c, 0xe6, 0xc2, 0x61,
+	0xf4, 0x17, 0x4f, 0x53, 0x2d, 0x00, 0x18, 0xf1, 0x48, 0x30, 0x8e, 0x3a, 0xe9, 0xfc, 0xa5, 0x43,
+	0x48, 0x94, 0x16, 0xac, 0xef, 0x91, 0xe0, 0x77, 0xd4, 0x08, 0xe3, 0xcf, 0x78, 0x82, 0x4a, 0x03,
+	0xb3, 0x3c, 0xcb, 0xf8, 0x5b, 0xe9, 0x9c, 0x52, 0x9b, 0x03, 0x08, 0x00, 0x91, 0xc6, 0x1c, 0xd9,
+	0xc0, 0x23, 0x81, 0x15, 0xd1, 0x0e, 0x85, 0x68, 0x0c, 0x6b, 0x9e, 0xcb, 0x34, 0x5e, 0x15, 0x28,
+	0x73, 0x36, 0x6c, 0x0c, 0x35, 0xba, 0x33, 0xc4, 0xf1, 0xe9, 0x44, 0x0b, 0x40, 0x2d, 0x13, 0x94,
+	0xaa, 0x00, 0x36, 0xaa, 0x03, 0x8e, 0x98, 0x9f, 0xd2, 0xe9, 0x9c, 0x57, 0x4b, 0x51, 0x60, 0x98,
+	0xa0, 0x5c, 0x4b, 0xac, 0x7e, 0xa8, 0x70, 0x46, 0x27, 0x1b, 0x59, 0xa4, 0x6a, 0x13, 0x03, 0x72,
+	0x8d, 0x75, 0x13, 0x2b, 0xb2, 0x1b, 0x76, 0x6b, 0x90, 0x69, 0x99, 0xa8, 0x55, 0x81, 0xcc, 0xf2,
+	0x48, 0x30, 0x88, 0x1a, 0xe1, 0x3f, 0x11, 0xfa, 0xe7, 0xda, 0x7c, 0x09, 0x5d, 0x72, 0x8d, 0xd5,
+	0x8d, 0x04, 0x54, 0xba, 0x32, 0x3f, 0xcc, 0xb4, 0x5a, 0xc6, 0xc7, 0x79, 0xb6, 0x61, 0x61, 0x9b,
+	0x79, 0x42, 0x29, 0xaa, 0x83, 0xa1, 0x5f, 0x1b, 0xc6, 0xa8, 0xc2, 0xcf, 0xad, 0x7e, 0xcf, 0x73,
+	0x3c, 0x3a, 0xc9, 0x39, 0x60, 0x5c, 0x72, 0xf9, 0x75, 0x79, 0x86, 0xcd, 0xb9, 0x4c, 0x43, 0xbc,
+	0xba, 0x78, 0xdd, 0xb9, 0x64, 0xbb, 0x73, 0xc9, 0xfb, 0xce, 0x25, 0xcf, 0x7b, 0xb7, 0xb7, 0xdd,
+	0xbb, 0xbd, 0xb7, 0xbd, 0xdb, 0xbb, 0xff, 0xd7, 0x3d, 0xfb, 0xe3, 0xe1, 0x00, 0xb0, 0x2a, 0x05,
+	0x3c, 0x8c, 0xea, 0x13, 0xb8, 0xfc, 0x18, 0x00, 0xc0, 0xcc, 0x1a, 0x4b, 0x20, 0x02, 0x00, 0x00,
+}
+
+func (m *RiskProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RiskProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RiskProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Restrictions[iNdEx])
+			copy(dAtA[i:], m.Restrictions[iNdEx])
+			i = encodeVarintRisk(dAtA, i, uint64(len(m.Restrictions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AssessedAt != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.AssessedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Factors) > 0 {
+		for iNdEx := len(m.Factors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Factors[iNdEx])
+			copy(dAtA[i:], m.Factors[iNdEx])
+			i = encodeVarintRisk(dAtA, i, uint64(len(m.Factors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Score != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRisk(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRisk(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CounterpartyHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPaidAt != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.LastPaidAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintRisk(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintRisk(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintRisk(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRisk(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRisk(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RiskProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRisk(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovRisk(uint64(m.Score))
+	}
+	if len(m.Factors) > 0 {
+		for _, s := range m.Factors {
+			l = len(s)
+			n += 1 + l + sovRisk(uint64(l))
+		}
+	}
+	if m.AssessedAt != 0 {
+		n += 1 + sovRisk(uint64(m.AssessedAt))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovRisk(uint64(m.ValidUntil))
+	}
+	if len(m.Restrictions) > 0 {
+		for _, s := range m.Restrictions {
+			l = len(s)
+			n += 1 + l + sovRisk(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PaymentActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRisk(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovRisk(uint64(m.WindowStart))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRisk(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *CounterpartyHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovRisk(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovRisk(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRisk(uint64(m.Count))
+	}
+	if m.LastPaidAt != 0 {
+		n += 1 + sovRisk(uint64(m.LastPaidAt))
+	}
+	return n
+}
+
+func sovRisk(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRisk(x uint64) (n int) {
+	return sovRisk(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RiskProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRisk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RiskProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RiskProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factors = append(m.Factors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssessedAt", wireType)
+			}
+			m.AssessedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssessedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRisk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRisk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRisk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CounterpartyHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRisk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRisk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPaidAt", wireType)
+			}
+			m.LastPaidAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPaidAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRisk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRisk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRisk(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRisk
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRisk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRisk
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRisk
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRisk
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRisk        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRisk          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRisk = fmt.Errorf("proto: unexpected end of group")
+)
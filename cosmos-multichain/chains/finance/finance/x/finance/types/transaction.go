@@ -0,0 +1,14 @@
+package types
+
+// Transaction statuses set by the module. Other statuses are set by the
+// transaction creator through MsgUpdateTransactionStatus.
+const (
+	TransactionStatusInitiated = "INITIATED"
+	TransactionStatusFinalized = "FINALIZED"
+
+	// Transactions received from other chains and held because of their
+	// risk until a risk officer releases or rejects them
+	TransactionStatusHeld     = "HELD_FOR_REVIEW"
+	TransactionStatusReleased = "RELEASED"
+	TransactionStatusRejected = "REJECTED"
+)
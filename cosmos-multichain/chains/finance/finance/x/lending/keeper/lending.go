@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"finance/x/lending/types"
+)
+
+// OpenLoan records a PENDING loan awaiting funding by its lender. The loan
+// must fit the maximum loan duration and be backed by collateral worth at
+// least the minimum collateralization of its principal.
+func (k Keeper) OpenLoan(ctx context.Context, loan types.Loan) error {
+	if loan.CollateralValue.IsNil() {
+		loan.CollateralValue = sdkmath.ZeroInt()
+	}
+	if err := k.validateLoan(ctx, loan); err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	loan.Status = types.LoanStatusPending
+	loan.Schedule = nil
+	loan.NextAccrual = 0
+	loan.CreatedAt = now
+	loan.UpdatedAt = now
+	k.SetLoan(ctx, loan)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLoanOpened,
+			sdk.NewAttribute(types.AttributeKeyLoanId, loan.LoanId),
+			sdk.NewAttribute(types.AttributeKeyBorrower, loan.Borrower),
+			sdk.NewAttribute(types.AttributeKeyLender, loan.Lender),
+			sdk.NewAttribute(types.AttributeKeyAmount, loan.Principal.String()),
+			sdk.NewAttribute(types.AttributeKeySourceChain, loan.SourceChain),
+			sdk.NewAttribute(types.AttributeKeyCollateralRefs, strings.Join(loan.CollateralRefs, ",")),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) validateLoan(ctx context.Context, loan types.Loan) error {
+	if loan.LoanId == "" {
+		return errorsmod.Wrap(types.ErrInvalidLoan, "loan ID is required")
+	}
+	if _, found := k.GetLoan(ctx, loan.LoanId); found {
+		return errorsmod.Wrap(types.ErrLoanExists, loan.LoanId)
+	}
+	if _, err := sdk.AccAddressFromBech32(loan.Borrower); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "invalid borrower address: %s", err)
+	}
+	if loan.Lender != "" {
+		if _, err := sdk.AccAddressFromBech32(loan.Lender); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidLoan, "invalid lender address: %s", err)
+		}
+	}
+	if !loan.Principal.IsValid() || !loan.Principal.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "invalid principal %s", loan.Principal)
+	}
+	if loan.RateBps > types.BasisPoints {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "rate cannot exceed %d bps", types.BasisPoints)
+	}
+	if loan.Term == 0 || loan.PaymentInterval <= 0 {
+		return errorsmod.Wrap(types.ErrInvalidLoan, "term and payment interval must be positive")
+	}
+
+	params := k.GetParams(ctx)
+	if duration := time.Duration(loan.Term) * loan.PaymentInterval; duration/time.Duration(loan.Term) != loan.PaymentInterval || duration > params.MaxLoanDuration {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "loan duration exceeds %s", params.MaxLoanDuration)
+	}
+
+	if params.MinCollateralization > 0 {
+		if len(loan.CollateralRefs) == 0 {
+			return errorsmod.Wrap(types.ErrInsufficientCollateral, "collateral references are required")
+		}
+		required := loan.Principal.Amount.MulRaw(int64(params.MinCollateralization))
+		if loan.CollateralValue.MulRaw(100).LT(required) {
+			return errorsmod.Wrapf(types.ErrInsufficientCollateral, "collateral must be worth %d%% of the principal", params.MinCollateralization)
+		}
+	}
+	return nil
+}
+
+// FundLoan disburses the principal of a pending loan from lender to the
+// borrower and starts its repayment schedule at the current block time
+func (k Keeper) FundLoan(ctx context.Context, lender string, loanId string) error {
+	loan, err := k.loanWithStatus(ctx, loanId, types.LoanStatusPending)
+	if err != nil {
+		return err
+	}
+	if loan.Lender != "" && loan.Lender != lender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "loan %s is funded by %s", loanId, loan.Lender)
+	}
+	from, err := sdk.AccAddressFromBech32(lender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(loan.Borrower)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidLoan, "invalid borrower address: %s", err)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(loan.Principal)); err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	loan.Lender = lender
+	loan.Status = types.LoanStatusActive
+	loan.Schedule = types.AmortizationSchedule(loan.Principal.Amount, loan.RateBps, loan.Term, loan.PaymentInterval, now)
+	loan.NextAccrual = nextAccrual(loan, k.GetParams(ctx), now)
+	loan.UpdatedAt = now.Unix()
+	k.SetLoan(ctx, loan)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLoanFunded,
+			sdk.NewAttribute(types.AttributeKeyLoanId, loan.LoanId),
+			sdk.NewAttribute(types.AttributeKeyBorrower, loan.Borrower),
+			sdk.NewAttribute(types.AttributeKeyLender, loan.Lender),
+			sdk.NewAttribute(types.AttributeKeyAmount, loan.Principal.String()),
+		),
+	)
+	return nil
+}
+
+// RepayLoan applies up to amount to an active loan, settling the late fees
+// then the principal plus interest of its installments in due order, and
+// sends the applied part from payer to the lender. The loan is REPAID once
+// every installment is settled.
+func (k Keeper) RepayLoan(ctx context.Context, payer string, loanId string, amount sdk.Coin) (sdk.Coin, types.Loan, error) {
+	loan, err := k.loanWithStatus(ctx, loanId, types.LoanStatusActive)
+	if err != nil {
+		return sdk.Coin{}, loan, err
+	}
+	if amount.Denom != loan.Principal.Denom {
+		return sdk.Coin{}, loan, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "loan %s is repaid in %s", loanId, loan.Principal.Denom)
+	}
+	from, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return sdk.Coin{}, loan, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address: %s", err)
+	}
+	to, err := sdk.AccAddressFromBech32(loan.Lender)
+	if err != nil {
+		return sdk.Coin{}, loan, errorsmod.Wrapf(types.ErrInvalidLoan, "invalid lender address: %s", err)
+	}
+
+	remaining := amount.Amount
+	for i := range loan.Schedule {
+		installment := &loan.Schedule[i]
+		fee := sdkmath.MinInt(remaining, installment.LateFee)
+		installment.LateFee = installment.LateFee.Sub(fee)
+		remaining = remaining.Sub(fee)
+
+		part := sdkmath.MinInt(remaining, installment.Outstanding())
+		installment.Paid = installment.Paid.Add(part)
+		remaining = remaining.Sub(part)
+	}
+	applied := sdk.NewCoin(amount.Denom, amount.Amount.Sub(remaining))
+
+	if applied.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(applied)); err != nil {
+			return sdk.Coin{}, loan, err
+		}
+	}
+
+	if loan.IsRepaid() {
+		loan.Status = types.LoanStatusRepaid
+		loan.NextAccrual = 0
+	}
+	loan.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	k.SetLoan(ctx, loan)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLoanRepayment,
+			sdk.NewAttribute(types.AttributeKeyLoanId, loan.LoanId),
+			sdk.NewAttribute(types.AttributeKeyAmount, applied.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, loan.Status),
+		),
+	)
+	if loan.Status == types.LoanStatusRepaid {
+		emitLoanStatusEvent(sdkCtx, loan)
+	}
+	return applied, loan, nil
+}
+
+// AccrueLateFees charges the late fee rate on the overdue part of every loan
+// queued for accrual at or before now and defaults the loans with at least
+// the default threshold of overdue installments. Loans still overdue are
+// requeued one late fee interval later, the others at the end of the grace
+// period of their next installment.
+func (k Keeper) AccrueLateFees(ctx context.Context, now time.Time) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+
+	for _, loanId := range k.dueLoans(ctx, now.Unix()) {
+		loan, found := k.GetLoan(ctx, loanId)
+		if !found {
+			continue
+		}
+
+		overdue := uint32(0)
+		accrued := sdkmath.ZeroInt()
+		for i := range loan.Schedule {
+			installment := &loan.Schedule[i]
+			if !installment.IsOverdue(now, params.GracePeriod) {
+				continue
+			}
+			overdue++
+			fee := installment.Outstanding().MulRaw(int64(params.LateFeeRateBps)).QuoRaw(types.BasisPoints)
+			installment.LateFee = installment.LateFee.Add(fee)
+			accrued = accrued.Add(fee)
+		}
+
+		if overdue >= params.DefaultThreshold {
+			loan.Status = types.LoanStatusDefaulted
+			loan.NextAccrual = 0
+		} else {
+			loan.NextAccrual = nextAccrual(loan, params, now)
+		}
+		loan.UpdatedAt = now.Unix()
+		k.SetLoan(ctx, loan)
+
+		if accrued.IsPositive() {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeLateFeeAccrued,
+					sdk.NewAttribute(types.AttributeKeyLoanId, loan.LoanId),
+					sdk.NewAttribute(types.AttributeKeyLateFee, sdk.NewCoin(loan.Principal.Denom, accrued).String()),
+				),
+			)
+		}
+		if loan.Status == types.LoanStatusDefaulted {
+			emitLoanStatusEvent(sdkCtx, loan)
+		}
+	}
+}
+
+// nextAccrual returns when the loan is next checked for overdue installments,
+// or 0 when no installment is left unpaid
+func nextAccrual(loan types.Loan, params types.Params, now time.Time) int64 {
+	for _, installment := range loan.Schedule {
+		if installment.IsOverdue(now, params.GracePeriod) {
+			return now.Add(params.LateFeeInterval).Unix()
+		}
+	}
+	for _, installment := range loan.Schedule {
+		if installment.Outstanding().IsPositive() {
+			return time.Unix(installment.DueDate, 0).Add(params.GracePeriod).Unix()
+		}
+	}
+	return 0
+}
+
+func (k Keeper) loanWithStatus(ctx context.Context, loanId string, status string) (types.Loan, error) {
+	loan, found := k.GetLoan(ctx, loanId)
+	if !found {
+		return loan, errorsmod.Wrap(types.ErrLoanNotFound, loanId)
+	}
+	if loan.Status != status {
+		return loan, errorsmod.Wrapf(types.ErrInvalidLoanStatus, "loan %s is %s, expected %s", loanId, loan.Status, status)
+	}
+	return loan, nil
+}
+
+func emitLoanStatusEvent(ctx sdk.Context, loan types.Loan) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLoanStatus,
+			sdk.NewAttribute(types.AttributeKeyLoanId, loan.LoanId),
+			sdk.NewAttribute(types.AttributeKeyStatus, loan.Status),
+			sdk.NewAttribute(types.AttributeKeyCollateralRefs, strings.Join(loan.CollateralRefs, ",")),
+		),
+	)
+}
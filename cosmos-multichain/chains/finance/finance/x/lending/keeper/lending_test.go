@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "finance/testutil/keeper"
+	"finance/testutil/sample"
+	"finance/x/lending/keeper"
+	"finance/x/lending/types"
+)
+
+const week = 7 * 24 * time.Hour
+
+func loan(id, borrower, lender string, principal int64) types.Loan {
+	return types.Loan{
+		LoanId:          id,
+		Borrower:        borrower,
+		Lender:          lender,
+		Principal:       sdk.NewInt64Coin("ufin", principal),
+		RateBps:         0,
+		Term:            4,
+		PaymentInterval: week,
+		CollateralRefs:  []string{"property-1"},
+		CollateralValue: sdkmath.NewInt(principal * 2),
+	}
+}
+
+func TestOpenLoan(t *testing.T) {
+	k, ctx := keepertest.LendingKeeper(t)
+	borrower, lender := sample.AccAddress(), sample.AccAddress()
+
+	require.NoError(t, k.OpenLoan(ctx, loan("loan-1", borrower, lender, 1_000)))
+	stored, found := k.GetLoan(ctx, "loan-1")
+	require.True(t, found)
+	require.Equal(t, types.LoanStatusPending, stored.Status)
+	require.Empty(t, stored.Schedule)
+	require.ErrorIs(t, k.OpenLoan(ctx, loan("loan-1", borrower, lender, 1_000)), types.ErrLoanExists)
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.Loan)
+		err    error
+	}{
+		{desc: "invalid borrower", modify: func(l *types.Loan) { l.Borrower = "invalid" }, err: types.ErrInvalidLoan},
+		{desc: "zero principal", modify: func(l *types.Loan) { l.Principal.Amount = sdkmath.ZeroInt() }, err: types.ErrInvalidLoan},
+		{desc: "zero term", modify: func(l *types.Loan) { l.Term = 0 }, err: types.ErrInvalidLoan},
+		{desc: "too long", modify: func(l *types.Loan) { l.Term = 60 }, err: types.ErrInvalidLoan},
+		{desc: "no collateral", modify: func(l *types.Loan) { l.CollateralRefs = nil }, err: types.ErrInsufficientCollateral},
+		{desc: "undercollateralized", modify: func(l *types.Loan) { l.CollateralValue = sdkmath.NewInt(1_499) }, err: types.ErrInsufficientCollateral},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			l := loan("loan-2", borrower, lender, 1_000)
+			tc.modify(&l)
+			require.ErrorIs(t, k.OpenLoan(ctx, l), tc.err)
+		})
+	}
+}
+
+func TestFundAndRepayLoan(t *testing.T) {
+	k, bank, ctx := keepertest.LendingKeeperWithBank(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	borrower, lender := sample.AccAddress(), sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("ufin", 1_000)))
+	require.NoError(t, k.OpenLoan(ctx, loan("loan-1", borrower, lender, 1_000)))
+
+	_, err := srv.RepayLoan(ctx, types.NewMsgRepayLoan(borrower, "loan-1", sdk.NewInt64Coin("ufin", 100)))
+	require.ErrorIs(t, err, types.ErrInvalidLoanStatus)
+	_, err = srv.FundLoan(ctx, types.NewMsgFundLoan(borrower, "loan-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.FundLoan(ctx, types.NewMsgFundLoan(lender, "loan-1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000), bank.Balance(sdk.MustAccAddressFromBech32(borrower)).AmountOf("ufin").Int64())
+	funded, _ := k.GetLoan(ctx, "loan-1")
+	require.Equal(t, types.LoanStatusActive, funded.Status)
+	require.Len(t, funded.Schedule, 4)
+	require.Equal(t, ctx.BlockTime().Add(week+types.DefaultGracePeriod).Unix(), funded.NextAccrual)
+
+	_, err = srv.RepayLoan(ctx, types.NewMsgRepayLoan(borrower, "loan-1", sdk.NewInt64Coin("uother", 100)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	res, err := srv.RepayLoan(ctx, types.NewMsgRepayLoan(borrower, "loan-1", sdk.NewInt64Coin("ufin", 300)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 300), res.Applied)
+	require.Equal(t, types.LoanStatusActive, res.Status)
+	repaid, _ := k.GetLoan(ctx, "loan-1")
+	require.Equal(t, sdkmath.NewInt(250), repaid.Schedule[0].Paid)
+	require.Equal(t, sdkmath.NewInt(50), repaid.Schedule[1].Paid)
+
+	res, err = srv.RepayLoan(ctx, types.NewMsgRepayLoan(borrower, "loan-1", sdk.NewInt64Coin("ufin", 1_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 700), res.Applied)
+	require.Equal(t, types.LoanStatusRepaid, res.Status)
+	require.Equal(t, int64(1_000), bank.Balance(sdk.MustAccAddressFromBech32(lender)).AmountOf("ufin").Int64())
+}
+
+func TestAccrueLateFees(t *testing.T) {
+	k, bank, ctx := keepertest.LendingKeeperWithBank(t)
+	start := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(start)
+	borrower, lender := sample.AccAddress(), sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("ufin", 100_000)))
+	require.NoError(t, k.OpenLoan(ctx, loan("loan-1", borrower, lender, 100_000)))
+	require.NoError(t, k.FundLoan(ctx, lender, "loan-1"))
+
+	// Nothing is overdue before the grace period of the first installment ends
+	k.AccrueLateFees(ctx, start.Add(week))
+	l, _ := k.GetLoan(ctx, "loan-1")
+	require.True(t, l.Schedule[0].LateFee.IsZero())
+
+	graceEnd := start.Add(week + types.DefaultGracePeriod)
+	k.AccrueLateFees(ctx, graceEnd)
+	l, _ = k.GetLoan(ctx, "loan-1")
+	require.Equal(t, sdkmath.NewInt(25), l.Schedule[0].LateFee)
+	require.Equal(t, graceEnd.Add(types.DefaultLateFeeInterval).Unix(), l.NextAccrual)
+
+	// Paying the late fee and the installment stops the accrual
+	applied, _, err := k.RepayLoan(ctx, borrower, "loan-1", sdk.NewInt64Coin("ufin", 25_025))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 25_025), applied)
+	k.AccrueLateFees(ctx, graceEnd.Add(types.DefaultLateFeeInterval))
+	l, _ = k.GetLoan(ctx, "loan-1")
+	require.True(t, l.Schedule[0].IsSettled())
+	require.True(t, l.Schedule[1].LateFee.IsZero())
+	require.Equal(t, start.Add(2*week+types.DefaultGracePeriod).Unix(), l.NextAccrual)
+
+	// The loan defaults once three installments are overdue
+	k.AccrueLateFees(ctx, start.Add(3*week+types.DefaultGracePeriod))
+	l, _ = k.GetLoan(ctx, "loan-1")
+	require.Equal(t, types.LoanStatusActive, l.Status)
+	k.AccrueLateFees(ctx, start.Add(4*week+types.DefaultGracePeriod))
+	l, _ = k.GetLoan(ctx, "loan-1")
+	require.Equal(t, types.LoanStatusDefaulted, l.Status)
+	require.Zero(t, l.NextAccrual)
+	_, _, err = k.RepayLoan(ctx, borrower, "loan-1", sdk.NewInt64Coin("ufin", 1))
+	require.ErrorIs(t, err, types.ErrInvalidLoanStatus)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"finance/x/lending/types"
+)
+
+// SetLoan set a specific loan in the store from its index and queues active
+// loans for their next late fee accrual
+func (k Keeper) SetLoan(ctx context.Context, loan types.Loan) {
+	if previous, found := k.GetLoan(ctx, loan.LoanId); found {
+		k.dequeueLoan(ctx, previous)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanKeyPrefix))
+	b := k.cdc.MustMarshal(&loan)
+	store.Set(types.LoanKey(
+		loan.LoanId,
+	), b)
+
+	if loan.Status == types.LoanStatusActive && loan.NextAccrual > 0 {
+		queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanAccrualQueuePrefix))
+		queue.Set(types.LoanAccrualQueueKey(loan.NextAccrual, loan.LoanId), []byte(loan.LoanId))
+	}
+}
+
+// GetLoan returns a loan from its index
+func (k Keeper) GetLoan(
+	ctx context.Context,
+	loanId string,
+) (val types.Loan, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanKeyPrefix))
+
+	b := store.Get(types.LoanKey(
+		loanId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllLoan returns all loan
+func (k Keeper) GetAllLoan(ctx context.Context) (list []types.Loan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Loan
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) dequeueLoan(ctx context.Context, loan types.Loan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanAccrualQueuePrefix))
+	queue.Delete(types.LoanAccrualQueueKey(loan.NextAccrual, loan.LoanId))
+}
+
+// dueLoans returns the IDs of the loans queued for accrual at or before now
+func (k Keeper) dueLoans(ctx context.Context, now int64) (loanIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LoanAccrualQueuePrefix))
+	iterator := queue.Iterator(nil, types.LoanTimeKey(now+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		loanIds = append(loanIds, string(iterator.Value()))
+	}
+	return loanIds
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"finance/x/lending/types"
+)
+
+func (k msgServer) FundLoan(goCtx context.Context, msg *types.MsgFundLoan) (*types.MsgFundLoanResponse, error) {
+	if err := k.Keeper.FundLoan(goCtx, msg.Creator, msg.LoanId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundLoanResponse{}, nil
+}
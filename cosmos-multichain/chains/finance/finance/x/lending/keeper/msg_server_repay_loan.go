@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"finance/x/lending/types"
+)
+
+func (k msgServer) RepayLoan(goCtx context.Context, msg *types.MsgRepayLoan) (*types.MsgRepayLoanResponse, error) {
+	applied, loan, err := k.Keeper.RepayLoan(goCtx, msg.Creator, msg.LoanId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRepayLoanResponse{Applied: applied, Status: loan.Status}, nil
+}
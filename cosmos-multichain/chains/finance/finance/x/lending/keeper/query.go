@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"finance/x/lending/types"
+)
+
+var _ types.QueryServer = Keeper{}
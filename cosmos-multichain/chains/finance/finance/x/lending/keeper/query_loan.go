@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/lending/types"
+)
+
+func (k Keeper) LoanAll(ctx context.Context, req *types.QueryAllLoanRequest) (*types.QueryAllLoanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var loans []types.Loan
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loanStore := prefix.NewStore(store, types.KeyPrefix(types.LoanKeyPrefix))
+
+	pageRes, err := query.Paginate(loanStore, req.Pagination, func(key []byte, value []byte) error {
+		var loan types.Loan
+		if err := k.cdc.Unmarshal(value, &loan); err != nil {
+			return err
+		}
+
+		loans = append(loans, loan)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLoanResponse{Loan: loans, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Loan(ctx context.Context, req *types.QueryGetLoanRequest) (*types.QueryGetLoanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetLoan(
+		ctx,
+		req.LoanId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetLoanResponse{Loan: val}, nil
+}
@@ -0,0 +1,58 @@
+package lending
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "finance/api/finance/lending"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "LoanAll",
+					Use:       "list-loan",
+					Short:     "List all Loan",
+				},
+				{
+					RpcMethod:      "Loan",
+					Use:            "show-loan [loan-id]",
+					Short:          "Shows a Loan",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "loan_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "FundLoan",
+					Use:            "fund-loan [loan-id]",
+					Short:          "Disburse the principal of a pending loan to its borrower",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "loan_id"}},
+				},
+				{
+					RpcMethod:      "RepayLoan",
+					Use:            "repay-loan [loan-id] [amount]",
+					Short:          "Repay an active loan",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "loan_id"}, {ProtoField: "amount"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package lending
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/lending/keeper"
+	"finance/x/lending/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the loan
+	for _, elem := range genState.LoanList {
+		k.SetLoan(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.LoanList = k.GetAllLoan(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
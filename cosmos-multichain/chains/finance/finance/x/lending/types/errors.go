@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/lending module sentinel errors
+var (
+	ErrInvalidSigner          = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidLoan            = sdkerrors.Register(ModuleName, 1101, "invalid loan")
+	ErrLoanExists             = sdkerrors.Register(ModuleName, 1102, "loan already exists")
+	ErrLoanNotFound           = sdkerrors.Register(ModuleName, 1103, "loan not found")
+	ErrInsufficientCollateral = sdkerrors.Register(ModuleName, 1104, "insufficient collateral")
+	ErrInvalidLoanStatus      = sdkerrors.Register(ModuleName, 1105, "invalid loan status")
+)
@@ -0,0 +1,19 @@
+package types
+
+// lending module event types
+const (
+	EventTypeLoanOpened     = "loan_opened"
+	EventTypeLoanFunded     = "loan_funded"
+	EventTypeLoanRepayment  = "loan_repayment"
+	EventTypeLateFeeAccrued = "late_fee_accrued"
+	EventTypeLoanStatus     = "loan_status"
+
+	AttributeKeyLoanId         = "loan_id"
+	AttributeKeyBorrower       = "borrower"
+	AttributeKeyLender         = "lender"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyLateFee        = "late_fee"
+	AttributeKeyStatus         = "status"
+	AttributeKeySourceChain    = "source_chain"
+	AttributeKeyCollateralRefs = "collateral_refs"
+)
@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		LoanList: []Loan{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in loan
+	loanIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LoanList {
+		index := string(LoanKey(elem.LoanId))
+		if _, ok := loanIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for loan")
+		}
+		loanIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,41 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// LoanKeyPrefix is the prefix to retrieve all Loan
+	LoanKeyPrefix = "Loan/value/"
+
+	// LoanAccrualQueuePrefix orders active loans by their next late fee accrual
+	LoanAccrualQueuePrefix = "Loan/accrual/"
+)
+
+// LoanKey returns the store key to retrieve a Loan from the index fields
+func LoanKey(
+	loanId string,
+) []byte {
+	var key []byte
+
+	loanIdBytes := []byte(loanId)
+	key = append(key, loanIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// LoanTimeKey returns the prefix of a time ordered queue entry
+func LoanTimeKey(
+	unix int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(unix))
+	return key
+}
+
+// LoanAccrualQueueKey returns the key of an accrual queue entry
+func LoanAccrualQueueKey(
+	unix int64,
+	loanId string,
+) []byte {
+	return append(LoanTimeKey(unix), LoanKey(loanId)...)
+}
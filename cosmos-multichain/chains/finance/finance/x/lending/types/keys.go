@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "lending"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_lending"
+)
+
+var (
+	ParamsKey = []byte("p_lending")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Loan statuses. A loan is opened PENDING, becomes ACTIVE once funded and
+// ends REPAID or DEFAULTED.
+const (
+	LoanStatusPending   = "PENDING"
+	LoanStatusActive    = "ACTIVE"
+	LoanStatusRepaid    = "REPAID"
+	LoanStatusDefaulted = "DEFAULTED"
+)
+
+// BasisPoints is a rate of 100%
+const BasisPoints = 10_000
+
+// year is the period of the annual interest rates
+const year = 365 * 24 * time.Hour
+
+// Amount returns the principal plus interest of the installment
+func (i Installment) Amount() sdkmath.Int {
+	return i.Principal.Add(i.Interest)
+}
+
+// Outstanding returns the unpaid principal plus interest of the installment
+func (i Installment) Outstanding() sdkmath.Int {
+	return i.Amount().Sub(i.Paid)
+}
+
+// IsSettled reports whether the installment and its late fees are paid
+func (i Installment) IsSettled() bool {
+	return !i.Outstanding().IsPositive() && !i.LateFee.IsPositive()
+}
+
+// IsOverdue reports whether the installment is unpaid past its due date plus the grace period
+func (i Installment) IsOverdue(now time.Time, gracePeriod time.Duration) bool {
+	return i.Outstanding().IsPositive() && !now.Before(time.Unix(i.DueDate, 0).Add(gracePeriod))
+}
+
+// IsRepaid reports whether every installment of the loan is settled
+func (l Loan) IsRepaid() bool {
+	for _, installment := range l.Schedule {
+		if !installment.IsSettled() {
+			return false
+		}
+	}
+	return true
+}
+
+// AmortizationSchedule splits principal into term equal installments, one
+// every interval from start, each paying the interest accrued on the
+// remaining balance at the annual rateBps plus part of the principal. The last
+// installment repays the remaining balance.
+func AmortizationSchedule(principal sdkmath.Int, rateBps uint32, term uint32, interval time.Duration, start time.Time) []Installment {
+	rate := sdkmath.LegacyNewDec(int64(rateBps)).QuoInt64(BasisPoints).
+		MulInt64(int64(interval)).QuoInt64(int64(year))
+
+	var payment sdkmath.Int
+	if rate.IsZero() {
+		payment = sdkmath.LegacyNewDecFromInt(principal).QuoInt64(int64(term)).Ceil().TruncateInt()
+	} else {
+		// payment = principal * rate * (1+rate)^term / ((1+rate)^term - 1)
+		growth := sdkmath.LegacyOneDec().Add(rate).Power(uint64(term))
+		payment = sdkmath.LegacyNewDecFromInt(principal).Mul(rate).Mul(growth).
+			Quo(growth.Sub(sdkmath.LegacyOneDec())).Ceil().TruncateInt()
+	}
+
+	schedule := make([]Installment, term)
+	balance := principal
+	for i := range schedule {
+		interest := rate.MulInt(balance).TruncateInt()
+		part := sdkmath.MinInt(payment.Sub(interest), balance)
+		if i == len(schedule)-1 {
+			part = balance
+		}
+		balance = balance.Sub(part)
+
+		schedule[i] = Installment{
+			DueDate:   start.Add(time.Duration(i+1) * interval).Unix(),
+			Principal: part,
+			Interest:  interest,
+			Paid:      sdkmath.ZeroInt(),
+			LateFee:   sdkmath.ZeroInt(),
+		}
+	}
+	return schedule
+}
@@ -0,0 +1,1355 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/lending/loan.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Installment is one repayment of a loan schedule.
+type Installment struct {
+	DueDate   int64                 `protobuf:"varint,1,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Principal cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=principal,proto3,customtype=cosmossdk.io/math.Int" json:"principal"`
+	Interest  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=interest,proto3,customtype=cosmossdk.io/math.Int" json:"interest"`
+	// paid is the part of principal plus interest repaid so far.
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	// late_fee is the unpaid late fee accrued on the installment.
+	LateFee cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=late_fee,json=lateFee,proto3,customtype=cosmossdk.io/math.Int" json:"late_fee"`
+}
+
+func (m *Installment) Reset()         { *m = Installment{} }
+func (m *Installment) String() string { return proto.CompactTextString(m) }
+func (*Installment) ProtoMessage()    {}
+func (*Installment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_100971d8871cae60, []int{0}
+}
+func (m *Installment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Installment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Installment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Installment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Installment.Merge(m, src)
+}
+func (m *Installment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Installment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Installment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Installment proto.InternalMessageInfo
+
+func (m *Installment) GetDueDate() int64 {
+	if m != nil {
+		return m.DueDate
+	}
+	return 0
+}
+
+// Loan is a loan of principal from lender to borrower repaid through a
+// schedule of installments.
+type Loan struct {
+	LoanId   string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Borrower string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// lender funds the loan. An empty lender lets any account fund it.
+	Lender    string     `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Principal types.Coin `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal"`
+	// rate_bps is the annual interest rate in basis points.
+	RateBps uint32 `protobuf:"varint,5,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	// term is the number of installments.
+	Term            uint32        `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
+	PaymentInterval time.Duration `protobuf:"bytes,7,opt,name=payment_interval,json=paymentInterval,proto3,stdduration" json:"payment_interval"`
+	// collateral_refs reference the assets securing the loan, such as
+	// real estate property IDs.
+	CollateralRefs  []string              `protobuf:"bytes,8,rep,name=collateral_refs,json=collateralRefs,proto3" json:"collateral_refs,omitempty"`
+	CollateralValue cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3,customtype=cosmossdk.io/math.Int" json:"collateral_value"`
+	// source_chain is the chain the loan was opened from, empty for local loans.
+	SourceChain string        `protobuf:"bytes,10,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	Status      string        `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Schedule    []Installment `protobuf:"bytes,12,rep,name=schedule,proto3" json:"schedule"`
+	// next_accrual is when the loan is next checked for overdue installments.
+	NextAccrual int64 `protobuf:"varint,13,opt,name=next_accrual,json=nextAccrual,proto3" json:"next_accrual,omitempty"`
+	CreatedAt   int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *Loan) Reset()         { *m = Loan{} }
+func (m *Loan) String() string { return proto.CompactTextString(m) }
+func (*Loan) ProtoMessage()    {}
+func (*Loan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_100971d8871cae60, []int{1}
+}
+func (m *Loan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Loan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Loan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Loan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loan.Merge(m, src)
+}
+func (m *Loan) XXX_Size() int {
+	return m.Size()
+}
+func (m *Loan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loan proto.InternalMessageInfo
+
+func (m *Loan) GetLoanId() string {
+	if m != nil {
+		return m.LoanId
+	}
+	return ""
+}
+
+func (m *Loan) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *Loan) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *Loan) GetPrincipal() types.Coin {
+	if m != nil {
+		return m.Principal
+	}
+	return types.Coin{}
+}
+
+func (m *Loan) GetRateBps() uint32 {
+	if m != nil {
+		return m.RateBps
+	}
+	return 0
+}
+
+func (m *Loan) GetTerm() uint32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *Loan) GetPaymentInterval() time.Duration {
+	if m != nil {
+		return m.PaymentInterval
+	}
+	return 0
+}
+
+func (m *Loan) GetCollateralRefs() []string {
+	if m != nil {
+		return m.CollateralRefs
+	}
+	return nil
+}
+
+func (m *Loan) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *Loan) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Loan) GetSchedule() []Installment {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *Loan) GetNextAccrual() int64 {
+	if m != nil {
+		return m.NextAccrual
+	}
+	return 0
+}
+
+func (m *Loan) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Loan) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Installment)(nil), "finance.lending.Installment")
+	proto.RegisterType((*Loan)(nil), "finance.lending.Loan")
+}
+
+func init() { proto.RegisterFile("finance/lending/loan.proto", fileDescriptor_100971d8871cae60) }
+
+var fileDescriptor_100971d8871cae60 = []byte{
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0x9b, 0xbc, 0x24, 0x9e, 0xb4, 0x4d, 0xdf, 0xe8, 0xbd, 0xd7, 0x49, 0xf4, 0x70, 0x43,
+	0x37, 0x44, 0x48, 0xd8, 0xb4, 0xec, 0x91, 0xea, 0x56, 0x48, 0x11, 0x15, 0x0b, 0x23, 0xb1, 0x80,
+	0x85, 0x35, 0xb1, 0x6f, 0x52, 0x0b, 0x67, 0xc6, 0x9a, 0x19, 0x97, 0xf6, 0x2f, 0x58, 0xf2, 0x09,
+	0x2c, 0x59, 0xf0, 0x11, 0x5d, 0x56, 0xac, 0x80, 0x45, 0x41, 0xed, 0x82, 0xaf, 0x40, 0x42, 0xe3,
+	0x99, 0xa4, 0x11, 0xec, 0xb2, 0xb1, 0x7c, 0xce, 0x9d, 0x39, 0xf7, 0xce, 0xbd, 0x67, 0x06, 0xf5,
+	0x27, 0x19, 0xa3, 0x2c, 0x81, 0x20, 0x07, 0x96, 0x66, 0x6c, 0x1a, 0xe4, 0x9c, 0x32, 0xbf, 0x10,
+	0x5c, 0x71, 0xdc, 0xb5, 0x31, 0xdf, 0xc6, 0xfa, 0x7f, 0xd3, 0x59, 0xc6, 0x78, 0x50, 0x7d, 0xcd,
+	0x9a, 0xbe, 0x97, 0x70, 0x39, 0xe3, 0x32, 0x18, 0x53, 0x09, 0xc1, 0xe9, 0xde, 0x18, 0x14, 0xdd,
+	0x0b, 0x12, 0x9e, 0x59, 0x8d, 0x7e, 0xcf, 0xc4, 0xe3, 0x0a, 0x05, 0x06, 0xd8, 0xd0, 0x3f, 0x53,
+	0x3e, 0xe5, 0x86, 0xd7, 0x7f, 0x73, 0xc1, 0x29, 0xe7, 0xd3, 0x1c, 0x82, 0x0a, 0x8d, 0xcb, 0x49,
+	0x90, 0x96, 0x82, 0xaa, 0x8c, 0x5b, 0xc1, 0xdd, 0x2f, 0x6b, 0xa8, 0x33, 0x62, 0x52, 0xd1, 0x3c,
+	0x9f, 0x01, 0x53, 0xb8, 0x87, 0xda, 0x69, 0x09, 0x71, 0x4a, 0x15, 0x10, 0x67, 0xe0, 0x0c, 0xeb,
+	0x51, 0x2b, 0x2d, 0xe1, 0x88, 0x2a, 0xc0, 0xcf, 0x90, 0x5b, 0x88, 0x8c, 0x25, 0x59, 0x41, 0x73,
+	0xb2, 0x36, 0x70, 0x86, 0x6e, 0xf8, 0xf0, 0xe2, 0x6a, 0xa7, 0xf6, 0xf5, 0x6a, 0xe7, 0x5f, 0x53,
+	0x89, 0x4c, 0x5f, 0xfb, 0x19, 0x0f, 0x66, 0x54, 0x9d, 0xf8, 0x23, 0xa6, 0x3e, 0x7d, 0x7c, 0x80,
+	0x6c, 0x89, 0x23, 0xa6, 0xde, 0xff, 0xf8, 0x70, 0xdf, 0x89, 0x6e, 0x25, 0xf0, 0x31, 0x6a, 0x67,
+	0x4c, 0x81, 0x00, 0xa9, 0x48, 0x7d, 0x45, 0xb9, 0x85, 0x02, 0x3e, 0x42, 0x8d, 0x82, 0x66, 0x29,
+	0x69, 0xac, 0xa8, 0x54, 0xed, 0xc6, 0x4f, 0x51, 0x3b, 0xa7, 0x0a, 0xe2, 0x09, 0x00, 0xf9, 0x6b,
+	0x45, 0xa5, 0x96, 0x56, 0x78, 0x02, 0xb0, 0xfb, 0xb3, 0x81, 0x1a, 0xc7, 0x9c, 0x32, 0xbc, 0x8d,
+	0x5a, 0xda, 0x07, 0x71, 0x96, 0x56, 0x3d, 0x75, 0xa3, 0xa6, 0x86, 0xa3, 0x14, 0xf7, 0x51, 0x7b,
+	0xcc, 0x85, 0xe0, 0x6f, 0x40, 0x98, 0x8e, 0x46, 0x0b, 0x8c, 0xff, 0x43, 0x4d, 0x6d, 0x14, 0x10,
+	0xa6, 0x39, 0x91, 0x45, 0x38, 0x5c, 0x1e, 0x83, 0x3e, 0x6d, 0x67, 0xbf, 0xe7, 0xdb, 0x1a, 0xb4,
+	0x6d, 0x7c, 0x6b, 0x1b, 0xff, 0x90, 0x67, 0x2c, 0x74, 0x75, 0xf9, 0x7f, 0xb4, 0xbe, 0x87, 0xda,
+	0x42, 0x1f, 0x73, 0x5c, 0xc8, 0xea, 0x98, 0x1b, 0x51, 0x4b, 0xe3, 0xb0, 0x90, 0x18, 0xa3, 0x86,
+	0x02, 0x31, 0x23, 0xcd, 0x8a, 0xae, 0xfe, 0xf1, 0x73, 0xb4, 0x55, 0xd0, 0x73, 0xed, 0x8f, 0xb8,
+	0xea, 0xf7, 0x29, 0xcd, 0x49, 0xcb, 0x66, 0x36, 0xfe, 0xf2, 0xe7, 0xfe, 0xf2, 0x8f, 0xac, 0xbf,
+	0xc2, 0x0d, 0x9d, 0xf9, 0xdd, 0xb7, 0x1d, 0xc7, 0x64, 0xef, 0x5a, 0x85, 0x91, 0x15, 0xc0, 0xf7,
+	0x50, 0x37, 0xe1, 0xb9, 0xee, 0x95, 0xa0, 0x79, 0x2c, 0x60, 0x22, 0x49, 0x7b, 0x50, 0x1f, 0xba,
+	0xd1, 0xe6, 0x2d, 0x1d, 0xc1, 0x44, 0xe2, 0x57, 0x68, 0x6b, 0x69, 0xe1, 0x29, 0xcd, 0x4b, 0x20,
+	0xee, 0x8a, 0xb3, 0x59, 0x4a, 0xf9, 0x42, 0x0b, 0xe1, 0xbb, 0x68, 0x5d, 0xf2, 0x52, 0x24, 0x10,
+	0x27, 0x27, 0x34, 0x63, 0x04, 0x55, 0xbd, 0xee, 0x18, 0xee, 0x50, 0x53, 0x7a, 0x10, 0x52, 0x51,
+	0x55, 0x4a, 0xd2, 0x31, 0x83, 0x30, 0x08, 0x3f, 0x46, 0x6d, 0x99, 0x9c, 0x40, 0x5a, 0xe6, 0x40,
+	0xd6, 0x07, 0xf5, 0x61, 0x67, 0xff, 0x7f, 0xff, 0xb7, 0x2b, 0xee, 0x2f, 0x5d, 0xad, 0xb0, 0xa1,
+	0xab, 0x8d, 0x16, 0x7b, 0x74, 0x6a, 0x06, 0x67, 0x2a, 0xa6, 0x49, 0x22, 0x4a, 0x9a, 0x93, 0x8d,
+	0xea, 0xba, 0x75, 0x34, 0x77, 0x60, 0x28, 0x7c, 0x07, 0xa1, 0x44, 0x00, 0x55, 0x90, 0xc6, 0x54,
+	0x91, 0xcd, 0x6a, 0x81, 0x6b, 0x99, 0x03, 0xa5, 0xc3, 0x65, 0x91, 0xce, 0xc3, 0x5d, 0x13, 0xb6,
+	0xcc, 0x81, 0x0a, 0xf7, 0x2e, 0xae, 0x3d, 0xe7, 0xf2, 0xda, 0x73, 0xbe, 0x5f, 0x7b, 0xce, 0xdb,
+	0x1b, 0xaf, 0x76, 0x79, 0xe3, 0xd5, 0x3e, 0xdf, 0x78, 0xb5, 0x97, 0xdb, 0xf3, 0x67, 0xea, 0x6c,
+	0xf1, 0x50, 0xa9, 0xf3, 0x02, 0xe4, 0xb8, 0x59, 0xcd, 0xf1, 0xd1, 0xaf, 0x01, 0x00, 0x28, 0x2d,
+	0x41, 0xd9, 0xc8, 0x04, 0x00, 0x00,
+}
+
+func (m *Installment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Installment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Installment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LateFee.Size()
+		i -= size
+		if _, err := m.LateFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Interest.Size()
+		i -= size
+		if _, err := m.Interest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Principal.Size()
+		i -= size
+		if _, err := m.Principal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DueDate != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.DueDate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Loan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Loan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Loan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NextAccrual != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.NextAccrual))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLoan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.CollateralValue.Size()
+		i -= size
+		if _, err := m.CollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CollateralRefs) > 0 {
+		for iNdEx := len(m.CollateralRefs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CollateralRefs[iNdEx])
+			copy(dAtA[i:], m.CollateralRefs[iNdEx])
+			i = encodeVarintLoan(dAtA, i, uint64(len(m.CollateralRefs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PaymentInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PaymentInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLoan(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Term != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RateBps != 0 {
+		i = encodeVarintLoan(dAtA, i, uint64(m.RateBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLoan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LoanId) > 0 {
+		i -= len(m.LoanId)
+		copy(dAtA[i:], m.LoanId)
+		i = encodeVarintLoan(dAtA, i, uint64(len(m.LoanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLoan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLoan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Installment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DueDate != 0 {
+		n += 1 + sovLoan(uint64(m.DueDate))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = m.Interest.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = m.LateFee.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	return n
+}
+
+func (m *Loan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LoanId)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	if m.RateBps != 0 {
+		n += 1 + sovLoan(uint64(m.RateBps))
+	}
+	if m.Term != 0 {
+		n += 1 + sovLoan(uint64(m.Term))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PaymentInterval)
+	n += 1 + l + sovLoan(uint64(l))
+	if len(m.CollateralRefs) > 0 {
+		for _, s := range m.CollateralRefs {
+			l = len(s)
+			n += 1 + l + sovLoan(uint64(l))
+		}
+	}
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovLoan(uint64(l))
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovLoan(uint64(l))
+	}
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovLoan(uint64(l))
+		}
+	}
+	if m.NextAccrual != 0 {
+		n += 1 + sovLoan(uint64(m.NextAccrual))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovLoan(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovLoan(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovLoan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLoan(x uint64) (n int) {
+	return sovLoan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Installment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Installment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Installment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
+			}
+			m.DueDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Interest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LateFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Loan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLoan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Loan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Loan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateBps", wireType)
+			}
+			m.RateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PaymentInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRefs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralRefs = append(m.CollateralRefs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLoan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, Installment{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAccrual", wireType)
+			}
+			m.NextAccrual = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAccrual |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLoan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLoan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLoan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLoan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLoan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLoan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLoan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLoan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLoan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLoan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLoan = fmt.Errorf("proto: unexpected end of group")
+)
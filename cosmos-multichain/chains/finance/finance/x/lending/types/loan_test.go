@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"finance/x/lending/types"
+)
+
+func TestAmortizationSchedule(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	month := 30 * 24 * time.Hour
+
+	for _, tc := range []struct {
+		desc      string
+		principal int64
+		rateBps   uint32
+		term      uint32
+	}{
+		{desc: "interest free", principal: 1_000, rateBps: 0, term: 3},
+		{desc: "annual rate", principal: 120_000, rateBps: 1_200, term: 12},
+		{desc: "single installment", principal: 500, rateBps: 500, term: 1},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			schedule := types.AmortizationSchedule(sdkmath.NewInt(tc.principal), tc.rateBps, tc.term, month, start)
+			require.Len(t, schedule, int(tc.term))
+
+			repaid := sdkmath.ZeroInt()
+			for i, installment := range schedule {
+				require.Equal(t, start.Add(time.Duration(i+1)*month).Unix(), installment.DueDate)
+				require.False(t, installment.Principal.IsNegative())
+				require.True(t, installment.Paid.IsZero())
+				if tc.rateBps == 0 {
+					require.True(t, installment.Interest.IsZero())
+				}
+				if i > 0 {
+					require.True(t, installment.Interest.LTE(schedule[i-1].Interest))
+				}
+				repaid = repaid.Add(installment.Principal)
+			}
+			require.Equal(t, sdkmath.NewInt(tc.principal), repaid)
+		})
+	}
+
+	// 12% a year on 120000 over twelve 30 day periods pays 1183 interest first
+	schedule := types.AmortizationSchedule(sdkmath.NewInt(120_000), 1_200, 12, month, start)
+	require.Equal(t, sdkmath.NewInt(1_183), schedule[0].Interest)
+	require.Equal(t, schedule[0].Amount(), schedule[1].Amount())
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFundLoan{}
+
+func NewMsgFundLoan(creator string, loanId string) *MsgFundLoan {
+	return &MsgFundLoan{
+		Creator: creator,
+		LoanId:  loanId,
+	}
+}
+
+func (msg *MsgFundLoan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.LoanId == "" {
+		return errorsmod.Wrap(ErrInvalidLoan, "loan id cannot be empty")
+	}
+	return nil
+}
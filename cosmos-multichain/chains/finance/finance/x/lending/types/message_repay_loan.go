@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRepayLoan{}
+
+func NewMsgRepayLoan(creator string, loanId string, amount sdk.Coin) *MsgRepayLoan {
+	return &MsgRepayLoan{
+		Creator: creator,
+		LoanId:  loanId,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgRepayLoan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.LoanId == "" {
+		return errorsmod.Wrap(ErrInvalidLoan, "loan id cannot be empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid repayment amount %s", msg.Amount)
+	}
+	return nil
+}
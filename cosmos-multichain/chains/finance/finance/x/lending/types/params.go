@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyMaxLoanDuration = []byte("MaxLoanDuration")
+	// DefaultMaxLoanDuration mirrors finance.features.lending.max_loan_duration in config.yml
+	DefaultMaxLoanDuration = 8760 * time.Hour
+)
+
+var (
+	KeyMinCollateralization = []byte("MinCollateralization")
+	// DefaultMinCollateralization mirrors finance.features.lending.min_collateralization in config.yml
+	DefaultMinCollateralization uint32 = 150
+)
+
+var (
+	KeyLateFeeRateBps = []byte("LateFeeRateBps")
+	// DefaultLateFeeRateBps accrues 0.1% of the overdue amount per interval
+	DefaultLateFeeRateBps uint32 = 10
+)
+
+var (
+	KeyLateFeeInterval = []byte("LateFeeInterval")
+	// DefaultLateFeeInterval accrues late fees daily
+	DefaultLateFeeInterval = 24 * time.Hour
+)
+
+var (
+	KeyGracePeriod = []byte("GracePeriod")
+	// DefaultGracePeriod gives borrowers three days past the due date
+	DefaultGracePeriod = 72 * time.Hour
+)
+
+var (
+	KeyDefaultThreshold = []byte("DefaultThreshold")
+	// DefaultDefaultThreshold defaults a loan on its third overdue installment
+	DefaultDefaultThreshold uint32 = 3
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxLoanDuration time.Duration,
+	minCollateralization uint32,
+	lateFeeRateBps uint32,
+	lateFeeInterval time.Duration,
+	gracePeriod time.Duration,
+	defaultThreshold uint32,
+) Params {
+	return Params{
+		MaxLoanDuration:      maxLoanDuration,
+		MinCollateralization: minCollateralization,
+		LateFeeRateBps:       lateFeeRateBps,
+		LateFeeInterval:      lateFeeInterval,
+		GracePeriod:          gracePeriod,
+		DefaultThreshold:     defaultThreshold,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxLoanDuration,
+		DefaultMinCollateralization,
+		DefaultLateFeeRateBps,
+		DefaultLateFeeInterval,
+		DefaultGracePeriod,
+		DefaultDefaultThreshold,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxLoanDuration, &p.MaxLoanDuration, validatePositiveDuration),
+		paramtypes.NewParamSetPair(KeyMinCollateralization, &p.MinCollateralization, validateUint32),
+		paramtypes.NewParamSetPair(KeyLateFeeRateBps, &p.LateFeeRateBps, validateLateFeeRateBps),
+		paramtypes.NewParamSetPair(KeyLateFeeInterval, &p.LateFeeInterval, validatePositiveDuration),
+		paramtypes.NewParamSetPair(KeyGracePeriod, &p.GracePeriod, validateGracePeriod),
+		paramtypes.NewParamSetPair(KeyDefaultThreshold, &p.DefaultThreshold, validateDefaultThreshold),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validatePositiveDuration(p.MaxLoanDuration); err != nil {
+		return err
+	}
+	if err := validateLateFeeRateBps(p.LateFeeRateBps); err != nil {
+		return err
+	}
+	if err := validatePositiveDuration(p.LateFeeInterval); err != nil {
+		return err
+	}
+	if err := validateGracePeriod(p.GracePeriod); err != nil {
+		return err
+	}
+	return validateDefaultThreshold(p.DefaultThreshold)
+}
+
+// validatePositiveDuration validates the MaxLoanDuration and LateFeeInterval params
+func validatePositiveDuration(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("duration must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateUint32 validates the MinCollateralization param
+func validateUint32(v interface{}) error {
+	if _, ok := v.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateLateFeeRateBps validates the LateFeeRateBps param
+func validateLateFeeRateBps(v interface{}) error {
+	rate, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if rate > BasisPoints {
+		return fmt.Errorf("late fee rate cannot exceed %d bps: %d", BasisPoints, rate)
+	}
+	return nil
+}
+
+// validateGracePeriod validates the GracePeriod param
+func validateGracePeriod(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d < 0 {
+		return fmt.Errorf("grace period cannot be negative: %s", d)
+	}
+	return nil
+}
+
+// validateDefaultThreshold validates the DefaultThreshold param
+func validateDefaultThreshold(v interface{}) error {
+	threshold, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if threshold == 0 {
+		return fmt.Errorf("default threshold must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/lending/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// max_loan_duration caps the term of a loan times its payment interval.
+	MaxLoanDuration time.Duration `protobuf:"bytes,1,opt,name=max_loan_duration,json=maxLoanDuration,proto3,stdduration" json:"max_loan_duration"`
+	// min_collateralization is the collateral value a loan needs, as a
+	// percentage of its principal. Zero allows unsecured loans.
+	MinCollateralization uint32 `protobuf:"varint,2,opt,name=min_collateralization,json=minCollateralization,proto3" json:"min_collateralization,omitempty"`
+	// late_fee_rate_bps is the late fee, in basis points of the overdue amount,
+	// accrued on an overdue installment every late_fee_interval.
+	LateFeeRateBps uint32 `protobuf:"varint,3,opt,name=late_fee_rate_bps,json=lateFeeRateBps,proto3" json:"late_fee_rate_bps,omitempty"`
+	// late_fee_interval is the time between two late fee accruals.
+	LateFeeInterval time.Duration `protobuf:"bytes,4,opt,name=late_fee_interval,json=lateFeeInterval,proto3,stdduration" json:"late_fee_interval"`
+	// grace_period is how long after its due date an installment becomes overdue.
+	GracePeriod time.Duration `protobuf:"bytes,5,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+	// default_threshold is the number of overdue installments that defaults a loan.
+	DefaultThreshold uint32 `protobuf:"varint,6,opt,name=default_threshold,json=defaultThreshold,proto3" json:"default_threshold,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c05de69aee4d0d52, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxLoanDuration() time.Duration {
+	if m != nil {
+		return m.MaxLoanDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinCollateralization() uint32 {
+	if m != nil {
+		return m.MinCollateralization
+	}
+	return 0
+}
+
+func (m *Params) GetLateFeeRateBps() uint32 {
+	if m != nil {
+		return m.LateFeeRateBps
+	}
+	return 0
+}
+
+func (m *Params) GetLateFeeInterval() time.Duration {
+	if m != nil {
+		return m.LateFeeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultThreshold() uint32 {
+	if m != nil {
+		return m.DefaultThreshold
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "finance.lending.Params")
+}
+
+func init() { proto.RegisterFile("finance/lending/params.proto", fileDescriptor_c05de69aee4d0d52) }
+
+var fileDescriptor_c05de69aee4d0d52 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0x7d, 0xa5, 0x65, 0x30, 0xa5, 0xd4, 0x16, 0x55, 0x5d, 0x54, 0x19, 0xda, 0x89, 0x52,
+	0xc9, 0x56, 0xc2, 0x96, 0x91, 0x44, 0x91, 0xa2, 0x64, 0x40, 0x88, 0x29, 0x8b, 0x75, 0xe0, 0x67,
+	0x73, 0xd2, 0xf9, 0xce, 0x3a, 0x1f, 0x11, 0xc9, 0x47, 0xc8, 0x94, 0x31, 0x63, 0xc6, 0x8c, 0xe4,
+	0x5b, 0x30, 0x32, 0x66, 0x4a, 0x22, 0x18, 0xc8, 0xc7, 0x88, 0x7c, 0xb6, 0x19, 0x92, 0x89, 0xc5,
+	0x7a, 0x7e, 0xbf, 0xff, 0xff, 0x7f, 0xef, 0x74, 0x4f, 0xff, 0x1d, 0x10, 0x86, 0xd9, 0x18, 0x5c,
+	0x0a, 0xcc, 0x27, 0x2c, 0x74, 0x63, 0x2c, 0x70, 0x94, 0x38, 0xb1, 0xe0, 0x92, 0x9b, 0xb5, 0x9c,
+	0x3a, 0x39, 0x6d, 0x18, 0x38, 0x22, 0x8c, 0xbb, 0xea, 0x9b, 0x69, 0x1a, 0xf5, 0x90, 0x87, 0x5c,
+	0x95, 0x6e, 0x5a, 0xe5, 0x5d, 0x3b, 0xe4, 0x3c, 0xa4, 0xe0, 0xaa, 0xbf, 0xd1, 0x34, 0x70, 0xfd,
+	0xa9, 0xc0, 0x92, 0x70, 0x96, 0xf1, 0xbf, 0x0f, 0x25, 0xbd, 0xdc, 0x57, 0x47, 0x99, 0x43, 0xdd,
+	0x88, 0xf0, 0xcc, 0xa3, 0x1c, 0x33, 0xaf, 0x50, 0x59, 0xa8, 0x85, 0xda, 0x95, 0xfd, 0x5f, 0x4e,
+	0x16, 0xe3, 0x14, 0x31, 0xce, 0x51, 0x2e, 0xe8, 0x55, 0x17, 0x4f, 0x4d, 0xed, 0xf6, 0xb9, 0x89,
+	0xee, 0x37, 0xf3, 0x0e, 0x1a, 0xd4, 0x22, 0x3c, 0x3b, 0xe3, 0x98, 0x15, 0xdc, 0xec, 0xea, 0x3f,
+	0x22, 0xc2, 0xbc, 0x31, 0xa7, 0x14, 0x4b, 0x10, 0x98, 0x92, 0xab, 0x2c, 0xf9, 0x53, 0x0b, 0xb5,
+	0xab, 0x83, 0x7a, 0x44, 0xd8, 0xe1, 0x7b, 0x66, 0xfe, 0xd3, 0x8d, 0xb4, 0xe3, 0x05, 0x00, 0x9e,
+	0x48, 0x8b, 0x51, 0x9c, 0x58, 0x25, 0x65, 0xf8, 0x96, 0x82, 0x63, 0x80, 0x01, 0x96, 0xd0, 0x8b,
+	0xd5, 0xd4, 0x5b, 0x29, 0x61, 0x12, 0xc4, 0x05, 0xa6, 0xd6, 0xe7, 0x5d, 0xa7, 0xce, 0x43, 0x4f,
+	0xf2, 0x00, 0xf3, 0x54, 0xff, 0x1a, 0x0a, 0x3c, 0x06, 0x2f, 0x06, 0x41, 0xb8, 0x6f, 0x7d, 0xd9,
+	0x31, 0xb0, 0xa2, 0xdc, 0x7d, 0x65, 0x36, 0xff, 0xeb, 0x86, 0x0f, 0x01, 0x9e, 0x52, 0xe9, 0xc9,
+	0x89, 0x80, 0x64, 0xc2, 0xa9, 0x6f, 0x95, 0xd5, 0x6d, 0xbe, 0xe7, 0x60, 0x58, 0xf4, 0x0f, 0xfe,
+	0xbc, 0xde, 0x35, 0xd1, 0xf5, 0x66, 0xde, 0xb1, 0x8a, 0x8d, 0x98, 0x6d, 0x77, 0x22, 0x7b, 0xa8,
+	0xde, 0xde, 0x62, 0x65, 0xa3, 0xe5, 0xca, 0x46, 0x2f, 0x2b, 0x1b, 0xdd, 0xac, 0x6d, 0x6d, 0xb9,
+	0xb6, 0xb5, 0xc7, 0xb5, 0xad, 0x9d, 0xff, 0xfc, 0xe8, 0x91, 0x97, 0x31, 0x24, 0xa3, 0xb2, 0x9a,
+	0xb8, 0xfb, 0x36, 0x00, 0x5d, 0x02, 0xf7, 0x6f, 0x67, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxLoanDuration != that1.MaxLoanDuration {
+		return false
+	}
+	if this.MinCollateralization != that1.MinCollateralization {
+		return false
+	}
+	if this.LateFeeRateBps != that1.LateFeeRateBps {
+		return false
+	}
+	if this.LateFeeInterval != that1.LateFeeInterval {
+		return false
+	}
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.DefaultThreshold != that1.DefaultThreshold {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefaultThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LateFeeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LateFeeInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.LateFeeRateBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LateFeeRateBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinCollateralization != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCollateralization))
+		i--
+		dAtA[i] = 0x10
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLoanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLoanDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLoanDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinCollateralization != 0 {
+		n += 1 + sovParams(uint64(m.MinCollateralization))
+	}
+	if m.LateFeeRateBps != 0 {
+		n += 1 + sovParams(uint64(m.LateFeeRateBps))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LateFeeInterval)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.DefaultThreshold != 0 {
+		n += 1 + sovParams(uint64(m.DefaultThreshold))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLoanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLoanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCollateralization", wireType)
+			}
+			m.MinCollateralization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCollateralization |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFeeRateBps", wireType)
+			}
+			m.LateFeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateFeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFeeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LateFeeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultThreshold", wireType)
+			}
+			m.DefaultThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
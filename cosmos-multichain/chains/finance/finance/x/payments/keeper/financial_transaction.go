@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"finance/x/payments/types"
+)
+
+// SetFinancialTransaction set a specific financialTransaction in the store from its index
+// and files it under the sender and recipient address index
+func (k Keeper) SetFinancialTransaction(ctx context.Context, financialTransaction types.FinancialTransaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FinancialTransactionKeyPrefix))
+	b := k.cdc.MustMarshal(&financialTransaction)
+	store.Set(types.FinancialTransactionKey(
+		financialTransaction.TransactionId,
+	), b)
+
+	index := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FinancialTransactionAddressIndexPrefix))
+	transactionId := []byte(financialTransaction.TransactionId)
+	for _, address := range []string{financialTransaction.FromAddress, financialTransaction.ToAddress} {
+		index.Set(types.FinancialTransactionAddressIndexKey(address, financialTransaction.TransactionId), transactionId)
+	}
+}
+
+// GetFinancialTransaction returns a financialTransaction from its index
+func (k Keeper) GetFinancialTransaction(
+	ctx context.Context,
+	transactionId string,
+) (val types.FinancialTransaction, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FinancialTransactionKeyPrefix))
+
+	b := store.Get(types.FinancialTransactionKey(
+		transactionId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFinancialTransaction returns all financialTransaction
+func (k Keeper) GetAllFinancialTransaction(ctx context.Context) (list []types.FinancialTransaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FinancialTransactionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FinancialTransaction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
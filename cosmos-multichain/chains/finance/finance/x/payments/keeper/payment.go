@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"finance/x/payments/types"
+)
+
+// CalculateFee returns the fee charged on amount by the fee schedule of txType.
+// Transaction types without a schedule are free.
+func (k Keeper) CalculateFee(ctx context.Context, txType string, amount sdkmath.Int) sdkmath.Int {
+	schedule, found := k.GetParams(ctx).FeeSchedule(txType)
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	return schedule.Fee(amount)
+}
+
+// ProcessFinancialTransaction records a payment or transfer and moves its
+// amount from the sender to the recipient, charging the sender the scheduled
+// fee on top. The recorded transaction is returned COMPLETED.
+func (k Keeper) ProcessFinancialTransaction(ctx context.Context, tx types.FinancialTransaction) (types.FinancialTransaction, error) {
+	from, to, err := k.validateFinancialTransaction(ctx, tx)
+	if err != nil {
+		return tx, err
+	}
+
+	tx.Fee = sdk.NewCoin(tx.Amount.Denom, k.CalculateFee(ctx, tx.TxType, tx.Amount.Amount))
+	spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(tx.Amount.Denom)
+	if spendable.LT(tx.Amount.Amount.Add(tx.Fee.Amount)) {
+		return tx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s%s is smaller than %s plus %s fee", spendable, tx.Amount.Denom, tx.Amount, tx.Fee)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	tx.Status = types.TransactionStatusPending
+	tx.CreatedAt = now
+	tx.UpdatedAt = now
+	k.SetFinancialTransaction(ctx, tx)
+	emitFinancialTransactionEvent(ctx, tx)
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(tx.Amount)); err != nil {
+		return tx, err
+	}
+	if tx.Fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, authtypes.FeeCollectorName, sdk.NewCoins(tx.Fee)); err != nil {
+			return tx, err
+		}
+	}
+
+	return k.setFinancialTransactionStatus(ctx, tx, types.TransactionStatusCompleted)
+}
+
+// SettleFinancialTransaction marks a completed transaction as settled
+func (k Keeper) SettleFinancialTransaction(ctx context.Context, transactionId string) error {
+	tx, found := k.GetFinancialTransaction(ctx, transactionId)
+	if !found {
+		return errorsmod.Wrap(types.ErrTransactionNotFound, transactionId)
+	}
+	_, err := k.setFinancialTransactionStatus(ctx, tx, types.TransactionStatusSettled)
+	return err
+}
+
+func (k Keeper) validateFinancialTransaction(ctx context.Context, tx types.FinancialTransaction) (from, to sdk.AccAddress, err error) {
+	if tx.TransactionId == "" {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidTransaction, "transaction ID is required")
+	}
+	if _, found := k.GetFinancialTransaction(ctx, tx.TransactionId); found {
+		return nil, nil, errorsmod.Wrap(types.ErrTransactionExists, tx.TransactionId)
+	}
+	if !types.IsFundsTxType(tx.TxType) {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "transaction type %s does not move funds", tx.TxType)
+	}
+	if from, err = sdk.AccAddressFromBech32(tx.FromAddress); err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "invalid from address: %s", err)
+	}
+	if to, err = sdk.AccAddressFromBech32(tx.ToAddress); err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "invalid to address: %s", err)
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "%s is not allowed to receive funds", tx.ToAddress)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsAllowedDenom(tx.Amount.Denom) {
+		return nil, nil, errorsmod.Wrap(types.ErrDenomNotAllowed, tx.Amount.Denom)
+	}
+	if tx.Amount.Amount.IsNil() || !tx.Amount.Amount.IsPositive() {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidTransaction, "amount must be positive")
+	}
+	if params.IsOverMaxTransactionSize(tx.Amount.Amount) {
+		return nil, nil, errorsmod.Wrapf(types.ErrTransactionTooLarge, "%s > %s", tx.Amount.Amount, params.MaxTransactionSize)
+	}
+	return from, to, nil
+}
+
+func (k Keeper) setFinancialTransactionStatus(ctx context.Context, tx types.FinancialTransaction, status string) (types.FinancialTransaction, error) {
+	if !types.CanTransition(tx.Status, status) {
+		return tx, errorsmod.Wrapf(types.ErrInvalidStatusTransition, "%s: %s -> %s", tx.TransactionId, tx.Status, status)
+	}
+	tx.Status = status
+	tx.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	k.SetFinancialTransaction(ctx, tx)
+	emitFinancialTransactionEvent(ctx, tx)
+	return tx, nil
+}
+
+func emitFinancialTransactionEvent(ctx context.Context, tx types.FinancialTransaction) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFinancialTransaction,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, tx.TransactionId),
+			sdk.NewAttribute(types.AttributeKeyTxType, tx.TxType),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, tx.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyToAddress, tx.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, tx.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, tx.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, tx.Status),
+		),
+	)
+}
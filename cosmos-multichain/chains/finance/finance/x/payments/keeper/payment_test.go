@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "finance/testutil/keeper"
+	"finance/testutil/sample"
+	"finance/x/payments/types"
+)
+
+func payment(id, from, to string, amount sdk.Coin) types.FinancialTransaction {
+	return types.FinancialTransaction{
+		TransactionId: id,
+		FromAddress:   from,
+		ToAddress:     to,
+		Amount:        amount,
+		TxType:        types.TxTypePayment,
+	}
+}
+
+func TestCalculateFee(t *testing.T) {
+	k, ctx := keepertest.PaymentsKeeper(t)
+
+	require.Equal(t, sdkmath.NewInt(1), k.CalculateFee(ctx, types.TxTypePayment, sdkmath.NewInt(100)))
+	require.Equal(t, sdkmath.NewInt(50), k.CalculateFee(ctx, types.TxTypePayment, sdkmath.NewInt(50_000)))
+	require.Equal(t, sdkmath.NewInt(25), k.CalculateFee(ctx, types.TxTypeTransfer, sdkmath.NewInt(50_000)))
+	require.True(t, k.CalculateFee(ctx, "LOAN", sdkmath.NewInt(50_000)).IsZero())
+
+	params := types.DefaultParams()
+	params.FeeSchedules = []types.FeeSchedule{{TxType: types.TxTypeTransfer, RateBps: 100, MinFee: sdkmath.NewInt(10)}}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, sdkmath.NewInt(10), k.CalculateFee(ctx, types.TxTypeTransfer, sdkmath.NewInt(100)))
+	require.True(t, k.CalculateFee(ctx, types.TxTypePayment, sdkmath.NewInt(50_000)).IsZero())
+}
+
+func TestProcessFinancialTransaction(t *testing.T) {
+	k, bank, ctx := keepertest.PaymentsKeeperWithBank(t)
+	from, to := sample.AccAddress(), sample.AccAddress()
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	bank.Fund(sdk.MustAccAddressFromBech32(from), sdk.NewCoins(sdk.NewInt64Coin("ufin", 1_000), sdk.NewInt64Coin(ibcDenom, 1_000)))
+
+	tx, err := k.ProcessFinancialTransaction(ctx, payment("tx-1", from, to, sdk.NewInt64Coin("ufin", 500)))
+	require.NoError(t, err)
+	require.Equal(t, types.TransactionStatusCompleted, tx.Status)
+	require.Equal(t, sdk.NewInt64Coin("ufin", 1), tx.Fee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufin", 500)), bank.Balance(sdk.MustAccAddressFromBech32(to)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufin", 1)), bank.ModuleBalance(authtypes.FeeCollectorName))
+
+	_, err = k.ProcessFinancialTransaction(ctx, payment("tx-2", from, to, sdk.NewInt64Coin(ibcDenom, 100)))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		tx   types.FinancialTransaction
+		err  error
+	}{
+		{desc: "Duplicated", tx: payment("tx-1", from, to, sdk.NewInt64Coin("ufin", 1)), err: types.ErrTransactionExists},
+		{desc: "DenomNotAllowed", tx: payment("tx-3", from, to, sdk.NewInt64Coin("uatom", 1)), err: types.ErrDenomNotAllowed},
+		{desc: "TooLarge", tx: payment("tx-3", from, to, sdk.NewCoin("ufin", types.DefaultMaxTransactionSize.AddRaw(1))), err: types.ErrTransactionTooLarge},
+		{desc: "InsufficientFunds", tx: payment("tx-3", from, to, sdk.NewInt64Coin("ufin", 1_000)), err: sdkerrors.ErrInsufficientFunds},
+		{desc: "InvalidAddress", tx: payment("tx-3", from, "bob", sdk.NewInt64Coin("ufin", 1)), err: types.ErrInvalidTransaction},
+		{desc: "NoFunds", tx: types.FinancialTransaction{TransactionId: "tx-3", FromAddress: from, ToAddress: to, Amount: sdk.NewInt64Coin("ufin", 1), TxType: "LOAN"}, err: types.ErrInvalidTransaction},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := k.ProcessFinancialTransaction(ctx, tc.tx)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	res, err := k.FinancialTransactionsByAddress(ctx, &types.QueryFinancialTransactionsByAddressRequest{Address: to})
+	require.NoError(t, err)
+	require.Len(t, res.FinancialTransaction, 2)
+
+	require.NoError(t, k.SettleFinancialTransaction(ctx, "tx-1"))
+	require.ErrorIs(t, k.SettleFinancialTransaction(ctx, "tx-1"), types.ErrInvalidStatusTransition)
+	require.ErrorIs(t, k.SettleFinancialTransaction(ctx, "missing"), types.ErrTransactionNotFound)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"finance/x/payments/types"
+)
+
+func (k Keeper) FinancialTransactionAll(ctx context.Context, req *types.QueryAllFinancialTransactionRequest) (*types.QueryAllFinancialTransactionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var financialTransactions []types.FinancialTransaction
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	financialTransactionStore := prefix.NewStore(store, types.KeyPrefix(types.FinancialTransactionKeyPrefix))
+
+	pageRes, err := query.Paginate(financialTransactionStore, req.Pagination, func(key []byte, value []byte) error {
+		var financialTransaction types.FinancialTransaction
+		if err := k.cdc.Unmarshal(value, &financialTransaction); err != nil {
+			return err
+		}
+
+		financialTransactions = append(financialTransactions, financialTransaction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFinancialTransactionResponse{FinancialTransaction: financialTransactions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FinancialTransaction(ctx context.Context, req *types.QueryGetFinancialTransactionRequest) (*types.QueryGetFinancialTransactionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetFinancialTransaction(
+		ctx,
+		req.TransactionId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetFinancialTransactionResponse{FinancialTransaction: val}, nil
+}
+
+func (k Keeper) FinancialTransactionsByAddress(ctx context.Context, req *types.QueryFinancialTransactionsByAddressRequest) (*types.QueryFinancialTransactionsByAddressResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var financialTransactions []types.FinancialTransaction
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, append(types.KeyPrefix(types.FinancialTransactionAddressIndexPrefix), types.FinancialTransactionAddressKey(req.Address)...))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		financialTransaction, found := k.GetFinancialTransaction(ctx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "dangling index entry for financial transaction %s", value)
+		}
+
+		financialTransactions = append(financialTransactions, financialTransaction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinancialTransactionsByAddressResponse{FinancialTransaction: financialTransactions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,52 @@
+package payments
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "finance/api/finance/payments"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "FinancialTransactionAll",
+					Use:       "list-financial-transaction",
+					Short:     "List all FinancialTransaction",
+				},
+				{
+					RpcMethod:      "FinancialTransaction",
+					Use:            "show-financial-transaction [transaction-id]",
+					Short:          "Shows a FinancialTransaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "FinancialTransactionsByAddress",
+					Use:            "financial-transactions-by-address [address]",
+					Short:          "List the FinancialTransaction sent or received by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
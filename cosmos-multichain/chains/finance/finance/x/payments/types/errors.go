@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/payments module sentinel errors
+var (
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTransaction      = sdkerrors.Register(ModuleName, 1101, "invalid financial transaction")
+	ErrTransactionExists       = sdkerrors.Register(ModuleName, 1102, "financial transaction already exists")
+	ErrTransactionNotFound     = sdkerrors.Register(ModuleName, 1103, "financial transaction not found")
+	ErrDenomNotAllowed         = sdkerrors.Register(ModuleName, 1104, "denom not allowed")
+	ErrTransactionTooLarge     = sdkerrors.Register(ModuleName, 1105, "transaction exceeds the max transaction size")
+	ErrInvalidStatusTransition = sdkerrors.Register(ModuleName, 1106, "invalid financial transaction status transition")
+)
@@ -0,0 +1,16 @@
+package types
+
+// payments module event types
+const (
+	// EventTypeFinancialTransaction is emitted on every status change of a
+	// FinancialTransaction and consumed by the callbacks of the other chains
+	EventTypeFinancialTransaction = "financial_transaction"
+
+	AttributeKeyTransactionId = "transaction_id"
+	AttributeKeyTxType        = "tx_type"
+	AttributeKeyFromAddress   = "from_address"
+	AttributeKeyToAddress     = "to_address"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyFee           = "fee"
+	AttributeKeyStatus        = "status"
+)
@@ -0,0 +1,32 @@
+package types
+
+// Transaction types that move funds
+const (
+	TxTypePayment  = "PAYMENT"
+	TxTypeTransfer = "TRANSFER"
+)
+
+// FinancialTransaction statuses. A transaction is recorded PENDING, becomes
+// COMPLETED once its funds moved and SETTLED when the finance transaction it
+// belongs to is finalized.
+const (
+	TransactionStatusPending   = "PENDING"
+	TransactionStatusCompleted = "COMPLETED"
+	TransactionStatusSettled   = "SETTLED"
+)
+
+// nextStatus maps each status to the status it may move to
+var nextStatus = map[string]string{
+	TransactionStatusPending:   TransactionStatusCompleted,
+	TransactionStatusCompleted: TransactionStatusSettled,
+}
+
+// IsFundsTxType reports whether transactions of txType move funds
+func IsFundsTxType(txType string) bool {
+	return txType == TxTypePayment || txType == TxTypeTransfer
+}
+
+// CanTransition reports whether a transaction may move from status from to status to
+func CanTransition(from, to string) bool {
+	return nextStatus[from] == to
+}
@@ -0,0 +1,43 @@
+package types
+
+const (
+	// FinancialTransactionKeyPrefix is the prefix to retrieve all FinancialTransaction
+	FinancialTransactionKeyPrefix = "FinancialTransaction/value/"
+
+	// FinancialTransactionAddressIndexPrefix indexes transactions by sender and recipient
+	FinancialTransactionAddressIndexPrefix = "FinancialTransaction/address/"
+)
+
+// FinancialTransactionKey returns the store key to retrieve a FinancialTransaction from the index fields
+func FinancialTransactionKey(
+	transactionId string,
+) []byte {
+	var key []byte
+
+	transactionIdBytes := []byte(transactionId)
+	key = append(key, transactionIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// FinancialTransactionAddressKey returns the prefix of the address index entries of an address
+func FinancialTransactionAddressKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// FinancialTransactionAddressIndexKey returns the key of an address index entry
+func FinancialTransactionAddressIndexKey(
+	address string,
+	transactionId string,
+) []byte {
+	return append(FinancialTransactionAddressKey(address), FinancialTransactionKey(transactionId)...)
+}
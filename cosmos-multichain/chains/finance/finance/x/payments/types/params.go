@@ -0,0 +1,180 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// maxRateBps is a fee of the whole transferred amount
+const maxRateBps = 10_000
+
+// ibcDenomPrefix prefixes the denoms of IBC vouchers
+const ibcDenomPrefix = "ibc/"
+
+var (
+	KeyFeeSchedules = []byte("FeeSchedules")
+	// DefaultFeeSchedules charges 0.1% on payments and 0.05% on transfers
+	DefaultFeeSchedules = []FeeSchedule{
+		{TxType: TxTypePayment, RateBps: 10, MinFee: sdkmath.OneInt()},
+		{TxType: TxTypeTransfer, RateBps: 5, MinFee: sdkmath.ZeroInt()},
+	}
+)
+
+var (
+	KeyMaxTransactionSize = []byte("MaxTransactionSize")
+	// DefaultMaxTransactionSize mirrors finance.features.payments.max_transaction_size in config.yml
+	DefaultMaxTransactionSize = sdkmath.NewInt(1_000_000)
+)
+
+var (
+	KeyAllowedDenoms = []byte("AllowedDenoms")
+	// DefaultAllowedDenoms mirrors token.base_denom in config.yml
+	DefaultAllowedDenoms = []string{"ufin"}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	feeSchedules []FeeSchedule,
+	maxTransactionSize sdkmath.Int,
+	allowedDenoms []string,
+) Params {
+	return Params{
+		FeeSchedules:       feeSchedules,
+		MaxTransactionSize: maxTransactionSize,
+		AllowedDenoms:      allowedDenoms,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultFeeSchedules,
+		DefaultMaxTransactionSize,
+		DefaultAllowedDenoms,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeSchedules, &p.FeeSchedules, validateFeeSchedules),
+		paramtypes.NewParamSetPair(KeyMaxTransactionSize, &p.MaxTransactionSize, validateMaxTransactionSize),
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateFeeSchedules(p.FeeSchedules); err != nil {
+		return err
+	}
+	if err := validateMaxTransactionSize(p.MaxTransactionSize); err != nil {
+		return err
+	}
+	return validateAllowedDenoms(p.AllowedDenoms)
+}
+
+// FeeSchedule returns the fee schedule of a transaction type
+func (p Params) FeeSchedule(txType string) (FeeSchedule, bool) {
+	for _, schedule := range p.FeeSchedules {
+		if schedule.TxType == txType {
+			return schedule, true
+		}
+	}
+	return FeeSchedule{}, false
+}
+
+// IsAllowedDenom reports whether denom can be moved: either an allowed native
+// denom or an IBC voucher
+func (p Params) IsAllowedDenom(denom string) bool {
+	if strings.HasPrefix(denom, ibcDenomPrefix) {
+		return sdk.ValidateDenom(denom) == nil
+	}
+	for _, d := range p.AllowedDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOverMaxTransactionSize reports whether amount exceeds the transaction size cap
+func (p Params) IsOverMaxTransactionSize(amount sdkmath.Int) bool {
+	return p.MaxTransactionSize.IsPositive() && amount.GT(p.MaxTransactionSize)
+}
+
+// Fee returns the fee charged by the schedule on amount
+func (s FeeSchedule) Fee(amount sdkmath.Int) sdkmath.Int {
+	fee := amount.MulRaw(int64(s.RateBps)).QuoRaw(maxRateBps)
+	if fee.LT(s.MinFee) {
+		return s.MinFee
+	}
+	return fee
+}
+
+// validateFeeSchedules validates the FeeSchedules param
+func validateFeeSchedules(v interface{}) error {
+	schedules, ok := v.([]FeeSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(schedules))
+	for _, s := range schedules {
+		if s.TxType == "" {
+			return fmt.Errorf("fee schedule transaction type cannot be empty")
+		}
+		if _, ok := seen[s.TxType]; ok {
+			return fmt.Errorf("duplicated fee schedule for %s", s.TxType)
+		}
+		seen[s.TxType] = struct{}{}
+		if s.RateBps > maxRateBps {
+			return fmt.Errorf("fee rate of %s cannot exceed %d bps: %d", s.TxType, maxRateBps, s.RateBps)
+		}
+		if s.MinFee.IsNil() || s.MinFee.IsNegative() {
+			return fmt.Errorf("minimum fee of %s cannot be negative", s.TxType)
+		}
+	}
+	return nil
+}
+
+// validateMaxTransactionSize validates the MaxTransactionSize param
+func validateMaxTransactionSize(v interface{}) error {
+	size, ok := v.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if size.IsNil() || size.IsNegative() {
+		return fmt.Errorf("max transaction size cannot be negative")
+	}
+	return nil
+}
+
+// validateAllowedDenoms validates the AllowedDenoms param
+func validateAllowedDenoms(v interface{}) error {
+	denoms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return err
+		}
+		if _, ok := seen[d]; ok {
+			return fmt.Errorf("duplicated denom %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+	return nil
+}
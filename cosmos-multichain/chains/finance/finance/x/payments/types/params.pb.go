@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/payments/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeSchedule is the fee charged on the transactions of a type.
+type FeeSchedule struct {
+	// tx_type is the FinancialTransaction type the schedule applies to.
+	TxType string `protobuf:"bytes,1,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// rate_bps is the fee in basis points of the transferred amount.
+	RateBps uint32 `protobuf:"varint,2,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	// min_fee is the lowest fee charged, in units of the transferred denom.
+	MinFee cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb4527d1c7a5a2e8, []int{0}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetTxType() string {
+	if m != nil {
+		return m.TxType
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetRateBps() uint32 {
+	if m != nil {
+		return m.RateBps
+	}
+	return 0
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	// fee_schedules lists the fees charged per transaction type. Transaction
+	// types without a schedule are free.
+	FeeSchedules []FeeSchedule `protobuf:"bytes,1,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules"`
+	// max_transaction_size caps the amount moved by a single transaction. Zero
+	// disables the cap.
+	MaxTransactionSize cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_transaction_size,json=maxTransactionSize,proto3,customtype=cosmossdk.io/math.Int" json:"max_transaction_size"`
+	// allowed_denoms lists the native denoms that can be moved. IBC vouchers
+	// are always accepted.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb4527d1c7a5a2e8, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeSchedules() []FeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeSchedule)(nil), "finance.payments.FeeSchedule")
+	proto.RegisterType((*Params)(nil), "finance.payments.Params")
+}
+
+func init() { proto.RegisterFile("finance/payments/params.proto", fileDescriptor_fb4527d1c7a5a2e8) }
+
+var fileDescriptor_fb4527d1c7a5a2e8 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0xcd, 0x34, 0x92, 0xda, 0x59, 0x2b, 0x3a, 0xac, 0x98, 0x2e, 0x6c, 0x1a, 0x0a, 0x42, 0x58,
+	0x30, 0x91, 0xf5, 0xb6, 0xc7, 0x20, 0x0b, 0x3d, 0x08, 0x92, 0xdd, 0x93, 0x97, 0x61, 0x9a, 0xfc,
+	0xb2, 0x3b, 0xd8, 0x99, 0x09, 0x99, 0x11, 0xd3, 0xfd, 0x00, 0x1e, 0x3c, 0x89, 0x9f, 0xc0, 0xa3,
+	0xc7, 0x3d, 0xf8, 0x21, 0xf6, 0xb8, 0x78, 0x12, 0x0f, 0x45, 0xda, 0x43, 0xfd, 0x18, 0x92, 0x3f,
+	0xa5, 0x22, 0x9e, 0xbc, 0x0c, 0xf3, 0xde, 0x9b, 0xdf, 0x8f, 0xf7, 0xe6, 0xe1, 0xc3, 0x9c, 0x4b,
+	0x26, 0x53, 0x88, 0x0a, 0xb6, 0x10, 0x20, 0x8d, 0x8e, 0x0a, 0x56, 0x32, 0xa1, 0xc3, 0xa2, 0x54,
+	0x46, 0x91, 0x07, 0x9d, 0x1c, 0x6e, 0xe5, 0x83, 0x87, 0x4c, 0x70, 0xa9, 0xa2, 0xe6, 0x6c, 0x1f,
+	0x1d, 0x8c, 0x52, 0xa5, 0x85, 0xd2, 0xb4, 0x41, 0x51, 0x0b, 0x3a, 0x69, 0xff, 0x42, 0x5d, 0xa8,
+	0x96, 0xaf, 0x6f, 0x2d, 0x3b, 0xf9, 0x84, 0xf0, 0xde, 0x29, 0xc0, 0x59, 0x7a, 0x09, 0xd9, 0xdb,
+	0x39, 0x90, 0xc7, 0xb8, 0x6f, 0x2a, 0x6a, 0x16, 0x05, 0xb8, 0xc8, 0x47, 0xc1, 0x20, 0x71, 0x4c,
+	0x75, 0xbe, 0x28, 0x80, 0x8c, 0xf0, 0xdd, 0x92, 0x19, 0xa0, 0xb3, 0x42, 0xbb, 0x3d, 0x1f, 0x05,
+	0xc3, 0xa4, 0x5f, 0xe3, 0xb8, 0xd0, 0x64, 0x8a, 0xfb, 0x82, 0x4b, 0x9a, 0x03, 0xb8, 0x76, 0x3d,
+	0x13, 0x3f, 0xbb, 0x59, 0x8e, 0xad, 0x1f, 0xcb, 0xf1, 0xa3, 0xd6, 0x80, 0xce, 0xde, 0x84, 0x5c,
+	0x45, 0x82, 0x99, 0xcb, 0x70, 0x2a, 0xcd, 0xb7, 0xaf, 0x4f, 0x71, 0xe7, 0x6c, 0x2a, 0xcd, 0x97,
+	0xcd, 0xf5, 0x11, 0x4a, 0x1c, 0xc1, 0xe5, 0x29, 0xc0, 0xc9, 0x9d, 0x5f, 0x9f, 0xc7, 0x68, 0xf2,
+	0xbe, 0x87, 0x9d, 0x57, 0x4d, 0x76, 0xf2, 0x12, 0x0f, 0x73, 0x00, 0xaa, 0x3b, 0x7f, 0xda, 0x45,
+	0xbe, 0x1d, 0xec, 0x1d, 0x1f, 0x86, 0x7f, 0xff, 0x46, 0xf8, 0x47, 0x8a, 0x78, 0x50, 0x1b, 0x68,
+	0x37, 0xdf, 0xcb, 0x77, 0xbc, 0x26, 0x33, 0xbc, 0x2f, 0x58, 0x45, 0x4d, 0xc9, 0xa4, 0x66, 0xa9,
+	0xe1, 0x4a, 0x52, 0xcd, 0xaf, 0xc0, 0xed, 0xfd, 0xa7, 0x6f, 0x22, 0x58, 0x75, 0xbe, 0x5b, 0x76,
+	0xc6, 0xaf, 0x80, 0x3c, 0xc1, 0xf7, 0xd9, 0x7c, 0xae, 0xde, 0x41, 0x46, 0x33, 0x90, 0x4a, 0x68,
+	0xd7, 0xf6, 0xed, 0x60, 0x90, 0x0c, 0x3b, 0xf6, 0x45, 0x43, 0x9e, 0x4c, 0xea, 0xa8, 0x1f, 0x36,
+	0xd7, 0x47, 0xa3, 0x6d, 0xef, 0xd5, 0xae, 0xf9, 0x36, 0x7d, 0x7c, 0x7c, 0xb3, 0xf2, 0xd0, 0xed,
+	0xca, 0x43, 0x3f, 0x57, 0x1e, 0xfa, 0xb8, 0xf6, 0xac, 0xdb, 0xb5, 0x67, 0x7d, 0x5f, 0x7b, 0xd6,
+	0x6b, 0xf7, 0x1f, 0x43, 0x75, 0x6b, 0x7a, 0xe6, 0x34, 0xc5, 0x3e, 0xff, 0x3d, 0x00, 0x71, 0x98,
+	0xae, 0xa7, 0x4f, 0x02, 0x00, 0x00,
+}
+
+func (this *FeeSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSchedule)
+	if !ok {
+		that2, ok := that.(FeeSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxType != that1.TxType {
+		return false
+	}
+	if this.RateBps != that1.RateBps {
+		return false
+	}
+	if !this.MinFee.Equal(that1.MinFee) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.FeeSchedules) != len(that1.FeeSchedules) {
+		return false
+	}
+	for i := range this.FeeSchedules {
+		if !this.FeeSchedules[i].Equal(&that1.FeeSchedules[i]) {
+			return false
+		}
+	}
+	if !this.MaxTransactionSize.Equal(that1.MaxTransactionSize) {
+		return false
+	}
+	if len(this.AllowedDenoms) != len(that1.AllowedDenoms) {
+		return false
+	}
+	for i := range this.AllowedDenoms {
+		if this.AllowedDenoms[i] != that1.AllowedDenoms[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RateBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxTransactionSize.Size()
+		i -= size
+		if _, err := m.MaxTransactionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RateBps != 0 {
+		n += 1 + sovParams(uint64(m.RateBps))
+	}
+	l = m.MinFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxTransactionSize.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateBps", wireType)
+			}
+			m.RateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, FeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransactionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransactionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
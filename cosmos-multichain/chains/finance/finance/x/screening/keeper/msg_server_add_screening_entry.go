@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"finance/x/screening/types"
+)
+
+func (k msgServer) AddScreeningEntry(goCtx context.Context, msg *types.MsgAddScreeningEntry) (*types.MsgAddScreeningEntryResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.ListEntry(goCtx, msg.Kind, msg.Value, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddScreeningEntryResponse{}, nil
+}
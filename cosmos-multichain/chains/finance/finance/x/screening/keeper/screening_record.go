@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/screening/types"
+)
+
+// GetScreeningRecordCount get the total number of screeningRecord
+func (k Keeper) GetScreeningRecordCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.ScreeningRecordCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetScreeningRecordCount set the total number of screeningRecord
+func (k Keeper) SetScreeningRecordCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.ScreeningRecordCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendScreeningRecord appends a record to the screening history at the current block time
+func (k Keeper) AppendScreeningRecord(ctx context.Context, action, value, detail string) uint64 {
+	count := k.GetScreeningRecordCount(ctx)
+	k.SetScreeningRecord(ctx, types.ScreeningRecord{
+		Id:        count,
+		Action:    action,
+		Value:     value,
+		Detail:    detail,
+		Timestamp: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	})
+	k.SetScreeningRecordCount(ctx, count+1)
+	return count
+}
+
+// SetScreeningRecord set a specific screeningRecord in the store
+func (k Keeper) SetScreeningRecord(ctx context.Context, screeningRecord types.ScreeningRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&screeningRecord)
+	store.Set(types.ScreeningRecordKey(screeningRecord.Id), b)
+}
+
+// GetAllScreeningRecord returns the screening history in the order it was appended
+func (k Keeper) GetAllScreeningRecord(ctx context.Context) (list []types.ScreeningRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ScreeningRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ScreeningRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
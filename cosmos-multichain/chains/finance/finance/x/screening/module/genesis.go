@@ -0,0 +1,41 @@
+package screening
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"finance/x/screening/keeper"
+	"finance/x/screening/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the screeningEntry
+	for _, elem := range genState.ScreeningEntryList {
+		k.SetScreeningEntry(ctx, elem)
+	}
+	// Set all the screeningRecord
+	for _, elem := range genState.ScreeningRecordList {
+		k.SetScreeningRecord(ctx, elem)
+	}
+
+	// Set screeningRecord count
+	k.SetScreeningRecordCount(ctx, genState.ScreeningRecordCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.ScreeningEntryList = k.GetAllScreeningEntry(ctx)
+	genesis.ScreeningRecordList = k.GetAllScreeningRecord(ctx)
+	genesis.ScreeningRecordCount = k.GetScreeningRecordCount(ctx)
+
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
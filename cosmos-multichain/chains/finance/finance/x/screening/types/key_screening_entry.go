@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ScreeningEntryKeyPrefix is the prefix to retrieve all ScreeningEntry
+	ScreeningEntryKeyPrefix = "ScreeningEntry/value/"
+
+	// ScreeningRecordKeyPrefix is the prefix to retrieve all ScreeningRecord
+	ScreeningRecordKeyPrefix = "ScreeningRecord/value/"
+
+	// ScreeningRecordCountKey is the key of the number of screening records ever appended
+	ScreeningRecordCountKey = "ScreeningRecord/count/"
+)
+
+// ScreeningEntryKey returns the store key to retrieve a ScreeningEntry from the index fields
+func ScreeningEntryKey(
+	value string,
+) []byte {
+	var key []byte
+
+	valueBytes := []byte(value)
+	key = append(key, valueBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ScreeningRecordKey returns the store key of a screening record, ordered by id
+func ScreeningRecordKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
@@ -0,0 +1,2225 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: finance/screening/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetScreeningEntryRequest struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryGetScreeningEntryRequest) Reset()         { *m = QueryGetScreeningEntryRequest{} }
+func (m *QueryGetScreeningEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScreeningEntryRequest) ProtoMessage()    {}
+func (*QueryGetScreeningEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{2}
+}
+func (m *QueryGetScreeningEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetScreeningEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScreeningEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetScreeningEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScreeningEntryRequest.Merge(m, src)
+}
+func (m *QueryGetScreeningEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetScreeningEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScreeningEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetScreeningEntryRequest proto.InternalMessageInfo
+
+func (m *QueryGetScreeningEntryRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type QueryGetScreeningEntryResponse struct {
+	ScreeningEntry ScreeningEntry `protobuf:"bytes,1,opt,name=screening_entry,json=screeningEntry,proto3" json:"screening_entry"`
+}
+
+func (m *QueryGetScreeningEntryResponse) Reset()         { *m = QueryGetScreeningEntryResponse{} }
+func (m *QueryGetScreeningEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetScreeningEntryResponse) ProtoMessage()    {}
+func (*QueryGetScreeningEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{3}
+}
+func (m *QueryGetScreeningEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetScreeningEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetScreeningEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetScreeningEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetScreeningEntryResponse.Merge(m, src)
+}
+func (m *QueryGetScreeningEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetScreeningEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetScreeningEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetScreeningEntryResponse proto.InternalMessageInfo
+
+func (m *QueryGetScreeningEntryResponse) GetScreeningEntry() ScreeningEntry {
+	if m != nil {
+		return m.ScreeningEntry
+	}
+	return ScreeningEntry{}
+}
+
+type QueryAllScreeningEntryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllScreeningEntryRequest) Reset()         { *m = QueryAllScreeningEntryRequest{} }
+func (m *QueryAllScreeningEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllScreeningEntryRequest) ProtoMessage()    {}
+func (*QueryAllScreeningEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{4}
+}
+func (m *QueryAllScreeningEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllScreeningEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllScreeningEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllScreeningEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllScreeningEntryRequest.Merge(m, src)
+}
+func (m *QueryAllScreeningEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllScreeningEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllScreeningEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllScreeningEntryRequest proto.InternalMessageInfo
+
+func (m *QueryAllScreeningEntryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllScreeningEntryResponse struct {
+	ScreeningEntry []ScreeningEntry    `protobuf:"bytes,1,rep,name=screening_entry,json=screeningEntry,proto3" json:"screening_entry"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllScreeningEntryResponse) Reset()         { *m = QueryAllScreeningEntryResponse{} }
+func (m *QueryAllScreeningEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllScreeningEntryResponse) ProtoMessage()    {}
+func (*QueryAllScreeningEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{5}
+}
+func (m *QueryAllScreeningEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllScreeningEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllScreeningEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllScreeningEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllScreeningEntryResponse.Merge(m, src)
+}
+func (m *QueryAllScreeningEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllScreeningEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllScreeningEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllScreeningEntryResponse proto.InternalMessageInfo
+
+func (m *QueryAllScreeningEntryResponse) GetScreeningEntry() []ScreeningEntry {
+	if m != nil {
+		return m.ScreeningEntry
+	}
+	return nil
+}
+
+func (m *QueryAllScreeningEntryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScreenedRequest struct {
+	Party string `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+}
+
+func (m *QueryScreenedRequest) Reset()         { *m = QueryScreenedRequest{} }
+func (m *QueryScreenedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedRequest) ProtoMessage()    {}
+func (*QueryScreenedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{6}
+}
+func (m *QueryScreenedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScreenedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedRequest.Merge(m, src)
+}
+func (m *QueryScreenedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScreenedRequest proto.InternalMessageInfo
+
+func (m *QueryScreenedRequest) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+type QueryScreenedResponse struct {
+	Screened bool `protobuf:"varint,1,opt,name=screened,proto3" json:"screened,omitempty"`
+}
+
+func (m *QueryScreenedResponse) Reset()         { *m = QueryScreenedResponse{} }
+func (m *QueryScreenedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScreenedResponse) ProtoMessage()    {}
+func (*QueryScreenedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{7}
+}
+func (m *QueryScreenedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreenedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreenedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScreenedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreenedResponse.Merge(m, src)
+}
+func (m *QueryScreenedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreenedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreenedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScreenedResponse proto.InternalMessageInfo
+
+func (m *QueryScreenedResponse) GetScreened() bool {
+	if m != nil {
+		return m.Screened
+	}
+	return false
+}
+
+type QueryScreeningHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScreeningHistoryRequest) Reset()         { *m = QueryScreeningHistoryRequest{} }
+func (m *QueryScreeningHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScreeningHistoryRequest) ProtoMessage()    {}
+func (*QueryScreeningHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{8}
+}
+func (m *QueryScreeningHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreeningHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreeningHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScreeningHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreeningHistoryRequest.Merge(m, src)
+}
+func (m *QueryScreeningHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreeningHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreeningHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScreeningHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryScreeningHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScreeningHistoryResponse struct {
+	ScreeningRecord []ScreeningRecord   `protobuf:"bytes,1,rep,name=screening_record,json=screeningRecord,proto3" json:"screening_record"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScreeningHistoryResponse) Reset()         { *m = QueryScreeningHistoryResponse{} }
+func (m *QueryScreeningHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScreeningHistoryResponse) ProtoMessage()    {}
+func (*QueryScreeningHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6cd488c97e0fb1, []int{9}
+}
+func (m *QueryScreeningHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScreeningHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScreeningHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScreeningHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScreeningHistoryResponse.Merge(m, src)
+}
+func (m *QueryScreeningHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScreeningHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScreeningHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScreeningHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryScreeningHistoryResponse) GetScreeningRecord() []ScreeningRecord {
+	if m != nil {
+		return m.ScreeningRecord
+	}
+	return nil
+}
+
+func (m *QueryScreeningHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "finance.screening.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "finance.screening.QueryParamsResponse")
+	proto.RegisterType((*QueryGetScreeningEntryRequest)(nil), "finance.screening.QueryGetScreeningEntryRequest")
+	proto.RegisterType((*QueryGetScreeningEntryResponse)(nil), "finance.screening.QueryGetScreeningEntryResponse")
+	proto.RegisterType((*QueryAllScreeningEntryRequest)(nil), "finance.screening.QueryAllScreeningEntryRequest")
+	proto.RegisterType((*QueryAllScreeningEntryResponse)(nil), "finance.screening.QueryAllScreeningEntryResponse")
+	proto.RegisterType((*QueryScreenedRequest)(nil), "finance.screening.QueryScreenedRequest")
+	proto.RegisterType((*QueryScreenedResponse)(nil), "finance.screening.QueryScreenedResponse")
+	proto.RegisterType((*QueryScreeningHistoryRequest)(nil), "finance.screening.QueryScreeningHistoryRequest")
+	proto.RegisterType((*QueryScreeningHistoryResponse)(nil), "finance.screening.QueryScreeningHistoryResponse")
+}
+
+func init() { proto.RegisterFile("finance/screening/query.proto", fileDescriptor_ab6cd488c97e0fb1) }
+
+var fileDescriptor_ab6cd488c97e0fb1 = []byte{
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbd, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0xe3, 0x42, 0xab, 0xd6, 0x48, 0xfd, 0x30, 0x41, 0xa2, 0x47, 0x7b, 0x50, 0x43, 0x3f,
+	0x14, 0xaa, 0x73, 0xd3, 0x8a, 0x8d, 0xa5, 0x95, 0xa0, 0x8c, 0xe1, 0xb2, 0xb1, 0x20, 0x27, 0x71,
+	0x8f, 0x93, 0x2e, 0xf6, 0xf5, 0x7c, 0xa9, 0x08, 0x55, 0x17, 0x46, 0x26, 0x24, 0x26, 0x76, 0x06,
+	0xd8, 0xd8, 0x11, 0x7b, 0xc7, 0x4a, 0x2c, 0x4c, 0x08, 0x25, 0x48, 0xfc, 0x19, 0xa0, 0xd8, 0xbe,
+	0x34, 0x97, 0xe4, 0x12, 0x2a, 0xba, 0x54, 0x77, 0xf6, 0xfb, 0xbc, 0xcf, 0xcf, 0x7d, 0xee, 0x75,
+	0xe0, 0xf2, 0x81, 0xcf, 0x29, 0xaf, 0x32, 0x22, 0xab, 0x11, 0x63, 0xdc, 0xe7, 0x1e, 0x39, 0x6c,
+	0xb0, 0xa8, 0xe9, 0x84, 0x91, 0x88, 0x05, 0x5a, 0x30, 0xdb, 0x4e, 0x77, 0xdb, 0x5a, 0xa0, 0x75,
+	0x9f, 0x0b, 0xa2, 0xfe, 0xea, 0x2a, 0x2b, 0xef, 0x09, 0x4f, 0xa8, 0x47, 0xd2, 0x79, 0x32, 0xab,
+	0x4b, 0x9e, 0x10, 0x5e, 0xc0, 0x08, 0x0d, 0x7d, 0x42, 0x39, 0x17, 0x31, 0x8d, 0x7d, 0xc1, 0xa5,
+	0xd9, 0x2d, 0x54, 0x85, 0xac, 0x0b, 0x49, 0x2a, 0x54, 0x32, 0x6d, 0x49, 0x8e, 0x8a, 0x15, 0x16,
+	0xd3, 0x22, 0x09, 0xa9, 0xe7, 0x73, 0x55, 0x6c, 0x6a, 0xed, 0x41, 0xc8, 0x90, 0x46, 0xb4, 0x9e,
+	0xf4, 0x5a, 0x1f, 0xdc, 0xef, 0x3e, 0x3d, 0x67, 0x3c, 0x4e, 0x8e, 0x83, 0xf3, 0x10, 0x3d, 0xed,
+	0x58, 0x95, 0x94, 0xda, 0x65, 0x87, 0x0d, 0x26, 0x63, 0x5c, 0x86, 0xd7, 0x53, 0xab, 0x32, 0x14,
+	0x5c, 0x32, 0xf4, 0x10, 0x4e, 0x69, 0x97, 0x9b, 0xe0, 0x0e, 0xd8, 0xb8, 0xb6, 0xbd, 0xe8, 0x0c,
+	0xfc, 0x33, 0x1c, 0x2d, 0xd9, 0x9b, 0x39, 0xfd, 0x71, 0x3b, 0xf7, 0xf1, 0xf7, 0xe7, 0x02, 0x70,
+	0x8d, 0x06, 0x3f, 0x80, 0xcb, 0xaa, 0xe9, 0x3e, 0x8b, 0xcb, 0x49, 0xf9, 0xa3, 0x0e, 0x8a, 0x71,
+	0x45, 0x79, 0x38, 0x79, 0x44, 0x83, 0x06, 0x53, 0xdd, 0x67, 0x5c, 0xfd, 0x82, 0x23, 0x68, 0x67,
+	0xc9, 0x0c, 0x56, 0x09, 0xce, 0xf5, 0x1d, 0xce, 0xf0, 0xad, 0x0c, 0xe1, 0x4b, 0xf7, 0xd8, 0xbb,
+	0xda, 0xe1, 0x74, 0x67, 0x65, 0x6a, 0x15, 0x7b, 0x06, 0x75, 0x37, 0x08, 0x86, 0xa3, 0x3e, 0x86,
+	0xf0, 0x3c, 0x13, 0xe3, 0xb6, 0xe6, 0xe8, 0x00, 0x9d, 0x4e, 0x80, 0x8e, 0xfe, 0x66, 0x4c, 0x80,
+	0x4e, 0x89, 0x7a, 0xcc, 0x68, 0xdd, 0x1e, 0x25, 0xfe, 0x02, 0xa0, 0x9d, 0xe5, 0x34, 0xea, 0x74,
+	0x57, 0xfe, 0xe3, 0x74, 0x68, 0x3f, 0x05, 0x3f, 0xa1, 0xe0, 0xd7, 0xc7, 0xc2, 0x6b, 0x9c, 0x14,
+	0xfd, 0x26, 0xcc, 0x2b, 0x78, 0xed, 0xca, 0x6a, 0x3d, 0x41, 0x86, 0x34, 0x8a, 0x9b, 0x49, 0x90,
+	0xea, 0x05, 0xef, 0xc0, 0x1b, 0x7d, 0xd5, 0xe6, 0x84, 0x16, 0x9c, 0x96, 0x66, 0x4d, 0x29, 0xa6,
+	0xdd, 0xee, 0x3b, 0x3e, 0x80, 0x4b, 0x3d, 0x22, 0x9f, 0x7b, 0x4f, 0x7c, 0x19, 0x8b, 0xcb, 0x0f,
+	0xe2, 0x2b, 0x80, 0xcb, 0x19, 0x46, 0x86, 0xb2, 0x0c, 0xe7, 0xcf, 0x73, 0x88, 0x58, 0x55, 0x44,
+	0x35, 0x13, 0x04, 0x1e, 0x15, 0x84, 0xab, 0x2a, 0x4d, 0x12, 0x73, 0x32, 0xbd, 0x7c, 0x69, 0x51,
+	0x6c, 0xff, 0x99, 0x84, 0x93, 0x8a, 0x1f, 0xbd, 0x82, 0x53, 0x7a, 0x06, 0xd1, 0xea, 0x10, 0xae,
+	0xc1, 0x61, 0xb7, 0xd6, 0xc6, 0x95, 0x69, 0x3b, 0xbc, 0xf2, 0xfa, 0xdb, 0xaf, 0x77, 0x13, 0xb7,
+	0xd0, 0x22, 0xc9, 0xba, 0x7c, 0xd0, 0x27, 0x00, 0x67, 0xd3, 0x9f, 0x20, 0xda, 0xca, 0xea, 0x9e,
+	0x75, 0x0d, 0x58, 0xc5, 0x0b, 0x28, 0x0c, 0xda, 0xb6, 0x42, 0xdb, 0x44, 0x05, 0x32, 0xf6, 0xde,
+	0x23, 0xc7, 0xea, 0x5a, 0x39, 0x41, 0x1f, 0x00, 0x5c, 0x48, 0xb7, 0xdb, 0x0d, 0x82, 0x6c, 0xdc,
+	0xac, 0xab, 0xc0, 0x2a, 0x5e, 0x40, 0x61, 0x70, 0x0b, 0x0a, 0xf7, 0x1e, 0xc2, 0xe3, 0x71, 0xd1,
+	0x1b, 0x00, 0xa7, 0x93, 0x89, 0x41, 0xeb, 0x59, 0x5e, 0x7d, 0x13, 0x68, 0x6d, 0x8c, 0x2f, 0x34,
+	0x2c, 0xf7, 0x15, 0xcb, 0x2a, 0xba, 0x9b, 0xc9, 0xc2, 0x6a, 0xe4, 0x58, 0x4d, 0xf0, 0x09, 0x7a,
+	0x0f, 0xe0, 0x7c, 0xff, 0x80, 0x20, 0x32, 0xda, 0x6b, 0x60, 0x66, 0xad, 0xad, 0x7f, 0x17, 0x18,
+	0x48, 0xac, 0x20, 0x97, 0x90, 0x35, 0x04, 0xf2, 0x85, 0xae, 0xdd, 0xdb, 0x39, 0x6d, 0xd9, 0xe0,
+	0xac, 0x65, 0x83, 0x9f, 0x2d, 0x1b, 0xbc, 0x6d, 0xdb, 0xb9, 0xb3, 0xb6, 0x9d, 0xfb, 0xde, 0xb6,
+	0x73, 0xcf, 0x16, 0x13, 0xd1, 0xcb, 0x1e, 0x59, 0xdc, 0x0c, 0x99, 0xac, 0x4c, 0xa9, 0x5f, 0xc1,
+	0x9d, 0xbf, 0x03, 0x00, 0x9a, 0xbb, 0x52, 0xff, 0xf5, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ScreeningEntry items.
+	ScreeningEntry(ctx context.Context, in *QueryGetScreeningEntryRequest, opts ...grpc.CallOption) (*QueryGetScreeningEntryResponse, error)
+	ScreeningEntryAll(ctx context.Context, in *QueryAllScreeningEntryRequest, opts ...grpc.CallOption) (*QueryAllScreeningEntryResponse, error)
+	// Screened queries whether a party is listed by its address or by the
+	// hash of its external identifier.
+	Screened(ctx context.Context, in *QueryScreenedRequest, opts ...grpc.CallOption) (*QueryScreenedResponse, error)
+	// ScreeningHistory queries the listings, delistings and blocked attempts.
+	ScreeningHistory(ctx context.Context, in *QueryScreeningHistoryRequest, opts ...grpc.CallOption) (*QueryScreeningHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/finance.screening.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningEntry(ctx context.Context, in *QueryGetScreeningEntryRequest, opts ...grpc.CallOption) (*QueryGetScreeningEntryResponse, error) {
+	out := new(QueryGetScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, "/finance.screening.Query/ScreeningEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningEntryAll(ctx context.Context, in *QueryAllScreeningEntryRequest, opts ...grpc.CallOption) (*QueryAllScreeningEntryResponse, error) {
+	out := new(QueryAllScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, "/finance.screening.Query/ScreeningEntryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Screened(ctx context.Context, in *QueryScreenedRequest, opts ...grpc.CallOption) (*QueryScreenedResponse, error) {
+	out := new(QueryScreenedResponse)
+	err := c.cc.Invoke(ctx, "/finance.screening.Query/Screened", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningHistory(ctx context.Context, in *QueryScreeningHistoryRequest, opts ...grpc.CallOption) (*QueryScreeningHistoryResponse, error) {
+	out := new(QueryScreeningHistoryResponse)
+	err := c.cc.Invoke(ctx, "/finance.screening.Query/ScreeningHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ScreeningEntry items.
+	ScreeningEntry(context.Context, *QueryGetScreeningEntryRequest) (*QueryGetScreeningEntryResponse, error)
+	ScreeningEntryAll(context.Context, *QueryAllScreeningEntryRequest) (*QueryAllScreeningEntryResponse, error)
+	// Screened queries whether a party is listed by its address or by the
+	// hash of its external identifier.
+	Screened(context.Context, *QueryScreenedRequest) (*QueryScreenedResponse, error)
+	// ScreeningHistory queries the listings, delistings and blocked attempts.
+	ScreeningHistory(context.Context, *QueryScreeningHistoryRequest) (*QueryScreeningHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ScreeningEntry(ctx context.Context, req *QueryGetScreeningEntryRequest) (*QueryGetScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningEntry not implemented")
+}
+func (*UnimplementedQueryServer) ScreeningEntryAll(ctx context.Context, req *QueryAllScreeningEntryRequest) (*QueryAllScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningEntryAll not implemented")
+}
+func (*UnimplementedQueryServer) Screened(ctx context.Context, req *QueryScreenedRequest) (*QueryScreenedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Screened not implemented")
+}
+func (*UnimplementedQueryServer) ScreeningHistory(ctx context.Context, req *QueryScreeningHistoryRequest) (*QueryScreeningHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/finance.screening.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetScreeningEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/finance.screening.Query/ScreeningEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningEntry(ctx, req.(*QueryGetScreeningEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningEntryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllScreeningEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningEntryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/finance.screening.Query/ScreeningEntryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningEntryAll(ctx, req.(*QueryAllScreeningEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Screened_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Screened(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/finance.screening.Query/Screened",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Screened(ctx, req.(*QueryScreenedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreeningHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/finance.screening.Query/ScreeningHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningHistory(ctx, req.(*QueryScreeningHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.screening.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ScreeningEntry",
+			Handler:    _Query_ScreeningEntry_Handler,
+		},
+		{
+			MethodName: "ScreeningEntryAll",
+			Handler:    _Query_ScreeningEntryAll_Handler,
+		},
+		{
+			MethodName: "Screened",
+			Handler:    _Query_Screened_Handler,
+		},
+		{
+			MethodName: "ScreeningHistory",
+			Handler:    _Query_ScreeningHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance/screening/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScreeningEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScreeningEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScreeningEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetScreeningEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetScreeningEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetScreeningEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScreeningEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllScreeningEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllScreeningEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllScreeningEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllScreeningEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllScreeningEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllScreeningEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScreeningEntry) > 0 {
+		for iNdEx := len(m.ScreeningEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScreeningEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Party) > 0 {
+		i -= len(m.Party)
+		copy(dAtA[i:], m.Party)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Party)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreenedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreenedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreenedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Screened {
+		i--
+		if m.Screened {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreeningHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreeningHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreeningHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScreeningHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScreeningHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScreeningHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScreeningRecord) > 0 {
+		for iNdEx := len(m.ScreeningRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScreeningRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetScreeningEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetScreeningEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScreeningEntry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllScreeningEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllScreeningEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScreeningEntry) > 0 {
+		for _, e := range m.ScreeningEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Party)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreenedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Screened {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScreeningHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScreeningHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScreeningRecord) > 0 {
+		for _, e := range m.ScreeningRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetScreeningEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetScreeningEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetScreeningEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetScreeningEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetScreeningEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetScreeningEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScreeningEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllScreeningEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllScreeningEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllScreeningEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllScreeningEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllScreeningEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllScreeningEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreeningEntry = append(m.ScreeningEntry, ScreeningEntry{})
+			if err := m.ScreeningEntry[len(m.ScreeningEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScreenedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScreenedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScreenedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Party", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Party = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScreenedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScreenedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScreenedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Screened", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Screened = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScreeningHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScreeningHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScreeningHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScreeningHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScreeningHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScreeningHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScreeningRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScreeningRecord = append(m.ScreeningRecord, ScreeningRecord{})
+			if err := m.ScreeningRecord[len(m.ScreeningRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Kinds of screening entries
+const (
+	// EntryKindAddress lists an account address of the chain
+	EntryKindAddress = "address"
+	// EntryKindIdentifier lists the hex encoded SHA-256 hash of an external
+	// identifier, like a customer ID or the address of an account on
+	// another chain
+	EntryKindIdentifier = "identifier"
+)
+
+// Actions of the screening history
+const (
+	RecordActionListed   = "listed"
+	RecordActionDelisted = "delisted"
+	RecordActionBlocked  = "blocked"
+)
+
+// ValidateEntry checks the value of a screening entry of the given kind
+func ValidateEntry(kind, value string) error {
+	switch kind {
+	case EntryKindAddress:
+		if _, err := sdk.AccAddressFromBech32(value); err != nil {
+			return errorsmod.Wrapf(ErrInvalidEntry, "invalid address %s: %s", value, err)
+		}
+	case EntryKindIdentifier:
+		if b, err := hex.DecodeString(value); err != nil || len(b) != 32 || hex.EncodeToString(b) != value {
+			return errorsmod.Wrapf(ErrInvalidEntry, "identifier %s is not a lowercase hex encoded SHA-256 hash", value)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidEntry, "unknown entry kind %s", kind)
+	}
+	return nil
+}
+
+// Validate checks the screening entry
+func (e ScreeningEntry) Validate() error {
+	if err := ValidateEntry(e.Kind, e.Value); err != nil {
+		return fmt.Errorf("invalid screening entry: %w", err)
+	}
+	return nil
+}
@@ -2,11 +2,13 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/government/contracts/interfaces"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"government/contracts/interfaces"
 )
 
 // Regulation represents a government regulation
@@ -26,15 +28,15 @@ type Regulation struct {
 
 // Permit represents a government permit
 type Permit struct {
-	PermitID      string            `json:"permit_id"`
-	HolderID      string            `json:"holder_id"`
-	Type          string            `json:"type"`
-	Status        string            `json:"status"`
-	IssuedDate    time.Time         `json:"issued_date"`
-	ExpiryDate    time.Time         `json:"expiry_date"`
-	Conditions    map[string]string `json:"conditions"`
-	Documents     []Document        `json:"documents"`
-	LastModified  time.Time         `json:"last_modified"`
+	PermitID     string            `json:"permit_id"`
+	HolderID     string            `json:"holder_id"`
+	Type         string            `json:"type"`
+	Status       string            `json:"status"`
+	IssuedDate   time.Time         `json:"issued_date"`
+	ExpiryDate   time.Time         `json:"expiry_date"`
+	Conditions   map[string]string `json:"conditions"`
+	Documents    []Document        `json:"documents"`
+	LastModified time.Time         `json:"last_modified"`
 }
 
 // Document represents a government document
@@ -53,11 +55,12 @@ type Document struct {
 
 // GovernmentContract implements the IGovernmentContract interface
 type GovernmentContract struct {
-	regulationManager  interfaces.IRegulationManager
+	regulationManager   interfaces.IRegulationManager
 	complianceProcessor interfaces.IComplianceProcessor
-	permitManager      interfaces.IPermitManager
-	documentVerifier   interfaces.IDocumentVerifier
-	auditManager       interfaces.IAuditManager
+	permitManager       interfaces.IPermitManager
+	documentVerifier    interfaces.IDocumentVerifier
+	auditManager        interfaces.IAuditManager
+	router              *interchain.Router
 }
 
 func NewGovernmentContract(
@@ -67,13 +70,30 @@ func NewGovernmentContract(
 	documentVerifier interfaces.IDocumentVerifier,
 	auditManager interfaces.IAuditManager,
 ) *GovernmentContract {
-	return &GovernmentContract{
+	c := &GovernmentContract{
 		regulationManager:   regulationManager,
 		complianceProcessor: complianceProcessor,
 		permitManager:       permitManager,
 		documentVerifier:    documentVerifier,
 		auditManager:        auditManager,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleFinanceMessage,
+			Prepare:  c.prepareFinanceMessage,
+			Callback: c.handleFinanceCallback,
+		}).
+		AddRoute(interchain.ChainHealthcare, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleHealthcareMessage,
+			Prepare:  c.prepareHealthcareMessage,
+			Callback: c.handleHealthcareCallback,
+		}).
+		AddRoute(interchain.ChainRealEstate, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleRealEstateMessage,
+			Prepare:  c.prepareRealEstateMessage,
+			Callback: c.handleRealEstateCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IGovernmentContract
@@ -82,7 +102,7 @@ func (c *GovernmentContract) ValidateInterchainData(ctx sdk.Context, data []byte
 		Type string `json:"type"`
 	}
 	if err := json.Unmarshal(data, &dataType); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid data format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid data format")
 	}
 
 	switch dataType.Type {
@@ -95,78 +115,61 @@ func (c *GovernmentContract) ValidateInterchainData(ctx sdk.Context, data []byte
 	case "document":
 		return c.documentVerifier.VerifyDocument(ctx, data)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported data type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported data type")
 	}
 }
 
 // ProcessInterchainMessage implements IGovernmentContract
-func (c *GovernmentContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceMessage(ctx, message)
-	case "healthcare":
-		return c.handleHealthcareMessage(ctx, message)
-	case "realestate":
-		return c.handleRealEstateMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+func (c *GovernmentContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IGovernmentContract
-func (c *GovernmentContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	switch targetChain {
-	case "finance":
-		return c.prepareFinanceMessage(data)
-	case "healthcare":
-		return c.prepareHealthcareMessage(data)
-	case "realestate":
-		return c.prepareRealEstateMessage(data)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+func (c *GovernmentContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IGovernmentContract
-func (c *GovernmentContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
+func (c *GovernmentContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
 	// Log the callback
 	if err := c.auditManager.LogAudit(ctx, response); err != nil {
 		return err
 	}
 
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceCallback(ctx, response)
-	case "healthcare":
-		return c.handleHealthcareCallback(ctx, response)
-	case "realestate":
-		return c.handleRealEstateCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ValidateRegulation implements IGovernmentContract
 func (c *GovernmentContract) ValidateRegulation(ctx sdk.Context, regulation []byte) error {
 	var reg Regulation
 	if err := json.Unmarshal(regulation, &reg); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid regulation format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid regulation format")
 	}
 
 	// Validate required fields
 	if reg.RegulationID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "regulation ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "regulation ID is required")
 	}
 	if reg.Title == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "title is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "title is required")
 	}
 	if reg.Type == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "type is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "type is required")
 	}
 
 	return c.regulationManager.ValidateRegulation(ctx, regulation)
 }
 
+// CreateRegulation stores a new regulation
+func (c *GovernmentContract) CreateRegulation(ctx sdk.Context, regulation []byte) error {
+	return c.regulationManager.CreateRegulation(ctx, regulation)
+}
+
+// UpdateRegulation replaces an existing regulation
+func (c *GovernmentContract) UpdateRegulation(ctx sdk.Context, regulation []byte) error {
+	return c.regulationManager.UpdateRegulation(ctx, regulation)
+}
+
 // ProcessCompliance implements IGovernmentContract
 func (c *GovernmentContract) ProcessCompliance(ctx sdk.Context, data []byte) error {
 	return c.complianceProcessor.ProcessCompliance(ctx, data)
@@ -180,6 +183,11 @@ func (c *GovernmentContract) IssuePermit(ctx sdk.Context, permit []byte) error {
 	return c.permitManager.IssuePermit(ctx, permit)
 }
 
+// RevokePermit revokes an issued permit
+func (c *GovernmentContract) RevokePermit(ctx sdk.Context, permitID string) error {
+	return c.permitManager.RevokePermit(ctx, permitID)
+}
+
 // VerifyDocument implements IGovernmentContract
 func (c *GovernmentContract) VerifyDocument(ctx sdk.Context, document []byte) error {
 	return c.documentVerifier.VerifyDocument(ctx, document)
@@ -202,17 +210,17 @@ func (c *GovernmentContract) handleRealEstateMessage(ctx sdk.Context, message []
 }
 
 // Internal message preparation
-func (c *GovernmentContract) prepareFinanceMessage(data []byte) ([]byte, error) {
+func (c *GovernmentContract) prepareFinanceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for finance chain
 	return data, nil
 }
 
-func (c *GovernmentContract) prepareHealthcareMessage(data []byte) ([]byte, error) {
+func (c *GovernmentContract) prepareHealthcareMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for healthcare chain
 	return data, nil
 }
 
-func (c *GovernmentContract) prepareRealEstateMessage(data []byte) ([]byte, error) {
+func (c *GovernmentContract) prepareRealEstateMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for real estate chain
 	return data, nil
 }
@@ -2,15 +2,13 @@ package interfaces
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IGovernmentContract extends the base interchain contract with government features
 type IGovernmentContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// Government-specific functionality
 	ValidateRegulation(ctx sdk.Context, regulation []byte) error
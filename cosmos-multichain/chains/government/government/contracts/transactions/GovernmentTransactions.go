@@ -2,10 +2,13 @@ package transactions
 
 import (
 	"encoding/json"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/government/contracts"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"government/contracts"
 )
 
 // TransactionType defines the type of government transaction
@@ -14,22 +17,22 @@ type TransactionType string
 const (
 	NewRegulation     TransactionType = "NEW_REGULATION"
 	UpdateRegulation  TransactionType = "UPDATE_REGULATION"
-	IssuePermit      TransactionType = "ISSUE_PERMIT"
-	RevokePermit     TransactionType = "REVOKE_PERMIT"
-	VerifyDocument   TransactionType = "VERIFY_DOCUMENT"
+	IssuePermit       TransactionType = "ISSUE_PERMIT"
+	RevokePermit      TransactionType = "REVOKE_PERMIT"
+	VerifyDocument    TransactionType = "VERIFY_DOCUMENT"
 	ProcessCompliance TransactionType = "PROCESS_COMPLIANCE"
 )
 
 // GovernmentTransactionRequest represents a government transaction request
 type GovernmentTransactionRequest struct {
-	TransactionID   string             `json:"transaction_id"`
-	TransactionType TransactionType    `json:"transaction_type"`
-	RegulationData  *RegulationData   `json:"regulation_data,omitempty"`
-	PermitData      *PermitData       `json:"permit_data,omitempty"`
-	DocumentData    *DocumentData     `json:"document_data,omitempty"`
-	ComplianceData  *ComplianceData   `json:"compliance_data,omitempty"`
-	Timestamp       time.Time         `json:"timestamp"`
-	Metadata        Metadata          `json:"metadata"`
+	TransactionID   string          `json:"transaction_id"`
+	TransactionType TransactionType `json:"transaction_type"`
+	RegulationData  *RegulationData `json:"regulation_data,omitempty"`
+	PermitData      *PermitData     `json:"permit_data,omitempty"`
+	DocumentData    *DocumentData   `json:"document_data,omitempty"`
+	ComplianceData  *ComplianceData `json:"compliance_data,omitempty"`
+	Timestamp       time.Time       `json:"timestamp"`
+	Metadata        Metadata        `json:"metadata"`
 }
 
 // RegulationData contains regulation information
@@ -45,21 +48,21 @@ type RegulationData struct {
 
 // PermitData contains permit information
 type PermitData struct {
-	PermitID      string            `json:"permit_id"`
-	HolderID      string            `json:"holder_id"`
-	Type          string            `json:"type"`
-	ExpiryDate    time.Time         `json:"expiry_date"`
-	Conditions    map[string]string `json:"conditions"`
+	PermitID   string            `json:"permit_id"`
+	HolderID   string            `json:"holder_id"`
+	Type       string            `json:"type"`
+	ExpiryDate time.Time         `json:"expiry_date"`
+	Conditions map[string]string `json:"conditions"`
 }
 
 // DocumentData contains document information
 type DocumentData struct {
-	DocumentID   string    `json:"document_id"`
-	Type         string    `json:"type"`
-	Title        string    `json:"title"`
-	Hash         string    `json:"hash"`
-	IPFSLink     string    `json:"ipfs_link"`
-	ValidUntil   time.Time `json:"valid_until"`
+	DocumentID string    `json:"document_id"`
+	Type       string    `json:"type"`
+	Title      string    `json:"title"`
+	Hash       string    `json:"hash"`
+	IPFSLink   string    `json:"ipfs_link"`
+	ValidUntil time.Time `json:"valid_until"`
 }
 
 // ComplianceData contains compliance information
@@ -81,9 +84,9 @@ type Evidence struct {
 
 // Metadata contains additional transaction information
 type Metadata struct {
-	References  []string          `json:"references"`
-	Notes       string            `json:"notes"`
-	Extra       map[string]string `json:"extra"`
+	References []string          `json:"references"`
+	Notes      string            `json:"notes"`
+	Extra      map[string]string `json:"extra"`
 }
 
 // GovernmentTransactionHandler handles government transactions
@@ -119,37 +122,37 @@ func (h *GovernmentTransactionHandler) InitiateTransaction(ctx sdk.Context, req
 	case ProcessCompliance:
 		return h.processCompliance(ctx, req)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported transaction type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported transaction type")
 	}
 }
 
 // ValidateRequest validates the transaction request
 func (h *GovernmentTransactionHandler) validateRequest(req GovernmentTransactionRequest) error {
 	if req.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 
 	switch req.TransactionType {
 	case NewRegulation, UpdateRegulation:
 		if req.RegulationData == nil {
-			return errors.Wrap(errors.ErrInvalidRequest, "regulation data is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "regulation data is required")
 		}
 		if req.RegulationData.RegulationID == "" {
-			return errors.Wrap(errors.ErrInvalidRequest, "regulation ID is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "regulation ID is required")
 		}
 	case IssuePermit:
 		if req.PermitData == nil {
-			return errors.Wrap(errors.ErrInvalidRequest, "permit data is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "permit data is required")
 		}
 		if req.PermitData.PermitID == "" {
-			return errors.Wrap(errors.ErrInvalidRequest, "permit ID is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "permit ID is required")
 		}
 	case VerifyDocument:
 		if req.DocumentData == nil {
-			return errors.Wrap(errors.ErrInvalidRequest, "document data is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "document data is required")
 		}
 		if req.DocumentData.DocumentID == "" {
-			return errors.Wrap(errors.ErrInvalidRequest, "document ID is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "document ID is required")
 		}
 	}
 
@@ -160,33 +163,44 @@ func (h *GovernmentTransactionHandler) validateRequest(req GovernmentTransaction
 func (h *GovernmentTransactionHandler) processNewRegulation(ctx sdk.Context, req GovernmentTransactionRequest) error {
 	regulationData, err := json.Marshal(req.RegulationData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal regulation data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal regulation data")
+	}
+	if err := h.contract.ValidateRegulation(ctx, regulationData); err != nil {
+		return err
 	}
-	return h.contract.ValidateRegulation(ctx, regulationData)
+	return h.contract.CreateRegulation(ctx, regulationData)
 }
 
 func (h *GovernmentTransactionHandler) processUpdateRegulation(ctx sdk.Context, req GovernmentTransactionRequest) error {
-	// Handle regulation update logic
-	return nil
+	regulationData, err := json.Marshal(req.RegulationData)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal regulation data")
+	}
+	if err := h.contract.ValidateRegulation(ctx, regulationData); err != nil {
+		return err
+	}
+	return h.contract.UpdateRegulation(ctx, regulationData)
 }
 
 func (h *GovernmentTransactionHandler) processIssuePermit(ctx sdk.Context, req GovernmentTransactionRequest) error {
 	permitData, err := json.Marshal(req.PermitData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal permit data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal permit data")
 	}
 	return h.contract.IssuePermit(ctx, permitData)
 }
 
 func (h *GovernmentTransactionHandler) processRevokePermit(ctx sdk.Context, req GovernmentTransactionRequest) error {
-	// Handle permit revocation logic
-	return nil
+	if req.PermitData == nil || req.PermitData.PermitID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "permit ID is required")
+	}
+	return h.contract.RevokePermit(ctx, req.PermitData.PermitID)
 }
 
 func (h *GovernmentTransactionHandler) processVerifyDocument(ctx sdk.Context, req GovernmentTransactionRequest) error {
 	documentData, err := json.Marshal(req.DocumentData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal document data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal document data")
 	}
 	return h.contract.VerifyDocument(ctx, documentData)
 }
@@ -194,7 +208,7 @@ func (h *GovernmentTransactionHandler) processVerifyDocument(ctx sdk.Context, re
 func (h *GovernmentTransactionHandler) processCompliance(ctx sdk.Context, req GovernmentTransactionRequest) error {
 	complianceData, err := json.Marshal(req.ComplianceData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal compliance data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal compliance data")
 	}
 	return h.contract.ProcessCompliance(ctx, complianceData)
 }
@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"government/contracts"
+	"government/contracts/transactions"
+	"government/x/government/types"
+)
+
+// ContractRecord kinds kept by the government contract
+const (
+	RecordKindRegulation = "regulation"
+	RecordKindPermit     = "permit"
+	RecordKindDocument   = "document"
+	RecordKindCompliance = "compliance"
+)
+
+const (
+	StatusActive   = "ACTIVE"
+	StatusInactive = "INACTIVE"
+	StatusRevoked  = "REVOKED"
+	StatusVerified = "VERIFIED"
+
+	ComplianceStatusCompliant    = "COMPLIANT"
+	ComplianceStatusNonCompliant = "NON_COMPLIANT"
+
+	// defaultAuditSubject is the audit trail subject of events not naming an entity
+	defaultAuditSubject = "government"
+)
+
+// AuditEvent is an event recorded by the audit manager
+type AuditEvent struct {
+	EntityID string `json:"entity_id"`
+	Action   string `json:"action"`
+	Detail   string `json:"detail"`
+}
+
+// newContract builds the government contract on top of the module state
+func newContract(k Keeper) *contracts.GovernmentContract {
+	return contracts.NewGovernmentContract(
+		regulationManager{k},
+		complianceProcessor{k},
+		permitManager{k},
+		documentVerifier{k},
+		auditManager{k},
+	)
+}
+
+// setRecord stores data as the contract record of the given kind and id
+func (k Keeper) setRecord(ctx sdk.Context, kind, id string, data []byte, status string) {
+	k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:      kind,
+		RecordId:  id,
+		Data:      data,
+		Status:    status,
+		UpdatedAt: ctx.BlockTime().Unix(),
+	})
+}
+
+// regulationManager keeps regulations as contract records
+type regulationManager struct {
+	k Keeper
+}
+
+func unmarshalRegulation(regulation []byte) (contracts.Regulation, error) {
+	var r contracts.Regulation
+	if err := json.Unmarshal(regulation, &r); err != nil {
+		return r, errorsmod.Wrap(interchain.ErrInvalidData, "invalid regulation format")
+	}
+	return r, nil
+}
+
+func (m regulationManager) CreateRegulation(ctx sdk.Context, regulation []byte) error {
+	r, err := unmarshalRegulation(regulation)
+	if err != nil {
+		return err
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindRegulation, r.RegulationID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "regulation %s already exists", r.RegulationID)
+	}
+	m.k.setRecord(ctx, RecordKindRegulation, r.RegulationID, regulation, StatusActive)
+	return nil
+}
+
+func (m regulationManager) UpdateRegulation(ctx sdk.Context, regulation []byte) error {
+	r, err := unmarshalRegulation(regulation)
+	if err != nil {
+		return err
+	}
+	record, found := m.k.GetContractRecord(ctx, RecordKindRegulation, r.RegulationID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, r.RegulationID)
+	}
+	if record.Status != StatusActive {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "regulation %s is not active", r.RegulationID)
+	}
+	m.k.setRecord(ctx, RecordKindRegulation, r.RegulationID, regulation, record.Status)
+	return nil
+}
+
+func (m regulationManager) DeactivateRegulation(ctx sdk.Context, regulationID string) error {
+	record, found := m.k.GetContractRecord(ctx, RecordKindRegulation, regulationID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, regulationID)
+	}
+	m.k.setRecord(ctx, RecordKindRegulation, regulationID, record.Data, StatusInactive)
+	return nil
+}
+
+func (m regulationManager) GetRegulation(ctx sdk.Context, regulationID string) ([]byte, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindRegulation, regulationID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRecordNotFound, regulationID)
+	}
+	return record.Data, nil
+}
+
+func (m regulationManager) ValidateRegulation(ctx sdk.Context, regulation []byte) error {
+	r, err := unmarshalRegulation(regulation)
+	if err != nil {
+		return err
+	}
+	if !r.EffectiveDate.IsZero() && !r.ExpiryDate.IsZero() && !r.ExpiryDate.After(r.EffectiveDate) {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "regulation must expire after it takes effect")
+	}
+	return nil
+}
+
+// complianceProcessor checks that every requirement of an entity is backed by evidence
+type complianceProcessor struct {
+	k Keeper
+}
+
+func unmarshalCompliance(data []byte) (transactions.ComplianceData, error) {
+	var c transactions.ComplianceData
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, errorsmod.Wrap(interchain.ErrInvalidData, "invalid compliance format")
+	}
+	if c.EntityID == "" {
+		return c, errorsmod.Wrap(interchain.ErrInvalidData, "entity ID is required")
+	}
+	return c, nil
+}
+
+func (p complianceProcessor) ProcessCompliance(ctx sdk.Context, data []byte) error {
+	c, err := unmarshalCompliance(data)
+	if err != nil {
+		return err
+	}
+
+	evidence := make(map[string]struct{}, len(c.Evidence))
+	for _, e := range c.Evidence {
+		evidence[e.Type] = struct{}{}
+	}
+	status := ComplianceStatusCompliant
+	for requirement := range c.Requirements {
+		if _, ok := evidence[requirement]; !ok {
+			status = ComplianceStatusNonCompliant
+			break
+		}
+	}
+
+	p.k.setRecord(ctx, RecordKindCompliance, c.EntityID, data, status)
+	return nil
+}
+
+func (p complianceProcessor) ValidateCompliance(ctx sdk.Context, data []byte) error {
+	_, err := unmarshalCompliance(data)
+	return err
+}
+
+func (p complianceProcessor) UpdateComplianceStatus(ctx sdk.Context, entityID string, status string) error {
+	record, found := p.k.GetContractRecord(ctx, RecordKindCompliance, entityID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, entityID)
+	}
+	p.k.setRecord(ctx, RecordKindCompliance, entityID, record.Data, status)
+	return nil
+}
+
+func (p complianceProcessor) GetComplianceReport(ctx sdk.Context, entityID string) ([]byte, error) {
+	record, found := p.k.GetContractRecord(ctx, RecordKindCompliance, entityID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrRecordNotFound, entityID)
+	}
+	return json.Marshal(record)
+}
+
+// permitManager keeps permits as contract records
+type permitManager struct {
+	k Keeper
+}
+
+func unmarshalPermit(permit []byte) (contracts.Permit, error) {
+	var p contracts.Permit
+	if err := json.Unmarshal(permit, &p); err != nil {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "invalid permit format")
+	}
+	return p, nil
+}
+
+func (m permitManager) IssuePermit(ctx sdk.Context, permit []byte) error {
+	p, err := unmarshalPermit(permit)
+	if err != nil {
+		return err
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindPermit, p.PermitID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "permit %s already exists", p.PermitID)
+	}
+	m.k.setRecord(ctx, RecordKindPermit, p.PermitID, permit, StatusActive)
+	return nil
+}
+
+func (m permitManager) RevokePermit(ctx sdk.Context, permitID string) error {
+	record, found := m.k.GetContractRecord(ctx, RecordKindPermit, permitID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, permitID)
+	}
+	if record.Status == StatusRevoked {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "permit %s is already revoked", permitID)
+	}
+	m.k.setRecord(ctx, RecordKindPermit, permitID, record.Data, StatusRevoked)
+	return nil
+}
+
+func (m permitManager) UpdatePermit(ctx sdk.Context, permit []byte) error {
+	p, err := unmarshalPermit(permit)
+	if err != nil {
+		return err
+	}
+	record, found := m.k.GetContractRecord(ctx, RecordKindPermit, p.PermitID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, p.PermitID)
+	}
+	if record.Status == StatusRevoked {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "permit %s is revoked", p.PermitID)
+	}
+	m.k.setRecord(ctx, RecordKindPermit, p.PermitID, permit, record.Status)
+	return nil
+}
+
+func (m permitManager) ValidatePermit(ctx sdk.Context, permit []byte) error {
+	p, err := unmarshalPermit(permit)
+	if err != nil {
+		return err
+	}
+	if p.PermitID == "" || p.HolderID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "permit and holder IDs are required")
+	}
+	if !p.ExpiryDate.IsZero() && !p.ExpiryDate.After(ctx.BlockTime()) {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "permit is already expired")
+	}
+	return nil
+}
+
+// documentVerifier checks documents against the hash they were registered with
+type documentVerifier struct {
+	k Keeper
+}
+
+func unmarshalDocument(document []byte) (contracts.Document, error) {
+	var d contracts.Document
+	if err := json.Unmarshal(document, &d); err != nil {
+		return d, errorsmod.Wrap(interchain.ErrInvalidData, "invalid document format")
+	}
+	if d.DocumentID == "" || d.Hash == "" {
+		return d, errorsmod.Wrap(interchain.ErrInvalidData, "document ID and hash are required")
+	}
+	return d, nil
+}
+
+// VerifyDocument registers documents seen for the first time and checks the
+// hash of the ones already registered
+func (v documentVerifier) VerifyDocument(ctx sdk.Context, document []byte) error {
+	d, err := unmarshalDocument(document)
+	if err != nil {
+		return err
+	}
+
+	record, found := v.k.GetContractRecord(ctx, RecordKindDocument, d.DocumentID)
+	if !found {
+		if err := v.RegisterDocument(ctx, document); err != nil {
+			return err
+		}
+	} else {
+		registered, err := unmarshalDocument(record.Data)
+		if err != nil {
+			return err
+		}
+		if registered.Hash != d.Hash {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "hash of document %s does not match", d.DocumentID)
+		}
+	}
+
+	return v.UpdateDocument(ctx, d.DocumentID, StatusVerified)
+}
+
+func (v documentVerifier) RegisterDocument(ctx sdk.Context, document []byte) error {
+	d, err := unmarshalDocument(document)
+	if err != nil {
+		return err
+	}
+	if _, found := v.k.GetContractRecord(ctx, RecordKindDocument, d.DocumentID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "document %s already exists", d.DocumentID)
+	}
+	v.k.setRecord(ctx, RecordKindDocument, d.DocumentID, document, StatusActive)
+	return v.k.AppendAuditEntry(ctx, d.DocumentID, "register", d.Hash)
+}
+
+func (v documentVerifier) UpdateDocument(ctx sdk.Context, documentID string, status string) error {
+	record, found := v.k.GetContractRecord(ctx, RecordKindDocument, documentID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, documentID)
+	}
+	v.k.setRecord(ctx, RecordKindDocument, documentID, record.Data, status)
+	return v.k.AppendAuditEntry(ctx, documentID, "status", status)
+}
+
+func (v documentVerifier) GetDocumentHistory(ctx sdk.Context, documentID string) ([]byte, error) {
+	return json.Marshal(v.k.GetAuditTrail(ctx, documentID))
+}
+
+// auditManager appends audit events to the module audit trail
+type auditManager struct {
+	k Keeper
+}
+
+// LogAudit records an AuditEvent, or the raw event under the default subject
+// when it is not one
+func (m auditManager) LogAudit(ctx sdk.Context, event []byte) error {
+	var e AuditEvent
+	if err := json.Unmarshal(event, &e); err != nil || e.EntityID == "" {
+		e = AuditEvent{EntityID: defaultAuditSubject, Action: "event", Detail: string(event)}
+	}
+	if e.Action == "" {
+		e.Action = "event"
+	}
+	return m.k.AppendAuditEntry(ctx, e.EntityID, e.Action, e.Detail)
+}
+
+func (m auditManager) GetAuditTrail(ctx sdk.Context, entityID string) ([]byte, error) {
+	return json.Marshal(m.k.GetAuditTrail(ctx, entityID))
+}
+
+func (m auditManager) ValidateAudit(ctx sdk.Context, audit []byte) error {
+	var e AuditEvent
+	if err := json.Unmarshal(audit, &e); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid audit format")
+	}
+	if e.EntityID == "" || e.Action == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "entity ID and action are required")
+	}
+	return nil
+}
+
+// GenerateAuditReport counts the audit entries of an entity by action
+func (m auditManager) GenerateAuditReport(ctx sdk.Context, parameters []byte) ([]byte, error) {
+	var params struct {
+		EntityID string `json:"entity_id"`
+	}
+	if err := json.Unmarshal(parameters, &params); err != nil || params.EntityID == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "entity ID is required")
+	}
+
+	counts := make(map[string]int)
+	for _, entry := range m.k.GetAuditTrail(ctx, params.EntityID) {
+		counts[entry.Action]++
+	}
+	return json.Marshal(counts)
+}
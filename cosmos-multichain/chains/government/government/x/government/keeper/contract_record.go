@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"government/x/government/types"
+)
+
+// SetContractRecord set a specific contractRecord in the store from its index
+func (k Keeper) SetContractRecord(ctx context.Context, contractRecord types.ContractRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ContractRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&contractRecord)
+	store.Set(types.ContractRecordKey(
+		contractRecord.Kind,
+		contractRecord.RecordId,
+	), b)
+}
+
+// GetContractRecord returns a contractRecord from its index
+func (k Keeper) GetContractRecord(
+	ctx context.Context,
+	kind string,
+	recordId string,
+) (val types.ContractRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ContractRecordKeyPrefix))
+
+	b := store.Get(types.ContractRecordKey(
+		kind,
+		recordId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllContractRecord returns all contractRecord
+func (k Keeper) GetAllContractRecord(ctx context.Context) (list []types.ContractRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ContractRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ContractRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
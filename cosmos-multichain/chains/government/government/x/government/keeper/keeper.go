@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/audit"
+
+	"government/contracts"
+	"government/contracts/transactions"
+	"government/x/government/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		audit audit.Keeper[types.AuditEntry, *types.AuditEntry]
+
+		contract     *contracts.GovernmentContract
+		transactions *transactions.GovernmentTransactionHandler
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+		audit:        audit.NewKeeper[types.AuditEntry](cdc, storeService, types.NewAuditEntry),
+	}
+	k.contract = newContract(k)
+	k.transactions = transactions.NewGovernmentTransactionHandler(k.contract)
+	return k
+}
+
+// Contract returns the government contract backed by the module state.
+func (k Keeper) Contract() *contracts.GovernmentContract {
+	return k.contract
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"government/contracts/transactions"
+	"government/x/government/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.GovernmentTransactionRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	// Entities file their own compliance, which they must sign, while
+	// regulations, permits and document verifications are acts of the
+	// government, passed through governance
+	if req.TransactionType == transactions.ProcessCompliance {
+		if req.ComplianceData != nil && req.ComplianceData.EntityID != msg.Creator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the entity can file its compliance")
+		}
+	} else if msg.Creator != k.GetAuthority() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the authority %s can initiate a %s", k.GetAuthority(), req.TransactionType)
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TransactionType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TransactionType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"government/contracts/transactions"
+	keepertest "government/testutil/keeper"
+	"government/testutil/sample"
+	"government/x/government/keeper"
+	"government/x/government/types"
+)
+
+func governmentData(t *testing.T, req transactions.GovernmentTransactionRequest) []byte {
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	return data
+}
+
+func TestRegulationsAndPermits(t *testing.T) {
+	k, ctx := keepertest.GovernmentKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := k.GetAuthority()
+	initiate := func(req transactions.GovernmentTransactionRequest) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, governmentData(t, req)))
+		return err
+	}
+
+	regulation := &transactions.RegulationData{RegulationID: "reg-1", Title: "Zoning", Type: "land"}
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(sample.AccAddress(), governmentData(t, transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-1", TransactionType: transactions.NewRegulation, RegulationData: regulation,
+	})))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, found := k.GetContractRecord(ctx, keeper.RecordKindRegulation, "reg-1")
+	require.False(t, found)
+
+	require.ErrorIs(t, initiate(transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-2", TransactionType: transactions.UpdateRegulation, RegulationData: regulation,
+	}), types.ErrRecordNotFound)
+	require.NoError(t, initiate(transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-3", TransactionType: transactions.NewRegulation, RegulationData: regulation,
+	}))
+	record, found := k.GetContractRecord(ctx, keeper.RecordKindRegulation, "reg-1")
+	require.True(t, found)
+	require.Equal(t, keeper.StatusActive, record.Status)
+
+	permit := &transactions.PermitData{PermitID: "permit-1", HolderID: "holder-1", Type: "building"}
+	require.NoError(t, initiate(transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-4", TransactionType: transactions.IssuePermit, PermitData: permit,
+	}))
+	require.ErrorIs(t, initiate(transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-5", TransactionType: transactions.IssuePermit, PermitData: permit,
+	}), interchain.ErrInvalidData)
+	require.NoError(t, initiate(transactions.GovernmentTransactionRequest{
+		TransactionID: "tx-6", TransactionType: transactions.RevokePermit, PermitData: permit,
+	}))
+	record, _ = k.GetContractRecord(ctx, keeper.RecordKindPermit, "permit-1")
+	require.Equal(t, keeper.StatusRevoked, record.Status)
+}
+
+func TestVerifyDocument(t *testing.T) {
+	k, ctx := keepertest.GovernmentKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verify := func(creator, id, hash string) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, governmentData(t, transactions.GovernmentTransactionRequest{
+			TransactionID:   id,
+			TransactionType: transactions.VerifyDocument,
+			DocumentData:    &transactions.DocumentData{DocumentID: "doc-1", Hash: hash},
+		})))
+		return err
+	}
+
+	authority := k.GetAuthority()
+	require.ErrorIs(t, verify(sample.AccAddress(), "tx-1", "abc"), sdkerrors.ErrUnauthorized)
+	require.NoError(t, verify(authority, "tx-1", "abc"))
+	require.NoError(t, verify(authority, "tx-2", "abc"))
+	require.ErrorIs(t, verify(authority, "tx-3", "def"), interchain.ErrInvalidData)
+
+	var actions []string
+	for _, entry := range k.GetAuditTrail(ctx, "doc-1") {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []string{"register", "status", "status"}, actions)
+}
+
+func TestProcessCompliance(t *testing.T) {
+	k, ctx := keepertest.GovernmentKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+
+	// another account cannot file the compliance of the entity
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(sample.AccAddress(), governmentData(t, transactions.GovernmentTransactionRequest{
+		TransactionID:   "tx-1",
+		TransactionType: transactions.ProcessCompliance,
+		ComplianceData:  &transactions.ComplianceData{EntityID: creator, Evidence: []transactions.Evidence{{Type: "fire_safety"}}},
+	})))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, found := k.GetContractRecord(ctx, keeper.RecordKindCompliance, creator)
+	require.False(t, found)
+
+	for i, evidence := range [][]transactions.Evidence{nil, {{Type: "fire_safety"}}} {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, governmentData(t, transactions.GovernmentTransactionRequest{
+			TransactionID:   string(rune('a' + i)),
+			TransactionType: transactions.ProcessCompliance,
+			ComplianceData: &transactions.ComplianceData{
+				EntityID:     creator,
+				Requirements: map[string]string{"fire_safety": "annual inspection"},
+				Evidence:     evidence,
+			},
+		})))
+		require.NoError(t, err)
+
+		record, found := k.GetContractRecord(ctx, keeper.RecordKindCompliance, creator)
+		require.True(t, found)
+		if evidence == nil {
+			require.Equal(t, keeper.ComplianceStatusNonCompliant, record.Status)
+		} else {
+			require.Equal(t, keeper.ComplianceStatusCompliant, record.Status)
+		}
+	}
+}
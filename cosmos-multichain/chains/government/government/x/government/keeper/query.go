@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"government/x/government/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"government/x/government/types"
+)
+
+func (k Keeper) TransactionAll(ctx context.Context, req *types.QueryAllTransactionRequest) (*types.QueryAllTransactionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var transactions []types.Transaction
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	transactionStore := prefix.NewStore(store, types.KeyPrefix(types.TransactionKeyPrefix))
+
+	pageRes, err := query.Paginate(transactionStore, req.Pagination, func(key []byte, value []byte) error {
+		var transaction types.Transaction
+		if err := k.cdc.Unmarshal(value, &transaction); err != nil {
+			return err
+		}
+
+		transactions = append(transactions, transaction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTransactionResponse{Transaction: transactions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Transaction(ctx context.Context, req *types.QueryGetTransactionRequest) (*types.QueryGetTransactionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetTransaction(
+		ctx,
+		req.TransactionId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTransactionResponse{Transaction: val}, nil
+}
@@ -0,0 +1,45 @@
+package government
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"government/x/government/keeper"
+	"government/x/government/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the transaction
+	for _, elem := range genState.TransactionList {
+		k.SetTransaction(ctx, elem)
+	}
+	// Set all the contractRecord
+	for _, elem := range genState.ContractRecordList {
+		k.SetContractRecord(ctx, elem)
+	}
+	// Set all the auditEntry
+	for _, elem := range genState.AuditEntryList {
+		k.SetAuditEntry(ctx, elem)
+	}
+
+	// Set auditEntry count
+	k.SetAuditEntryCount(ctx, genState.AuditEntryCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.TransactionList = k.GetAllTransaction(ctx)
+	genesis.ContractRecordList = k.GetAllContractRecord(ctx)
+	genesis.AuditEntryList = k.GetAllAuditEntry(ctx)
+	genesis.AuditEntryCount = k.GetAuditEntryCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
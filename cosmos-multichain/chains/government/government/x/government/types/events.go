@@ -0,0 +1,13 @@
+package types
+
+// government module event types
+const (
+	EventTypeTransactionInitiated     = "transaction_initiated"
+	EventTypeTransactionStatusUpdated = "transaction_status_updated"
+	EventTypeTransactionFinalized     = "transaction_finalized"
+
+	AttributeKeyTransactionId   = "transaction_id"
+	AttributeKeyTransactionType = "transaction_type"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyStatus          = "status"
+)
@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: government/government/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the government module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransactionList    []Transaction    `protobuf:"bytes,2,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	ContractRecordList []ContractRecord `protobuf:"bytes,3,rep,name=contract_record_list,json=contractRecordList,proto3" json:"contract_record_list"`
+	AuditEntryList     []AuditEntry     `protobuf:"bytes,4,rep,name=audit_entry_list,json=auditEntryList,proto3" json:"audit_entry_list"`
+	AuditEntryCount    uint64           `protobuf:"varint,5,opt,name=audit_entry_count,json=auditEntryCount,proto3" json:"audit_entry_count,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7793434ea363260, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTransactionList() []Transaction {
+	if m != nil {
+		return m.TransactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRecordList() []ContractRecord {
+	if m != nil {
+		return m.ContractRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryList() []AuditEntry {
+	if m != nil {
+		return m.AuditEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryCount() uint64 {
+	if m != nil {
+		return m.AuditEntryCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "government.government.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("government/government/genesis.proto", fileDescriptor_a7793434ea363260)
+}
+
+var fileDescriptor_a7793434ea363260 = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0xd2, 0x84, 0xc6, 0x48, 0x5d, 0x0c, 0x44, 0x70, 0x33, 0x23, 0x12, 0x0f, 0x2b,
+	0xd8, 0xa1, 0x6b, 0x29, 0xd1, 0xa5, 0x43, 0xad, 0x9d, 0x82, 0x90, 0x69, 0x1d, 0x64, 0x20, 0x67,
+	0x64, 0xe6, 0x19, 0x79, 0xee, 0x0b, 0xf4, 0x31, 0x3a, 0xf6, 0x31, 0x3c, 0x7a, 0xec, 0x14, 0xa1,
+	0x87, 0xbe, 0x46, 0xec, 0xcc, 0xd0, 0xae, 0xe0, 0x76, 0x59, 0x1e, 0xff, 0xfd, 0xbd, 0xdf, 0x9b,
+	0xc7, 0xc3, 0x47, 0x23, 0xf1, 0x4c, 0x25, 0x1f, 0x53, 0x0e, 0xed, 0x64, 0x49, 0x39, 0x55, 0x4c,
+	0xf9, 0x13, 0x29, 0x40, 0xb8, 0xfb, 0xf1, 0x1f, 0x3f, 0x2e, 0xab, 0x25, 0x32, 0x66, 0x5c, 0xb4,
+	0xf5, 0xd7, 0x90, 0xd5, 0xf2, 0x48, 0x8c, 0x84, 0x2e, 0xdb, 0x51, 0x65, 0xd3, 0xc6, 0xe6, 0x21,
+	0x13, 0x22, 0xc9, 0xd8, 0xce, 0xa8, 0x9e, 0x6c, 0x66, 0x40, 0x12, 0xae, 0x48, 0x08, 0x4c, 0xf0,
+	0xff, 0x65, 0x92, 0x86, 0x42, 0x0e, 0x0d, 0xd3, 0x78, 0xcd, 0xe0, 0xdd, 0x2b, 0xb3, 0x42, 0x1f,
+	0x08, 0x50, 0xf7, 0x1c, 0xe7, 0xcc, 0xb4, 0x0a, 0xaa, 0xa3, 0x66, 0xbe, 0x53, 0xf3, 0x37, 0xae,
+	0xe4, 0xdf, 0x68, 0xa8, 0xbb, 0x33, 0xff, 0x3a, 0x70, 0xde, 0x7f, 0x3e, 0x5a, 0x28, 0xb0, 0x7d,
+	0x6e, 0x1f, 0x17, 0x13, 0x6f, 0x19, 0x3c, 0x31, 0x05, 0x95, 0xad, 0x7a, 0xa6, 0x99, 0xef, 0x34,
+	0x52, 0x5c, 0x77, 0x31, 0xde, 0xcd, 0x46, 0xc2, 0xa0, 0x90, 0x30, 0x5c, 0x33, 0x05, 0xee, 0x03,
+	0x2e, 0x87, 0x82, 0x83, 0x24, 0x21, 0x0c, 0xcc, 0x02, 0x46, 0x9c, 0xd1, 0xe2, 0xe3, 0x14, 0x71,
+	0xcf, 0xb6, 0x04, 0xba, 0xc3, 0xba, 0xdd, 0x70, 0x2d, 0xd5, 0xfa, 0x5b, 0x5c, 0x24, 0xd3, 0x21,
+	0x83, 0x01, 0xe5, 0x20, 0x67, 0x46, 0x9d, 0xd5, 0xea, 0xc3, 0x14, 0xf5, 0x45, 0x84, 0x5f, 0x46,
+	0xb4, 0xd5, 0xee, 0x91, 0xbf, 0x44, 0x2b, 0x5b, 0xb8, 0x94, 0x54, 0x86, 0x62, 0xca, 0xa1, 0xb2,
+	0x5d, 0x47, 0xcd, 0x6c, 0x50, 0x88, 0xd1, 0x5e, 0x14, 0x77, 0xcf, 0xe6, 0x4b, 0x0f, 0x2d, 0x96,
+	0x1e, 0xfa, 0x5e, 0x7a, 0xe8, 0x6d, 0xe5, 0x39, 0x8b, 0x95, 0xe7, 0x7c, 0xae, 0x3c, 0xe7, 0xbe,
+	0x96, 0x38, 0xdc, 0xcb, 0xda, 0xb9, 0x67, 0x13, 0xaa, 0x1e, 0x73, 0xfa, 0x8a, 0xa7, 0xbf, 0x03,
+	0x00, 0x19, 0x3a, 0xb9, 0xfc, 0x9d, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuditEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditEntryCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuditEntryList) > 0 {
+		for iNdEx := len(m.AuditEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for iNdEx := len(m.ContractRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for _, e := range m.ContractRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntryList) > 0 {
+		for _, e := range m.AuditEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditEntryCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRecordList = append(m.ContractRecordList, ContractRecord{})
+			if err := m.ContractRecordList[len(m.ContractRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntryList = append(m.AuditEntryList, AuditEntry{})
+			if err := m.AuditEntryList[len(m.AuditEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryCount", wireType)
+			}
+			m.AuditEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
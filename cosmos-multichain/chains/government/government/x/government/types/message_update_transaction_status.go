@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateTransactionStatus{}
+
+func NewMsgUpdateTransactionStatus(
+	creator string,
+	transactionId string,
+	status string,
+) *MsgUpdateTransactionStatus {
+	return &MsgUpdateTransactionStatus{
+		Creator:       creator,
+		TransactionId: transactionId,
+		Status:        status,
+	}
+}
+
+func (msg *MsgUpdateTransactionStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TransactionId == "" {
+		return errorsmod.Wrap(ErrInvalidTransaction, "transaction id cannot be empty")
+	}
+	if msg.Status == "" || msg.Status == TransactionStatusFinalized {
+		return errorsmod.Wrapf(ErrInvalidTransaction, "invalid status %q", msg.Status)
+	}
+	return nil
+}
@@ -0,0 +1,1030 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package healthcare
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Consent              protoreflect.MessageDescriptor
+	fd_Consent_patient      protoreflect.FieldDescriptor
+	fd_Consent_grantee      protoreflect.FieldDescriptor
+	fd_Consent_data_type    protoreflect.FieldDescriptor
+	fd_Consent_purpose      protoreflect.FieldDescriptor
+	fd_Consent_valid_from   protoreflect.FieldDescriptor
+	fd_Consent_valid_until  protoreflect.FieldDescriptor
+	fd_Consent_restrictions protoreflect.FieldDescriptor
+	fd_Consent_granted_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_healthcare_healthcare_consent_proto_init()
+	md_Consent = File_healthcare_healthcare_consent_proto.Messages().ByName("Consent")
+	fd_Consent_patient = md_Consent.Fields().ByName("patient")
+	fd_Consent_grantee = md_Consent.Fields().ByName("grantee")
+	fd_Consent_data_type = md_Consent.Fields().ByName("data_type")
+	fd_Consent_purpose = md_Consent.Fields().ByName("purpose")
+	fd_Consent_valid_from = md_Consent.Fields().ByName("valid_from")
+	fd_Consent_valid_until = md_Consent.Fields().ByName("valid_until")
+	fd_Consent_restrictions = md_Consent.Fields().ByName("restrictions")
+	fd_Consent_granted_at = md_Consent.Fields().ByName("granted_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_Consent)(nil)
+
+type fastReflection_Consent Consent
+
+func (x *Consent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Consent)(x)
+}
+
+func (x *Consent) slowProtoReflect() protoreflect.Message {
+	mi := &file_healthcare_healthcare_consent_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Consent_messageType fastReflection_Consent_messageType
+var _ protoreflect.MessageType = fastReflection_Consent_messageType{}
+
+type fastReflection_Consent_messageType struct{}
+
+func (x fastReflection_Consent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Consent)(nil)
+}
+func (x fastReflection_Consent_messageType) New() protoreflect.Message {
+	return new(fastReflection_Consent)
+}
+func (x fastReflection_Consent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Consent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Consent) Descriptor() protoreflect.MessageDescriptor {
+	return md_Consent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Consent) Type() protoreflect.MessageType {
+	return _fastReflection_Consent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Consent) New() protoreflect.Message {
+	return new(fastReflection_Consent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Consent) Interface() protoreflect.ProtoMessage {
+	return (*Consent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Consent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Patient != "" {
+		value := protoreflect.ValueOfString(x.Patient)
+		if !f(fd_Consent_patient, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_Consent_grantee, value) {
+			return
+		}
+	}
+	if x.DataType != "" {
+		value := protoreflect.ValueOfString(x.DataType)
+		if !f(fd_Consent_data_type, value) {
+			return
+		}
+	}
+	if x.Purpose != "" {
+		value := protoreflect.ValueOfString(x.Purpose)
+		if !f(fd_Consent_purpose, value) {
+			return
+		}
+	}
+	if x.ValidFrom != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ValidFrom)
+		if !f(fd_Consent_valid_from, value) {
+			return
+		}
+	}
+	if x.ValidUntil != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ValidUntil)
+		if !f(fd_Consent_valid_until, value) {
+			return
+		}
+	}
+	if x.Restrictions != "" {
+		value := protoreflect.ValueOfString(x.Restrictions)
+		if !f(fd_Consent_restrictions, value) {
+			return
+		}
+	}
+	if x.GrantedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GrantedAt)
+		if !f(fd_Consent_granted_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Consent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		return x.Patient != ""
+	case "healthcare.healthcare.Consent.grantee":
+		return x.Grantee != ""
+	case "healthcare.healthcare.Consent.data_type":
+		return x.DataType != ""
+	case "healthcare.healthcare.Consent.purpose":
+		return x.Purpose != ""
+	case "healthcare.healthcare.Consent.valid_from":
+		return x.ValidFrom != int64(0)
+	case "healthcare.healthcare.Consent.valid_until":
+		return x.ValidUntil != int64(0)
+	case "healthcare.healthcare.Consent.restrictions":
+		return x.Restrictions != ""
+	case "healthcare.healthcare.Consent.granted_at":
+		return x.GrantedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Consent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		x.Patient = ""
+	case "healthcare.healthcare.Consent.grantee":
+		x.Grantee = ""
+	case "healthcare.healthcare.Consent.data_type":
+		x.DataType = ""
+	case "healthcare.healthcare.Consent.purpose":
+		x.Purpose = ""
+	case "healthcare.healthcare.Consent.valid_from":
+		x.ValidFrom = int64(0)
+	case "healthcare.healthcare.Consent.valid_until":
+		x.ValidUntil = int64(0)
+	case "healthcare.healthcare.Consent.restrictions":
+		x.Restrictions = ""
+	case "healthcare.healthcare.Consent.granted_at":
+		x.GrantedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Consent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		value := x.Patient
+		return protoreflect.ValueOfString(value)
+	case "healthcare.healthcare.Consent.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "healthcare.healthcare.Consent.data_type":
+		value := x.DataType
+		return protoreflect.ValueOfString(value)
+	case "healthcare.healthcare.Consent.purpose":
+		value := x.Purpose
+		return protoreflect.ValueOfString(value)
+	case "healthcare.healthcare.Consent.valid_from":
+		value := x.ValidFrom
+		return protoreflect.ValueOfInt64(value)
+	case "healthcare.healthcare.Consent.valid_until":
+		value := x.ValidUntil
+		return protoreflect.ValueOfInt64(value)
+	case "healthcare.healthcare.Consent.restrictions":
+		value := x.Restrictions
+		return protoreflect.ValueOfString(value)
+	case "healthcare.healthcare.Consent.granted_at":
+		value := x.GrantedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Consent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		x.Patient = value.Interface().(string)
+	case "healthcare.healthcare.Consent.grantee":
+		x.Grantee = value.Interface().(string)
+	case "healthcare.healthcare.Consent.data_type":
+		x.DataType = value.Interface().(string)
+	case "healthcare.healthcare.Consent.purpose":
+		x.Purpose = value.Interface().(string)
+	case "healthcare.healthcare.Consent.valid_from":
+		x.ValidFrom = value.Int()
+	case "healthcare.healthcare.Consent.valid_until":
+		x.ValidUntil = value.Int()
+	case "healthcare.healthcare.Consent.restrictions":
+		x.Restrictions = value.Interface().(string)
+	case "healthcare.healthcare.Consent.granted_at":
+		x.GrantedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Consent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		panic(fmt.Errorf("field patient of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.grantee":
+		panic(fmt.Errorf("field grantee of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.data_type":
+		panic(fmt.Errorf("field data_type of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.purpose":
+		panic(fmt.Errorf("field purpose of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.valid_from":
+		panic(fmt.Errorf("field valid_from of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.valid_until":
+		panic(fmt.Errorf("field valid_until of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.restrictions":
+		panic(fmt.Errorf("field restrictions of message healthcare.healthcare.Consent is not mutable"))
+	case "healthcare.healthcare.Consent.granted_at":
+		panic(fmt.Errorf("field granted_at of message healthcare.healthcare.Consent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Consent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "healthcare.healthcare.Consent.patient":
+		return protoreflect.ValueOfString("")
+	case "healthcare.healthcare.Consent.grantee":
+		return protoreflect.ValueOfString("")
+	case "healthcare.healthcare.Consent.data_type":
+		return protoreflect.ValueOfString("")
+	case "healthcare.healthcare.Consent.purpose":
+		return protoreflect.ValueOfString("")
+	case "healthcare.healthcare.Consent.valid_from":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "healthcare.healthcare.Consent.valid_until":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "healthcare.healthcare.Consent.restrictions":
+		return protoreflect.ValueOfString("")
+	case "healthcare.healthcare.Consent.granted_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: healthcare.healthcare.Consent"))
+		}
+		panic(fmt.Errorf("message healthcare.healthcare.Consent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Consent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in healthcare.healthcare.Consent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Consent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Consent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Consent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Consent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Consent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Patient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DataType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Purpose)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidFrom != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidFrom))
+		}
+		if x.ValidUntil != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidUntil))
+		}
+		l = len(x.Restrictions)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GrantedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.GrantedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Consent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GrantedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GrantedAt))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Restrictions) > 0 {
+			i -= len(x.Restrictions)
+			copy(dAtA[i:], x.Restrictions)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Restrictions)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ValidUntil != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidUntil))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ValidFrom != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidFrom))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Purpose) > 0 {
+			i -= len(x.Purpose)
+			copy(dAtA[i:], x.Purpose)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Purpose)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DataType) > 0 {
+			i -= len(x.DataType)
+			copy(dAtA[i:], x.DataType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataType)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Patient) > 0 {
+			i -= len(x.Patient)
+			copy(dAtA[i:], x.Patient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Patient)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Consent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Consent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Consent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Patient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Patient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Purpose = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+				}
+				x.ValidFrom = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidFrom |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+				}
+				x.ValidUntil = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidUntil |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Restrictions = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+				}
+				x.GrantedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GrantedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.0
+// source: healthcare/healthcare/consent.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Consent is the consent of a patient for a grantee to access a type of their
+// data for a purpose, from valid_from until valid_until.
+type Consent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patient      string `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	Grantee      string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	DataType     string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Purpose      string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	ValidFrom    int64  `protobuf:"varint,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil   int64  `protobuf:"varint,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Restrictions string `protobuf:"bytes,7,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	GrantedAt    int64  `protobuf:"varint,8,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+}
+
+func (x *Consent) Reset() {
+	*x = Consent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_healthcare_healthcare_consent_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consent) ProtoMessage() {}
+
+// Deprecated: Use Consent.ProtoReflect.Descriptor instead.
+func (*Consent) Descriptor() ([]byte, []int) {
+	return file_healthcare_healthcare_consent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Consent) GetPatient() string {
+	if x != nil {
+		return x.Patient
+	}
+	return ""
+}
+
+func (x *Consent) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *Consent) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *Consent) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *Consent) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *Consent) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *Consent) GetRestrictions() string {
+	if x != nil {
+		return x.Restrictions
+	}
+	return ""
+}
+
+func (x *Consent) GetGrantedAt() int64 {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return 0
+}
+
+var File_healthcare_healthcare_consent_proto protoreflect.FileDescriptor
+
+var file_healthcare_healthcare_consent_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x61, 0x72, 0x65, 0x2f, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x63, 0x61, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x61, 0x72,
+	0x65, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x61, 0x72, 0x65, 0x22, 0xf7, 0x01, 0x0a,
+	0x07, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x26, 0x5a, 0x24, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x63, 0x61, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x61, 0x72, 0x65, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x61, 0x72, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_healthcare_healthcare_consent_proto_rawDescOnce sync.Once
+	file_healthcare_healthcare_consent_proto_rawDescData = file_healthcare_healthcare_consent_proto_rawDesc
+)
+
+func file_healthcare_healthcare_consent_proto_rawDescGZIP() []byte {
+	file_healthcare_healthcare_consent_proto_rawDescOnce.Do(func() {
+		file_healthcare_healthcare_consent_proto_rawDescData = protoimpl.X.CompressGZIP(file_healthcare_healthcare_consent_proto_rawDescData)
+	})
+	return file_healthcare_healthcare_consent_proto_rawDescData
+}
+
+var file_healthcare_healthcare_consent_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_healthcare_healthcare_consent_proto_goTypes = []interface{}{
+	(*Consent)(nil), // 0: healthcare.healthcare.Consent
+}
+var file_healthcare_healthcare_consent_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_healthcare_healthcare_consent_proto_init() }
+func file_healthcare_healthcare_consent_proto_init() {
+	if File_healthcare_healthcare_consent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_healthcare_healthcare_consent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_healthcare_healthcare_consent_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_healthcare_healthcare_consent_proto_goTypes,
+		DependencyIndexes: file_healthcare_healthcare_consent_proto_depIdxs,
+		MessageInfos:      file_healthcare_healthcare_consent_proto_msgTypes,
+	}.Build()
+	File_healthcare_healthcare_consent_proto = out.File
+	file_healthcare_healthcare_consent_proto_rawDesc = nil
+	file_healthcare_healthcare_consent_proto_goTypes = nil
+	file_healthcare_healthcare_consent_proto_depIdxs = nil
+}
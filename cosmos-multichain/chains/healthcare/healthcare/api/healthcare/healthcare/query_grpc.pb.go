@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: healthcare/healthcare/query.proto
+
+package healthcare
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName              = "/healthcare.healthcare.Query/Params"
+	Query_Transaction_FullMethodName         = "/healthcare.healthcare.Query/Transaction"
+	Query_TransactionAll_FullMethodName      = "/healthcare.healthcare.Query/TransactionAll"
+	Query_ContractRecord_FullMethodName      = "/healthcare.healthcare.Query/ContractRecord"
+	Query_AuditTrail_FullMethodName          = "/healthcare.healthcare.Query/AuditTrail"
+	Query_VerifyAuditTrail_FullMethodName    = "/healthcare.healthcare.Query/VerifyAuditTrail"
+	Query_EncryptionKey_FullMethodName       = "/healthcare.healthcare.Query/EncryptionKey"
+	Query_EncryptionKeyAll_FullMethodName    = "/healthcare.healthcare.Query/EncryptionKeyAll"
+	Query_PatientConsents_FullMethodName     = "/healthcare.healthcare.Query/PatientConsents"
+	Query_CheckAccess_FullMethodName         = "/healthcare.healthcare.Query/CheckAccess"
+	Query_BreakGlassAccess_FullMethodName    = "/healthcare.healthcare.Query/BreakGlassAccess"
+	Query_BreakGlassAccessAll_FullMethodName = "/healthcare.healthcare.Query/BreakGlassAccessAll"
+	Query_Notifications_FullMethodName       = "/healthcare.healthcare.Query/Notifications"
+	Query_RecordHistory_FullMethodName       = "/healthcare.healthcare.Query/RecordHistory"
+	Query_RecordDiff_FullMethodName          = "/healthcare.healthcare.Query/RecordDiff"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
+	TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error)
+	// Re-walks the hash chain of the audit trail of a subject.
+	VerifyAuditTrail(ctx context.Context, in *QueryVerifyAuditTrailRequest, opts ...grpc.CallOption) (*QueryVerifyAuditTrailResponse, error)
+	// Queries the encryption key registered by an account.
+	EncryptionKey(ctx context.Context, in *QueryGetEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryGetEncryptionKeyResponse, error)
+	EncryptionKeyAll(ctx context.Context, in *QueryAllEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryAllEncryptionKeyResponse, error)
+	// Queries the consents granted by a patient.
+	PatientConsents(ctx context.Context, in *QueryPatientConsentsRequest, opts ...grpc.CallOption) (*QueryPatientConsentsResponse, error)
+	// Queries whether an accessor may read a type of data of a patient now,
+	// for a purpose, with consent or by breaking the glass.
+	CheckAccess(ctx context.Context, in *QueryCheckAccessRequest, opts ...grpc.CallOption) (*QueryCheckAccessResponse, error)
+	// Queries a break-glass access by id.
+	BreakGlassAccess(ctx context.Context, in *QueryGetBreakGlassAccessRequest, opts ...grpc.CallOption) (*QueryGetBreakGlassAccessResponse, error)
+	BreakGlassAccessAll(ctx context.Context, in *QueryAllBreakGlassAccessRequest, opts ...grpc.CallOption) (*QueryAllBreakGlassAccessResponse, error)
+	// Queries the notifications of an account.
+	Notifications(ctx context.Context, in *QueryNotificationsRequest, opts ...grpc.CallOption) (*QueryNotificationsResponse, error)
+	// Queries the versions of a medical record, oldest first.
+	RecordHistory(ctx context.Context, in *QueryRecordHistoryRequest, opts ...grpc.CallOption) (*QueryRecordHistoryResponse, error)
+	// Queries the metadata changes of a medical record between two of its
+	// versions.
+	RecordDiff(ctx context.Context, in *QueryRecordDiffRequest, opts ...grpc.CallOption) (*QueryRecordDiffResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
+	out := new(QueryGetTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_Transaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
+	out := new(QueryAllTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_TransactionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error) {
+	out := new(QueryGetContractRecordResponse)
+	err := c.cc.Invoke(ctx, Query_ContractRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error) {
+	out := new(QueryAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_AuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyAuditTrail(ctx context.Context, in *QueryVerifyAuditTrailRequest, opts ...grpc.CallOption) (*QueryVerifyAuditTrailResponse, error) {
+	out := new(QueryVerifyAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_VerifyAuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptionKey(ctx context.Context, in *QueryGetEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryGetEncryptionKeyResponse, error) {
+	out := new(QueryGetEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptionKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptionKeyAll(ctx context.Context, in *QueryAllEncryptionKeyRequest, opts ...grpc.CallOption) (*QueryAllEncryptionKeyResponse, error) {
+	out := new(QueryAllEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, Query_EncryptionKeyAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PatientConsents(ctx context.Context, in *QueryPatientConsentsRequest, opts ...grpc.CallOption) (*QueryPatientConsentsResponse, error) {
+	out := new(QueryPatientConsentsResponse)
+	err := c.cc.Invoke(ctx, Query_PatientConsents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckAccess(ctx context.Context, in *QueryCheckAccessRequest, opts ...grpc.CallOption) (*QueryCheckAccessResponse, error) {
+	out := new(QueryCheckAccessResponse)
+	err := c.cc.Invoke(ctx, Query_CheckAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BreakGlassAccess(ctx context.Context, in *QueryGetBreakGlassAccessRequest, opts ...grpc.CallOption) (*QueryGetBreakGlassAccessResponse, error) {
+	out := new(QueryGetBreakGlassAccessResponse)
+	err := c.cc.Invoke(ctx, Query_BreakGlassAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BreakGlassAccessAll(ctx context.Context, in *QueryAllBreakGlassAccessRequest, opts ...grpc.CallOption) (*QueryAllBreakGlassAccessResponse, error) {
+	out := new(QueryAllBreakGlassAccessResponse)
+	err := c.cc.Invoke(ctx, Query_BreakGlassAccessAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Notifications(ctx context.Context, in *QueryNotificationsRequest, opts ...grpc.CallOption) (*QueryNotificationsResponse, error) {
+	out := new(QueryNotificationsResponse)
+	err := c.cc.Invoke(ctx, Query_Notifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordHistory(ctx context.Context, in *QueryRecordHistoryRequest, opts ...grpc.CallOption) (*QueryRecordHistoryResponse, error) {
+	out := new(QueryRecordHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_RecordHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordDiff(ctx context.Context, in *QueryRecordDiffRequest, opts ...grpc.CallOption) (*QueryRecordDiffResponse, error) {
+	out := new(QueryRecordDiffResponse)
+	err := c.cc.Invoke(ctx, Query_RecordDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
+	TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error)
+	// Re-walks the hash chain of the audit trail of a subject.
+	VerifyAuditTrail(context.Context, *QueryVerifyAuditTrailRequest) (*QueryVerifyAuditTrailResponse, error)
+	// Queries the encryption key registered by an account.
+	EncryptionKey(context.Context, *QueryGetEncryptionKeyRequest) (*QueryGetEncryptionKeyResponse, error)
+	EncryptionKeyAll(context.Context, *QueryAllEncryptionKeyRequest) (*QueryAllEncryptionKeyResponse, error)
+	// Queries the consents granted by a patient.
+	PatientConsents(context.Context, *QueryPatientConsentsRequest) (*QueryPatientConsentsResponse, error)
+	// Queries whether an accessor may read a type of data of a patient now,
+	// for a purpose, with consent or by breaking the glass.
+	CheckAccess(context.Context, *QueryCheckAccessRequest) (*QueryCheckAccessResponse, error)
+	// Queries a break-glass access by id.
+	BreakGlassAccess(context.Context, *QueryGetBreakGlassAccessRequest) (*QueryGetBreakGlassAccessResponse, error)
+	BreakGlassAccessAll(context.Context, *QueryAllBreakGlassAccessRequest) (*QueryAllBreakGlassAccessResponse, error)
+	// Queries the notifications of an account.
+	Notifications(context.Context, *QueryNotificationsRequest) (*QueryNotificationsResponse, error)
+	// Queries the versions of a medical record, oldest first.
+	RecordHistory(context.Context, *QueryRecordHistoryRequest) (*QueryRecordHistoryResponse, error)
+	// Queries the metadata changes of a medical record between two of its
+	// versions.
+	RecordDiff(context.Context, *QueryRecordDiffRequest) (*QueryRecordDiffResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedQueryServer) TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionAll not implemented")
+}
+func (UnimplementedQueryServer) ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRecord not implemented")
+}
+func (UnimplementedQueryServer) AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTrail not implemented")
+}
+func (UnimplementedQueryServer) VerifyAuditTrail(context.Context, *QueryVerifyAuditTrailRequest) (*QueryVerifyAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditTrail not implemented")
+}
+func (UnimplementedQueryServer) EncryptionKey(context.Context, *QueryGetEncryptionKeyRequest) (*QueryGetEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionKey not implemented")
+}
+func (UnimplementedQueryServer) EncryptionKeyAll(context.Context, *QueryAllEncryptionKeyRequest) (*QueryAllEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionKeyAll not implemented")
+}
+func (UnimplementedQueryServer) PatientConsents(context.Context, *QueryPatientConsentsRequest) (*QueryPatientConsentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatientConsents not implemented")
+}
+func (UnimplementedQueryServer) CheckAccess(context.Context, *QueryCheckAccessRequest) (*QueryCheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedQueryServer) BreakGlassAccess(context.Context, *QueryGetBreakGlassAccessRequest) (*QueryGetBreakGlassAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlassAccess not implemented")
+}
+func (UnimplementedQueryServer) BreakGlassAccessAll(context.Context, *QueryAllBreakGlassAccessRequest) (*QueryAllBreakGlassAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlassAccessAll not implemented")
+}
+func (UnimplementedQueryServer) Notifications(context.Context, *QueryNotificationsRequest) (*QueryNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notifications not implemented")
+}
+func (UnimplementedQueryServer) RecordHistory(context.Context, *QueryRecordHistoryRequest) (*QueryRecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordHistory not implemented")
+}
+func (UnimplementedQueryServer) RecordDiff(context.Context, *QueryRecordDiffRequest) (*QueryRecordDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDiff not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Transaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transaction(ctx, req.(*QueryGetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransactionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransactionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionAll(ctx, req.(*QueryAllTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContractRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRecord(ctx, req.(*QueryGetContractRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditTrail(ctx, req.(*QueryAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyAuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifyAuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyAuditTrail(ctx, req.(*QueryVerifyAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptionKey(ctx, req.(*QueryGetEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptionKeyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptionKeyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EncryptionKeyAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptionKeyAll(ctx, req.(*QueryAllEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PatientConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPatientConsentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PatientConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PatientConsents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PatientConsents(ctx, req.(*QueryPatientConsentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckAccess(ctx, req.(*QueryCheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BreakGlassAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBreakGlassAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BreakGlassAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BreakGlassAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BreakGlassAccess(ctx, req.(*QueryGetBreakGlassAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BreakGlassAccessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBreakGlassAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BreakGlassAccessAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BreakGlassAccessAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BreakGlassAccessAll(ctx, req.(*QueryAllBreakGlassAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Notifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Notifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Notifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Notifications(ctx, req.(*QueryNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecordHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordHistory(ctx, req.(*QueryRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecordDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordDiff(ctx, req.(*QueryRecordDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcare.healthcare.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _Query_Transaction_Handler,
+		},
+		{
+			MethodName: "TransactionAll",
+			Handler:    _Query_TransactionAll_Handler,
+		},
+		{
+			MethodName: "ContractRecord",
+			Handler:    _Query_ContractRecord_Handler,
+		},
+		{
+			MethodName: "AuditTrail",
+			Handler:    _Query_AuditTrail_Handler,
+		},
+		{
+			MethodName: "VerifyAuditTrail",
+			Handler:    _Query_VerifyAuditTrail_Handler,
+		},
+		{
+			MethodName: "EncryptionKey",
+			Handler:    _Query_EncryptionKey_Handler,
+		},
+		{
+			MethodName: "EncryptionKeyAll",
+			Handler:    _Query_EncryptionKeyAll_Handler,
+		},
+		{
+			MethodName: "PatientConsents",
+			Handler:    _Query_PatientConsents_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _Query_CheckAccess_Handler,
+		},
+		{
+			MethodName: "BreakGlassAccess",
+			Handler:    _Query_BreakGlassAccess_Handler,
+		},
+		{
+			MethodName: "BreakGlassAccessAll",
+			Handler:    _Query_BreakGlassAccessAll_Handler,
+		},
+		{
+			MethodName: "Notifications",
+			Handler:    _Query_Notifications_Handler,
+		},
+		{
+			MethodName: "RecordHistory",
+			Handler:    _Query_RecordHistory_Handler,
+		},
+		{
+			MethodName: "RecordDiff",
+			Handler:    _Query_RecordDiff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "healthcare/healthcare/query.proto",
+}
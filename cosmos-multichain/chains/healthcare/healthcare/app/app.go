@@ -75,6 +75,9 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	healthcaremodulekeeper "healthcare/x/healthcare/keeper"
+	laboratorymodulekeeper "healthcare/x/laboratory/keeper"
+	pharmacymodulekeeper "healthcare/x/pharmacy/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"healthcare/docs"
@@ -140,6 +143,9 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	HealthcareKeeper healthcaremodulekeeper.Keeper
+	PharmacyKeeper   pharmacymodulekeeper.Keeper
+	LaboratoryKeeper laboratorymodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -243,6 +249,9 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.HealthcareKeeper,
+		&app.PharmacyKeeper,
+		&app.LaboratoryKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -52,6 +52,15 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"google.golang.org/protobuf/types/known/durationpb"
+	healthcaremodulev1 "healthcare/api/healthcare/healthcare/module"
+	laboratorymodulev1 "healthcare/api/healthcare/laboratory/module"
+	pharmacymodulev1 "healthcare/api/healthcare/pharmacy/module"
+	_ "healthcare/x/healthcare/module" // import for side-effects
+	healthcaremoduletypes "healthcare/x/healthcare/types"
+	_ "healthcare/x/laboratory/module" // import for side-effects
+	laboratorymoduletypes "healthcare/x/laboratory/types"
+	_ "healthcare/x/pharmacy/module" // import for side-effects
+	pharmacymoduletypes "healthcare/x/pharmacy/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -89,6 +98,9 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		healthcaremoduletypes.ModuleName,
+		pharmacymoduletypes.ModuleName,
+		laboratorymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +125,9 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		healthcaremoduletypes.ModuleName,
+		pharmacymoduletypes.ModuleName,
+		laboratorymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +146,9 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		healthcaremoduletypes.ModuleName,
+		pharmacymoduletypes.ModuleName,
+		laboratorymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -286,6 +304,18 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   healthcaremoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&healthcaremodulev1.Module{}),
+			},
+			{
+				Name:   pharmacymoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&pharmacymodulev1.Module{}),
+			},
+			{
+				Name:   laboratorymoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&laboratorymodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
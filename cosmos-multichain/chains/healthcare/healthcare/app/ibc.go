@@ -35,6 +35,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -159,6 +160,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
+	ibcRouter.AddRoute(interchain.PortID, interchain.NewIBCModule(app.HealthcareKeeper.Port(), app.HealthcareKeeper))
+
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
@@ -2,26 +2,36 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/healthcare/contracts/interfaces"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"healthcare/contracts/interfaces"
+)
+
+// Healthcare counterparties outside the industry chains
+const (
+	ChainPharmacy   = "pharmacy"
+	ChainLaboratory = "laboratory"
 )
 
 // MedicalRecord represents patient medical data
 type MedicalRecord struct {
-	RecordID        string    `json:"record_id"`
-	PatientID       string    `json:"patient_id"`
-	ProviderID      string    `json:"provider_id"`
-	RecordType      string    `json:"record_type"` // Lab, Prescription, Diagnosis, etc.
-	Data            []byte    `json:"data"`        // Encrypted medical data
-	Timestamp       time.Time `json:"timestamp"`
-	LastModified    time.Time `json:"last_modified"`
-	AccessControl   []string  `json:"access_control"` // List of authorized addresses
-	ConsentRecords  []Consent `json:"consent_records"`
-	EncryptionType  string    `json:"encryption_type"`
-	DataHash        string    `json:"data_hash"`
+	RecordID       string    `json:"record_id"`
+	PatientID      string    `json:"patient_id"`
+	ProviderID     string    `json:"provider_id"`
+	RecordType     string    `json:"record_type"` // Lab, Prescription, Diagnosis, etc.
+	Data           []byte    `json:"data"`        // JSON encoded SealedData
+	Status         string    `json:"status"`
+	Timestamp      time.Time `json:"timestamp"`
+	LastModified   time.Time `json:"last_modified"`
+	AccessControl  []string  `json:"access_control"` // List of authorized addresses
+	ConsentRecords []Consent `json:"consent_records"`
+	DataHash       string    `json:"data_hash"`     // Commits to the version and to PreviousHash
+	Version        uint64    `json:"version"`       // Starts at 1, incremented by every change
+	PreviousHash   string    `json:"previous_hash"` // DataHash of the previous version
 }
 
 // Consent represents patient consent for data sharing
@@ -39,34 +49,71 @@ type Consent struct {
 type HealthcareContract struct {
 	validator interfaces.IHealthcareDataValidator
 	auditor   interfaces.IHealthcareAudit
+	keys      interfaces.IEncryptionKeyRegistry
+	records   interfaces.IMedicalRecordStore
+	pharmacy  interfaces.IPharmacy
+	lab       interfaces.ILaboratory
+	insurer   interfaces.IInsurer
+	router    *interchain.Router
 }
 
 func NewHealthcareContract(
 	validator interfaces.IHealthcareDataValidator,
 	auditor interfaces.IHealthcareAudit,
+	keys interfaces.IEncryptionKeyRegistry,
+	records interfaces.IMedicalRecordStore,
+	pharmacy interfaces.IPharmacy,
+	lab interfaces.ILaboratory,
+	insurer interfaces.IInsurer,
 ) *HealthcareContract {
-	return &HealthcareContract{
+	c := &HealthcareContract{
 		validator: validator,
 		auditor:   auditor,
+		keys:      keys,
+		records:   records,
+		pharmacy:  pharmacy,
+		lab:       lab,
+		insurer:   insurer,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainInsurance, MessageTypeTreatmentClaim, interchain.Route{
+			Prepare:  c.prepareTreatmentClaim,
+			Callback: c.handleClaimDecision,
+		}).
+		AddRoute(interchain.ChainInsurance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleInsuranceMessage,
+			Prepare:  c.prepareInsuranceMessage,
+			Callback: c.handleInsuranceCallback,
+		}).
+		AddRoute(ChainPharmacy, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handlePharmacyMessage,
+			Prepare:  c.preparePharmacyMessage,
+			Callback: c.handlePharmacyCallback,
+		}).
+		AddRoute(ChainLaboratory, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleLaboratoryMessage,
+			Prepare:  c.prepareLaboratoryMessage,
+			Callback: c.handleLaboratoryCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IHealthcareContract
 func (c *HealthcareContract) ValidateInterchainData(ctx sdk.Context, data []byte) error {
 	var record MedicalRecord
 	if err := json.Unmarshal(data, &record); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid medical record format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
 	}
 
 	// Validate required fields
 	if record.RecordID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "record ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "record ID is required")
 	}
 	if record.PatientID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "patient ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "patient ID is required")
 	}
 	if record.ProviderID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "provider ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "provider ID is required")
 	}
 
 	// Validate HIPAA compliance
@@ -78,59 +125,25 @@ func (c *HealthcareContract) ValidateInterchainData(ctx sdk.Context, data []byte
 }
 
 // ProcessInterchainMessage implements IHealthcareContract
-func (c *HealthcareContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	// Validate and process the incoming message based on source chain
-	switch sourceChain {
-	case "insurance":
-		return c.handleInsuranceMessage(ctx, message)
-	case "pharmacy":
-		return c.handlePharmacyMessage(ctx, message)
-	case "laboratory":
-		return c.handleLaboratoryMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
-}
-
-// PrepareInterchainMessage implements IHealthcareContract
-func (c *HealthcareContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	// Encrypt sensitive data before sending
-	encryptedData, err := c.EncryptSensitiveData(data)
-	if err != nil {
-		return nil, err
-	}
+func (c *HealthcareContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
+}
 
-	// Prepare chain-specific message
-	switch targetChain {
-	case "insurance":
-		return c.prepareInsuranceMessage(encryptedData)
-	case "pharmacy":
-		return c.preparePharmacyMessage(encryptedData)
-	case "laboratory":
-		return c.prepareLaboratoryMessage(encryptedData)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+// PrepareInterchainMessage implements IHealthcareContract. The data of the
+// medical records is sealed by their clients before it is stored, so the
+// records leave the chain as they are stored.
+func (c *HealthcareContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IHealthcareContract
-func (c *HealthcareContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
+func (c *HealthcareContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
 	// Log the callback
-	if err := c.auditor.LogAccess(ctx, sourceChain, "", "callback"); err != nil {
+	if err := c.auditor.LogAccess(ctx, sourceChain, "", "callback", "", true); err != nil {
 		return err
 	}
 
-	// Process chain-specific callbacks
-	switch sourceChain {
-	case "insurance":
-		return c.handleInsuranceCallback(ctx, response)
-	case "pharmacy":
-		return c.handlePharmacyCallback(ctx, response)
-	case "laboratory":
-		return c.handleLaboratoryCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ValidateHIPAACompliance implements IHealthcareContract
@@ -138,22 +151,93 @@ func (c *HealthcareContract) ValidateHIPAACompliance(ctx sdk.Context, data []byt
 	return c.validator.ValidatePrivacy(data)
 }
 
-// EncryptSensitiveData implements IHealthcareContract
-func (c *HealthcareContract) EncryptSensitiveData(data []byte) ([]byte, error) {
-	// Implement encryption logic
-	return data, nil
+// EncryptSensitiveData implements IHealthcareContract. It seals the data of a
+// JSON encoded medical record under a random data key, and wraps that key to
+// the X25519 public key of every address of its access control list. It runs
+// on the side of the client, which submits the sealed record: the chain
+// never sees the data in the clear.
+func (c *HealthcareContract) EncryptSensitiveData(data []byte, publicKeys map[string][]byte) ([]byte, error) {
+	var record MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	if len(record.Data) == 0 {
+		return data, nil
+	}
+	if len(record.AccessControl) == 0 {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "medical record has no authorized recipient")
+	}
+
+	dataKey, err := newDataKey()
+	if err != nil {
+		return nil, err
+	}
+	sealed := SealedData{EncryptionType: EncryptionTypeEnvelope}
+	for _, recipient := range record.AccessControl {
+		publicKey, found := publicKeys[recipient]
+		if !found {
+			return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "no encryption key for %s", recipient)
+		}
+		wrap, err := wrapKey(recipient, publicKey, dataKey)
+		if err != nil {
+			return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "cannot wrap data key to %s: %s", recipient, err)
+		}
+		sealed.KeyWraps = append(sealed.KeyWraps, wrap)
+	}
+	sealed.Ciphertext, err = seal(dataKey, record.Data, []byte(record.RecordID))
+	if err != nil {
+		return nil, err
+	}
+
+	record.Data, err = json.Marshal(sealed)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal sealed data")
+	}
+	return json.Marshal(record)
 }
 
-// DecryptSensitiveData implements IHealthcareContract
-func (c *HealthcareContract) DecryptSensitiveData(encryptedData []byte) ([]byte, error) {
-	// Implement decryption logic
-	return encryptedData, nil
+// DecryptSensitiveData implements IHealthcareContract. It opens the data of a
+// medical record sealed by EncryptSensitiveData with the X25519 private key
+// of one of its recipients, so it runs on the side of the recipient.
+func (c *HealthcareContract) DecryptSensitiveData(encryptedData []byte, recipient string, privateKey []byte) ([]byte, error) {
+	var record MedicalRecord
+	if err := json.Unmarshal(encryptedData, &record); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	var sealed SealedData
+	if err := json.Unmarshal(record.Data, &sealed); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid sealed data format")
+	}
+	if sealed.EncryptionType != EncryptionTypeEnvelope {
+		return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "unsupported encryption type %q", sealed.EncryptionType)
+	}
+
+	for _, wrap := range sealed.KeyWraps {
+		if wrap.Recipient != recipient {
+			continue
+		}
+		dataKey, err := unwrapKey(wrap, privateKey)
+		if err != nil {
+			return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "cannot unwrap data key: %s", err)
+		}
+		plaintext, err := open(dataKey, sealed.Ciphertext, []byte(record.RecordID))
+		if err != nil {
+			return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "cannot decrypt medical data: %s", err)
+		}
+		record.Data = plaintext
+		return json.Marshal(record)
+	}
+	return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record is not encrypted to %s", recipient)
 }
 
-// ValidateDataAccess implements IHealthcareContract
-func (c *HealthcareContract) ValidateDataAccess(ctx sdk.Context, userAddress string, dataType string) error {
-	// Implement access control validation
-	return nil
+// ValidateDataAccess implements IHealthcareContract. Access is granted by an
+// active consent of the patient, and every decision is audited.
+func (c *HealthcareContract) ValidateDataAccess(ctx sdk.Context, userAddress, patientID, dataType, purpose string) error {
+	err := c.validator.ValidateConsent(ctx, patientID, dataType, userAddress, purpose)
+	if logErr := c.auditor.LogAccess(ctx, userAddress, patientID, dataType, purpose, err == nil); logErr != nil {
+		return logErr
+	}
+	return err
 }
 
 // Internal handlers for chain-specific messages
@@ -173,33 +257,13 @@ func (c *HealthcareContract) handleLaboratoryMessage(ctx sdk.Context, message []
 }
 
 // Internal message preparation
-func (c *HealthcareContract) prepareInsuranceMessage(data []byte) ([]byte, error) {
+func (c *HealthcareContract) prepareInsuranceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for insurance chain
 	return data, nil
 }
 
-func (c *HealthcareContract) preparePharmacyMessage(data []byte) ([]byte, error) {
-	// Prepare message for pharmacy chain
-	return data, nil
-}
-
-func (c *HealthcareContract) prepareLaboratoryMessage(data []byte) ([]byte, error) {
-	// Prepare message for laboratory chain
-	return data, nil
-}
-
 // Internal callback handlers
 func (c *HealthcareContract) handleInsuranceCallback(ctx sdk.Context, response []byte) error {
 	// Handle insurance responses
 	return nil
 }
-
-func (c *HealthcareContract) handlePharmacyCallback(ctx sdk.Context, response []byte) error {
-	// Handle pharmacy responses
-	return nil
-}
-
-func (c *HealthcareContract) handleLaboratoryCallback(ctx sdk.Context, response []byte) error {
-	// Handle laboratory responses
-	return nil
-}
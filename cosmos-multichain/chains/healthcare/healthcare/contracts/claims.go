@@ -0,0 +1,205 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// PurposeInsuranceClaim is the purpose patients consent to the insurer
+// accessing their treatments for, so that the treatments are claimed
+const PurposeInsuranceClaim = "insurance-claim"
+
+// MessageTypeTreatmentClaim is the interchain message type of the treatment
+// claims sent to the insurance chain, and of the decisions sent back
+const MessageTypeTreatmentClaim = "treatment_claim"
+
+// MessageTypeClaimCorroboration is the interchain message type of the
+// queries of the insurance chain for the medical records corroborating a
+// claim
+const MessageTypeClaimCorroboration = "claim_corroboration"
+
+// MessageTypeTreatmentRecord is the interchain message type of the versions
+// of the treatment medical records shared with the insurance chain
+const MessageTypeTreatmentRecord = "treatment_record"
+
+// Decisions of the insurance chain on a treatment claim
+const (
+	ClaimDecisionApproved = "APPROVED"
+	ClaimDecisionDenied   = "DENIED"
+	ClaimDecisionPaid     = "PAID"
+)
+
+// TreatmentClaim is the claim of a treatment sent to the insurance chain,
+// where it is filed against the health policy of the patient
+type TreatmentClaim struct {
+	ClaimID    string      `json:"claim_id"` // ID of the treatment medical record
+	PatientID  string      `json:"patient"`
+	ProviderID string      `json:"provider"`
+	Amount     sdkmath.Int `json:"amount"`
+	DataHash   string      `json:"data_hash"`
+}
+
+// ClaimDecision is the insurance callback reporting a decision on a
+// treatment claim
+type ClaimDecision struct {
+	ClaimID string `json:"claim_id"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+}
+
+// CorroborationQuery is the query of the insurance chain for the medical
+// records corroborating a claim filed by a patient
+type CorroborationQuery struct {
+	ClaimID   string    `json:"claim_id"`
+	Subject   string    `json:"subject"` // Claimant, the patient of the records
+	ClaimType string    `json:"claim_type"`
+	Evidence  []string  `json:"evidence"` // Hashes of the claim evidence
+	FiledAt   time.Time `json:"filed_at"`
+}
+
+// CorroborationResult is the answer to a corroboration query
+type CorroborationResult struct {
+	ClaimID string   `json:"claim_id"`
+	Records []string `json:"records"` // IDs of the corroborating records, none when the claim is not corroborated
+}
+
+// ClaimStatus returns the status of a treatment medical record whose claim
+// received a decision
+func ClaimStatus(decision string) string {
+	return "CLAIM_" + decision
+}
+
+// ClaimTreatment prepares the claim of a treatment and submits it to the
+// insurer on the insurance chain, which sends its decisions back as callbacks
+func (c *HealthcareContract) ClaimTreatment(ctx sdk.Context, data []byte) error {
+	prepared, err := c.PrepareInterchainMessage(ctx, interchain.ChainInsurance, MessageTypeTreatmentClaim, data)
+	if err != nil {
+		return err
+	}
+	return c.insurer.SubmitClaim(ctx, prepared)
+}
+
+// ShareTreatmentRecord sends a version of a treatment medical record, sealed
+// by its client, to the insurance chain. Nothing is shared while there is no
+// insurer.
+func (c *HealthcareContract) ShareTreatmentRecord(ctx sdk.Context, data []byte) error {
+	if c.insurer.Address(ctx) == "" {
+		return nil
+	}
+	prepared, err := c.PrepareInterchainMessage(ctx, interchain.ChainInsurance, MessageTypeTreatmentRecord, data)
+	if err != nil {
+		return err
+	}
+	return c.insurer.ShareRecord(ctx, prepared)
+}
+
+// prepareTreatmentClaim prepares the claim of amount for the treatment
+// medical record with the claim ID, which the patient must have consented to
+// the insurer accessing for the insurance-claim purpose. The patient,
+// provider and data hash of the claim are those of the record.
+func (c *HealthcareContract) prepareTreatmentClaim(ctx sdk.Context, data []byte) ([]byte, error) {
+	var claim TreatmentClaim
+	if err := json.Unmarshal(data, &claim); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid treatment claim format")
+	}
+	if claim.Amount.IsNil() || !claim.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claimed amount must be positive")
+	}
+	record, found := c.GetMedicalRecord(ctx, claim.ClaimID)
+	if !found {
+		return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record %s does not exist", claim.ClaimID)
+	}
+
+	insurer := c.insurer.Address(ctx)
+	if insurer == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "no insurer to claim treatments from")
+	}
+	if err := c.ValidateDataAccess(ctx, insurer, record.PatientID, record.RecordType, PurposeInsuranceClaim); err != nil {
+		return nil, err
+	}
+
+	claim.PatientID = record.PatientID
+	claim.ProviderID = record.ProviderID
+	claim.DataHash = record.DataHash
+	prepared, err := json.Marshal(claim)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal treatment claim")
+	}
+	return prepared, nil
+}
+
+// handleClaimDecision records the decision on the claim of a treatment as the
+// status of a new version of its record, changed by the insurer. The decisions
+// may arrive out of order, so no decision follows the payment of a claim.
+func (c *HealthcareContract) handleClaimDecision(ctx sdk.Context, response []byte) error {
+	var decision ClaimDecision
+	if err := json.Unmarshal(response, &decision); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim decision format")
+	}
+	switch decision.Status {
+	case ClaimDecisionApproved, ClaimDecisionDenied, ClaimDecisionPaid:
+	default:
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "%q is not a claim decision", decision.Status)
+	}
+	insurer := c.insurer.Address(ctx)
+	if insurer == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "no insurer to receive claim decisions from")
+	}
+
+	record, err := c.counterpartyRecord(ctx, decision.ClaimID)
+	if err != nil {
+		return err
+	}
+	status := ClaimStatus(decision.Status)
+	if record.Status == status || record.Status == ClaimStatus(ClaimDecisionPaid) {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "claim of %s is already %s", record.RecordID, record.Status)
+	}
+	reason := "claim " + decision.Status + " by " + insurer
+	if decision.Reason != "" {
+		reason += ": " + decision.Reason
+	}
+	record.Status = status
+	_, err = c.AmendMedicalRecord(ctx, insurer, reason, record)
+	return err
+}
+
+// CorroborateClaim answers a corroboration query of the insurance chain with
+// the medical records of the patient, with a version of one of the hashes of
+// the claim evidence if it has any.
+// Only the records the patient consented to the insurer accessing for the
+// insurance-claim purpose are reported.
+func (c *HealthcareContract) CorroborateClaim(ctx sdk.Context, data []byte) ([]byte, error) {
+	var query CorroborationQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid corroboration query format")
+	}
+	if query.ClaimID == "" || query.Subject == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claim ID and subject are required")
+	}
+	insurer := c.insurer.Address(ctx)
+	if insurer == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "no insurer to corroborate claims for")
+	}
+
+	result := CorroborationResult{ClaimID: query.ClaimID, Records: []string{}}
+	for _, data := range c.records.FindRecords(ctx, query.Subject, query.Evidence) {
+		var record MedicalRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+		}
+		if c.ValidateDataAccess(ctx, insurer, record.PatientID, record.RecordType, PurposeInsuranceClaim) != nil {
+			continue
+		}
+		result.Records = append(result.Records, record.RecordID)
+	}
+	response, err := json.Marshal(result)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal corroboration result")
+	}
+	return response, nil
+}
@@ -0,0 +1,164 @@
+package contracts
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// Statuses of the medical records changed by the pharmacy and laboratory
+// callbacks
+const (
+	StatusDispensed = "DISPENSED"
+	StatusResulted  = "RESULTED"
+)
+
+// Message types of the pharmacy and laboratory callbacks
+const (
+	MessageTypeDispensed = "DISPENSED"
+	MessageTypeLabResult = "LAB_RESULT"
+)
+
+// EPrescription is the e-prescription sent to the pharmacy for the first
+// version of a prescription medical record
+type EPrescription struct {
+	PrescriptionID string `json:"prescription_id"`
+	PatientID      string `json:"patient"`
+	PrescriberID   string `json:"prescriber"`
+	DataHash       string `json:"data_hash"`
+}
+
+// LabOrder is the lab test order sent to the laboratory for the first version
+// of a lab test medical record
+type LabOrder struct {
+	OrderID   string `json:"order_id"`
+	PatientID string `json:"patient"`
+	OrdererID string `json:"orderer"`
+	DataHash  string `json:"data_hash"`
+}
+
+// Dispensing is the pharmacy callback reporting a dispensed prescription
+type Dispensing struct {
+	PrescriptionID string `json:"prescription_id"`
+	Pharmacist     string `json:"pharmacist"`
+}
+
+// LabResult is the laboratory callback reporting the result of a lab order
+type LabResult struct {
+	OrderID    string `json:"order_id"`
+	Laboratory string `json:"laboratory"`
+	Result     []byte `json:"result"` // Encrypted result of the test
+}
+
+// preparePharmacyMessage sends the e-prescription of a new prescription
+// record to the pharmacy. Later versions of the record are not prescribed
+// again.
+func (c *HealthcareContract) preparePharmacyMessage(ctx sdk.Context, data []byte) ([]byte, error) {
+	var record MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	if record.Version != 1 {
+		return data, nil
+	}
+
+	prescription, err := json.Marshal(EPrescription{
+		PrescriptionID: record.RecordID,
+		PatientID:      record.PatientID,
+		PrescriberID:   record.ProviderID,
+		DataHash:       record.DataHash,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal e-prescription")
+	}
+	if err := c.pharmacy.SubmitPrescription(ctx, prescription); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// prepareLaboratoryMessage sends the lab order of a new lab test record to the
+// laboratory. Later versions of the record are not ordered again.
+func (c *HealthcareContract) prepareLaboratoryMessage(ctx sdk.Context, data []byte) ([]byte, error) {
+	var record MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	if record.Version != 1 {
+		return data, nil
+	}
+
+	order, err := json.Marshal(LabOrder{
+		OrderID:   record.RecordID,
+		PatientID: record.PatientID,
+		OrdererID: record.ProviderID,
+		DataHash:  record.DataHash,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal lab order")
+	}
+	if err := c.lab.SubmitLabOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// handlePharmacyCallback marks the prescription record DISPENSED with a new
+// version changed by the pharmacist
+func (c *HealthcareContract) handlePharmacyCallback(ctx sdk.Context, response []byte) error {
+	var dispensing Dispensing
+	if err := json.Unmarshal(response, &dispensing); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid dispensing format")
+	}
+	if dispensing.PrescriptionID == "" || dispensing.Pharmacist == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "prescription ID and pharmacist are required")
+	}
+
+	record, err := c.counterpartyRecord(ctx, dispensing.PrescriptionID)
+	if err != nil {
+		return err
+	}
+	if record.Status == StatusDispensed {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "prescription %s is already dispensed", record.RecordID)
+	}
+	record.Status = StatusDispensed
+	_, err = c.AmendMedicalRecord(ctx, dispensing.Pharmacist, "dispensed by "+dispensing.Pharmacist, record)
+	return err
+}
+
+// handleLaboratoryCallback records the result of a lab test as the data of a
+// new RESULTED version of its record, changed by the laboratory
+func (c *HealthcareContract) handleLaboratoryCallback(ctx sdk.Context, response []byte) error {
+	var result LabResult
+	if err := json.Unmarshal(response, &result); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid lab result format")
+	}
+	if result.OrderID == "" || result.Laboratory == "" || len(result.Result) == 0 {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "order ID, laboratory and result are required")
+	}
+
+	record, err := c.counterpartyRecord(ctx, result.OrderID)
+	if err != nil {
+		return err
+	}
+	if record.Status == StatusResulted {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "lab order %s is already resulted", record.RecordID)
+	}
+	record.Status = StatusResulted
+	record.Data = result.Result
+	_, err = c.AmendMedicalRecord(ctx, result.Laboratory, "resulted by "+result.Laboratory, record)
+	return err
+}
+
+// counterpartyRecord returns the latest version of a medical record, ready to
+// be changed into its next version
+func (c *HealthcareContract) counterpartyRecord(ctx sdk.Context, recordID string) (MedicalRecord, error) {
+	record, found := c.GetMedicalRecord(ctx, recordID)
+	if !found {
+		return record, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record %s does not exist", recordID)
+	}
+	record.PreviousHash = record.DataHash
+	return record, nil
+}
@@ -0,0 +1,136 @@
+package contracts
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// EncryptionTypeEnvelope is the encryption type of the medical data sealed
+// by EncryptSensitiveData. The data is encrypted with AES-256-GCM under a
+// random data key, wrapped to each recipient with an ephemeral X25519 key
+// agreement and HKDF-SHA256.
+const EncryptionTypeEnvelope = "x25519-hkdf-sha256-aes256gcm"
+
+// SealedData is the JSON encoded data of a medical record sealed by its
+// client. The chain only stores and sends sealed data.
+type SealedData struct {
+	EncryptionType string    `json:"encryption_type"`
+	Ciphertext     []byte    `json:"ciphertext"`
+	KeyWraps       []KeyWrap `json:"key_wraps"` // Data key wrapped to each authorized address
+}
+
+// dataKeySize is the size of the data keys and of the keys wrapping them
+const dataKeySize = 32
+
+// KeyWrap is the data key of a medical record wrapped to one of its recipients
+type KeyWrap struct {
+	Recipient    string `json:"recipient"`
+	EphemeralKey []byte `json:"ephemeral_key"`
+	WrappedKey   []byte `json:"wrapped_key"`
+}
+
+// newDataKey returns a random data key
+func newDataKey() ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// wrapKey wraps dataKey to the X25519 public key of recipient
+func wrapKey(recipient string, publicKey []byte, dataKey []byte) (KeyWrap, error) {
+	recipientKey, err := ecdh.X25519().NewPublicKey(publicKey)
+	if err != nil {
+		return KeyWrap{}, err
+	}
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyWrap{}, err
+	}
+	ephemeralKey := ephemeral.PublicKey().Bytes()
+	kek, err := wrappingKey(ephemeral, recipientKey, ephemeralKey, recipient)
+	if err != nil {
+		return KeyWrap{}, err
+	}
+	wrapped, err := seal(kek, dataKey, []byte(recipient))
+	if err != nil {
+		return KeyWrap{}, err
+	}
+	return KeyWrap{Recipient: recipient, EphemeralKey: ephemeralKey, WrappedKey: wrapped}, nil
+}
+
+// unwrapKey recovers the data key wrapped to the recipient owning privateKey
+func unwrapKey(wrap KeyWrap, privateKey []byte) ([]byte, error) {
+	recipientKey, err := ecdh.X25519().NewPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(wrap.EphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := wrappingKey(recipientKey, ephemeral, wrap.EphemeralKey, wrap.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	return open(kek, wrap.WrappedKey, []byte(wrap.Recipient))
+}
+
+// wrappingKey derives the key wrapping a data key from the X25519 agreement
+// between the ephemeral key and the key of the recipient, bound to both
+func wrappingKey(private *ecdh.PrivateKey, public *ecdh.PublicKey, ephemeralKey []byte, recipient string) ([]byte, error) {
+	shared, err := private.ECDH(public)
+	if err != nil {
+		return nil, err
+	}
+	info := append([]byte(EncryptionTypeEnvelope), ephemeralKey...)
+	info = append(info, recipient...)
+	kek := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, shared, nil, info), kek); err != nil {
+		return nil, err
+	}
+	return kek, nil
+}
+
+// seal encrypts plaintext with AES-256-GCM under key, prefixing the result
+// with its random nonce
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts a ciphertext produced by seal
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -2,21 +2,19 @@ package interfaces
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IHealthcareContract extends the base interchain contract with healthcare-specific features
 type IHealthcareContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// Healthcare-specific functionality
 	ValidateHIPAACompliance(ctx sdk.Context, data []byte) error
-	EncryptSensitiveData(data []byte) ([]byte, error)
-	DecryptSensitiveData(encryptedData []byte) ([]byte, error)
-	ValidateDataAccess(ctx sdk.Context, userAddress string, dataType string) error
+	EncryptSensitiveData(data []byte, publicKeys map[string][]byte) ([]byte, error)
+	DecryptSensitiveData(encryptedData []byte, recipient string, privateKey []byte) ([]byte, error)
+	ValidateDataAccess(ctx sdk.Context, userAddress, patientID, dataType, purpose string) error
 }
 
 // IHealthcareDataValidator defines the interface for healthcare data validation
@@ -27,8 +25,9 @@ type IHealthcareDataValidator interface {
 	// ValidateAuthorization checks if the requester is authorized to access the data
 	ValidateAuthorization(requesterID string, patientID string, dataType string) error
 
-	// ValidateConsent checks if patient consent exists for data sharing
-	ValidateConsent(patientID string, dataType string, recipientID string) error
+	// ValidateConsent checks if an active patient consent allows sharing the
+	// data type with the recipient for the purpose
+	ValidateConsent(ctx sdk.Context, patientID, dataType, recipientID, purpose string) error
 
 	// ValidatePrivacy ensures HIPAA compliance of data handling
 	ValidatePrivacy(data []byte) error
@@ -36,8 +35,8 @@ type IHealthcareDataValidator interface {
 
 // IHealthcareAudit defines the interface for healthcare audit logging
 type IHealthcareAudit interface {
-	// LogAccess records data access events
-	LogAccess(ctx sdk.Context, accessorID string, patientID string, dataType string) error
+	// LogAccess records data access decisions
+	LogAccess(ctx sdk.Context, accessorID, patientID, dataType, purpose string, granted bool) error
 
 	// LogModification records data modification events
 	LogModification(ctx sdk.Context, modifierID string, patientID string, dataType string) error
@@ -48,3 +47,52 @@ type IHealthcareAudit interface {
 	// GetAuditTrail retrieves the audit trail for specific data
 	GetAuditTrail(ctx sdk.Context, patientID string, dataType string) ([]byte, error)
 }
+
+// IEncryptionKeyRegistry defines the interface for resolving the keys medical
+// records are encrypted to
+type IEncryptionKeyRegistry interface {
+	// GetEncryptionKey returns the X25519 public key registered by address
+	GetEncryptionKey(ctx sdk.Context, address string) ([]byte, bool)
+}
+
+// IMedicalRecordStore defines the interface for keeping the versions of
+// medical records
+type IMedicalRecordStore interface {
+	// GetLatestVersion returns the latest version of a medical record, JSON encoded
+	GetLatestVersion(ctx sdk.Context, recordID string) ([]byte, bool)
+
+	// AppendVersion stores the JSON encoded medical record as its next
+	// version, changed by modifierID for reason
+	AppendVersion(ctx sdk.Context, modifierID, reason string, record []byte) error
+
+	// FindRecords returns the latest versions, JSON encoded, of the medical
+	// records of a patient. With data hashes, only the records with a version
+	// of one of them are returned.
+	FindRecords(ctx sdk.Context, patientID string, dataHashes []string) [][]byte
+}
+
+// IPharmacy defines the interface of the pharmacies e-prescriptions are sent to
+type IPharmacy interface {
+	// SubmitPrescription records the JSON encoded e-prescription for dispensing
+	SubmitPrescription(ctx sdk.Context, prescription []byte) error
+}
+
+// ILaboratory defines the interface of the laboratories lab tests are ordered from
+type ILaboratory interface {
+	// SubmitLabOrder records the JSON encoded lab order for testing
+	SubmitLabOrder(ctx sdk.Context, order []byte) error
+}
+
+// IInsurer defines the interface of the insurer treatments are claimed from
+type IInsurer interface {
+	// Address returns the address of the insurer, empty when there is none
+	Address(ctx sdk.Context) string
+
+	// SubmitClaim records the JSON encoded treatment claim and sends it to the
+	// insurance chain
+	SubmitClaim(ctx sdk.Context, claim []byte) error
+
+	// ShareRecord sends the JSON encoded sealed medical record to the
+	// insurance chain
+	ShareRecord(ctx sdk.Context, record []byte) error
+}
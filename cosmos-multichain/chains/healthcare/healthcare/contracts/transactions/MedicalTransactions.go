@@ -3,32 +3,41 @@ package transactions
 import (
 	"encoding/json"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/healthcare/contracts"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"healthcare/contracts"
 )
 
 // TransactionType defines the type of medical transaction
 type TransactionType string
 
 const (
-	Diagnosis   TransactionType = "DIAGNOSIS"
+	Diagnosis    TransactionType = "DIAGNOSIS"
 	Prescription TransactionType = "PRESCRIPTION"
-	LabTest     TransactionType = "LAB_TEST"
-	Treatment   TransactionType = "TREATMENT"
+	LabTest      TransactionType = "LAB_TEST"
+	Treatment    TransactionType = "TREATMENT"
 )
 
+// StatusFinalized is the status of the medical record of a finalized transaction
+const StatusFinalized = "FINALIZED"
+
 // MedicalTransaction represents a healthcare transaction
 type MedicalTransaction struct {
 	TransactionID   string          `json:"transaction_id"`
 	PatientID       string          `json:"patient_id"`
 	ProviderID      string          `json:"provider_id"`
 	TransactionType TransactionType `json:"transaction_type"`
-	Data            []byte          `json:"data"` // Encrypted medical data
+	Purpose         string          `json:"purpose"` // Purpose of the access consented by the patient
+	Data            []byte          `json:"data"`    // JSON encoded contracts.SealedData, sealed by the client
 	Timestamp       time.Time       `json:"timestamp"`
 	Status          string          `json:"status"`
 	Attachments     []Attachment    `json:"attachments"`
-	Consent         []string        `json:"consent"` // List of consenting parties
+	Consent         []string        `json:"consent"`      // List of consenting parties
+	ClaimAmount     sdkmath.Int     `json:"claim_amount"` // Amount of a treatment claimed from the insurer, if positive
 }
 
 // Attachment represents medical documents or images
@@ -52,73 +61,176 @@ func NewMedicalTransactionHandler(contract *contracts.HealthcareContract) *Medic
 	}
 }
 
-// InitiateTransaction starts a new medical transaction
+// InitiateTransaction starts a new medical transaction, and stores its
+// medical record as the first version of the record. The claim amount of a
+// treatment is claimed from the insurer.
 func (h *MedicalTransactionHandler) InitiateTransaction(ctx sdk.Context, tx MedicalTransaction) error {
 	// Validate transaction data
 	if err := h.validateTransaction(tx); err != nil {
 		return err
 	}
 
+	// Check the patient consents to the access of every party
+	if err := h.validateAccess(ctx, tx); err != nil {
+		return err
+	}
+
+	record, err := h.contract.AmendMedicalRecord(ctx, tx.ProviderID, "", tx.record())
+	if err != nil {
+		return err
+	}
+
 	// Notify relevant chains
-	if err := h.notifyRelevantChains(ctx, tx); err != nil {
+	if err := h.notifyRelevantChains(ctx, record); err != nil {
 		return err
 	}
 
-	return nil
+	if !tx.claimed() {
+		return nil
+	}
+	return h.claimTreatment(ctx, record.RecordID, tx.ClaimAmount)
 }
 
 // ValidateTransaction validates the transaction data
 func (h *MedicalTransactionHandler) validateTransaction(tx MedicalTransaction) error {
 	if tx.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 	if tx.PatientID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "patient ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "patient ID is required")
 	}
 	if tx.ProviderID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "provider ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "provider ID is required")
 	}
 	if tx.TransactionType == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction type is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction type is required")
+	}
+	if tx.Purpose == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "purpose is required")
+	}
+	if !tx.ClaimAmount.IsNil() && tx.ClaimAmount.IsNegative() {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "claim amount cannot be negative")
 	}
+	if tx.claimed() && tx.TransactionType != Treatment {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "only treatments are claimed")
+	}
+
+	return nil
+}
 
+// validateAccess checks the provider and the parties the medical record is
+// shared with may access the transaction type data of the patient
+func (h *MedicalTransactionHandler) validateAccess(ctx sdk.Context, tx MedicalTransaction) error {
+	for _, party := range append([]string{tx.ProviderID}, tx.Consent...) {
+		if err := h.contract.ValidateDataAccess(ctx, party, tx.PatientID, string(tx.TransactionType), tx.Purpose); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// NotifyRelevantChains notifies other chains about the transaction
-func (h *MedicalTransactionHandler) notifyRelevantChains(ctx sdk.Context, tx MedicalTransaction) error {
-	txData, err := json.Marshal(tx)
+// NotifyRelevantChains notifies other chains about a version of the medical
+// record of a transaction. Prescriptions and lab tests are ordered from the
+// pharmacy and the laboratory, and treatments are shared with the insurance
+// chain as they are stored, sealed by their client.
+func (h *MedicalTransactionHandler) notifyRelevantChains(ctx sdk.Context, record contracts.MedicalRecord) error {
+	txData, err := json.Marshal(record)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal transaction data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal transaction data")
 	}
 
 	// Notify based on transaction type
-	switch tx.TransactionType {
+	switch TransactionType(record.RecordType) {
 	case Prescription:
-		if _, err := h.contract.PrepareInterchainMessage(ctx, "pharmacy", txData); err != nil {
+		if _, err := h.contract.PrepareInterchainMessage(ctx, contracts.ChainPharmacy, record.RecordType, txData); err != nil {
 			return err
 		}
 	case LabTest:
-		if _, err := h.contract.PrepareInterchainMessage(ctx, "laboratory", txData); err != nil {
+		if _, err := h.contract.PrepareInterchainMessage(ctx, contracts.ChainLaboratory, record.RecordType, txData); err != nil {
 			return err
 		}
 	case Treatment:
-		if _, err := h.contract.PrepareInterchainMessage(ctx, "insurance", txData); err != nil {
-			return err
-		}
+		return h.contract.ShareTreatmentRecord(ctx, txData)
 	}
 
 	return nil
 }
 
-// UpdateTransactionStatus updates the status of a medical transaction
-func (h *MedicalTransactionHandler) UpdateTransactionStatus(ctx sdk.Context, txID string, status string) error {
-	// Update transaction status and notify relevant chains
-	return nil
+// claimed reports whether an amount of the transaction is claimed
+func (tx MedicalTransaction) claimed() bool {
+	return !tx.ClaimAmount.IsNil() && tx.ClaimAmount.IsPositive()
 }
 
-// FinalizeTransaction completes the medical transaction process
-func (h *MedicalTransactionHandler) FinalizeTransaction(ctx sdk.Context, txID string) error {
-	// Finalize the transaction and update all relevant chains
-	return nil
+// claimTreatment claims amount for a treatment from the insurer of the
+// patient on the insurance chain
+func (h *MedicalTransactionHandler) claimTreatment(ctx sdk.Context, recordID string, amount sdkmath.Int) error {
+	claim, err := json.Marshal(contracts.TreatmentClaim{
+		ClaimID: recordID,
+		Amount:  amount,
+	})
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal treatment claim")
+	}
+	return h.contract.ClaimTreatment(ctx, claim)
+}
+
+// record returns the medical record shared with the other chains, readable by
+// the consenting parties
+func (tx MedicalTransaction) record() contracts.MedicalRecord {
+	return contracts.MedicalRecord{
+		RecordID:      tx.TransactionID,
+		PatientID:     tx.PatientID,
+		ProviderID:    tx.ProviderID,
+		RecordType:    string(tx.TransactionType),
+		Status:        tx.Status,
+		Data:          tx.Data,
+		Timestamp:     tx.Timestamp,
+		LastModified:  tx.Timestamp,
+		AccessControl: tx.Consent,
+	}
+}
+
+// UpdateTransactionStatus updates the status of a medical transaction with a
+// new version of its medical record, and notifies the relevant chains
+func (h *MedicalTransactionHandler) UpdateTransactionStatus(ctx sdk.Context, modifierID, txID, status string) error {
+	_, err := h.changeRecord(ctx, modifierID, txID, "status updated to "+status, func(record *contracts.MedicalRecord) {
+		record.Status = status
+	})
+	return err
+}
+
+// FinalizeTransaction completes the medical transaction process, and notifies
+// the relevant chains of the final version of its medical record
+func (h *MedicalTransactionHandler) FinalizeTransaction(ctx sdk.Context, modifierID, txID string) error {
+	_, err := h.changeRecord(ctx, modifierID, txID, "transaction finalized", func(record *contracts.MedicalRecord) {
+		record.Status = StatusFinalized
+	})
+	return err
+}
+
+// AmendRecord replaces the data of a medical record with a new version. The
+// amendment is made on the version with the previousHash data hash, which
+// must be the latest version of the record.
+func (h *MedicalTransactionHandler) AmendRecord(ctx sdk.Context, modifierID, recordID, previousHash, reason string, data []byte) (contracts.MedicalRecord, error) {
+	return h.changeRecord(ctx, modifierID, recordID, reason, func(record *contracts.MedicalRecord) {
+		record.PreviousHash = previousHash
+		record.Data = data
+	})
+}
+
+// changeRecord stores the change of the latest version of a medical record as
+// its next version, and notifies the relevant chains
+func (h *MedicalTransactionHandler) changeRecord(ctx sdk.Context, modifierID, recordID, reason string, change func(*contracts.MedicalRecord)) (contracts.MedicalRecord, error) {
+	record, found := h.contract.GetMedicalRecord(ctx, recordID)
+	if !found {
+		return record, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record %s does not exist", recordID)
+	}
+	record.PreviousHash = record.DataHash
+	change(&record)
+
+	record, err := h.contract.AmendMedicalRecord(ctx, modifierID, reason, record)
+	if err != nil {
+		return record, err
+	}
+	return record, h.notifyRelevantChains(ctx, record)
 }
@@ -0,0 +1,110 @@
+package contracts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// GetMedicalRecord returns the latest version of a medical record
+func (c *HealthcareContract) GetMedicalRecord(ctx sdk.Context, recordID string) (MedicalRecord, bool) {
+	var record MedicalRecord
+	data, found := c.records.GetLatestVersion(ctx, recordID)
+	if !found {
+		return record, false
+	}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return record, false
+	}
+	return record, true
+}
+
+// AmendMedicalRecord stores record as the next version of the medical record
+// with its ID, and logs the modification. The first version creates the
+// record. Later versions must carry the DataHash of the latest version in
+// PreviousHash, so that a change made on an outdated version never
+// overwrites the versions after it, and must keep the patient and type of
+// the record. The data of every version must be sealed by its client.
+func (c *HealthcareContract) AmendMedicalRecord(ctx sdk.Context, modifierID, reason string, record MedicalRecord) (MedicalRecord, error) {
+	if record.RecordID == "" || record.PatientID == "" || record.ProviderID == "" || record.RecordType == "" {
+		return record, errorsmod.Wrap(interchain.ErrInvalidData, "record ID, patient, provider and record type are required")
+	}
+	if err := c.validateSealedData(ctx, record); err != nil {
+		return record, err
+	}
+
+	latest, found := c.GetMedicalRecord(ctx, record.RecordID)
+	switch {
+	case !found && record.PreviousHash != "":
+		return record, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record %s does not exist", record.RecordID)
+	case !found:
+		record.Version = 1
+	case record.PreviousHash != latest.DataHash:
+		return record, errorsmod.Wrapf(interchain.ErrInvalidData, "medical record %s was changed since, its latest version is %d", record.RecordID, latest.Version)
+	case reason == "":
+		return record, errorsmod.Wrap(interchain.ErrInvalidData, "amendment reason is required")
+	case record.PatientID != latest.PatientID || record.RecordType != latest.RecordType:
+		return record, errorsmod.Wrap(interchain.ErrInvalidData, "the patient and type of a medical record cannot be amended")
+	default:
+		record.Version = latest.Version + 1
+		record.Timestamp = latest.Timestamp
+	}
+	record.LastModified = ctx.BlockTime()
+	record.DataHash = record.hash()
+
+	if err := c.auditor.LogModification(ctx, modifierID, record.PatientID, record.RecordType); err != nil {
+		return record, err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return record, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal medical record")
+	}
+	if err := c.records.AppendVersion(ctx, modifierID, reason, data); err != nil {
+		return record, err
+	}
+	return record, nil
+}
+
+// validateSealedData checks the data of a medical record was sealed by its
+// client to every address of its access control list, each of which
+// registered an encryption key
+func (c *HealthcareContract) validateSealedData(ctx sdk.Context, record MedicalRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal medical record")
+	}
+	if err := c.ValidateHIPAACompliance(ctx, data); err != nil {
+		return err
+	}
+	if len(record.Data) == 0 {
+		return nil
+	}
+	for _, recipient := range record.AccessControl {
+		if _, found := c.keys.GetEncryptionKey(ctx, recipient); !found {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "no encryption key registered by %s", recipient)
+		}
+	}
+	return nil
+}
+
+// hash returns the hex encoded SHA-256 hash of the content of a version of
+// the record, chained to the hash of the previous version
+func (r MedicalRecord) hash() string {
+	content, _ := json.Marshal(struct {
+		PreviousHash  string   `json:"previous_hash"`
+		RecordID      string   `json:"record_id"`
+		Version       uint64   `json:"version"`
+		PatientID     string   `json:"patient_id"`
+		ProviderID    string   `json:"provider_id"`
+		RecordType    string   `json:"record_type"`
+		Status        string   `json:"status"`
+		AccessControl []string `json:"access_control"`
+		Data          []byte   `json:"data"`
+	}{r.PreviousHash, r.RecordID, r.Version, r.PatientID, r.ProviderID, r.RecordType, r.Status, r.AccessControl, r.Data})
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
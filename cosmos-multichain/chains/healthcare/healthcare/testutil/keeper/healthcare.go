@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+	laboratorykeeper "healthcare/x/laboratory/keeper"
+	laboratorytypes "healthcare/x/laboratory/types"
+	pharmacykeeper "healthcare/x/pharmacy/keeper"
+	pharmacytypes "healthcare/x/pharmacy/types"
+)
+
+func HealthcareKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return HealthcareKeeperWithGroups(t, nil)
+}
+
+// HealthcareKeeperWithGroups returns a healthcare keeper resolving the
+// break-glass providers and reviewers with the given group keeper
+func HealthcareKeeperWithGroups(t testing.TB, groupKeeper types.GroupKeeper) (keeper.Keeper, sdk.Context) {
+	k, _, _, ctx := healthcareKeeperWithModules(t, groupKeeper)
+	return k, ctx
+}
+
+// HealthcareKeeperWithCounterparties returns a healthcare keeper sending
+// prescriptions to a pharmacy keeper and lab orders to a laboratory keeper
+func HealthcareKeeperWithCounterparties(t testing.TB) (keeper.Keeper, pharmacykeeper.Keeper, laboratorykeeper.Keeper, sdk.Context) {
+	return healthcareKeeperWithModules(t, nil)
+}
+
+func healthcareKeeperWithModules(t testing.TB, groupKeeper types.GroupKeeper) (keeper.Keeper, pharmacykeeper.Keeper, laboratorykeeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	pharmacyStoreKey := storetypes.NewKVStoreKey(pharmacytypes.StoreKey)
+	laboratoryStoreKey := storetypes.NewKVStoreKey(laboratorytypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(pharmacyStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(laboratoryStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	pharmacyKeeper := pharmacykeeper.NewKeeper(cdc, runtime.NewKVStoreService(pharmacyStoreKey), log.NewNopLogger(), authority.String())
+	laboratoryKeeper := laboratorykeeper.NewKeeper(cdc, runtime.NewKVStoreService(laboratoryStoreKey), log.NewNopLogger(), authority.String())
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		nil,
+		nil,
+		groupKeeper,
+		pharmacyKeeper,
+		laboratoryKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	if err := pharmacyKeeper.SetParams(ctx, pharmacytypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	if err := laboratoryKeeper.SetParams(ctx, laboratorytypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, pharmacyKeeper, laboratoryKeeper, ctx
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"healthcare/x/healthcare/types"
+)
+
+// GetBreakGlassAccessCount get the total number of breakGlassAccess
+func (k Keeper) GetBreakGlassAccessCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.BreakGlassAccessCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetBreakGlassAccessCount set the total number of breakGlassAccess
+func (k Keeper) SetBreakGlassAccessCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.BreakGlassAccessCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendBreakGlassAccess appends a breakGlassAccess with a new id and returns it
+func (k Keeper) AppendBreakGlassAccess(ctx context.Context, access types.BreakGlassAccess) uint64 {
+	count := k.GetBreakGlassAccessCount(ctx)
+	access.Id = count
+	k.SetBreakGlassAccess(ctx, access)
+	k.SetBreakGlassAccessCount(ctx, count+1)
+	return count
+}
+
+// SetBreakGlassAccess set a specific breakGlassAccess in the store, indexed
+// as the access of its provider to the records of its patient and, until it
+// is reviewed, as pending against its provider identity
+func (k Keeper) SetBreakGlassAccess(ctx context.Context, access types.BreakGlassAccess) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassAccessKeyPrefix))
+	b := k.cdc.MustMarshal(&access)
+	store.Set(types.BreakGlassAccessKey(access.Id), b)
+
+	active := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassActiveIndexPrefix))
+	activeKey := types.BreakGlassActiveKey(access.Patient, access.Provider)
+	if latest, found := k.getActiveBreakGlassAccess(ctx, access.Patient, access.Provider); !found || latest.ExpiresAt <= access.ExpiresAt {
+		active.Set(activeKey, types.BreakGlassAccessKey(access.Id))
+	}
+
+	pending := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassPendingIndexPrefix))
+	if access.IsPending() {
+		pending.Set(types.BreakGlassPendingKey(access.Identity(), access.Id), []byte{})
+	} else {
+		pending.Delete(types.BreakGlassPendingKey(access.Identity(), access.Id))
+	}
+}
+
+// GetBreakGlassAccess returns a breakGlassAccess from its id
+func (k Keeper) GetBreakGlassAccess(ctx context.Context, id uint64) (val types.BreakGlassAccess, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassAccessKeyPrefix))
+	b := store.Get(types.BreakGlassAccessKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllBreakGlassAccess returns all breakGlassAccess
+func (k Keeper) GetAllBreakGlassAccess(ctx context.Context) (list []types.BreakGlassAccess) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassAccessKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BreakGlassAccess
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getActiveBreakGlassAccess returns the latest break-glass access of provider
+// to the records of patient
+func (k Keeper) getActiveBreakGlassAccess(ctx context.Context, patient, provider string) (types.BreakGlassAccess, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	active := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassActiveIndexPrefix))
+	bz := active.Get(types.BreakGlassActiveKey(patient, provider))
+	if bz == nil {
+		return types.BreakGlassAccess{}, false
+	}
+	return k.GetBreakGlassAccess(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// HasBreakGlassAccess reports whether provider broke the glass to read the
+// dataType data of patient for purpose, and the access has not expired at
+// the block time
+func (k Keeper) HasBreakGlassAccess(ctx context.Context, patient, provider, dataType, purpose string) bool {
+	access, found := k.getActiveBreakGlassAccess(ctx, patient, provider)
+	return found && access.IsActive(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()) && access.Covers(dataType, purpose)
+}
+
+// CountUnreviewedBreakGlass returns the number of break-glass accesses of a
+// provider identity waiting for review
+func (k Keeper) CountUnreviewedBreakGlass(ctx context.Context, identity string) (count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pending := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BreakGlassPendingIndexPrefix))
+	iterator := storetypes.KVStorePrefixIterator(pending, types.BreakGlassProviderKey(identity))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return
+}
+
+// BreakGlassProviderIdentity returns the provider identity of addr, a member
+// of the group of the provider group policy: its metadata, or its address
+// when it has none. Only the members of the group break the glass.
+func (k Keeper) BreakGlassProviderIdentity(ctx context.Context, addr string) (string, error) {
+	policy := k.GetParams(ctx).BreakGlassProviderPolicy
+	if policy == "" {
+		return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, "no provider group may break the glass")
+	}
+	member, err := k.groupPolicyMember(ctx, policy, addr)
+	if err != nil {
+		return "", err
+	}
+	if member == nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a provider allowed to break the glass", addr)
+	}
+	if member.Metadata != "" {
+		return member.Metadata, nil
+	}
+	return member.Address, nil
+}
+
+// IsBreakGlassReviewer reports whether addr reviews the break-glass accesses:
+// the review group policy account, or a member of its group
+func (k Keeper) IsBreakGlassReviewer(ctx context.Context, addr string) (bool, error) {
+	policy := k.GetParams(ctx).BreakGlassReviewPolicy
+	if policy == "" {
+		return false, nil
+	}
+	if addr == policy {
+		return true, nil
+	}
+	member, err := k.groupPolicyMember(ctx, policy, addr)
+	return member != nil, err
+}
+
+// groupPolicyMember returns addr as a member of the group of a group policy,
+// and nil when it is not one
+func (k Keeper) groupPolicyMember(ctx context.Context, policy, addr string) (*group.Member, error) {
+	info, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: policy})
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGroupPolicy, "%s: %s", policy, err)
+	}
+
+	var nextKey []byte
+	for {
+		res, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    info.Info.GroupId,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidGroupPolicy, "%s: %s", policy, err)
+		}
+		for _, member := range res.Members {
+			if member.Member.Address == addr {
+				return member.Member, nil
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+// groupPolicy is a group policy account and the members of its group
+type groupPolicy struct {
+	address string
+	members []group.Member
+}
+
+// groupKeeper serves group policies, the group of each one numbered by its
+// position
+type groupKeeper []groupPolicy
+
+func (g groupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	for i, policy := range g {
+		if policy.address == req.Address {
+			return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: policy.address, GroupId: uint64(i + 1)}}, nil
+		}
+	}
+	return nil, sdkerrors.ErrNotFound
+}
+
+func (g groupKeeper) GroupMembers(_ context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	if req.GroupId == 0 || req.GroupId > uint64(len(g)) {
+		return nil, sdkerrors.ErrNotFound
+	}
+	res := &group.QueryGroupMembersResponse{}
+	for _, member := range g[req.GroupId-1].members {
+		res.Members = append(res.Members, &group.GroupMember{GroupId: req.GroupId, Member: &member})
+	}
+	return res, nil
+}
+
+// members returns group members without metadata
+func members(addrs ...string) []group.Member {
+	res := make([]group.Member, len(addrs))
+	for i, addr := range addrs {
+		res[i] = group.Member{Address: addr}
+	}
+	return res
+}
+
+// diagnosis is the scope of the break-glass accesses of the tests
+var diagnosis = []string{"DIAGNOSIS"}
+
+func TestBreakGlass(t *testing.T) {
+	providers, provider, colleague, patient := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k, ctx := keepertest.HealthcareKeeperWithGroups(t, groupKeeper{{address: providers, members: members(provider, colleague)}})
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+
+	require.Error(t, types.NewMsgBreakGlass(provider, patient, "", diagnosis).ValidateBasic())
+	require.Error(t, types.NewMsgBreakGlass(provider, provider, "cardiac arrest", diagnosis).ValidateBasic())
+	require.Error(t, types.NewMsgBreakGlass(provider, patient, "cardiac arrest", nil).ValidateBasic())
+	require.Error(t, types.NewMsgBreakGlass(provider, patient, "cardiac arrest", []string{""}).ValidateBasic())
+	require.ErrorIs(t, k.Contract().ValidateDataAccess(ctx, provider, patient, "DIAGNOSIS", treatment), types.ErrNoConsent)
+
+	// Nobody breaks the glass until governance configures the provider group
+	_, err := srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, patient, "cardiac arrest", diagnosis))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	params := k.GetParams(ctx)
+	params.BreakGlassProviderPolicy = providers
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(sample.AccAddress(), patient, "cardiac arrest", diagnosis))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, patient, "cardiac arrest", diagnosis))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBreakGlassDuration).Unix(), res.ExpiresAt)
+
+	// The provider reads the justified data for treatment until the access
+	// expires, and writes nothing without consent
+	canRead := func(ctx context.Context, accessor, dataType, purpose string) bool {
+		res, err := k.CheckAccess(ctx, &types.QueryCheckAccessRequest{Patient: patient, Accessor: accessor, DataType: dataType, Purpose: purpose})
+		require.NoError(t, err)
+		return res.Allowed
+	}
+	require.True(t, canRead(ctx, provider, "DIAGNOSIS", treatment))
+	require.False(t, canRead(ctx, provider, "LAB_TEST", treatment))
+	require.False(t, canRead(ctx, provider, "DIAGNOSIS", "research"))
+	require.False(t, canRead(ctx, sample.AccAddress(), "DIAGNOSIS", treatment))
+	require.False(t, canRead(ctx.WithBlockTime(time.Unix(res.ExpiresAt, 0)), provider, "DIAGNOSIS", treatment))
+	require.ErrorIs(t, k.Contract().ValidateDataAccess(ctx, provider, patient, "DIAGNOSIS", treatment), types.ErrNoConsent)
+
+	var severity string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBreakGlass {
+			attr, _ := event.GetAttribute(types.AttributeKeySeverity)
+			severity = attr.Value
+		}
+	}
+	require.Equal(t, types.SeverityHigh, severity)
+
+	notifications, err := k.Notifications(ctx, &types.QueryNotificationsRequest{Recipient: patient})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notification, 1)
+	require.Equal(t, types.NotificationKindBreakGlass, notifications.Notification[0].Kind)
+	require.Contains(t, notifications.Notification[0].Detail, "cardiac arrest")
+	var actions []string
+	for _, entry := range k.GetAuditTrail(ctx, patient) {
+		actions = append(actions, entry.Action)
+	}
+	require.Contains(t, actions, "break_glass")
+
+	// Unreviewed accesses count against the provider
+	for i := uint64(1); i < types.DefaultBreakGlassMaxUnreviewed; i++ {
+		_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, sample.AccAddress(), "trauma", diagnosis))
+		require.NoError(t, err)
+	}
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, sample.AccAddress(), "trauma", diagnosis))
+	require.ErrorIs(t, err, types.ErrTooManyUnreviewed)
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(colleague, patient, "trauma", diagnosis))
+	require.NoError(t, err)
+}
+
+func TestBreakGlassProviderIdentity(t *testing.T) {
+	providers, ward, clinic := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k, ctx := keepertest.HealthcareKeeperWithGroups(t, groupKeeper{{
+		address: providers,
+		members: []group.Member{{Address: ward, Metadata: "license-1"}, {Address: clinic, Metadata: "license-1"}},
+	}})
+	srv := keeper.NewMsgServerImpl(k)
+	params := types.DefaultParams()
+	params.BreakGlassProviderPolicy = providers
+	params.BreakGlassMaxUnreviewed = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// The accounts of a provider share its limit of unreviewed accesses
+	res, err := srv.BreakGlass(ctx, types.NewMsgBreakGlass(ward, sample.AccAddress(), "trauma", diagnosis))
+	require.NoError(t, err)
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(clinic, sample.AccAddress(), "trauma", diagnosis))
+	require.NoError(t, err)
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(sample.AccAddress(), sample.AccAddress(), "trauma", diagnosis))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(ward, sample.AccAddress(), "trauma", diagnosis))
+	require.ErrorIs(t, err, types.ErrTooManyUnreviewed)
+
+	access, found := k.GetBreakGlassAccess(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, ward, access.Provider)
+	require.Equal(t, "license-1", access.ProviderIdentity)
+	require.Equal(t, uint64(2), k.CountUnreviewedBreakGlass(ctx, "license-1"))
+	require.Zero(t, k.CountUnreviewedBreakGlass(ctx, ward))
+}
+
+func TestReviewBreakGlass(t *testing.T) {
+	policy, member, providers := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	provider, patient := sample.AccAddress(), sample.AccAddress()
+	k, ctx := keepertest.HealthcareKeeperWithGroups(t, groupKeeper{
+		{address: policy, members: members(member)},
+		{address: providers, members: members(provider)},
+	})
+	srv := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.BreakGlassProviderPolicy = providers
+	params.BreakGlassMaxUnreviewed = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, patient, "unconscious patient", diagnosis))
+	require.NoError(t, err)
+	_, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, patient, "unconscious patient", diagnosis))
+	require.ErrorIs(t, err, types.ErrTooManyUnreviewed)
+
+	// Nobody reviews until governance configures the review group
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(member, res.Id, true, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	params.BreakGlassReviewPolicy = policy
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(sample.AccAddress(), res.Id, true, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(member, res.Id+1, true, ""))
+	require.ErrorIs(t, err, types.ErrBreakGlassNotFound)
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(member, res.Id, false, "no emergency on record"))
+	require.NoError(t, err)
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(policy, res.Id, true, ""))
+	require.ErrorIs(t, err, types.ErrBreakGlassReviewed)
+
+	access, found := k.GetBreakGlassAccess(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, types.BreakGlassStatusRejected, access.Status)
+	require.Equal(t, member, access.Reviewer)
+	require.Zero(t, k.CountUnreviewedBreakGlass(ctx, provider))
+
+	// The review group may also review through a group proposal
+	res, err = srv.BreakGlass(ctx, types.NewMsgBreakGlass(provider, patient, "unconscious patient", diagnosis))
+	require.NoError(t, err)
+	_, err = srv.ReviewBreakGlass(ctx, types.NewMsgReviewBreakGlass(policy, res.Id, true, ""))
+	require.NoError(t, err)
+	access, _ = k.GetBreakGlassAccess(ctx, res.Id)
+	require.Equal(t, types.BreakGlassStatusApproved, access.Status)
+}
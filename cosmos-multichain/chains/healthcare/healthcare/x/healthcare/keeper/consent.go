@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+// SetConsent set a specific consent in the store from its index, queued for
+// removal at the end of its validity
+func (k Keeper) SetConsent(ctx context.Context, consent types.Consent) {
+	if previous, found := k.GetConsent(ctx, consent.Patient, consent.Grantee, consent.DataType, consent.Purpose); found {
+		k.RemoveConsent(ctx, previous)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentKeyPrefix))
+	key := types.ConsentKey(consent.Patient, consent.Grantee, consent.DataType, consent.Purpose)
+	b := k.cdc.MustMarshal(&consent)
+	store.Set(key, b)
+
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentExpiryQueuePrefix))
+	queue.Set(types.ConsentExpiryKey(consent), key)
+}
+
+// GetConsent returns a consent from its index
+func (k Keeper) GetConsent(
+	ctx context.Context,
+	patient string,
+	grantee string,
+	dataType string,
+	purpose string,
+) (val types.Consent, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentKeyPrefix))
+
+	b := store.Get(types.ConsentKey(
+		patient,
+		grantee,
+		dataType,
+		purpose,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveConsent removes a consent and its expiry queue entry from the store
+func (k Keeper) RemoveConsent(ctx context.Context, consent types.Consent) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentKeyPrefix))
+	store.Delete(types.ConsentKey(consent.Patient, consent.Grantee, consent.DataType, consent.Purpose))
+
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentExpiryQueuePrefix))
+	queue.Delete(types.ConsentExpiryKey(consent))
+}
+
+// GetAllConsent returns all consent
+func (k Keeper) GetAllConsent(ctx context.Context) (list []types.Consent) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Consent
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HasConsent reports whether accessor may access the dataType data of patient
+// for purpose at the block time. Patients may always access their own data.
+func (k Keeper) HasConsent(ctx context.Context, patient, accessor, dataType, purpose string) bool {
+	if accessor == patient {
+		return true
+	}
+	consent, found := k.GetConsent(ctx, patient, accessor, dataType, purpose)
+	return found && consent.IsActive(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+}
+
+// CanRead reports whether accessor may read the dataType data of patient for
+// purpose at the block time: with consent, or within the scope of a
+// break-glass access until it expires.
+func (k Keeper) CanRead(ctx context.Context, patient, accessor, dataType, purpose string) bool {
+	return k.HasConsent(ctx, patient, accessor, dataType, purpose) || k.HasBreakGlassAccess(ctx, patient, accessor, dataType, purpose)
+}
+
+// PruneExpiredConsents removes every consent whose validity ended at or
+// before now and returns the pruned consents
+func (k Keeper) PruneExpiredConsents(ctx context.Context, now int64) (pruned []types.Consent) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentKeyPrefix))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ConsentExpiryQueuePrefix))
+	iterator := queue.Iterator(nil, types.ConsentTimeKey(now+1))
+
+	for ; iterator.Valid(); iterator.Next() {
+		var consent types.Consent
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &consent)
+		pruned = append(pruned, consent)
+	}
+	iterator.Close()
+
+	for _, consent := range pruned {
+		k.RemoveConsent(ctx, consent)
+	}
+	return pruned
+}
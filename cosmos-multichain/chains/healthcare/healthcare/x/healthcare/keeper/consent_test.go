@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+func TestConsent(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, grantee := sample.AccAddress(), sample.AccAddress()
+	now := ctx.BlockTime().Unix()
+
+	require.Error(t, types.NewMsgGrantConsent(patient, grantee, "LAB_TEST", treatment, now, now, "").ValidateBasic())
+	require.Error(t, types.NewMsgGrantConsent(patient, grantee, "LAB_TEST", "", 0, now+10, "").ValidateBasic())
+
+	_, err := srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, grantee, "LAB_TEST", treatment, now+10, now+100, ""))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		at       int64
+		accessor string
+		dataType string
+		purpose  string
+		allowed  bool
+	}{
+		{desc: "before valid from", at: now, accessor: grantee, dataType: "LAB_TEST", purpose: treatment},
+		{desc: "valid", at: now + 10, accessor: grantee, dataType: "LAB_TEST", purpose: treatment, allowed: true},
+		{desc: "other data type", at: now + 10, accessor: grantee, dataType: "DIAGNOSIS", purpose: treatment},
+		{desc: "other purpose", at: now + 10, accessor: grantee, dataType: "LAB_TEST", purpose: "research"},
+		{desc: "other accessor", at: now + 10, accessor: sample.AccAddress(), dataType: "LAB_TEST", purpose: treatment},
+		{desc: "patient", at: now, accessor: patient, dataType: "DIAGNOSIS", purpose: "research", allowed: true},
+		{desc: "at valid until", at: now + 100, accessor: grantee, dataType: "LAB_TEST", purpose: treatment},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := k.CheckAccess(ctx.WithBlockTime(time.Unix(tc.at, 0)), &types.QueryCheckAccessRequest{
+				Patient:  patient,
+				Accessor: tc.accessor,
+				DataType: tc.dataType,
+				Purpose:  tc.purpose,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, res.Allowed)
+		})
+	}
+
+	// Granting again replaces the consent and its expiry
+	_, err = srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, grantee, "LAB_TEST", treatment, 0, now+200, ""))
+	require.NoError(t, err)
+	_, err = srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, grantee, "DIAGNOSIS", treatment, 0, now+50, ""))
+	require.NoError(t, err)
+	res, err := k.PatientConsents(ctx, &types.QueryPatientConsentsRequest{Patient: patient})
+	require.NoError(t, err)
+	require.Len(t, res.Consent, 2)
+
+	require.Empty(t, k.PruneExpiredConsents(ctx, now+49))
+	pruned := k.PruneExpiredConsents(ctx, now+100)
+	require.Len(t, pruned, 1)
+	require.Equal(t, "DIAGNOSIS", pruned[0].DataType)
+	require.True(t, k.HasConsent(ctx, patient, grantee, "LAB_TEST", treatment))
+
+	_, err = srv.RevokeConsent(ctx, types.NewMsgRevokeConsent(patient, grantee, "LAB_TEST", treatment))
+	require.NoError(t, err)
+	require.False(t, k.HasConsent(ctx, patient, grantee, "LAB_TEST", treatment))
+	require.Empty(t, k.PruneExpiredConsents(ctx, now+200))
+	_, err = srv.RevokeConsent(ctx, types.NewMsgRevokeConsent(patient, grantee, "LAB_TEST", treatment))
+	require.ErrorIs(t, err, types.ErrConsentNotFound)
+
+	var actions []string
+	for _, entry := range k.GetAuditTrail(ctx, patient) {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []string{"consent", "consent", "consent", "consent"}, actions)
+}
+
+func TestValidateDataAccess(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	patient, grantee := sample.AccAddress(), sample.AccAddress()
+	validUntil := ctx.BlockTime().Add(time.Hour).Unix()
+
+	err := k.Contract().ValidateDataAccess(ctx, grantee, patient, "LAB_TEST", treatment)
+	require.ErrorIs(t, err, types.ErrNoConsent)
+
+	_, err = srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, grantee, "LAB_TEST", treatment, 0, validUntil, ""))
+	require.NoError(t, err)
+	require.NoError(t, k.Contract().ValidateDataAccess(ctx, grantee, patient, "LAB_TEST", treatment))
+
+	var details []string
+	for _, entry := range k.GetAuditTrail(ctx, patient) {
+		if entry.Action == "access" {
+			details = append(details, entry.Detail)
+		}
+	}
+	require.Equal(t, []string{
+		"LAB_TEST by " + grantee + " for treatment granted=false",
+		"LAB_TEST by " + grantee + " for treatment granted=true",
+	}, details)
+}
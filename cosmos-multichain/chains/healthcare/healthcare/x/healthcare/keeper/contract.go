@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"healthcare/contracts"
+	"healthcare/x/healthcare/types"
+	laboratorytypes "healthcare/x/laboratory/types"
+	pharmacytypes "healthcare/x/pharmacy/types"
+)
+
+// newContract builds the healthcare contract on top of the module state
+func newContract(k Keeper) *contracts.HealthcareContract {
+	return contracts.NewHealthcareContract(healthcareValidator{k}, healthcareAudit{k}, encryptionKeyRegistry{k}, medicalRecordStore{k}, pharmacy{k}, laboratory{k}, insurer{k})
+}
+
+// healthcareValidator checks medical records, and the consents of the
+// patients against the consent registry
+type healthcareValidator struct {
+	k Keeper
+}
+
+func (healthcareValidator) ValidateData(data []byte) error {
+	var record contracts.MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	if record.RecordID == "" || record.PatientID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "record and patient IDs are required")
+	}
+	return nil
+}
+
+func (healthcareValidator) ValidateAuthorization(requesterID string, patientID string, dataType string) error {
+	if requesterID == "" || patientID == "" || dataType == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "requester, patient and data type are required")
+	}
+	return nil
+}
+
+func (v healthcareValidator) ValidateConsent(ctx sdk.Context, patientID, dataType, recipientID, purpose string) error {
+	if patientID == "" || dataType == "" || recipientID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "patient, data type and recipient are required")
+	}
+	if !v.k.HasConsent(ctx, patientID, recipientID, dataType, purpose) {
+		return errorsmod.Wrapf(types.ErrNoConsent, "%s may not access %s of %s for %q", recipientID, dataType, patientID, purpose)
+	}
+	return nil
+}
+
+func (healthcareValidator) ValidatePrivacy(data []byte) error {
+	var record contracts.MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	if len(record.Data) == 0 {
+		return nil
+	}
+	var sealed contracts.SealedData
+	if err := json.Unmarshal(record.Data, &sealed); err != nil || sealed.EncryptionType != contracts.EncryptionTypeEnvelope || len(sealed.Ciphertext) == 0 {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "medical data must be sealed by the client")
+	}
+	for _, recipient := range record.AccessControl {
+		if !slices.ContainsFunc(sealed.KeyWraps, func(wrap contracts.KeyWrap) bool { return wrap.Recipient == recipient }) {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "medical data is not sealed to %s", recipient)
+		}
+	}
+	return nil
+}
+
+// healthcareAudit appends the contract audit events to the audit trail of the patient
+type healthcareAudit struct {
+	k Keeper
+}
+
+func (a healthcareAudit) LogAccess(ctx sdk.Context, accessorID, patientID, dataType, purpose string, granted bool) error {
+	subject := patientID
+	if subject == "" {
+		subject = accessorID
+	}
+	detail := fmt.Sprintf("%s by %s", dataType, accessorID)
+	if purpose != "" {
+		detail += fmt.Sprintf(" for %s", purpose)
+	}
+	return a.k.AppendAuditEntry(ctx, subject, "access", fmt.Sprintf("%s granted=%t", detail, granted))
+}
+
+func (a healthcareAudit) LogModification(ctx sdk.Context, modifierID string, patientID string, dataType string) error {
+	return a.k.AppendAuditEntry(ctx, patientID, "modification", fmt.Sprintf("%s by %s", dataType, modifierID))
+}
+
+func (a healthcareAudit) LogConsent(ctx sdk.Context, patientID string, consentType string, granted bool) error {
+	return a.k.AppendAuditEntry(ctx, patientID, "consent", fmt.Sprintf("%s granted=%t", consentType, granted))
+}
+
+func (a healthcareAudit) GetAuditTrail(ctx sdk.Context, patientID string, dataType string) ([]byte, error) {
+	trail := []types.AuditEntry{}
+	for _, entry := range a.k.GetAuditTrail(ctx, patientID) {
+		if strings.HasPrefix(entry.Detail, dataType+" ") {
+			trail = append(trail, entry)
+		}
+	}
+	return json.Marshal(trail)
+}
+
+// encryptionKeyRegistry resolves the recipients of medical records to the
+// encryption keys they registered
+type encryptionKeyRegistry struct {
+	k Keeper
+}
+
+func (r encryptionKeyRegistry) GetEncryptionKey(ctx sdk.Context, address string) ([]byte, bool) {
+	key, found := r.k.GetEncryptionKey(ctx, address)
+	return key.PublicKey, found
+}
+
+// medicalRecordStore keeps the versions of the medical records in the module state
+type medicalRecordStore struct {
+	k Keeper
+}
+
+func (s medicalRecordStore) GetLatestVersion(ctx sdk.Context, recordID string) ([]byte, bool) {
+	version, found := s.k.GetLatestRecordVersion(ctx, recordID)
+	if !found {
+		return nil, false
+	}
+	data, err := json.Marshal(contracts.MedicalRecord{
+		RecordID:      version.RecordId,
+		PatientID:     version.Patient,
+		ProviderID:    version.Provider,
+		RecordType:    version.RecordType,
+		Data:          version.Data,
+		Status:        version.Status,
+		Timestamp:     time.Unix(version.CreatedAt, 0).UTC(),
+		LastModified:  time.Unix(version.ModifiedAt, 0).UTC(),
+		AccessControl: version.AccessControl,
+		DataHash:      version.DataHash,
+		Version:       version.Version,
+		PreviousHash:  version.PreviousHash,
+	})
+	return data, err == nil
+}
+
+func (s medicalRecordStore) AppendVersion(ctx sdk.Context, modifierID, reason string, data []byte) error {
+	var record contracts.MedicalRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid medical record format")
+	}
+	s.k.SetRecordVersion(ctx, types.RecordVersion{
+		RecordId:      record.RecordID,
+		Version:       record.Version,
+		Patient:       record.PatientID,
+		Provider:      record.ProviderID,
+		RecordType:    record.RecordType,
+		Status:        record.Status,
+		Data:          record.Data,
+		AccessControl: record.AccessControl,
+		DataHash:      record.DataHash,
+		PreviousHash:  record.PreviousHash,
+		Modifier:      modifierID,
+		Reason:        reason,
+		CreatedAt:     record.Timestamp.Unix(),
+		ModifiedAt:    record.LastModified.Unix(),
+	})
+	return nil
+}
+
+func (s medicalRecordStore) FindRecords(ctx sdk.Context, patientID string, dataHashes []string) (records [][]byte) {
+	found := make(map[string]bool)
+	for _, version := range s.k.GetAllRecordVersion(ctx) {
+		if found[version.RecordId] || version.Patient != patientID {
+			continue
+		}
+		if len(dataHashes) > 0 && !slices.Contains(dataHashes, version.DataHash) {
+			continue
+		}
+		found[version.RecordId] = true
+		if record, ok := s.GetLatestVersion(ctx, version.RecordId); ok {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// pharmacy sends e-prescriptions to the pharmacy module
+type pharmacy struct {
+	k Keeper
+}
+
+func (p pharmacy) SubmitPrescription(ctx sdk.Context, prescription []byte) error {
+	var e contracts.EPrescription
+	if err := json.Unmarshal(prescription, &e); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid e-prescription format")
+	}
+	return p.k.pharmacyKeeper.SubmitPrescription(ctx, pharmacytypes.Prescription{
+		PrescriptionId: e.PrescriptionID,
+		Patient:        e.PatientID,
+		Prescriber:     e.PrescriberID,
+		DataHash:       e.DataHash,
+	})
+}
+
+// laboratory sends lab orders to the laboratory module
+type laboratory struct {
+	k Keeper
+}
+
+func (l laboratory) SubmitLabOrder(ctx sdk.Context, order []byte) error {
+	var o contracts.LabOrder
+	if err := json.Unmarshal(order, &o); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid lab order format")
+	}
+	return l.k.laboratoryKeeper.SubmitLabOrder(ctx, laboratorytypes.LabOrder{
+		OrderId:  o.OrderID,
+		Patient:  o.PatientID,
+		Orderer:  o.OrdererID,
+		DataHash: o.DataHash,
+	})
+}
+
+// RecordKindInsuranceClaim is the kind of the contract records keeping the
+// treatment claims submitted to the insurance chain
+const RecordKindInsuranceClaim = "insurance_claim"
+
+// insurer keeps the treatment claims for the insurance chain in contract
+// records, and sends them to the insurance chain to be claimed from the
+// insurer of the module params, with the treatment records they claim
+type insurer struct {
+	k Keeper
+}
+
+func (i insurer) Address(ctx sdk.Context) string {
+	return i.k.GetParams(ctx).Insurer
+}
+
+func (i insurer) SubmitClaim(ctx sdk.Context, claim []byte) error {
+	var c contracts.TreatmentClaim
+	if err := json.Unmarshal(claim, &c); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid treatment claim format")
+	}
+	if _, found := i.k.GetContractRecord(ctx, RecordKindInsuranceClaim, c.ClaimID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "treatment %s is already claimed", c.ClaimID)
+	}
+	i.k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:      RecordKindInsuranceClaim,
+		RecordId:  c.ClaimID,
+		Data:      claim,
+		UpdatedAt: ctx.BlockTime().Unix(),
+	})
+	if err := i.k.QueuePacket(ctx, interchain.ChainInsurance, interchain.NewMessagePacket(contracts.MessageTypeTreatmentClaim, claim)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTreatmentClaimed,
+			sdk.NewAttribute(types.AttributeKeyRecordId, c.ClaimID),
+			sdk.NewAttribute(types.AttributeKeyPatient, c.PatientID),
+			sdk.NewAttribute(types.AttributeKeyProvider, c.ProviderID),
+			sdk.NewAttribute(types.AttributeKeyAmount, c.Amount.String()),
+		),
+	)
+	return nil
+}
+
+func (i insurer) ShareRecord(ctx sdk.Context, record []byte) error {
+	return i.k.QueuePacket(ctx, interchain.ChainInsurance, interchain.NewMessagePacket(contracts.MessageTypeTreatmentRecord, record))
+}
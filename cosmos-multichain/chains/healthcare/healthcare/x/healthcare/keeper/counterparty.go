@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/contracts"
+	"healthcare/x/healthcare/types"
+)
+
+// DeliverCallbacks hands the callbacks queued by the pharmacy and laboratory
+// modules to the healthcare contract. Each callback is delivered once: the
+// changes of a failed callback are discarded and the failure is reported in
+// an event.
+func (k Keeper) DeliverCallbacks(ctx sdk.Context) {
+	for _, cb := range k.pharmacyKeeper.GetAllCallback(ctx) {
+		k.deliverCallback(ctx, contracts.ChainPharmacy, cb.MessageType, cb.Data)
+		k.pharmacyKeeper.RemoveCallback(ctx, cb.Id)
+	}
+	for _, cb := range k.laboratoryKeeper.GetAllCallback(ctx) {
+		k.deliverCallback(ctx, contracts.ChainLaboratory, cb.MessageType, cb.Data)
+		k.laboratoryKeeper.RemoveCallback(ctx, cb.Id)
+	}
+}
+
+func (k Keeper) deliverCallback(ctx sdk.Context, sourceChain, messageType string, data []byte) {
+	cacheCtx, write := ctx.CacheContext()
+	err := k.contract.HandleCallback(cacheCtx, sourceChain, messageType, data)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySourceChain, sourceChain),
+		sdk.NewAttribute(types.AttributeKeyMessageType, messageType),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		k.Logger().Error("failed to deliver callback", "source_chain", sourceChain, "message_type", messageType, "error", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	} else {
+		write()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallbackDelivered, attributes...))
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"crypto/ecdh"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"healthcare/contracts"
+	"healthcare/contracts/transactions"
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+	laboratorytypes "healthcare/x/laboratory/types"
+	pharmacytypes "healthcare/x/pharmacy/types"
+)
+
+func TestPrescriptionDispensing(t *testing.T) {
+	k, pharmacyKeeper, _, ctx := keepertest.HealthcareKeeperWithCounterparties(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, pharmacist := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.Prescription)
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "rx-1", transactions.Prescription, patient)))
+	require.NoError(t, err)
+	first, _ := k.GetLatestRecordVersion(ctx, "rx-1")
+	prescription, found := pharmacyKeeper.GetPrescription(ctx, "rx-1")
+	require.True(t, found)
+	require.Equal(t, patient, prescription.Patient)
+	require.Equal(t, provider, prescription.Prescriber)
+	require.Equal(t, first.DataHash, prescription.DataHash)
+
+	// Later versions of the record are not prescribed again
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(provider, "rx-1", "REVIEWED"))
+	require.NoError(t, err)
+	require.Len(t, pharmacyKeeper.GetAllPrescription(ctx), 1)
+
+	require.NoError(t, pharmacyKeeper.Dispense(ctx, pharmacist, "rx-1"))
+	require.ErrorIs(t, pharmacyKeeper.Dispense(ctx, pharmacist, "rx-1"), pharmacytypes.ErrAlreadyDispensed)
+	k.DeliverCallbacks(ctx)
+	require.Empty(t, pharmacyKeeper.GetAllCallback(ctx))
+
+	latest, _ := k.GetLatestRecordVersion(ctx, "rx-1")
+	require.Equal(t, uint64(3), latest.Version)
+	require.Equal(t, contracts.StatusDispensed, latest.Status)
+	require.Equal(t, pharmacist, latest.Modifier)
+
+	// A replayed dispensing is rejected without changing the record
+	data, err := json.Marshal(contracts.Dispensing{PrescriptionID: "rx-1", Pharmacist: pharmacist})
+	require.NoError(t, err)
+	require.Error(t, k.Contract().HandleCallback(ctx, contracts.ChainPharmacy, contracts.MessageTypeDispensed, data))
+	latest, _ = k.GetLatestRecordVersion(ctx, "rx-1")
+	require.Equal(t, uint64(3), latest.Version)
+}
+
+func TestLabResult(t *testing.T) {
+	k, _, laboratoryKeeper, ctx := keepertest.HealthcareKeeperWithCounterparties(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, lab := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.LabTest)
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "lab-1", transactions.LabTest, patient)))
+	require.NoError(t, err)
+	order, found := laboratoryKeeper.GetLabOrder(ctx, "lab-1")
+	require.True(t, found)
+	require.Equal(t, laboratorytypes.LabOrderStatusOrdered, order.Status)
+
+	// The result is sealed by the laboratory
+	negative := sealData(t, k, "lab-1", []byte("negative"), map[string]*ecdh.PrivateKey{provider: newEncryptionKey(t)})
+	require.NoError(t, laboratoryKeeper.RecordResult(ctx, lab, "lab-1", negative))
+	k.DeliverCallbacks(ctx)
+	require.Empty(t, laboratoryKeeper.GetAllCallback(ctx))
+
+	latest, _ := k.GetLatestRecordVersion(ctx, "lab-1")
+	require.Equal(t, uint64(2), latest.Version)
+	require.Equal(t, contracts.StatusResulted, latest.Status)
+	require.Equal(t, negative, latest.Data)
+	require.Equal(t, lab, latest.Modifier)
+}
+
+func TestDeliverFailedCallback(t *testing.T) {
+	k, pharmacyKeeper, _, ctx := keepertest.HealthcareKeeperWithCounterparties(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	pharmacist := sample.AccAddress()
+
+	// The prescription was never issued by the healthcare contract
+	require.NoError(t, pharmacyKeeper.SubmitPrescription(ctx, pharmacytypes.Prescription{
+		PrescriptionId: "rx-unknown",
+		Patient:        sample.AccAddress(),
+		Prescriber:     sample.AccAddress(),
+	}))
+	require.NoError(t, pharmacyKeeper.Dispense(ctx, pharmacist, "rx-unknown"))
+
+	k.DeliverCallbacks(ctx)
+	require.Empty(t, pharmacyKeeper.GetAllCallback(ctx))
+	_, found := k.GetLatestRecordVersion(ctx, "rx-unknown")
+	require.False(t, found)
+	require.Empty(t, k.GetAuditTrail(ctx, contracts.ChainPharmacy))
+
+	var delivered bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCallbackDelivered {
+			delivered = true
+			success, _ := event.GetAttribute(types.AttributeKeySuccess)
+			require.Equal(t, "false", success.Value)
+		}
+	}
+	require.True(t, delivered)
+}
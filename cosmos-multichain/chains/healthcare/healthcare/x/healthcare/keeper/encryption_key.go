@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"healthcare/x/healthcare/types"
+)
+
+// SetEncryptionKey set a specific encryptionKey in the store from its index
+func (k Keeper) SetEncryptionKey(ctx context.Context, encryptionKey types.EncryptionKey) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptionKeyKeyPrefix))
+	b := k.cdc.MustMarshal(&encryptionKey)
+	store.Set(types.EncryptionKeyKey(
+		encryptionKey.Address,
+	), b)
+}
+
+// GetEncryptionKey returns a encryptionKey from its index
+func (k Keeper) GetEncryptionKey(
+	ctx context.Context,
+	address string,
+) (val types.EncryptionKey, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptionKeyKeyPrefix))
+
+	b := store.Get(types.EncryptionKeyKey(
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllEncryptionKey returns all encryptionKey
+func (k Keeper) GetAllEncryptionKey(ctx context.Context) (list []types.EncryptionKey) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EncryptionKeyKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EncryptionKey
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/contracts"
+	"healthcare/contracts/transactions"
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+func newEncryptionKey(t *testing.T) *ecdh.PrivateKey {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func TestRegisterEncryptionKey(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	provider := sample.AccAddress()
+
+	require.Error(t, types.NewMsgRegisterEncryptionKey(provider, []byte("short")).ValidateBasic())
+
+	first, second := newEncryptionKey(t).PublicKey().Bytes(), newEncryptionKey(t).PublicKey().Bytes()
+	for _, publicKey := range [][]byte{first, second} {
+		msg := types.NewMsgRegisterEncryptionKey(provider, publicKey)
+		require.NoError(t, msg.ValidateBasic())
+		_, err := srv.RegisterEncryptionKey(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	res, err := k.EncryptionKey(ctx, &types.QueryGetEncryptionKeyRequest{Address: provider})
+	require.NoError(t, err)
+	require.Equal(t, second, res.EncryptionKey.PublicKey)
+	require.Len(t, k.GetAllEncryptionKey(ctx), 1)
+}
+
+// sealData seals data for a medical record to the recipients, as their
+// client does before submitting it
+func sealData(t *testing.T, k keeper.Keeper, recordID string, data []byte, recipients map[string]*ecdh.PrivateKey) []byte {
+	record := contracts.MedicalRecord{RecordID: recordID, Data: data}
+	publicKeys := map[string][]byte{}
+	for recipient, key := range recipients {
+		record.AccessControl = append(record.AccessControl, recipient)
+		publicKeys[recipient] = key.PublicKey().Bytes()
+	}
+	plain, err := json.Marshal(record)
+	require.NoError(t, err)
+	sealed, err := k.Contract().EncryptSensitiveData(plain, publicKeys)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(sealed, &record))
+	return record.Data
+}
+
+func TestEncryptSensitiveData(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	provider, insurer, other := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	keys := map[string]*ecdh.PrivateKey{}
+	publicKeys := map[string][]byte{}
+	for _, recipient := range []string{provider, insurer, other} {
+		keys[recipient] = newEncryptionKey(t)
+		publicKeys[recipient] = keys[recipient].PublicKey().Bytes()
+	}
+
+	plaintext := []byte(`{"diagnosis":"J45"}`)
+	data, err := json.Marshal(contracts.MedicalRecord{
+		RecordID:      "record-1",
+		PatientID:     "patient-1",
+		ProviderID:    provider,
+		Data:          plaintext,
+		AccessControl: []string{provider, insurer},
+	})
+	require.NoError(t, err)
+
+	sealed, err := k.Contract().EncryptSensitiveData(data, publicKeys)
+	require.NoError(t, err)
+	require.NoError(t, k.Contract().ValidateHIPAACompliance(ctx, sealed))
+
+	var record contracts.MedicalRecord
+	require.NoError(t, json.Unmarshal(sealed, &record))
+	var sealedData contracts.SealedData
+	require.NoError(t, json.Unmarshal(record.Data, &sealedData))
+	require.Equal(t, contracts.EncryptionTypeEnvelope, sealedData.EncryptionType)
+	require.NotContains(t, string(record.Data), "J45")
+	require.Len(t, sealedData.KeyWraps, 2)
+
+	for _, recipient := range []string{provider, insurer} {
+		opened, err := k.Contract().DecryptSensitiveData(sealed, recipient, keys[recipient].Bytes())
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(opened, &record))
+		require.Equal(t, plaintext, record.Data)
+	}
+
+	// Only the recipients can decrypt, with their own key
+	_, err = k.Contract().DecryptSensitiveData(sealed, other, keys[other].Bytes())
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	_, err = k.Contract().DecryptSensitiveData(sealed, insurer, keys[other].Bytes())
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	// The data is bound to the record it was encrypted for
+	require.NoError(t, json.Unmarshal(sealed, &record))
+	record.RecordID = "record-2"
+	tampered, err := json.Marshal(record)
+	require.NoError(t, err)
+	_, err = k.Contract().DecryptSensitiveData(tampered, insurer, keys[insurer].Bytes())
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	// Every recipient must have a key
+	data, err = json.Marshal(contracts.MedicalRecord{
+		RecordID:      "record-3",
+		Data:          plaintext,
+		AccessControl: []string{insurer, sample.AccAddress()},
+	})
+	require.NoError(t, err)
+	_, err = k.Contract().EncryptSensitiveData(data, publicKeys)
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+}
+
+func TestSealedMedicalData(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, insurer := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.Treatment)
+	_, err := srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, insurer, string(transactions.Treatment), treatment, 0, ctx.BlockTime().Add(time.Hour).Unix(), ""))
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	params.Insurer = insurer
+	require.NoError(t, k.SetParams(ctx, params))
+
+	keys := map[string]*ecdh.PrivateKey{insurer: newEncryptionKey(t)}
+	submit := func(id string, data []byte) error {
+		tx, err := json.Marshal(transactions.MedicalTransaction{
+			TransactionID:   id,
+			PatientID:       patient,
+			ProviderID:      provider,
+			TransactionType: transactions.Treatment,
+			Purpose:         treatment,
+			Data:            data,
+			Consent:         []string{insurer},
+		})
+		require.NoError(t, err)
+		_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, tx))
+		return err
+	}
+
+	// The chain only accepts data sealed by the client to every recipient,
+	// each of which registered a key
+	require.ErrorIs(t, submit("tx-1", []byte("asthma")), interchain.ErrInvalidData)
+	require.ErrorIs(t, submit("tx-1", sealData(t, k, "tx-1", []byte("asthma"), map[string]*ecdh.PrivateKey{provider: newEncryptionKey(t)})), interchain.ErrInvalidData)
+	sealed := sealData(t, k, "tx-1", []byte("asthma"), keys)
+	require.ErrorIs(t, submit("tx-1", sealed), interchain.ErrInvalidData)
+	_, err = srv.RegisterEncryptionKey(ctx, types.NewMsgRegisterEncryptionKey(insurer, keys[insurer].PublicKey().Bytes()))
+	require.NoError(t, err)
+	require.NoError(t, submit("tx-1", sealed))
+
+	// The treatment record is shared with the insurance chain as it is stored
+	stored, _ := k.GetLatestRecordVersion(ctx, "tx-1")
+	require.Equal(t, sealed, stored.Data)
+	queued := k.GetQueuedPackets(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, interchain.ChainInsurance, queued[0].Chain)
+	require.Equal(t, contracts.MessageTypeTreatmentRecord, queued[0].Packet.MessageType)
+	opened, err := k.Contract().DecryptSensitiveData(queued[0].Packet.Payload, insurer, keys[insurer].Bytes())
+	require.NoError(t, err)
+	var record contracts.MedicalRecord
+	require.NoError(t, json.Unmarshal(opened, &record))
+	require.Equal(t, []byte("asthma"), record.Data)
+	require.Equal(t, stored.DataHash, record.DataHash)
+}
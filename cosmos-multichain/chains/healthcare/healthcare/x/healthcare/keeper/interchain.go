@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"healthcare/contracts"
+)
+
+var _ interchain.PacketHandler = Keeper{}
+
+// counterpartyChain resolves a counterparty chain ID from the params
+func (k Keeper) counterpartyChain(ctx sdk.Context, chainId string) (string, bool) {
+	return k.GetParams(ctx).CounterpartyChain(chainId)
+}
+
+// OnRecvMessage processes a message of a counterparty chain with the
+// healthcare contract. The corroboration queries of the insurance chain are
+// answered with the records corroborating their claim.
+func (k Keeper) OnRecvMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) ([]byte, error) {
+	if sourceChain == interchain.ChainInsurance && messageType == contracts.MessageTypeClaimCorroboration {
+		return k.contract.CorroborateClaim(ctx, message)
+	}
+	return k.ContractHandler.OnRecvMessage(ctx, sourceChain, messageType, message)
+}
+
+// OnAcknowledgement hands the response to a message to the healthcare
+// contract. A treatment claim the insurance chain rejected is denied with
+// the rejection as its reason.
+func (k Keeper) OnAcknowledgement(ctx sdk.Context, targetChain string, packet interchain.PacketData, response []byte, ackErr error) error {
+	if ackErr != nil && packet.Kind == interchain.PacketKindMessage && packet.MessageType == contracts.MessageTypeTreatmentClaim {
+		return k.denyTreatmentClaim(ctx, targetChain, packet.Payload, ackErr)
+	}
+	return k.ContractHandler.OnAcknowledgement(ctx, targetChain, packet, response, ackErr)
+}
+
+// denyTreatmentClaim records the rejection of a treatment claim by the
+// insurance chain as its denial
+func (k Keeper) denyTreatmentClaim(ctx sdk.Context, sourceChain string, data []byte, ackErr error) error {
+	var claim contracts.TreatmentClaim
+	if err := json.Unmarshal(data, &claim); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid treatment claim format")
+	}
+	decision, err := json.Marshal(contracts.ClaimDecision{
+		ClaimID: claim.ClaimID,
+		Status:  contracts.ClaimDecisionDenied,
+		Reason:  ackErr.Error(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal claim decision")
+	}
+	return k.contract.HandleCallback(ctx, sourceChain, contracts.MessageTypeTreatmentClaim, decision)
+}
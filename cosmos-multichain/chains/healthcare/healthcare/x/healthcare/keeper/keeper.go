@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/example/cosmos-multichain/audit"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"healthcare/contracts"
+	"healthcare/contracts/transactions"
+	"healthcare/x/healthcare/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		audit audit.Keeper[types.AuditEntry, *types.AuditEntry]
+
+		groupKeeper      types.GroupKeeper
+		pharmacyKeeper   types.PharmacyKeeper
+		laboratoryKeeper types.LaboratoryKeeper
+
+		port interchain.Port
+		interchain.PacketQueue[types.ContractRecord, *types.ContractRecord]
+		interchain.ContractHandler
+
+		contract     *contracts.HealthcareContract
+		transactions *transactions.MedicalTransactionHandler
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
+	groupKeeper types.GroupKeeper,
+	pharmacyKeeper types.PharmacyKeeper,
+	laboratoryKeeper types.LaboratoryKeeper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		authority:        authority,
+		logger:           logger,
+		audit:            audit.NewKeeper[types.AuditEntry](cdc, storeService, types.NewAuditEntry),
+		groupKeeper:      groupKeeper,
+		pharmacyKeeper:   pharmacyKeeper,
+		laboratoryKeeper: laboratoryKeeper,
+	}
+	k.port = interchain.NewPort(types.ModuleName, ibcKeeperFn, capabilityScopedFn, k.counterpartyChain)
+	k.PacketQueue = interchain.NewPacketQueue[types.ContractRecord](cdc, storeService, types.KeyPrefix(types.ContractRecordKeyPrefix), types.NewPacketRecord, k.port, k.Logger())
+	k.contract = newContract(k)
+	k.ContractHandler = interchain.NewContractHandler(k.contract, k.PacketQueue)
+	k.transactions = transactions.NewMedicalTransactionHandler(k.contract)
+	return k
+}
+
+// Contract returns the healthcare contract backed by the module state.
+func (k Keeper) Contract() *contracts.HealthcareContract {
+	return k.contract
+}
+
+// Port returns the interchain port of the module.
+func (k Keeper) Port() interchain.Port {
+	return k.port
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
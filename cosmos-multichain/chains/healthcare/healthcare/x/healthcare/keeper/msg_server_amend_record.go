@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) AmendRecord(goCtx context.Context, msg *types.MsgAmendRecord) (*types.MsgAmendRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	latest, found := k.GetLatestRecordVersion(ctx, msg.RecordId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrMedicalRecordNotFound, msg.RecordId)
+	}
+	if latest.Provider != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the provider of the record can amend it")
+	}
+	if latest.DataHash != msg.PreviousHash {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmendment, "version %d with hash %s is the latest version of the record", latest.Version, latest.DataHash)
+	}
+
+	record, err := k.transactions.AmendRecord(ctx, msg.Creator, msg.RecordId, msg.PreviousHash, msg.Reason, msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// The patient learns of every amendment of their records
+	k.Notify(ctx, record.PatientID, types.NotificationKindRecordAmended,
+		fmt.Sprintf("%s %s amended to version %d by %s: %s", record.RecordType, record.RecordID, record.Version, msg.Creator, msg.Reason))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRecordAmended,
+			sdk.NewAttribute(types.AttributeKeyRecordId, record.RecordID),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(record.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyDataHash, record.DataHash),
+			sdk.NewAttribute(types.AttributeKeyPreviousHash, record.PreviousHash),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgAmendRecordResponse{Version: record.Version, DataHash: record.DataHash}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) BreakGlass(goCtx context.Context, msg *types.MsgBreakGlass) (*types.MsgBreakGlassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	identity, err := k.BreakGlassProviderIdentity(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	params := k.GetParams(ctx)
+	if unreviewed := k.CountUnreviewedBreakGlass(ctx, identity); unreviewed >= params.BreakGlassMaxUnreviewed {
+		return nil, errorsmod.Wrapf(types.ErrTooManyUnreviewed, "%s has %d break-glass accesses waiting for review", identity, unreviewed)
+	}
+
+	now := ctx.BlockTime()
+	access := types.BreakGlassAccess{
+		Provider:         msg.Creator,
+		Patient:          msg.Patient,
+		Justification:    msg.Justification,
+		GrantedAt:        now.Unix(),
+		ExpiresAt:        now.Add(params.BreakGlassDuration).Unix(),
+		Status:           types.BreakGlassStatusPending,
+		ProviderIdentity: identity,
+		DataTypes:        msg.DataTypes,
+	}
+	access.Id = k.AppendBreakGlassAccess(ctx, access)
+
+	dataTypes := strings.Join(msg.DataTypes, ",")
+	detail := fmt.Sprintf("break-glass access %d by %s to %s until %d: %s", access.Id, msg.Creator, dataTypes, access.ExpiresAt, msg.Justification)
+	if err := k.AppendAuditEntry(ctx, msg.Patient, "break_glass", detail); err != nil {
+		return nil, err
+	}
+	k.Notify(ctx, msg.Patient, types.NotificationKindBreakGlass, detail)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBreakGlass,
+			sdk.NewAttribute(types.AttributeKeySeverity, types.SeverityHigh),
+			sdk.NewAttribute(types.AttributeKeyBreakGlassId, strconv.FormatUint(access.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyProvider, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPatient, msg.Patient),
+			sdk.NewAttribute(types.AttributeKeyJustification, msg.Justification),
+			sdk.NewAttribute(types.AttributeKeyDataTypes, dataTypes),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatInt(access.ExpiresAt, 10)),
+		),
+	)
+
+	return &types.MsgBreakGlassResponse{Id: access.Id, ExpiresAt: access.ExpiresAt}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) GrantConsent(goCtx context.Context, msg *types.MsgGrantConsent) (*types.MsgGrantConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	now := ctx.BlockTime().Unix()
+	validFrom := msg.ValidFrom
+	if validFrom == 0 {
+		validFrom = now
+	}
+	consent := types.Consent{
+		Patient:      msg.Creator,
+		Grantee:      msg.Grantee,
+		DataType:     msg.DataType,
+		Purpose:      msg.Purpose,
+		ValidFrom:    validFrom,
+		ValidUntil:   msg.ValidUntil,
+		Restrictions: msg.Restrictions,
+		GrantedAt:    now,
+	}
+	if err := consent.Validate(); err != nil {
+		return nil, err
+	}
+	k.SetConsent(ctx, consent)
+
+	if err := (healthcareAudit{k.Keeper}).LogConsent(ctx, msg.Creator, consentType(consent), true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeConsentGranted,
+			sdk.NewAttribute(types.AttributeKeyPatient, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyDataType, msg.DataType),
+			sdk.NewAttribute(types.AttributeKeyPurpose, msg.Purpose),
+			sdk.NewAttribute(types.AttributeKeyValidUntil, strconv.FormatInt(msg.ValidUntil, 10)),
+		),
+	)
+
+	return &types.MsgGrantConsentResponse{}, nil
+}
+
+// consentType describes a consent in the audit trail of the patient
+func consentType(consent types.Consent) string {
+	return fmt.Sprintf("%s to %s for %s", consent.DataType, consent.Grantee, consent.Purpose)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcare/contracts/transactions"
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.MedicalTransaction
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	// Medical records are written by their provider, or by their patient,
+	// who must sign them
+	if msg.Creator != req.ProviderID && msg.Creator != req.PatientID {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the provider or the patient can initiate a medical transaction")
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+	req.Status = types.TransactionStatusInitiated
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TransactionType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TransactionType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) RegisterEncryptionKey(goCtx context.Context, msg *types.MsgRegisterEncryptionKey) (*types.MsgRegisterEncryptionKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetEncryptionKey(ctx, types.EncryptionKey{
+		Address:      msg.Creator,
+		PublicKey:    msg.PublicKey,
+		RegisteredAt: ctx.BlockTime().Unix(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEncryptionKeyRegistered,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Creator),
+		),
+	)
+
+	return &types.MsgRegisterEncryptionKeyResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) ReviewBreakGlass(goCtx context.Context, msg *types.MsgReviewBreakGlass) (*types.MsgReviewBreakGlassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	reviewer, err := k.IsBreakGlassReviewer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !reviewer {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not in the break-glass review group", msg.Creator)
+	}
+
+	access, found := k.GetBreakGlassAccess(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBreakGlassNotFound, "%d", msg.Id)
+	}
+	if !access.IsPending() {
+		return nil, errorsmod.Wrapf(types.ErrBreakGlassReviewed, "%d is %s", msg.Id, access.Status)
+	}
+
+	access.Status = types.BreakGlassStatusRejected
+	if msg.Approve {
+		access.Status = types.BreakGlassStatusApproved
+	}
+	access.Reviewer = msg.Creator
+	access.ReviewNote = msg.Note
+	access.ReviewedAt = ctx.BlockTime().Unix()
+	k.SetBreakGlassAccess(ctx, access)
+
+	detail := fmt.Sprintf("break-glass access %d %s by %s", access.Id, access.Status, msg.Creator)
+	if err := k.AppendAuditEntry(ctx, access.Patient, "break_glass_review", detail); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBreakGlassReviewed,
+			sdk.NewAttribute(types.AttributeKeyBreakGlassId, strconv.FormatUint(access.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyProvider, access.Provider),
+			sdk.NewAttribute(types.AttributeKeyReviewer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyStatus, access.Status),
+		),
+	)
+
+	return &types.MsgReviewBreakGlassResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k msgServer) RevokeConsent(goCtx context.Context, msg *types.MsgRevokeConsent) (*types.MsgRevokeConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consent, found := k.GetConsent(ctx, msg.Creator, msg.Grantee, msg.DataType, msg.Purpose)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrConsentNotFound, "%s to %s", msg.DataType, msg.Grantee)
+	}
+	k.RemoveConsent(ctx, consent)
+
+	if err := (healthcareAudit{k.Keeper}).LogConsent(ctx, msg.Creator, consentType(consent), false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeConsentRevoked,
+			sdk.NewAttribute(types.AttributeKeyPatient, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyDataType, msg.DataType),
+			sdk.NewAttribute(types.AttributeKeyPurpose, msg.Purpose),
+		),
+	)
+
+	return &types.MsgRevokeConsentResponse{}, nil
+}
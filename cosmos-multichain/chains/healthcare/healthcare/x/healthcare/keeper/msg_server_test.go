@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/contracts/transactions"
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+const treatment = "treatment"
+
+// provider is the account of the provider writing the medical records
+var provider = sample.AccAddress()
+
+func medicalData(t *testing.T, id string, txType transactions.TransactionType, patient string) []byte {
+	data, err := json.Marshal(transactions.MedicalTransaction{
+		TransactionID:   id,
+		PatientID:       patient,
+		ProviderID:      provider,
+		TransactionType: txType,
+		Purpose:         treatment,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+// grantConsent grants the consent of patient for the provider to access the
+// data of the transaction types for treatment
+func grantConsent(t *testing.T, srv types.MsgServer, ctx context.Context, patient string, txTypes ...transactions.TransactionType) {
+	validUntil := sdk.UnwrapSDKContext(ctx).BlockTime().Add(time.Hour).Unix()
+	for _, txType := range txTypes {
+		_, err := srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, provider, string(txType), treatment, 0, validUntil, ""))
+		require.NoError(t, err)
+	}
+}
+
+func TestInitiateMedicalTransaction(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	patient := sample.AccAddress()
+	txTypes := []transactions.TransactionType{
+		transactions.Diagnosis,
+		transactions.Prescription,
+		transactions.LabTest,
+		transactions.Treatment,
+	}
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "a", transactions.Diagnosis, patient)))
+	require.ErrorIs(t, err, types.ErrNoConsent)
+	grantConsent(t, srv, ctx, patient, txTypes...)
+
+	for i, txType := range txTypes {
+		id := string(rune('a' + i))
+		res, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, id, txType, patient)))
+		require.NoError(t, err)
+		require.Equal(t, id, res.TransactionId)
+
+		tx, found := k.GetTransaction(ctx, id)
+		require.True(t, found)
+		require.Equal(t, string(txType), tx.TransactionType)
+	}
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "a", transactions.Diagnosis, patient)))
+	require.ErrorIs(t, err, types.ErrTransactionExists)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "e", transactions.Diagnosis, "")))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	// Nobody but the provider and the patient writes their records
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(sample.AccAddress(), medicalData(t, "f", transactions.Diagnosis, patient)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, found := k.GetTransaction(ctx, "f")
+	require.False(t, found)
+	_, found = k.GetLatestRecordVersion(ctx, "f")
+	require.False(t, found)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(patient, medicalData(t, "f", transactions.Diagnosis, patient)))
+	require.NoError(t, err)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	other, patient := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.Treatment)
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, medicalData(t, "tx-1", transactions.Treatment, patient)))
+	require.NoError(t, err)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(other, "tx-1", "REVIEWED"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(provider, "tx-1", "REVIEWED"))
+	require.NoError(t, err)
+
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(provider, "tx-1"))
+	require.NoError(t, err)
+	tx, _ := k.GetTransaction(ctx, "tx-1")
+	require.True(t, tx.Finalized)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(provider, "tx-1", "REOPENED"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
+
+func TestCallbackAudit(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+
+	require.NoError(t, k.Contract().HandleCallback(ctx, interchain.ChainInsurance, "TREATMENT", nil))
+	trail := k.GetAuditTrail(ctx, interchain.ChainInsurance)
+	require.Len(t, trail, 1)
+	require.Equal(t, "access", trail[0].Action)
+
+	_, err := k.Contract().PrepareInterchainMessage(ctx, interchain.ChainFinance, "TREATMENT", nil)
+	require.ErrorIs(t, err, interchain.ErrUnsupportedChain)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/types"
+)
+
+// GetNotificationCount get the total number of notification
+func (k Keeper) GetNotificationCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.NotificationCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNotificationCount set the total number of notification
+func (k Keeper) SetNotificationCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.NotificationCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// Notify appends a notification for recipient at the current block time
+func (k Keeper) Notify(ctx context.Context, recipient, kind, detail string) uint64 {
+	count := k.GetNotificationCount(ctx)
+	k.SetNotification(ctx, types.Notification{
+		Id:        count,
+		Recipient: recipient,
+		Kind:      kind,
+		Detail:    detail,
+		CreatedAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	})
+	k.SetNotificationCount(ctx, count+1)
+	return count
+}
+
+// SetNotification set a specific notification in the store
+func (k Keeper) SetNotification(ctx context.Context, notification types.Notification) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.NotificationKeyPrefix))
+	b := k.cdc.MustMarshal(&notification)
+	store.Set(types.NotificationKey(notification.Recipient, notification.Id), b)
+}
+
+// GetAllNotification returns all notification
+func (k Keeper) GetAllNotification(ctx context.Context) (list []types.Notification) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.NotificationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Notification
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
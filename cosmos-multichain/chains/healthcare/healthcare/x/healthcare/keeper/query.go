@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"healthcare/x/healthcare/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k Keeper) BreakGlassAccessAll(ctx context.Context, req *types.QueryAllBreakGlassAccessRequest) (*types.QueryAllBreakGlassAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var accesses []types.BreakGlassAccess
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	accessStore := prefix.NewStore(store, types.KeyPrefix(types.BreakGlassAccessKeyPrefix))
+
+	pageRes, err := query.Paginate(accessStore, req.Pagination, func(key []byte, value []byte) error {
+		var access types.BreakGlassAccess
+		if err := k.cdc.Unmarshal(value, &access); err != nil {
+			return err
+		}
+
+		accesses = append(accesses, access)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBreakGlassAccessResponse{BreakGlassAccess: accesses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BreakGlassAccess(ctx context.Context, req *types.QueryGetBreakGlassAccessRequest) (*types.QueryGetBreakGlassAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	access, found := k.GetBreakGlassAccess(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetBreakGlassAccessResponse{BreakGlassAccess: access}, nil
+}
+
+func (k Keeper) Notifications(ctx context.Context, req *types.QueryNotificationsRequest) (*types.QueryNotificationsResponse, error) {
+	if req == nil || req.Recipient == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var notifications []types.Notification
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	notificationStore := prefix.NewStore(store, append(types.KeyPrefix(types.NotificationKeyPrefix), types.NotificationRecipientKey(req.Recipient)...))
+
+	pageRes, err := query.Paginate(notificationStore, req.Pagination, func(key []byte, value []byte) error {
+		var notification types.Notification
+		if err := k.cdc.Unmarshal(value, &notification); err != nil {
+			return err
+		}
+
+		notifications = append(notifications, notification)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNotificationsResponse{Notification: notifications, Pagination: pageRes}, nil
+}
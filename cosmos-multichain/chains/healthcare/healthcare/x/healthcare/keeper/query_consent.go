@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k Keeper) PatientConsents(ctx context.Context, req *types.QueryPatientConsentsRequest) (*types.QueryPatientConsentsResponse, error) {
+	if req == nil || req.Patient == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var consents []types.Consent
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	consentStore := prefix.NewStore(store, append(types.KeyPrefix(types.ConsentKeyPrefix), types.ConsentPatientKey(req.Patient)...))
+
+	pageRes, err := query.Paginate(consentStore, req.Pagination, func(key []byte, value []byte) error {
+		var consent types.Consent
+		if err := k.cdc.Unmarshal(value, &consent); err != nil {
+			return err
+		}
+
+		consents = append(consents, consent)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPatientConsentsResponse{Consent: consents, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CheckAccess(ctx context.Context, req *types.QueryCheckAccessRequest) (*types.QueryCheckAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryCheckAccessResponse{
+		Allowed: k.CanRead(ctx, req.Patient, req.Accessor, req.DataType, req.Purpose),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k Keeper) EncryptionKeyAll(ctx context.Context, req *types.QueryAllEncryptionKeyRequest) (*types.QueryAllEncryptionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var encryptionKeys []types.EncryptionKey
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	encryptionKeyStore := prefix.NewStore(store, types.KeyPrefix(types.EncryptionKeyKeyPrefix))
+
+	pageRes, err := query.Paginate(encryptionKeyStore, req.Pagination, func(key []byte, value []byte) error {
+		var encryptionKey types.EncryptionKey
+		if err := k.cdc.Unmarshal(value, &encryptionKey); err != nil {
+			return err
+		}
+
+		encryptionKeys = append(encryptionKeys, encryptionKey)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllEncryptionKeyResponse{EncryptionKey: encryptionKeys, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EncryptionKey(ctx context.Context, req *types.QueryGetEncryptionKeyRequest) (*types.QueryGetEncryptionKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetEncryptionKey(
+		ctx,
+		req.Address,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetEncryptionKeyResponse{EncryptionKey: val}, nil
+}
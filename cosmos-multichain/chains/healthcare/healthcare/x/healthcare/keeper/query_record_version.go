@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/healthcare/types"
+)
+
+func (k Keeper) RecordHistory(ctx context.Context, req *types.QueryRecordHistoryRequest) (*types.QueryRecordHistoryResponse, error) {
+	if req == nil || req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var versions []types.RecordVersion
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	versionStore := prefix.NewStore(store, append(types.KeyPrefix(types.RecordVersionKeyPrefix), types.RecordVersionRecordKey(req.RecordId)...))
+
+	pageRes, err := query.Paginate(versionStore, req.Pagination, func(key []byte, value []byte) error {
+		var version types.RecordVersion
+		if err := k.cdc.Unmarshal(value, &version); err != nil {
+			return err
+		}
+
+		versions = append(versions, version)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordHistoryResponse{RecordVersion: versions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RecordDiff(ctx context.Context, req *types.QueryRecordDiffRequest) (*types.QueryRecordDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	from, found := k.GetRecordVersion(ctx, req.RecordId, req.FromVersion)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "version %d not found", req.FromVersion)
+	}
+	to, found := k.GetRecordVersion(ctx, req.RecordId, req.ToVersion)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "version %d not found", req.ToVersion)
+	}
+
+	return &types.QueryRecordDiffResponse{Changes: types.DiffRecordVersions(from, to)}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"healthcare/x/healthcare/types"
+)
+
+// SetRecordVersion set a specific recordVersion in the store from its index
+func (k Keeper) SetRecordVersion(ctx context.Context, recordVersion types.RecordVersion) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RecordVersionKeyPrefix))
+	b := k.cdc.MustMarshal(&recordVersion)
+	store.Set(types.RecordVersionKey(
+		recordVersion.RecordId,
+		recordVersion.Version,
+	), b)
+}
+
+// GetRecordVersion returns a recordVersion from its index
+func (k Keeper) GetRecordVersion(
+	ctx context.Context,
+	recordId string,
+	version uint64,
+) (val types.RecordVersion, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RecordVersionKeyPrefix))
+
+	b := store.Get(types.RecordVersionKey(
+		recordId,
+		version,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLatestRecordVersion returns the latest version of a medical record
+func (k Keeper) GetLatestRecordVersion(ctx context.Context, recordId string) (val types.RecordVersion, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RecordVersionKeyPrefix))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.RecordVersionRecordKey(recordId))
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetAllRecordVersion returns all recordVersion
+func (k Keeper) GetAllRecordVersion(ctx context.Context) (list []types.RecordVersion) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RecordVersionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RecordVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"crypto/ecdh"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/contracts/transactions"
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+func TestRecordVersions(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	other, doctor, patient := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	keys := map[string]*ecdh.PrivateKey{doctor: newEncryptionKey(t)}
+	pneumonia := sealData(t, k, "dx-1", []byte("pneumonia"), keys)
+
+	_, err := srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, doctor, string(transactions.Diagnosis), treatment, 0, ctx.BlockTime().Add(time.Hour).Unix(), ""))
+	require.NoError(t, err)
+	data, err := json.Marshal(transactions.MedicalTransaction{
+		TransactionID:   "dx-1",
+		PatientID:       patient,
+		ProviderID:      doctor,
+		TransactionType: transactions.Diagnosis,
+		Purpose:         treatment,
+		Data:            sealData(t, k, "dx-1", []byte("influenza"), keys),
+	})
+	require.NoError(t, err)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(doctor, data))
+	require.NoError(t, err)
+
+	first, found := k.GetLatestRecordVersion(ctx, "dx-1")
+	require.True(t, found)
+	require.Equal(t, uint64(1), first.Version)
+	require.Equal(t, types.TransactionStatusInitiated, first.Status)
+	require.Equal(t, doctor, first.Modifier)
+	require.Empty(t, first.PreviousHash)
+	require.NotEmpty(t, first.DataHash)
+
+	require.Error(t, types.NewMsgAmendRecord(doctor, "dx-1", first.DataHash, pneumonia, "").ValidateBasic())
+	_, err = srv.AmendRecord(ctx, types.NewMsgAmendRecord(doctor, "dx-2", first.DataHash, pneumonia, "x-ray"))
+	require.ErrorIs(t, err, types.ErrMedicalRecordNotFound)
+	_, err = srv.AmendRecord(ctx, types.NewMsgAmendRecord(other, "dx-1", first.DataHash, pneumonia, "x-ray"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// The amended data must be sealed by the client too
+	_, err = srv.AmendRecord(ctx, types.NewMsgAmendRecord(doctor, "dx-1", first.DataHash, []byte("pneumonia"), "x-ray"))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	res, err := srv.AmendRecord(ctx, types.NewMsgAmendRecord(doctor, "dx-1", first.DataHash, pneumonia, "x-ray"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Version)
+
+	// An amendment made on an outdated version never overwrites the later ones
+	_, err = srv.AmendRecord(ctx, types.NewMsgAmendRecord(doctor, "dx-1", first.DataHash, sealData(t, k, "dx-1", []byte("bronchitis"), keys), "second opinion"))
+	require.ErrorIs(t, err, types.ErrInvalidAmendment)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(doctor, "dx-1", "REVIEWED"))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(doctor, "dx-1"))
+	require.NoError(t, err)
+
+	history, err := k.RecordHistory(ctx, &types.QueryRecordHistoryRequest{RecordId: "dx-1"})
+	require.NoError(t, err)
+	require.Len(t, history.RecordVersion, 4)
+	require.NoError(t, types.ValidateRecordHistory(history.RecordVersion))
+	require.Equal(t, first, history.RecordVersion[0])
+	require.Equal(t, res.DataHash, history.RecordVersion[1].DataHash)
+	require.Equal(t, "x-ray", history.RecordVersion[1].Reason)
+	require.Equal(t, pneumonia, history.RecordVersion[3].Data)
+	require.Equal(t, transactions.StatusFinalized, history.RecordVersion[3].Status)
+
+	diff, err := k.RecordDiff(ctx, &types.QueryRecordDiffRequest{RecordId: "dx-1", FromVersion: 1, ToVersion: 2})
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 1)
+	require.Equal(t, "data", diff.Changes[0].Field)
+	diff, err = k.RecordDiff(ctx, &types.QueryRecordDiffRequest{RecordId: "dx-1", FromVersion: 2, ToVersion: 4})
+	require.NoError(t, err)
+	require.Equal(t, []types.MetadataChange{{Field: "status", From: types.TransactionStatusInitiated, To: transactions.StatusFinalized}}, diff.Changes)
+	_, err = k.RecordDiff(ctx, &types.QueryRecordDiffRequest{RecordId: "dx-1", FromVersion: 1, ToVersion: 5})
+	require.Error(t, err)
+
+	var modifications int
+	for _, entry := range k.GetAuditTrail(ctx, patient) {
+		if entry.Action == "modification" {
+			modifications++
+		}
+	}
+	require.Equal(t, 4, modifications)
+
+	notifications, err := k.Notifications(ctx, &types.QueryNotificationsRequest{Recipient: patient})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notification, 1)
+	require.Equal(t, types.NotificationKindRecordAmended, notifications.Notification[0].Kind)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/contracts"
+	"healthcare/contracts/transactions"
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+func TestTreatmentClaim(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, insurer := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.Treatment, transactions.Diagnosis)
+	claimData := func(id string, txType transactions.TransactionType, amount int64) []byte {
+		data, err := json.Marshal(transactions.MedicalTransaction{
+			TransactionID:   id,
+			PatientID:       patient,
+			ProviderID:      provider,
+			TransactionType: txType,
+			Purpose:         treatment,
+			ClaimAmount:     sdkmath.NewInt(amount),
+		})
+		require.NoError(t, err)
+		return data
+	}
+
+	// Treatments are claimed from the insurer the patient consented to
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, claimData("tx-1", transactions.Treatment, 60)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	params := k.GetParams(ctx)
+	params.Insurer = insurer
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, claimData("tx-2", transactions.Treatment, 60)))
+	require.ErrorIs(t, err, types.ErrNoConsent)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, claimData("tx-3", transactions.Diagnosis, 60)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	validUntil := ctx.BlockTime().Add(time.Hour).Unix()
+	_, err = srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, insurer, string(transactions.Treatment), contracts.PurposeInsuranceClaim, 0, validUntil, ""))
+	require.NoError(t, err)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, claimData("tx-4", transactions.Treatment, 60)))
+	require.NoError(t, err)
+
+	first, _ := k.GetLatestRecordVersion(ctx, "tx-4")
+	submitted, found := k.GetContractRecord(ctx, keeper.RecordKindInsuranceClaim, "tx-4")
+	require.True(t, found)
+	var claim contracts.TreatmentClaim
+	require.NoError(t, json.Unmarshal(submitted.Data, &claim))
+	require.Equal(t, contracts.TreatmentClaim{
+		ClaimID:    "tx-4",
+		PatientID:  patient,
+		ProviderID: provider,
+		Amount:     sdkmath.NewInt(60),
+		DataHash:   first.DataHash,
+	}, claim)
+
+	// The claim is sent to the insurance chain once a channel opens, with the
+	// treatment record it claims
+	packet := interchain.NewMessagePacket(contracts.MessageTypeTreatmentClaim, submitted.Data)
+	require.Contains(t, k.GetQueuedPackets(ctx), interchain.QueuedPacket{Chain: interchain.ChainInsurance, Packet: packet})
+	queued := len(k.GetQueuedPackets(ctx))
+	k.SendQueuedPackets(ctx)
+	require.Len(t, k.GetQueuedPackets(ctx), queued)
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainInsurance, packet, nil, nil))
+	unchanged, _ := k.GetLatestRecordVersion(ctx, "tx-4")
+	require.Equal(t, first.Version, unchanged.Version)
+
+	// The decisions of the insurer update the status of the treatment
+	decide := func(status, reason string) error {
+		data, err := json.Marshal(contracts.ClaimDecision{ClaimID: "tx-4", Status: status, Reason: reason})
+		require.NoError(t, err)
+		return k.OnRecvCallback(ctx, interchain.ChainInsurance, contracts.MessageTypeTreatmentClaim, data)
+	}
+	require.NoError(t, decide(contracts.ClaimDecisionDenied, "not covered"))
+	latest, _ := k.GetLatestRecordVersion(ctx, "tx-4")
+	require.Equal(t, uint64(2), latest.Version)
+	require.Equal(t, contracts.ClaimStatus(contracts.ClaimDecisionDenied), latest.Status)
+	require.Equal(t, insurer, latest.Modifier)
+	require.Equal(t, "claim DENIED by "+insurer+": not covered", latest.Reason)
+
+	require.NoError(t, decide(contracts.ClaimDecisionPaid, ""))
+	require.ErrorIs(t, decide(contracts.ClaimDecisionPaid, ""), interchain.ErrInvalidData)
+	require.ErrorIs(t, decide("UNDER_REVIEW", ""), interchain.ErrInvalidData)
+	// An approval delivered after the payment does not undo it
+	require.ErrorIs(t, decide(contracts.ClaimDecisionApproved, ""), interchain.ErrInvalidData)
+	latest, _ = k.GetLatestRecordVersion(ctx, "tx-4")
+	require.Equal(t, uint64(3), latest.Version)
+	require.Equal(t, contracts.ClaimStatus(contracts.ClaimDecisionPaid), latest.Status)
+
+	// A claim the insurance chain rejects is denied
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, claimData("tx-5", transactions.Treatment, 60)))
+	require.NoError(t, err)
+	rejected, _ := k.GetContractRecord(ctx, keeper.RecordKindInsuranceClaim, "tx-5")
+	packet = interchain.NewMessagePacket(contracts.MessageTypeTreatmentClaim, rejected.Data)
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainInsurance, packet, nil, interchain.ErrInvalidData))
+	latest, _ = k.GetLatestRecordVersion(ctx, "tx-5")
+	require.Equal(t, contracts.ClaimStatus(contracts.ClaimDecisionDenied), latest.Status)
+	require.Equal(t, "claim DENIED by "+insurer+": "+interchain.ErrInvalidData.Error(), latest.Reason)
+}
+
+func TestClaimCorroboration(t *testing.T) {
+	k, ctx := keepertest.HealthcareKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, insurer := sample.AccAddress(), sample.AccAddress()
+	grantConsent(t, srv, ctx, patient, transactions.Treatment, transactions.Diagnosis)
+	record := func(id string, txType transactions.TransactionType) types.RecordVersion {
+		data, err := json.Marshal(transactions.MedicalTransaction{
+			TransactionID:   id,
+			PatientID:       patient,
+			ProviderID:      provider,
+			TransactionType: txType,
+			Purpose:         treatment,
+		})
+		require.NoError(t, err)
+		_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(provider, data))
+		require.NoError(t, err)
+		version, _ := k.GetLatestRecordVersion(ctx, id)
+		return version
+	}
+	corroborate := func(subject string, evidence ...string) ([]string, error) {
+		query, err := json.Marshal(contracts.CorroborationQuery{ClaimID: "claim-1", Subject: subject, Evidence: evidence, FiledAt: ctx.BlockTime()})
+		require.NoError(t, err)
+		response, err := k.OnRecvMessage(ctx, interchain.ChainInsurance, contracts.MessageTypeClaimCorroboration, query)
+		if err != nil {
+			return nil, err
+		}
+		var result contracts.CorroborationResult
+		require.NoError(t, json.Unmarshal(response, &result))
+		require.Equal(t, "claim-1", result.ClaimID)
+		return result.Records, nil
+	}
+
+	treated := record("tx-1", transactions.Treatment)
+	record("tx-2", transactions.Diagnosis)
+
+	// Claims are corroborated for the insurer of the module params
+	_, err := corroborate(patient)
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	params := k.GetParams(ctx)
+	params.Insurer = insurer
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Only the records the patient consented to the insurer accessing
+	// corroborate their claims
+	records, err := corroborate(patient)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	validUntil := ctx.BlockTime().Add(time.Hour).Unix()
+	_, err = srv.GrantConsent(ctx, types.NewMsgGrantConsent(patient, insurer, string(transactions.Treatment), contracts.PurposeInsuranceClaim, 0, validUntil, ""))
+	require.NoError(t, err)
+	records, err = corroborate(patient)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-1"}, records)
+
+	// The records must match the evidence of the claim
+	records, err = corroborate(patient, treated.DataHash)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-1"}, records)
+	records, err = corroborate(patient, "unknown")
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = corroborate(sample.AccAddress())
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
@@ -0,0 +1,170 @@
+package healthcare
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "healthcare/api/healthcare/healthcare"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "TransactionAll",
+					Use:       "list-transaction",
+					Short:     "List all transaction",
+				},
+				{
+					RpcMethod:      "Transaction",
+					Use:            "show-transaction [transaction-id]",
+					Short:          "Shows a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "ContractRecord",
+					Use:            "show-contract-record [kind] [record-id]",
+					Short:          "Shows a record kept by the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kind"}, {ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "AuditTrail",
+					Use:            "audit-trail [subject-id]",
+					Short:          "List the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				{
+					RpcMethod:      "VerifyAuditTrail",
+					Use:            "verify-audit-trail [subject-id]",
+					Short:          "Verify the hash chain of the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				{
+					RpcMethod: "EncryptionKeyAll",
+					Use:       "list-encryption-key",
+					Short:     "List all the registered encryption keys",
+				},
+				{
+					RpcMethod:      "EncryptionKey",
+					Use:            "show-encryption-key [address]",
+					Short:          "Shows the encryption key registered by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "PatientConsents",
+					Use:            "patient-consents [patient]",
+					Short:          "List the consents granted by a patient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "patient"}},
+				},
+				{
+					RpcMethod:      "CheckAccess",
+					Use:            "check-access [patient] [accessor] [data-type] [purpose]",
+					Short:          "Check whether an accessor may read a type of data of a patient now",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "patient"}, {ProtoField: "accessor"}, {ProtoField: "data_type"}, {ProtoField: "purpose"}},
+				},
+				{
+					RpcMethod: "BreakGlassAccessAll",
+					Use:       "list-break-glass-access",
+					Short:     "List all the break-glass accesses",
+				},
+				{
+					RpcMethod:      "BreakGlassAccess",
+					Use:            "show-break-glass-access [id]",
+					Short:          "Shows a break-glass access",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "Notifications",
+					Use:            "notifications [recipient]",
+					Short:          "List the notifications of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "RecordHistory",
+					Use:            "record-history [record-id]",
+					Short:          "List the versions of a medical record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "RecordDiff",
+					Use:            "record-diff [record-id] [from-version] [to-version]",
+					Short:          "Show the metadata changed between two versions of a medical record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}, {ProtoField: "from_version"}, {ProtoField: "to_version"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "InitiateTransaction",
+					Use:            "initiate-transaction [data]",
+					Short:          "Run a JSON encoded healthcare transaction through the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data"}},
+				},
+				{
+					RpcMethod:      "UpdateTransactionStatus",
+					Use:            "update-transaction-status [transaction-id] [status]",
+					Short:          "Update the status of a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "FinalizeTransaction",
+					Use:            "finalize-transaction [transaction-id]",
+					Short:          "Finalize a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "RegisterEncryptionKey",
+					Use:            "register-encryption-key [public-key]",
+					Short:          "Publish the X25519 public key medical records are encrypted to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}},
+				},
+				{
+					RpcMethod:      "GrantConsent",
+					Use:            "grant-consent [grantee] [data-type] [purpose] [valid-until]",
+					Short:          "Grant a consent to access a type of your data for a purpose",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grantee"}, {ProtoField: "data_type"}, {ProtoField: "purpose"}, {ProtoField: "valid_until"}},
+				},
+				{
+					RpcMethod:      "RevokeConsent",
+					Use:            "revoke-consent [grantee] [data-type] [purpose]",
+					Short:          "Revoke a consent you granted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grantee"}, {ProtoField: "data_type"}, {ProtoField: "purpose"}},
+				},
+				{
+					RpcMethod:      "BreakGlass",
+					Use:            "break-glass [patient] [justification] [data-types]...",
+					Short:          "Read the records of a patient in an emergency, without their consent, as a member of the provider group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "patient"}, {ProtoField: "justification"}, {ProtoField: "data_types", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ReviewBreakGlass",
+					Use:            "review-break-glass [id] [approve] [note]",
+					Short:          "Review a break-glass access as a member of the review group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "approve"}, {ProtoField: "note"}},
+				},
+				{
+					RpcMethod:      "AmendRecord",
+					Use:            "amend-record [record-id] [previous-hash] [data] [reason]",
+					Short:          "Amend the data of a medical record with a new version",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "record_id"}, {ProtoField: "previous_hash"}, {ProtoField: "data"}, {ProtoField: "reason"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
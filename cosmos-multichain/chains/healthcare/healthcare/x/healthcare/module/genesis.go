@@ -0,0 +1,81 @@
+package healthcare
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/healthcare/keeper"
+	"healthcare/x/healthcare/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the transaction
+	for _, elem := range genState.TransactionList {
+		k.SetTransaction(ctx, elem)
+	}
+	// Set all the contractRecord
+	for _, elem := range genState.ContractRecordList {
+		k.SetContractRecord(ctx, elem)
+	}
+	// Set all the auditEntry
+	for _, elem := range genState.AuditEntryList {
+		k.SetAuditEntry(ctx, elem)
+	}
+
+	// Set auditEntry count
+	k.SetAuditEntryCount(ctx, genState.AuditEntryCount)
+	// Set all the encryptionKey
+	for _, elem := range genState.EncryptionKeyList {
+		k.SetEncryptionKey(ctx, elem)
+	}
+	// Set all the consent
+	for _, elem := range genState.ConsentList {
+		k.SetConsent(ctx, elem)
+	}
+	// Set all the breakGlassAccess
+	for _, elem := range genState.BreakGlassAccessList {
+		k.SetBreakGlassAccess(ctx, elem)
+	}
+
+	// Set breakGlassAccess count
+	k.SetBreakGlassAccessCount(ctx, genState.BreakGlassAccessCount)
+	// Set all the notification
+	for _, elem := range genState.NotificationList {
+		k.SetNotification(ctx, elem)
+	}
+
+	// Set notification count
+	k.SetNotificationCount(ctx, genState.NotificationCount)
+	// Set all the recordVersion
+	for _, elem := range genState.RecordVersionList {
+		k.SetRecordVersion(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	if err := k.Port().BindPort(ctx); err != nil {
+		panic("could not claim port capability: " + err.Error())
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.TransactionList = k.GetAllTransaction(ctx)
+	genesis.ContractRecordList = k.GetAllContractRecord(ctx)
+	genesis.AuditEntryList = k.GetAllAuditEntry(ctx)
+	genesis.AuditEntryCount = k.GetAuditEntryCount(ctx)
+	genesis.EncryptionKeyList = k.GetAllEncryptionKey(ctx)
+	genesis.ConsentList = k.GetAllConsent(ctx)
+	genesis.BreakGlassAccessList = k.GetAllBreakGlassAccess(ctx)
+	genesis.BreakGlassAccessCount = k.GetBreakGlassAccessCount(ctx)
+	genesis.NotificationList = k.GetAllNotification(ctx)
+	genesis.NotificationCount = k.GetNotificationCount(ctx)
+	genesis.RecordVersionList = k.GetAllRecordVersion(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
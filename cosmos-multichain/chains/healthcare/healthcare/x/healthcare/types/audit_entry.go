@@ -0,0 +1,14 @@
+package types
+
+// NewAuditEntry builds an audit entry, the entry message of the module audit trails
+func NewAuditEntry(id uint64, subjectId, action, detail string, timestamp int64, prevHash, hash []byte) AuditEntry {
+	return AuditEntry{
+		Id:        id,
+		SubjectId: subjectId,
+		Action:    action,
+		Detail:    detail,
+		Timestamp: timestamp,
+		PrevHash:  prevHash,
+		Hash:      hash,
+	}
+}
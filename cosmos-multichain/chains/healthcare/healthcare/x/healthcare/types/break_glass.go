@@ -0,0 +1,44 @@
+package types
+
+import "slices"
+
+// Statuses of the break-glass accesses
+const (
+	BreakGlassStatusPending  = "PENDING_REVIEW"
+	BreakGlassStatusApproved = "APPROVED"
+	BreakGlassStatusRejected = "REJECTED"
+)
+
+// NotificationKindBreakGlass is the kind of the notification a patient gets
+// when a provider breaks the glass on their records
+const NotificationKindBreakGlass = "break_glass"
+
+// BreakGlassPurpose is the only purpose a break-glass access reads data for
+const BreakGlassPurpose = "treatment"
+
+// SeverityHigh is the severity of the events calling for immediate attention
+const SeverityHigh = "high"
+
+// IsActive reports whether the access applies at unix time now
+func (a BreakGlassAccess) IsActive(now int64) bool {
+	return a.GrantedAt <= now && now < a.ExpiresAt
+}
+
+// Covers reports whether the access reads the dataType data for purpose
+func (a BreakGlassAccess) Covers(dataType, purpose string) bool {
+	return purpose == BreakGlassPurpose && slices.Contains(a.DataTypes, dataType)
+}
+
+// IsPending reports whether the access waits for review
+func (a BreakGlassAccess) IsPending() bool {
+	return a.Status == BreakGlassStatusPending
+}
+
+// Identity returns the provider identity the access counts against, the
+// account of the provider when it has none
+func (a BreakGlassAccess) Identity() string {
+	if a.ProviderIdentity != "" {
+		return a.ProviderIdentity
+	}
+	return a.Provider
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IsActive reports whether the consent applies at unix time now
+func (c Consent) IsActive(now int64) bool {
+	return c.ValidFrom <= now && now < c.ValidUntil
+}
+
+// Validate checks the fields of a consent
+func (c Consent) Validate() error {
+	if c.Patient == "" || c.Grantee == "" {
+		return errorsmod.Wrap(ErrInvalidConsent, "patient and grantee cannot be empty")
+	}
+	return validateConsentScope(c.DataType, c.Purpose, c.ValidFrom, c.ValidUntil)
+}
+
+func validateConsentScope(dataType, purpose string, validFrom, validUntil int64) error {
+	if dataType == "" || purpose == "" {
+		return errorsmod.Wrap(ErrInvalidConsent, "data type and purpose cannot be empty")
+	}
+	if validUntil <= validFrom {
+		return errorsmod.Wrap(ErrInvalidConsent, "valid until must be after valid from")
+	}
+	return nil
+}
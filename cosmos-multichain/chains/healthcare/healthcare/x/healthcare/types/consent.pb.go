@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare/healthcare/consent.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Consent is the consent of a patient for a grantee to access a type of their
+// data for a purpose, from valid_from until valid_until.
+type Consent struct {
+	Patient      string `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	Grantee      string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	DataType     string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Purpose      string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	ValidFrom    int64  `protobuf:"varint,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil   int64  `protobuf:"varint,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Restrictions string `protobuf:"bytes,7,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	GrantedAt    int64  `protobuf:"varint,8,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+}
+
+func (m *Consent) Reset()         { *m = Consent{} }
+func (m *Consent) String() string { return proto.CompactTextString(m) }
+func (*Consent) ProtoMessage()    {}
+func (*Consent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb6953bfa117abd0, []int{0}
+}
+func (m *Consent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Consent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Consent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Consent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Consent.Merge(m, src)
+}
+func (m *Consent) XXX_Size() int {
+	return m.Size()
+}
+func (m *Consent) XXX_DiscardUnknown() {
+	xxx_messageInfo_Consent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Consent proto.InternalMessageInfo
+
+func (m *Consent) GetPatient() string {
+	if m != nil {
+		return m.Patient
+	}
+	return ""
+}
+
+func (m *Consent) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *Consent) GetDataType() string {
+	if m != nil {
+		return m.DataType
+	}
+	return ""
+}
+
+func (m *Consent) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
+	}
+	return ""
+}
+
+func (m *Consent) GetValidFrom() int64 {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+func (m *Consent) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *Consent) GetRestrictions() string {
+	if m != nil {
+		return m.Restrictions
+	}
+	return ""
+}
+
+func (m *Consent) GetGrantedAt() int64 {
+	if m != nil {
+		return m.GrantedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Consent)(nil), "healthcare.healthcare.Consent")
+}
+
+func init() {
+	proto.RegisterFile("healthcare/healthcare/consent.proto", fileDescriptor_eb6953bfa117abd0)
+}
+
+var fileDescriptor_eb6953bfa117abd0 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x45, 0x6b, 0x0a, 0x4d, 0x63, 0x98, 0x2c, 0x21, 0x59, 0x42, 0x35, 0x55, 0x59, 0x3a, 0x95,
+	0x81, 0x81, 0x19, 0x90, 0xf8, 0x80, 0x0a, 0x16, 0x96, 0xc8, 0x24, 0x0f, 0x6a, 0x29, 0xb5, 0x2d,
+	0xfb, 0x15, 0xd1, 0xbf, 0xe0, 0xb3, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x23, 0x58, 0x91, 0xed, 0xa0,
+	0x86, 0xed, 0xdd, 0x7b, 0xec, 0xfb, 0xec, 0x4b, 0x2f, 0x56, 0x20, 0x6b, 0x5c, 0x95, 0xd2, 0xc1,
+	0x65, 0x6f, 0x2c, 0x8d, 0xf6, 0xa0, 0x71, 0x61, 0x9d, 0x41, 0xc3, 0x4e, 0xf7, 0x64, 0xb1, 0x1f,
+	0x67, 0x3f, 0x84, 0x66, 0x77, 0xe9, 0x20, 0xe3, 0x34, 0xb3, 0x12, 0x15, 0x68, 0xe4, 0x64, 0x4a,
+	0xe6, 0xf9, 0xf2, 0x4f, 0x06, 0xf2, 0xea, 0xa4, 0x46, 0x00, 0x7e, 0x90, 0x48, 0x27, 0xd9, 0x19,
+	0xcd, 0x2b, 0x89, 0xb2, 0xc0, 0xad, 0x05, 0x3e, 0x8c, 0x6c, 0x1c, 0x8c, 0x87, 0xad, 0x85, 0x18,
+	0xb8, 0x71, 0xd6, 0x78, 0xe0, 0x87, 0x5d, 0x60, 0x92, 0x6c, 0x42, 0xe9, 0x9b, 0xac, 0x55, 0x55,
+	0xbc, 0x38, 0xb3, 0xe6, 0x47, 0x53, 0x32, 0x1f, 0x2e, 0xf3, 0xe8, 0xdc, 0x3b, 0xb3, 0x66, 0xe7,
+	0xf4, 0x38, 0xe1, 0x8d, 0x46, 0x55, 0xf3, 0x51, 0xe4, 0xe9, 0xc6, 0x63, 0x70, 0xd8, 0x8c, 0x9e,
+	0x38, 0xf0, 0xe8, 0x54, 0x89, 0xca, 0x68, 0xcf, 0xb3, 0x18, 0xff, 0xcf, 0x0b, 0x3b, 0xd2, 0x2b,
+	0xab, 0x42, 0x22, 0x1f, 0xa7, 0x1d, 0x9d, 0x73, 0x83, 0xb7, 0xd7, 0x9f, 0x8d, 0x20, 0xbb, 0x46,
+	0x90, 0xef, 0x46, 0x90, 0x8f, 0x56, 0x0c, 0x76, 0xad, 0x18, 0x7c, 0xb5, 0x62, 0xf0, 0x34, 0xe9,
+	0x95, 0xf8, 0xde, 0x6f, 0x34, 0x7c, 0xd2, 0x3f, 0x8f, 0x62, 0xa1, 0x57, 0xbf, 0x03, 0x00, 0xd1,
+	0x38, 0xd3, 0xf8, 0x77, 0x01, 0x00, 0x00,
+}
+
+func (m *Consent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Consent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Consent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GrantedAt != 0 {
+		i = encodeVarintConsent(dAtA, i, uint64(m.GrantedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Restrictions) > 0 {
+		i -= len(m.Restrictions)
+		copy(dAtA[i:], m.Restrictions)
+		i = encodeVarintConsent(dAtA, i, uint64(len(m.Restrictions)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ValidUntil != 0 {
+		i = encodeVarintConsent(dAtA, i, uint64(m.ValidUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidFrom != 0 {
+		i = encodeVarintConsent(dAtA, i, uint64(m.ValidFrom))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintConsent(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataType) > 0 {
+		i -= len(m.DataType)
+		copy(dAtA[i:], m.DataType)
+		i = encodeVarintConsent(dAtA, i, uint64(len(m.DataType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintConsent(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Patient) > 0 {
+		i -= len(m.Patient)
+		copy(dAtA[i:], m.Patient)
+		i = encodeVarintConsent(dAtA, i, uint64(len(m.Patient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Consent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Patient)
+	if l > 0 {
+		n += 1 + l + sovConsent(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovConsent(uint64(l))
+	}
+	l = len(m.DataType)
+	if l > 0 {
+		n += 1 + l + sovConsent(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovConsent(uint64(l))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovConsent(uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovConsent(uint64(m.ValidUntil))
+	}
+	l = len(m.Restrictions)
+	if l > 0 {
+		n += 1 + l + sovConsent(uint64(l))
+	}
+	if m.GrantedAt != 0 {
+		n += 1 + sovConsent(uint64(m.GrantedAt))
+	}
+	return n
+}
+
+func sovConsent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsent(x uint64) (n int) {
+	return sovConsent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Consent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Consent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Consent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedAt", wireType)
+			}
+			m.GrantedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConsent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConsent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConsent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConsent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConsent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConsent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConsent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConsent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"crypto/ecdh"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateEncryptionKey checks that publicKey is a X25519 public key
+func ValidateEncryptionKey(publicKey []byte) error {
+	if _, err := ecdh.X25519().NewPublicKey(publicKey); err != nil {
+		return errorsmod.Wrap(ErrInvalidEncryptionKey, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/healthcare module sentinel errors
+var (
+	ErrInvalidSigner         = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTransaction    = sdkerrors.Register(ModuleName, 1101, "invalid transaction")
+	ErrTransactionExists     = sdkerrors.Register(ModuleName, 1102, "transaction already exists")
+	ErrTransactionNotFound   = sdkerrors.Register(ModuleName, 1103, "transaction not found")
+	ErrTransactionFinalized  = sdkerrors.Register(ModuleName, 1104, "transaction is finalized")
+	ErrRecordNotFound        = sdkerrors.Register(ModuleName, 1105, "contract record not found")
+	ErrInvalidEncryptionKey  = sdkerrors.Register(ModuleName, 1106, "invalid encryption key")
+	ErrInvalidConsent        = sdkerrors.Register(ModuleName, 1107, "invalid consent")
+	ErrConsentNotFound       = sdkerrors.Register(ModuleName, 1108, "consent not found")
+	ErrNoConsent             = sdkerrors.Register(ModuleName, 1109, "no active consent of the patient")
+	ErrInvalidBreakGlass     = sdkerrors.Register(ModuleName, 1110, "invalid break-glass access")
+	ErrBreakGlassNotFound    = sdkerrors.Register(ModuleName, 1111, "break-glass access not found")
+	ErrBreakGlassReviewed    = sdkerrors.Register(ModuleName, 1112, "break-glass access already reviewed")
+	ErrTooManyUnreviewed     = sdkerrors.Register(ModuleName, 1113, "too many unreviewed break-glass accesses")
+	ErrInvalidGroupPolicy    = sdkerrors.Register(ModuleName, 1114, "invalid group policy")
+	ErrMedicalRecordNotFound = sdkerrors.Register(ModuleName, 1115, "medical record not found")
+	ErrInvalidAmendment      = sdkerrors.Register(ModuleName, 1116, "invalid medical record amendment")
+)
@@ -0,0 +1,45 @@
+package types
+
+// healthcare module event types
+const (
+	EventTypeTransactionInitiated     = "transaction_initiated"
+	EventTypeTransactionStatusUpdated = "transaction_status_updated"
+	EventTypeTransactionFinalized     = "transaction_finalized"
+	EventTypeEncryptionKeyRegistered  = "encryption_key_registered"
+	EventTypeConsentGranted           = "consent_granted"
+	EventTypeConsentRevoked           = "consent_revoked"
+	EventTypeConsentExpired           = "consent_expired"
+	EventTypeBreakGlass               = "break_glass"
+	EventTypeBreakGlassReviewed       = "break_glass_reviewed"
+	EventTypeRecordAmended            = "record_amended"
+	EventTypeCallbackDelivered        = "callback_delivered"
+	EventTypeTreatmentClaimed         = "treatment_claimed"
+
+	AttributeKeyTransactionId   = "transaction_id"
+	AttributeKeyTransactionType = "transaction_type"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyStatus          = "status"
+	AttributeKeyAddress         = "address"
+	AttributeKeyPatient         = "patient"
+	AttributeKeyGrantee         = "grantee"
+	AttributeKeyDataType        = "data_type"
+	AttributeKeyPurpose         = "purpose"
+	AttributeKeyValidUntil      = "valid_until"
+	AttributeKeySeverity        = "severity"
+	AttributeKeyBreakGlassId    = "break_glass_id"
+	AttributeKeyProvider        = "provider"
+	AttributeKeyJustification   = "justification"
+	AttributeKeyDataTypes       = "data_types"
+	AttributeKeyExpiresAt       = "expires_at"
+	AttributeKeyReviewer        = "reviewer"
+	AttributeKeyRecordId        = "record_id"
+	AttributeKeyVersion         = "version"
+	AttributeKeyDataHash        = "data_hash"
+	AttributeKeyPreviousHash    = "previous_hash"
+	AttributeKeyReason          = "reason"
+	AttributeKeySourceChain     = "source_chain"
+	AttributeKeyMessageType     = "message_type"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
+	AttributeKeyAmount          = "amount"
+)
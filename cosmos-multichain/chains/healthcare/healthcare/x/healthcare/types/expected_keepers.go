@@ -0,0 +1,49 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	laboratorytypes "healthcare/x/laboratory/types"
+	pharmacytypes "healthcare/x/pharmacy/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	// Methods imported from account should be defined here
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	// Methods imported from bank should be defined here
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
+
+// GroupKeeper defines the expected interface for the group module.
+type GroupKeeper interface {
+	GroupPolicyInfo(ctx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+	GroupMembers(ctx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error)
+}
+
+// PharmacyKeeper defines the expected interface for the pharmacy module.
+type PharmacyKeeper interface {
+	SubmitPrescription(ctx context.Context, prescription pharmacytypes.Prescription) error
+	GetAllCallback(ctx context.Context) []pharmacytypes.Callback
+	RemoveCallback(ctx context.Context, id uint64)
+}
+
+// LaboratoryKeeper defines the expected interface for the laboratory module.
+type LaboratoryKeeper interface {
+	SubmitLabOrder(ctx context.Context, order laboratorytypes.LabOrder) error
+	GetAllCallback(ctx context.Context) []laboratorytypes.Callback
+	RemoveCallback(ctx context.Context, id uint64)
+}
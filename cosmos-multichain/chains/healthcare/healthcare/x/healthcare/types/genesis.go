@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/example/cosmos-multichain/audit"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		TransactionList:      []Transaction{},
+		ContractRecordList:   []ContractRecord{},
+		AuditEntryList:       []AuditEntry{},
+		EncryptionKeyList:    []EncryptionKey{},
+		ConsentList:          []Consent{},
+		BreakGlassAccessList: []BreakGlassAccess{},
+		NotificationList:     []Notification{},
+		RecordVersionList:    []RecordVersion{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in transaction
+	transactionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TransactionList {
+		index := string(TransactionKey(elem.TransactionId))
+		if _, ok := transactionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transaction")
+		}
+		transactionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in contractRecord
+	contractRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ContractRecordList {
+		index := string(ContractRecordKey(elem.Kind, elem.RecordId))
+		if _, ok := contractRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for contractRecord")
+		}
+		contractRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in auditEntry
+	auditEntryIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AuditEntryList {
+		if _, ok := auditEntryIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auditEntry")
+		}
+		if elem.Id >= gs.AuditEntryCount {
+			return fmt.Errorf("auditEntry id should be lower or equal than the last id")
+		}
+		auditEntryIdMap[elem.Id] = true
+	}
+	// Check the hash chains of the audit trails
+	if err := audit.Verify(gs.AuditEntryList); err != nil {
+		return err
+	}
+	// Check for duplicated index and invalid keys in encryptionKey
+	encryptionKeyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.EncryptionKeyList {
+		index := string(EncryptionKeyKey(elem.Address))
+		if _, ok := encryptionKeyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for encryptionKey")
+		}
+		if err := ValidateEncryptionKey(elem.PublicKey); err != nil {
+			return err
+		}
+		encryptionKeyIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index and invalid consents in consent
+	consentIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ConsentList {
+		index := string(ConsentKey(elem.Patient, elem.Grantee, elem.DataType, elem.Purpose))
+		if _, ok := consentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for consent")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		consentIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in breakGlassAccess
+	breakGlassAccessIdMap := make(map[uint64]bool)
+	for _, elem := range gs.BreakGlassAccessList {
+		if _, ok := breakGlassAccessIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for breakGlassAccess")
+		}
+		if elem.Id >= gs.BreakGlassAccessCount {
+			return fmt.Errorf("breakGlassAccess id should be lower or equal than the last id")
+		}
+		breakGlassAccessIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in notification
+	notificationIdMap := make(map[uint64]bool)
+	for _, elem := range gs.NotificationList {
+		if _, ok := notificationIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for notification")
+		}
+		if elem.Id >= gs.NotificationCount {
+			return fmt.Errorf("notification id should be lower or equal than the last id")
+		}
+		notificationIdMap[elem.Id] = true
+	}
+	// Check for duplicated index and broken hash chains in recordVersion
+	if err := ValidateRecordHistory(gs.RecordVersionList); err != nil {
+		return err
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare/healthcare/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the healthcare module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransactionList       []Transaction      `protobuf:"bytes,2,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	ContractRecordList    []ContractRecord   `protobuf:"bytes,3,rep,name=contract_record_list,json=contractRecordList,proto3" json:"contract_record_list"`
+	AuditEntryList        []AuditEntry       `protobuf:"bytes,4,rep,name=audit_entry_list,json=auditEntryList,proto3" json:"audit_entry_list"`
+	AuditEntryCount       uint64             `protobuf:"varint,5,opt,name=audit_entry_count,json=auditEntryCount,proto3" json:"audit_entry_count,omitempty"`
+	EncryptionKeyList     []EncryptionKey    `protobuf:"bytes,6,rep,name=encryption_key_list,json=encryptionKeyList,proto3" json:"encryption_key_list"`
+	ConsentList           []Consent          `protobuf:"bytes,7,rep,name=consent_list,json=consentList,proto3" json:"consent_list"`
+	BreakGlassAccessList  []BreakGlassAccess `protobuf:"bytes,8,rep,name=break_glass_access_list,json=breakGlassAccessList,proto3" json:"break_glass_access_list"`
+	BreakGlassAccessCount uint64             `protobuf:"varint,9,opt,name=break_glass_access_count,json=breakGlassAccessCount,proto3" json:"break_glass_access_count,omitempty"`
+	NotificationList      []Notification     `protobuf:"bytes,10,rep,name=notification_list,json=notificationList,proto3" json:"notification_list"`
+	NotificationCount     uint64             `protobuf:"varint,11,opt,name=notification_count,json=notificationCount,proto3" json:"notification_count,omitempty"`
+	RecordVersionList     []RecordVersion    `protobuf:"bytes,12,rep,name=record_version_list,json=recordVersionList,proto3" json:"record_version_list"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5158350d1f380e61, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTransactionList() []Transaction {
+	if m != nil {
+		return m.TransactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRecordList() []ContractRecord {
+	if m != nil {
+		return m.ContractRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryList() []AuditEntry {
+	if m != nil {
+		return m.AuditEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryCount() uint64 {
+	if m != nil {
+		return m.AuditEntryCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEncryptionKeyList() []EncryptionKey {
+	if m != nil {
+		return m.EncryptionKeyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsentList() []Consent {
+	if m != nil {
+		return m.ConsentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBreakGlassAccessList() []BreakGlassAccess {
+	if m != nil {
+		return m.BreakGlassAccessList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBreakGlassAccessCount() uint64 {
+	if m != nil {
+		return m.BreakGlassAccessCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNotificationList() []Notification {
+	if m != nil {
+		return m.NotificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNotificationCount() uint64 {
+	if m != nil {
+		return m.NotificationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRecordVersionList() []RecordVersion {
+	if m != nil {
+		return m.RecordVersionList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "healthcare.healthcare.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("healthcare/healthcare/genesis.proto", fileDescriptor_5158350d1f380e61)
+}
+
+var fileDescriptor_5158350d1f380e61 = []byte{
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x59, 0x8b, 0xd4, 0x0e, 0xc4, 0xc2, 0x4a, 0x23, 0x21, 0xe9, 0x8a, 0x54, 0x53, 0x42,
+	0x22, 0x24, 0xf5, 0xd0, 0xab, 0xa5, 0x69, 0x38, 0x68, 0x8c, 0x52, 0xd3, 0x43, 0x13, 0xb3, 0x19,
+	0x86, 0x91, 0x6e, 0x0a, 0x33, 0x64, 0x66, 0x6a, 0xe4, 0x5b, 0xf8, 0x31, 0x8c, 0x27, 0x3f, 0x46,
+	0x8f, 0x3d, 0x7a, 0x32, 0x06, 0x0e, 0x7e, 0x0d, 0x33, 0x6f, 0xa6, 0xec, 0x2c, 0xd9, 0xe5, 0x42,
+	0x26, 0x8f, 0xff, 0xff, 0xf7, 0xfe, 0xbc, 0x79, 0x03, 0x3a, 0xb8, 0xa2, 0x78, 0xa2, 0xae, 0x08,
+	0x16, 0xb4, 0xeb, 0x1c, 0xc7, 0x94, 0x51, 0x19, 0xc9, 0xce, 0x4c, 0x70, 0xc5, 0xfd, 0xbd, 0xf8,
+	0x9b, 0x4e, 0x7c, 0xac, 0x57, 0xf0, 0x34, 0x62, 0xbc, 0x0b, 0x9f, 0x46, 0x59, 0xaf, 0x8e, 0xf9,
+	0x98, 0xc3, 0xb1, 0xab, 0x4f, 0xb6, 0xda, 0x4c, 0x6f, 0x32, 0xc3, 0x02, 0x4f, 0x6d, 0x8f, 0xfa,
+	0x61, 0xba, 0x46, 0x09, 0xcc, 0x24, 0x26, 0x2a, 0xe2, 0x6c, 0x33, 0x4c, 0x50, 0xc2, 0xc5, 0xc8,
+	0x6a, 0xda, 0xe9, 0x1a, 0xca, 0x88, 0x98, 0xcf, 0x34, 0x2b, 0xbc, 0xa6, 0x73, 0xab, 0xcd, 0x98,
+	0x00, 0xe1, 0x4c, 0x52, 0xa6, 0x36, 0xa7, 0x1b, 0x0a, 0x8a, 0xaf, 0xc3, 0xf1, 0x04, 0x4b, 0xb9,
+	0xb9, 0xf3, 0x94, 0x8e, 0x22, 0x82, 0x27, 0xa1, 0x9b, 0xb2, 0xf9, 0x73, 0x1b, 0x95, 0xfa, 0x66,
+	0xd0, 0xe7, 0x0a, 0x2b, 0xea, 0xbf, 0x41, 0x05, 0x33, 0x93, 0x9a, 0xd7, 0xf0, 0x5a, 0xc5, 0xa3,
+	0xfd, 0x4e, 0xea, 0xe0, 0x3b, 0x1f, 0x40, 0xd4, 0xdb, 0xb9, 0xfd, 0xf3, 0x2c, 0xf7, 0xe3, 0xdf,
+	0xaf, 0xb6, 0x37, 0xb0, 0x3e, 0xff, 0x1c, 0x95, 0x9d, 0x89, 0x85, 0x93, 0x48, 0xaa, 0xda, 0x83,
+	0xc6, 0x56, 0xab, 0x78, 0xd4, 0xcc, 0x60, 0x7d, 0x8a, 0xe5, 0xbd, 0xbc, 0x06, 0x0e, 0x76, 0x1d,
+	0xc2, 0xbb, 0x48, 0x2a, 0xff, 0x33, 0xaa, 0x12, 0xce, 0x94, 0xc0, 0x44, 0xd9, 0x1f, 0x60, 0xc0,
+	0x5b, 0x00, 0x7e, 0x99, 0x01, 0x3e, 0xb5, 0x96, 0x01, 0x38, 0x2c, 0xdb, 0x27, 0x89, 0x2a, 0xe0,
+	0x3f, 0xa2, 0x32, 0xbe, 0x19, 0x45, 0x2a, 0xa4, 0x4c, 0x89, 0xb9, 0x41, 0xe7, 0x01, 0xfd, 0x3c,
+	0x03, 0x7d, 0xa2, 0xe5, 0x67, 0x5a, 0x6d, 0xb1, 0x8f, 0xf1, 0xaa, 0x02, 0xc8, 0x36, 0xaa, 0xb8,
+	0x48, 0xc2, 0x6f, 0x98, 0xaa, 0x3d, 0x6c, 0x78, 0xad, 0xfc, 0x60, 0x37, 0x96, 0x9e, 0xea, 0xb2,
+	0x7f, 0x89, 0x9e, 0x24, 0xf7, 0xc2, 0x24, 0x28, 0x40, 0x82, 0x17, 0x19, 0x09, 0xce, 0x56, 0x8e,
+	0xb7, 0xf4, 0x3e, 0x44, 0x85, 0xba, 0x45, 0xc8, 0xd1, 0x47, 0x25, 0xbb, 0x47, 0x06, 0xba, 0x0d,
+	0xd0, 0x20, 0x7b, 0x62, 0x5a, 0x6a, 0x71, 0x45, 0xeb, 0x04, 0xd0, 0x08, 0x3d, 0x75, 0x76, 0x2d,
+	0xc4, 0x84, 0x50, 0x29, 0x0d, 0xf3, 0x11, 0x30, 0x0f, 0x33, 0x98, 0x3d, 0xed, 0xea, 0x6b, 0xd3,
+	0x09, 0x78, 0x2c, 0xbc, 0x3a, 0x5c, 0xab, 0x43, 0x97, 0x63, 0x54, 0x4b, 0xe9, 0x62, 0xa6, 0xb7,
+	0x03, 0xd3, 0xdb, 0x5b, 0xf7, 0x99, 0x19, 0x5e, 0xa0, 0x0a, 0xe3, 0x2a, 0xfa, 0x12, 0x11, 0x1c,
+	0xef, 0x1d, 0x82, 0x60, 0x07, 0x19, 0xc1, 0xde, 0x3b, 0x7a, 0x1b, 0xaa, 0xec, 0x32, 0x20, 0xd0,
+	0x2b, 0xe4, 0x27, 0xb8, 0x26, 0x4a, 0x11, 0xa2, 0x24, 0x3a, 0xae, 0xae, 0xd2, 0xee, 0xe7, 0x57,
+	0x2a, 0xe4, 0x2a, 0x48, 0x69, 0xe3, 0x55, 0x9a, 0x4d, 0xbc, 0x30, 0x86, 0xfb, 0xab, 0x14, 0x6e,
+	0x51, 0x47, 0xe9, 0x1d, 0xdf, 0x2e, 0x02, 0xef, 0x6e, 0x11, 0x78, 0x7f, 0x17, 0x81, 0xf7, 0x7d,
+	0x19, 0xe4, 0xee, 0x96, 0x41, 0xee, 0xf7, 0x32, 0xc8, 0x5d, 0xee, 0x3b, 0xef, 0xfc, 0x5b, 0xe2,
+	0xbf, 0x6b, 0x3e, 0xa3, 0x72, 0x58, 0x80, 0xc7, 0xfe, 0xfa, 0xff, 0x00, 0xe1, 0x43, 0x2f, 0x56,
+	0x6a, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordVersionList) > 0 {
+		for iNdEx := len(m.RecordVersionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVersionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.NotificationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NotificationCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.NotificationList) > 0 {
+		for iNdEx := len(m.NotificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.BreakGlassAccessCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BreakGlassAccessCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.BreakGlassAccessList) > 0 {
+		for iNdEx := len(m.BreakGlassAccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BreakGlassAccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ConsentList) > 0 {
+		for iNdEx := len(m.ConsentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EncryptionKeyList) > 0 {
+		for iNdEx := len(m.EncryptionKeyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptionKeyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditEntryCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuditEntryList) > 0 {
+		for iNdEx := len(m.AuditEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for iNdEx := len(m.ContractRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for _, e := range m.ContractRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntryList) > 0 {
+		for _, e := range m.AuditEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditEntryCount))
+	}
+	if len(m.EncryptionKeyList) > 0 {
+		for _, e := range m.EncryptionKeyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsentList) > 0 {
+		for _, e := range m.ConsentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BreakGlassAccessList) > 0 {
+		for _, e := range m.BreakGlassAccessList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BreakGlassAccessCount != 0 {
+		n += 1 + sovGenesis(uint64(m.BreakGlassAccessCount))
+	}
+	if len(m.NotificationList) > 0 {
+		for _, e := range m.NotificationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NotificationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.NotificationCount))
+	}
+	if len(m.RecordVersionList) > 0 {
+		for _, e := range m.RecordVersionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRecordList = append(m.ContractRecordList, ContractRecord{})
+			if err := m.ContractRecordList[len(m.ContractRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntryList = append(m.AuditEntryList, AuditEntry{})
+			if err := m.AuditEntryList[len(m.AuditEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryCount", wireType)
+			}
+			m.AuditEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptionKeyList = append(m.EncryptionKeyList, EncryptionKey{})
+			if err := m.EncryptionKeyList[len(m.EncryptionKeyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsentList = append(m.ConsentList, Consent{})
+			if err := m.ConsentList[len(m.ConsentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassAccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BreakGlassAccessList = append(m.BreakGlassAccessList, BreakGlassAccess{})
+			if err := m.BreakGlassAccessList[len(m.BreakGlassAccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassAccessCount", wireType)
+			}
+			m.BreakGlassAccessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BreakGlassAccessCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotificationList = append(m.NotificationList, Notification{})
+			if err := m.NotificationList[len(m.NotificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationCount", wireType)
+			}
+			m.NotificationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotificationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVersionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordVersionList = append(m.RecordVersionList, RecordVersion{})
+			if err := m.RecordVersionList[len(m.RecordVersionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,239 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/example/cosmos-multichain/audit"
+	"github.com/stretchr/testify/require"
+
+	"healthcare/x/healthcare/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	first := types.NewAuditEntry(0, "0", "", "", 0, nil, audit.Hash(nil, 0, "0", "", "", 0))
+	second := types.NewAuditEntry(1, "0", "", "", 0, first.Hash, audit.Hash(first.Hash, 1, "0", "", "", 0))
+	publicKey := bytes.Repeat([]byte{1}, 32)
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "1"},
+				},
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "b", RecordId: "0"},
+				},
+				AuditEntryList:  []types.AuditEntry{first, second},
+				AuditEntryCount: 2,
+				EncryptionKeyList: []types.EncryptionKey{
+					{Address: "0", PublicKey: publicKey},
+					{Address: "1", PublicKey: publicKey},
+				},
+				ConsentList: []types.Consent{
+					{Patient: "0", Grantee: "1", DataType: "a", Purpose: "a", ValidUntil: 1},
+					{Patient: "0", Grantee: "1", DataType: "a", Purpose: "b", ValidUntil: 1},
+				},
+				BreakGlassAccessList: []types.BreakGlassAccess{
+					{Id: 0},
+					{Id: 1},
+				},
+				BreakGlassAccessCount: 2,
+				NotificationList: []types.Notification{
+					{Id: 0},
+					{Id: 1},
+				},
+				NotificationCount: 2,
+				RecordVersionList: []types.RecordVersion{
+					{RecordId: "0", Version: 1, DataHash: "a"},
+					{RecordId: "0", Version: 2, DataHash: "b", PreviousHash: "a"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated transaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contractRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "a", RecordId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auditEntry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0},
+					{Id: 0},
+				},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auditEntry count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 1},
+				},
+				AuditEntryCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "rewritten auditEntry",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				AuditEntryList:  []types.AuditEntry{first, types.NewAuditEntry(1, "0", "", "rewritten", 0, first.Hash, second.Hash)},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated encryptionKey",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EncryptionKeyList: []types.EncryptionKey{
+					{Address: "0", PublicKey: publicKey},
+					{Address: "0", PublicKey: publicKey},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid encryptionKey",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EncryptionKeyList: []types.EncryptionKey{
+					{Address: "0", PublicKey: publicKey[:16]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated consent",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConsentList: []types.Consent{
+					{Patient: "0", Grantee: "1", DataType: "a", Purpose: "a", ValidUntil: 1},
+					{Patient: "0", Grantee: "1", DataType: "a", Purpose: "a", ValidUntil: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid consent",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ConsentList: []types.Consent{
+					{Patient: "0", Grantee: "1", DataType: "a", Purpose: "a", ValidFrom: 1, ValidUntil: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated breakGlassAccess",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				BreakGlassAccessList: []types.BreakGlassAccess{
+					{Id: 0},
+					{Id: 0},
+				},
+				BreakGlassAccessCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid breakGlassAccess count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				BreakGlassAccessList: []types.BreakGlassAccess{
+					{Id: 1},
+				},
+				BreakGlassAccessCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid notification count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				NotificationList: []types.Notification{
+					{Id: 0},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated recordVersion",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RecordVersionList: []types.RecordVersion{
+					{RecordId: "0", Version: 1, DataHash: "a"},
+					{RecordId: "0", Version: 1, DataHash: "a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unlinked recordVersion",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RecordVersionList: []types.RecordVersion{
+					{RecordId: "0", Version: 1, DataHash: "a"},
+					{RecordId: "0", Version: 2, DataHash: "b", PreviousHash: "c"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "missing recordVersion",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RecordVersionList: []types.RecordVersion{
+					{RecordId: "0", Version: 2, DataHash: "b", PreviousHash: "a"},
+				},
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// BreakGlassAccessKeyPrefix is the prefix to retrieve all BreakGlassAccess
+	BreakGlassAccessKeyPrefix = "BreakGlassAccess/value/"
+
+	// BreakGlassAccessCountKey is the key of the number of break-glass accesses ever granted
+	BreakGlassAccessCountKey = "BreakGlassAccess/count/"
+
+	// BreakGlassActiveIndexPrefix indexes the latest break-glass access of a
+	// provider to the records of a patient
+	BreakGlassActiveIndexPrefix = "BreakGlassAccess/active/"
+
+	// BreakGlassPendingIndexPrefix indexes the break-glass accesses of a
+	// provider waiting for review
+	BreakGlassPendingIndexPrefix = "BreakGlassAccess/pending/"
+
+	// NotificationKeyPrefix is the prefix to retrieve all Notification
+	NotificationKeyPrefix = "Notification/value/"
+
+	// NotificationCountKey is the key of the number of notifications ever sent
+	NotificationCountKey = "Notification/count/"
+)
+
+// BreakGlassAccessKey returns the store key to retrieve a BreakGlassAccess from its id
+func BreakGlassAccessKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+// BreakGlassActiveKey returns the key of the active index entry of a
+// provider on the records of a patient
+func BreakGlassActiveKey(
+	patient string,
+	provider string,
+) []byte {
+	var key []byte
+
+	for _, field := range []string{patient, provider} {
+		key = append(key, []byte(field)...)
+		key = append(key, []byte("/")...)
+	}
+
+	return key
+}
+
+// BreakGlassProviderKey returns the prefix of the pending index entries of a provider
+func BreakGlassProviderKey(
+	provider string,
+) []byte {
+	var key []byte
+
+	providerBytes := []byte(provider)
+	key = append(key, providerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// BreakGlassPendingKey returns the key of the pending index entry of an access
+func BreakGlassPendingKey(
+	provider string,
+	id uint64,
+) []byte {
+	return append(BreakGlassProviderKey(provider), BreakGlassAccessKey(id)...)
+}
+
+// NotificationRecipientKey returns the prefix of the notifications of a recipient
+func NotificationRecipientKey(
+	recipient string,
+) []byte {
+	var key []byte
+
+	recipientBytes := []byte(recipient)
+	key = append(key, recipientBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// NotificationKey returns the store key to retrieve a Notification from the index fields
+func NotificationKey(
+	recipient string,
+	id uint64,
+) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(NotificationRecipientKey(recipient), idBytes...)
+}
@@ -0,0 +1,58 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ConsentKeyPrefix is the prefix to retrieve all Consent
+	ConsentKeyPrefix = "Consent/value/"
+
+	// ConsentExpiryQueuePrefix orders consents by the end of their validity
+	ConsentExpiryQueuePrefix = "Consent/expiry/"
+)
+
+// ConsentPatientKey returns the prefix of the store keys of the consents
+// granted by a patient
+func ConsentPatientKey(
+	patient string,
+) []byte {
+	var key []byte
+
+	patientBytes := []byte(patient)
+	key = append(key, patientBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ConsentKey returns the store key to retrieve a Consent from the index fields
+func ConsentKey(
+	patient string,
+	grantee string,
+	dataType string,
+	purpose string,
+) []byte {
+	key := ConsentPatientKey(patient)
+
+	for _, field := range []string{grantee, dataType, purpose} {
+		key = append(key, []byte(field)...)
+		key = append(key, []byte("/")...)
+	}
+
+	return key
+}
+
+// ConsentTimeKey returns the prefix of the expiry queue entries at unix time
+func ConsentTimeKey(
+	unix int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(unix))
+	return key
+}
+
+// ConsentExpiryKey returns the key of the expiry queue entry of a consent
+func ConsentExpiryKey(
+	consent Consent,
+) []byte {
+	return append(ConsentTimeKey(consent.ValidUntil), ConsentKey(consent.Patient, consent.Grantee, consent.DataType, consent.Purpose)...)
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// EncryptionKeyKeyPrefix is the prefix to retrieve all EncryptionKey
+	EncryptionKeyKeyPrefix = "EncryptionKey/value/"
+)
+
+// EncryptionKeyKey returns the store key to retrieve a EncryptionKey from the index fields
+func EncryptionKeyKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,31 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// RecordVersionKeyPrefix is the prefix to retrieve all RecordVersion
+	RecordVersionKeyPrefix = "RecordVersion/value/"
+)
+
+// RecordVersionRecordKey returns the prefix of the versions of a medical record
+func RecordVersionRecordKey(
+	recordId string,
+) []byte {
+	var key []byte
+
+	recordIdBytes := []byte(recordId)
+	key = append(key, recordIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RecordVersionKey returns the store key to retrieve a RecordVersion from the index fields
+func RecordVersionKey(
+	recordId string,
+	version uint64,
+) []byte {
+	versionBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(versionBytes, version)
+	return append(RecordVersionRecordKey(recordId), versionBytes...)
+}
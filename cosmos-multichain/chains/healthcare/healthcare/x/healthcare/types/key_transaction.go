@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TransactionKeyPrefix is the prefix to retrieve all Transaction
+	TransactionKeyPrefix = "Transaction/value/"
+)
+
+// TransactionKey returns the store key to retrieve a Transaction from the index fields
+func TransactionKey(
+	transactionId string,
+) []byte {
+	var key []byte
+
+	transactionIdBytes := []byte(transactionId)
+	key = append(key, transactionIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
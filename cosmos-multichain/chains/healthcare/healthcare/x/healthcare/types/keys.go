@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "healthcare"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_healthcare"
+)
+
+var (
+	ParamsKey = []byte("p_healthcare")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAmendRecord{}
+
+func NewMsgAmendRecord(
+	creator string,
+	recordId string,
+	previousHash string,
+	data []byte,
+	reason string,
+) *MsgAmendRecord {
+	return &MsgAmendRecord{
+		Creator:      creator,
+		RecordId:     recordId,
+		PreviousHash: previousHash,
+		Data:         data,
+		Reason:       reason,
+	}
+}
+
+func (msg *MsgAmendRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.RecordId == "" {
+		return errorsmod.Wrap(ErrInvalidAmendment, "record id is required")
+	}
+	if msg.PreviousHash == "" {
+		return errorsmod.Wrap(ErrInvalidAmendment, "hash of the amended version is required")
+	}
+	if msg.Reason == "" {
+		return errorsmod.Wrap(ErrInvalidAmendment, "reason is required")
+	}
+	return nil
+}
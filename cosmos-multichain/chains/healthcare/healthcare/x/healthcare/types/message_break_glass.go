@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBreakGlass{}
+
+func NewMsgBreakGlass(
+	creator string,
+	patient string,
+	justification string,
+	dataTypes []string,
+) *MsgBreakGlass {
+	return &MsgBreakGlass{
+		Creator:       creator,
+		Patient:       patient,
+		Justification: justification,
+		DataTypes:     dataTypes,
+	}
+}
+
+func (msg *MsgBreakGlass) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Patient == "" || msg.Patient == msg.Creator {
+		return errorsmod.Wrap(ErrInvalidBreakGlass, "patient must be another account")
+	}
+	if msg.Justification == "" {
+		return errorsmod.Wrap(ErrInvalidBreakGlass, "justification cannot be empty")
+	}
+	if len(msg.DataTypes) == 0 {
+		return errorsmod.Wrap(ErrInvalidBreakGlass, "the data types the emergency calls for are required")
+	}
+	for _, dataType := range msg.DataTypes {
+		if dataType == "" {
+			return errorsmod.Wrap(ErrInvalidBreakGlass, "data type cannot be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantConsent{}
+
+func NewMsgGrantConsent(
+	creator string,
+	grantee string,
+	dataType string,
+	purpose string,
+	validFrom int64,
+	validUntil int64,
+	restrictions string,
+) *MsgGrantConsent {
+	return &MsgGrantConsent{
+		Creator:      creator,
+		Grantee:      grantee,
+		DataType:     dataType,
+		Purpose:      purpose,
+		ValidFrom:    validFrom,
+		ValidUntil:   validUntil,
+		Restrictions: restrictions,
+	}
+}
+
+func (msg *MsgGrantConsent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Grantee == "" {
+		return errorsmod.Wrap(ErrInvalidConsent, "grantee cannot be empty")
+	}
+	return validateConsentScope(msg.DataType, msg.Purpose, msg.ValidFrom, msg.ValidUntil)
+}
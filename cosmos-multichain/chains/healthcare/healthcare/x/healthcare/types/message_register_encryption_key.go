@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterEncryptionKey{}
+
+func NewMsgRegisterEncryptionKey(
+	creator string,
+	publicKey []byte,
+) *MsgRegisterEncryptionKey {
+	return &MsgRegisterEncryptionKey{
+		Creator:   creator,
+		PublicKey: publicKey,
+	}
+}
+
+func (msg *MsgRegisterEncryptionKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateEncryptionKey(msg.PublicKey)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReviewBreakGlass{}
+
+func NewMsgReviewBreakGlass(
+	creator string,
+	id uint64,
+	approve bool,
+	note string,
+) *MsgReviewBreakGlass {
+	return &MsgReviewBreakGlass{
+		Creator: creator,
+		Id:      id,
+		Approve: approve,
+		Note:    note,
+	}
+}
+
+func (msg *MsgReviewBreakGlass) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
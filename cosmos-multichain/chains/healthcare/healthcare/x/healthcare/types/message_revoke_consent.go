@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeConsent{}
+
+func NewMsgRevokeConsent(
+	creator string,
+	grantee string,
+	dataType string,
+	purpose string,
+) *MsgRevokeConsent {
+	return &MsgRevokeConsent{
+		Creator:  creator,
+		Grantee:  grantee,
+		DataType: dataType,
+		Purpose:  purpose,
+	}
+}
+
+func (msg *MsgRevokeConsent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Grantee == "" {
+		return errorsmod.Wrap(ErrInvalidConsent, "grantee cannot be empty")
+	}
+	return nil
+}
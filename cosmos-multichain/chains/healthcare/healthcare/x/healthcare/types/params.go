@@ -0,0 +1,204 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyBreakGlassReviewPolicy = []byte("BreakGlassReviewPolicy")
+	// DefaultBreakGlassReviewPolicy is empty; the review group is configured by governance
+	DefaultBreakGlassReviewPolicy = ""
+)
+
+var (
+	KeyBreakGlassDuration = []byte("BreakGlassDuration")
+	// DefaultBreakGlassDuration opens the records of a patient for four hours
+	DefaultBreakGlassDuration = 4 * time.Hour
+)
+
+var (
+	KeyBreakGlassMaxUnreviewed = []byte("BreakGlassMaxUnreviewed")
+	// DefaultBreakGlassMaxUnreviewed stops a provider from breaking the glass
+	// while three of their accesses are waiting for review
+	DefaultBreakGlassMaxUnreviewed uint64 = 3
+)
+
+var (
+	KeyInsurer = []byte("Insurer")
+	// DefaultInsurer is empty; the insurer is configured by governance
+	DefaultInsurer = ""
+)
+
+var (
+	KeyCounterparties = []byte("Counterparties")
+	// DefaultCounterparties are the chains of the network the healthcare
+	// contract exchanges messages with
+	DefaultCounterparties = []Counterparty{
+		{ChainId: "bloqz-insurance-1", Chain: interchain.ChainInsurance},
+	}
+)
+
+var (
+	KeyBreakGlassProviderPolicy = []byte("BreakGlassProviderPolicy")
+	// DefaultBreakGlassProviderPolicy is empty; the provider group is configured by governance
+	DefaultBreakGlassProviderPolicy = ""
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	breakGlassReviewPolicy string,
+	breakGlassDuration time.Duration,
+	breakGlassMaxUnreviewed uint64,
+	insurer string,
+	counterparties []Counterparty,
+	breakGlassProviderPolicy string,
+) Params {
+	return Params{
+		BreakGlassReviewPolicy:   breakGlassReviewPolicy,
+		BreakGlassDuration:       breakGlassDuration,
+		BreakGlassMaxUnreviewed:  breakGlassMaxUnreviewed,
+		Insurer:                  insurer,
+		Counterparties:           counterparties,
+		BreakGlassProviderPolicy: breakGlassProviderPolicy,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultBreakGlassReviewPolicy,
+		DefaultBreakGlassDuration,
+		DefaultBreakGlassMaxUnreviewed,
+		DefaultInsurer,
+		DefaultCounterparties,
+		DefaultBreakGlassProviderPolicy,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBreakGlassReviewPolicy, &p.BreakGlassReviewPolicy, validateGroupPolicy),
+		paramtypes.NewParamSetPair(KeyBreakGlassDuration, &p.BreakGlassDuration, validateBreakGlassDuration),
+		paramtypes.NewParamSetPair(KeyBreakGlassMaxUnreviewed, &p.BreakGlassMaxUnreviewed, validateBreakGlassMaxUnreviewed),
+		paramtypes.NewParamSetPair(KeyInsurer, &p.Insurer, validateInsurer),
+		paramtypes.NewParamSetPair(KeyCounterparties, &p.Counterparties, validateCounterparties),
+		paramtypes.NewParamSetPair(KeyBreakGlassProviderPolicy, &p.BreakGlassProviderPolicy, validateGroupPolicy),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateGroupPolicy(p.BreakGlassReviewPolicy); err != nil {
+		return err
+	}
+	if err := validateBreakGlassDuration(p.BreakGlassDuration); err != nil {
+		return err
+	}
+	if err := validateBreakGlassMaxUnreviewed(p.BreakGlassMaxUnreviewed); err != nil {
+		return err
+	}
+	if err := validateInsurer(p.Insurer); err != nil {
+		return err
+	}
+	if err := validateCounterparties(p.Counterparties); err != nil {
+		return err
+	}
+	return validateGroupPolicy(p.BreakGlassProviderPolicy)
+}
+
+// CounterpartyChain returns the chain of a counterparty chain ID
+func (p Params) CounterpartyChain(chainId string) (string, bool) {
+	for _, counterparty := range p.Counterparties {
+		if counterparty.ChainId == chainId {
+			return counterparty.Chain, true
+		}
+	}
+	return "", false
+}
+
+// validateGroupPolicy validates the BreakGlassReviewPolicy and
+// BreakGlassProviderPolicy params
+func validateGroupPolicy(v interface{}) error {
+	policy, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if policy == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(policy); err != nil {
+		return fmt.Errorf("invalid group policy address %s: %w", policy, err)
+	}
+	return nil
+}
+
+// validateBreakGlassDuration validates the BreakGlassDuration param
+func validateBreakGlassDuration(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("break-glass duration must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateBreakGlassMaxUnreviewed validates the BreakGlassMaxUnreviewed param
+func validateBreakGlassMaxUnreviewed(v interface{}) error {
+	max, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if max == 0 {
+		return fmt.Errorf("break-glass max unreviewed must be positive")
+	}
+	return nil
+}
+
+// validateInsurer validates the Insurer param
+func validateInsurer(v interface{}) error {
+	insurer, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if insurer == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(insurer); err != nil {
+		return fmt.Errorf("invalid insurer address %s: %w", insurer, err)
+	}
+	return nil
+}
+
+// validateCounterparties validates the Counterparties param
+func validateCounterparties(v interface{}) error {
+	counterparties, ok := v.([]Counterparty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chainIds := make(map[string]struct{}, len(counterparties))
+	for _, counterparty := range counterparties {
+		if counterparty.ChainId == "" || counterparty.Chain == "" {
+			return fmt.Errorf("counterparty needs a chain id and a chain")
+		}
+		if _, ok := chainIds[counterparty.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty %s", counterparty.ChainId)
+		}
+		chainIds[counterparty.ChainId] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare/healthcare/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// break_glass_review_policy is the x/group policy account whose group
+	// reviews the break-glass accesses. Break-glass accesses cannot be
+	// reviewed while it is empty.
+	BreakGlassReviewPolicy string `protobuf:"bytes,1,opt,name=break_glass_review_policy,json=breakGlassReviewPolicy,proto3" json:"break_glass_review_policy,omitempty"`
+	// break_glass_duration is how long a break-glass access lasts.
+	BreakGlassDuration time.Duration `protobuf:"bytes,2,opt,name=break_glass_duration,json=breakGlassDuration,proto3,stdduration" json:"break_glass_duration"`
+	// break_glass_max_unreviewed is the number of unreviewed break-glass
+	// accesses at which a provider cannot break the glass again.
+	BreakGlassMaxUnreviewed uint64 `protobuf:"varint,3,opt,name=break_glass_max_unreviewed,json=breakGlassMaxUnreviewed,proto3" json:"break_glass_max_unreviewed,omitempty"`
+	// insurer is the address of the insurer the treatment claims are submitted
+	// to. Patients consent to it for the insurance-claim purpose. Treatments
+	// cannot be claimed while it is empty.
+	Insurer string `protobuf:"bytes,4,opt,name=insurer,proto3" json:"insurer,omitempty"`
+	// counterparties are the chains allowed to open interchain channels with
+	// the healthcare contract.
+	Counterparties []Counterparty `protobuf:"bytes,5,rep,name=counterparties,proto3" json:"counterparties"`
+	// break_glass_provider_policy is the x/group policy account whose group
+	// members are the providers allowed to break the glass. A member is the
+	// provider named by its metadata, like a license number, or by its
+	// address when it has none. Nobody breaks the glass while it is empty.
+	BreakGlassProviderPolicy string `protobuf:"bytes,6,opt,name=break_glass_provider_policy,json=breakGlassProviderPolicy,proto3" json:"break_glass_provider_policy,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cce33039f077371, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBreakGlassReviewPolicy() string {
+	if m != nil {
+		return m.BreakGlassReviewPolicy
+	}
+	return ""
+}
+
+func (m *Params) GetBreakGlassDuration() time.Duration {
+	if m != nil {
+		return m.BreakGlassDuration
+	}
+	return 0
+}
+
+func (m *Params) GetBreakGlassMaxUnreviewed() uint64 {
+	if m != nil {
+		return m.BreakGlassMaxUnreviewed
+	}
+	return 0
+}
+
+func (m *Params) GetInsurer() string {
+	if m != nil {
+		return m.Insurer
+	}
+	return ""
+}
+
+func (m *Params) GetCounterparties() []Counterparty {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
+}
+
+func (m *Params) GetBreakGlassProviderPolicy() string {
+	if m != nil {
+		return m.BreakGlassProviderPolicy
+	}
+	return ""
+}
+
+// Counterparty maps the chain ID of a counterparty to the chain its messages
+// are routed for.
+type Counterparty struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Chain   string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *Counterparty) Reset()         { *m = Counterparty{} }
+func (m *Counterparty) String() string { return proto.CompactTextString(m) }
+func (*Counterparty) ProtoMessage()    {}
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cce33039f077371, []int{1}
+}
+func (m *Counterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Counterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Counterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Counterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Counterparty.Merge(m, src)
+}
+func (m *Counterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Counterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Counterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Counterparty proto.InternalMessageInfo
+
+func (m *Counterparty) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Counterparty) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "healthcare.healthcare.Params")
+	proto.RegisterType((*Counterparty)(nil), "healthcare.healthcare.Counterparty")
+}
+
+func init() {
+	proto.RegisterFile("healthcare/healthcare/params.proto", fileDescriptor_5cce33039f077371)
+}
+
+var fileDescriptor_5cce33039f077371 = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0x34, 0x25, 0x57, 0x40, 0xe2, 0x14, 0xc0, 0x09, 0xe2, 0x62, 0x85, 0x01, 0xab,
+	0x12, 0xb6, 0x14, 0x06, 0xa4, 0x32, 0x61, 0x40, 0x88, 0x01, 0x29, 0x72, 0x05, 0x48, 0x5d, 0xac,
+	0x8b, 0x7d, 0x38, 0x27, 0x6c, 0x9f, 0x75, 0x67, 0x97, 0x64, 0x67, 0x62, 0x62, 0x64, 0x64, 0x64,
+	0xec, 0xc0, 0x8f, 0xe8, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x33, 0x90, 0xef, 0x6c, 0x6c,
+	0x21, 0x50, 0x17, 0xeb, 0x3d, 0xbf, 0xef, 0x7b, 0xef, 0xfb, 0xee, 0x3d, 0x38, 0x59, 0x50, 0x12,
+	0xe7, 0x8b, 0x80, 0x08, 0xea, 0xb4, 0xc2, 0x8c, 0x08, 0x92, 0x48, 0x3b, 0x13, 0x3c, 0xe7, 0xe8,
+	0x5a, 0x53, 0xb0, 0x9b, 0x70, 0x74, 0x95, 0x24, 0x2c, 0xe5, 0x8e, 0xfa, 0x6a, 0xe4, 0x68, 0x18,
+	0x70, 0x99, 0x70, 0xe9, 0xab, 0xcc, 0xd1, 0x49, 0x55, 0x1a, 0x44, 0x3c, 0xe2, 0xfa, 0x7f, 0x19,
+	0x55, 0x7f, 0x71, 0xc4, 0x79, 0x14, 0x53, 0x47, 0x65, 0xf3, 0xe2, 0xb5, 0x13, 0x16, 0x82, 0xe4,
+	0x8c, 0xa7, 0xba, 0x3e, 0x79, 0xd7, 0x85, 0xbd, 0x99, 0xd2, 0x82, 0x0e, 0xe0, 0x70, 0x2e, 0x28,
+	0x79, 0xe3, 0x47, 0x31, 0x91, 0xd2, 0x17, 0xf4, 0x88, 0xd1, 0xb7, 0x7e, 0xc6, 0x63, 0x16, 0xac,
+	0x0c, 0x60, 0x02, 0xab, 0xef, 0x1a, 0x5f, 0xbf, 0xdc, 0x1d, 0x54, 0x53, 0x1f, 0x86, 0xa1, 0xa0,
+	0x52, 0x1e, 0xe4, 0x82, 0xa5, 0x91, 0x77, 0x5d, 0x51, 0x9f, 0x96, 0x4c, 0x4f, 0x11, 0x67, 0x8a,
+	0x87, 0x0e, 0xe1, 0xa0, 0xdd, 0xb4, 0x9e, 0x6e, 0x5c, 0x30, 0x81, 0xb5, 0x3b, 0x1d, 0xda, 0x5a,
+	0x9e, 0x5d, 0xcb, 0xb3, 0x1f, 0x57, 0x00, 0xf7, 0xf2, 0xc9, 0xf7, 0x71, 0xe7, 0xe3, 0x8f, 0x31,
+	0xf8, 0x7c, 0x76, 0xbc, 0x07, 0x3c, 0xd4, 0xf4, 0xaf, 0x21, 0xe8, 0x01, 0x1c, 0xb5, 0x7b, 0x27,
+	0x64, 0xe9, 0x17, 0xa9, 0x96, 0x4d, 0x43, 0x63, 0xcb, 0x04, 0x56, 0xd7, 0xbb, 0xd1, 0xf0, 0x9e,
+	0x93, 0xe5, 0x8b, 0x3f, 0x65, 0x34, 0x85, 0x3b, 0x2c, 0x95, 0x85, 0xa0, 0xc2, 0xe8, 0x9e, 0xe3,
+	0xad, 0x06, 0xa2, 0x97, 0xf0, 0x4a, 0xc0, 0x8b, 0x34, 0xa7, 0x22, 0x23, 0x22, 0x67, 0x54, 0x1a,
+	0xdb, 0xe6, 0x96, 0xb5, 0x3b, 0xbd, 0x6d, 0xff, 0x73, 0x81, 0xf6, 0xa3, 0x06, 0xbc, 0x72, 0xfb,
+	0xa5, 0x21, 0x6d, 0xe6, 0xaf, 0x2e, 0xe8, 0x15, 0xbc, 0xd9, 0x36, 0x92, 0x09, 0x7e, 0xc4, 0x42,
+	0x2a, 0xea, 0xb7, 0xef, 0x9d, 0xa3, 0xcf, 0x68, 0x3c, 0xce, 0x2a, 0xaa, 0x7e, 0xfd, 0xfd, 0x3b,
+	0xbf, 0x3e, 0x8d, 0xc1, 0xfb, 0xb3, 0xe3, 0x3d, 0xdc, 0x3a, 0xbd, 0x65, 0xfb, 0x0e, 0xf5, 0xee,
+	0x27, 0x4f, 0xe0, 0xa5, 0xb6, 0x58, 0x34, 0x84, 0x17, 0x83, 0x05, 0x61, 0xa9, 0xcf, 0x42, 0xbd,
+	0x7a, 0x6f, 0x47, 0xe5, 0xcf, 0x42, 0x34, 0x80, 0xdb, 0x2a, 0x54, 0x2b, 0xec, 0x7b, 0x3a, 0xd9,
+	0xef, 0x96, 0x93, 0xdc, 0xfb, 0x27, 0x6b, 0x0c, 0x4e, 0xd7, 0x18, 0xfc, 0x5c, 0x63, 0xf0, 0x61,
+	0x83, 0x3b, 0xa7, 0x1b, 0xdc, 0xf9, 0xb6, 0xc1, 0x9d, 0xc3, 0x5b, 0xff, 0x13, 0x90, 0xaf, 0x32,
+	0x2a, 0xe7, 0x3d, 0x75, 0x00, 0xf7, 0x7e, 0x0f, 0x00, 0x11, 0x8d, 0x67, 0xf5, 0x2e, 0x03, 0x00,
+	0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BreakGlassReviewPolicy != that1.BreakGlassReviewPolicy {
+		return false
+	}
+	if this.BreakGlassDuration != that1.BreakGlassDuration {
+		return false
+	}
+	if this.BreakGlassMaxUnreviewed != that1.BreakGlassMaxUnreviewed {
+		return false
+	}
+	if this.Insurer != that1.Insurer {
+		return false
+	}
+	if len(this.Counterparties) != len(that1.Counterparties) {
+		return false
+	}
+	for i := range this.Counterparties {
+		if !this.Counterparties[i].Equal(&that1.Counterparties[i]) {
+			return false
+		}
+	}
+	if this.BreakGlassProviderPolicy != that1.BreakGlassProviderPolicy {
+		return false
+	}
+	return true
+}
+func (this *Counterparty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Counterparty)
+	if !ok {
+		that2, ok := that.(Counterparty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if this.Chain != that1.Chain {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BreakGlassProviderPolicy) > 0 {
+		i -= len(m.BreakGlassProviderPolicy)
+		copy(dAtA[i:], m.BreakGlassProviderPolicy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BreakGlassProviderPolicy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Counterparties) > 0 {
+		for iNdEx := len(m.Counterparties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counterparties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Insurer) > 0 {
+		i -= len(m.Insurer)
+		copy(dAtA[i:], m.Insurer)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Insurer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BreakGlassMaxUnreviewed != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BreakGlassMaxUnreviewed))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BreakGlassDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BreakGlassDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.BreakGlassReviewPolicy) > 0 {
+		i -= len(m.BreakGlassReviewPolicy)
+		copy(dAtA[i:], m.BreakGlassReviewPolicy)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BreakGlassReviewPolicy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Counterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BreakGlassReviewPolicy)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BreakGlassDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BreakGlassMaxUnreviewed != 0 {
+		n += 1 + sovParams(uint64(m.BreakGlassMaxUnreviewed))
+	}
+	l = len(m.Insurer)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Counterparties) > 0 {
+		for _, e := range m.Counterparties {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.BreakGlassProviderPolicy)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassReviewPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BreakGlassReviewPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BreakGlassDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassMaxUnreviewed", wireType)
+			}
+			m.BreakGlassMaxUnreviewed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BreakGlassMaxUnreviewed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insurer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Insurer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparties = append(m.Counterparties, Counterparty{})
+			if err := m.Counterparties[len(m.Counterparties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlassProviderPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BreakGlassProviderPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// NotificationKindRecordAmended is the kind of the notification a patient
+// gets when one of their medical records is amended
+const NotificationKindRecordAmended = "record_amended"
+
+// DiffRecordVersions returns the metadata fields of a medical record changed
+// between two of its versions. The data is compared by its SHA-256 hash.
+func DiffRecordVersions(from, to RecordVersion) []MetadataChange {
+	var changes []MetadataChange
+	for _, field := range []struct {
+		name     string
+		from, to string
+	}{
+		{"provider", from.Provider, to.Provider},
+		{"record_type", from.RecordType, to.RecordType},
+		{"status", from.Status, to.Status},
+		{"access_control", strings.Join(from.AccessControl, ","), strings.Join(to.AccessControl, ",")},
+		{"data", hashData(from.Data), hashData(to.Data)},
+	} {
+		if field.from != field.to {
+			changes = append(changes, MetadataChange{Field: field.name, From: field.from, To: field.to})
+		}
+	}
+	return changes
+}
+
+func hashData(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateRecordHistory checks the versions of every medical record are
+// numbered from one and linked to the version before them by its data hash
+func ValidateRecordHistory(versions []RecordVersion) error {
+	byKey := make(map[string]RecordVersion, len(versions))
+	for _, elem := range versions {
+		index := string(RecordVersionKey(elem.RecordId, elem.Version))
+		if _, ok := byKey[index]; ok {
+			return fmt.Errorf("duplicated index for recordVersion")
+		}
+		byKey[index] = elem
+	}
+
+	for _, elem := range versions {
+		switch {
+		case elem.Version == 0:
+			return fmt.Errorf("record %s has a version 0", elem.RecordId)
+		case elem.DataHash == "":
+			return fmt.Errorf("version %d of record %s has no data hash", elem.Version, elem.RecordId)
+		case elem.Version == 1:
+			if elem.PreviousHash != "" {
+				return fmt.Errorf("first version of record %s has a previous hash", elem.RecordId)
+			}
+		default:
+			previous, ok := byKey[string(RecordVersionKey(elem.RecordId, elem.Version-1))]
+			if !ok {
+				return fmt.Errorf("version %d of record %s is missing", elem.Version-1, elem.RecordId)
+			}
+			if elem.PreviousHash != previous.DataHash {
+				return fmt.Errorf("version %d of record %s is not linked to version %d", elem.Version, elem.RecordId, previous.Version)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"healthcare/x/laboratory/types"
+)
+
+// SetLabOrder set a specific labOrder in the store from its index
+func (k Keeper) SetLabOrder(ctx context.Context, labOrder types.LabOrder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LabOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&labOrder)
+	store.Set(types.LabOrderKey(
+		labOrder.OrderId,
+	), b)
+}
+
+// GetLabOrder returns a labOrder from its index
+func (k Keeper) GetLabOrder(
+	ctx context.Context,
+	orderId string,
+) (val types.LabOrder, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LabOrderKeyPrefix))
+
+	b := store.Get(types.LabOrderKey(
+		orderId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllLabOrder returns all labOrder
+func (k Keeper) GetAllLabOrder(ctx context.Context) (list []types.LabOrder) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LabOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LabOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcare/x/laboratory/types"
+)
+
+// SubmitLabOrder records an ORDERED lab test received from the healthcare
+// contract
+func (k Keeper) SubmitLabOrder(ctx context.Context, order types.LabOrder) error {
+	if order.OrderId == "" {
+		return errorsmod.Wrap(types.ErrInvalidLabOrder, "order ID is required")
+	}
+	if order.Patient == "" || order.Orderer == "" {
+		return errorsmod.Wrap(types.ErrInvalidLabOrder, "patient and orderer are required")
+	}
+	if _, found := k.GetLabOrder(ctx, order.OrderId); found {
+		return errorsmod.Wrap(types.ErrLabOrderExists, order.OrderId)
+	}
+
+	order.Status = types.LabOrderStatusOrdered
+	order.OrderedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	order.Laboratory = ""
+	order.Result = nil
+	order.ResultedAt = 0
+	k.SetLabOrder(ctx, order)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLabOrderReceived,
+			sdk.NewAttribute(types.AttributeKeyOrderId, order.OrderId),
+			sdk.NewAttribute(types.AttributeKeyPatient, order.Patient),
+			sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+		),
+	)
+	return nil
+}
+
+// RecordResult records the result of an ordered lab test by laboratory, and
+// queues it for the healthcare contract. The result of an order is recorded
+// once, and never by its orderer or patient.
+func (k Keeper) RecordResult(ctx context.Context, laboratory string, orderId string, result []byte) error {
+	if len(result) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidLabOrder, "result is required")
+	}
+	order, found := k.GetLabOrder(ctx, orderId)
+	if !found {
+		return errorsmod.Wrap(types.ErrLabOrderNotFound, orderId)
+	}
+	if order.Status != types.LabOrderStatusOrdered {
+		return errorsmod.Wrapf(types.ErrAlreadyResulted, "lab order %s was resulted by %s", orderId, order.Laboratory)
+	}
+	if laboratory == order.Orderer || laboratory == order.Patient {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot result their own lab order", laboratory)
+	}
+
+	order.Status = types.LabOrderStatusResulted
+	order.Laboratory = laboratory
+	order.Result = result
+	order.ResultedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	k.SetLabOrder(ctx, order)
+
+	data, err := json.Marshal(order)
+	if err != nil {
+		return err
+	}
+	k.AppendCallback(ctx, types.CallbackLabResult, data)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeLabOrderResulted,
+			sdk.NewAttribute(types.AttributeKeyOrderId, order.OrderId),
+			sdk.NewAttribute(types.AttributeKeyPatient, order.Patient),
+			sdk.NewAttribute(types.AttributeKeyLaboratory, laboratory),
+		),
+	)
+	return nil
+}
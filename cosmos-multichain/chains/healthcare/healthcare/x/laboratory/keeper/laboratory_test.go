@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/laboratory/keeper"
+	"healthcare/x/laboratory/types"
+)
+
+func TestRecordResult(t *testing.T) {
+	k, ctx := keepertest.LaboratoryKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, orderer, lab := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	order := types.LabOrder{OrderId: "lab-1", Patient: patient, Orderer: orderer, DataHash: "hash"}
+	require.NoError(t, k.SubmitLabOrder(ctx, order))
+	require.ErrorIs(t, k.SubmitLabOrder(ctx, order), types.ErrLabOrderExists)
+	stored, found := k.GetLabOrder(ctx, "lab-1")
+	require.True(t, found)
+	require.Equal(t, types.LabOrderStatusOrdered, stored.Status)
+
+	_, err := srv.RecordResult(ctx, types.NewMsgRecordResult(lab, "lab-2", []byte("negative")))
+	require.ErrorIs(t, err, types.ErrLabOrderNotFound)
+	_, err = srv.RecordResult(ctx, types.NewMsgRecordResult(orderer, "lab-1", []byte("negative")))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RecordResult(ctx, types.NewMsgRecordResult(lab, "lab-1", []byte("negative")))
+	require.NoError(t, err)
+	stored, _ = k.GetLabOrder(ctx, "lab-1")
+	require.Equal(t, types.LabOrderStatusResulted, stored.Status)
+	require.Equal(t, []byte("negative"), stored.Result)
+
+	_, err = srv.RecordResult(ctx, types.NewMsgRecordResult(lab, "lab-1", []byte("positive")))
+	require.ErrorIs(t, err, types.ErrAlreadyResulted)
+
+	callbacks := k.GetAllCallback(ctx)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, types.CallbackLabResult, callbacks[0].MessageType)
+	var resulted types.LabOrder
+	require.NoError(t, json.Unmarshal(callbacks[0].Data, &resulted))
+	require.Equal(t, stored, resulted)
+}
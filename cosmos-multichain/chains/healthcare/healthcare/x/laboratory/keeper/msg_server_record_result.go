@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"healthcare/x/laboratory/types"
+)
+
+func (k msgServer) RecordResult(goCtx context.Context, msg *types.MsgRecordResult) (*types.MsgRecordResultResponse, error) {
+	if err := k.Keeper.RecordResult(goCtx, msg.Creator, msg.OrderId, msg.Result); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecordResultResponse{}, nil
+}
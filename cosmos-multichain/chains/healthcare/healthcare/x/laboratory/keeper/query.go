@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"healthcare/x/laboratory/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/laboratory/types"
+)
+
+func (k Keeper) LabOrderAll(ctx context.Context, req *types.QueryAllLabOrderRequest) (*types.QueryAllLabOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var labOrders []types.LabOrder
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	labOrderStore := prefix.NewStore(store, types.KeyPrefix(types.LabOrderKeyPrefix))
+
+	pageRes, err := query.Paginate(labOrderStore, req.Pagination, func(key []byte, value []byte) error {
+		var labOrder types.LabOrder
+		if err := k.cdc.Unmarshal(value, &labOrder); err != nil {
+			return err
+		}
+
+		labOrders = append(labOrders, labOrder)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLabOrderResponse{LabOrder: labOrders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) LabOrder(ctx context.Context, req *types.QueryGetLabOrderRequest) (*types.QueryGetLabOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetLabOrder(
+		ctx,
+		req.OrderId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetLabOrderResponse{LabOrder: val}, nil
+}
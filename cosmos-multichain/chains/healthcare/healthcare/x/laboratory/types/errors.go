@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/laboratory module sentinel errors
+var (
+	ErrInvalidSigner    = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidLabOrder  = sdkerrors.Register(ModuleName, 1101, "invalid lab order")
+	ErrLabOrderExists   = sdkerrors.Register(ModuleName, 1102, "lab order already exists")
+	ErrLabOrderNotFound = sdkerrors.Register(ModuleName, 1103, "lab order not found")
+	ErrAlreadyResulted  = sdkerrors.Register(ModuleName, 1104, "lab order already resulted")
+)
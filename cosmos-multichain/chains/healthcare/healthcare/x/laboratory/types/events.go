@@ -0,0 +1,12 @@
+package types
+
+// laboratory module event types
+const (
+	EventTypeLabOrderReceived = "lab_order_received"
+	EventTypeLabOrderResulted = "lab_order_resulted"
+
+	AttributeKeyOrderId    = "order_id"
+	AttributeKeyPatient    = "patient"
+	AttributeKeyOrderer    = "orderer"
+	AttributeKeyLaboratory = "laboratory"
+)
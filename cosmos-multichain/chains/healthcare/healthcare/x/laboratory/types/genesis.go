@@ -0,0 +1,47 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		LabOrderList: []LabOrder{},
+		CallbackList: []Callback{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in labOrder
+	labOrderIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.LabOrderList {
+		index := string(LabOrderKey(elem.OrderId))
+		if _, ok := labOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for labOrder")
+		}
+		labOrderIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in callback
+	callbackIdMap := make(map[uint64]bool)
+	for _, elem := range gs.CallbackList {
+		if _, ok := callbackIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for callback")
+		}
+		if elem.Id >= gs.CallbackCount {
+			return fmt.Errorf("callback id should be lower or equal than the last id")
+		}
+		callbackIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
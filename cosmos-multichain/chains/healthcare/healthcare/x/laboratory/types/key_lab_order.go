@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// LabOrderKeyPrefix is the prefix to retrieve all LabOrder
+	LabOrderKeyPrefix = "LabOrder/value/"
+
+	// CallbackKeyPrefix is the prefix to retrieve all Callback
+	CallbackKeyPrefix = "Callback/value/"
+
+	// CallbackCountKey is the key of the number of callbacks ever queued
+	CallbackCountKey = "Callback/count/"
+)
+
+// LabOrderKey returns the store key to retrieve a LabOrder from the index fields
+func LabOrderKey(
+	orderId string,
+) []byte {
+	var key []byte
+
+	orderIdBytes := []byte(orderId)
+	key = append(key, orderIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CallbackKey returns the store key to retrieve a Callback from its id
+func CallbackKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
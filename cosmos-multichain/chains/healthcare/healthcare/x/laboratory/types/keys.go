@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "laboratory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_laboratory"
+)
+
+var (
+	ParamsKey = []byte("p_laboratory")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,12 @@
+package types
+
+// Lab order statuses. A lab order is received ORDERED and is RESULTED once
+// its result is recorded.
+const (
+	LabOrderStatusOrdered  = "ORDERED"
+	LabOrderStatusResulted = "RESULTED"
+)
+
+// CallbackLabResult is the message type of the callback reporting the result
+// of a lab order to the healthcare contract
+const CallbackLabResult = "LAB_RESULT"
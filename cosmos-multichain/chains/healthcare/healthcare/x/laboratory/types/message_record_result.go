@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRecordResult{}
+
+func NewMsgRecordResult(creator string, orderId string, result []byte) *MsgRecordResult {
+	return &MsgRecordResult{
+		Creator: creator,
+		OrderId: orderId,
+		Result:  result,
+	}
+}
+
+func (msg *MsgRecordResult) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.OrderId == "" {
+		return errorsmod.Wrap(ErrInvalidLabOrder, "order id cannot be empty")
+	}
+	if len(msg.Result) == 0 {
+		return errorsmod.Wrap(ErrInvalidLabOrder, "result cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"healthcare/x/pharmacy/types"
+)
+
+// GetCallbackCount get the total number of callback
+func (k Keeper) GetCallbackCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CallbackCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCallbackCount set the total number of callback
+func (k Keeper) SetCallbackCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CallbackCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendCallback queues a callback to the healthcare contract
+func (k Keeper) AppendCallback(ctx context.Context, messageType string, data []byte) uint64 {
+	count := k.GetCallbackCount(ctx)
+	k.SetCallback(ctx, types.Callback{
+		Id:          count,
+		MessageType: messageType,
+		Data:        data,
+	})
+	k.SetCallbackCount(ctx, count+1)
+	return count
+}
+
+// SetCallback set a specific callback in the store
+func (k Keeper) SetCallback(ctx context.Context, callback types.Callback) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CallbackKeyPrefix))
+	b := k.cdc.MustMarshal(&callback)
+	store.Set(types.CallbackKey(callback.Id), b)
+}
+
+// RemoveCallback removes a callback from the store
+func (k Keeper) RemoveCallback(ctx context.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CallbackKeyPrefix))
+	store.Delete(types.CallbackKey(id))
+}
+
+// GetAllCallback returns all callback in the order they were queued
+func (k Keeper) GetAllCallback(ctx context.Context) (list []types.Callback) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CallbackKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Callback
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
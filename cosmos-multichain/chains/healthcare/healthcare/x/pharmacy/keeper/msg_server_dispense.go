@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"healthcare/x/pharmacy/types"
+)
+
+func (k msgServer) Dispense(goCtx context.Context, msg *types.MsgDispense) (*types.MsgDispenseResponse, error) {
+	if err := k.Keeper.Dispense(goCtx, msg.Creator, msg.PrescriptionId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDispenseResponse{}, nil
+}
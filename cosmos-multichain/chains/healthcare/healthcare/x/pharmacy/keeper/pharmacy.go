@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"healthcare/x/pharmacy/types"
+)
+
+// SubmitPrescription records a PENDING e-prescription received from the
+// healthcare contract. It can be dispensed until the prescription validity
+// elapses.
+func (k Keeper) SubmitPrescription(ctx context.Context, prescription types.Prescription) error {
+	if prescription.PrescriptionId == "" {
+		return errorsmod.Wrap(types.ErrInvalidPrescription, "prescription ID is required")
+	}
+	if prescription.Patient == "" || prescription.Prescriber == "" {
+		return errorsmod.Wrap(types.ErrInvalidPrescription, "patient and prescriber are required")
+	}
+	if _, found := k.GetPrescription(ctx, prescription.PrescriptionId); found {
+		return errorsmod.Wrap(types.ErrPrescriptionExists, prescription.PrescriptionId)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	prescription.Status = types.PrescriptionStatusPending
+	prescription.IssuedAt = now.Unix()
+	prescription.ExpiresAt = now.Add(k.GetParams(ctx).PrescriptionValidity).Unix()
+	prescription.Pharmacist = ""
+	prescription.DispensedAt = 0
+	k.SetPrescription(ctx, prescription)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePrescriptionReceived,
+			sdk.NewAttribute(types.AttributeKeyPrescriptionId, prescription.PrescriptionId),
+			sdk.NewAttribute(types.AttributeKeyPatient, prescription.Patient),
+			sdk.NewAttribute(types.AttributeKeyPrescriber, prescription.Prescriber),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, strconv.FormatInt(prescription.ExpiresAt, 10)),
+		),
+	)
+	return nil
+}
+
+// Dispense records that pharmacist dispensed a pending prescription, and
+// queues the dispensing for the healthcare contract. A prescription is
+// dispensed at most once, before it expires, and never by its prescriber or
+// patient.
+func (k Keeper) Dispense(ctx context.Context, pharmacist string, prescriptionId string) error {
+	prescription, found := k.GetPrescription(ctx, prescriptionId)
+	if !found {
+		return errorsmod.Wrap(types.ErrPrescriptionNotFound, prescriptionId)
+	}
+	if prescription.Status != types.PrescriptionStatusPending {
+		return errorsmod.Wrapf(types.ErrAlreadyDispensed, "prescription %s was dispensed by %s", prescriptionId, prescription.Pharmacist)
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if now >= prescription.ExpiresAt {
+		return errorsmod.Wrapf(types.ErrPrescriptionExpired, "prescription %s expired at %d", prescriptionId, prescription.ExpiresAt)
+	}
+	if pharmacist == prescription.Prescriber || pharmacist == prescription.Patient {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot dispense their own prescription", pharmacist)
+	}
+
+	prescription.Status = types.PrescriptionStatusDispensed
+	prescription.Pharmacist = pharmacist
+	prescription.DispensedAt = now
+	k.SetPrescription(ctx, prescription)
+
+	data, err := json.Marshal(prescription)
+	if err != nil {
+		return err
+	}
+	k.AppendCallback(ctx, types.CallbackDispensed, data)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePrescriptionDispensed,
+			sdk.NewAttribute(types.AttributeKeyPrescriptionId, prescription.PrescriptionId),
+			sdk.NewAttribute(types.AttributeKeyPatient, prescription.Patient),
+			sdk.NewAttribute(types.AttributeKeyPharmacist, pharmacist),
+		),
+	)
+	return nil
+}
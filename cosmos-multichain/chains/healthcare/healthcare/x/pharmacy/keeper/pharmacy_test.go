@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "healthcare/testutil/keeper"
+	"healthcare/testutil/sample"
+	"healthcare/x/pharmacy/keeper"
+	"healthcare/x/pharmacy/types"
+)
+
+func prescription(id, patient, prescriber string) types.Prescription {
+	return types.Prescription{
+		PrescriptionId: id,
+		Patient:        patient,
+		Prescriber:     prescriber,
+		DataHash:       "hash",
+	}
+}
+
+func TestSubmitPrescription(t *testing.T) {
+	k, ctx := keepertest.PharmacyKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	patient, prescriber := sample.AccAddress(), sample.AccAddress()
+
+	require.NoError(t, k.SubmitPrescription(ctx, prescription("rx-1", patient, prescriber)))
+	stored, found := k.GetPrescription(ctx, "rx-1")
+	require.True(t, found)
+	require.Equal(t, types.PrescriptionStatusPending, stored.Status)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultPrescriptionValidity).Unix(), stored.ExpiresAt)
+
+	require.ErrorIs(t, k.SubmitPrescription(ctx, prescription("rx-1", patient, prescriber)), types.ErrPrescriptionExists)
+	require.ErrorIs(t, k.SubmitPrescription(ctx, prescription("", patient, prescriber)), types.ErrInvalidPrescription)
+	require.ErrorIs(t, k.SubmitPrescription(ctx, prescription("rx-2", "", prescriber)), types.ErrInvalidPrescription)
+}
+
+func TestDispense(t *testing.T) {
+	k, ctx := keepertest.PharmacyKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	patient, prescriber, pharmacist, other := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, k.SubmitPrescription(ctx, prescription("rx-1", patient, prescriber)))
+
+	_, err := srv.Dispense(ctx, types.NewMsgDispense(pharmacist, "rx-2"))
+	require.ErrorIs(t, err, types.ErrPrescriptionNotFound)
+	_, err = srv.Dispense(ctx, types.NewMsgDispense(prescriber, "rx-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.Dispense(ctx, types.NewMsgDispense(patient, "rx-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.Dispense(ctx, types.NewMsgDispense(pharmacist, "rx-1"))
+	require.NoError(t, err)
+	stored, _ := k.GetPrescription(ctx, "rx-1")
+	require.Equal(t, types.PrescriptionStatusDispensed, stored.Status)
+	require.Equal(t, pharmacist, stored.Pharmacist)
+
+	// A prescription is dispensed once
+	_, err = srv.Dispense(ctx, types.NewMsgDispense(pharmacist, "rx-1"))
+	require.ErrorIs(t, err, types.ErrAlreadyDispensed)
+	_, err = srv.Dispense(ctx, types.NewMsgDispense(other, "rx-1"))
+	require.ErrorIs(t, err, types.ErrAlreadyDispensed)
+
+	callbacks := k.GetAllCallback(ctx)
+	require.Len(t, callbacks, 1)
+	require.Equal(t, types.CallbackDispensed, callbacks[0].MessageType)
+	var dispensed types.Prescription
+	require.NoError(t, json.Unmarshal(callbacks[0].Data, &dispensed))
+	require.Equal(t, stored, dispensed)
+}
+
+func TestDispenseExpired(t *testing.T) {
+	k, ctx := keepertest.PharmacyKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	patient, prescriber, pharmacist := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, k.SubmitPrescription(ctx, prescription("rx-1", patient, prescriber)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultPrescriptionValidity))
+	require.ErrorIs(t, k.Dispense(ctx, pharmacist, "rx-1"), types.ErrPrescriptionExpired)
+	require.Empty(t, k.GetAllCallback(ctx))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"healthcare/x/pharmacy/types"
+)
+
+// SetPrescription set a specific prescription in the store from its index
+func (k Keeper) SetPrescription(ctx context.Context, prescription types.Prescription) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PrescriptionKeyPrefix))
+	b := k.cdc.MustMarshal(&prescription)
+	store.Set(types.PrescriptionKey(
+		prescription.PrescriptionId,
+	), b)
+}
+
+// GetPrescription returns a prescription from its index
+func (k Keeper) GetPrescription(
+	ctx context.Context,
+	prescriptionId string,
+) (val types.Prescription, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PrescriptionKeyPrefix))
+
+	b := store.Get(types.PrescriptionKey(
+		prescriptionId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPrescription returns all prescription
+func (k Keeper) GetAllPrescription(ctx context.Context) (list []types.Prescription) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PrescriptionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Prescription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
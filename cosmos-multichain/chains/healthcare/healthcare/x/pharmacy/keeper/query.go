@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"healthcare/x/pharmacy/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"healthcare/x/pharmacy/types"
+)
+
+func (k Keeper) PrescriptionAll(ctx context.Context, req *types.QueryAllPrescriptionRequest) (*types.QueryAllPrescriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var prescriptions []types.Prescription
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prescriptionStore := prefix.NewStore(store, types.KeyPrefix(types.PrescriptionKeyPrefix))
+
+	pageRes, err := query.Paginate(prescriptionStore, req.Pagination, func(key []byte, value []byte) error {
+		var prescription types.Prescription
+		if err := k.cdc.Unmarshal(value, &prescription); err != nil {
+			return err
+		}
+
+		prescriptions = append(prescriptions, prescription)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPrescriptionResponse{Prescription: prescriptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Prescription(ctx context.Context, req *types.QueryGetPrescriptionRequest) (*types.QueryGetPrescriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPrescription(
+		ctx,
+		req.PrescriptionId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPrescriptionResponse{Prescription: val}, nil
+}
@@ -0,0 +1,52 @@
+package pharmacy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "healthcare/api/healthcare/pharmacy"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "PrescriptionAll",
+					Use:       "list-prescription",
+					Short:     "List all Prescription",
+				},
+				{
+					RpcMethod:      "Prescription",
+					Use:            "show-prescription [prescription-id]",
+					Short:          "Shows a Prescription",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "prescription_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Dispense",
+					Use:            "dispense [prescription-id]",
+					Short:          "Record the dispensing of a pending prescription",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "prescription_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
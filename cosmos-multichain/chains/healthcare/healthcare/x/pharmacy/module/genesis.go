@@ -0,0 +1,40 @@
+package pharmacy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"healthcare/x/pharmacy/keeper"
+	"healthcare/x/pharmacy/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the prescription
+	for _, elem := range genState.PrescriptionList {
+		k.SetPrescription(ctx, elem)
+	}
+	// Set all the callback
+	for _, elem := range genState.CallbackList {
+		k.SetCallback(ctx, elem)
+	}
+
+	// Set callback count
+	k.SetCallbackCount(ctx, genState.CallbackCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.PrescriptionList = k.GetAllPrescription(ctx)
+	genesis.CallbackList = k.GetAllCallback(ctx)
+	genesis.CallbackCount = k.GetCallbackCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/pharmacy module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidPrescription  = sdkerrors.Register(ModuleName, 1101, "invalid prescription")
+	ErrPrescriptionExists   = sdkerrors.Register(ModuleName, 1102, "prescription already exists")
+	ErrPrescriptionNotFound = sdkerrors.Register(ModuleName, 1103, "prescription not found")
+	ErrAlreadyDispensed     = sdkerrors.Register(ModuleName, 1104, "prescription already dispensed")
+	ErrPrescriptionExpired  = sdkerrors.Register(ModuleName, 1105, "prescription expired")
+)
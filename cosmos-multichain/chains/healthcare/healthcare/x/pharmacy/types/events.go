@@ -0,0 +1,13 @@
+package types
+
+// pharmacy module event types
+const (
+	EventTypePrescriptionReceived  = "prescription_received"
+	EventTypePrescriptionDispensed = "prescription_dispensed"
+
+	AttributeKeyPrescriptionId = "prescription_id"
+	AttributeKeyPatient        = "patient"
+	AttributeKeyPrescriber     = "prescriber"
+	AttributeKeyPharmacist     = "pharmacist"
+	AttributeKeyExpiresAt      = "expires_at"
+)
@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// PrescriptionKeyPrefix is the prefix to retrieve all Prescription
+	PrescriptionKeyPrefix = "Prescription/value/"
+
+	// CallbackKeyPrefix is the prefix to retrieve all Callback
+	CallbackKeyPrefix = "Callback/value/"
+
+	// CallbackCountKey is the key of the number of callbacks ever queued
+	CallbackCountKey = "Callback/count/"
+)
+
+// PrescriptionKey returns the store key to retrieve a Prescription from the index fields
+func PrescriptionKey(
+	prescriptionId string,
+) []byte {
+	var key []byte
+
+	prescriptionIdBytes := []byte(prescriptionId)
+	key = append(key, prescriptionIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CallbackKey returns the store key to retrieve a Callback from its id
+func CallbackKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "pharmacy"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_pharmacy"
+)
+
+var (
+	ParamsKey = []byte("p_pharmacy")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDispense{}
+
+func NewMsgDispense(creator string, prescriptionId string) *MsgDispense {
+	return &MsgDispense{
+		Creator:        creator,
+		PrescriptionId: prescriptionId,
+	}
+}
+
+func (msg *MsgDispense) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PrescriptionId == "" {
+		return errorsmod.Wrap(ErrInvalidPrescription, "prescription id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyPrescriptionValidity = []byte("PrescriptionValidity")
+	// DefaultPrescriptionValidity lets a prescription be dispensed for 30 days
+	DefaultPrescriptionValidity = 720 * time.Hour
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	prescriptionValidity time.Duration,
+) Params {
+	return Params{
+		PrescriptionValidity: prescriptionValidity,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultPrescriptionValidity,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPrescriptionValidity, &p.PrescriptionValidity, validatePrescriptionValidity),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validatePrescriptionValidity(p.PrescriptionValidity)
+}
+
+// validatePrescriptionValidity validates the PrescriptionValidity param
+func validatePrescriptionValidity(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("prescription validity must be positive: %s", d)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package types
+
+// Prescription statuses. A prescription is received PENDING and is DISPENSED
+// at most once.
+const (
+	PrescriptionStatusPending   = "PENDING"
+	PrescriptionStatusDispensed = "DISPENSED"
+)
+
+// CallbackDispensed is the message type of the callback reporting a dispensed
+// prescription to the healthcare contract
+const CallbackDispensed = "DISPENSED"
@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: healthcare/pharmacy/prescription.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Prescription is an e-prescription received from the healthcare contract.
+// It is dispensed at most once, before it expires.
+type Prescription struct {
+	PrescriptionId string `protobuf:"bytes,1,opt,name=prescription_id,json=prescriptionId,proto3" json:"prescription_id,omitempty"`
+	Patient        string `protobuf:"bytes,2,opt,name=patient,proto3" json:"patient,omitempty"`
+	Prescriber     string `protobuf:"bytes,3,opt,name=prescriber,proto3" json:"prescriber,omitempty"`
+	// data_hash is the hash of the version of the medical record issuing the
+	// prescription.
+	DataHash  string `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,5,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// status is PENDING until the prescription is DISPENSED.
+	Status      string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Pharmacist  string `protobuf:"bytes,8,opt,name=pharmacist,proto3" json:"pharmacist,omitempty"`
+	DispensedAt int64  `protobuf:"varint,9,opt,name=dispensed_at,json=dispensedAt,proto3" json:"dispensed_at,omitempty"`
+}
+
+func (m *Prescription) Reset()         { *m = Prescription{} }
+func (m *Prescription) String() string { return proto.CompactTextString(m) }
+func (*Prescription) ProtoMessage()    {}
+func (*Prescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c13a2606c7605d, []int{0}
+}
+func (m *Prescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Prescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Prescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Prescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prescription.Merge(m, src)
+}
+func (m *Prescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Prescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prescription proto.InternalMessageInfo
+
+func (m *Prescription) GetPrescriptionId() string {
+	if m != nil {
+		return m.PrescriptionId
+	}
+	return ""
+}
+
+func (m *Prescription) GetPatient() string {
+	if m != nil {
+		return m.Patient
+	}
+	return ""
+}
+
+func (m *Prescription) GetPrescriber() string {
+	if m != nil {
+		return m.Prescriber
+	}
+	return ""
+}
+
+func (m *Prescription) GetDataHash() string {
+	if m != nil {
+		return m.DataHash
+	}
+	return ""
+}
+
+func (m *Prescription) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *Prescription) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Prescription) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Prescription) GetPharmacist() string {
+	if m != nil {
+		return m.Pharmacist
+	}
+	return ""
+}
+
+func (m *Prescription) GetDispensedAt() int64 {
+	if m != nil {
+		return m.DispensedAt
+	}
+	return 0
+}
+
+// Callback is a response waiting to be delivered back to the healthcare
+// contract
+type Callback struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageType string `protobuf:"bytes,2,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	// data is the JSON encoded response.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Callback) Reset()         { *m = Callback{} }
+func (m *Callback) String() string { return proto.CompactTextString(m) }
+func (*Callback) ProtoMessage()    {}
+func (*Callback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32c13a2606c7605d, []int{1}
+}
+func (m *Callback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Callback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Callback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Callback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Callback.Merge(m, src)
+}
+func (m *Callback) XXX_Size() int {
+	return m.Size()
+}
+func (m *Callback) XXX_DiscardUnknown() {
+	xxx_messageInfo_Callback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Callback proto.InternalMessageInfo
+
+func (m *Callback) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Callback) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
+}
+
+func (m *Callback) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Prescription)(nil), "healthcare.pharmacy.Prescription")
+	proto.RegisterType((*Callback)(nil), "healthcare.pharmacy.Callback")
+}
+
+func init() {
+	proto.RegisterFile("healthcare/pharmacy/prescription.proto", fileDescriptor_32c13a2606c7605d)
+}
+
+var fileDescriptor_32c13a2606c7605d = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3d, 0x6e, 0xea, 0x40,
+	0x10, 0xc7, 0xb1, 0xe1, 0x81, 0x3d, 0x20, 0x9e, 0xb4, 0x4f, 0x7a, 0x5a, 0x09, 0xc5, 0x22, 0x14,
+	0x09, 0x15, 0x14, 0x51, 0x0e, 0x40, 0xd2, 0x24, 0x5d, 0x62, 0xa5, 0x4a, 0x63, 0x0d, 0xf6, 0x2a,
+	0x5e, 0x85, 0x8f, 0x95, 0x67, 0x90, 0xe0, 0x16, 0xb9, 0x44, 0xee, 0x92, 0x92, 0x32, 0x65, 0x04,
+	0x17, 0x89, 0xbc, 0x2c, 0xe0, 0x6e, 0xe7, 0xf7, 0xff, 0xcf, 0xec, 0x7c, 0xc0, 0x55, 0xae, 0x70,
+	0xc6, 0x79, 0x8a, 0x85, 0x1a, 0x9b, 0x1c, 0x8b, 0x39, 0xa6, 0x9b, 0xb1, 0x29, 0x14, 0xa5, 0x85,
+	0x36, 0xac, 0x97, 0x8b, 0x91, 0x29, 0x96, 0xbc, 0x14, 0xff, 0xce, 0xbe, 0xd1, 0xd1, 0x37, 0xf8,
+	0xf4, 0xa1, 0xf3, 0x54, 0xf1, 0x8a, 0x6b, 0xf8, 0x5b, 0xcd, 0x4d, 0x74, 0x26, 0xbd, 0xbe, 0x37,
+	0x0c, 0xe3, 0x6e, 0x15, 0x3f, 0x66, 0x42, 0x42, 0xcb, 0x20, 0x6b, 0xb5, 0x60, 0xe9, 0x5b, 0xc3,
+	0x31, 0x14, 0x11, 0x80, 0xf3, 0x4e, 0x55, 0x21, 0xeb, 0x56, 0xac, 0x10, 0xd1, 0x83, 0x30, 0x43,
+	0xc6, 0x24, 0x47, 0xca, 0x65, 0xc3, 0xca, 0x41, 0x09, 0x1e, 0x90, 0xf2, 0x52, 0xd4, 0x44, 0x2b,
+	0x95, 0x25, 0xc8, 0xf2, 0x4f, 0xdf, 0x1b, 0xd6, 0xe3, 0xe0, 0x00, 0x26, 0x2c, 0x2e, 0x00, 0xd4,
+	0xda, 0xe8, 0x42, 0x51, 0xa9, 0x36, 0xad, 0x1a, 0x3a, 0x32, 0x61, 0xf1, 0x1f, 0x9a, 0xc4, 0xc8,
+	0x2b, 0x92, 0x2d, 0x5b, 0xd5, 0x45, 0xb6, 0xa1, 0xc3, 0xc0, 0x9a, 0x58, 0x06, 0xae, 0xa1, 0x13,
+	0x11, 0x97, 0xd0, 0xc9, 0x34, 0x19, 0xb5, 0xa0, 0xc3, 0xb7, 0xa1, 0x2d, 0xdc, 0x3e, 0xb1, 0x09,
+	0x0f, 0x9e, 0x21, 0xb8, 0xc7, 0xd9, 0x6c, 0x8a, 0xe9, 0xbb, 0xe8, 0x82, 0xef, 0xb6, 0xd2, 0x88,
+	0x7d, 0x9d, 0x95, 0xe9, 0x73, 0x45, 0x84, 0x6f, 0x2a, 0xe1, 0x8d, 0x51, 0x6e, 0x1d, 0x6d, 0xc7,
+	0x5e, 0x36, 0x46, 0x09, 0x01, 0x8d, 0x72, 0x42, 0xbb, 0x8c, 0x4e, 0x6c, 0xdf, 0x77, 0xb7, 0x5f,
+	0xbb, 0xc8, 0xdb, 0xee, 0x22, 0xef, 0x67, 0x17, 0x79, 0x1f, 0xfb, 0xa8, 0xb6, 0xdd, 0x47, 0xb5,
+	0xef, 0x7d, 0x54, 0x7b, 0xed, 0x55, 0x2e, 0xba, 0x3e, 0xdf, 0xb4, 0x2c, 0x4e, 0xd3, 0xa6, 0xbd,
+	0xe6, 0xcd, 0xef, 0x00, 0x92, 0x91, 0x89, 0x51, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *Prescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Prescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DispensedAt != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.DispensedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Pharmacist) > 0 {
+		i -= len(m.Pharmacist)
+		copy(dAtA[i:], m.Pharmacist)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Pharmacist)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Prescriber) > 0 {
+		i -= len(m.Prescriber)
+		copy(dAtA[i:], m.Prescriber)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Prescriber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Patient) > 0 {
+		i -= len(m.Patient)
+		copy(dAtA[i:], m.Patient)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Patient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrescriptionId) > 0 {
+		i -= len(m.PrescriptionId)
+		copy(dAtA[i:], m.PrescriptionId)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.PrescriptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Callback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintPrescription(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPrescription(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrescription(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrescription(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Prescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrescriptionId)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Patient)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Prescriber)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovPrescription(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPrescription(uint64(m.ExpiresAt))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Pharmacist)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	if m.DispensedAt != 0 {
+		n += 1 + sovPrescription(uint64(m.DispensedAt))
+	}
+	return n
+}
+
+func (m *Callback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPrescription(uint64(m.Id))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPrescription(uint64(l))
+	}
+	return n
+}
+
+func sovPrescription(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrescription(x uint64) (n int) {
+	return sovPrescription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Prescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrescriptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrescriptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prescriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prescriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pharmacist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pharmacist = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispensedAt", wireType)
+			}
+			m.DispensedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DispensedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Callback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrescription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrescription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrescription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrescription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrescription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrescription
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrescription
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrescription
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrescription        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrescription          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrescription = fmt.Errorf("proto: unexpected end of group")
+)
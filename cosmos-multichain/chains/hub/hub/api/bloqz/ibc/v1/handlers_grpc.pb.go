@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: bloqz/ibc/v1/handlers.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CrossChainService_VerifyUserProfile_FullMethodName  = "/bloqz.ibc.v1.CrossChainService/VerifyUserProfile"
+	CrossChainService_VerifyDocument_FullMethodName     = "/bloqz.ibc.v1.CrossChainService/VerifyDocument"
+	CrossChainService_SendMessage_FullMethodName        = "/bloqz.ibc.v1.CrossChainService/SendMessage"
+	CrossChainService_SignalVideo_FullMethodName        = "/bloqz.ibc.v1.CrossChainService/SignalVideo"
+	CrossChainService_AuthenticateDevice_FullMethodName = "/bloqz.ibc.v1.CrossChainService/AuthenticateDevice"
+	CrossChainService_VerifyService_FullMethodName      = "/bloqz.ibc.v1.CrossChainService/VerifyService"
+)
+
+// CrossChainServiceClient is the client API for CrossChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CrossChainServiceClient interface {
+	// Profile verification
+	VerifyUserProfile(ctx context.Context, in *VerifyUserProfileRequest, opts ...grpc.CallOption) (*VerifyUserProfileResponse, error)
+	// Document verification
+	VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error)
+	// Real-time messaging
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Video conferencing
+	SignalVideo(ctx context.Context, in *VideoSignalRequest, opts ...grpc.CallOption) (*VideoSignalResponse, error)
+	// Device authentication
+	AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error)
+	// Service verification
+	VerifyService(ctx context.Context, in *VerifyServiceRequest, opts ...grpc.CallOption) (*VerifyServiceResponse, error)
+}
+
+type crossChainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCrossChainServiceClient(cc grpc.ClientConnInterface) CrossChainServiceClient {
+	return &crossChainServiceClient{cc}
+}
+
+func (c *crossChainServiceClient) VerifyUserProfile(ctx context.Context, in *VerifyUserProfileRequest, opts ...grpc.CallOption) (*VerifyUserProfileResponse, error) {
+	out := new(VerifyUserProfileResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_VerifyUserProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error) {
+	out := new(VerifyDocumentResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_VerifyDocument_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_SendMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) SignalVideo(ctx context.Context, in *VideoSignalRequest, opts ...grpc.CallOption) (*VideoSignalResponse, error) {
+	out := new(VideoSignalResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_SignalVideo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error) {
+	out := new(AuthenticateDeviceResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_AuthenticateDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) VerifyService(ctx context.Context, in *VerifyServiceRequest, opts ...grpc.CallOption) (*VerifyServiceResponse, error) {
+	out := new(VerifyServiceResponse)
+	err := c.cc.Invoke(ctx, CrossChainService_VerifyService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrossChainServiceServer is the server API for CrossChainService service.
+// All implementations must embed UnimplementedCrossChainServiceServer
+// for forward compatibility
+type CrossChainServiceServer interface {
+	// Profile verification
+	VerifyUserProfile(context.Context, *VerifyUserProfileRequest) (*VerifyUserProfileResponse, error)
+	// Document verification
+	VerifyDocument(context.Context, *VerifyDocumentRequest) (*VerifyDocumentResponse, error)
+	// Real-time messaging
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// Video conferencing
+	SignalVideo(context.Context, *VideoSignalRequest) (*VideoSignalResponse, error)
+	// Device authentication
+	AuthenticateDevice(context.Context, *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error)
+	// Service verification
+	VerifyService(context.Context, *VerifyServiceRequest) (*VerifyServiceResponse, error)
+	mustEmbedUnimplementedCrossChainServiceServer()
+}
+
+// UnimplementedCrossChainServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCrossChainServiceServer struct {
+}
+
+func (UnimplementedCrossChainServiceServer) VerifyUserProfile(context.Context, *VerifyUserProfileRequest) (*VerifyUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyUserProfile not implemented")
+}
+func (UnimplementedCrossChainServiceServer) VerifyDocument(context.Context, *VerifyDocumentRequest) (*VerifyDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDocument not implemented")
+}
+func (UnimplementedCrossChainServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedCrossChainServiceServer) SignalVideo(context.Context, *VideoSignalRequest) (*VideoSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalVideo not implemented")
+}
+func (UnimplementedCrossChainServiceServer) AuthenticateDevice(context.Context, *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateDevice not implemented")
+}
+func (UnimplementedCrossChainServiceServer) VerifyService(context.Context, *VerifyServiceRequest) (*VerifyServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyService not implemented")
+}
+func (UnimplementedCrossChainServiceServer) mustEmbedUnimplementedCrossChainServiceServer() {}
+
+// UnsafeCrossChainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CrossChainServiceServer will
+// result in compilation errors.
+type UnsafeCrossChainServiceServer interface {
+	mustEmbedUnimplementedCrossChainServiceServer()
+}
+
+func RegisterCrossChainServiceServer(s grpc.ServiceRegistrar, srv CrossChainServiceServer) {
+	s.RegisterService(&CrossChainService_ServiceDesc, srv)
+}
+
+func _CrossChainService_VerifyUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_VerifyUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyUserProfile(ctx, req.(*VerifyUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_VerifyDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_VerifyDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyDocument(ctx, req.(*VerifyDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_SignalVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).SignalVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_SignalVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).SignalVideo(ctx, req.(*VideoSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_AuthenticateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).AuthenticateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_AuthenticateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).AuthenticateDevice(ctx, req.(*AuthenticateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_VerifyService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainService_VerifyService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyService(ctx, req.(*VerifyServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CrossChainService_ServiceDesc is the grpc.ServiceDesc for CrossChainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CrossChainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bloqz.ibc.v1.CrossChainService",
+	HandlerType: (*CrossChainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyUserProfile",
+			Handler:    _CrossChainService_VerifyUserProfile_Handler,
+		},
+		{
+			MethodName: "VerifyDocument",
+			Handler:    _CrossChainService_VerifyDocument_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _CrossChainService_SendMessage_Handler,
+		},
+		{
+			MethodName: "SignalVideo",
+			Handler:    _CrossChainService_SignalVideo_Handler,
+		},
+		{
+			MethodName: "AuthenticateDevice",
+			Handler:    _CrossChainService_AuthenticateDevice_Handler,
+		},
+		{
+			MethodName: "VerifyService",
+			Handler:    _CrossChainService_VerifyService_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bloqz/ibc/v1/handlers.proto",
+}
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: hub/communication/query.proto
+
+package communication
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                 = "/hub.communication.Query/Params"
+	Query_ChannelCounterparty_FullMethodName    = "/hub.communication.Query/ChannelCounterparty"
+	Query_ChannelCounterpartyAll_FullMethodName = "/hub.communication.Query/ChannelCounterpartyAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ChannelCounterparty items.
+	ChannelCounterparty(ctx context.Context, in *QueryGetChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryGetChannelCounterpartyResponse, error)
+	ChannelCounterpartyAll(ctx context.Context, in *QueryAllChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryAllChannelCounterpartyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelCounterparty(ctx context.Context, in *QueryGetChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryGetChannelCounterpartyResponse, error) {
+	out := new(QueryGetChannelCounterpartyResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelCounterparty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelCounterpartyAll(ctx context.Context, in *QueryAllChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryAllChannelCounterpartyResponse, error) {
+	out := new(QueryAllChannelCounterpartyResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelCounterpartyAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ChannelCounterparty items.
+	ChannelCounterparty(context.Context, *QueryGetChannelCounterpartyRequest) (*QueryGetChannelCounterpartyResponse, error)
+	ChannelCounterpartyAll(context.Context, *QueryAllChannelCounterpartyRequest) (*QueryAllChannelCounterpartyResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ChannelCounterparty(context.Context, *QueryGetChannelCounterpartyRequest) (*QueryGetChannelCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCounterparty not implemented")
+}
+func (UnimplementedQueryServer) ChannelCounterpartyAll(context.Context, *QueryAllChannelCounterpartyRequest) (*QueryAllChannelCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCounterpartyAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChannelCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelCounterparty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelCounterparty(ctx, req.(*QueryGetChannelCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelCounterpartyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelCounterpartyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelCounterpartyAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelCounterpartyAll(ctx, req.(*QueryAllChannelCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hub.communication.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ChannelCounterparty",
+			Handler:    _Query_ChannelCounterparty_Handler,
+		},
+		{
+			MethodName: "ChannelCounterpartyAll",
+			Handler:    _Query_ChannelCounterpartyAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hub/communication/query.proto",
+}
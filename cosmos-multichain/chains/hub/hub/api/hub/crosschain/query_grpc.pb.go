@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: hub/crosschain/query.proto
+
+package crosschain
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                  = "/hub.crosschain.Query/Params"
+	Query_ProfileVerification_FullMethodName     = "/hub.crosschain.Query/ProfileVerification"
+	Query_ProfileVerificationAll_FullMethodName  = "/hub.crosschain.Query/ProfileVerificationAll"
+	Query_DocumentVerification_FullMethodName    = "/hub.crosschain.Query/DocumentVerification"
+	Query_DocumentVerificationAll_FullMethodName = "/hub.crosschain.Query/DocumentVerificationAll"
+	Query_Message_FullMethodName                 = "/hub.crosschain.Query/Message"
+	Query_MessageAll_FullMethodName              = "/hub.crosschain.Query/MessageAll"
+	Query_DeviceAuth_FullMethodName              = "/hub.crosschain.Query/DeviceAuth"
+	Query_DeviceAuthAll_FullMethodName           = "/hub.crosschain.Query/DeviceAuthAll"
+	Query_ServiceVerification_FullMethodName     = "/hub.crosschain.Query/ServiceVerification"
+	Query_ServiceVerificationAll_FullMethodName  = "/hub.crosschain.Query/ServiceVerificationAll"
+	Query_VideoSignals_FullMethodName            = "/hub.crosschain.Query/VideoSignals"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ProfileVerification items.
+	ProfileVerification(ctx context.Context, in *QueryGetProfileVerificationRequest, opts ...grpc.CallOption) (*QueryGetProfileVerificationResponse, error)
+	ProfileVerificationAll(ctx context.Context, in *QueryAllProfileVerificationRequest, opts ...grpc.CallOption) (*QueryAllProfileVerificationResponse, error)
+	// Queries a list of DocumentVerification items.
+	DocumentVerification(ctx context.Context, in *QueryGetDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryGetDocumentVerificationResponse, error)
+	DocumentVerificationAll(ctx context.Context, in *QueryAllDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryAllDocumentVerificationResponse, error)
+	// Queries a list of Message items.
+	Message(ctx context.Context, in *QueryGetMessageRequest, opts ...grpc.CallOption) (*QueryGetMessageResponse, error)
+	MessageAll(ctx context.Context, in *QueryAllMessageRequest, opts ...grpc.CallOption) (*QueryAllMessageResponse, error)
+	// Queries a list of DeviceAuth items.
+	DeviceAuth(ctx context.Context, in *QueryGetDeviceAuthRequest, opts ...grpc.CallOption) (*QueryGetDeviceAuthResponse, error)
+	DeviceAuthAll(ctx context.Context, in *QueryAllDeviceAuthRequest, opts ...grpc.CallOption) (*QueryAllDeviceAuthResponse, error)
+	// Queries a list of ServiceVerification items.
+	ServiceVerification(ctx context.Context, in *QueryGetServiceVerificationRequest, opts ...grpc.CallOption) (*QueryGetServiceVerificationResponse, error)
+	ServiceVerificationAll(ctx context.Context, in *QueryAllServiceVerificationRequest, opts ...grpc.CallOption) (*QueryAllServiceVerificationResponse, error)
+	// Queries the video signals exchanged in a session.
+	VideoSignals(ctx context.Context, in *QueryVideoSignalsRequest, opts ...grpc.CallOption) (*QueryVideoSignalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileVerification(ctx context.Context, in *QueryGetProfileVerificationRequest, opts ...grpc.CallOption) (*QueryGetProfileVerificationResponse, error) {
+	out := new(QueryGetProfileVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_ProfileVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileVerificationAll(ctx context.Context, in *QueryAllProfileVerificationRequest, opts ...grpc.CallOption) (*QueryAllProfileVerificationResponse, error) {
+	out := new(QueryAllProfileVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_ProfileVerificationAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentVerification(ctx context.Context, in *QueryGetDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryGetDocumentVerificationResponse, error) {
+	out := new(QueryGetDocumentVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentVerificationAll(ctx context.Context, in *QueryAllDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryAllDocumentVerificationResponse, error) {
+	out := new(QueryAllDocumentVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentVerificationAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Message(ctx context.Context, in *QueryGetMessageRequest, opts ...grpc.CallOption) (*QueryGetMessageResponse, error) {
+	out := new(QueryGetMessageResponse)
+	err := c.cc.Invoke(ctx, Query_Message_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MessageAll(ctx context.Context, in *QueryAllMessageRequest, opts ...grpc.CallOption) (*QueryAllMessageResponse, error) {
+	out := new(QueryAllMessageResponse)
+	err := c.cc.Invoke(ctx, Query_MessageAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviceAuth(ctx context.Context, in *QueryGetDeviceAuthRequest, opts ...grpc.CallOption) (*QueryGetDeviceAuthResponse, error) {
+	out := new(QueryGetDeviceAuthResponse)
+	err := c.cc.Invoke(ctx, Query_DeviceAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviceAuthAll(ctx context.Context, in *QueryAllDeviceAuthRequest, opts ...grpc.CallOption) (*QueryAllDeviceAuthResponse, error) {
+	out := new(QueryAllDeviceAuthResponse)
+	err := c.cc.Invoke(ctx, Query_DeviceAuthAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceVerification(ctx context.Context, in *QueryGetServiceVerificationRequest, opts ...grpc.CallOption) (*QueryGetServiceVerificationResponse, error) {
+	out := new(QueryGetServiceVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceVerificationAll(ctx context.Context, in *QueryAllServiceVerificationRequest, opts ...grpc.CallOption) (*QueryAllServiceVerificationResponse, error) {
+	out := new(QueryAllServiceVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_ServiceVerificationAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VideoSignals(ctx context.Context, in *QueryVideoSignalsRequest, opts ...grpc.CallOption) (*QueryVideoSignalsResponse, error) {
+	out := new(QueryVideoSignalsResponse)
+	err := c.cc.Invoke(ctx, Query_VideoSignals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ProfileVerification items.
+	ProfileVerification(context.Context, *QueryGetProfileVerificationRequest) (*QueryGetProfileVerificationResponse, error)
+	ProfileVerificationAll(context.Context, *QueryAllProfileVerificationRequest) (*QueryAllProfileVerificationResponse, error)
+	// Queries a list of DocumentVerification items.
+	DocumentVerification(context.Context, *QueryGetDocumentVerificationRequest) (*QueryGetDocumentVerificationResponse, error)
+	DocumentVerificationAll(context.Context, *QueryAllDocumentVerificationRequest) (*QueryAllDocumentVerificationResponse, error)
+	// Queries a list of Message items.
+	Message(context.Context, *QueryGetMessageRequest) (*QueryGetMessageResponse, error)
+	MessageAll(context.Context, *QueryAllMessageRequest) (*QueryAllMessageResponse, error)
+	// Queries a list of DeviceAuth items.
+	DeviceAuth(context.Context, *QueryGetDeviceAuthRequest) (*QueryGetDeviceAuthResponse, error)
+	DeviceAuthAll(context.Context, *QueryAllDeviceAuthRequest) (*QueryAllDeviceAuthResponse, error)
+	// Queries a list of ServiceVerification items.
+	ServiceVerification(context.Context, *QueryGetServiceVerificationRequest) (*QueryGetServiceVerificationResponse, error)
+	ServiceVerificationAll(context.Context, *QueryAllServiceVerificationRequest) (*QueryAllServiceVerificationResponse, error)
+	// Queries the video signals exchanged in a session.
+	VideoSignals(context.Context, *QueryVideoSignalsRequest) (*QueryVideoSignalsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ProfileVerification(context.Context, *QueryGetProfileVerificationRequest) (*QueryGetProfileVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileVerification not implemented")
+}
+func (UnimplementedQueryServer) ProfileVerificationAll(context.Context, *QueryAllProfileVerificationRequest) (*QueryAllProfileVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileVerificationAll not implemented")
+}
+func (UnimplementedQueryServer) DocumentVerification(context.Context, *QueryGetDocumentVerificationRequest) (*QueryGetDocumentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentVerification not implemented")
+}
+func (UnimplementedQueryServer) DocumentVerificationAll(context.Context, *QueryAllDocumentVerificationRequest) (*QueryAllDocumentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentVerificationAll not implemented")
+}
+func (UnimplementedQueryServer) Message(context.Context, *QueryGetMessageRequest) (*QueryGetMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Message not implemented")
+}
+func (UnimplementedQueryServer) MessageAll(context.Context, *QueryAllMessageRequest) (*QueryAllMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageAll not implemented")
+}
+func (UnimplementedQueryServer) DeviceAuth(context.Context, *QueryGetDeviceAuthRequest) (*QueryGetDeviceAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAuth not implemented")
+}
+func (UnimplementedQueryServer) DeviceAuthAll(context.Context, *QueryAllDeviceAuthRequest) (*QueryAllDeviceAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAuthAll not implemented")
+}
+func (UnimplementedQueryServer) ServiceVerification(context.Context, *QueryGetServiceVerificationRequest) (*QueryGetServiceVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceVerification not implemented")
+}
+func (UnimplementedQueryServer) ServiceVerificationAll(context.Context, *QueryAllServiceVerificationRequest) (*QueryAllServiceVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceVerificationAll not implemented")
+}
+func (UnimplementedQueryServer) VideoSignals(context.Context, *QueryVideoSignalsRequest) (*QueryVideoSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VideoSignals not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProfileVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProfileVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileVerification(ctx, req.(*QueryGetProfileVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProfileVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProfileVerificationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileVerificationAll(ctx, req.(*QueryAllProfileVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDocumentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentVerification(ctx, req.(*QueryGetDocumentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDocumentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentVerificationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentVerificationAll(ctx, req.(*QueryAllDocumentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Message_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Message(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Message_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Message(ctx, req.(*QueryGetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MessageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MessageAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageAll(ctx, req.(*QueryAllMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviceAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDeviceAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviceAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeviceAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviceAuth(ctx, req.(*QueryGetDeviceAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviceAuthAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDeviceAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviceAuthAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeviceAuthAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviceAuthAll(ctx, req.(*QueryAllDeviceAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetServiceVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceVerification(ctx, req.(*QueryGetServiceVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllServiceVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ServiceVerificationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceVerificationAll(ctx, req.(*QueryAllServiceVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VideoSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVideoSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VideoSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VideoSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VideoSignals(ctx, req.(*QueryVideoSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hub.crosschain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ProfileVerification",
+			Handler:    _Query_ProfileVerification_Handler,
+		},
+		{
+			MethodName: "ProfileVerificationAll",
+			Handler:    _Query_ProfileVerificationAll_Handler,
+		},
+		{
+			MethodName: "DocumentVerification",
+			Handler:    _Query_DocumentVerification_Handler,
+		},
+		{
+			MethodName: "DocumentVerificationAll",
+			Handler:    _Query_DocumentVerificationAll_Handler,
+		},
+		{
+			MethodName: "Message",
+			Handler:    _Query_Message_Handler,
+		},
+		{
+			MethodName: "MessageAll",
+			Handler:    _Query_MessageAll_Handler,
+		},
+		{
+			MethodName: "DeviceAuth",
+			Handler:    _Query_DeviceAuth_Handler,
+		},
+		{
+			MethodName: "DeviceAuthAll",
+			Handler:    _Query_DeviceAuthAll_Handler,
+		},
+		{
+			MethodName: "ServiceVerification",
+			Handler:    _Query_ServiceVerification_Handler,
+		},
+		{
+			MethodName: "ServiceVerificationAll",
+			Handler:    _Query_ServiceVerificationAll_Handler,
+		},
+		{
+			MethodName: "VideoSignals",
+			Handler:    _Query_VideoSignals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hub/crosschain/query.proto",
+}
@@ -0,0 +1,5891 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package crosschain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v1 "hub/api/bloqz/ibc/v1"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ProfileVerificationRecord_4_list)(nil)
+
+type _ProfileVerificationRecord_4_list struct {
+	list *[]string
+}
+
+func (x *_ProfileVerificationRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProfileVerificationRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProfileVerificationRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProfileVerificationRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProfileVerificationRecord_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProfileVerificationRecord at list field RequiredCredentials as it is not of Message kind"))
+}
+
+func (x *_ProfileVerificationRecord_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProfileVerificationRecord_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProfileVerificationRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ProfileVerificationRecord_5_list)(nil)
+
+type _ProfileVerificationRecord_5_list struct {
+	list *[]string
+}
+
+func (x *_ProfileVerificationRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProfileVerificationRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProfileVerificationRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProfileVerificationRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProfileVerificationRecord_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProfileVerificationRecord at list field VerifiedCredentials as it is not of Message kind"))
+}
+
+func (x *_ProfileVerificationRecord_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProfileVerificationRecord_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProfileVerificationRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProfileVerificationRecord                      protoreflect.MessageDescriptor
+	fd_ProfileVerificationRecord_verification_id      protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_creator              protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_profile              protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_required_credentials protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_verified_credentials protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_status               protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_error_message        protoreflect.FieldDescriptor
+	fd_ProfileVerificationRecord_created_at           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_ProfileVerificationRecord = File_hub_crosschain_records_proto.Messages().ByName("ProfileVerificationRecord")
+	fd_ProfileVerificationRecord_verification_id = md_ProfileVerificationRecord.Fields().ByName("verification_id")
+	fd_ProfileVerificationRecord_creator = md_ProfileVerificationRecord.Fields().ByName("creator")
+	fd_ProfileVerificationRecord_profile = md_ProfileVerificationRecord.Fields().ByName("profile")
+	fd_ProfileVerificationRecord_required_credentials = md_ProfileVerificationRecord.Fields().ByName("required_credentials")
+	fd_ProfileVerificationRecord_verified_credentials = md_ProfileVerificationRecord.Fields().ByName("verified_credentials")
+	fd_ProfileVerificationRecord_status = md_ProfileVerificationRecord.Fields().ByName("status")
+	fd_ProfileVerificationRecord_error_message = md_ProfileVerificationRecord.Fields().ByName("error_message")
+	fd_ProfileVerificationRecord_created_at = md_ProfileVerificationRecord.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProfileVerificationRecord)(nil)
+
+type fastReflection_ProfileVerificationRecord ProfileVerificationRecord
+
+func (x *ProfileVerificationRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProfileVerificationRecord)(x)
+}
+
+func (x *ProfileVerificationRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProfileVerificationRecord_messageType fastReflection_ProfileVerificationRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ProfileVerificationRecord_messageType{}
+
+type fastReflection_ProfileVerificationRecord_messageType struct{}
+
+func (x fastReflection_ProfileVerificationRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProfileVerificationRecord)(nil)
+}
+func (x fastReflection_ProfileVerificationRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProfileVerificationRecord)
+}
+func (x fastReflection_ProfileVerificationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProfileVerificationRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProfileVerificationRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProfileVerificationRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProfileVerificationRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ProfileVerificationRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProfileVerificationRecord) New() protoreflect.Message {
+	return new(fastReflection_ProfileVerificationRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProfileVerificationRecord) Interface() protoreflect.ProtoMessage {
+	return (*ProfileVerificationRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProfileVerificationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VerificationId != "" {
+		value := protoreflect.ValueOfString(x.VerificationId)
+		if !f(fd_ProfileVerificationRecord_verification_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_ProfileVerificationRecord_creator, value) {
+			return
+		}
+	}
+	if x.Profile != nil {
+		value := protoreflect.ValueOfMessage(x.Profile.ProtoReflect())
+		if !f(fd_ProfileVerificationRecord_profile, value) {
+			return
+		}
+	}
+	if len(x.RequiredCredentials) != 0 {
+		value := protoreflect.ValueOfList(&_ProfileVerificationRecord_4_list{list: &x.RequiredCredentials})
+		if !f(fd_ProfileVerificationRecord_required_credentials, value) {
+			return
+		}
+	}
+	if len(x.VerifiedCredentials) != 0 {
+		value := protoreflect.ValueOfList(&_ProfileVerificationRecord_5_list{list: &x.VerifiedCredentials})
+		if !f(fd_ProfileVerificationRecord_verified_credentials, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ProfileVerificationRecord_status, value) {
+			return
+		}
+	}
+	if x.ErrorMessage != "" {
+		value := protoreflect.ValueOfString(x.ErrorMessage)
+		if !f(fd_ProfileVerificationRecord_error_message, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_ProfileVerificationRecord_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProfileVerificationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		return x.VerificationId != ""
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		return x.Profile != nil
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		return len(x.RequiredCredentials) != 0
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		return len(x.VerifiedCredentials) != 0
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		return x.Status != 0
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		return x.ErrorMessage != ""
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProfileVerificationRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		x.VerificationId = ""
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		x.Profile = nil
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		x.RequiredCredentials = nil
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		x.VerifiedCredentials = nil
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		x.Status = 0
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		x.ErrorMessage = ""
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProfileVerificationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		value := x.VerificationId
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		value := x.Profile
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		if len(x.RequiredCredentials) == 0 {
+			return protoreflect.ValueOfList(&_ProfileVerificationRecord_4_list{})
+		}
+		listValue := &_ProfileVerificationRecord_4_list{list: &x.RequiredCredentials}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		if len(x.VerifiedCredentials) == 0 {
+			return protoreflect.ValueOfList(&_ProfileVerificationRecord_5_list{})
+		}
+		listValue := &_ProfileVerificationRecord_5_list{list: &x.VerifiedCredentials}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		value := x.ErrorMessage
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProfileVerificationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		x.VerificationId = value.Interface().(string)
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		x.Profile = value.Message().Interface().(*v1.UserProfile)
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		lv := value.List()
+		clv := lv.(*_ProfileVerificationRecord_4_list)
+		x.RequiredCredentials = *clv.list
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		lv := value.List()
+		clv := lv.(*_ProfileVerificationRecord_5_list)
+		x.VerifiedCredentials = *clv.list
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		x.Status = (v1.VerificationStatus)(value.Enum())
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		x.ErrorMessage = value.Interface().(string)
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProfileVerificationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		if x.Profile == nil {
+			x.Profile = new(v1.UserProfile)
+		}
+		return protoreflect.ValueOfMessage(x.Profile.ProtoReflect())
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		if x.RequiredCredentials == nil {
+			x.RequiredCredentials = []string{}
+		}
+		value := &_ProfileVerificationRecord_4_list{list: &x.RequiredCredentials}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		if x.VerifiedCredentials == nil {
+			x.VerifiedCredentials = []string{}
+		}
+		value := &_ProfileVerificationRecord_5_list{list: &x.VerifiedCredentials}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		panic(fmt.Errorf("field verification_id of message hub.crosschain.ProfileVerificationRecord is not mutable"))
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.ProfileVerificationRecord is not mutable"))
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		panic(fmt.Errorf("field status of message hub.crosschain.ProfileVerificationRecord is not mutable"))
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		panic(fmt.Errorf("field error_message of message hub.crosschain.ProfileVerificationRecord is not mutable"))
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		panic(fmt.Errorf("field created_at of message hub.crosschain.ProfileVerificationRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProfileVerificationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.ProfileVerificationRecord.verification_id":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.ProfileVerificationRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.ProfileVerificationRecord.profile":
+		m := new(v1.UserProfile)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.ProfileVerificationRecord.required_credentials":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProfileVerificationRecord_4_list{list: &list})
+	case "hub.crosschain.ProfileVerificationRecord.verified_credentials":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProfileVerificationRecord_5_list{list: &list})
+	case "hub.crosschain.ProfileVerificationRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "hub.crosschain.ProfileVerificationRecord.error_message":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.ProfileVerificationRecord.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ProfileVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ProfileVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProfileVerificationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.ProfileVerificationRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProfileVerificationRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProfileVerificationRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProfileVerificationRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProfileVerificationRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProfileVerificationRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VerificationId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Profile != nil {
+			l = options.Size(x.Profile)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequiredCredentials) > 0 {
+			for _, s := range x.RequiredCredentials {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerifiedCredentials) > 0 {
+			for _, s := range x.VerifiedCredentials {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.ErrorMessage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProfileVerificationRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ErrorMessage) > 0 {
+			i -= len(x.ErrorMessage)
+			copy(dAtA[i:], x.ErrorMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorMessage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.VerifiedCredentials) > 0 {
+			for iNdEx := len(x.VerifiedCredentials) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerifiedCredentials[iNdEx])
+				copy(dAtA[i:], x.VerifiedCredentials[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifiedCredentials[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RequiredCredentials) > 0 {
+			for iNdEx := len(x.RequiredCredentials) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredCredentials[iNdEx])
+				copy(dAtA[i:], x.RequiredCredentials[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredCredentials[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Profile != nil {
+			encoded, err := options.Marshal(x.Profile)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VerificationId) > 0 {
+			i -= len(x.VerificationId)
+			copy(dAtA[i:], x.VerificationId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProfileVerificationRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProfileVerificationRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProfileVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Profile == nil {
+					x.Profile = &v1.UserProfile{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Profile); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredCredentials", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredCredentials = append(x.RequiredCredentials, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedCredentials", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifiedCredentials = append(x.VerifiedCredentials, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= v1.VerificationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorMessage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DocumentVerificationRecord_4_list)(nil)
+
+type _DocumentVerificationRecord_4_list struct {
+	list *[]string
+}
+
+func (x *_DocumentVerificationRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DocumentVerificationRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DocumentVerificationRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DocumentVerificationRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DocumentVerificationRecord_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DocumentVerificationRecord at list field RequiredVerifications as it is not of Message kind"))
+}
+
+func (x *_DocumentVerificationRecord_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DocumentVerificationRecord_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DocumentVerificationRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_DocumentVerificationRecord_5_list)(nil)
+
+type _DocumentVerificationRecord_5_list struct {
+	list *[]string
+}
+
+func (x *_DocumentVerificationRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DocumentVerificationRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DocumentVerificationRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DocumentVerificationRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DocumentVerificationRecord_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DocumentVerificationRecord at list field CompletedVerifications as it is not of Message kind"))
+}
+
+func (x *_DocumentVerificationRecord_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DocumentVerificationRecord_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DocumentVerificationRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DocumentVerificationRecord                         protoreflect.MessageDescriptor
+	fd_DocumentVerificationRecord_verification_id         protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_creator                 protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_document                protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_required_verifications  protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_completed_verifications protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_status                  protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_error_message           protoreflect.FieldDescriptor
+	fd_DocumentVerificationRecord_created_at              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_DocumentVerificationRecord = File_hub_crosschain_records_proto.Messages().ByName("DocumentVerificationRecord")
+	fd_DocumentVerificationRecord_verification_id = md_DocumentVerificationRecord.Fields().ByName("verification_id")
+	fd_DocumentVerificationRecord_creator = md_DocumentVerificationRecord.Fields().ByName("creator")
+	fd_DocumentVerificationRecord_document = md_DocumentVerificationRecord.Fields().ByName("document")
+	fd_DocumentVerificationRecord_required_verifications = md_DocumentVerificationRecord.Fields().ByName("required_verifications")
+	fd_DocumentVerificationRecord_completed_verifications = md_DocumentVerificationRecord.Fields().ByName("completed_verifications")
+	fd_DocumentVerificationRecord_status = md_DocumentVerificationRecord.Fields().ByName("status")
+	fd_DocumentVerificationRecord_error_message = md_DocumentVerificationRecord.Fields().ByName("error_message")
+	fd_DocumentVerificationRecord_created_at = md_DocumentVerificationRecord.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_DocumentVerificationRecord)(nil)
+
+type fastReflection_DocumentVerificationRecord DocumentVerificationRecord
+
+func (x *DocumentVerificationRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DocumentVerificationRecord)(x)
+}
+
+func (x *DocumentVerificationRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DocumentVerificationRecord_messageType fastReflection_DocumentVerificationRecord_messageType
+var _ protoreflect.MessageType = fastReflection_DocumentVerificationRecord_messageType{}
+
+type fastReflection_DocumentVerificationRecord_messageType struct{}
+
+func (x fastReflection_DocumentVerificationRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DocumentVerificationRecord)(nil)
+}
+func (x fastReflection_DocumentVerificationRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_DocumentVerificationRecord)
+}
+func (x fastReflection_DocumentVerificationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DocumentVerificationRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DocumentVerificationRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_DocumentVerificationRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DocumentVerificationRecord) Type() protoreflect.MessageType {
+	return _fastReflection_DocumentVerificationRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DocumentVerificationRecord) New() protoreflect.Message {
+	return new(fastReflection_DocumentVerificationRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DocumentVerificationRecord) Interface() protoreflect.ProtoMessage {
+	return (*DocumentVerificationRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DocumentVerificationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VerificationId != "" {
+		value := protoreflect.ValueOfString(x.VerificationId)
+		if !f(fd_DocumentVerificationRecord_verification_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_DocumentVerificationRecord_creator, value) {
+			return
+		}
+	}
+	if x.Document != nil {
+		value := protoreflect.ValueOfMessage(x.Document.ProtoReflect())
+		if !f(fd_DocumentVerificationRecord_document, value) {
+			return
+		}
+	}
+	if len(x.RequiredVerifications) != 0 {
+		value := protoreflect.ValueOfList(&_DocumentVerificationRecord_4_list{list: &x.RequiredVerifications})
+		if !f(fd_DocumentVerificationRecord_required_verifications, value) {
+			return
+		}
+	}
+	if len(x.CompletedVerifications) != 0 {
+		value := protoreflect.ValueOfList(&_DocumentVerificationRecord_5_list{list: &x.CompletedVerifications})
+		if !f(fd_DocumentVerificationRecord_completed_verifications, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_DocumentVerificationRecord_status, value) {
+			return
+		}
+	}
+	if x.ErrorMessage != "" {
+		value := protoreflect.ValueOfString(x.ErrorMessage)
+		if !f(fd_DocumentVerificationRecord_error_message, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_DocumentVerificationRecord_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DocumentVerificationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		return x.VerificationId != ""
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		return x.Document != nil
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		return len(x.RequiredVerifications) != 0
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		return len(x.CompletedVerifications) != 0
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		return x.Status != 0
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		return x.ErrorMessage != ""
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DocumentVerificationRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		x.VerificationId = ""
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		x.Document = nil
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		x.RequiredVerifications = nil
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		x.CompletedVerifications = nil
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		x.Status = 0
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		x.ErrorMessage = ""
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DocumentVerificationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		value := x.VerificationId
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		value := x.Document
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		if len(x.RequiredVerifications) == 0 {
+			return protoreflect.ValueOfList(&_DocumentVerificationRecord_4_list{})
+		}
+		listValue := &_DocumentVerificationRecord_4_list{list: &x.RequiredVerifications}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		if len(x.CompletedVerifications) == 0 {
+			return protoreflect.ValueOfList(&_DocumentVerificationRecord_5_list{})
+		}
+		listValue := &_DocumentVerificationRecord_5_list{list: &x.CompletedVerifications}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		value := x.ErrorMessage
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DocumentVerificationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		x.VerificationId = value.Interface().(string)
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		x.Document = value.Message().Interface().(*v1.Document)
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		lv := value.List()
+		clv := lv.(*_DocumentVerificationRecord_4_list)
+		x.RequiredVerifications = *clv.list
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		lv := value.List()
+		clv := lv.(*_DocumentVerificationRecord_5_list)
+		x.CompletedVerifications = *clv.list
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		x.Status = (v1.VerificationStatus)(value.Enum())
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		x.ErrorMessage = value.Interface().(string)
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DocumentVerificationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		if x.Document == nil {
+			x.Document = new(v1.Document)
+		}
+		return protoreflect.ValueOfMessage(x.Document.ProtoReflect())
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		if x.RequiredVerifications == nil {
+			x.RequiredVerifications = []string{}
+		}
+		value := &_DocumentVerificationRecord_4_list{list: &x.RequiredVerifications}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		if x.CompletedVerifications == nil {
+			x.CompletedVerifications = []string{}
+		}
+		value := &_DocumentVerificationRecord_5_list{list: &x.CompletedVerifications}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		panic(fmt.Errorf("field verification_id of message hub.crosschain.DocumentVerificationRecord is not mutable"))
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.DocumentVerificationRecord is not mutable"))
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		panic(fmt.Errorf("field status of message hub.crosschain.DocumentVerificationRecord is not mutable"))
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		panic(fmt.Errorf("field error_message of message hub.crosschain.DocumentVerificationRecord is not mutable"))
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		panic(fmt.Errorf("field created_at of message hub.crosschain.DocumentVerificationRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DocumentVerificationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.DocumentVerificationRecord.verification_id":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.DocumentVerificationRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.DocumentVerificationRecord.document":
+		m := new(v1.Document)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.DocumentVerificationRecord.required_verifications":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DocumentVerificationRecord_4_list{list: &list})
+	case "hub.crosschain.DocumentVerificationRecord.completed_verifications":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DocumentVerificationRecord_5_list{list: &list})
+	case "hub.crosschain.DocumentVerificationRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "hub.crosschain.DocumentVerificationRecord.error_message":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.DocumentVerificationRecord.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DocumentVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DocumentVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DocumentVerificationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.DocumentVerificationRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DocumentVerificationRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DocumentVerificationRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DocumentVerificationRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DocumentVerificationRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DocumentVerificationRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VerificationId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Document != nil {
+			l = options.Size(x.Document)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequiredVerifications) > 0 {
+			for _, s := range x.RequiredVerifications {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CompletedVerifications) > 0 {
+			for _, s := range x.CompletedVerifications {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.ErrorMessage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DocumentVerificationRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ErrorMessage) > 0 {
+			i -= len(x.ErrorMessage)
+			copy(dAtA[i:], x.ErrorMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorMessage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.CompletedVerifications) > 0 {
+			for iNdEx := len(x.CompletedVerifications) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CompletedVerifications[iNdEx])
+				copy(dAtA[i:], x.CompletedVerifications[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CompletedVerifications[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RequiredVerifications) > 0 {
+			for iNdEx := len(x.RequiredVerifications) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredVerifications[iNdEx])
+				copy(dAtA[i:], x.RequiredVerifications[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredVerifications[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Document != nil {
+			encoded, err := options.Marshal(x.Document)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VerificationId) > 0 {
+			i -= len(x.VerificationId)
+			copy(dAtA[i:], x.VerificationId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DocumentVerificationRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DocumentVerificationRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DocumentVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Document == nil {
+					x.Document = &v1.Document{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Document); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredVerifications", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredVerifications = append(x.RequiredVerifications, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedVerifications", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompletedVerifications = append(x.CompletedVerifications, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= v1.VerificationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorMessage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MessageRecord_4_list)(nil)
+
+type _MessageRecord_4_list struct {
+	list *[]string
+}
+
+func (x *_MessageRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MessageRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MessageRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MessageRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MessageRecord_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MessageRecord at list field DeliveredTo as it is not of Message kind"))
+}
+
+func (x *_MessageRecord_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MessageRecord_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MessageRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MessageRecord_5_list)(nil)
+
+type _MessageRecord_5_list struct {
+	list *[]string
+}
+
+func (x *_MessageRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MessageRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MessageRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MessageRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MessageRecord_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MessageRecord at list field FailedDeliveries as it is not of Message kind"))
+}
+
+func (x *_MessageRecord_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MessageRecord_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MessageRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MessageRecord                               protoreflect.MessageDescriptor
+	fd_MessageRecord_creator                       protoreflect.FieldDescriptor
+	fd_MessageRecord_message                       protoreflect.FieldDescriptor
+	fd_MessageRecord_require_delivery_confirmation protoreflect.FieldDescriptor
+	fd_MessageRecord_delivered_to                  protoreflect.FieldDescriptor
+	fd_MessageRecord_failed_deliveries             protoreflect.FieldDescriptor
+	fd_MessageRecord_created_at                    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_MessageRecord = File_hub_crosschain_records_proto.Messages().ByName("MessageRecord")
+	fd_MessageRecord_creator = md_MessageRecord.Fields().ByName("creator")
+	fd_MessageRecord_message = md_MessageRecord.Fields().ByName("message")
+	fd_MessageRecord_require_delivery_confirmation = md_MessageRecord.Fields().ByName("require_delivery_confirmation")
+	fd_MessageRecord_delivered_to = md_MessageRecord.Fields().ByName("delivered_to")
+	fd_MessageRecord_failed_deliveries = md_MessageRecord.Fields().ByName("failed_deliveries")
+	fd_MessageRecord_created_at = md_MessageRecord.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_MessageRecord)(nil)
+
+type fastReflection_MessageRecord MessageRecord
+
+func (x *MessageRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessageRecord)(x)
+}
+
+func (x *MessageRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MessageRecord_messageType fastReflection_MessageRecord_messageType
+var _ protoreflect.MessageType = fastReflection_MessageRecord_messageType{}
+
+type fastReflection_MessageRecord_messageType struct{}
+
+func (x fastReflection_MessageRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessageRecord)(nil)
+}
+func (x fastReflection_MessageRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessageRecord)
+}
+func (x fastReflection_MessageRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MessageRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MessageRecord) Type() protoreflect.MessageType {
+	return _fastReflection_MessageRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MessageRecord) New() protoreflect.Message {
+	return new(fastReflection_MessageRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MessageRecord) Interface() protoreflect.ProtoMessage {
+	return (*MessageRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MessageRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MessageRecord_creator, value) {
+			return
+		}
+	}
+	if x.Message != nil {
+		value := protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+		if !f(fd_MessageRecord_message, value) {
+			return
+		}
+	}
+	if x.RequireDeliveryConfirmation != false {
+		value := protoreflect.ValueOfBool(x.RequireDeliveryConfirmation)
+		if !f(fd_MessageRecord_require_delivery_confirmation, value) {
+			return
+		}
+	}
+	if len(x.DeliveredTo) != 0 {
+		value := protoreflect.ValueOfList(&_MessageRecord_4_list{list: &x.DeliveredTo})
+		if !f(fd_MessageRecord_delivered_to, value) {
+			return
+		}
+	}
+	if len(x.FailedDeliveries) != 0 {
+		value := protoreflect.ValueOfList(&_MessageRecord_5_list{list: &x.FailedDeliveries})
+		if !f(fd_MessageRecord_failed_deliveries, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_MessageRecord_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MessageRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.MessageRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.MessageRecord.message":
+		return x.Message != nil
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		return x.RequireDeliveryConfirmation != false
+	case "hub.crosschain.MessageRecord.delivered_to":
+		return len(x.DeliveredTo) != 0
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		return len(x.FailedDeliveries) != 0
+	case "hub.crosschain.MessageRecord.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.MessageRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.MessageRecord.message":
+		x.Message = nil
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		x.RequireDeliveryConfirmation = false
+	case "hub.crosschain.MessageRecord.delivered_to":
+		x.DeliveredTo = nil
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		x.FailedDeliveries = nil
+	case "hub.crosschain.MessageRecord.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MessageRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.MessageRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.MessageRecord.message":
+		value := x.Message
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		value := x.RequireDeliveryConfirmation
+		return protoreflect.ValueOfBool(value)
+	case "hub.crosschain.MessageRecord.delivered_to":
+		if len(x.DeliveredTo) == 0 {
+			return protoreflect.ValueOfList(&_MessageRecord_4_list{})
+		}
+		listValue := &_MessageRecord_4_list{list: &x.DeliveredTo}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		if len(x.FailedDeliveries) == 0 {
+			return protoreflect.ValueOfList(&_MessageRecord_5_list{})
+		}
+		listValue := &_MessageRecord_5_list{list: &x.FailedDeliveries}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.MessageRecord.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.MessageRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.MessageRecord.message":
+		x.Message = value.Message().Interface().(*v1.RealTimeMessage)
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		x.RequireDeliveryConfirmation = value.Bool()
+	case "hub.crosschain.MessageRecord.delivered_to":
+		lv := value.List()
+		clv := lv.(*_MessageRecord_4_list)
+		x.DeliveredTo = *clv.list
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		lv := value.List()
+		clv := lv.(*_MessageRecord_5_list)
+		x.FailedDeliveries = *clv.list
+	case "hub.crosschain.MessageRecord.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.MessageRecord.message":
+		if x.Message == nil {
+			x.Message = new(v1.RealTimeMessage)
+		}
+		return protoreflect.ValueOfMessage(x.Message.ProtoReflect())
+	case "hub.crosschain.MessageRecord.delivered_to":
+		if x.DeliveredTo == nil {
+			x.DeliveredTo = []string{}
+		}
+		value := &_MessageRecord_4_list{list: &x.DeliveredTo}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		if x.FailedDeliveries == nil {
+			x.FailedDeliveries = []string{}
+		}
+		value := &_MessageRecord_5_list{list: &x.FailedDeliveries}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.MessageRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.MessageRecord is not mutable"))
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		panic(fmt.Errorf("field require_delivery_confirmation of message hub.crosschain.MessageRecord is not mutable"))
+	case "hub.crosschain.MessageRecord.created_at":
+		panic(fmt.Errorf("field created_at of message hub.crosschain.MessageRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MessageRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.MessageRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.MessageRecord.message":
+		m := new(v1.RealTimeMessage)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.MessageRecord.require_delivery_confirmation":
+		return protoreflect.ValueOfBool(false)
+	case "hub.crosschain.MessageRecord.delivered_to":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MessageRecord_4_list{list: &list})
+	case "hub.crosschain.MessageRecord.failed_deliveries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MessageRecord_5_list{list: &list})
+	case "hub.crosschain.MessageRecord.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.MessageRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.MessageRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MessageRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.MessageRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MessageRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MessageRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MessageRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MessageRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Message != nil {
+			l = options.Size(x.Message)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequireDeliveryConfirmation {
+			n += 2
+		}
+		if len(x.DeliveredTo) > 0 {
+			for _, s := range x.DeliveredTo {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FailedDeliveries) > 0 {
+			for _, s := range x.FailedDeliveries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MessageRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.FailedDeliveries) > 0 {
+			for iNdEx := len(x.FailedDeliveries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FailedDeliveries[iNdEx])
+				copy(dAtA[i:], x.FailedDeliveries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailedDeliveries[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DeliveredTo) > 0 {
+			for iNdEx := len(x.DeliveredTo) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeliveredTo[iNdEx])
+				copy(dAtA[i:], x.DeliveredTo[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeliveredTo[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.RequireDeliveryConfirmation {
+			i--
+			if x.RequireDeliveryConfirmation {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Message != nil {
+			encoded, err := options.Marshal(x.Message)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MessageRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Message == nil {
+					x.Message = &v1.RealTimeMessage{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Message); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireDeliveryConfirmation", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireDeliveryConfirmation = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeliveredTo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeliveredTo = append(x.DeliveredTo, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedDeliveries", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedDeliveries = append(x.FailedDeliveries, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VideoSignalRecord              protoreflect.MessageDescriptor
+	fd_VideoSignalRecord_signal_id    protoreflect.FieldDescriptor
+	fd_VideoSignalRecord_creator      protoreflect.FieldDescriptor
+	fd_VideoSignalRecord_signal       protoreflect.FieldDescriptor
+	fd_VideoSignalRecord_acknowledged protoreflect.FieldDescriptor
+	fd_VideoSignalRecord_created_at   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_VideoSignalRecord = File_hub_crosschain_records_proto.Messages().ByName("VideoSignalRecord")
+	fd_VideoSignalRecord_signal_id = md_VideoSignalRecord.Fields().ByName("signal_id")
+	fd_VideoSignalRecord_creator = md_VideoSignalRecord.Fields().ByName("creator")
+	fd_VideoSignalRecord_signal = md_VideoSignalRecord.Fields().ByName("signal")
+	fd_VideoSignalRecord_acknowledged = md_VideoSignalRecord.Fields().ByName("acknowledged")
+	fd_VideoSignalRecord_created_at = md_VideoSignalRecord.Fields().ByName("created_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_VideoSignalRecord)(nil)
+
+type fastReflection_VideoSignalRecord VideoSignalRecord
+
+func (x *VideoSignalRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VideoSignalRecord)(x)
+}
+
+func (x *VideoSignalRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VideoSignalRecord_messageType fastReflection_VideoSignalRecord_messageType
+var _ protoreflect.MessageType = fastReflection_VideoSignalRecord_messageType{}
+
+type fastReflection_VideoSignalRecord_messageType struct{}
+
+func (x fastReflection_VideoSignalRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VideoSignalRecord)(nil)
+}
+func (x fastReflection_VideoSignalRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_VideoSignalRecord)
+}
+func (x fastReflection_VideoSignalRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VideoSignalRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VideoSignalRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_VideoSignalRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VideoSignalRecord) Type() protoreflect.MessageType {
+	return _fastReflection_VideoSignalRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VideoSignalRecord) New() protoreflect.Message {
+	return new(fastReflection_VideoSignalRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VideoSignalRecord) Interface() protoreflect.ProtoMessage {
+	return (*VideoSignalRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VideoSignalRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SignalId != "" {
+		value := protoreflect.ValueOfString(x.SignalId)
+		if !f(fd_VideoSignalRecord_signal_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_VideoSignalRecord_creator, value) {
+			return
+		}
+	}
+	if x.Signal != nil {
+		value := protoreflect.ValueOfMessage(x.Signal.ProtoReflect())
+		if !f(fd_VideoSignalRecord_signal, value) {
+			return
+		}
+	}
+	if x.Acknowledged != false {
+		value := protoreflect.ValueOfBool(x.Acknowledged)
+		if !f(fd_VideoSignalRecord_acknowledged, value) {
+			return
+		}
+	}
+	if x.CreatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedAt)
+		if !f(fd_VideoSignalRecord_created_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VideoSignalRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		return x.SignalId != ""
+	case "hub.crosschain.VideoSignalRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.VideoSignalRecord.signal":
+		return x.Signal != nil
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		return x.Acknowledged != false
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		return x.CreatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VideoSignalRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		x.SignalId = ""
+	case "hub.crosschain.VideoSignalRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.VideoSignalRecord.signal":
+		x.Signal = nil
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		x.Acknowledged = false
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		x.CreatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VideoSignalRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		value := x.SignalId
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.VideoSignalRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.VideoSignalRecord.signal":
+		value := x.Signal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		value := x.Acknowledged
+		return protoreflect.ValueOfBool(value)
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VideoSignalRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		x.SignalId = value.Interface().(string)
+	case "hub.crosschain.VideoSignalRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.VideoSignalRecord.signal":
+		x.Signal = value.Message().Interface().(*v1.VideoSignal)
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		x.Acknowledged = value.Bool()
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		x.CreatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VideoSignalRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal":
+		if x.Signal == nil {
+			x.Signal = new(v1.VideoSignal)
+		}
+		return protoreflect.ValueOfMessage(x.Signal.ProtoReflect())
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		panic(fmt.Errorf("field signal_id of message hub.crosschain.VideoSignalRecord is not mutable"))
+	case "hub.crosschain.VideoSignalRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.VideoSignalRecord is not mutable"))
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		panic(fmt.Errorf("field acknowledged of message hub.crosschain.VideoSignalRecord is not mutable"))
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		panic(fmt.Errorf("field created_at of message hub.crosschain.VideoSignalRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VideoSignalRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.VideoSignalRecord.signal_id":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.VideoSignalRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.VideoSignalRecord.signal":
+		m := new(v1.VideoSignal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.VideoSignalRecord.acknowledged":
+		return protoreflect.ValueOfBool(false)
+	case "hub.crosschain.VideoSignalRecord.created_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.VideoSignalRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.VideoSignalRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VideoSignalRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.VideoSignalRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VideoSignalRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VideoSignalRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VideoSignalRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VideoSignalRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VideoSignalRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SignalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Signal != nil {
+			l = options.Size(x.Signal)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Acknowledged {
+			n += 2
+		}
+		if x.CreatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VideoSignalRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Acknowledged {
+			i--
+			if x.Acknowledged {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Signal != nil {
+			encoded, err := options.Marshal(x.Signal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SignalId) > 0 {
+			i -= len(x.SignalId)
+			copy(dAtA[i:], x.SignalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SignalId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VideoSignalRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VideoSignalRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VideoSignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SignalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Signal == nil {
+					x.Signal = &v1.VideoSignal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Acknowledged = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				x.CreatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_DeviceAuthRecord_3_list)(nil)
+
+type _DeviceAuthRecord_3_list struct {
+	list *[]string
+}
+
+func (x *_DeviceAuthRecord_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DeviceAuthRecord_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DeviceAuthRecord_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DeviceAuthRecord_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DeviceAuthRecord_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DeviceAuthRecord at list field RequiredFactors as it is not of Message kind"))
+}
+
+func (x *_DeviceAuthRecord_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DeviceAuthRecord_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DeviceAuthRecord_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DeviceAuthRecord                  protoreflect.MessageDescriptor
+	fd_DeviceAuthRecord_creator          protoreflect.FieldDescriptor
+	fd_DeviceAuthRecord_auth             protoreflect.FieldDescriptor
+	fd_DeviceAuthRecord_required_factors protoreflect.FieldDescriptor
+	fd_DeviceAuthRecord_auth_token       protoreflect.FieldDescriptor
+	fd_DeviceAuthRecord_token_expiry     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_DeviceAuthRecord = File_hub_crosschain_records_proto.Messages().ByName("DeviceAuthRecord")
+	fd_DeviceAuthRecord_creator = md_DeviceAuthRecord.Fields().ByName("creator")
+	fd_DeviceAuthRecord_auth = md_DeviceAuthRecord.Fields().ByName("auth")
+	fd_DeviceAuthRecord_required_factors = md_DeviceAuthRecord.Fields().ByName("required_factors")
+	fd_DeviceAuthRecord_auth_token = md_DeviceAuthRecord.Fields().ByName("auth_token")
+	fd_DeviceAuthRecord_token_expiry = md_DeviceAuthRecord.Fields().ByName("token_expiry")
+}
+
+var _ protoreflect.Message = (*fastReflection_DeviceAuthRecord)(nil)
+
+type fastReflection_DeviceAuthRecord DeviceAuthRecord
+
+func (x *DeviceAuthRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DeviceAuthRecord)(x)
+}
+
+func (x *DeviceAuthRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DeviceAuthRecord_messageType fastReflection_DeviceAuthRecord_messageType
+var _ protoreflect.MessageType = fastReflection_DeviceAuthRecord_messageType{}
+
+type fastReflection_DeviceAuthRecord_messageType struct{}
+
+func (x fastReflection_DeviceAuthRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DeviceAuthRecord)(nil)
+}
+func (x fastReflection_DeviceAuthRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_DeviceAuthRecord)
+}
+func (x fastReflection_DeviceAuthRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DeviceAuthRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DeviceAuthRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_DeviceAuthRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DeviceAuthRecord) Type() protoreflect.MessageType {
+	return _fastReflection_DeviceAuthRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DeviceAuthRecord) New() protoreflect.Message {
+	return new(fastReflection_DeviceAuthRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DeviceAuthRecord) Interface() protoreflect.ProtoMessage {
+	return (*DeviceAuthRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DeviceAuthRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_DeviceAuthRecord_creator, value) {
+			return
+		}
+	}
+	if x.Auth != nil {
+		value := protoreflect.ValueOfMessage(x.Auth.ProtoReflect())
+		if !f(fd_DeviceAuthRecord_auth, value) {
+			return
+		}
+	}
+	if len(x.RequiredFactors) != 0 {
+		value := protoreflect.ValueOfList(&_DeviceAuthRecord_3_list{list: &x.RequiredFactors})
+		if !f(fd_DeviceAuthRecord_required_factors, value) {
+			return
+		}
+	}
+	if x.AuthToken != "" {
+		value := protoreflect.ValueOfString(x.AuthToken)
+		if !f(fd_DeviceAuthRecord_auth_token, value) {
+			return
+		}
+	}
+	if x.TokenExpiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TokenExpiry)
+		if !f(fd_DeviceAuthRecord_token_expiry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DeviceAuthRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		return x.Auth != nil
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		return len(x.RequiredFactors) != 0
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		return x.AuthToken != ""
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		return x.TokenExpiry != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DeviceAuthRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		x.Auth = nil
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		x.RequiredFactors = nil
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		x.AuthToken = ""
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		x.TokenExpiry = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DeviceAuthRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		value := x.Auth
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		if len(x.RequiredFactors) == 0 {
+			return protoreflect.ValueOfList(&_DeviceAuthRecord_3_list{})
+		}
+		listValue := &_DeviceAuthRecord_3_list{list: &x.RequiredFactors}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		value := x.AuthToken
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		value := x.TokenExpiry
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DeviceAuthRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		x.Auth = value.Message().Interface().(*v1.DeviceAuth)
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		lv := value.List()
+		clv := lv.(*_DeviceAuthRecord_3_list)
+		x.RequiredFactors = *clv.list
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		x.AuthToken = value.Interface().(string)
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		x.TokenExpiry = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DeviceAuthRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		if x.Auth == nil {
+			x.Auth = new(v1.DeviceAuth)
+		}
+		return protoreflect.ValueOfMessage(x.Auth.ProtoReflect())
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		if x.RequiredFactors == nil {
+			x.RequiredFactors = []string{}
+		}
+		value := &_DeviceAuthRecord_3_list{list: &x.RequiredFactors}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.DeviceAuthRecord is not mutable"))
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		panic(fmt.Errorf("field auth_token of message hub.crosschain.DeviceAuthRecord is not mutable"))
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		panic(fmt.Errorf("field token_expiry of message hub.crosschain.DeviceAuthRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DeviceAuthRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.DeviceAuthRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.DeviceAuthRecord.auth":
+		m := new(v1.DeviceAuth)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.DeviceAuthRecord.required_factors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DeviceAuthRecord_3_list{list: &list})
+	case "hub.crosschain.DeviceAuthRecord.auth_token":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.DeviceAuthRecord.token_expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.DeviceAuthRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.DeviceAuthRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DeviceAuthRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.DeviceAuthRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DeviceAuthRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DeviceAuthRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DeviceAuthRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DeviceAuthRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DeviceAuthRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Auth != nil {
+			l = options.Size(x.Auth)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequiredFactors) > 0 {
+			for _, s := range x.RequiredFactors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.AuthToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TokenExpiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.TokenExpiry))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DeviceAuthRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TokenExpiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TokenExpiry))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AuthToken) > 0 {
+			i -= len(x.AuthToken)
+			copy(dAtA[i:], x.AuthToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuthToken)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RequiredFactors) > 0 {
+			for iNdEx := len(x.RequiredFactors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredFactors[iNdEx])
+				copy(dAtA[i:], x.RequiredFactors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredFactors[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Auth != nil {
+			encoded, err := options.Marshal(x.Auth)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DeviceAuthRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DeviceAuthRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DeviceAuthRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Auth == nil {
+					x.Auth = &v1.DeviceAuth{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auth); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredFactors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredFactors = append(x.RequiredFactors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenExpiry", wireType)
+				}
+				x.TokenExpiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TokenExpiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ServiceVerificationRecord_4_list)(nil)
+
+type _ServiceVerificationRecord_4_list struct {
+	list *[]string
+}
+
+func (x *_ServiceVerificationRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceVerificationRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ServiceVerificationRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceVerificationRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceVerificationRecord_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceVerificationRecord at list field RequiredCapabilities as it is not of Message kind"))
+}
+
+func (x *_ServiceVerificationRecord_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceVerificationRecord_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ServiceVerificationRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ServiceVerificationRecord_5_list)(nil)
+
+type _ServiceVerificationRecord_5_list struct {
+	list *[]string
+}
+
+func (x *_ServiceVerificationRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceVerificationRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ServiceVerificationRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceVerificationRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceVerificationRecord_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceVerificationRecord at list field VerifiedCapabilities as it is not of Message kind"))
+}
+
+func (x *_ServiceVerificationRecord_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceVerificationRecord_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ServiceVerificationRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ServiceVerificationRecord                       protoreflect.MessageDescriptor
+	fd_ServiceVerificationRecord_verification_id       protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_creator               protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_service               protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_required_capabilities protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_verified_capabilities protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_status                protoreflect.FieldDescriptor
+	fd_ServiceVerificationRecord_error_message         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_crosschain_records_proto_init()
+	md_ServiceVerificationRecord = File_hub_crosschain_records_proto.Messages().ByName("ServiceVerificationRecord")
+	fd_ServiceVerificationRecord_verification_id = md_ServiceVerificationRecord.Fields().ByName("verification_id")
+	fd_ServiceVerificationRecord_creator = md_ServiceVerificationRecord.Fields().ByName("creator")
+	fd_ServiceVerificationRecord_service = md_ServiceVerificationRecord.Fields().ByName("service")
+	fd_ServiceVerificationRecord_required_capabilities = md_ServiceVerificationRecord.Fields().ByName("required_capabilities")
+	fd_ServiceVerificationRecord_verified_capabilities = md_ServiceVerificationRecord.Fields().ByName("verified_capabilities")
+	fd_ServiceVerificationRecord_status = md_ServiceVerificationRecord.Fields().ByName("status")
+	fd_ServiceVerificationRecord_error_message = md_ServiceVerificationRecord.Fields().ByName("error_message")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceVerificationRecord)(nil)
+
+type fastReflection_ServiceVerificationRecord ServiceVerificationRecord
+
+func (x *ServiceVerificationRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceVerificationRecord)(x)
+}
+
+func (x *ServiceVerificationRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_crosschain_records_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceVerificationRecord_messageType fastReflection_ServiceVerificationRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceVerificationRecord_messageType{}
+
+type fastReflection_ServiceVerificationRecord_messageType struct{}
+
+func (x fastReflection_ServiceVerificationRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceVerificationRecord)(nil)
+}
+func (x fastReflection_ServiceVerificationRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceVerificationRecord)
+}
+func (x fastReflection_ServiceVerificationRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceVerificationRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceVerificationRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceVerificationRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceVerificationRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceVerificationRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceVerificationRecord) New() protoreflect.Message {
+	return new(fastReflection_ServiceVerificationRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceVerificationRecord) Interface() protoreflect.ProtoMessage {
+	return (*ServiceVerificationRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceVerificationRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VerificationId != "" {
+		value := protoreflect.ValueOfString(x.VerificationId)
+		if !f(fd_ServiceVerificationRecord_verification_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_ServiceVerificationRecord_creator, value) {
+			return
+		}
+	}
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_ServiceVerificationRecord_service, value) {
+			return
+		}
+	}
+	if len(x.RequiredCapabilities) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceVerificationRecord_4_list{list: &x.RequiredCapabilities})
+		if !f(fd_ServiceVerificationRecord_required_capabilities, value) {
+			return
+		}
+	}
+	if len(x.VerifiedCapabilities) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceVerificationRecord_5_list{list: &x.VerifiedCapabilities})
+		if !f(fd_ServiceVerificationRecord_verified_capabilities, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_ServiceVerificationRecord_status, value) {
+			return
+		}
+	}
+	if x.ErrorMessage != "" {
+		value := protoreflect.ValueOfString(x.ErrorMessage)
+		if !f(fd_ServiceVerificationRecord_error_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceVerificationRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		return x.VerificationId != ""
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		return x.Creator != ""
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		return x.Service != nil
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		return len(x.RequiredCapabilities) != 0
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		return len(x.VerifiedCapabilities) != 0
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		return x.Status != 0
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		return x.ErrorMessage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceVerificationRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		x.VerificationId = ""
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		x.Creator = ""
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		x.Service = nil
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		x.RequiredCapabilities = nil
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		x.VerifiedCapabilities = nil
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		x.Status = 0
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		x.ErrorMessage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceVerificationRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		value := x.VerificationId
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		if len(x.RequiredCapabilities) == 0 {
+			return protoreflect.ValueOfList(&_ServiceVerificationRecord_4_list{})
+		}
+		listValue := &_ServiceVerificationRecord_4_list{list: &x.RequiredCapabilities}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		if len(x.VerifiedCapabilities) == 0 {
+			return protoreflect.ValueOfList(&_ServiceVerificationRecord_5_list{})
+		}
+		listValue := &_ServiceVerificationRecord_5_list{list: &x.VerifiedCapabilities}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		value := x.ErrorMessage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceVerificationRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		x.VerificationId = value.Interface().(string)
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		x.Creator = value.Interface().(string)
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		x.Service = value.Message().Interface().(*v1.ServiceVerification)
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		lv := value.List()
+		clv := lv.(*_ServiceVerificationRecord_4_list)
+		x.RequiredCapabilities = *clv.list
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		lv := value.List()
+		clv := lv.(*_ServiceVerificationRecord_5_list)
+		x.VerifiedCapabilities = *clv.list
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		x.Status = (v1.VerificationStatus)(value.Enum())
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		x.ErrorMessage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceVerificationRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		if x.Service == nil {
+			x.Service = new(v1.ServiceVerification)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		if x.RequiredCapabilities == nil {
+			x.RequiredCapabilities = []string{}
+		}
+		value := &_ServiceVerificationRecord_4_list{list: &x.RequiredCapabilities}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		if x.VerifiedCapabilities == nil {
+			x.VerifiedCapabilities = []string{}
+		}
+		value := &_ServiceVerificationRecord_5_list{list: &x.VerifiedCapabilities}
+		return protoreflect.ValueOfList(value)
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		panic(fmt.Errorf("field verification_id of message hub.crosschain.ServiceVerificationRecord is not mutable"))
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		panic(fmt.Errorf("field creator of message hub.crosschain.ServiceVerificationRecord is not mutable"))
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		panic(fmt.Errorf("field status of message hub.crosschain.ServiceVerificationRecord is not mutable"))
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		panic(fmt.Errorf("field error_message of message hub.crosschain.ServiceVerificationRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceVerificationRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.crosschain.ServiceVerificationRecord.verification_id":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.ServiceVerificationRecord.creator":
+		return protoreflect.ValueOfString("")
+	case "hub.crosschain.ServiceVerificationRecord.service":
+		m := new(v1.ServiceVerification)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.crosschain.ServiceVerificationRecord.required_capabilities":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceVerificationRecord_4_list{list: &list})
+	case "hub.crosschain.ServiceVerificationRecord.verified_capabilities":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceVerificationRecord_5_list{list: &list})
+	case "hub.crosschain.ServiceVerificationRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "hub.crosschain.ServiceVerificationRecord.error_message":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.crosschain.ServiceVerificationRecord"))
+		}
+		panic(fmt.Errorf("message hub.crosschain.ServiceVerificationRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceVerificationRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.crosschain.ServiceVerificationRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceVerificationRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceVerificationRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceVerificationRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceVerificationRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceVerificationRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.VerificationId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RequiredCapabilities) > 0 {
+			for _, s := range x.RequiredCapabilities {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerifiedCapabilities) > 0 {
+			for _, s := range x.VerifiedCapabilities {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.ErrorMessage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceVerificationRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ErrorMessage) > 0 {
+			i -= len(x.ErrorMessage)
+			copy(dAtA[i:], x.ErrorMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorMessage)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.VerifiedCapabilities) > 0 {
+			for iNdEx := len(x.VerifiedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerifiedCapabilities[iNdEx])
+				copy(dAtA[i:], x.VerifiedCapabilities[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifiedCapabilities[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RequiredCapabilities) > 0 {
+			for iNdEx := len(x.RequiredCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredCapabilities[iNdEx])
+				copy(dAtA[i:], x.RequiredCapabilities[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredCapabilities[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.VerificationId) > 0 {
+			i -= len(x.VerificationId)
+			copy(dAtA[i:], x.VerificationId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerificationId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceVerificationRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceVerificationRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &v1.ServiceVerification{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredCapabilities", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredCapabilities = append(x.RequiredCapabilities, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedCapabilities", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifiedCapabilities = append(x.VerifiedCapabilities, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= v1.VerificationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorMessage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.0
+// source: hub/crosschain/records.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProfileVerificationRecord stores a CrossChainService/VerifyUserProfile call.
+type ProfileVerificationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerificationId      string                `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator             string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Profile             *v1.UserProfile       `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	RequiredCredentials []string              `protobuf:"bytes,4,rep,name=required_credentials,json=requiredCredentials,proto3" json:"required_credentials,omitempty"`
+	VerifiedCredentials []string              `protobuf:"bytes,5,rep,name=verified_credentials,json=verifiedCredentials,proto3" json:"verified_credentials,omitempty"`
+	Status              v1.VerificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage        string                `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt           int64                 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ProfileVerificationRecord) Reset() {
+	*x = ProfileVerificationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileVerificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVerificationRecord) ProtoMessage() {}
+
+// Deprecated: Use ProfileVerificationRecord.ProtoReflect.Descriptor instead.
+func (*ProfileVerificationRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProfileVerificationRecord) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *ProfileVerificationRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *ProfileVerificationRecord) GetProfile() *v1.UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ProfileVerificationRecord) GetRequiredCredentials() []string {
+	if x != nil {
+		return x.RequiredCredentials
+	}
+	return nil
+}
+
+func (x *ProfileVerificationRecord) GetVerifiedCredentials() []string {
+	if x != nil {
+		return x.VerifiedCredentials
+	}
+	return nil
+}
+
+func (x *ProfileVerificationRecord) GetStatus() v1.VerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.VerificationStatus(0)
+}
+
+func (x *ProfileVerificationRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ProfileVerificationRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// DocumentVerificationRecord stores a CrossChainService/VerifyDocument call.
+type DocumentVerificationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerificationId         string                `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator                string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Document               *v1.Document          `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"`
+	RequiredVerifications  []string              `protobuf:"bytes,4,rep,name=required_verifications,json=requiredVerifications,proto3" json:"required_verifications,omitempty"`
+	CompletedVerifications []string              `protobuf:"bytes,5,rep,name=completed_verifications,json=completedVerifications,proto3" json:"completed_verifications,omitempty"`
+	Status                 v1.VerificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage           string                `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt              int64                 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DocumentVerificationRecord) Reset() {
+	*x = DocumentVerificationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentVerificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentVerificationRecord) ProtoMessage() {}
+
+// Deprecated: Use DocumentVerificationRecord.ProtoReflect.Descriptor instead.
+func (*DocumentVerificationRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DocumentVerificationRecord) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *DocumentVerificationRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *DocumentVerificationRecord) GetDocument() *v1.Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DocumentVerificationRecord) GetRequiredVerifications() []string {
+	if x != nil {
+		return x.RequiredVerifications
+	}
+	return nil
+}
+
+func (x *DocumentVerificationRecord) GetCompletedVerifications() []string {
+	if x != nil {
+		return x.CompletedVerifications
+	}
+	return nil
+}
+
+func (x *DocumentVerificationRecord) GetStatus() v1.VerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.VerificationStatus(0)
+}
+
+func (x *DocumentVerificationRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *DocumentVerificationRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// MessageRecord stores a CrossChainService/SendMessage call and its delivery outcome.
+type MessageRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator                     string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Message                     *v1.RealTimeMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequireDeliveryConfirmation bool                `protobuf:"varint,3,opt,name=require_delivery_confirmation,json=requireDeliveryConfirmation,proto3" json:"require_delivery_confirmation,omitempty"`
+	DeliveredTo                 []string            `protobuf:"bytes,4,rep,name=delivered_to,json=deliveredTo,proto3" json:"delivered_to,omitempty"`
+	FailedDeliveries            []string            `protobuf:"bytes,5,rep,name=failed_deliveries,json=failedDeliveries,proto3" json:"failed_deliveries,omitempty"`
+	CreatedAt                   int64               `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *MessageRecord) Reset() {
+	*x = MessageRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRecord) ProtoMessage() {}
+
+// Deprecated: Use MessageRecord.ProtoReflect.Descriptor instead.
+func (*MessageRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MessageRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MessageRecord) GetMessage() *v1.RealTimeMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessageRecord) GetRequireDeliveryConfirmation() bool {
+	if x != nil {
+		return x.RequireDeliveryConfirmation
+	}
+	return false
+}
+
+func (x *MessageRecord) GetDeliveredTo() []string {
+	if x != nil {
+		return x.DeliveredTo
+	}
+	return nil
+}
+
+func (x *MessageRecord) GetFailedDeliveries() []string {
+	if x != nil {
+		return x.FailedDeliveries
+	}
+	return nil
+}
+
+func (x *MessageRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// VideoSignalRecord stores a CrossChainService/SignalVideo call.
+type VideoSignalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignalId     string          `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	Creator      string          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Signal       *v1.VideoSignal `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Acknowledged bool            `protobuf:"varint,4,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	CreatedAt    int64           `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *VideoSignalRecord) Reset() {
+	*x = VideoSignalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoSignalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoSignalRecord) ProtoMessage() {}
+
+// Deprecated: Use VideoSignalRecord.ProtoReflect.Descriptor instead.
+func (*VideoSignalRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VideoSignalRecord) GetSignalId() string {
+	if x != nil {
+		return x.SignalId
+	}
+	return ""
+}
+
+func (x *VideoSignalRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *VideoSignalRecord) GetSignal() *v1.VideoSignal {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
+func (x *VideoSignalRecord) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *VideoSignalRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// DeviceAuthRecord stores the latest CrossChainService/AuthenticateDevice
+// outcome for a device.
+type DeviceAuthRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Auth            *v1.DeviceAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	RequiredFactors []string       `protobuf:"bytes,3,rep,name=required_factors,json=requiredFactors,proto3" json:"required_factors,omitempty"`
+	AuthToken       string         `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	TokenExpiry     int64          `protobuf:"varint,5,opt,name=token_expiry,json=tokenExpiry,proto3" json:"token_expiry,omitempty"`
+}
+
+func (x *DeviceAuthRecord) Reset() {
+	*x = DeviceAuthRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceAuthRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceAuthRecord) ProtoMessage() {}
+
+// Deprecated: Use DeviceAuthRecord.ProtoReflect.Descriptor instead.
+func (*DeviceAuthRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeviceAuthRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *DeviceAuthRecord) GetAuth() *v1.DeviceAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *DeviceAuthRecord) GetRequiredFactors() []string {
+	if x != nil {
+		return x.RequiredFactors
+	}
+	return nil
+}
+
+func (x *DeviceAuthRecord) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *DeviceAuthRecord) GetTokenExpiry() int64 {
+	if x != nil {
+		return x.TokenExpiry
+	}
+	return 0
+}
+
+// ServiceVerificationRecord stores a CrossChainService/VerifyService call.
+type ServiceVerificationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VerificationId       string                  `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator              string                  `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Service              *v1.ServiceVerification `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	RequiredCapabilities []string                `protobuf:"bytes,4,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	VerifiedCapabilities []string                `protobuf:"bytes,5,rep,name=verified_capabilities,json=verifiedCapabilities,proto3" json:"verified_capabilities,omitempty"`
+	Status               v1.VerificationStatus   `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage         string                  `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ServiceVerificationRecord) Reset() {
+	*x = ServiceVerificationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_crosschain_records_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceVerificationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceVerificationRecord) ProtoMessage() {}
+
+// Deprecated: Use ServiceVerificationRecord.ProtoReflect.Descriptor instead.
+func (*ServiceVerificationRecord) Descriptor() ([]byte, []int) {
+	return file_hub_crosschain_records_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServiceVerificationRecord) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *ServiceVerificationRecord) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *ServiceVerificationRecord) GetService() *v1.ServiceVerification {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ServiceVerificationRecord) GetRequiredCapabilities() []string {
+	if x != nil {
+		return x.RequiredCapabilities
+	}
+	return nil
+}
+
+func (x *ServiceVerificationRecord) GetVerifiedCapabilities() []string {
+	if x != nil {
+		return x.VerifiedCapabilities
+	}
+	return nil
+}
+
+func (x *ServiceVerificationRecord) GetStatus() v1.VerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v1.VerificationStatus(0)
+}
+
+func (x *ServiceVerificationRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+var File_hub_crosschain_records_proto protoreflect.FileDescriptor
+
+var file_hub_crosschain_records_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x68, 0x75, 0x62, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x18,
+	0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x02, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6c, 0x6f,
+	0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31,
+	0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x31, 0x0a, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x81, 0x03, 0x0a, 0x1a, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69,
+	0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x37, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a,
+	0x2e, 0x69, 0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc0,
+	0x01, 0x0a, 0x11, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6c,
+	0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xe4, 0x02, 0x0a, 0x19,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x33,
+	0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x18, 0x5a, 0x16, 0x68, 0x75, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75,
+	0x62, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hub_crosschain_records_proto_rawDescOnce sync.Once
+	file_hub_crosschain_records_proto_rawDescData = file_hub_crosschain_records_proto_rawDesc
+)
+
+func file_hub_crosschain_records_proto_rawDescGZIP() []byte {
+	file_hub_crosschain_records_proto_rawDescOnce.Do(func() {
+		file_hub_crosschain_records_proto_rawDescData = protoimpl.X.CompressGZIP(file_hub_crosschain_records_proto_rawDescData)
+	})
+	return file_hub_crosschain_records_proto_rawDescData
+}
+
+var file_hub_crosschain_records_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_hub_crosschain_records_proto_goTypes = []interface{}{
+	(*ProfileVerificationRecord)(nil),  // 0: hub.crosschain.ProfileVerificationRecord
+	(*DocumentVerificationRecord)(nil), // 1: hub.crosschain.DocumentVerificationRecord
+	(*MessageRecord)(nil),              // 2: hub.crosschain.MessageRecord
+	(*VideoSignalRecord)(nil),          // 3: hub.crosschain.VideoSignalRecord
+	(*DeviceAuthRecord)(nil),           // 4: hub.crosschain.DeviceAuthRecord
+	(*ServiceVerificationRecord)(nil),  // 5: hub.crosschain.ServiceVerificationRecord
+	(*v1.UserProfile)(nil),             // 6: bloqz.ibc.v1.UserProfile
+	(v1.VerificationStatus)(0),         // 7: bloqz.ibc.v1.VerificationStatus
+	(*v1.Document)(nil),                // 8: bloqz.ibc.v1.Document
+	(*v1.RealTimeMessage)(nil),         // 9: bloqz.ibc.v1.RealTimeMessage
+	(*v1.VideoSignal)(nil),             // 10: bloqz.ibc.v1.VideoSignal
+	(*v1.DeviceAuth)(nil),              // 11: bloqz.ibc.v1.DeviceAuth
+	(*v1.ServiceVerification)(nil),     // 12: bloqz.ibc.v1.ServiceVerification
+}
+var file_hub_crosschain_records_proto_depIdxs = []int32{
+	6,  // 0: hub.crosschain.ProfileVerificationRecord.profile:type_name -> bloqz.ibc.v1.UserProfile
+	7,  // 1: hub.crosschain.ProfileVerificationRecord.status:type_name -> bloqz.ibc.v1.VerificationStatus
+	8,  // 2: hub.crosschain.DocumentVerificationRecord.document:type_name -> bloqz.ibc.v1.Document
+	7,  // 3: hub.crosschain.DocumentVerificationRecord.status:type_name -> bloqz.ibc.v1.VerificationStatus
+	9,  // 4: hub.crosschain.MessageRecord.message:type_name -> bloqz.ibc.v1.RealTimeMessage
+	10, // 5: hub.crosschain.VideoSignalRecord.signal:type_name -> bloqz.ibc.v1.VideoSignal
+	11, // 6: hub.crosschain.DeviceAuthRecord.auth:type_name -> bloqz.ibc.v1.DeviceAuth
+	12, // 7: hub.crosschain.ServiceVerificationRecord.service:type_name -> bloqz.ibc.v1.ServiceVerification
+	7,  // 8: hub.crosschain.ServiceVerificationRecord.status:type_name -> bloqz.ibc.v1.VerificationStatus
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_hub_crosschain_records_proto_init() }
+func file_hub_crosschain_records_proto_init() {
+	if File_hub_crosschain_records_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hub_crosschain_records_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileVerificationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_crosschain_records_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentVerificationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_crosschain_records_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_crosschain_records_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoSignalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_crosschain_records_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceAuthRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_crosschain_records_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceVerificationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hub_crosschain_records_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hub_crosschain_records_proto_goTypes,
+		DependencyIndexes: file_hub_crosschain_records_proto_depIdxs,
+		MessageInfos:      file_hub_crosschain_records_proto_msgTypes,
+	}.Build()
+	File_hub_crosschain_records_proto = out.File
+	file_hub_crosschain_records_proto_rawDesc = nil
+	file_hub_crosschain_records_proto_goTypes = nil
+	file_hub_crosschain_records_proto_depIdxs = nil
+}
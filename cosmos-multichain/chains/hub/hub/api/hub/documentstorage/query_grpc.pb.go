@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: hub/documentstorage/query.proto
+
+package documentstorage
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                  = "/hub.documentstorage.Query/Params"
+	Query_Document_FullMethodName                = "/hub.documentstorage.Query/Document"
+	Query_DocumentAll_FullMethodName             = "/hub.documentstorage.Query/DocumentAll"
+	Query_DocumentsByOwner_FullMethodName        = "/hub.documentstorage.Query/DocumentsByOwner"
+	Query_DocumentsByType_FullMethodName         = "/hub.documentstorage.Query/DocumentsByType"
+	Query_DocumentsByIndustry_FullMethodName     = "/hub.documentstorage.Query/DocumentsByIndustry"
+	Query_DocumentsByCreationDate_FullMethodName = "/hub.documentstorage.Query/DocumentsByCreationDate"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Document items.
+	Document(ctx context.Context, in *QueryGetDocumentRequest, opts ...grpc.CallOption) (*QueryGetDocumentResponse, error)
+	DocumentAll(ctx context.Context, in *QueryAllDocumentRequest, opts ...grpc.CallOption) (*QueryAllDocumentResponse, error)
+	// Queries documents through the owner index.
+	DocumentsByOwner(ctx context.Context, in *QueryDocumentsByOwnerRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error)
+	// Queries documents through the document_type index.
+	DocumentsByType(ctx context.Context, in *QueryDocumentsByTypeRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error)
+	// Queries documents through the industry index.
+	DocumentsByIndustry(ctx context.Context, in *QueryDocumentsByIndustryRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error)
+	// Queries documents created within [start, end], both unix seconds.
+	DocumentsByCreationDate(ctx context.Context, in *QueryDocumentsByCreationDateRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Document(ctx context.Context, in *QueryGetDocumentRequest, opts ...grpc.CallOption) (*QueryGetDocumentResponse, error) {
+	out := new(QueryGetDocumentResponse)
+	err := c.cc.Invoke(ctx, Query_Document_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentAll(ctx context.Context, in *QueryAllDocumentRequest, opts ...grpc.CallOption) (*QueryAllDocumentResponse, error) {
+	out := new(QueryAllDocumentResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentsByOwner(ctx context.Context, in *QueryDocumentsByOwnerRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error) {
+	out := new(QueryDocumentsResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentsByType(ctx context.Context, in *QueryDocumentsByTypeRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error) {
+	out := new(QueryDocumentsResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentsByType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentsByIndustry(ctx context.Context, in *QueryDocumentsByIndustryRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error) {
+	out := new(QueryDocumentsResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentsByIndustry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentsByCreationDate(ctx context.Context, in *QueryDocumentsByCreationDateRequest, opts ...grpc.CallOption) (*QueryDocumentsResponse, error) {
+	out := new(QueryDocumentsResponse)
+	err := c.cc.Invoke(ctx, Query_DocumentsByCreationDate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Document items.
+	Document(context.Context, *QueryGetDocumentRequest) (*QueryGetDocumentResponse, error)
+	DocumentAll(context.Context, *QueryAllDocumentRequest) (*QueryAllDocumentResponse, error)
+	// Queries documents through the owner index.
+	DocumentsByOwner(context.Context, *QueryDocumentsByOwnerRequest) (*QueryDocumentsResponse, error)
+	// Queries documents through the document_type index.
+	DocumentsByType(context.Context, *QueryDocumentsByTypeRequest) (*QueryDocumentsResponse, error)
+	// Queries documents through the industry index.
+	DocumentsByIndustry(context.Context, *QueryDocumentsByIndustryRequest) (*QueryDocumentsResponse, error)
+	// Queries documents created within [start, end], both unix seconds.
+	DocumentsByCreationDate(context.Context, *QueryDocumentsByCreationDateRequest) (*QueryDocumentsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Document(context.Context, *QueryGetDocumentRequest) (*QueryGetDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Document not implemented")
+}
+func (UnimplementedQueryServer) DocumentAll(context.Context, *QueryAllDocumentRequest) (*QueryAllDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentAll not implemented")
+}
+func (UnimplementedQueryServer) DocumentsByOwner(context.Context, *QueryDocumentsByOwnerRequest) (*QueryDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByOwner not implemented")
+}
+func (UnimplementedQueryServer) DocumentsByType(context.Context, *QueryDocumentsByTypeRequest) (*QueryDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByType not implemented")
+}
+func (UnimplementedQueryServer) DocumentsByIndustry(context.Context, *QueryDocumentsByIndustryRequest) (*QueryDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByIndustry not implemented")
+}
+func (UnimplementedQueryServer) DocumentsByCreationDate(context.Context, *QueryDocumentsByCreationDateRequest) (*QueryDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentsByCreationDate not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Document_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Document(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Document_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Document(ctx, req.(*QueryGetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentAll(ctx, req.(*QueryAllDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentsByOwner(ctx, req.(*QueryDocumentsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentsByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentsByType(ctx, req.(*QueryDocumentsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentsByIndustry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentsByIndustryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentsByIndustry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentsByIndustry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentsByIndustry(ctx, req.(*QueryDocumentsByIndustryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentsByCreationDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDocumentsByCreationDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentsByCreationDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DocumentsByCreationDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentsByCreationDate(ctx, req.(*QueryDocumentsByCreationDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hub.documentstorage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Document",
+			Handler:    _Query_Document_Handler,
+		},
+		{
+			MethodName: "DocumentAll",
+			Handler:    _Query_DocumentAll_Handler,
+		},
+		{
+			MethodName: "DocumentsByOwner",
+			Handler:    _Query_DocumentsByOwner_Handler,
+		},
+		{
+			MethodName: "DocumentsByType",
+			Handler:    _Query_DocumentsByType_Handler,
+		},
+		{
+			MethodName: "DocumentsByIndustry",
+			Handler:    _Query_DocumentsByIndustry_Handler,
+		},
+		{
+			MethodName: "DocumentsByCreationDate",
+			Handler:    _Query_DocumentsByCreationDate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hub/documentstorage/query.proto",
+}
@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package integrationverification
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v1 "hub/api/bloqz/ibc/v1"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ServiceRecord_3_list)(nil)
+
+type _ServiceRecord_3_list struct {
+	list *[]string
+}
+
+func (x *_ServiceRecord_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ServiceRecord_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ServiceRecord_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ServiceRecord_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ServiceRecord_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ServiceRecord at list field VerifiedCapabilities as it is not of Message kind"))
+}
+
+func (x *_ServiceRecord_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ServiceRecord_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ServiceRecord_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ServiceRecord                       protoreflect.MessageDescriptor
+	fd_ServiceRecord_service               protoreflect.FieldDescriptor
+	fd_ServiceRecord_owner                 protoreflect.FieldDescriptor
+	fd_ServiceRecord_verified_capabilities protoreflect.FieldDescriptor
+	fd_ServiceRecord_last_verifier         protoreflect.FieldDescriptor
+	fd_ServiceRecord_next_verification     protoreflect.FieldDescriptor
+	fd_ServiceRecord_retry_count           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_hub_integrationverification_service_proto_init()
+	md_ServiceRecord = File_hub_integrationverification_service_proto.Messages().ByName("ServiceRecord")
+	fd_ServiceRecord_service = md_ServiceRecord.Fields().ByName("service")
+	fd_ServiceRecord_owner = md_ServiceRecord.Fields().ByName("owner")
+	fd_ServiceRecord_verified_capabilities = md_ServiceRecord.Fields().ByName("verified_capabilities")
+	fd_ServiceRecord_last_verifier = md_ServiceRecord.Fields().ByName("last_verifier")
+	fd_ServiceRecord_next_verification = md_ServiceRecord.Fields().ByName("next_verification")
+	fd_ServiceRecord_retry_count = md_ServiceRecord.Fields().ByName("retry_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceRecord)(nil)
+
+type fastReflection_ServiceRecord ServiceRecord
+
+func (x *ServiceRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceRecord)(x)
+}
+
+func (x *ServiceRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_hub_integrationverification_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceRecord_messageType fastReflection_ServiceRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceRecord_messageType{}
+
+type fastReflection_ServiceRecord_messageType struct{}
+
+func (x fastReflection_ServiceRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceRecord)(nil)
+}
+func (x fastReflection_ServiceRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceRecord)
+}
+func (x fastReflection_ServiceRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceRecord) New() protoreflect.Message {
+	return new(fastReflection_ServiceRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceRecord) Interface() protoreflect.ProtoMessage {
+	return (*ServiceRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_ServiceRecord_service, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_ServiceRecord_owner, value) {
+			return
+		}
+	}
+	if len(x.VerifiedCapabilities) != 0 {
+		value := protoreflect.ValueOfList(&_ServiceRecord_3_list{list: &x.VerifiedCapabilities})
+		if !f(fd_ServiceRecord_verified_capabilities, value) {
+			return
+		}
+	}
+	if x.LastVerifier != "" {
+		value := protoreflect.ValueOfString(x.LastVerifier)
+		if !f(fd_ServiceRecord_last_verifier, value) {
+			return
+		}
+	}
+	if x.NextVerification != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextVerification)
+		if !f(fd_ServiceRecord_next_verification, value) {
+			return
+		}
+	}
+	if x.RetryCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RetryCount)
+		if !f(fd_ServiceRecord_retry_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		return x.Service != nil
+	case "hub.integrationverification.ServiceRecord.owner":
+		return x.Owner != ""
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		return len(x.VerifiedCapabilities) != 0
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		return x.LastVerifier != ""
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		return x.NextVerification != int64(0)
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		return x.RetryCount != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		x.Service = nil
+	case "hub.integrationverification.ServiceRecord.owner":
+		x.Owner = ""
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		x.VerifiedCapabilities = nil
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		x.LastVerifier = ""
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		x.NextVerification = int64(0)
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		x.RetryCount = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "hub.integrationverification.ServiceRecord.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		if len(x.VerifiedCapabilities) == 0 {
+			return protoreflect.ValueOfList(&_ServiceRecord_3_list{})
+		}
+		listValue := &_ServiceRecord_3_list{list: &x.VerifiedCapabilities}
+		return protoreflect.ValueOfList(listValue)
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		value := x.LastVerifier
+		return protoreflect.ValueOfString(value)
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		value := x.NextVerification
+		return protoreflect.ValueOfInt64(value)
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		value := x.RetryCount
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		x.Service = value.Message().Interface().(*v1.ServiceVerification)
+	case "hub.integrationverification.ServiceRecord.owner":
+		x.Owner = value.Interface().(string)
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		lv := value.List()
+		clv := lv.(*_ServiceRecord_3_list)
+		x.VerifiedCapabilities = *clv.list
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		x.LastVerifier = value.Interface().(string)
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		x.NextVerification = value.Int()
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		x.RetryCount = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		if x.Service == nil {
+			x.Service = new(v1.ServiceVerification)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		if x.VerifiedCapabilities == nil {
+			x.VerifiedCapabilities = []string{}
+		}
+		value := &_ServiceRecord_3_list{list: &x.VerifiedCapabilities}
+		return protoreflect.ValueOfList(value)
+	case "hub.integrationverification.ServiceRecord.owner":
+		panic(fmt.Errorf("field owner of message hub.integrationverification.ServiceRecord is not mutable"))
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		panic(fmt.Errorf("field last_verifier of message hub.integrationverification.ServiceRecord is not mutable"))
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		panic(fmt.Errorf("field next_verification of message hub.integrationverification.ServiceRecord is not mutable"))
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		panic(fmt.Errorf("field retry_count of message hub.integrationverification.ServiceRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "hub.integrationverification.ServiceRecord.service":
+		m := new(v1.ServiceVerification)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "hub.integrationverification.ServiceRecord.owner":
+		return protoreflect.ValueOfString("")
+	case "hub.integrationverification.ServiceRecord.verified_capabilities":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ServiceRecord_3_list{list: &list})
+	case "hub.integrationverification.ServiceRecord.last_verifier":
+		return protoreflect.ValueOfString("")
+	case "hub.integrationverification.ServiceRecord.next_verification":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "hub.integrationverification.ServiceRecord.retry_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: hub.integrationverification.ServiceRecord"))
+		}
+		panic(fmt.Errorf("message hub.integrationverification.ServiceRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in hub.integrationverification.ServiceRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.VerifiedCapabilities) > 0 {
+			for _, s := range x.VerifiedCapabilities {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.LastVerifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextVerification != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextVerification))
+		}
+		if x.RetryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.NextVerification != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextVerification))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.LastVerifier) > 0 {
+			i -= len(x.LastVerifier)
+			copy(dAtA[i:], x.LastVerifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastVerifier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VerifiedCapabilities) > 0 {
+			for iNdEx := len(x.VerifiedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerifiedCapabilities[iNdEx])
+				copy(dAtA[i:], x.VerifiedCapabilities[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifiedCapabilities[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &v1.ServiceVerification{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedCapabilities", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifiedCapabilities = append(x.VerifiedCapabilities, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastVerifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastVerifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextVerification", wireType)
+				}
+				x.NextVerification = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextVerification |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+				}
+				x.RetryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.0
+// source: hub/integrationverification/service.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceRecord tracks a registered third-party service and its verification
+// schedule.
+type ServiceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service              *v1.ServiceVerification `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Owner                string                  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	VerifiedCapabilities []string                `protobuf:"bytes,3,rep,name=verified_capabilities,json=verifiedCapabilities,proto3" json:"verified_capabilities,omitempty"`
+	LastVerifier         string                  `protobuf:"bytes,4,opt,name=last_verifier,json=lastVerifier,proto3" json:"last_verifier,omitempty"`
+	// next_verification is the unix time the current verification lapses; zero
+	// when the service is not scheduled.
+	NextVerification int64  `protobuf:"varint,5,opt,name=next_verification,json=nextVerification,proto3" json:"next_verification,omitempty"`
+	RetryCount       uint32 `protobuf:"varint,6,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+}
+
+func (x *ServiceRecord) Reset() {
+	*x = ServiceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_integrationverification_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRecord) ProtoMessage() {}
+
+// Deprecated: Use ServiceRecord.ProtoReflect.Descriptor instead.
+func (*ServiceRecord) Descriptor() ([]byte, []int) {
+	return file_hub_integrationverification_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceRecord) GetService() *v1.ServiceVerification {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ServiceRecord) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ServiceRecord) GetVerifiedCapabilities() []string {
+	if x != nil {
+		return x.VerifiedCapabilities
+	}
+	return nil
+}
+
+func (x *ServiceRecord) GetLastVerifier() string {
+	if x != nil {
+		return x.LastVerifier
+	}
+	return ""
+}
+
+func (x *ServiceRecord) GetNextVerification() int64 {
+	if x != nil {
+		return x.NextVerification
+	}
+	return 0
+}
+
+func (x *ServiceRecord) GetRetryCount() uint32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+var File_hub_integrationverification_service_proto protoreflect.FileDescriptor
+
+var file_hub_integrationverification_service_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x68, 0x75, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x68, 0x75, 0x62,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2f,
+	0x69, 0x62, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x8a, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6c, 0x6f, 0x71, 0x7a, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65,
+	0x78, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x25, 0x5a, 0x23, 0x68, 0x75, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75, 0x62, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hub_integrationverification_service_proto_rawDescOnce sync.Once
+	file_hub_integrationverification_service_proto_rawDescData = file_hub_integrationverification_service_proto_rawDesc
+)
+
+func file_hub_integrationverification_service_proto_rawDescGZIP() []byte {
+	file_hub_integrationverification_service_proto_rawDescOnce.Do(func() {
+		file_hub_integrationverification_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_hub_integrationverification_service_proto_rawDescData)
+	})
+	return file_hub_integrationverification_service_proto_rawDescData
+}
+
+var file_hub_integrationverification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hub_integrationverification_service_proto_goTypes = []interface{}{
+	(*ServiceRecord)(nil),          // 0: hub.integrationverification.ServiceRecord
+	(*v1.ServiceVerification)(nil), // 1: bloqz.ibc.v1.ServiceVerification
+}
+var file_hub_integrationverification_service_proto_depIdxs = []int32{
+	1, // 0: hub.integrationverification.ServiceRecord.service:type_name -> bloqz.ibc.v1.ServiceVerification
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hub_integrationverification_service_proto_init() }
+func file_hub_integrationverification_service_proto_init() {
+	if File_hub_integrationverification_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hub_integrationverification_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hub_integrationverification_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hub_integrationverification_service_proto_goTypes,
+		DependencyIndexes: file_hub_integrationverification_service_proto_depIdxs,
+		MessageInfos:      file_hub_integrationverification_service_proto_msgTypes,
+	}.Build()
+	File_hub_integrationverification_service_proto = out.File
+	file_hub_integrationverification_service_proto_rawDesc = nil
+	file_hub_integrationverification_service_proto_goTypes = nil
+	file_hub_integrationverification_service_proto_depIdxs = nil
+}
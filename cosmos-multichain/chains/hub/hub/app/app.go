@@ -75,6 +75,12 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	communicationmodulekeeper "hub/x/communication/keeper"
+	crosschainmodulekeeper "hub/x/crosschain/keeper"
+	documentstoragemodulekeeper "hub/x/documentstorage/keeper"
+	integrationverificationmodulekeeper "hub/x/integrationverification/keeper"
+	profileverificationmodulekeeper "hub/x/profileverification/keeper"
+	verificationmodulekeeper "hub/x/verification/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"hub/docs"
@@ -140,6 +146,12 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	CrosschainKeeper              crosschainmodulekeeper.Keeper
+	ProfileverificationKeeper     profileverificationmodulekeeper.Keeper
+	DocumentstorageKeeper         documentstoragemodulekeeper.Keeper
+	IntegrationverificationKeeper integrationverificationmodulekeeper.Keeper
+	VerificationKeeper            verificationmodulekeeper.Keeper
+	CommunicationKeeper           communicationmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -243,6 +255,12 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.CrosschainKeeper,
+		&app.ProfileverificationKeeper,
+		&app.DocumentstorageKeeper,
+		&app.IntegrationverificationKeeper,
+		&app.VerificationKeeper,
+		&app.CommunicationKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -52,6 +52,24 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"google.golang.org/protobuf/types/known/durationpb"
+	communicationmodulev1 "hub/api/hub/communication/module"
+	crosschainmodulev1 "hub/api/hub/crosschain/module"
+	documentstoragemodulev1 "hub/api/hub/documentstorage/module"
+	integrationverificationmodulev1 "hub/api/hub/integrationverification/module"
+	profileverificationmodulev1 "hub/api/hub/profileverification/module"
+	verificationmodulev1 "hub/api/hub/verification/module"
+	_ "hub/x/communication/module" // import for side-effects
+	communicationmoduletypes "hub/x/communication/types"
+	_ "hub/x/crosschain/module" // import for side-effects
+	crosschainmoduletypes "hub/x/crosschain/types"
+	_ "hub/x/documentstorage/module" // import for side-effects
+	documentstoragemoduletypes "hub/x/documentstorage/types"
+	_ "hub/x/integrationverification/module" // import for side-effects
+	integrationverificationmoduletypes "hub/x/integrationverification/types"
+	_ "hub/x/profileverification/module" // import for side-effects
+	profileverificationmoduletypes "hub/x/profileverification/types"
+	_ "hub/x/verification/module" // import for side-effects
+	verificationmoduletypes "hub/x/verification/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -89,6 +107,12 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		crosschainmoduletypes.ModuleName,
+		profileverificationmoduletypes.ModuleName,
+		documentstoragemoduletypes.ModuleName,
+		integrationverificationmoduletypes.ModuleName,
+		verificationmoduletypes.ModuleName,
+		communicationmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +137,12 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		crosschainmoduletypes.ModuleName,
+		profileverificationmoduletypes.ModuleName,
+		documentstoragemoduletypes.ModuleName,
+		integrationverificationmoduletypes.ModuleName,
+		verificationmoduletypes.ModuleName,
+		communicationmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +161,12 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		crosschainmoduletypes.ModuleName,
+		profileverificationmoduletypes.ModuleName,
+		documentstoragemoduletypes.ModuleName,
+		integrationverificationmoduletypes.ModuleName,
+		verificationmoduletypes.ModuleName,
+		communicationmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -286,6 +322,30 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   crosschainmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&crosschainmodulev1.Module{}),
+			},
+			{
+				Name:   profileverificationmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&profileverificationmodulev1.Module{}),
+			},
+			{
+				Name:   documentstoragemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&documentstoragemodulev1.Module{}),
+			},
+			{
+				Name:   integrationverificationmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&integrationverificationmodulev1.Module{}),
+			},
+			{
+				Name:   verificationmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&verificationmodulev1.Module{}),
+			},
+			{
+				Name:   communicationmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&communicationmodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
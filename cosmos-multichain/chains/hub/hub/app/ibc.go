@@ -36,6 +36,11 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	// this line is used by starport scaffolding # ibc/app/import
+
+	communicationmodule "hub/x/communication/module"
+	communicationmoduletypes "hub/x/communication/types"
+	verificationmodule "hub/x/verification/module"
+	verificationmoduletypes "hub/x/verification/types"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -160,6 +165,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
+	ibcRouter.AddRoute(communicationmoduletypes.ModuleName, communicationmodule.NewIBCModule(app.CommunicationKeeper))
+	ibcRouter.AddRoute(verificationmoduletypes.ModuleName, verificationmodule.NewIBCModule(app.VerificationKeeper))
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/stretchr/testify/require"
+
+	"hub/x/communication/keeper"
+	"hub/x/communication/types"
+	crosschainkeeper "hub/x/crosschain/keeper"
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+func CommunicationKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := CommunicationKeeperWithGroups(t, nil)
+	return k, ctx
+}
+
+// CommunicationKeeperWithGroups returns a communication keeper backed by a
+// crosschain message store and the given group keeper
+func CommunicationKeeperWithGroups(t testing.TB, groupKeeper types.GroupKeeper) (keeper.Keeper, crosschainkeeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	crosschainStoreKey := storetypes.NewKVStoreKey(crosschaintypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(crosschainStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	messageKeeper := crosschainkeeper.NewKeeper(cdc, runtime.NewKVStoreService(crosschainStoreKey), log.NewNopLogger(), authority.String(), nil)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		func() *ibckeeper.Keeper { return nil },
+		func(string) capabilitykeeper.ScopedKeeper { return capabilitykeeper.ScopedKeeper{} },
+		messageKeeper,
+		groupKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, messageKeeper, ctx
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/stretchr/testify/require"
+
+	documentstoragekeeper "hub/x/documentstorage/keeper"
+	documentstoragetypes "hub/x/documentstorage/types"
+	integrationverificationkeeper "hub/x/integrationverification/keeper"
+	integrationverificationtypes "hub/x/integrationverification/types"
+	profileverificationkeeper "hub/x/profileverification/keeper"
+	profileverificationtypes "hub/x/profileverification/types"
+	"hub/x/verification/keeper"
+	"hub/x/verification/types"
+)
+
+// VerificationRegistries are the hub registries the verification keeper
+// resolves packets against
+type VerificationRegistries struct {
+	Profiles  profileverificationkeeper.Keeper
+	Documents documentstoragekeeper.Keeper
+	Services  integrationverificationkeeper.Keeper
+}
+
+func VerificationKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := VerificationKeeperWithRegistries(t)
+	return k, ctx
+}
+
+func VerificationKeeperWithRegistries(t testing.TB) (keeper.Keeper, VerificationRegistries, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	profileStoreKey := storetypes.NewKVStoreKey(profileverificationtypes.StoreKey)
+	documentStoreKey := storetypes.NewKVStoreKey(documentstoragetypes.StoreKey)
+	serviceStoreKey := storetypes.NewKVStoreKey(integrationverificationtypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(profileStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(documentStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(serviceStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	registries := VerificationRegistries{
+		Profiles:  profileverificationkeeper.NewKeeper(cdc, runtime.NewKVStoreService(profileStoreKey), log.NewNopLogger(), authority.String()),
+		Documents: documentstoragekeeper.NewKeeper(cdc, runtime.NewKVStoreService(documentStoreKey), log.NewNopLogger(), authority.String()),
+		Services:  integrationverificationkeeper.NewKeeper(cdc, runtime.NewKVStoreService(serviceStoreKey), log.NewNopLogger(), authority.String()),
+	}
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		func() *ibckeeper.Keeper { return nil },
+		func(string) capabilitykeeper.ScopedKeeper { return capabilitykeeper.ScopedKeeper{} },
+		registries.Profiles,
+		registries.Documents,
+		registries.Services,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, registries, ctx
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/communication/types"
+)
+
+// SetChannelCounterparty set a specific channelCounterparty in the store from its index
+func (k Keeper) SetChannelCounterparty(ctx context.Context, channelCounterparty types.ChannelCounterparty) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChannelCounterpartyKeyPrefix))
+	b := k.cdc.MustMarshal(&channelCounterparty)
+	store.Set(types.ChannelCounterpartyKey(
+		channelCounterparty.ChannelId,
+	), b)
+}
+
+// GetChannelCounterparty returns a channelCounterparty from its index
+func (k Keeper) GetChannelCounterparty(
+	ctx context.Context,
+	channelId string,
+) (val types.ChannelCounterparty, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChannelCounterpartyKeyPrefix))
+
+	b := store.Get(types.ChannelCounterpartyKey(
+		channelId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChannelCounterparty returns all channelCounterparty
+func (k Keeper) GetAllChannelCounterparty(ctx context.Context) (list []types.ChannelCounterparty) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChannelCounterpartyKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChannelCounterparty
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"hub/x/communication/types"
+)
+
+// CounterpartyChainID resolves the chain ID of the counterparty behind a
+// connection from its tendermint light client
+func (k Keeper) CounterpartyChainID(ctx sdk.Context, connectionID string) (string, error) {
+	ibcKeeper := k.ibcKeeperFn()
+
+	connection, found := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return "", errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return "", errorsmod.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrUnknownCounterparty, "client %s is not a tendermint client", connection.ClientId)
+	}
+	return tmClientState.ChainId, nil
+}
+
+// BindChannel checks a channel handshake against the counterparty policy and
+// records which chain the channel was opened with
+func (k Keeper) BindChannel(ctx sdk.Context, order channeltypes.Order, connectionHops []string, channelID string) error {
+	if len(connectionHops) != 1 {
+		return errorsmod.Wrapf(channeltypes.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
+	}
+	chainId, err := k.CounterpartyChainID(ctx, connectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	policy, found := k.GetParams(ctx).CounterpartyPolicy(chainId)
+	if !found {
+		return errorsmod.Wrap(types.ErrUnknownCounterparty, chainId)
+	}
+	if order != policy.Order() {
+		return errorsmod.Wrapf(types.ErrInvalidOrdering, "expected %s channel for %s, got %s", policy.Order(), chainId, order)
+	}
+
+	k.SetChannelCounterparty(ctx, types.ChannelCounterparty{
+		ChannelId: channelID,
+		ChainId:   chainId,
+	})
+	return nil
+}
+
+// ChannelPolicy returns the counterparty policy of a bound channel
+func (k Keeper) ChannelPolicy(ctx sdk.Context, channelID string) (types.CounterpartyPolicy, error) {
+	binding, found := k.GetChannelCounterparty(ctx, channelID)
+	if !found {
+		return types.CounterpartyPolicy{}, errorsmod.Wrap(types.ErrUnknownChannel, channelID)
+	}
+	policy, found := k.GetParams(ctx).CounterpartyPolicy(binding.ChainId)
+	if !found {
+		return types.CounterpartyPolicy{}, errorsmod.Wrap(types.ErrUnknownCounterparty, binding.ChainId)
+	}
+	return policy, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"hub/x/communication/types"
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+func (k msgServer) SendMessage(goCtx context.Context, msg *types.MsgSendMessage) (*types.MsgSendMessageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, err := k.ChannelPolicy(ctx, msg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the packet, failing early on anything the counterparty would reject
+	packet := msg.PacketData()
+	if _, err := k.ValidateRealTimeMessage(ctx, policy, packet); err != nil {
+		return nil, err
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitRealTimeMessagePacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deliveries are filled in from the acknowledgement
+	message := *msg.Message
+	message.ChannelId = msg.ChannelID
+	k.messageKeeper.SetMessage(ctx, crosschaintypes.MessageRecord{
+		Creator:                     msg.Creator,
+		Message:                     &message,
+		RequireDeliveryConfirmation: msg.RequireDeliveryConfirmation,
+		CreatedAt:                   ctx.BlockTime().Unix(),
+	})
+
+	return &types.MsgSendMessageResponse{
+		MessageId: message.MessageId,
+		Sequence:  sequence,
+	}, nil
+}
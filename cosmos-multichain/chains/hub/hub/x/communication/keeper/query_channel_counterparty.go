@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/communication/types"
+)
+
+func (k Keeper) ChannelCounterpartyAll(ctx context.Context, req *types.QueryAllChannelCounterpartyRequest) (*types.QueryAllChannelCounterpartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var channelCounterparties []types.ChannelCounterparty
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	channelCounterpartyStore := prefix.NewStore(store, types.KeyPrefix(types.ChannelCounterpartyKeyPrefix))
+
+	pageRes, err := query.Paginate(channelCounterpartyStore, req.Pagination, func(key []byte, value []byte) error {
+		var channelCounterparty types.ChannelCounterparty
+		if err := k.cdc.Unmarshal(value, &channelCounterparty); err != nil {
+			return err
+		}
+
+		channelCounterparties = append(channelCounterparties, channelCounterparty)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllChannelCounterpartyResponse{ChannelCounterparty: channelCounterparties, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChannelCounterparty(ctx context.Context, req *types.QueryGetChannelCounterpartyRequest) (*types.QueryGetChannelCounterpartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetChannelCounterparty(
+		ctx,
+		req.ChannelId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetChannelCounterpartyResponse{ChannelCounterparty: val}, nil
+}
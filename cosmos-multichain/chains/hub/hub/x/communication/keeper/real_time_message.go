@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"hub/x/communication/types"
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+// TransmitRealTimeMessagePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitRealTimeMessagePacket(
+	ctx sdk.Context,
+	packetData types.RealTimeMessagePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	channelCap, ok := k.ScopedKeeper().GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// ValidateRealTimeMessage checks a packet against the counterparty allowlist
+// and the stateless validations of its delivery type rule
+func (k Keeper) ValidateRealTimeMessage(ctx sdk.Context, policy types.CounterpartyPolicy, data types.RealTimeMessagePacketData) (types.MessageRule, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.MessageRule{}, err
+	}
+	if !policy.AllowsMessageType(data.MessageType) {
+		return types.MessageRule{}, errorsmod.Wrapf(types.ErrMessageTypeNotAllowed, "%s for %s", data.MessageType, policy.ChainId)
+	}
+	rule, found := k.GetParams(ctx).MessageRule(data.DeliveryType)
+	if !found {
+		return types.MessageRule{}, errorsmod.Wrap(types.ErrUnknownDeliveryType, data.DeliveryType)
+	}
+	if err := data.ValidateRule(rule); err != nil {
+		return types.MessageRule{}, err
+	}
+	if _, found := k.messageKeeper.GetMessage(ctx, data.Message.MessageId); found {
+		return types.MessageRule{}, errorsmod.Wrapf(types.ErrInvalidMessage, "message %s already exists", data.Message.MessageId)
+	}
+	return rule, nil
+}
+
+// OnRecvRealTimeMessagePacket processes packet reception. Recipients that are
+// blank, repeated or outside the group policy are reported as failed
+// deliveries in the acknowledgement.
+func (k Keeper) OnRecvRealTimeMessagePacket(ctx sdk.Context, packet channeltypes.Packet, data types.RealTimeMessagePacketData) (packetAck crosschaintypes.SendMessageResponse, err error) {
+	policy, err := k.ChannelPolicy(ctx, packet.DestinationChannel)
+	if err != nil {
+		return packetAck, err
+	}
+	rule, err := k.ValidateRealTimeMessage(ctx, policy, data)
+	if err != nil {
+		return packetAck, err
+	}
+
+	var members map[string]bool
+	if rule.Requires(types.ValidationGroupPolicy) {
+		if members, err = k.groupPolicyMembers(ctx, data.GroupPolicy); err != nil {
+			return packetAck, err
+		}
+	}
+	now := ctx.BlockTime().Unix()
+	if rule.Requires(types.ValidationRateLimit) {
+		if err := k.consumeRateLimit(ctx, k.GetParams(ctx), policy.ChainId, data.Sender, now); err != nil {
+			return packetAck, errorsmod.Wrapf(err, "%s from %s", data.Sender, policy.ChainId)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var delivered, failed []string
+	for _, recipient := range data.Message.Recipients {
+		if recipient == "" || seen[recipient] || (members != nil && !members[recipient]) {
+			failed = append(failed, recipient)
+			continue
+		}
+		seen[recipient] = true
+		delivered = append(delivered, recipient)
+	}
+
+	message := *data.Message
+	message.ChannelId = packet.DestinationChannel
+	k.messageKeeper.SetMessage(ctx, crosschaintypes.MessageRecord{
+		Creator:          data.Sender,
+		Message:          &message,
+		DeliveredTo:      delivered,
+		FailedDeliveries: failed,
+		CreatedAt:        now,
+	})
+
+	packetAck = crosschaintypes.SendMessageResponse{
+		MessageId:        message.MessageId,
+		DeliveredTo:      delivered,
+		FailedDeliveries: failed,
+	}
+	if err := k.emitMessageDeliveries(ctx, packetAck); err != nil {
+		return packetAck, err
+	}
+	return packetAck, nil
+}
+
+// groupPolicyMembers returns the member addresses of the group behind a group policy
+func (k Keeper) groupPolicyMembers(ctx sdk.Context, policyAddress string) (map[string]bool, error) {
+	info, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: policyAddress})
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidGroupPolicy, "%s: %s", policyAddress, err)
+	}
+
+	members := make(map[string]bool)
+	var nextKey []byte
+	for {
+		res, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    info.Info.GroupId,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidGroupPolicy, "%s: %s", policyAddress, err)
+		}
+		for _, member := range res.Members {
+			members[member.Member.Address] = true
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return members, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// OnAcknowledgementRealTimeMessagePacket fills the delivery outcome of a
+// message sent from this chain from the counterparty acknowledgement
+func (k Keeper) OnAcknowledgementRealTimeMessagePacket(ctx sdk.Context, packet channeltypes.Packet, data types.RealTimeMessagePacketData, ack channeltypes.Acknowledgement) error {
+	var response crosschaintypes.SendMessageResponse
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		response = crosschaintypes.SendMessageResponse{
+			MessageId:        data.Message.GetMessageId(),
+			FailedDeliveries: data.Message.GetRecipients(),
+			ErrorMessage:     dispatchedAck.Error,
+		}
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &response); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+
+	return k.recordDeliveries(ctx, response)
+}
+
+// OnTimeoutRealTimeMessagePacket reports every recipient of a timed out message as failed
+func (k Keeper) OnTimeoutRealTimeMessagePacket(ctx sdk.Context, packet channeltypes.Packet, data types.RealTimeMessagePacketData) error {
+	return k.recordDeliveries(ctx, crosschaintypes.SendMessageResponse{
+		MessageId:        data.Message.GetMessageId(),
+		FailedDeliveries: data.Message.GetRecipients(),
+		ErrorMessage:     fmt.Sprintf("packet %d timed out", packet.Sequence),
+	})
+}
+
+// recordDeliveries stores the delivery outcome on the message record and
+// emits a MessageDeliveryEvent per recipient
+func (k Keeper) recordDeliveries(ctx sdk.Context, response crosschaintypes.SendMessageResponse) error {
+	if record, found := k.messageKeeper.GetMessage(ctx, response.MessageId); found {
+		record.DeliveredTo = response.DeliveredTo
+		record.FailedDeliveries = response.FailedDeliveries
+		k.messageKeeper.SetMessage(ctx, record)
+	}
+	return k.emitMessageDeliveries(ctx, response)
+}
+
+func (k Keeper) emitMessageDeliveries(ctx sdk.Context, response crosschaintypes.SendMessageResponse) error {
+	emit := func(recipient string, delivered bool) error {
+		return ctx.EventManager().EmitTypedEvent(&crosschaintypes.MessageDeliveryEvent{
+			EventId:   response.MessageId + "/" + recipient,
+			MessageId: response.MessageId,
+			Recipient: recipient,
+			Delivered: delivered,
+			Timestamp: ctx.BlockTime().Unix(),
+		})
+	}
+	for _, recipient := range response.DeliveredTo {
+		if err := emit(recipient, true); err != nil {
+			return err
+		}
+	}
+	for _, recipient := range response.FailedDeliveries {
+		if err := emit(recipient, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	"hub/x/communication/types"
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+// groupKeeper serves a single group policy with fixed members
+type groupKeeper struct {
+	policy  string
+	members []string
+}
+
+func (g groupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if req.Address != g.policy {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: g.policy, GroupId: 1}}, nil
+}
+
+func (g groupKeeper) GroupMembers(context.Context, *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	res := &group.QueryGroupMembersResponse{}
+	for _, addr := range g.members {
+		res.Members = append(res.Members, &group.GroupMember{GroupId: 1, Member: &group.Member{Address: addr}})
+	}
+	return res, nil
+}
+
+func signedPacket(t *testing.T, priv *secp256k1.PrivKey, deliveryType, messageId string, recipients ...string) types.RealTimeMessagePacketData {
+	data := types.RealTimeMessagePacketData{
+		MessageType:  "realtime",
+		DeliveryType: deliveryType,
+		Message: &crosschaintypes.RealTimeMessage{
+			MessageId:  messageId,
+			Content:    []byte("ciphertext"),
+			Recipients: recipients,
+		},
+		Sender:       sdk.AccAddress(priv.PubKey().Address()).String(),
+		SenderPubKey: priv.PubKey().Bytes(),
+		Encryption:   "x25519-xsalsa20-poly1305",
+		GroupPolicy:  "policy",
+	}
+	sig, err := priv.Sign(data.SignBytes())
+	require.NoError(t, err)
+	data.Signature = sig
+	return data
+}
+
+func TestOnRecvRealTimeMessagePacket(t *testing.T) {
+	groups := groupKeeper{policy: "policy", members: []string{"alice", "bob"}}
+	k, messages, ctx := keepertest.CommunicationKeeperWithGroups(t, groups)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	k.SetChannelCounterparty(ctx, types.ChannelCounterparty{ChannelId: "channel-0", ChainId: "bloqz-education-1"})
+	packet := channeltypes.Packet{DestinationChannel: "channel-0", Sequence: 1}
+	priv := secp256k1.GenPrivKey()
+
+	t.Run("direct message delivered", func(t *testing.T) {
+		ack, err := k.OnRecvRealTimeMessagePacket(ctx, packet, signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m1", "alice", "", "alice"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"alice"}, ack.DeliveredTo)
+		require.Equal(t, []string{"", "alice"}, ack.FailedDeliveries)
+
+		record, found := messages.GetMessage(ctx, "m1")
+		require.True(t, found)
+		require.Equal(t, "channel-0", record.Message.ChannelId)
+		require.Equal(t, ack.DeliveredTo, record.DeliveredTo)
+	})
+
+	t.Run("message ids are not reused", func(t *testing.T) {
+		_, err := k.OnRecvRealTimeMessagePacket(ctx, packet, signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m1", "alice"))
+		require.ErrorIs(t, err, types.ErrInvalidMessage)
+	})
+
+	t.Run("tampered packet", func(t *testing.T) {
+		data := signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m2", "alice")
+		data.Message.Recipients = []string{"mallory"}
+		_, err := k.OnRecvRealTimeMessagePacket(ctx, packet, data)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		data = signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m2", "alice")
+		data.Sender = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		_, err = k.OnRecvRealTimeMessagePacket(ctx, packet, data)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("direct message requires encryption", func(t *testing.T) {
+		data := signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m3", "alice")
+		data.Encryption = ""
+		sig, err := priv.Sign(data.SignBytes())
+		require.NoError(t, err)
+		data.Signature = sig
+		_, err = k.OnRecvRealTimeMessagePacket(ctx, packet, data)
+		require.ErrorIs(t, err, types.ErrEncryptionRequired)
+	})
+
+	t.Run("group message limited to members", func(t *testing.T) {
+		ack, err := k.OnRecvRealTimeMessagePacket(ctx, packet, signedPacket(t, priv, types.DeliveryTypeGroupMessage, "m4", "alice", "carol"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"alice"}, ack.DeliveredTo)
+		require.Equal(t, []string{"carol"}, ack.FailedDeliveries)
+
+		data := signedPacket(t, priv, types.DeliveryTypeGroupMessage, "m5", "alice")
+		data.GroupPolicy = "unknown"
+		sig, err := priv.Sign(data.SignBytes())
+		require.NoError(t, err)
+		data.Signature = sig
+		_, err = k.OnRecvRealTimeMessagePacket(ctx, packet, data)
+		require.ErrorIs(t, err, types.ErrInvalidGroupPolicy)
+	})
+
+	t.Run("broadcast is rate limited", func(t *testing.T) {
+		params := k.GetParams(ctx)
+		params.RateLimitMaxMessages = 2
+		require.NoError(t, k.SetParams(ctx, params))
+
+		for _, id := range []string{"b1", "b2"} {
+			_, err := k.OnRecvRealTimeMessagePacket(ctx, packet, signedPacket(t, priv, types.DeliveryTypeBroadcast, id, "alice"))
+			require.NoError(t, err)
+		}
+		_, err := k.OnRecvRealTimeMessagePacket(ctx, packet, signedPacket(t, priv, types.DeliveryTypeBroadcast, "b3", "alice"))
+		require.ErrorIs(t, err, types.ErrRateLimited)
+
+		later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRateLimitWindow))
+		_, err = k.OnRecvRealTimeMessagePacket(later, packet, signedPacket(t, priv, types.DeliveryTypeBroadcast, "b3", "alice"))
+		require.NoError(t, err)
+	})
+
+	t.Run("message type not allowlisted", func(t *testing.T) {
+		data := signedPacket(t, priv, types.DeliveryTypeDirectMessage, "m6", "alice")
+		data.MessageType = "inventory"
+		_, err := k.OnRecvRealTimeMessagePacket(ctx, packet, data)
+		require.ErrorIs(t, err, types.ErrMessageTypeNotAllowed)
+	})
+}
+
+func TestOnAcknowledgementRealTimeMessagePacket(t *testing.T) {
+	k, messages, ctx := keepertest.CommunicationKeeperWithGroups(t, nil)
+	message := &crosschaintypes.RealTimeMessage{MessageId: "m1", Recipients: []string{"alice", "bob"}}
+	messages.SetMessage(ctx, crosschaintypes.MessageRecord{Message: message})
+	data := types.RealTimeMessagePacketData{Message: message}
+
+	response := crosschaintypes.SendMessageResponse{MessageId: "m1", DeliveredTo: []string{"alice"}, FailedDeliveries: []string{"bob"}}
+	bz, err := types.ModuleCdc.MarshalJSON(&response)
+	require.NoError(t, err)
+	require.NoError(t, k.OnAcknowledgementRealTimeMessagePacket(ctx, channeltypes.Packet{}, data, channeltypes.NewResultAcknowledgement(bz)))
+
+	record, found := messages.GetMessage(ctx, "m1")
+	require.True(t, found)
+	require.Equal(t, []string{"alice"}, record.DeliveredTo)
+	require.Equal(t, []string{"bob"}, record.FailedDeliveries)
+
+	require.NoError(t, k.OnTimeoutRealTimeMessagePacket(ctx, channeltypes.Packet{Sequence: 1}, data))
+	record, _ = messages.GetMessage(ctx, "m1")
+	require.Empty(t, record.DeliveredTo)
+	require.Equal(t, []string{"alice", "bob"}, record.FailedDeliveries)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/communication/types"
+)
+
+// SetSenderRateLimit set a specific senderRateLimit in the store from its index
+func (k Keeper) SetSenderRateLimit(ctx context.Context, senderRateLimit types.SenderRateLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SenderRateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&senderRateLimit)
+	store.Set(types.SenderRateLimitKey(
+		senderRateLimit.SourceChain,
+		senderRateLimit.Sender,
+	), b)
+}
+
+// GetSenderRateLimit returns a senderRateLimit from its index
+func (k Keeper) GetSenderRateLimit(
+	ctx context.Context,
+	sourceChain string,
+	sender string,
+) (val types.SenderRateLimit, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SenderRateLimitKeyPrefix))
+
+	b := store.Get(types.SenderRateLimitKey(
+		sourceChain,
+		sender,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSenderRateLimit returns all senderRateLimit
+func (k Keeper) GetAllSenderRateLimit(ctx context.Context) (list []types.SenderRateLimit) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SenderRateLimitKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SenderRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// consumeRateLimit counts a message against the sender's window, rejecting it
+// once the window's allowance is spent
+func (k Keeper) consumeRateLimit(ctx context.Context, params types.Params, sourceChain, sender string, now int64) error {
+	limit, found := k.GetSenderRateLimit(ctx, sourceChain, sender)
+	if !found || now >= limit.WindowStart+int64(params.RateLimitWindow.Seconds()) {
+		limit = types.SenderRateLimit{
+			SourceChain: sourceChain,
+			Sender:      sender,
+			WindowStart: now,
+		}
+	}
+	if limit.Count >= params.RateLimitMaxMessages {
+		return types.ErrRateLimited
+	}
+	limit.Count++
+	k.SetSenderRateLimit(ctx, limit)
+	return nil
+}
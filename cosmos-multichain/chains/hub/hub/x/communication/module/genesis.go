@@ -0,0 +1,48 @@
+package communication
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/communication/keeper"
+	"hub/x/communication/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if k.ShouldBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+	// Set all the channelCounterparty
+	for _, elem := range genState.ChannelCounterpartyList {
+		k.SetChannelCounterparty(ctx, elem)
+	}
+	// Set all the senderRateLimit
+	for _, elem := range genState.SenderRateLimitList {
+		k.SetSenderRateLimit(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.PortId = k.GetPort(ctx)
+	genesis.ChannelCounterpartyList = k.GetAllChannelCounterparty(ctx)
+	genesis.SenderRateLimitList = k.GetAllSenderRateLimit(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
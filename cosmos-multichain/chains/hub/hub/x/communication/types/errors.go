@@ -0,0 +1,24 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/communication module sentinel errors
+var (
+	ErrInvalidSigner         = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnknownCounterparty   = sdkerrors.Register(ModuleName, 1101, "counterparty chain has no communication policy")
+	ErrInvalidOrdering       = sdkerrors.Register(ModuleName, 1102, "channel ordering does not match the counterparty policy")
+	ErrUnknownChannel        = sdkerrors.Register(ModuleName, 1103, "channel is not bound to a counterparty chain")
+	ErrMessageTypeNotAllowed = sdkerrors.Register(ModuleName, 1104, "message type is not allowlisted for the counterparty")
+	ErrUnknownDeliveryType   = sdkerrors.Register(ModuleName, 1105, "unknown delivery type")
+	ErrInvalidMessage        = sdkerrors.Register(ModuleName, 1106, "invalid message")
+	ErrInvalidSignature      = sdkerrors.Register(ModuleName, 1107, "invalid sender signature")
+	ErrEncryptionRequired    = sdkerrors.Register(ModuleName, 1108, "message content must be encrypted")
+	ErrInvalidGroupPolicy    = sdkerrors.Register(ModuleName, 1109, "invalid group policy")
+	ErrRateLimited           = sdkerrors.Register(ModuleName, 1110, "sender exceeded the message rate limit")
+	ErrInvalidPacketTimeout  = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
+	ErrInvalidVersion        = sdkerrors.Register(ModuleName, 1501, "invalid version")
+)
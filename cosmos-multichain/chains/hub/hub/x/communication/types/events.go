@@ -0,0 +1,17 @@
+package types
+
+// communication module event types
+const (
+	EventTypeRealTimeMessagePacket = "real_time_message_packet"
+	EventTypeTimeout               = "timeout"
+
+	AttributeKeyChannel      = "channel"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyCounterparty = "counterparty_chain"
+	AttributeKeyMessageId    = "message_id"
+	AttributeKeyMessageType  = "message_type"
+	AttributeKeyDeliveryType = "delivery_type"
+	AttributeKeyAck          = "acknowledgement"
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+)
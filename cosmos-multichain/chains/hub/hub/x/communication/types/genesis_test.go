@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hub/x/communication/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				ChannelCounterpartyList: []types.ChannelCounterparty{
+					{ChannelId: "channel-0", ChainId: "bloqz-education-1"},
+					{ChannelId: "channel-1", ChainId: "bloqz-retail-1"},
+				},
+				SenderRateLimitList: []types.SenderRateLimit{
+					{SourceChain: "bloqz-education-1", Sender: "a"},
+					{SourceChain: "bloqz-retail-1", Sender: "a"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated senderRateLimit",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				SenderRateLimitList: []types.SenderRateLimit{
+					{SourceChain: "bloqz-education-1", Sender: "a"},
+					{SourceChain: "bloqz-education-1", Sender: "a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rule without signature",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(
+					types.DefaultVersion,
+					types.DefaultCounterpartyPolicies,
+					[]types.MessageRule{{DeliveryType: types.DeliveryTypeBroadcast, Validations: []string{types.ValidationRateLimit}}},
+					types.DefaultRateLimitWindow,
+					types.DefaultRateLimitMaxMessages,
+				),
+			},
+			valid: false,
+		},
+		{
+			desc: "unsupported validation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(
+					types.DefaultVersion,
+					types.DefaultCounterpartyPolicies,
+					[]types.MessageRule{{DeliveryType: types.DeliveryTypeBroadcast, Validations: []string{types.ValidationSignature, "biometric"}}},
+					types.DefaultRateLimitWindow,
+					types.DefaultRateLimitMaxMessages,
+				),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	// SenderRateLimitKeyPrefix is the prefix to retrieve all SenderRateLimit
+	SenderRateLimitKeyPrefix = "SenderRateLimit/value/"
+)
+
+// SenderRateLimitKey returns the store key to retrieve a SenderRateLimit from the index fields
+func SenderRateLimitKey(
+	sourceChain string,
+	sender string,
+) []byte {
+	var key []byte
+
+	sourceChainBytes := []byte(sourceChain)
+	key = append(key, sourceChainBytes...)
+	key = append(key, []byte("/")...)
+
+	senderBytes := []byte(sender)
+	key = append(key, senderBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "communication"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_communication"
+
+	// Version defines the current version the IBC module supports
+	Version = "ics30-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "communication"
+)
+
+var (
+	ParamsKey = []byte("p_communication")
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = KeyPrefix("communication-port-")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSendMessage{}
+
+func (msg *MsgSendMessage) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "invalid packet timeout")
+	}
+	return msg.PacketData().ValidateBasic()
+}
+
+// PacketData returns the packet relayed for the message, signed by the creator
+func (msg *MsgSendMessage) PacketData() RealTimeMessagePacketData {
+	return RealTimeMessagePacketData{
+		MessageType:  msg.MessageType,
+		DeliveryType: msg.DeliveryType,
+		Message:      msg.Message,
+		Sender:       msg.Creator,
+		SenderPubKey: msg.SenderPubKey,
+		Signature:    msg.Signature,
+		Encryption:   msg.Encryption,
+		GroupPolicy:  msg.GroupPolicy,
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// ValidateBasic is used for validating the packet
+func (p RealTimeMessagePacketData) ValidateBasic() error {
+	if p.MessageType == "" {
+		return errorsmod.Wrap(ErrInvalidMessage, "message type cannot be empty")
+	}
+	if p.DeliveryType == "" {
+		return errorsmod.Wrap(ErrUnknownDeliveryType, "delivery type cannot be empty")
+	}
+	if p.Message == nil {
+		return errorsmod.Wrap(ErrInvalidMessage, "message cannot be empty")
+	}
+	if p.Message.MessageId == "" {
+		return errorsmod.Wrap(ErrInvalidMessage, "message id cannot be empty")
+	}
+	if len(p.Message.Recipients) == 0 {
+		return errorsmod.Wrap(ErrInvalidMessage, "message must have at least one recipient")
+	}
+	return nil
+}
+
+// SignBytes returns the bytes the sender signs: the packet with an empty
+// signature
+func (p RealTimeMessagePacketData) SignBytes() []byte {
+	p.Signature = nil
+	bz, err := p.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifySignature checks that the sender public key matches the sender
+// address, whatever its bech32 prefix, and that it signed the packet
+func (p RealTimeMessagePacketData) VerifySignature() error {
+	if len(p.SenderPubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(ErrInvalidSignature, "sender public key must be %d bytes", secp256k1.PubKeySize)
+	}
+	pubKey := &secp256k1.PubKey{Key: p.SenderPubKey}
+
+	_, senderBz, err := bech32.DecodeAndConvert(p.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidSignature, "invalid sender address (%s)", err)
+	}
+	if !bytes.Equal(pubKey.Address(), senderBz) {
+		return errorsmod.Wrap(ErrInvalidSignature, "public key does not belong to the sender")
+	}
+	if !pubKey.VerifySignature(p.SignBytes(), p.Signature) {
+		return errorsmod.Wrap(ErrInvalidSignature, "signature verification failed")
+	}
+	return nil
+}
+
+// ValidateRule enforces the stateless validations of a delivery type rule.
+// Group policy membership and rate limits need state and are checked by the
+// keeper.
+func (p RealTimeMessagePacketData) ValidateRule(rule MessageRule) error {
+	if rule.Requires(ValidationSignature) {
+		if err := p.VerifySignature(); err != nil {
+			return err
+		}
+	}
+	if rule.Requires(ValidationEncryption) && (p.Encryption == "" || len(p.Message.Content) == 0) {
+		return errorsmod.Wrapf(ErrEncryptionRequired, "%s requires encrypted content", rule.DeliveryType)
+	}
+	if rule.Requires(ValidationGroupPolicy) && p.GroupPolicy == "" {
+		return errorsmod.Wrapf(ErrInvalidGroupPolicy, "%s requires a group policy", rule.DeliveryType)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p RealTimeMessagePacketData) GetBytes() ([]byte, error) {
+	var modulePacket CommunicationPacketData
+
+	modulePacket.Packet = &CommunicationPacketData_RealTimeMessagePacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,275 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Delivery types from message_types.user_communication in ibc-config.yml
+const (
+	DeliveryTypeDirectMessage = "direct_message"
+	DeliveryTypeGroupMessage  = "group_message"
+	DeliveryTypeBroadcast     = "broadcast"
+)
+
+// Validations a delivery type may require
+const (
+	ValidationSignature   = "signature"
+	ValidationEncryption  = "encryption"
+	ValidationGroupPolicy = "group_policy"
+	ValidationRateLimit   = "rate_limit"
+)
+
+// supportedValidations are the validations the module knows how to enforce
+var supportedValidations = map[string]struct{}{
+	ValidationSignature:   {},
+	ValidationEncryption:  {},
+	ValidationGroupPolicy: {},
+	ValidationRateLimit:   {},
+}
+
+var (
+	KeyVersion = []byte("Version")
+	// DefaultVersion mirrors the communication path version in ibc-config.yml
+	DefaultVersion = Version
+)
+
+var (
+	KeyCounterpartyPolicies = []byte("CounterpartyPolicies")
+	// DefaultCounterpartyPolicies mirrors the communication paths in ibc-config.yml
+	DefaultCounterpartyPolicies = []CounterpartyPolicy{
+		{
+			ChainId:      "bloqz-education-1",
+			Ordering:     channeltypes.ORDERED.String(),
+			MessageTypes: []string{"profile", "document", "realtime"},
+		},
+		{
+			ChainId:      "bloqz-retail-1",
+			Ordering:     channeltypes.UNORDERED.String(),
+			MessageTypes: []string{"transaction", "inventory", "customer"},
+		},
+	}
+)
+
+var (
+	KeyMessageRules = []byte("MessageRules")
+	// DefaultMessageRules mirrors message_types.user_communication in ibc-config.yml
+	DefaultMessageRules = []MessageRule{
+		{DeliveryType: DeliveryTypeDirectMessage, Validations: []string{ValidationSignature, ValidationEncryption}},
+		{DeliveryType: DeliveryTypeGroupMessage, Validations: []string{ValidationSignature, ValidationEncryption, ValidationGroupPolicy}},
+		{DeliveryType: DeliveryTypeBroadcast, Validations: []string{ValidationSignature, ValidationRateLimit}},
+	}
+)
+
+var (
+	KeyRateLimitWindow = []byte("RateLimitWindow")
+	// DefaultRateLimitWindow is the window rate limited messages are counted over
+	DefaultRateLimitWindow = time.Minute
+)
+
+var (
+	KeyRateLimitMaxMessages = []byte("RateLimitMaxMessages")
+	// DefaultRateLimitMaxMessages is the number of rate limited messages a sender may relay per window
+	DefaultRateLimitMaxMessages uint32 = 10
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	version string,
+	counterpartyPolicies []CounterpartyPolicy,
+	messageRules []MessageRule,
+	rateLimitWindow time.Duration,
+	rateLimitMaxMessages uint32,
+) Params {
+	return Params{
+		Version:              version,
+		CounterpartyPolicies: counterpartyPolicies,
+		MessageRules:         messageRules,
+		RateLimitWindow:      rateLimitWindow,
+		RateLimitMaxMessages: rateLimitMaxMessages,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVersion,
+		DefaultCounterpartyPolicies,
+		DefaultMessageRules,
+		DefaultRateLimitWindow,
+		DefaultRateLimitMaxMessages,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVersion, &p.Version, validateVersion),
+		paramtypes.NewParamSetPair(KeyCounterpartyPolicies, &p.CounterpartyPolicies, validateCounterpartyPolicies),
+		paramtypes.NewParamSetPair(KeyMessageRules, &p.MessageRules, validateMessageRules),
+		paramtypes.NewParamSetPair(KeyRateLimitWindow, &p.RateLimitWindow, validateRateLimitWindow),
+		paramtypes.NewParamSetPair(KeyRateLimitMaxMessages, &p.RateLimitMaxMessages, validateRateLimitMaxMessages),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateVersion(p.Version); err != nil {
+		return err
+	}
+	if err := validateCounterpartyPolicies(p.CounterpartyPolicies); err != nil {
+		return err
+	}
+	if err := validateMessageRules(p.MessageRules); err != nil {
+		return err
+	}
+	if err := validateRateLimitWindow(p.RateLimitWindow); err != nil {
+		return err
+	}
+	return validateRateLimitMaxMessages(p.RateLimitMaxMessages)
+}
+
+// CounterpartyPolicy returns the policy configured for the given chain
+func (p Params) CounterpartyPolicy(chainId string) (CounterpartyPolicy, bool) {
+	for _, policy := range p.CounterpartyPolicies {
+		if policy.ChainId == chainId {
+			return policy, true
+		}
+	}
+	return CounterpartyPolicy{}, false
+}
+
+// MessageRule returns the rule configured for the given delivery type
+func (p Params) MessageRule(deliveryType string) (MessageRule, bool) {
+	for _, rule := range p.MessageRules {
+		if rule.DeliveryType == deliveryType {
+			return rule, true
+		}
+	}
+	return MessageRule{}, false
+}
+
+// Order returns the channel ordering required by the policy
+func (p CounterpartyPolicy) Order() channeltypes.Order {
+	return channeltypes.Order(channeltypes.Order_value[p.Ordering])
+}
+
+// AllowsMessageType reports whether the message type is allowlisted by the policy
+func (p CounterpartyPolicy) AllowsMessageType(messageType string) bool {
+	for _, mt := range p.MessageTypes {
+		if mt == messageType {
+			return true
+		}
+	}
+	return false
+}
+
+// Requires reports whether the rule includes the given validation
+func (r MessageRule) Requires(validation string) bool {
+	for _, v := range r.Validations {
+		if v == validation {
+			return true
+		}
+	}
+	return false
+}
+
+// validateVersion validates the Version param
+func validateVersion(v interface{}) error {
+	version, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if version == "" {
+		return fmt.Errorf("version cannot be empty")
+	}
+	return nil
+}
+
+// validateCounterpartyPolicies validates the CounterpartyPolicies param
+func validateCounterpartyPolicies(v interface{}) error {
+	policies, ok := v.([]CounterpartyPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chains := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		if policy.ChainId == "" {
+			return fmt.Errorf("counterparty chain id cannot be empty")
+		}
+		if _, ok := chains[policy.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty policy for %s", policy.ChainId)
+		}
+		chains[policy.ChainId] = struct{}{}
+
+		if order := policy.Order(); order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+			return fmt.Errorf("invalid ordering %q for %s", policy.Ordering, policy.ChainId)
+		}
+		if len(policy.MessageTypes) == 0 {
+			return fmt.Errorf("counterparty %s must allow at least one message type", policy.ChainId)
+		}
+	}
+	return nil
+}
+
+// validateMessageRules validates the MessageRules param
+func validateMessageRules(v interface{}) error {
+	rules, ok := v.([]MessageRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	deliveryTypes := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule.DeliveryType == "" {
+			return fmt.Errorf("delivery type cannot be empty")
+		}
+		if _, ok := deliveryTypes[rule.DeliveryType]; ok {
+			return fmt.Errorf("duplicated message rule for %s", rule.DeliveryType)
+		}
+		deliveryTypes[rule.DeliveryType] = struct{}{}
+
+		if !rule.Requires(ValidationSignature) {
+			return fmt.Errorf("delivery type %s must require a signature", rule.DeliveryType)
+		}
+		for _, validation := range rule.Validations {
+			if _, ok := supportedValidations[validation]; !ok {
+				return fmt.Errorf("unsupported validation %q for %s", validation, rule.DeliveryType)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRateLimitWindow validates the RateLimitWindow param
+func validateRateLimitWindow(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("rate limit window must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateRateLimitMaxMessages validates the RateLimitMaxMessages param
+func validateRateLimitMaxMessages(v interface{}) error {
+	max, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if max == 0 {
+		return fmt.Errorf("rate limit max messages must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,1176 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/communication/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// version is the channel version negotiated on the communication port.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// counterparty_policies lists the chains allowed to open communication
+	// channels, mirroring the communication paths in ibc-config.yml.
+	CounterpartyPolicies []CounterpartyPolicy `protobuf:"bytes,2,rep,name=counterparty_policies,json=counterpartyPolicies,proto3" json:"counterparty_policies"`
+	// message_rules lists the validations each delivery type must pass,
+	// mirroring message_types.user_communication in ibc-config.yml.
+	MessageRules []MessageRule `protobuf:"bytes,3,rep,name=message_rules,json=messageRules,proto3" json:"message_rules"`
+	// rate_limit_window and rate_limit_max_messages bound how many messages a
+	// sender may relay when its delivery type requires rate_limit.
+	RateLimitWindow      time.Duration `protobuf:"bytes,4,opt,name=rate_limit_window,json=rateLimitWindow,proto3,stdduration" json:"rate_limit_window"`
+	RateLimitMaxMessages uint32        `protobuf:"varint,5,opt,name=rate_limit_max_messages,json=rateLimitMaxMessages,proto3" json:"rate_limit_max_messages,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfa1e0e7d667a93, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Params) GetCounterpartyPolicies() []CounterpartyPolicy {
+	if m != nil {
+		return m.CounterpartyPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMessageRules() []MessageRule {
+	if m != nil {
+		return m.MessageRules
+	}
+	return nil
+}
+
+func (m *Params) GetRateLimitWindow() time.Duration {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRateLimitMaxMessages() uint32 {
+	if m != nil {
+		return m.RateLimitMaxMessages
+	}
+	return 0
+}
+
+// CounterpartyPolicy is the communication path configuration for one chain.
+type CounterpartyPolicy struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// ordering is ORDERED or UNORDERED.
+	Ordering string `protobuf:"bytes,2,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	// message_types is the allowlist of relayed message categories.
+	MessageTypes []string `protobuf:"bytes,3,rep,name=message_types,json=messageTypes,proto3" json:"message_types,omitempty"`
+}
+
+func (m *CounterpartyPolicy) Reset()         { *m = CounterpartyPolicy{} }
+func (m *CounterpartyPolicy) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyPolicy) ProtoMessage()    {}
+func (*CounterpartyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfa1e0e7d667a93, []int{1}
+}
+func (m *CounterpartyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyPolicy.Merge(m, src)
+}
+func (m *CounterpartyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyPolicy proto.InternalMessageInfo
+
+func (m *CounterpartyPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *CounterpartyPolicy) GetOrdering() string {
+	if m != nil {
+		return m.Ordering
+	}
+	return ""
+}
+
+func (m *CounterpartyPolicy) GetMessageTypes() []string {
+	if m != nil {
+		return m.MessageTypes
+	}
+	return nil
+}
+
+// MessageRule names the validations required for a delivery type.
+type MessageRule struct {
+	DeliveryType string   `protobuf:"bytes,1,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`
+	Validations  []string `protobuf:"bytes,2,rep,name=validations,proto3" json:"validations,omitempty"`
+}
+
+func (m *MessageRule) Reset()         { *m = MessageRule{} }
+func (m *MessageRule) String() string { return proto.CompactTextString(m) }
+func (*MessageRule) ProtoMessage()    {}
+func (*MessageRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfa1e0e7d667a93, []int{2}
+}
+func (m *MessageRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRule.Merge(m, src)
+}
+func (m *MessageRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRule proto.InternalMessageInfo
+
+func (m *MessageRule) GetDeliveryType() string {
+	if m != nil {
+		return m.DeliveryType
+	}
+	return ""
+}
+
+func (m *MessageRule) GetValidations() []string {
+	if m != nil {
+		return m.Validations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hub.communication.Params")
+	proto.RegisterType((*CounterpartyPolicy)(nil), "hub.communication.CounterpartyPolicy")
+	proto.RegisterType((*MessageRule)(nil), "hub.communication.MessageRule")
+}
+
+func init() { proto.RegisterFile("hub/communication/params.proto", fileDescriptor_6cfa1e0e7d667a93) }
+
+var fileDescriptor_6cfa1e0e7d667a93 = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x69, 0x28, 0xc9, 0xa5, 0x15, 0xca, 0x29, 0x08, 0x27, 0xc3, 0xc5, 0x4a, 0x85,
+	0x14, 0x31, 0xd8, 0x52, 0x2b, 0x96, 0x8e, 0x81, 0xa5, 0x12, 0x15, 0x95, 0x85, 0x84, 0xd4, 0xc5,
+	0x5c, 0xec, 0xc3, 0x39, 0xc9, 0xbe, 0xb3, 0xee, 0x7c, 0x69, 0xf2, 0x15, 0x98, 0x18, 0x19, 0xf9,
+	0x08, 0x7c, 0x8c, 0x8e, 0x1d, 0x99, 0x00, 0x25, 0x03, 0x7c, 0x08, 0x06, 0x74, 0x67, 0x27, 0x35,
+	0x6d, 0x17, 0xeb, 0xde, 0x3f, 0xcf, 0xf3, 0xbe, 0xfe, 0xbd, 0x10, 0xcf, 0xf5, 0xcc, 0x8f, 0x44,
+	0x96, 0x69, 0xce, 0x22, 0x52, 0x30, 0xc1, 0xfd, 0x9c, 0x48, 0x92, 0x29, 0x2f, 0x97, 0xa2, 0x10,
+	0xa8, 0x37, 0xd7, 0x33, 0xef, 0xbf, 0xfa, 0xb0, 0x47, 0x32, 0xc6, 0x85, 0x6f, 0xbf, 0x65, 0xd7,
+	0xb0, 0x9f, 0x88, 0x44, 0xd8, 0xa7, 0x6f, 0x5e, 0x55, 0x16, 0x27, 0x42, 0x24, 0x29, 0xf5, 0x6d,
+	0x34, 0xd3, 0x1f, 0xfd, 0x58, 0x4b, 0x6b, 0x51, 0xd6, 0xc7, 0x7f, 0x9b, 0x70, 0xff, 0xc2, 0x0e,
+	0x43, 0x0e, 0x7c, 0xbc, 0xa0, 0x52, 0x31, 0xc1, 0x1d, 0xe0, 0x82, 0x49, 0x27, 0xd8, 0x86, 0xe8,
+	0x03, 0x7c, 0x1a, 0x09, 0xcd, 0x0b, 0x2a, 0x73, 0x22, 0x8b, 0x55, 0x98, 0x8b, 0x94, 0x45, 0x8c,
+	0x2a, 0xa7, 0xe9, 0xee, 0x4d, 0xba, 0xc7, 0xcf, 0xbd, 0x7b, 0x0b, 0x7a, 0xaf, 0x6a, 0xfd, 0x17,
+	0xa6, 0x7d, 0x35, 0x6d, 0x5d, 0xff, 0x18, 0x35, 0x82, 0x7e, 0x74, 0xb7, 0xc2, 0xa8, 0x42, 0x67,
+	0xf0, 0x30, 0xa3, 0x4a, 0x91, 0x84, 0x86, 0x52, 0xa7, 0x54, 0x39, 0x7b, 0xd6, 0x19, 0x3f, 0xe0,
+	0x7c, 0x5e, 0xf6, 0x05, 0x3a, 0xa5, 0x95, 0xe5, 0x41, 0x76, 0x9b, 0x52, 0xe8, 0x2d, 0xec, 0x49,
+	0x52, 0xd0, 0x30, 0x65, 0x19, 0x2b, 0xc2, 0x2b, 0xc6, 0x63, 0x71, 0xe5, 0xb4, 0x5c, 0x30, 0xe9,
+	0x1e, 0x0f, 0xbc, 0x92, 0x86, 0xb7, 0xa5, 0xe1, 0xbd, 0xae, 0x68, 0x4c, 0xdb, 0xc6, 0xe9, 0xcb,
+	0xcf, 0x11, 0x08, 0x9e, 0x18, 0xf5, 0x1b, 0x23, 0x7e, 0x6f, 0xb5, 0xe8, 0x25, 0x7c, 0x56, 0x33,
+	0xcc, 0xc8, 0x32, 0xac, 0xe6, 0x29, 0xe7, 0x91, 0x0b, 0x26, 0x87, 0x41, 0x7f, 0xa7, 0x38, 0x27,
+	0xcb, 0x6a, 0x3d, 0x75, 0x7a, 0xf4, 0xe7, 0xeb, 0x08, 0x7c, 0xfa, 0xfd, 0xed, 0xc5, 0xd0, 0x9c,
+	0x77, 0x79, 0xe7, 0xc0, 0x25, 0xf3, 0xf1, 0x02, 0xa2, 0xfb, 0xa4, 0xd0, 0x00, 0xb6, 0xa3, 0x39,
+	0x61, 0x3c, 0x64, 0xf1, 0xf6, 0x14, 0x36, 0x3e, 0x8b, 0xd1, 0x10, 0xb6, 0x85, 0x8c, 0xa9, 0x64,
+	0x3c, 0x71, 0x9a, 0xb6, 0xb4, 0x8b, 0xd1, 0xd1, 0x2d, 0xc4, 0x62, 0x95, 0x57, 0x10, 0x3b, 0x3b,
+	0x3c, 0xef, 0x4c, 0xee, 0xb4, 0x65, 0xd6, 0x1a, 0x5f, 0xc2, 0x6e, 0x8d, 0xa3, 0x51, 0xc6, 0x34,
+	0x65, 0x0b, 0x2a, 0x57, 0x56, 0x5a, 0x4d, 0x3d, 0xd8, 0x26, 0x8d, 0x14, 0xb9, 0xb0, 0xbb, 0x20,
+	0x29, 0x8b, 0xed, 0x0f, 0x94, 0xb7, 0xef, 0x04, 0xf5, 0x54, 0xe9, 0x3d, 0x3d, 0xb9, 0x5e, 0x63,
+	0x70, 0xb3, 0xc6, 0xe0, 0xd7, 0x1a, 0x83, 0xcf, 0x1b, 0xdc, 0xb8, 0xd9, 0xe0, 0xc6, 0xf7, 0x0d,
+	0x6e, 0x5c, 0x0e, 0x1e, 0x22, 0x61, 0x57, 0x9d, 0xed, 0xdb, 0x93, 0x9c, 0xfc, 0x1b, 0x00, 0xee,
+	0x6b, 0x27, 0x9f, 0x0c, 0x03, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.CounterpartyPolicies) != len(that1.CounterpartyPolicies) {
+		return false
+	}
+	for i := range this.CounterpartyPolicies {
+		if !this.CounterpartyPolicies[i].Equal(&that1.CounterpartyPolicies[i]) {
+			return false
+		}
+	}
+	if len(this.MessageRules) != len(that1.MessageRules) {
+		return false
+	}
+	for i := range this.MessageRules {
+		if !this.MessageRules[i].Equal(&that1.MessageRules[i]) {
+			return false
+		}
+	}
+	if this.RateLimitWindow != that1.RateLimitWindow {
+		return false
+	}
+	if this.RateLimitMaxMessages != that1.RateLimitMaxMessages {
+		return false
+	}
+	return true
+}
+func (this *CounterpartyPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CounterpartyPolicy)
+	if !ok {
+		that2, ok := that.(CounterpartyPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if this.Ordering != that1.Ordering {
+		return false
+	}
+	if len(this.MessageTypes) != len(that1.MessageTypes) {
+		return false
+	}
+	for i := range this.MessageTypes {
+		if this.MessageTypes[i] != that1.MessageTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *MessageRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageRule)
+	if !ok {
+		that2, ok := that.(MessageRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeliveryType != that1.DeliveryType {
+		return false
+	}
+	if len(this.Validations) != len(that1.Validations) {
+		return false
+	}
+	for i := range this.Validations {
+		if this.Validations[i] != that1.Validations[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitMaxMessages != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RateLimitMaxMessages))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RateLimitWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MessageRules) > 0 {
+		for iNdEx := len(m.MessageRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CounterpartyPolicies) > 0 {
+		for iNdEx := len(m.CounterpartyPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CounterpartyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageTypes) > 0 {
+		for iNdEx := len(m.MessageTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageTypes[iNdEx])
+			copy(dAtA[i:], m.MessageTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MessageTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ordering) > 0 {
+		i -= len(m.Ordering)
+		copy(dAtA[i:], m.Ordering)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Ordering)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validations[iNdEx])
+			copy(dAtA[i:], m.Validations[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Validations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeliveryType) > 0 {
+		i -= len(m.DeliveryType)
+		copy(dAtA[i:], m.DeliveryType)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DeliveryType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.CounterpartyPolicies) > 0 {
+		for _, e := range m.CounterpartyPolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MessageRules) > 0 {
+		for _, e := range m.MessageRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RateLimitWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.RateLimitMaxMessages != 0 {
+		n += 1 + sovParams(uint64(m.RateLimitMaxMessages))
+	}
+	return n
+}
+
+func (m *CounterpartyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Ordering)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.MessageTypes) > 0 {
+		for _, s := range m.MessageTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeliveryType)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Validations) > 0 {
+		for _, s := range m.Validations {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPolicies = append(m.CounterpartyPolicies, CounterpartyPolicy{})
+			if err := m.CounterpartyPolicies[len(m.CounterpartyPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageRules = append(m.MessageRules, MessageRule{})
+			if err := m.MessageRules[len(m.MessageRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RateLimitWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitMaxMessages", wireType)
+			}
+			m.RateLimitMaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitMaxMessages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CounterpartyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTypes = append(m.MessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validations = append(m.Validations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
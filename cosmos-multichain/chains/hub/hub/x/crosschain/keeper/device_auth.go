@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetDeviceAuth set a specific deviceAuth in the store from its index
+func (k Keeper) SetDeviceAuth(ctx context.Context, deviceAuth types.DeviceAuthRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DeviceAuthKeyPrefix))
+	b := k.cdc.MustMarshal(&deviceAuth)
+	store.Set(types.DeviceAuthKey(
+		deviceAuth.Auth.GetDeviceId(),
+	), b)
+}
+
+// GetDeviceAuth returns a deviceAuth from its index
+func (k Keeper) GetDeviceAuth(
+	ctx context.Context,
+	deviceId string,
+) (val types.DeviceAuthRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DeviceAuthKeyPrefix))
+
+	b := store.Get(types.DeviceAuthKey(
+		deviceId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDeviceAuth removes a deviceAuth from the store
+func (k Keeper) RemoveDeviceAuth(
+	ctx context.Context,
+	deviceId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DeviceAuthKeyPrefix))
+	store.Delete(types.DeviceAuthKey(
+		deviceId,
+	))
+}
+
+// GetAllDeviceAuth returns all deviceAuth
+func (k Keeper) GetAllDeviceAuth(ctx context.Context) (list []types.DeviceAuthRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DeviceAuthKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeviceAuthRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetDocumentVerification set a specific documentVerification in the store from its index
+func (k Keeper) SetDocumentVerification(ctx context.Context, documentVerification types.DocumentVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentVerificationKeyPrefix))
+	b := k.cdc.MustMarshal(&documentVerification)
+	store.Set(types.DocumentVerificationKey(
+		documentVerification.VerificationId,
+	), b)
+}
+
+// GetDocumentVerification returns a documentVerification from its index
+func (k Keeper) GetDocumentVerification(
+	ctx context.Context,
+	verificationId string,
+) (val types.DocumentVerificationRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentVerificationKeyPrefix))
+
+	b := store.Get(types.DocumentVerificationKey(
+		verificationId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDocumentVerification removes a documentVerification from the store
+func (k Keeper) RemoveDocumentVerification(
+	ctx context.Context,
+	verificationId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentVerificationKeyPrefix))
+	store.Delete(types.DocumentVerificationKey(
+		verificationId,
+	))
+}
+
+// GetAllDocumentVerification returns all documentVerification
+func (k Keeper) GetAllDocumentVerification(ctx context.Context) (list []types.DocumentVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentVerificationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DocumentVerificationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetMessage set a specific message in the store from its index
+func (k Keeper) SetMessage(ctx context.Context, message types.MessageRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MessageKeyPrefix))
+	b := k.cdc.MustMarshal(&message)
+	store.Set(types.MessageKey(
+		message.Message.GetMessageId(),
+	), b)
+}
+
+// GetMessage returns a message from its index
+func (k Keeper) GetMessage(
+	ctx context.Context,
+	messageId string,
+) (val types.MessageRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MessageKeyPrefix))
+
+	b := store.Get(types.MessageKey(
+		messageId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveMessage removes a message from the store
+func (k Keeper) RemoveMessage(
+	ctx context.Context,
+	messageId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MessageKeyPrefix))
+	store.Delete(types.MessageKey(
+		messageId,
+	))
+}
+
+// GetAllMessage returns all message
+func (k Keeper) GetAllMessage(ctx context.Context) (list []types.MessageRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MessageKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MessageRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
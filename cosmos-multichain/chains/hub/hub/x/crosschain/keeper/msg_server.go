@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"hub/x/crosschain/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewCrossChainServerImpl returns an implementation of the CrossChainService
+// Msg service for the provided Keeper.
+func NewCrossChainServerImpl(keeper Keeper) types.CrossChainServiceServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.CrossChainServiceServer = msgServer{}
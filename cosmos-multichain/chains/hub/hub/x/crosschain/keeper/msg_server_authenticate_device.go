@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+func (k msgServer) AuthenticateDevice(goCtx context.Context, msg *types.AuthenticateDeviceRequest) (*types.AuthenticateDeviceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+	params := k.GetParams(ctx)
+
+	auth := *msg.AuthData
+	if existing, found := k.GetDeviceAuth(ctx, auth.DeviceId); found && existing.Creator != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrDeviceNotOwned, auth.DeviceId)
+	}
+	for _, factor := range msg.RequiredFactors {
+		if !params.IsAllowedAuthMethod(factor) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDeviceAuth, "unsupported factor %s", factor)
+		}
+	}
+
+	status := types.VerificationStatus_STATUS_VERIFIED
+	var errMsg string
+	switch {
+	case !params.IsAllowedAuthMethod(auth.AuthMethod):
+		errMsg = fmt.Sprintf("unsupported auth method %s", auth.AuthMethod)
+	case len(msg.RequiredFactors) > 0 && !containsString(msg.RequiredFactors, auth.AuthMethod):
+		errMsg = fmt.Sprintf("auth method %s does not satisfy the required factors", auth.AuthMethod)
+	case len(auth.AuthData) == 0:
+		errMsg = "auth data cannot be empty"
+	}
+	if errMsg != "" {
+		status = types.VerificationStatus_STATUS_REJECTED
+	}
+
+	auth.Status = status
+	auth.Timestamp = now
+	record := types.DeviceAuthRecord{
+		Creator:         msg.Creator,
+		Auth:            &auth,
+		RequiredFactors: msg.RequiredFactors,
+	}
+	if status == types.VerificationStatus_STATUS_VERIFIED {
+		record.AuthToken = deviceAuthToken(ctx, &auth)
+		record.TokenExpiry = now + params.SessionTokenTtl
+	}
+	k.SetDeviceAuth(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.DeviceAuthEvent{
+		EventId:   fmt.Sprintf("device-%s-%d", auth.DeviceId, ctx.BlockHeight()),
+		DeviceId:  auth.DeviceId,
+		UserId:    auth.UserId,
+		Status:    status,
+		Timestamp: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.AuthenticateDeviceResponse{
+		Status:       status,
+		AuthToken:    record.AuthToken,
+		TokenExpiry:  record.TokenExpiry,
+		ErrorMessage: errMsg,
+	}, nil
+}
+
+// deviceAuthToken derives a session token bound to the device, the user, the
+// presented credential and the block it was issued in.
+func deviceAuthToken(ctx sdk.Context, auth *types.DeviceAuth) string {
+	h := sha256.New()
+	h.Write([]byte(auth.DeviceId))
+	h.Write([]byte{0})
+	h.Write([]byte(auth.UserId))
+	h.Write([]byte{0})
+	h.Write([]byte(auth.AuthMethod))
+	h.Write([]byte{0})
+	h.Write(auth.AuthData)
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(ctx.BlockHeight()))
+	h.Write(height)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+func (k msgServer) SendMessage(goCtx context.Context, msg *types.SendMessageRequest) (*types.SendMessageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	params := k.GetParams(ctx)
+	if uint32(len(msg.Message.Recipients)) > params.MaxRecipients {
+		return nil, errorsmod.Wrapf(types.ErrTooManyRecipients, "%d exceeds limit of %d", len(msg.Message.Recipients), params.MaxRecipients)
+	}
+
+	message := *msg.Message
+	if message.MessageId == "" {
+		message.MessageId = k.nextRequestID(ctx, "message")
+	}
+	if _, found := k.GetMessage(ctx, message.MessageId); found {
+		return nil, errorsmod.Wrap(types.ErrMessageExists, message.MessageId)
+	}
+	if message.Timestamp == 0 {
+		message.Timestamp = now
+	}
+
+	// Blank and repeated recipients are reported back as failed deliveries.
+	seen := make(map[string]bool)
+	var delivered, failed []string
+	for _, recipient := range message.Recipients {
+		if recipient == "" || seen[recipient] {
+			failed = append(failed, recipient)
+			continue
+		}
+		seen[recipient] = true
+		delivered = append(delivered, recipient)
+	}
+
+	k.SetMessage(ctx, types.MessageRecord{
+		Creator:                     msg.Creator,
+		Message:                     &message,
+		RequireDeliveryConfirmation: msg.RequireDeliveryConfirmation,
+		DeliveredTo:                 delivered,
+		FailedDeliveries:            failed,
+		CreatedAt:                   now,
+	})
+
+	for _, recipient := range delivered {
+		if err := k.emitMessageDelivery(ctx, message.MessageId, recipient, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, recipient := range failed {
+		if err := k.emitMessageDelivery(ctx, message.MessageId, recipient, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.SendMessageResponse{
+		MessageId:        message.MessageId,
+		DeliveredTo:      delivered,
+		FailedDeliveries: failed,
+	}, nil
+}
+
+func (k Keeper) emitMessageDelivery(ctx sdk.Context, messageId, recipient string, delivered bool) error {
+	return ctx.EventManager().EmitTypedEvent(&types.MessageDeliveryEvent{
+		EventId:   messageId + "/" + recipient,
+		MessageId: messageId,
+		Recipient: recipient,
+		Delivered: delivered,
+		Timestamp: ctx.BlockTime().Unix(),
+	})
+}
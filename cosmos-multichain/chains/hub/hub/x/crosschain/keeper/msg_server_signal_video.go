@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+func (k msgServer) SignalVideo(goCtx context.Context, msg *types.VideoSignalRequest) (*types.VideoSignalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	if !k.GetParams(ctx).IsAllowedSignalType(msg.Signal.SignalType) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignal, "unsupported signal type %s", msg.Signal.SignalType)
+	}
+
+	signal := *msg.Signal
+	if signal.Timestamp == 0 {
+		signal.Timestamp = now
+	}
+
+	// The hub acknowledges on behalf of the peer once the signal is recorded.
+	acknowledged := msg.RequireAcknowledgment && signal.PeerId != ""
+
+	record := types.VideoSignalRecord{
+		SignalId:     k.nextRequestID(ctx, "signal"),
+		Creator:      msg.Creator,
+		Signal:       &signal,
+		Acknowledged: acknowledged,
+		CreatedAt:    now,
+	}
+	k.SetVideoSignal(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.VideoSignalEvent{
+		EventId:      record.SignalId,
+		SessionId:    signal.SessionId,
+		SignalType:   signal.SignalType,
+		PeerId:       signal.PeerId,
+		Acknowledged: acknowledged,
+		Timestamp:    now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.VideoSignalResponse{
+		SignalId:     record.SignalId,
+		Acknowledged: acknowledged,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	"hub/testutil/sample"
+	"hub/x/crosschain/keeper"
+	"hub/x/crosschain/types"
+)
+
+func setupCrossChainServer(t testing.TB) (keeper.Keeper, types.CrossChainServiceServer, sdk.Context) {
+	k, ctx := keepertest.CrosschainKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	return k, keeper.NewCrossChainServerImpl(k), ctx
+}
+
+// attestations stubs x/profileverification, attesting the credential types
+// listed for each user
+type attestations map[string][]string
+
+func (a attestations) IsCredentialAttested(_ context.Context, userId string, credential types.Credential) bool {
+	for _, credentialType := range a[userId] {
+		if credentialType == credential.CredentialType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestVerifyUserProfile(t *testing.T) {
+	attested := attestations{}
+	k, ctx := keepertest.CrosschainKeeperWithAttestations(t, attested)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewCrossChainServerImpl(k)
+	creator := sample.AccAddress()
+	now := ctx.BlockTime().Unix()
+
+	profile := &types.UserProfile{
+		UserId: "alice",
+		Credentials: []*types.Credential{
+			{CredentialType: "email", Issuer: "hub"},
+			{CredentialType: "kyc", Issuer: "provider", ExpiryDate: now - 1},
+		},
+	}
+	verify := func(required ...string) *types.VerifyUserProfileResponse {
+		res, err := srv.VerifyUserProfile(ctx, &types.VerifyUserProfileRequest{
+			Creator: creator, Profile: profile, RequiredCredentials: required,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// self-supplied credentials wait for the attestors
+	res := verify("email")
+	require.Equal(t, types.VerificationStatus_STATUS_PENDING, res.Status)
+	require.Empty(t, res.VerifiedCredentials)
+	require.Contains(t, res.ErrorMessage, "email")
+	require.Equal(t, types.VerificationStatus_STATUS_PENDING, verify().Status)
+
+	attested["alice"] = []string{"email"}
+	res = verify("email")
+	require.Equal(t, types.VerificationStatus_STATUS_VERIFIED, res.Status)
+	require.Equal(t, []string{"email"}, res.VerifiedCredentials)
+	require.Equal(t, types.VerificationStatus_STATUS_VERIFIED, verify().Status)
+
+	res = verify("email", "kyc")
+	require.Equal(t, types.VerificationStatus_STATUS_REJECTED, res.Status)
+	require.Contains(t, res.ErrorMessage, "kyc")
+
+	stored, found := k.GetProfileVerification(ctx, res.VerificationId)
+	require.True(t, found)
+	require.Equal(t, creator, stored.Profile.VerifierId)
+	require.Equal(t, types.VerificationStatus_STATUS_REJECTED, stored.Profile.Status)
+	require.Len(t, k.GetAllProfileVerification(ctx), 5)
+	require.NotEmpty(t, ctx.EventManager().Events())
+}
+
+func TestVerifyDocument(t *testing.T) {
+	_, srv, ctx := setupCrossChainServer(t)
+
+	res, err := srv.VerifyDocument(ctx, &types.VerifyDocumentRequest{
+		Creator:               sample.AccAddress(),
+		Document:              &types.Document{DocumentId: "doc", DocumentType: "deed", DocumentHash: make([]byte, 32)},
+		RequiredVerifications: []string{"document_type"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_VERIFIED, res.Status)
+	require.Equal(t, []string{"hash", "document_type"}, res.CompletedVerifications)
+
+	res, err = srv.VerifyDocument(ctx, &types.VerifyDocumentRequest{
+		Creator:  sample.AccAddress(),
+		Document: &types.Document{DocumentId: "doc", DocumentHash: []byte("short")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_REJECTED, res.Status)
+}
+
+func TestSendMessage(t *testing.T) {
+	k, srv, ctx := setupCrossChainServer(t)
+	creator := sample.AccAddress()
+
+	res, err := srv.SendMessage(ctx, &types.SendMessageRequest{
+		Creator: creator,
+		Message: &types.RealTimeMessage{MessageId: "m1", Recipients: []string{"bob", "", "carol", "bob"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob", "carol"}, res.DeliveredTo)
+	require.Equal(t, []string{"", "bob"}, res.FailedDeliveries)
+
+	_, found := k.GetMessage(ctx, "m1")
+	require.True(t, found)
+
+	_, err = srv.SendMessage(ctx, &types.SendMessageRequest{
+		Creator: creator,
+		Message: &types.RealTimeMessage{MessageId: "m1", Recipients: []string{"bob"}},
+	})
+	require.ErrorIs(t, err, types.ErrMessageExists)
+}
+
+func TestSignalVideo(t *testing.T) {
+	k, srv, ctx := setupCrossChainServer(t)
+
+	res, err := srv.SignalVideo(ctx, &types.VideoSignalRequest{
+		Creator:               sample.AccAddress(),
+		Signal:                &types.VideoSignal{SessionId: "s1", SignalType: "offer", PeerId: "peer"},
+		RequireAcknowledgment: true,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Acknowledged)
+
+	_, found := k.GetVideoSignal(ctx, "s1", res.SignalId)
+	require.True(t, found)
+
+	_, err = srv.SignalVideo(ctx, &types.VideoSignalRequest{
+		Creator: sample.AccAddress(),
+		Signal:  &types.VideoSignal{SessionId: "s1", SignalType: "hangup"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSignal)
+}
+
+func TestAuthenticateDevice(t *testing.T) {
+	k, srv, ctx := setupCrossChainServer(t)
+	creator := sample.AccAddress()
+	auth := &types.DeviceAuth{DeviceId: "d1", UserId: "alice", AuthMethod: "pin", AuthData: []byte("1234")}
+
+	res, err := srv.AuthenticateDevice(ctx, &types.AuthenticateDeviceRequest{
+		Creator: creator, AuthData: auth, RequiredFactors: []string{"pin", "biometric"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_VERIFIED, res.Status)
+	require.NotEmpty(t, res.AuthToken)
+	require.Equal(t, ctx.BlockTime().Unix()+k.GetParams(ctx).SessionTokenTtl, res.TokenExpiry)
+
+	res, err = srv.AuthenticateDevice(ctx, &types.AuthenticateDeviceRequest{
+		Creator: creator, AuthData: auth, RequiredFactors: []string{"biometric"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_REJECTED, res.Status)
+	require.Empty(t, res.AuthToken)
+
+	_, err = srv.AuthenticateDevice(ctx, &types.AuthenticateDeviceRequest{
+		Creator: sample.AccAddress(), AuthData: auth,
+	})
+	require.ErrorIs(t, err, types.ErrDeviceNotOwned)
+}
+
+func TestVerifyService(t *testing.T) {
+	_, srv, ctx := setupCrossChainServer(t)
+	service := &types.ServiceVerification{
+		ServiceId:    "svc",
+		ServiceType:  "api",
+		Capabilities: map[string]string{"oauth2": "v2", "webhooks": "v1"},
+	}
+
+	res, err := srv.VerifyService(ctx, &types.VerifyServiceRequest{Creator: sample.AccAddress(), Service: service})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_VERIFIED, res.Status)
+	require.Equal(t, []string{"oauth2", "webhooks"}, res.VerifiedCapabilities)
+
+	res, err = srv.VerifyService(ctx, &types.VerifyServiceRequest{
+		Creator: sample.AccAddress(), Service: service, RequiredCapabilities: []string{"oauth2", "sso"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.VerificationStatus_STATUS_REJECTED, res.Status)
+	require.Equal(t, []string{"oauth2"}, res.VerifiedCapabilities)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+// documentChecks are the verifications the hub can complete on its own.
+var documentChecks = map[string]func(*types.Document) bool{
+	// hash accepts sha256 and sha512 digests.
+	"hash": func(d *types.Document) bool {
+		n := len(d.DocumentHash)
+		return n == sha256.Size || n == sha512.Size
+	},
+	"document_type": func(d *types.Document) bool {
+		return d.DocumentType != ""
+	},
+	"metadata": func(d *types.Document) bool {
+		return len(d.Metadata) > 0
+	},
+}
+
+func (k msgServer) VerifyDocument(goCtx context.Context, msg *types.VerifyDocumentRequest) (*types.VerifyDocumentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	// The document hash is always checked, whether or not it was requested.
+	required := msg.RequiredVerifications
+	if !containsString(required, "hash") {
+		required = append([]string{"hash"}, required...)
+	}
+
+	var completed, failed []string
+	for _, name := range required {
+		check, ok := documentChecks[name]
+		if ok && check(msg.Document) {
+			completed = append(completed, name)
+		} else {
+			failed = append(failed, name)
+		}
+	}
+
+	status := types.VerificationStatus_STATUS_VERIFIED
+	var errMsg string
+	if len(failed) > 0 {
+		status = types.VerificationStatus_STATUS_REJECTED
+		errMsg = fmt.Sprintf("failed verifications: %s", strings.Join(failed, ", "))
+	}
+
+	document := *msg.Document
+	document.Status = status
+	document.VerificationTimestamp = now
+	document.VerifierId = msg.Creator
+
+	record := types.DocumentVerificationRecord{
+		VerificationId:         k.nextRequestID(ctx, "document"),
+		Creator:                msg.Creator,
+		Document:               &document,
+		RequiredVerifications:  msg.RequiredVerifications,
+		CompletedVerifications: completed,
+		Status:                 status,
+		ErrorMessage:           errMsg,
+		CreatedAt:              now,
+	}
+	k.SetDocumentVerification(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.DocumentVerificationEvent{
+		EventId:    record.VerificationId,
+		DocumentId: document.DocumentId,
+		Status:     status,
+		Timestamp:  now,
+		VerifierId: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.VerifyDocumentResponse{
+		Status:                 status,
+		VerificationId:         record.VerificationId,
+		CompletedVerifications: completed,
+		ErrorMessage:           errMsg,
+	}, nil
+}
+
+func containsString(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
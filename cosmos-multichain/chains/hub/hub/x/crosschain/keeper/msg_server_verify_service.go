@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+func (k msgServer) VerifyService(goCtx context.Context, msg *types.VerifyServiceRequest) (*types.VerifyServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	// Without explicit requirements every advertised capability is checked.
+	required := msg.RequiredCapabilities
+	if len(required) == 0 {
+		for name := range msg.Service.Capabilities {
+			required = append(required, name)
+		}
+		sort.Strings(required)
+	}
+
+	var verified, missing []string
+	for _, name := range required {
+		if msg.Service.Capabilities[name] != "" {
+			verified = append(verified, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	status := types.VerificationStatus_STATUS_VERIFIED
+	var errMsg string
+	switch {
+	case len(missing) > 0:
+		status = types.VerificationStatus_STATUS_REJECTED
+		errMsg = fmt.Sprintf("missing capabilities: %s", strings.Join(missing, ", "))
+	case len(verified) == 0:
+		status = types.VerificationStatus_STATUS_REJECTED
+		errMsg = "service advertises no capabilities"
+	}
+
+	service := *msg.Service
+	service.Status = status
+	service.VerificationTimestamp = now
+
+	record := types.ServiceVerificationRecord{
+		VerificationId:       k.nextRequestID(ctx, "service"),
+		Creator:              msg.Creator,
+		Service:              &service,
+		RequiredCapabilities: msg.RequiredCapabilities,
+		VerifiedCapabilities: verified,
+		Status:               status,
+		ErrorMessage:         errMsg,
+	}
+	k.SetServiceVerification(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.ServiceVerificationEvent{
+		EventId:              record.VerificationId,
+		ServiceId:            service.ServiceId,
+		Status:               status,
+		VerifiedCapabilities: verified,
+		Timestamp:            now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.VerifyServiceResponse{
+		Status:               status,
+		VerificationId:       record.VerificationId,
+		VerifiedCapabilities: verified,
+		ErrorMessage:         errMsg,
+	}, nil
+}
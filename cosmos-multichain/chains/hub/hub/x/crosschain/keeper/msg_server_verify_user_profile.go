@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/types"
+)
+
+func (k msgServer) VerifyUserProfile(goCtx context.Context, msg *types.VerifyUserProfileRequest) (*types.VerifyUserProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	// A credential counts when it names an issuer and has not expired, and
+	// is verified once the attestors of x/profileverification confirmed it.
+	valid := make(map[string]bool)
+	attested := make(map[string]bool)
+	var verified, unattested []string
+	for _, c := range msg.Profile.Credentials {
+		if c.Issuer == "" || (c.ExpiryDate != 0 && c.ExpiryDate <= now) || valid[c.CredentialType] {
+			continue
+		}
+		valid[c.CredentialType] = true
+		if k.attestationKeeper.IsCredentialAttested(ctx, msg.Profile.UserId, *c) {
+			attested[c.CredentialType] = true
+			verified = append(verified, c.CredentialType)
+		} else {
+			unattested = append(unattested, c.CredentialType)
+		}
+	}
+
+	// Without explicit requirements every valid credential must be attested.
+	var missing []string
+	pending := unattested
+	if len(msg.RequiredCredentials) > 0 {
+		pending = nil
+		for _, required := range msg.RequiredCredentials {
+			switch {
+			case !valid[required]:
+				missing = append(missing, required)
+			case !attested[required]:
+				pending = append(pending, required)
+			}
+		}
+	}
+
+	status := types.VerificationStatus_STATUS_VERIFIED
+	var errMsg string
+	switch {
+	case len(missing) > 0:
+		status = types.VerificationStatus_STATUS_REJECTED
+		errMsg = fmt.Sprintf("missing or expired credentials: %s", strings.Join(missing, ", "))
+	case len(valid) == 0:
+		status = types.VerificationStatus_STATUS_REJECTED
+		errMsg = "profile has no valid credentials"
+	case len(pending) > 0:
+		status = types.VerificationStatus_STATUS_PENDING
+		errMsg = fmt.Sprintf("credentials awaiting attestation: %s", strings.Join(pending, ", "))
+	}
+
+	profile := *msg.Profile
+	profile.Status = status
+	profile.VerificationTimestamp = now
+	profile.VerifierId = msg.Creator
+
+	record := types.ProfileVerificationRecord{
+		VerificationId:      k.nextRequestID(ctx, "profile"),
+		Creator:             msg.Creator,
+		Profile:             &profile,
+		RequiredCredentials: msg.RequiredCredentials,
+		VerifiedCredentials: verified,
+		Status:              status,
+		ErrorMessage:        errMsg,
+		CreatedAt:           now,
+	}
+	k.SetProfileVerification(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.ProfileVerificationEvent{
+		EventId:    record.VerificationId,
+		ProfileId:  profile.UserId,
+		Status:     status,
+		Timestamp:  now,
+		VerifierId: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.VerifyUserProfileResponse{
+		Status:              status,
+		VerificationId:      record.VerificationId,
+		VerifiedCredentials: verified,
+		ErrorMessage:        errMsg,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetProfileVerification set a specific profileVerification in the store from its index
+func (k Keeper) SetProfileVerification(ctx context.Context, profileVerification types.ProfileVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileVerificationKeyPrefix))
+	b := k.cdc.MustMarshal(&profileVerification)
+	store.Set(types.ProfileVerificationKey(
+		profileVerification.VerificationId,
+	), b)
+}
+
+// GetProfileVerification returns a profileVerification from its index
+func (k Keeper) GetProfileVerification(
+	ctx context.Context,
+	verificationId string,
+) (val types.ProfileVerificationRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileVerificationKeyPrefix))
+
+	b := store.Get(types.ProfileVerificationKey(
+		verificationId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProfileVerification removes a profileVerification from the store
+func (k Keeper) RemoveProfileVerification(
+	ctx context.Context,
+	verificationId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileVerificationKeyPrefix))
+	store.Delete(types.ProfileVerificationKey(
+		verificationId,
+	))
+}
+
+// GetAllProfileVerification returns all profileVerification
+func (k Keeper) GetAllProfileVerification(ctx context.Context) (list []types.ProfileVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileVerificationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProfileVerificationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"hub/x/crosschain/types"
+)
+
+var _ types.QueryServer = Keeper{}
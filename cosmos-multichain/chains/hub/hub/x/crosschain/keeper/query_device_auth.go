@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) DeviceAuthAll(ctx context.Context, req *types.QueryAllDeviceAuthRequest) (*types.QueryAllDeviceAuthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var deviceAuths []types.DeviceAuthRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	deviceAuthStore := prefix.NewStore(store, types.KeyPrefix(types.DeviceAuthKeyPrefix))
+
+	pageRes, err := query.Paginate(deviceAuthStore, req.Pagination, func(key []byte, value []byte) error {
+		var deviceAuth types.DeviceAuthRecord
+		if err := k.cdc.Unmarshal(value, &deviceAuth); err != nil {
+			return err
+		}
+
+		deviceAuths = append(deviceAuths, deviceAuth)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDeviceAuthResponse{DeviceAuth: deviceAuths, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DeviceAuth(ctx context.Context, req *types.QueryGetDeviceAuthRequest) (*types.QueryGetDeviceAuthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetDeviceAuth(
+		ctx,
+		req.DeviceId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDeviceAuthResponse{DeviceAuth: val}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) DocumentVerificationAll(ctx context.Context, req *types.QueryAllDocumentVerificationRequest) (*types.QueryAllDocumentVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var documentVerifications []types.DocumentVerificationRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	documentVerificationStore := prefix.NewStore(store, types.KeyPrefix(types.DocumentVerificationKeyPrefix))
+
+	pageRes, err := query.Paginate(documentVerificationStore, req.Pagination, func(key []byte, value []byte) error {
+		var documentVerification types.DocumentVerificationRecord
+		if err := k.cdc.Unmarshal(value, &documentVerification); err != nil {
+			return err
+		}
+
+		documentVerifications = append(documentVerifications, documentVerification)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDocumentVerificationResponse{DocumentVerification: documentVerifications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DocumentVerification(ctx context.Context, req *types.QueryGetDocumentVerificationRequest) (*types.QueryGetDocumentVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetDocumentVerification(
+		ctx,
+		req.VerificationId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDocumentVerificationResponse{DocumentVerification: val}, nil
+}
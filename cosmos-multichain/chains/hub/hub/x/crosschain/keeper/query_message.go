@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) MessageAll(ctx context.Context, req *types.QueryAllMessageRequest) (*types.QueryAllMessageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var messages []types.MessageRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	messageStore := prefix.NewStore(store, types.KeyPrefix(types.MessageKeyPrefix))
+
+	pageRes, err := query.Paginate(messageStore, req.Pagination, func(key []byte, value []byte) error {
+		var message types.MessageRecord
+		if err := k.cdc.Unmarshal(value, &message); err != nil {
+			return err
+		}
+
+		messages = append(messages, message)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMessageResponse{Message: messages, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Message(ctx context.Context, req *types.QueryGetMessageRequest) (*types.QueryGetMessageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetMessage(
+		ctx,
+		req.MessageId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetMessageResponse{Message: val}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) ProfileVerificationAll(ctx context.Context, req *types.QueryAllProfileVerificationRequest) (*types.QueryAllProfileVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var profileVerifications []types.ProfileVerificationRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	profileVerificationStore := prefix.NewStore(store, types.KeyPrefix(types.ProfileVerificationKeyPrefix))
+
+	pageRes, err := query.Paginate(profileVerificationStore, req.Pagination, func(key []byte, value []byte) error {
+		var profileVerification types.ProfileVerificationRecord
+		if err := k.cdc.Unmarshal(value, &profileVerification); err != nil {
+			return err
+		}
+
+		profileVerifications = append(profileVerifications, profileVerification)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProfileVerificationResponse{ProfileVerification: profileVerifications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProfileVerification(ctx context.Context, req *types.QueryGetProfileVerificationRequest) (*types.QueryGetProfileVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetProfileVerification(
+		ctx,
+		req.VerificationId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetProfileVerificationResponse{ProfileVerification: val}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) ServiceVerificationAll(ctx context.Context, req *types.QueryAllServiceVerificationRequest) (*types.QueryAllServiceVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var serviceVerifications []types.ServiceVerificationRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	serviceVerificationStore := prefix.NewStore(store, types.KeyPrefix(types.ServiceVerificationKeyPrefix))
+
+	pageRes, err := query.Paginate(serviceVerificationStore, req.Pagination, func(key []byte, value []byte) error {
+		var serviceVerification types.ServiceVerificationRecord
+		if err := k.cdc.Unmarshal(value, &serviceVerification); err != nil {
+			return err
+		}
+
+		serviceVerifications = append(serviceVerifications, serviceVerification)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllServiceVerificationResponse{ServiceVerification: serviceVerifications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ServiceVerification(ctx context.Context, req *types.QueryGetServiceVerificationRequest) (*types.QueryGetServiceVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetServiceVerification(
+		ctx,
+		req.VerificationId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetServiceVerificationResponse{ServiceVerification: val}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/crosschain/types"
+)
+
+func (k Keeper) VideoSignals(ctx context.Context, req *types.QueryVideoSignalsRequest) (*types.QueryVideoSignalsResponse, error) {
+	if req == nil || req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var videoSignals []types.VideoSignalRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	videoSignalStore := prefix.NewStore(store, append(types.KeyPrefix(types.VideoSignalKeyPrefix), types.VideoSignalSessionKey(req.SessionId)...))
+
+	pageRes, err := query.Paginate(videoSignalStore, req.Pagination, func(key []byte, value []byte) error {
+		var videoSignal types.VideoSignalRecord
+		if err := k.cdc.Unmarshal(value, &videoSignal); err != nil {
+			return err
+		}
+
+		videoSignals = append(videoSignals, videoSignal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVideoSignalsResponse{VideoSignal: videoSignals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetServiceVerification set a specific serviceVerification in the store from its index
+func (k Keeper) SetServiceVerification(ctx context.Context, serviceVerification types.ServiceVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceVerificationKeyPrefix))
+	b := k.cdc.MustMarshal(&serviceVerification)
+	store.Set(types.ServiceVerificationKey(
+		serviceVerification.VerificationId,
+	), b)
+}
+
+// GetServiceVerification returns a serviceVerification from its index
+func (k Keeper) GetServiceVerification(
+	ctx context.Context,
+	verificationId string,
+) (val types.ServiceVerificationRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceVerificationKeyPrefix))
+
+	b := store.Get(types.ServiceVerificationKey(
+		verificationId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveServiceVerification removes a serviceVerification from the store
+func (k Keeper) RemoveServiceVerification(
+	ctx context.Context,
+	verificationId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceVerificationKeyPrefix))
+	store.Delete(types.ServiceVerificationKey(
+		verificationId,
+	))
+}
+
+// GetAllServiceVerification returns all serviceVerification
+func (k Keeper) GetAllServiceVerification(ctx context.Context) (list []types.ServiceVerificationRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceVerificationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ServiceVerificationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
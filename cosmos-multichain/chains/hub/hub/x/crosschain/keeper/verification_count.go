@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// GetVerificationCount get the total number of request IDs issued
+func (k Keeper) GetVerificationCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.VerificationCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetVerificationCount set the total number of request IDs issued
+func (k Keeper) SetVerificationCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.VerificationCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// nextRequestID issues a new request ID of the form "<kind>-<n>"
+func (k Keeper) nextRequestID(ctx context.Context, kind string) string {
+	count := k.GetVerificationCount(ctx)
+	k.SetVerificationCount(ctx, count+1)
+	return fmt.Sprintf("%s-%d", kind, count)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/crosschain/types"
+)
+
+// SetVideoSignal set a specific videoSignal in the store from its index
+func (k Keeper) SetVideoSignal(ctx context.Context, videoSignal types.VideoSignalRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VideoSignalKeyPrefix))
+	b := k.cdc.MustMarshal(&videoSignal)
+	store.Set(types.VideoSignalKey(
+		videoSignal.Signal.GetSessionId(),
+		videoSignal.SignalId,
+	), b)
+}
+
+// GetVideoSignal returns a videoSignal from its index
+func (k Keeper) GetVideoSignal(
+	ctx context.Context,
+	sessionId string,
+	signalId string,
+) (val types.VideoSignalRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VideoSignalKeyPrefix))
+
+	b := store.Get(types.VideoSignalKey(
+		sessionId,
+		signalId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVideoSignal removes a videoSignal from the store
+func (k Keeper) RemoveVideoSignal(
+	ctx context.Context,
+	sessionId string,
+	signalId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VideoSignalKeyPrefix))
+	store.Delete(types.VideoSignalKey(
+		sessionId,
+		signalId,
+	))
+}
+
+// GetAllVideoSignal returns all videoSignal
+func (k Keeper) GetAllVideoSignal(ctx context.Context) (list []types.VideoSignalRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VideoSignalKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VideoSignalRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
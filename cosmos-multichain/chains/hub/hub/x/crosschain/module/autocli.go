@@ -0,0 +1,135 @@
+package crosschain
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	ibcv1 "hub/api/bloqz/ibc/v1"
+	modulev1 "hub/api/hub/crosschain"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ProfileVerificationAll",
+					Use:       "list-profile-verification",
+					Short:     "List all profile verifications",
+				},
+				{
+					RpcMethod:      "ProfileVerification",
+					Use:            "show-profile-verification [verification-id]",
+					Short:          "Shows a profile verification",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "verification_id"}},
+				},
+				{
+					RpcMethod: "DocumentVerificationAll",
+					Use:       "list-document-verification",
+					Short:     "List all document verifications",
+				},
+				{
+					RpcMethod:      "DocumentVerification",
+					Use:            "show-document-verification [verification-id]",
+					Short:          "Shows a document verification",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "verification_id"}},
+				},
+				{
+					RpcMethod: "MessageAll",
+					Use:       "list-message",
+					Short:     "List all relayed real-time messages",
+				},
+				{
+					RpcMethod:      "Message",
+					Use:            "show-message [message-id]",
+					Short:          "Shows a relayed real-time message",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "message_id"}},
+				},
+				{
+					RpcMethod: "DeviceAuthAll",
+					Use:       "list-device-auth",
+					Short:     "List all device authentications",
+				},
+				{
+					RpcMethod:      "DeviceAuth",
+					Use:            "show-device-auth [device-id]",
+					Short:          "Shows the latest authentication of a device",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "device_id"}},
+				},
+				{
+					RpcMethod: "ServiceVerificationAll",
+					Use:       "list-service-verification",
+					Short:     "List all service verifications",
+				},
+				{
+					RpcMethod:      "ServiceVerification",
+					Use:            "show-service-verification [verification-id]",
+					Short:          "Shows a service verification",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "verification_id"}},
+				},
+				{
+					RpcMethod:      "VideoSignals",
+					Use:            "video-signals [session-id]",
+					Short:          "Lists the video signals exchanged in a session",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "session_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"handlers": {
+					Service: ibcv1.CrossChainService_ServiceDesc.ServiceName,
+					Short:   "Cross-chain verification and communication transactions",
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "VerifyUserProfile",
+							Use:       "verify-user-profile",
+							Short:     "Verify a user profile against required credentials",
+						},
+						{
+							RpcMethod: "VerifyDocument",
+							Use:       "verify-document",
+							Short:     "Verify a document against required verifications",
+						},
+						{
+							RpcMethod: "SendMessage",
+							Use:       "send-message",
+							Short:     "Send a real-time message to its recipients",
+						},
+						{
+							RpcMethod: "SignalVideo",
+							Use:       "signal-video",
+							Short:     "Relay a video conferencing signal",
+						},
+						{
+							RpcMethod: "AuthenticateDevice",
+							Use:       "authenticate-device",
+							Short:     "Authenticate a device and issue a session token",
+						},
+						{
+							RpcMethod: "VerifyService",
+							Use:       "verify-service",
+							Short:     "Verify an integration service's capabilities",
+						},
+					},
+				},
+			},
+		},
+	}
+}
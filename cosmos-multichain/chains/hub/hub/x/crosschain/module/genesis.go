@@ -0,0 +1,60 @@
+package crosschain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/crosschain/keeper"
+	"hub/x/crosschain/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the profileVerification
+	for _, elem := range genState.ProfileVerificationList {
+		k.SetProfileVerification(ctx, elem)
+	}
+	// Set all the documentVerification
+	for _, elem := range genState.DocumentVerificationList {
+		k.SetDocumentVerification(ctx, elem)
+	}
+	// Set all the message
+	for _, elem := range genState.MessageList {
+		k.SetMessage(ctx, elem)
+	}
+	// Set all the videoSignal
+	for _, elem := range genState.VideoSignalList {
+		k.SetVideoSignal(ctx, elem)
+	}
+	// Set all the deviceAuth
+	for _, elem := range genState.DeviceAuthList {
+		k.SetDeviceAuth(ctx, elem)
+	}
+	// Set all the serviceVerification
+	for _, elem := range genState.ServiceVerificationList {
+		k.SetServiceVerification(ctx, elem)
+	}
+
+	// Set request id count
+	k.SetVerificationCount(ctx, genState.VerificationCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.ProfileVerificationList = k.GetAllProfileVerification(ctx)
+	genesis.DocumentVerificationList = k.GetAllDocumentVerification(ctx)
+	genesis.MessageList = k.GetAllMessage(ctx)
+	genesis.VideoSignalList = k.GetAllVideoSignal(ctx)
+	genesis.DeviceAuthList = k.GetAllDeviceAuth(ctx)
+	genesis.ServiceVerificationList = k.GetAllServiceVerification(ctx)
+	genesis.VerificationCount = k.GetVerificationCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
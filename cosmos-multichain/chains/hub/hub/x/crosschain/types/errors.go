@@ -0,0 +1,22 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/crosschain module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidProfile       = sdkerrors.Register(ModuleName, 1101, "invalid user profile")
+	ErrInvalidDocument      = sdkerrors.Register(ModuleName, 1102, "invalid document")
+	ErrInvalidMessage       = sdkerrors.Register(ModuleName, 1103, "invalid real-time message")
+	ErrMessageExists        = sdkerrors.Register(ModuleName, 1104, "message already exists")
+	ErrInvalidSignal        = sdkerrors.Register(ModuleName, 1105, "invalid video signal")
+	ErrInvalidDeviceAuth    = sdkerrors.Register(ModuleName, 1106, "invalid device authentication")
+	ErrDeviceNotOwned       = sdkerrors.Register(ModuleName, 1107, "device is registered to another account")
+	ErrInvalidService       = sdkerrors.Register(ModuleName, 1108, "invalid service verification")
+	ErrTooManyRecipients    = sdkerrors.Register(ModuleName, 1109, "too many recipients")
+	ErrInvalidRequiredField = sdkerrors.Register(ModuleName, 1110, "invalid required field")
+)
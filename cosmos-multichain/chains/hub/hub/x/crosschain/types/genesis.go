@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ProfileVerificationList:  []ProfileVerificationRecord{},
+		DocumentVerificationList: []DocumentVerificationRecord{},
+		MessageList:              []MessageRecord{},
+		VideoSignalList:          []VideoSignalRecord{},
+		DeviceAuthList:           []DeviceAuthRecord{},
+		ServiceVerificationList:  []ServiceVerificationRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in profileVerification
+	profileVerificationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProfileVerificationList {
+		index := string(ProfileVerificationKey(elem.VerificationId))
+		if _, ok := profileVerificationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for profileVerification")
+		}
+		profileVerificationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in documentVerification
+	documentVerificationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DocumentVerificationList {
+		index := string(DocumentVerificationKey(elem.VerificationId))
+		if _, ok := documentVerificationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for documentVerification")
+		}
+		documentVerificationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in message
+	messageIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MessageList {
+		if elem.Message == nil {
+			return fmt.Errorf("message record without message")
+		}
+		index := string(MessageKey(elem.Message.MessageId))
+		if _, ok := messageIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for message")
+		}
+		messageIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in videoSignal
+	videoSignalIndexMap := make(map[string]struct{})
+	for _, elem := range gs.VideoSignalList {
+		if elem.Signal == nil {
+			return fmt.Errorf("video signal record without signal")
+		}
+		index := string(VideoSignalKey(elem.Signal.SessionId, elem.SignalId))
+		if _, ok := videoSignalIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for videoSignal")
+		}
+		videoSignalIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in deviceAuth
+	deviceAuthIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DeviceAuthList {
+		if elem.Auth == nil {
+			return fmt.Errorf("device auth record without auth data")
+		}
+		index := string(DeviceAuthKey(elem.Auth.DeviceId))
+		if _, ok := deviceAuthIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for deviceAuth")
+		}
+		deviceAuthIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in serviceVerification
+	serviceVerificationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ServiceVerificationList {
+		index := string(ServiceVerificationKey(elem.VerificationId))
+		if _, ok := serviceVerificationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for serviceVerification")
+		}
+		serviceVerificationIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
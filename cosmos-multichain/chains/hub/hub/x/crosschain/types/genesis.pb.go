@@ -0,0 +1,743 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/crosschain/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the crosschain module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                   Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ProfileVerificationList  []ProfileVerificationRecord  `protobuf:"bytes,2,rep,name=profileVerificationList,proto3" json:"profileVerificationList"`
+	DocumentVerificationList []DocumentVerificationRecord `protobuf:"bytes,3,rep,name=documentVerificationList,proto3" json:"documentVerificationList"`
+	MessageList              []MessageRecord              `protobuf:"bytes,4,rep,name=messageList,proto3" json:"messageList"`
+	VideoSignalList          []VideoSignalRecord          `protobuf:"bytes,5,rep,name=videoSignalList,proto3" json:"videoSignalList"`
+	DeviceAuthList           []DeviceAuthRecord           `protobuf:"bytes,6,rep,name=deviceAuthList,proto3" json:"deviceAuthList"`
+	ServiceVerificationList  []ServiceVerificationRecord  `protobuf:"bytes,7,rep,name=serviceVerificationList,proto3" json:"serviceVerificationList"`
+	VerificationCount        uint64                       `protobuf:"varint,8,opt,name=verificationCount,proto3" json:"verificationCount,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4486c453d2160be, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetProfileVerificationList() []ProfileVerificationRecord {
+	if m != nil {
+		return m.ProfileVerificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDocumentVerificationList() []DocumentVerificationRecord {
+	if m != nil {
+		return m.DocumentVerificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMessageList() []MessageRecord {
+	if m != nil {
+		return m.MessageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVideoSignalList() []VideoSignalRecord {
+	if m != nil {
+		return m.VideoSignalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeviceAuthList() []DeviceAuthRecord {
+	if m != nil {
+		return m.DeviceAuthList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetServiceVerificationList() []ServiceVerificationRecord {
+	if m != nil {
+		return m.ServiceVerificationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerificationCount() uint64 {
+	if m != nil {
+		return m.VerificationCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "hub.crosschain.GenesisState")
+}
+
+func init() { proto.RegisterFile("hub/crosschain/genesis.proto", fileDescriptor_a4486c453d2160be) }
+
+var fileDescriptor_a4486c453d2160be = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x4e, 0xdb, 0x40,
+	0x10, 0xc6, 0xed, 0xe6, 0x4f, 0xdb, 0x4d, 0x95, 0x2a, 0xab, 0x2a, 0xb5, 0xd2, 0xd6, 0x75, 0x7b,
+	0x4a, 0xa3, 0xca, 0x46, 0xe1, 0xc4, 0x91, 0x00, 0xe2, 0x02, 0x08, 0x1c, 0x29, 0x07, 0x6e, 0x1b,
+	0x7b, 0x63, 0xaf, 0x14, 0x7b, 0xad, 0xdd, 0x75, 0x04, 0x6f, 0xc1, 0x4b, 0x20, 0x71, 0xe4, 0x31,
+	0x72, 0xcc, 0x91, 0x13, 0x42, 0xc9, 0x81, 0xd7, 0x40, 0x5e, 0x5b, 0x24, 0xb6, 0x93, 0x4b, 0xb4,
+	0x9a, 0xf9, 0xbe, 0xdf, 0x37, 0xf1, 0x0c, 0xf8, 0xe9, 0xc7, 0x63, 0xcb, 0x61, 0x94, 0x73, 0xc7,
+	0x47, 0x24, 0xb4, 0x3c, 0x1c, 0x62, 0x4e, 0xb8, 0x19, 0x31, 0x2a, 0x28, 0x6c, 0xfa, 0xf1, 0xd8,
+	0x5c, 0x77, 0x3b, 0x2d, 0x14, 0x90, 0x90, 0x5a, 0xf2, 0x37, 0x95, 0x74, 0xbe, 0x79, 0xd4, 0xa3,
+	0xf2, 0x69, 0x25, 0xaf, 0xac, 0xfa, 0xa3, 0x80, 0x8d, 0x10, 0x43, 0x41, 0x46, 0xed, 0x14, 0x33,
+	0x19, 0x76, 0x28, 0x73, 0xb3, 0xee, 0xdf, 0xfb, 0x1a, 0xf8, 0x72, 0x9a, 0x4e, 0x31, 0x14, 0x48,
+	0x60, 0x78, 0x00, 0xea, 0xa9, 0x5d, 0x53, 0x0d, 0xb5, 0xdb, 0xe8, 0xb7, 0xcd, 0xfc, 0x54, 0xe6,
+	0xa5, 0xec, 0x0e, 0x3e, 0xcf, 0x9f, 0x7f, 0x2b, 0x0f, 0xaf, 0x8f, 0x3d, 0xd5, 0xce, 0x0c, 0x90,
+	0x80, 0xef, 0x11, 0xa3, 0x13, 0x32, 0xc5, 0x23, 0xcc, 0xc8, 0x84, 0x38, 0x48, 0x10, 0x1a, 0x9e,
+	0x11, 0x2e, 0xb4, 0x0f, 0x46, 0xa5, 0xdb, 0xe8, 0xff, 0x2b, 0xb1, 0xca, 0x72, 0x5b, 0x8e, 0x37,
+	0xa8, 0x26, 0x78, 0x7b, 0x17, 0x0f, 0x4e, 0x81, 0xe6, 0x52, 0x27, 0x0e, 0x70, 0x28, 0x4a, 0x59,
+	0x15, 0x99, 0xd5, 0x2b, 0x66, 0x1d, 0x6f, 0xd1, 0xe7, 0xc2, 0x76, 0x12, 0xe1, 0x09, 0x68, 0x04,
+	0x98, 0x73, 0xe4, 0x61, 0x19, 0x50, 0x95, 0x01, 0xbf, 0x8a, 0x01, 0xe7, 0xa9, 0x24, 0xc7, 0xdc,
+	0xf4, 0xc1, 0x2b, 0xf0, 0x75, 0x46, 0x5c, 0x4c, 0x87, 0xc4, 0x0b, 0xd1, 0x54, 0xa2, 0x6a, 0x12,
+	0xf5, 0xa7, 0x88, 0x1a, 0xad, 0x65, 0x39, 0x5c, 0xd1, 0x0f, 0x2f, 0x40, 0xd3, 0xc5, 0x33, 0xe2,
+	0xe0, 0xc3, 0x58, 0xf8, 0x92, 0x58, 0x97, 0x44, 0xa3, 0xf4, 0xef, 0xdf, 0x55, 0x39, 0x60, 0xc1,
+	0x9d, 0xac, 0x90, 0x63, 0x96, 0x94, 0x4a, 0x9f, 0xf5, 0xe3, 0xf6, 0x15, 0x0e, 0xcb, 0xf2, 0xfc,
+	0x0a, 0x77, 0xf0, 0xe0, 0x7f, 0xd0, 0x9a, 0x6d, 0xd4, 0x8e, 0x68, 0x1c, 0x0a, 0xed, 0x93, 0xa1,
+	0x76, 0xab, 0x76, 0xb9, 0x31, 0xd8, 0x9b, 0x2f, 0x75, 0x75, 0xb1, 0xd4, 0xd5, 0x97, 0xa5, 0xae,
+	0xde, 0xad, 0x74, 0x65, 0xb1, 0xd2, 0x95, 0xa7, 0x95, 0xae, 0x5c, 0xb7, 0x93, 0xfb, 0xbe, 0xd9,
+	0xbc, 0x70, 0x71, 0x1b, 0x61, 0x3e, 0xae, 0xcb, 0x03, 0xdf, 0x7f, 0x1b, 0x00, 0xc6, 0xdb, 0xbb,
+	0x39, 0x74, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VerificationCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ServiceVerificationList) > 0 {
+		for iNdEx := len(m.ServiceVerificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceVerificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeviceAuthList) > 0 {
+		for iNdEx := len(m.DeviceAuthList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeviceAuthList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VideoSignalList) > 0 {
+		for iNdEx := len(m.VideoSignalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VideoSignalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MessageList) > 0 {
+		for iNdEx := len(m.MessageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DocumentVerificationList) > 0 {
+		for iNdEx := len(m.DocumentVerificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentVerificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProfileVerificationList) > 0 {
+		for iNdEx := len(m.ProfileVerificationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfileVerificationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProfileVerificationList) > 0 {
+		for _, e := range m.ProfileVerificationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DocumentVerificationList) > 0 {
+		for _, e := range m.DocumentVerificationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MessageList) > 0 {
+		for _, e := range m.MessageList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VideoSignalList) > 0 {
+		for _, e := range m.VideoSignalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeviceAuthList) > 0 {
+		for _, e := range m.DeviceAuthList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ServiceVerificationList) > 0 {
+		for _, e := range m.ServiceVerificationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VerificationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.VerificationCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileVerificationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileVerificationList = append(m.ProfileVerificationList, ProfileVerificationRecord{})
+			if err := m.ProfileVerificationList[len(m.ProfileVerificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentVerificationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentVerificationList = append(m.DocumentVerificationList, DocumentVerificationRecord{})
+			if err := m.DocumentVerificationList[len(m.DocumentVerificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageList = append(m.MessageList, MessageRecord{})
+			if err := m.MessageList[len(m.MessageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoSignalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoSignalList = append(m.VideoSignalList, VideoSignalRecord{})
+			if err := m.VideoSignalList[len(m.VideoSignalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceAuthList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceAuthList = append(m.DeviceAuthList, DeviceAuthRecord{})
+			if err := m.DeviceAuthList[len(m.DeviceAuthList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceVerificationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceVerificationList = append(m.ServiceVerificationList, ServiceVerificationRecord{})
+			if err := m.ServiceVerificationList[len(m.ServiceVerificationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationCount", wireType)
+			}
+			m.VerificationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
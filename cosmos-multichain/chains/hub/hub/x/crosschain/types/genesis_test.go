@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hub/x/crosschain/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProfileVerificationList: []types.ProfileVerificationRecord{
+					{VerificationId: "profile-0"},
+					{VerificationId: "profile-1"},
+				},
+				MessageList: []types.MessageRecord{
+					{Message: &types.RealTimeMessage{MessageId: "0"}},
+				},
+				VideoSignalList: []types.VideoSignalRecord{
+					{SignalId: "signal-0", Signal: &types.VideoSignal{SessionId: "s"}},
+					{SignalId: "signal-1", Signal: &types.VideoSignal{SessionId: "s"}},
+				},
+				VerificationCount: 4,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated profileVerification",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ProfileVerificationList: []types.ProfileVerificationRecord{
+					{VerificationId: "profile-0"},
+					{VerificationId: "profile-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated videoSignal",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				VideoSignalList: []types.VideoSignalRecord{
+					{SignalId: "signal-0", Signal: &types.VideoSignal{SessionId: "s"}},
+					{SignalId: "signal-0", Signal: &types.VideoSignal{SessionId: "s"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "device auth without auth data",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				DeviceAuthList: []types.DeviceAuthRecord{{Creator: "c"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, types.DefaultSignalTypes, types.DefaultAuthMethods, 1),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &VerifyUserProfileRequest{}
+	_ sdk.Msg = &VerifyDocumentRequest{}
+	_ sdk.Msg = &SendMessageRequest{}
+	_ sdk.Msg = &VideoSignalRequest{}
+	_ sdk.Msg = &AuthenticateDeviceRequest{}
+	_ sdk.Msg = &VerifyServiceRequest{}
+)
+
+func validateCreator(creator string) error {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func validateRequired(list []string) error {
+	for _, item := range list {
+		if item == "" {
+			return errorsmod.Wrap(ErrInvalidRequiredField, "entries cannot be empty")
+		}
+	}
+	return nil
+}
+
+func (msg *VerifyUserProfileRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Profile == nil || msg.Profile.UserId == "" {
+		return errorsmod.Wrap(ErrInvalidProfile, "profile user id cannot be empty")
+	}
+	for _, c := range msg.Profile.Credentials {
+		if c == nil || c.CredentialType == "" {
+			return errorsmod.Wrap(ErrInvalidProfile, "credential type cannot be empty")
+		}
+	}
+	return validateRequired(msg.RequiredCredentials)
+}
+
+func (msg *VerifyDocumentRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Document == nil || msg.Document.DocumentId == "" {
+		return errorsmod.Wrap(ErrInvalidDocument, "document id cannot be empty")
+	}
+	return validateRequired(msg.RequiredVerifications)
+}
+
+func (msg *SendMessageRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Message == nil {
+		return errorsmod.Wrap(ErrInvalidMessage, "message cannot be empty")
+	}
+	if len(msg.Message.Recipients) == 0 {
+		return errorsmod.Wrap(ErrInvalidMessage, "message has no recipients")
+	}
+	return nil
+}
+
+func (msg *VideoSignalRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Signal == nil || msg.Signal.SessionId == "" {
+		return errorsmod.Wrap(ErrInvalidSignal, "session id cannot be empty")
+	}
+	if msg.Signal.SignalType == "" {
+		return errorsmod.Wrap(ErrInvalidSignal, "signal type cannot be empty")
+	}
+	return nil
+}
+
+func (msg *AuthenticateDeviceRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.AuthData == nil || msg.AuthData.DeviceId == "" || msg.AuthData.UserId == "" {
+		return errorsmod.Wrap(ErrInvalidDeviceAuth, "device id and user id cannot be empty")
+	}
+	return validateRequired(msg.RequiredFactors)
+}
+
+func (msg *VerifyServiceRequest) ValidateBasic() error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if msg.Service == nil || msg.Service.ServiceId == "" {
+		return errorsmod.Wrap(ErrInvalidService, "service id cannot be empty")
+	}
+	return validateRequired(msg.RequiredCapabilities)
+}
@@ -0,0 +1,3979 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bloqz/ibc/v1/handlers.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type VerifyUserProfileRequest struct {
+	Profile             *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	RequiredCredentials []string     `protobuf:"bytes,2,rep,name=required_credentials,json=requiredCredentials,proto3" json:"required_credentials,omitempty"`
+	Creator             string       `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *VerifyUserProfileRequest) Reset()         { *m = VerifyUserProfileRequest{} }
+func (m *VerifyUserProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyUserProfileRequest) ProtoMessage()    {}
+func (*VerifyUserProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{0}
+}
+func (m *VerifyUserProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyUserProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyUserProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyUserProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyUserProfileRequest.Merge(m, src)
+}
+func (m *VerifyUserProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyUserProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyUserProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyUserProfileRequest proto.InternalMessageInfo
+
+func (m *VerifyUserProfileRequest) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *VerifyUserProfileRequest) GetRequiredCredentials() []string {
+	if m != nil {
+		return m.RequiredCredentials
+	}
+	return nil
+}
+
+func (m *VerifyUserProfileRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type VerifyUserProfileResponse struct {
+	Status              VerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	VerificationId      string             `protobuf:"bytes,2,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	VerifiedCredentials []string           `protobuf:"bytes,3,rep,name=verified_credentials,json=verifiedCredentials,proto3" json:"verified_credentials,omitempty"`
+	ErrorMessage        string             `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *VerifyUserProfileResponse) Reset()         { *m = VerifyUserProfileResponse{} }
+func (m *VerifyUserProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyUserProfileResponse) ProtoMessage()    {}
+func (*VerifyUserProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{1}
+}
+func (m *VerifyUserProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyUserProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyUserProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyUserProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyUserProfileResponse.Merge(m, src)
+}
+func (m *VerifyUserProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyUserProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyUserProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyUserProfileResponse proto.InternalMessageInfo
+
+func (m *VerifyUserProfileResponse) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *VerifyUserProfileResponse) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *VerifyUserProfileResponse) GetVerifiedCredentials() []string {
+	if m != nil {
+		return m.VerifiedCredentials
+	}
+	return nil
+}
+
+func (m *VerifyUserProfileResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type VerifyDocumentRequest struct {
+	Document              *Document `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	RequiredVerifications []string  `protobuf:"bytes,2,rep,name=required_verifications,json=requiredVerifications,proto3" json:"required_verifications,omitempty"`
+	Creator               string    `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *VerifyDocumentRequest) Reset()         { *m = VerifyDocumentRequest{} }
+func (m *VerifyDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyDocumentRequest) ProtoMessage()    {}
+func (*VerifyDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{2}
+}
+func (m *VerifyDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDocumentRequest.Merge(m, src)
+}
+func (m *VerifyDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDocumentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDocumentRequest proto.InternalMessageInfo
+
+func (m *VerifyDocumentRequest) GetDocument() *Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *VerifyDocumentRequest) GetRequiredVerifications() []string {
+	if m != nil {
+		return m.RequiredVerifications
+	}
+	return nil
+}
+
+func (m *VerifyDocumentRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type VerifyDocumentResponse struct {
+	Status                 VerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	VerificationId         string             `protobuf:"bytes,2,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	CompletedVerifications []string           `protobuf:"bytes,3,rep,name=completed_verifications,json=completedVerifications,proto3" json:"completed_verifications,omitempty"`
+	ErrorMessage           string             `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *VerifyDocumentResponse) Reset()         { *m = VerifyDocumentResponse{} }
+func (m *VerifyDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyDocumentResponse) ProtoMessage()    {}
+func (*VerifyDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{3}
+}
+func (m *VerifyDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDocumentResponse.Merge(m, src)
+}
+func (m *VerifyDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDocumentResponse proto.InternalMessageInfo
+
+func (m *VerifyDocumentResponse) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *VerifyDocumentResponse) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *VerifyDocumentResponse) GetCompletedVerifications() []string {
+	if m != nil {
+		return m.CompletedVerifications
+	}
+	return nil
+}
+
+func (m *VerifyDocumentResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type SendMessageRequest struct {
+	Message                     *RealTimeMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	RequireDeliveryConfirmation bool             `protobuf:"varint,2,opt,name=require_delivery_confirmation,json=requireDeliveryConfirmation,proto3" json:"require_delivery_confirmation,omitempty"`
+	Creator                     string           `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *SendMessageRequest) Reset()         { *m = SendMessageRequest{} }
+func (m *SendMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SendMessageRequest) ProtoMessage()    {}
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{4}
+}
+func (m *SendMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendMessageRequest.Merge(m, src)
+}
+func (m *SendMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendMessageRequest proto.InternalMessageInfo
+
+func (m *SendMessageRequest) GetMessage() *RealTimeMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SendMessageRequest) GetRequireDeliveryConfirmation() bool {
+	if m != nil {
+		return m.RequireDeliveryConfirmation
+	}
+	return false
+}
+
+func (m *SendMessageRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	MessageId        string   `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	DeliveredTo      []string `protobuf:"bytes,2,rep,name=delivered_to,json=deliveredTo,proto3" json:"delivered_to,omitempty"`
+	FailedDeliveries []string `protobuf:"bytes,3,rep,name=failed_deliveries,json=failedDeliveries,proto3" json:"failed_deliveries,omitempty"`
+	ErrorMessage     string   `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *SendMessageResponse) Reset()         { *m = SendMessageResponse{} }
+func (m *SendMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SendMessageResponse) ProtoMessage()    {}
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{5}
+}
+func (m *SendMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendMessageResponse.Merge(m, src)
+}
+func (m *SendMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendMessageResponse proto.InternalMessageInfo
+
+func (m *SendMessageResponse) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SendMessageResponse) GetDeliveredTo() []string {
+	if m != nil {
+		return m.DeliveredTo
+	}
+	return nil
+}
+
+func (m *SendMessageResponse) GetFailedDeliveries() []string {
+	if m != nil {
+		return m.FailedDeliveries
+	}
+	return nil
+}
+
+func (m *SendMessageResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type VideoSignalRequest struct {
+	Signal                *VideoSignal `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	RequireAcknowledgment bool         `protobuf:"varint,2,opt,name=require_acknowledgment,json=requireAcknowledgment,proto3" json:"require_acknowledgment,omitempty"`
+	Creator               string       `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *VideoSignalRequest) Reset()         { *m = VideoSignalRequest{} }
+func (m *VideoSignalRequest) String() string { return proto.CompactTextString(m) }
+func (*VideoSignalRequest) ProtoMessage()    {}
+func (*VideoSignalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{6}
+}
+func (m *VideoSignalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoSignalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoSignalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VideoSignalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoSignalRequest.Merge(m, src)
+}
+func (m *VideoSignalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoSignalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoSignalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoSignalRequest proto.InternalMessageInfo
+
+func (m *VideoSignalRequest) GetSignal() *VideoSignal {
+	if m != nil {
+		return m.Signal
+	}
+	return nil
+}
+
+func (m *VideoSignalRequest) GetRequireAcknowledgment() bool {
+	if m != nil {
+		return m.RequireAcknowledgment
+	}
+	return false
+}
+
+func (m *VideoSignalRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type VideoSignalResponse struct {
+	SignalId     string `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	Acknowledged bool   `protobuf:"varint,2,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *VideoSignalResponse) Reset()         { *m = VideoSignalResponse{} }
+func (m *VideoSignalResponse) String() string { return proto.CompactTextString(m) }
+func (*VideoSignalResponse) ProtoMessage()    {}
+func (*VideoSignalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{7}
+}
+func (m *VideoSignalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoSignalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoSignalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VideoSignalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoSignalResponse.Merge(m, src)
+}
+func (m *VideoSignalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoSignalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoSignalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoSignalResponse proto.InternalMessageInfo
+
+func (m *VideoSignalResponse) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *VideoSignalResponse) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *VideoSignalResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type AuthenticateDeviceRequest struct {
+	AuthData        *DeviceAuth `protobuf:"bytes,1,opt,name=auth_data,json=authData,proto3" json:"auth_data,omitempty"`
+	RequiredFactors []string    `protobuf:"bytes,2,rep,name=required_factors,json=requiredFactors,proto3" json:"required_factors,omitempty"`
+	Creator         string      `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *AuthenticateDeviceRequest) Reset()         { *m = AuthenticateDeviceRequest{} }
+func (m *AuthenticateDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateDeviceRequest) ProtoMessage()    {}
+func (*AuthenticateDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{8}
+}
+func (m *AuthenticateDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticateDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticateDeviceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticateDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateDeviceRequest.Merge(m, src)
+}
+func (m *AuthenticateDeviceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticateDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateDeviceRequest proto.InternalMessageInfo
+
+func (m *AuthenticateDeviceRequest) GetAuthData() *DeviceAuth {
+	if m != nil {
+		return m.AuthData
+	}
+	return nil
+}
+
+func (m *AuthenticateDeviceRequest) GetRequiredFactors() []string {
+	if m != nil {
+		return m.RequiredFactors
+	}
+	return nil
+}
+
+func (m *AuthenticateDeviceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type AuthenticateDeviceResponse struct {
+	Status       VerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	AuthToken    string             `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	TokenExpiry  int64              `protobuf:"varint,3,opt,name=token_expiry,json=tokenExpiry,proto3" json:"token_expiry,omitempty"`
+	ErrorMessage string             `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *AuthenticateDeviceResponse) Reset()         { *m = AuthenticateDeviceResponse{} }
+func (m *AuthenticateDeviceResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateDeviceResponse) ProtoMessage()    {}
+func (*AuthenticateDeviceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{9}
+}
+func (m *AuthenticateDeviceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticateDeviceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticateDeviceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticateDeviceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateDeviceResponse.Merge(m, src)
+}
+func (m *AuthenticateDeviceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticateDeviceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateDeviceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateDeviceResponse proto.InternalMessageInfo
+
+func (m *AuthenticateDeviceResponse) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *AuthenticateDeviceResponse) GetAuthToken() string {
+	if m != nil {
+		return m.AuthToken
+	}
+	return ""
+}
+
+func (m *AuthenticateDeviceResponse) GetTokenExpiry() int64 {
+	if m != nil {
+		return m.TokenExpiry
+	}
+	return 0
+}
+
+func (m *AuthenticateDeviceResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type VerifyServiceRequest struct {
+	Service              *ServiceVerification `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	RequiredCapabilities []string             `protobuf:"bytes,2,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	Creator              string               `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *VerifyServiceRequest) Reset()         { *m = VerifyServiceRequest{} }
+func (m *VerifyServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyServiceRequest) ProtoMessage()    {}
+func (*VerifyServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{10}
+}
+func (m *VerifyServiceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyServiceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyServiceRequest.Merge(m, src)
+}
+func (m *VerifyServiceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyServiceRequest proto.InternalMessageInfo
+
+func (m *VerifyServiceRequest) GetService() *ServiceVerification {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *VerifyServiceRequest) GetRequiredCapabilities() []string {
+	if m != nil {
+		return m.RequiredCapabilities
+	}
+	return nil
+}
+
+func (m *VerifyServiceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type VerifyServiceResponse struct {
+	Status               VerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	VerificationId       string             `protobuf:"bytes,2,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	VerifiedCapabilities []string           `protobuf:"bytes,3,rep,name=verified_capabilities,json=verifiedCapabilities,proto3" json:"verified_capabilities,omitempty"`
+	ErrorMessage         string             `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *VerifyServiceResponse) Reset()         { *m = VerifyServiceResponse{} }
+func (m *VerifyServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyServiceResponse) ProtoMessage()    {}
+func (*VerifyServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b47b16e4f5f4b79, []int{11}
+}
+func (m *VerifyServiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyServiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyServiceResponse.Merge(m, src)
+}
+func (m *VerifyServiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyServiceResponse proto.InternalMessageInfo
+
+func (m *VerifyServiceResponse) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *VerifyServiceResponse) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *VerifyServiceResponse) GetVerifiedCapabilities() []string {
+	if m != nil {
+		return m.VerifiedCapabilities
+	}
+	return nil
+}
+
+func (m *VerifyServiceResponse) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*VerifyUserProfileRequest)(nil), "bloqz.ibc.v1.VerifyUserProfileRequest")
+	proto.RegisterType((*VerifyUserProfileResponse)(nil), "bloqz.ibc.v1.VerifyUserProfileResponse")
+	proto.RegisterType((*VerifyDocumentRequest)(nil), "bloqz.ibc.v1.VerifyDocumentRequest")
+	proto.RegisterType((*VerifyDocumentResponse)(nil), "bloqz.ibc.v1.VerifyDocumentResponse")
+	proto.RegisterType((*SendMessageRequest)(nil), "bloqz.ibc.v1.SendMessageRequest")
+	proto.RegisterType((*SendMessageResponse)(nil), "bloqz.ibc.v1.SendMessageResponse")
+	proto.RegisterType((*VideoSignalRequest)(nil), "bloqz.ibc.v1.VideoSignalRequest")
+	proto.RegisterType((*VideoSignalResponse)(nil), "bloqz.ibc.v1.VideoSignalResponse")
+	proto.RegisterType((*AuthenticateDeviceRequest)(nil), "bloqz.ibc.v1.AuthenticateDeviceRequest")
+	proto.RegisterType((*AuthenticateDeviceResponse)(nil), "bloqz.ibc.v1.AuthenticateDeviceResponse")
+	proto.RegisterType((*VerifyServiceRequest)(nil), "bloqz.ibc.v1.VerifyServiceRequest")
+	proto.RegisterType((*VerifyServiceResponse)(nil), "bloqz.ibc.v1.VerifyServiceResponse")
+}
+
+func init() { proto.RegisterFile("bloqz/ibc/v1/handlers.proto", fileDescriptor_6b47b16e4f5f4b79) }
+
+var fileDescriptor_6b47b16e4f5f4b79 = []byte{
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x36, 0x25, 0x1f, 0x6f, 0xd2, 0xb4, 0x99, 0x7c, 0xd4, 0xd9, 0x28, 0x56, 0x3e, 0x10,
+	0x0d, 0x45, 0xc4, 0xd8, 0x51, 0x55, 0x04, 0xa7, 0xc4, 0x06, 0x29, 0x07, 0x24, 0xb4, 0x0e, 0x15,
+	0x82, 0xc3, 0x6a, 0xbc, 0x3b, 0xb6, 0x47, 0x5d, 0xef, 0x38, 0x33, 0x63, 0xd3, 0xc0, 0x05, 0xf1,
+	0x0b, 0xf8, 0x1b, 0xdc, 0x7a, 0xe0, 0x8a, 0xe0, 0xc0, 0xa1, 0xe2, 0x54, 0x01, 0x42, 0x70, 0x43,
+	0xc9, 0xa1, 0x7f, 0x03, 0xed, 0x7c, 0xac, 0x77, 0x6d, 0x93, 0x46, 0xaa, 0xd5, 0x5b, 0xf6, 0x79,
+	0x9f, 0x99, 0xbc, 0xcf, 0xf3, 0xcc, 0xbe, 0xb3, 0x86, 0xcd, 0x46, 0xc4, 0xce, 0xbe, 0x2e, 0xd1,
+	0x46, 0x50, 0xea, 0x97, 0x4b, 0x6d, 0x1c, 0x87, 0x11, 0xe1, 0xe2, 0xa0, 0xcb, 0x99, 0x64, 0x68,
+	0x51, 0x15, 0x0f, 0x68, 0x23, 0x38, 0xe8, 0x97, 0xdd, 0x42, 0x8e, 0x2a, 0xcf, 0xbb, 0xc4, 0xf0,
+	0xdc, 0xbb, 0x01, 0x13, 0x1d, 0x26, 0x4a, 0x1d, 0xd1, 0x4a, 0x4a, 0x1d, 0xd1, 0x32, 0x85, 0x0d,
+	0x5d, 0xf0, 0xd5, 0x53, 0x49, 0x3f, 0x98, 0xd2, 0x6a, 0x8b, 0xb5, 0x98, 0xc6, 0x93, 0xbf, 0x0c,
+	0x5a, 0x34, 0x3b, 0x35, 0xb0, 0x20, 0xa5, 0x7e, 0xb9, 0x41, 0x24, 0x2e, 0x97, 0x02, 0x46, 0x63,
+	0x5d, 0xdf, 0xfd, 0xd5, 0x81, 0xc2, 0x23, 0xc2, 0x69, 0xf3, 0xfc, 0x33, 0x41, 0xf8, 0xa7, 0x9c,
+	0x35, 0x69, 0x44, 0x3c, 0x72, 0xd6, 0x23, 0x42, 0xa2, 0x43, 0x98, 0xed, 0x6a, 0xa4, 0xe0, 0x6c,
+	0x3b, 0xfb, 0x0b, 0x95, 0x8d, 0x83, 0xac, 0x80, 0x83, 0xec, 0x12, 0xcb, 0x44, 0x65, 0x58, 0xe5,
+	0xe4, 0xac, 0x47, 0x39, 0x09, 0xfd, 0x80, 0x93, 0x90, 0xc4, 0x92, 0xe2, 0x48, 0x14, 0x6e, 0x6c,
+	0x4f, 0xef, 0xcf, 0x7b, 0x2b, 0xb6, 0x56, 0x1d, 0x94, 0x50, 0x05, 0x66, 0x03, 0x4e, 0xb0, 0x64,
+	0xbc, 0x30, 0xbd, 0xed, 0xec, 0xcf, 0x1f, 0x17, 0x7e, 0xff, 0xf1, 0xdd, 0x55, 0xa3, 0xee, 0x28,
+	0x0c, 0x39, 0x11, 0xa2, 0x2e, 0x39, 0x8d, 0x5b, 0x9e, 0x25, 0x7e, 0xb0, 0xf8, 0xdd, 0x8b, 0xa7,
+	0xf7, 0xed, 0xd3, 0xee, 0x5f, 0x0e, 0x6c, 0x8c, 0x91, 0x21, 0xba, 0x2c, 0x16, 0x04, 0xbd, 0x0f,
+	0x33, 0x42, 0x62, 0xd9, 0x13, 0x4a, 0xc6, 0x52, 0x65, 0x3b, 0x2f, 0x43, 0x2d, 0xa4, 0x01, 0x96,
+	0x94, 0xc5, 0x75, 0xc5, 0xf3, 0x0c, 0x1f, 0xdd, 0x83, 0xdb, 0xfd, 0x4c, 0xd5, 0xa7, 0x61, 0xe1,
+	0x46, 0xd2, 0xa1, 0xb7, 0x94, 0x85, 0x4f, 0xc2, 0x44, 0xb5, 0x46, 0x86, 0x54, 0x4f, 0x6b, 0xd5,
+	0xb6, 0x96, 0x55, 0xbd, 0x07, 0xb7, 0x08, 0xe7, 0x8c, 0xfb, 0x1d, 0x22, 0x04, 0x6e, 0x91, 0xc2,
+	0x4d, 0xb5, 0xf3, 0xa2, 0x02, 0x3f, 0xd1, 0x58, 0x92, 0xcf, 0x9a, 0x16, 0x56, 0x63, 0x41, 0xaf,
+	0x43, 0x62, 0x69, 0xc3, 0xa9, 0xc0, 0x5c, 0x68, 0x20, 0x93, 0xce, 0x7a, 0x5e, 0x56, 0xba, 0x20,
+	0xe5, 0xa1, 0x07, 0xb0, 0x9e, 0x66, 0x93, 0x15, 0x60, 0xd3, 0x59, 0xb3, 0xd5, 0xac, 0x25, 0x93,
+	0xc8, 0xe7, 0x1f, 0x07, 0xd6, 0x87, 0x65, 0xbc, 0xbe, 0x70, 0x1e, 0xc2, 0xdd, 0x80, 0x75, 0xba,
+	0x11, 0x91, 0x23, 0xba, 0x75, 0x3e, 0xeb, 0x69, 0x39, 0x2f, 0xfc, 0x5a, 0x11, 0xfd, 0xe1, 0x00,
+	0xaa, 0x93, 0x38, 0x34, 0xcf, 0x36, 0x9f, 0x87, 0x30, 0x6b, 0x57, 0xe9, 0x78, 0xb6, 0xf2, 0xc2,
+	0x3c, 0x82, 0xa3, 0x53, 0xda, 0x21, 0x76, 0x99, 0x65, 0xa3, 0x63, 0xd8, 0x32, 0x31, 0xf8, 0x21,
+	0x89, 0x68, 0x9f, 0xf0, 0x73, 0x3f, 0x60, 0x71, 0x93, 0xf2, 0x8e, 0x6a, 0x4b, 0x89, 0x9c, 0xf3,
+	0x36, 0x0d, 0xa9, 0x66, 0x38, 0xd5, 0x0c, 0x65, 0x02, 0x89, 0xfd, 0xe0, 0xc0, 0x4a, 0x4e, 0x95,
+	0x89, 0x6b, 0x0b, 0xc0, 0x34, 0x9a, 0xf8, 0xed, 0x28, 0x3f, 0xe6, 0x0d, 0x72, 0x12, 0xa2, 0x1d,
+	0x58, 0x34, 0x4d, 0x93, 0xd0, 0x97, 0xcc, 0x9c, 0xab, 0x85, 0x14, 0x3b, 0x65, 0xe8, 0x1d, 0x58,
+	0x6e, 0x62, 0x1a, 0x91, 0xd0, 0xca, 0xa3, 0xc4, 0xe6, 0x70, 0x47, 0x17, 0x6a, 0x29, 0x7e, 0xbd,
+	0x04, 0x7e, 0x71, 0x00, 0x3d, 0xa2, 0x21, 0x61, 0x75, 0xda, 0x8a, 0x71, 0x64, 0x13, 0x28, 0xc3,
+	0x8c, 0x50, 0xc0, 0xf8, 0xe9, 0x95, 0x5d, 0x61, 0x88, 0x99, 0x17, 0xc4, 0xc7, 0xc1, 0xe3, 0x98,
+	0x7d, 0x15, 0x91, 0xb0, 0xa5, 0x5e, 0x31, 0x6d, 0xba, 0x7d, 0x41, 0x8e, 0x72, 0xc5, 0x09, 0xd8,
+	0xfd, 0x0d, 0xac, 0xe4, 0x14, 0x18, 0xb7, 0x37, 0x61, 0x5e, 0x77, 0x36, 0x30, 0x7b, 0x4e, 0x03,
+	0x27, 0x21, 0xda, 0x85, 0xc5, 0x41, 0x93, 0x24, 0x34, 0x2d, 0xe6, 0xb0, 0x51, 0xff, 0xa6, 0xc7,
+	0xf8, 0xf7, 0xb3, 0x03, 0x1b, 0x47, 0x3d, 0xd9, 0x4e, 0x26, 0x53, 0x80, 0x25, 0xa9, 0x91, 0x3e,
+	0x0d, 0xd2, 0x83, 0xfc, 0x00, 0xe6, 0x71, 0x4f, 0xb6, 0xfd, 0x10, 0x4b, 0x6c, 0x9c, 0x2c, 0x0c,
+	0x4d, 0x1a, 0xc5, 0x4f, 0x76, 0xf0, 0xe6, 0x12, 0x6a, 0x0d, 0x4b, 0x8c, 0xde, 0x86, 0x3b, 0xe9,
+	0xac, 0x69, 0xe2, 0x40, 0x32, 0x6e, 0xa7, 0xcc, 0x6d, 0x8b, 0x7f, 0xac, 0xe1, 0x09, 0xd8, 0xf7,
+	0x93, 0x03, 0xee, 0x38, 0x05, 0xaf, 0x3c, 0x63, 0xb6, 0x00, 0x94, 0x78, 0xc9, 0x1e, 0x93, 0xd8,
+	0x8c, 0x17, 0x65, 0xc7, 0x69, 0x02, 0x24, 0xc7, 0x5d, 0x55, 0x7c, 0xf2, 0xa4, 0x4b, 0xf9, 0xb9,
+	0x6a, 0x7f, 0xda, 0x5b, 0x50, 0xd8, 0x47, 0x0a, 0xba, 0xde, 0x09, 0xfe, 0xcd, 0x81, 0x55, 0x3d,
+	0x1f, 0xeb, 0x84, 0x67, 0xcd, 0xff, 0x10, 0x66, 0x85, 0x46, 0x8c, 0xf5, 0x3b, 0xf9, 0xd6, 0x0d,
+	0x3d, 0xab, 0xc0, 0xb3, 0x2b, 0xd0, 0x21, 0xac, 0x0d, 0xae, 0x62, 0xdc, 0xc5, 0x0d, 0x1a, 0x51,
+	0x49, 0x89, 0xcd, 0x21, 0xbd, 0xa7, 0xab, 0x99, 0xda, 0x04, 0xc2, 0xf8, 0x33, 0xbd, 0xb3, 0x52,
+	0x31, 0xaf, 0x6f, 0xd6, 0x1f, 0xc2, 0xda, 0xe0, 0x22, 0xce, 0x6a, 0xd6, 0x13, 0x26, 0xbd, 0xa5,
+	0x73, 0x9a, 0xaf, 0x93, 0x51, 0xe5, 0xd9, 0x4d, 0x58, 0xae, 0x72, 0x26, 0x44, 0xb5, 0x8d, 0x69,
+	0x6c, 0xa4, 0xa1, 0x10, 0x96, 0x47, 0x3e, 0x3c, 0xd0, 0x5b, 0x63, 0x74, 0x8d, 0xf9, 0xc0, 0x72,
+	0xef, 0xbd, 0x94, 0x67, 0x8c, 0xfb, 0x12, 0x96, 0xf2, 0xd7, 0x27, 0xda, 0x1b, 0xb7, 0x74, 0xe8,
+	0x1b, 0xc1, 0x7d, 0xf3, 0x6a, 0x92, 0xd9, 0xdc, 0x83, 0x85, 0xcc, 0xa4, 0x47, 0xdb, 0xc3, 0x27,
+	0x6c, 0xf8, 0x6a, 0x73, 0x77, 0xae, 0x60, 0x64, 0xf6, 0x54, 0x73, 0x4a, 0x4d, 0xb5, 0xe1, 0x3d,
+	0x47, 0x87, 0xb5, 0xbb, 0x73, 0x05, 0xc3, 0xec, 0xd9, 0x02, 0x34, 0xfa, 0x8e, 0xa3, 0x21, 0x0f,
+	0xff, 0x77, 0x8e, 0xb9, 0xfb, 0x2f, 0x27, 0x9a, 0x7f, 0xf4, 0x39, 0xdc, 0xca, 0x9d, 0x5f, 0xb4,
+	0x3b, 0xce, 0xc7, 0xfc, 0x9b, 0xea, 0xee, 0x5d, 0xc9, 0xd1, 0x3b, 0xbb, 0x6f, 0x7c, 0xfb, 0xe2,
+	0xe9, 0x7d, 0xe7, 0xf8, 0xbd, 0x67, 0x17, 0x45, 0xe7, 0xf9, 0x45, 0xd1, 0xf9, 0xf7, 0xa2, 0xe8,
+	0x7c, 0x7f, 0x59, 0x9c, 0x7a, 0x7e, 0x59, 0x9c, 0xfa, 0xfb, 0xb2, 0x38, 0xf5, 0xc5, 0x7a, 0xbb,
+	0xd7, 0x28, 0x3d, 0x29, 0x05, 0xc9, 0x39, 0x0b, 0x92, 0x73, 0xa6, 0x7f, 0x17, 0x34, 0x66, 0xd4,
+	0xe7, 0xfa, 0xe1, 0x7f, 0x03, 0x00, 0x03, 0xb3, 0x8a, 0x46, 0x5f, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CrossChainServiceClient is the client API for CrossChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CrossChainServiceClient interface {
+	// Profile verification
+	VerifyUserProfile(ctx context.Context, in *VerifyUserProfileRequest, opts ...grpc.CallOption) (*VerifyUserProfileResponse, error)
+	// Document verification
+	VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error)
+	// Real-time messaging
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Video conferencing
+	SignalVideo(ctx context.Context, in *VideoSignalRequest, opts ...grpc.CallOption) (*VideoSignalResponse, error)
+	// Device authentication
+	AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error)
+	// Service verification
+	VerifyService(ctx context.Context, in *VerifyServiceRequest, opts ...grpc.CallOption) (*VerifyServiceResponse, error)
+}
+
+type crossChainServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewCrossChainServiceClient(cc grpc1.ClientConn) CrossChainServiceClient {
+	return &crossChainServiceClient{cc}
+}
+
+func (c *crossChainServiceClient) VerifyUserProfile(ctx context.Context, in *VerifyUserProfileRequest, opts ...grpc.CallOption) (*VerifyUserProfileResponse, error) {
+	out := new(VerifyUserProfileResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/VerifyUserProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) VerifyDocument(ctx context.Context, in *VerifyDocumentRequest, opts ...grpc.CallOption) (*VerifyDocumentResponse, error) {
+	out := new(VerifyDocumentResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/VerifyDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) SignalVideo(ctx context.Context, in *VideoSignalRequest, opts ...grpc.CallOption) (*VideoSignalResponse, error) {
+	out := new(VideoSignalResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/SignalVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error) {
+	out := new(AuthenticateDeviceResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/AuthenticateDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crossChainServiceClient) VerifyService(ctx context.Context, in *VerifyServiceRequest, opts ...grpc.CallOption) (*VerifyServiceResponse, error) {
+	out := new(VerifyServiceResponse)
+	err := c.cc.Invoke(ctx, "/bloqz.ibc.v1.CrossChainService/VerifyService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrossChainServiceServer is the server API for CrossChainService service.
+type CrossChainServiceServer interface {
+	// Profile verification
+	VerifyUserProfile(context.Context, *VerifyUserProfileRequest) (*VerifyUserProfileResponse, error)
+	// Document verification
+	VerifyDocument(context.Context, *VerifyDocumentRequest) (*VerifyDocumentResponse, error)
+	// Real-time messaging
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// Video conferencing
+	SignalVideo(context.Context, *VideoSignalRequest) (*VideoSignalResponse, error)
+	// Device authentication
+	AuthenticateDevice(context.Context, *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error)
+	// Service verification
+	VerifyService(context.Context, *VerifyServiceRequest) (*VerifyServiceResponse, error)
+}
+
+// UnimplementedCrossChainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCrossChainServiceServer struct {
+}
+
+func (*UnimplementedCrossChainServiceServer) VerifyUserProfile(ctx context.Context, req *VerifyUserProfileRequest) (*VerifyUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyUserProfile not implemented")
+}
+func (*UnimplementedCrossChainServiceServer) VerifyDocument(ctx context.Context, req *VerifyDocumentRequest) (*VerifyDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDocument not implemented")
+}
+func (*UnimplementedCrossChainServiceServer) SendMessage(ctx context.Context, req *SendMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (*UnimplementedCrossChainServiceServer) SignalVideo(ctx context.Context, req *VideoSignalRequest) (*VideoSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalVideo not implemented")
+}
+func (*UnimplementedCrossChainServiceServer) AuthenticateDevice(ctx context.Context, req *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateDevice not implemented")
+}
+func (*UnimplementedCrossChainServiceServer) VerifyService(ctx context.Context, req *VerifyServiceRequest) (*VerifyServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyService not implemented")
+}
+
+func RegisterCrossChainServiceServer(s grpc1.Server, srv CrossChainServiceServer) {
+	s.RegisterService(&_CrossChainService_serviceDesc, srv)
+}
+
+func _CrossChainService_VerifyUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/VerifyUserProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyUserProfile(ctx, req.(*VerifyUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_VerifyDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/VerifyDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyDocument(ctx, req.(*VerifyDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_SignalVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).SignalVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/SignalVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).SignalVideo(ctx, req.(*VideoSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_AuthenticateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).AuthenticateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/AuthenticateDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).AuthenticateDevice(ctx, req.(*AuthenticateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrossChainService_VerifyService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainServiceServer).VerifyService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bloqz.ibc.v1.CrossChainService/VerifyService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainServiceServer).VerifyService(ctx, req.(*VerifyServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var CrossChainService_serviceDesc = _CrossChainService_serviceDesc
+var _CrossChainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bloqz.ibc.v1.CrossChainService",
+	HandlerType: (*CrossChainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyUserProfile",
+			Handler:    _CrossChainService_VerifyUserProfile_Handler,
+		},
+		{
+			MethodName: "VerifyDocument",
+			Handler:    _CrossChainService_VerifyDocument_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _CrossChainService_SendMessage_Handler,
+		},
+		{
+			MethodName: "SignalVideo",
+			Handler:    _CrossChainService_SignalVideo_Handler,
+		},
+		{
+			MethodName: "AuthenticateDevice",
+			Handler:    _CrossChainService_AuthenticateDevice_Handler,
+		},
+		{
+			MethodName: "VerifyService",
+			Handler:    _CrossChainService_VerifyService_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bloqz/ibc/v1/handlers.proto",
+}
+
+func (m *VerifyUserProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyUserProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyUserProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequiredCredentials) > 0 {
+		for iNdEx := len(m.RequiredCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCredentials[iNdEx])
+			copy(dAtA[i:], m.RequiredCredentials[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.RequiredCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyUserProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyUserProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyUserProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerifiedCredentials) > 0 {
+		for iNdEx := len(m.VerifiedCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedCredentials[iNdEx])
+			copy(dAtA[i:], m.VerifiedCredentials[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.VerifiedCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintHandlers(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequiredVerifications) > 0 {
+		for iNdEx := len(m.RequiredVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredVerifications[iNdEx])
+			copy(dAtA[i:], m.RequiredVerifications[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.RequiredVerifications[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompletedVerifications) > 0 {
+		for iNdEx := len(m.CompletedVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CompletedVerifications[iNdEx])
+			copy(dAtA[i:], m.CompletedVerifications[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.CompletedVerifications[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintHandlers(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequireDeliveryConfirmation {
+		i--
+		if m.RequireDeliveryConfirmation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FailedDeliveries) > 0 {
+		for iNdEx := len(m.FailedDeliveries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedDeliveries[iNdEx])
+			copy(dAtA[i:], m.FailedDeliveries[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.FailedDeliveries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeliveredTo) > 0 {
+		for iNdEx := len(m.DeliveredTo) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeliveredTo[iNdEx])
+			copy(dAtA[i:], m.DeliveredTo[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.DeliveredTo[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoSignalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoSignalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoSignalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequireAcknowledgment {
+		i--
+		if m.RequireAcknowledgment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Signal != nil {
+		{
+			size, err := m.Signal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoSignalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoSignalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoSignalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Acknowledged {
+		i--
+		if m.Acknowledged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateDeviceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticateDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticateDeviceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequiredFactors) > 0 {
+		for iNdEx := len(m.RequiredFactors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredFactors[iNdEx])
+			copy(dAtA[i:], m.RequiredFactors[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.RequiredFactors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AuthData != nil {
+		{
+			size, err := m.AuthData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticateDeviceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticateDeviceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticateDeviceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TokenExpiry != 0 {
+		i = encodeVarintHandlers(dAtA, i, uint64(m.TokenExpiry))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AuthToken) > 0 {
+		i -= len(m.AuthToken)
+		copy(dAtA[i:], m.AuthToken)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.AuthToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintHandlers(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyServiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyServiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyServiceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RequiredCapabilities) > 0 {
+		for iNdEx := len(m.RequiredCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCapabilities[iNdEx])
+			copy(dAtA[i:], m.RequiredCapabilities[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.RequiredCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandlers(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyServiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyServiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for iNdEx := len(m.VerifiedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedCapabilities[iNdEx])
+			copy(dAtA[i:], m.VerifiedCapabilities[iNdEx])
+			i = encodeVarintHandlers(dAtA, i, uint64(len(m.VerifiedCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintHandlers(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintHandlers(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHandlers(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHandlers(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VerifyUserProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.RequiredCredentials) > 0 {
+		for _, s := range m.RequiredCredentials {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyUserProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovHandlers(uint64(m.Status))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.VerifiedCredentials) > 0 {
+		for _, s := range m.VerifiedCredentials {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.RequiredVerifications) > 0 {
+		for _, s := range m.RequiredVerifications {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovHandlers(uint64(m.Status))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.CompletedVerifications) > 0 {
+		for _, s := range m.CompletedVerifications {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *SendMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if m.RequireDeliveryConfirmation {
+		n += 2
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *SendMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.DeliveredTo) > 0 {
+		for _, s := range m.DeliveredTo {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	if len(m.FailedDeliveries) > 0 {
+		for _, s := range m.FailedDeliveries {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoSignalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Signal != nil {
+		l = m.Signal.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if m.RequireAcknowledgment {
+		n += 2
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoSignalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if m.Acknowledged {
+		n += 2
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticateDeviceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthData != nil {
+		l = m.AuthData.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.RequiredFactors) > 0 {
+		for _, s := range m.RequiredFactors {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticateDeviceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovHandlers(uint64(m.Status))
+	}
+	l = len(m.AuthToken)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if m.TokenExpiry != 0 {
+		n += 1 + sovHandlers(uint64(m.TokenExpiry))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyServiceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.RequiredCapabilities) > 0 {
+		for _, s := range m.RequiredCapabilities {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyServiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovHandlers(uint64(m.Status))
+	}
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for _, s := range m.VerifiedCapabilities {
+			l = len(s)
+			n += 1 + l + sovHandlers(uint64(l))
+		}
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovHandlers(uint64(l))
+	}
+	return n
+}
+
+func sovHandlers(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandlers(x uint64) (n int) {
+	return sovHandlers(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VerifyUserProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyUserProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyUserProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &UserProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCredentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredCredentials = append(m.RequiredCredentials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyUserProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyUserProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyUserProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCredentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCredentials = append(m.VerifiedCredentials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &Document{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredVerifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredVerifications = append(m.RequiredVerifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedVerifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedVerifications = append(m.CompletedVerifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &RealTimeMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDeliveryConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDeliveryConfirmation = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredTo = append(m.DeliveredTo, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeliveries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeliveries = append(m.FailedDeliveries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoSignalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoSignalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoSignalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signal == nil {
+				m.Signal = &VideoSignal{}
+			}
+			if err := m.Signal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAcknowledgment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAcknowledgment = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoSignalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoSignalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoSignalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acknowledged = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticateDeviceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticateDeviceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticateDeviceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthData == nil {
+				m.AuthData = &DeviceAuth{}
+			}
+			if err := m.AuthData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFactors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredFactors = append(m.RequiredFactors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthenticateDeviceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthenticateDeviceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthenticateDeviceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpiry", wireType)
+			}
+			m.TokenExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyServiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyServiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyServiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &ServiceVerification{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredCapabilities = append(m.RequiredCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCapabilities = append(m.VerifiedCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandlers(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHandlers
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHandlers(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHandlers
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHandlers
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHandlers
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHandlers
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHandlers
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHandlers        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHandlers          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHandlers = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// DeviceAuthKeyPrefix is the prefix to retrieve all DeviceAuth
+	DeviceAuthKeyPrefix = "DeviceAuth/value/"
+)
+
+// DeviceAuthKey returns the store key to retrieve a DeviceAuth from the index fields
+func DeviceAuthKey(
+	deviceId string,
+) []byte {
+	var key []byte
+
+	deviceIdBytes := []byte(deviceId)
+	key = append(key, deviceIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// DocumentVerificationKeyPrefix is the prefix to retrieve all DocumentVerification
+	DocumentVerificationKeyPrefix = "DocumentVerification/value/"
+)
+
+// DocumentVerificationKey returns the store key to retrieve a DocumentVerification from the index fields
+func DocumentVerificationKey(
+	verificationId string,
+) []byte {
+	var key []byte
+
+	verificationIdBytes := []byte(verificationId)
+	key = append(key, verificationIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ProfileVerificationKeyPrefix is the prefix to retrieve all ProfileVerification
+	ProfileVerificationKeyPrefix = "ProfileVerification/value/"
+)
+
+// ProfileVerificationKey returns the store key to retrieve a ProfileVerification from the index fields
+func ProfileVerificationKey(
+	verificationId string,
+) []byte {
+	var key []byte
+
+	verificationIdBytes := []byte(verificationId)
+	key = append(key, verificationIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
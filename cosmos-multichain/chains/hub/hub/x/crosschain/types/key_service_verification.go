@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ServiceVerificationKeyPrefix is the prefix to retrieve all ServiceVerification
+	ServiceVerificationKeyPrefix = "ServiceVerification/value/"
+)
+
+// ServiceVerificationKey returns the store key to retrieve a ServiceVerification from the index fields
+func ServiceVerificationKey(
+	verificationId string,
+) []byte {
+	var key []byte
+
+	verificationIdBytes := []byte(verificationId)
+	key = append(key, verificationIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,38 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// VideoSignalKeyPrefix is the prefix to retrieve all VideoSignal
+	VideoSignalKeyPrefix = "VideoSignal/value/"
+)
+
+// VideoSignalSessionKey returns the store key prefix holding every
+// VideoSignal exchanged in a session
+func VideoSignalSessionKey(
+	sessionId string,
+) []byte {
+	var key []byte
+
+	sessionIdBytes := []byte(sessionId)
+	key = append(key, sessionIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// VideoSignalKey returns the store key to retrieve a VideoSignal from the index fields
+func VideoSignalKey(
+	sessionId string,
+	signalId string,
+) []byte {
+	key := VideoSignalSessionKey(sessionId)
+
+	signalIdBytes := []byte(signalId)
+	key = append(key, signalIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "crosschain"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_crosschain"
+)
+
+var (
+	ParamsKey = []byte("p_crosschain")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+const (
+	// VerificationCountKey holds the sequence used to derive request IDs
+	VerificationCountKey = "Verification/count/"
+)
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeySessionTokenTTL = []byte("SessionTokenTTL")
+	// DefaultSessionTokenTTL is the device auth token lifetime, in seconds
+	DefaultSessionTokenTTL int64 = 24 * 60 * 60
+)
+
+var (
+	KeySignalTypes = []byte("SignalTypes")
+	// DefaultSignalTypes mirrors the signaling types in ibc-config.yml
+	DefaultSignalTypes = []string{"offer", "answer", "ice_candidate"}
+)
+
+var (
+	KeyAuthMethods = []byte("AuthMethods")
+	// DefaultAuthMethods mirrors the device auth methods in ibc-config.yml
+	DefaultAuthMethods = []string{"biometric", "pin", "pattern"}
+)
+
+var (
+	KeyMaxRecipients = []byte("MaxRecipients")
+	// DefaultMaxRecipients matches the document_sync batch size in ibc-config.yml
+	DefaultMaxRecipients uint32 = 100
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	sessionTokenTTL int64,
+	signalTypes []string,
+	authMethods []string,
+	maxRecipients uint32,
+) Params {
+	return Params{
+		SessionTokenTtl: sessionTokenTTL,
+		SignalTypes:     signalTypes,
+		AuthMethods:     authMethods,
+		MaxRecipients:   maxRecipients,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultSessionTokenTTL,
+		DefaultSignalTypes,
+		DefaultAuthMethods,
+		DefaultMaxRecipients,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySessionTokenTTL, &p.SessionTokenTtl, validateSessionTokenTTL),
+		paramtypes.NewParamSetPair(KeySignalTypes, &p.SignalTypes, validateStringSet),
+		paramtypes.NewParamSetPair(KeyAuthMethods, &p.AuthMethods, validateStringSet),
+		paramtypes.NewParamSetPair(KeyMaxRecipients, &p.MaxRecipients, validateMaxRecipients),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateSessionTokenTTL(p.SessionTokenTtl); err != nil {
+		return err
+	}
+	if err := validateStringSet(p.SignalTypes); err != nil {
+		return fmt.Errorf("signal types: %w", err)
+	}
+	if err := validateStringSet(p.AuthMethods); err != nil {
+		return fmt.Errorf("auth methods: %w", err)
+	}
+	return validateMaxRecipients(p.MaxRecipients)
+}
+
+// IsAllowedSignalType reports whether signalType is accepted by the params
+func (p Params) IsAllowedSignalType(signalType string) bool {
+	return contains(p.SignalTypes, signalType)
+}
+
+// IsAllowedAuthMethod reports whether method is accepted by the params
+func (p Params) IsAllowedAuthMethod(method string) bool {
+	return contains(p.AuthMethods, method)
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSessionTokenTTL validates the SessionTokenTTL param
+func validateSessionTokenTTL(v interface{}) error {
+	ttl, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if ttl <= 0 {
+		return fmt.Errorf("session token ttl must be positive: %d", ttl)
+	}
+	return nil
+}
+
+// validateStringSet validates a non-empty list of unique, non-empty strings
+func validateStringSet(v interface{}) error {
+	list, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("list cannot be empty")
+	}
+	seen := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		if item == "" {
+			return fmt.Errorf("list entries cannot be empty")
+		}
+		if _, ok := seen[item]; ok {
+			return fmt.Errorf("duplicated entry %q", item)
+		}
+		seen[item] = struct{}{}
+	}
+	return nil
+}
+
+// validateMaxRecipients validates the MaxRecipients param
+func validateMaxRecipients(v interface{}) error {
+	max, ok := v.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if max == 0 {
+		return fmt.Errorf("max recipients must be positive")
+	}
+	return nil
+}
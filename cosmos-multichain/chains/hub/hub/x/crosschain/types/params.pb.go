@@ -0,0 +1,500 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/crosschain/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// session_token_ttl is the lifetime, in seconds, of device auth tokens.
+	SessionTokenTtl int64 `protobuf:"varint,1,opt,name=session_token_ttl,json=sessionTokenTtl,proto3" json:"session_token_ttl,omitempty"`
+	// signal_types lists the accepted video signaling types.
+	SignalTypes []string `protobuf:"bytes,2,rep,name=signal_types,json=signalTypes,proto3" json:"signal_types,omitempty"`
+	// auth_methods lists the accepted device authentication methods.
+	AuthMethods []string `protobuf:"bytes,3,rep,name=auth_methods,json=authMethods,proto3" json:"auth_methods,omitempty"`
+	// max_recipients bounds the fan-out of a single real-time message.
+	MaxRecipients uint32 `protobuf:"varint,4,opt,name=max_recipients,json=maxRecipients,proto3" json:"max_recipients,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9f472d0fe007362, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSessionTokenTtl() int64 {
+	if m != nil {
+		return m.SessionTokenTtl
+	}
+	return 0
+}
+
+func (m *Params) GetSignalTypes() []string {
+	if m != nil {
+		return m.SignalTypes
+	}
+	return nil
+}
+
+func (m *Params) GetAuthMethods() []string {
+	if m != nil {
+		return m.AuthMethods
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRecipients() uint32 {
+	if m != nil {
+		return m.MaxRecipients
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hub.crosschain.Params")
+}
+
+func init() { proto.RegisterFile("hub/crosschain/params.proto", fileDescriptor_d9f472d0fe007362) }
+
+var fileDescriptor_d9f472d0fe007362 = []byte{
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x28, 0x4d, 0xd2,
+	0x4f, 0x2e, 0xca, 0x2f, 0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
+	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcb, 0x28, 0x4d, 0xd2, 0x43, 0x48, 0x4a,
+	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x12, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
+	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x1d, 0x66, 0xe4, 0x62, 0x0b, 0x00, 0x9b, 0x24,
+	0xa4, 0xc5, 0x25, 0x58, 0x9c, 0x5a, 0x5c, 0x9c, 0x99, 0x9f, 0x17, 0x5f, 0x92, 0x9f, 0x9d, 0x9a,
+	0x17, 0x5f, 0x52, 0x92, 0x23, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1c, 0xc4, 0x0f, 0x95, 0x08, 0x01,
+	0x89, 0x87, 0x94, 0xe4, 0x08, 0x29, 0x72, 0xf1, 0x14, 0x67, 0xa6, 0xe7, 0x25, 0xe6, 0xc4, 0x97,
+	0x54, 0x16, 0xa4, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x06, 0x71, 0x43, 0xc4, 0x42, 0x40,
+	0x42, 0x20, 0x25, 0x89, 0xa5, 0x25, 0x19, 0xf1, 0xb9, 0xa9, 0x25, 0x19, 0xf9, 0x29, 0xc5, 0x12,
+	0xcc, 0x10, 0x25, 0x20, 0x31, 0x5f, 0x88, 0x90, 0x90, 0x2a, 0x17, 0x5f, 0x6e, 0x62, 0x45, 0x7c,
+	0x51, 0x6a, 0x72, 0x66, 0x41, 0x66, 0x6a, 0x5e, 0x49, 0xb1, 0x04, 0x8b, 0x02, 0xa3, 0x06, 0x6f,
+	0x10, 0x6f, 0x6e, 0x62, 0x45, 0x10, 0x5c, 0xd0, 0x4a, 0xe1, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7,
+	0x1b, 0xb4, 0xc4, 0x41, 0x41, 0x50, 0x81, 0x1c, 0x08, 0x10, 0xa7, 0x3b, 0x19, 0x9c, 0x78, 0x24,
+	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
+	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x18, 0x86, 0x16, 0xb0, 0x83, 0x93, 0xd8, 0xc0,
+	0xde, 0x37, 0x06, 0x0c, 0x00, 0x60, 0xee, 0xd0, 0x32, 0x56, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionTokenTtl != that1.SessionTokenTtl {
+		return false
+	}
+	if len(this.SignalTypes) != len(that1.SignalTypes) {
+		return false
+	}
+	for i := range this.SignalTypes {
+		if this.SignalTypes[i] != that1.SignalTypes[i] {
+			return false
+		}
+	}
+	if len(this.AuthMethods) != len(that1.AuthMethods) {
+		return false
+	}
+	for i := range this.AuthMethods {
+		if this.AuthMethods[i] != that1.AuthMethods[i] {
+			return false
+		}
+	}
+	if this.MaxRecipients != that1.MaxRecipients {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecipients != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecipients))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AuthMethods) > 0 {
+		for iNdEx := len(m.AuthMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthMethods[iNdEx])
+			copy(dAtA[i:], m.AuthMethods[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AuthMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SignalTypes) > 0 {
+		for iNdEx := len(m.SignalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignalTypes[iNdEx])
+			copy(dAtA[i:], m.SignalTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SignalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SessionTokenTtl != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SessionTokenTtl))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionTokenTtl != 0 {
+		n += 1 + sovParams(uint64(m.SessionTokenTtl))
+	}
+	if len(m.SignalTypes) > 0 {
+		for _, s := range m.SignalTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AuthMethods) > 0 {
+		for _, s := range m.AuthMethods {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRecipients != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecipients))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTokenTtl", wireType)
+			}
+			m.SessionTokenTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionTokenTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalTypes = append(m.SignalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthMethods = append(m.AuthMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecipients", wireType)
+			}
+			m.MaxRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecipients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,5278 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/crosschain/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetProfileVerificationRequest struct {
+	VerificationId string `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+}
+
+func (m *QueryGetProfileVerificationRequest) Reset()         { *m = QueryGetProfileVerificationRequest{} }
+func (m *QueryGetProfileVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProfileVerificationRequest) ProtoMessage()    {}
+func (*QueryGetProfileVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{2}
+}
+func (m *QueryGetProfileVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProfileVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProfileVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProfileVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProfileVerificationRequest.Merge(m, src)
+}
+func (m *QueryGetProfileVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProfileVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProfileVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProfileVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryGetProfileVerificationRequest) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+type QueryGetProfileVerificationResponse struct {
+	ProfileVerification ProfileVerificationRecord `protobuf:"bytes,1,opt,name=profileVerification,proto3" json:"profileVerification"`
+}
+
+func (m *QueryGetProfileVerificationResponse) Reset()         { *m = QueryGetProfileVerificationResponse{} }
+func (m *QueryGetProfileVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProfileVerificationResponse) ProtoMessage()    {}
+func (*QueryGetProfileVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{3}
+}
+func (m *QueryGetProfileVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProfileVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProfileVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProfileVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProfileVerificationResponse.Merge(m, src)
+}
+func (m *QueryGetProfileVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProfileVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProfileVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProfileVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryGetProfileVerificationResponse) GetProfileVerification() ProfileVerificationRecord {
+	if m != nil {
+		return m.ProfileVerification
+	}
+	return ProfileVerificationRecord{}
+}
+
+type QueryAllProfileVerificationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProfileVerificationRequest) Reset()         { *m = QueryAllProfileVerificationRequest{} }
+func (m *QueryAllProfileVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProfileVerificationRequest) ProtoMessage()    {}
+func (*QueryAllProfileVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{4}
+}
+func (m *QueryAllProfileVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProfileVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProfileVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllProfileVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProfileVerificationRequest.Merge(m, src)
+}
+func (m *QueryAllProfileVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProfileVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProfileVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProfileVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryAllProfileVerificationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllProfileVerificationResponse struct {
+	ProfileVerification []ProfileVerificationRecord `protobuf:"bytes,1,rep,name=profileVerification,proto3" json:"profileVerification"`
+	Pagination          *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllProfileVerificationResponse) Reset()         { *m = QueryAllProfileVerificationResponse{} }
+func (m *QueryAllProfileVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProfileVerificationResponse) ProtoMessage()    {}
+func (*QueryAllProfileVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{5}
+}
+func (m *QueryAllProfileVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProfileVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProfileVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllProfileVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProfileVerificationResponse.Merge(m, src)
+}
+func (m *QueryAllProfileVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProfileVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProfileVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProfileVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryAllProfileVerificationResponse) GetProfileVerification() []ProfileVerificationRecord {
+	if m != nil {
+		return m.ProfileVerification
+	}
+	return nil
+}
+
+func (m *QueryAllProfileVerificationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetDocumentVerificationRequest struct {
+	VerificationId string `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+}
+
+func (m *QueryGetDocumentVerificationRequest) Reset()         { *m = QueryGetDocumentVerificationRequest{} }
+func (m *QueryGetDocumentVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDocumentVerificationRequest) ProtoMessage()    {}
+func (*QueryGetDocumentVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{6}
+}
+func (m *QueryGetDocumentVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDocumentVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDocumentVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDocumentVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDocumentVerificationRequest.Merge(m, src)
+}
+func (m *QueryGetDocumentVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDocumentVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDocumentVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDocumentVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryGetDocumentVerificationRequest) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+type QueryGetDocumentVerificationResponse struct {
+	DocumentVerification DocumentVerificationRecord `protobuf:"bytes,1,opt,name=documentVerification,proto3" json:"documentVerification"`
+}
+
+func (m *QueryGetDocumentVerificationResponse) Reset()         { *m = QueryGetDocumentVerificationResponse{} }
+func (m *QueryGetDocumentVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDocumentVerificationResponse) ProtoMessage()    {}
+func (*QueryGetDocumentVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{7}
+}
+func (m *QueryGetDocumentVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDocumentVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDocumentVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDocumentVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDocumentVerificationResponse.Merge(m, src)
+}
+func (m *QueryGetDocumentVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDocumentVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDocumentVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDocumentVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryGetDocumentVerificationResponse) GetDocumentVerification() DocumentVerificationRecord {
+	if m != nil {
+		return m.DocumentVerification
+	}
+	return DocumentVerificationRecord{}
+}
+
+type QueryAllDocumentVerificationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDocumentVerificationRequest) Reset()         { *m = QueryAllDocumentVerificationRequest{} }
+func (m *QueryAllDocumentVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDocumentVerificationRequest) ProtoMessage()    {}
+func (*QueryAllDocumentVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{8}
+}
+func (m *QueryAllDocumentVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDocumentVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDocumentVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDocumentVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDocumentVerificationRequest.Merge(m, src)
+}
+func (m *QueryAllDocumentVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDocumentVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDocumentVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDocumentVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryAllDocumentVerificationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDocumentVerificationResponse struct {
+	DocumentVerification []DocumentVerificationRecord `protobuf:"bytes,1,rep,name=documentVerification,proto3" json:"documentVerification"`
+	Pagination           *query.PageResponse          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDocumentVerificationResponse) Reset()         { *m = QueryAllDocumentVerificationResponse{} }
+func (m *QueryAllDocumentVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDocumentVerificationResponse) ProtoMessage()    {}
+func (*QueryAllDocumentVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{9}
+}
+func (m *QueryAllDocumentVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDocumentVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDocumentVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDocumentVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDocumentVerificationResponse.Merge(m, src)
+}
+func (m *QueryAllDocumentVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDocumentVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDocumentVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDocumentVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryAllDocumentVerificationResponse) GetDocumentVerification() []DocumentVerificationRecord {
+	if m != nil {
+		return m.DocumentVerification
+	}
+	return nil
+}
+
+func (m *QueryAllDocumentVerificationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetMessageRequest struct {
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (m *QueryGetMessageRequest) Reset()         { *m = QueryGetMessageRequest{} }
+func (m *QueryGetMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMessageRequest) ProtoMessage()    {}
+func (*QueryGetMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{10}
+}
+func (m *QueryGetMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMessageRequest.Merge(m, src)
+}
+func (m *QueryGetMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMessageRequest proto.InternalMessageInfo
+
+func (m *QueryGetMessageRequest) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+type QueryGetMessageResponse struct {
+	Message MessageRecord `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+}
+
+func (m *QueryGetMessageResponse) Reset()         { *m = QueryGetMessageResponse{} }
+func (m *QueryGetMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMessageResponse) ProtoMessage()    {}
+func (*QueryGetMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{11}
+}
+func (m *QueryGetMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMessageResponse.Merge(m, src)
+}
+func (m *QueryGetMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMessageResponse proto.InternalMessageInfo
+
+func (m *QueryGetMessageResponse) GetMessage() MessageRecord {
+	if m != nil {
+		return m.Message
+	}
+	return MessageRecord{}
+}
+
+type QueryAllMessageRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMessageRequest) Reset()         { *m = QueryAllMessageRequest{} }
+func (m *QueryAllMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMessageRequest) ProtoMessage()    {}
+func (*QueryAllMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{12}
+}
+func (m *QueryAllMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMessageRequest.Merge(m, src)
+}
+func (m *QueryAllMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMessageRequest proto.InternalMessageInfo
+
+func (m *QueryAllMessageRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllMessageResponse struct {
+	Message    []MessageRecord     `protobuf:"bytes,1,rep,name=message,proto3" json:"message"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMessageResponse) Reset()         { *m = QueryAllMessageResponse{} }
+func (m *QueryAllMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMessageResponse) ProtoMessage()    {}
+func (*QueryAllMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{13}
+}
+func (m *QueryAllMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMessageResponse.Merge(m, src)
+}
+func (m *QueryAllMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMessageResponse proto.InternalMessageInfo
+
+func (m *QueryAllMessageResponse) GetMessage() []MessageRecord {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *QueryAllMessageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetDeviceAuthRequest struct {
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+}
+
+func (m *QueryGetDeviceAuthRequest) Reset()         { *m = QueryGetDeviceAuthRequest{} }
+func (m *QueryGetDeviceAuthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeviceAuthRequest) ProtoMessage()    {}
+func (*QueryGetDeviceAuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{14}
+}
+func (m *QueryGetDeviceAuthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDeviceAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDeviceAuthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDeviceAuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeviceAuthRequest.Merge(m, src)
+}
+func (m *QueryGetDeviceAuthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDeviceAuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeviceAuthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDeviceAuthRequest proto.InternalMessageInfo
+
+func (m *QueryGetDeviceAuthRequest) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+type QueryGetDeviceAuthResponse struct {
+	DeviceAuth DeviceAuthRecord `protobuf:"bytes,1,opt,name=deviceAuth,proto3" json:"deviceAuth"`
+}
+
+func (m *QueryGetDeviceAuthResponse) Reset()         { *m = QueryGetDeviceAuthResponse{} }
+func (m *QueryGetDeviceAuthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDeviceAuthResponse) ProtoMessage()    {}
+func (*QueryGetDeviceAuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{15}
+}
+func (m *QueryGetDeviceAuthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDeviceAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDeviceAuthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDeviceAuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDeviceAuthResponse.Merge(m, src)
+}
+func (m *QueryGetDeviceAuthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDeviceAuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDeviceAuthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetDeviceAuthResponse proto.InternalMessageInfo
+
+func (m *QueryGetDeviceAuthResponse) GetDeviceAuth() DeviceAuthRecord {
+	if m != nil {
+		return m.DeviceAuth
+	}
+	return DeviceAuthRecord{}
+}
+
+type QueryAllDeviceAuthRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDeviceAuthRequest) Reset()         { *m = QueryAllDeviceAuthRequest{} }
+func (m *QueryAllDeviceAuthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDeviceAuthRequest) ProtoMessage()    {}
+func (*QueryAllDeviceAuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{16}
+}
+func (m *QueryAllDeviceAuthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDeviceAuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDeviceAuthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDeviceAuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDeviceAuthRequest.Merge(m, src)
+}
+func (m *QueryAllDeviceAuthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDeviceAuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDeviceAuthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDeviceAuthRequest proto.InternalMessageInfo
+
+func (m *QueryAllDeviceAuthRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllDeviceAuthResponse struct {
+	DeviceAuth []DeviceAuthRecord  `protobuf:"bytes,1,rep,name=deviceAuth,proto3" json:"deviceAuth"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllDeviceAuthResponse) Reset()         { *m = QueryAllDeviceAuthResponse{} }
+func (m *QueryAllDeviceAuthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllDeviceAuthResponse) ProtoMessage()    {}
+func (*QueryAllDeviceAuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{17}
+}
+func (m *QueryAllDeviceAuthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllDeviceAuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllDeviceAuthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllDeviceAuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllDeviceAuthResponse.Merge(m, src)
+}
+func (m *QueryAllDeviceAuthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllDeviceAuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllDeviceAuthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllDeviceAuthResponse proto.InternalMessageInfo
+
+func (m *QueryAllDeviceAuthResponse) GetDeviceAuth() []DeviceAuthRecord {
+	if m != nil {
+		return m.DeviceAuth
+	}
+	return nil
+}
+
+func (m *QueryAllDeviceAuthResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetServiceVerificationRequest struct {
+	VerificationId string `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+}
+
+func (m *QueryGetServiceVerificationRequest) Reset()         { *m = QueryGetServiceVerificationRequest{} }
+func (m *QueryGetServiceVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetServiceVerificationRequest) ProtoMessage()    {}
+func (*QueryGetServiceVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{18}
+}
+func (m *QueryGetServiceVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetServiceVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetServiceVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetServiceVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetServiceVerificationRequest.Merge(m, src)
+}
+func (m *QueryGetServiceVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetServiceVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetServiceVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetServiceVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryGetServiceVerificationRequest) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+type QueryGetServiceVerificationResponse struct {
+	ServiceVerification ServiceVerificationRecord `protobuf:"bytes,1,opt,name=serviceVerification,proto3" json:"serviceVerification"`
+}
+
+func (m *QueryGetServiceVerificationResponse) Reset()         { *m = QueryGetServiceVerificationResponse{} }
+func (m *QueryGetServiceVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetServiceVerificationResponse) ProtoMessage()    {}
+func (*QueryGetServiceVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{19}
+}
+func (m *QueryGetServiceVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetServiceVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetServiceVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetServiceVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetServiceVerificationResponse.Merge(m, src)
+}
+func (m *QueryGetServiceVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetServiceVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetServiceVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetServiceVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryGetServiceVerificationResponse) GetServiceVerification() ServiceVerificationRecord {
+	if m != nil {
+		return m.ServiceVerification
+	}
+	return ServiceVerificationRecord{}
+}
+
+type QueryAllServiceVerificationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllServiceVerificationRequest) Reset()         { *m = QueryAllServiceVerificationRequest{} }
+func (m *QueryAllServiceVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllServiceVerificationRequest) ProtoMessage()    {}
+func (*QueryAllServiceVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{20}
+}
+func (m *QueryAllServiceVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllServiceVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllServiceVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllServiceVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllServiceVerificationRequest.Merge(m, src)
+}
+func (m *QueryAllServiceVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllServiceVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllServiceVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllServiceVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryAllServiceVerificationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllServiceVerificationResponse struct {
+	ServiceVerification []ServiceVerificationRecord `protobuf:"bytes,1,rep,name=serviceVerification,proto3" json:"serviceVerification"`
+	Pagination          *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllServiceVerificationResponse) Reset()         { *m = QueryAllServiceVerificationResponse{} }
+func (m *QueryAllServiceVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllServiceVerificationResponse) ProtoMessage()    {}
+func (*QueryAllServiceVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{21}
+}
+func (m *QueryAllServiceVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllServiceVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllServiceVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllServiceVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllServiceVerificationResponse.Merge(m, src)
+}
+func (m *QueryAllServiceVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllServiceVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllServiceVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllServiceVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryAllServiceVerificationResponse) GetServiceVerification() []ServiceVerificationRecord {
+	if m != nil {
+		return m.ServiceVerification
+	}
+	return nil
+}
+
+func (m *QueryAllServiceVerificationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVideoSignalsRequest struct {
+	SessionId  string             `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVideoSignalsRequest) Reset()         { *m = QueryVideoSignalsRequest{} }
+func (m *QueryVideoSignalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVideoSignalsRequest) ProtoMessage()    {}
+func (*QueryVideoSignalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{22}
+}
+func (m *QueryVideoSignalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVideoSignalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVideoSignalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVideoSignalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVideoSignalsRequest.Merge(m, src)
+}
+func (m *QueryVideoSignalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVideoSignalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVideoSignalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVideoSignalsRequest proto.InternalMessageInfo
+
+func (m *QueryVideoSignalsRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *QueryVideoSignalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVideoSignalsResponse struct {
+	VideoSignal []VideoSignalRecord `protobuf:"bytes,1,rep,name=videoSignal,proto3" json:"videoSignal"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVideoSignalsResponse) Reset()         { *m = QueryVideoSignalsResponse{} }
+func (m *QueryVideoSignalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVideoSignalsResponse) ProtoMessage()    {}
+func (*QueryVideoSignalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffb195338183591b, []int{23}
+}
+func (m *QueryVideoSignalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVideoSignalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVideoSignalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVideoSignalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVideoSignalsResponse.Merge(m, src)
+}
+func (m *QueryVideoSignalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVideoSignalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVideoSignalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVideoSignalsResponse proto.InternalMessageInfo
+
+func (m *QueryVideoSignalsResponse) GetVideoSignal() []VideoSignalRecord {
+	if m != nil {
+		return m.VideoSignal
+	}
+	return nil
+}
+
+func (m *QueryVideoSignalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hub.crosschain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hub.crosschain.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProfileVerificationRequest)(nil), "hub.crosschain.QueryGetProfileVerificationRequest")
+	proto.RegisterType((*QueryGetProfileVerificationResponse)(nil), "hub.crosschain.QueryGetProfileVerificationResponse")
+	proto.RegisterType((*QueryAllProfileVerificationRequest)(nil), "hub.crosschain.QueryAllProfileVerificationRequest")
+	proto.RegisterType((*QueryAllProfileVerificationResponse)(nil), "hub.crosschain.QueryAllProfileVerificationResponse")
+	proto.RegisterType((*QueryGetDocumentVerificationRequest)(nil), "hub.crosschain.QueryGetDocumentVerificationRequest")
+	proto.RegisterType((*QueryGetDocumentVerificationResponse)(nil), "hub.crosschain.QueryGetDocumentVerificationResponse")
+	proto.RegisterType((*QueryAllDocumentVerificationRequest)(nil), "hub.crosschain.QueryAllDocumentVerificationRequest")
+	proto.RegisterType((*QueryAllDocumentVerificationResponse)(nil), "hub.crosschain.QueryAllDocumentVerificationResponse")
+	proto.RegisterType((*QueryGetMessageRequest)(nil), "hub.crosschain.QueryGetMessageRequest")
+	proto.RegisterType((*QueryGetMessageResponse)(nil), "hub.crosschain.QueryGetMessageResponse")
+	proto.RegisterType((*QueryAllMessageRequest)(nil), "hub.crosschain.QueryAllMessageRequest")
+	proto.RegisterType((*QueryAllMessageResponse)(nil), "hub.crosschain.QueryAllMessageResponse")
+	proto.RegisterType((*QueryGetDeviceAuthRequest)(nil), "hub.crosschain.QueryGetDeviceAuthRequest")
+	proto.RegisterType((*QueryGetDeviceAuthResponse)(nil), "hub.crosschain.QueryGetDeviceAuthResponse")
+	proto.RegisterType((*QueryAllDeviceAuthRequest)(nil), "hub.crosschain.QueryAllDeviceAuthRequest")
+	proto.RegisterType((*QueryAllDeviceAuthResponse)(nil), "hub.crosschain.QueryAllDeviceAuthResponse")
+	proto.RegisterType((*QueryGetServiceVerificationRequest)(nil), "hub.crosschain.QueryGetServiceVerificationRequest")
+	proto.RegisterType((*QueryGetServiceVerificationResponse)(nil), "hub.crosschain.QueryGetServiceVerificationResponse")
+	proto.RegisterType((*QueryAllServiceVerificationRequest)(nil), "hub.crosschain.QueryAllServiceVerificationRequest")
+	proto.RegisterType((*QueryAllServiceVerificationResponse)(nil), "hub.crosschain.QueryAllServiceVerificationResponse")
+	proto.RegisterType((*QueryVideoSignalsRequest)(nil), "hub.crosschain.QueryVideoSignalsRequest")
+	proto.RegisterType((*QueryVideoSignalsResponse)(nil), "hub.crosschain.QueryVideoSignalsResponse")
+}
+
+func init() { proto.RegisterFile("hub/crosschain/query.proto", fileDescriptor_ffb195338183591b) }
+
+var fileDescriptor_ffb195338183591b = []byte{
+	// 1100 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd4, 0x90, 0xe2, 0x57, 0x68, 0xc5, 0x24, 0x72, 0x92, 0x4d, 0xe3, 0x96, 0x4d, 0x89,
+	0x6b, 0xab, 0x78, 0x69, 0x82, 0x68, 0x7b, 0x00, 0x94, 0x08, 0xa5, 0xf2, 0xa1, 0x28, 0xb8, 0x52,
+	0x85, 0xb8, 0x84, 0xb5, 0x77, 0x6a, 0xaf, 0xb4, 0xde, 0x71, 0x76, 0xd6, 0x11, 0x55, 0x14, 0x24,
+	0x38, 0x55, 0x82, 0x03, 0x12, 0x48, 0x9c, 0x39, 0x20, 0x71, 0x41, 0x45, 0xe2, 0xce, 0x81, 0x53,
+	0x8f, 0x45, 0x08, 0x89, 0x13, 0x42, 0x09, 0x12, 0x7f, 0x03, 0xed, 0xec, 0xd8, 0x3b, 0xde, 0x9d,
+	0x5d, 0xdb, 0x61, 0xb9, 0x44, 0xd6, 0x9b, 0x79, 0xef, 0x7d, 0xdf, 0x7b, 0x6f, 0xe7, 0x7d, 0x0a,
+	0x68, 0xdd, 0x41, 0xcb, 0x68, 0x7b, 0x94, 0xb1, 0x76, 0xd7, 0xb4, 0x5d, 0xe3, 0x60, 0x40, 0xbc,
+	0x47, 0xf5, 0xbe, 0x47, 0x7d, 0x8a, 0x2f, 0x76, 0x07, 0xad, 0x7a, 0x74, 0xa6, 0xbd, 0x6c, 0xf6,
+	0x6c, 0x97, 0x1a, 0xfc, 0x6f, 0x78, 0x45, 0x5b, 0xec, 0xd0, 0x0e, 0xe5, 0x3f, 0x8d, 0xe0, 0x97,
+	0xb0, 0x5e, 0xee, 0x50, 0xda, 0x71, 0x88, 0x61, 0xf6, 0x6d, 0xc3, 0x74, 0x5d, 0xea, 0x9b, 0xbe,
+	0x4d, 0x5d, 0x26, 0x4e, 0x6b, 0x6d, 0xca, 0x7a, 0x94, 0x19, 0x2d, 0x93, 0x91, 0x30, 0x9f, 0x71,
+	0x78, 0xb3, 0x45, 0x7c, 0xf3, 0xa6, 0xd1, 0x37, 0x3b, 0xb6, 0xcb, 0x2f, 0x8b, 0xbb, 0xab, 0x31,
+	0x78, 0x7d, 0xd3, 0x33, 0x7b, 0xc3, 0x40, 0x97, 0x63, 0x87, 0x1e, 0x69, 0x53, 0xcf, 0x12, 0xa7,
+	0xfa, 0x22, 0xe0, 0xf7, 0x83, 0xe0, 0x7b, 0xdc, 0xa5, 0x49, 0x0e, 0x06, 0x84, 0xf9, 0xfa, 0x1e,
+	0x2c, 0x8c, 0x59, 0x59, 0x9f, 0xba, 0x8c, 0xe0, 0x3b, 0x30, 0x1f, 0x86, 0x5e, 0x46, 0x57, 0xd1,
+	0xf5, 0x0b, 0x9b, 0xa5, 0xfa, 0x38, 0xf7, 0x7a, 0x78, 0x7f, 0xa7, 0xf8, 0xf4, 0xcf, 0x2b, 0x73,
+	0xdf, 0xff, 0xf3, 0x63, 0x0d, 0x35, 0x85, 0x83, 0x7e, 0x0f, 0x74, 0x1e, 0xf1, 0x2e, 0xf1, 0xf7,
+	0x3c, 0xfa, 0xd0, 0x76, 0xc8, 0x03, 0xe2, 0xd9, 0x0f, 0xed, 0x36, 0xe7, 0x21, 0xf2, 0xe2, 0x0a,
+	0x5c, 0x3a, 0x94, 0xcc, 0xfb, 0xb6, 0xc5, 0x33, 0x15, 0x9b, 0x17, 0x65, 0x73, 0xc3, 0xd2, 0x1f,
+	0x23, 0x58, 0xcf, 0x8c, 0x27, 0x10, 0x9b, 0xb0, 0xd0, 0x4f, 0x1e, 0x0b, 0xf8, 0xd5, 0x04, 0x7c,
+	0x55, 0xa4, 0xa0, 0x5a, 0x3b, 0xcf, 0x05, 0x8c, 0x9a, 0xaa, 0x58, 0xba, 0x23, 0x98, 0x6d, 0x3b,
+	0x4e, 0x06, 0xb3, 0x5d, 0x80, 0xa8, 0x6d, 0x22, 0xff, 0x46, 0x3d, 0xec, 0x71, 0x3d, 0xe8, 0x71,
+	0x3d, 0x9c, 0x29, 0xd1, 0xe3, 0xfa, 0x9e, 0xd9, 0x21, 0xc2, 0xb7, 0x29, 0x79, 0xea, 0xbf, 0x0e,
+	0x89, 0xa7, 0xa5, 0x9b, 0x44, 0xbc, 0x90, 0x17, 0x71, 0x7c, 0x77, 0x8c, 0xd2, 0x39, 0x4e, 0xa9,
+	0x32, 0x91, 0x52, 0x88, 0x6f, 0x8c, 0xd3, 0x7b, 0x51, 0x2f, 0xdf, 0xa5, 0xed, 0x41, 0x8f, 0xb8,
+	0xfe, 0x7f, 0x1a, 0x8e, 0x2f, 0x10, 0x5c, 0xcb, 0x0e, 0x28, 0x8a, 0x64, 0xc1, 0xa2, 0xa5, 0x38,
+	0x17, 0xed, 0xa9, 0xc5, 0xab, 0xa4, 0x8e, 0x25, 0x95, 0x49, 0x19, 0x4d, 0xef, 0x45, 0x1d, 0xcb,
+	0xa2, 0x97, 0xd7, 0x84, 0xfc, 0x3e, 0x64, 0x9f, 0x9a, 0x6f, 0x22, 0xfb, 0x42, 0x7e, 0xec, 0xf3,
+	0x9b, 0x92, 0x5b, 0x50, 0x1a, 0x36, 0xf5, 0x1e, 0x61, 0x2c, 0x62, 0x8f, 0xd7, 0x00, 0x7a, 0xa1,
+	0x25, 0x9a, 0x89, 0xa2, 0xb0, 0x34, 0x2c, 0xfd, 0x03, 0x58, 0x4a, 0x38, 0x8a, 0x12, 0xbc, 0x05,
+	0xe7, 0xc5, 0x3d, 0x51, 0xf0, 0xb5, 0x38, 0xeb, 0x91, 0x87, 0x44, 0x74, 0xe8, 0xa3, 0x7f, 0x24,
+	0x20, 0x6d, 0x3b, 0x4e, 0x0c, 0x52, 0x5e, 0xcd, 0xfc, 0x16, 0xc1, 0x52, 0x22, 0x85, 0x0a, 0x7c,
+	0x61, 0x56, 0xf0, 0xf9, 0x35, 0xe6, 0x36, 0xac, 0x8c, 0xbe, 0x36, 0x72, 0x68, 0xb7, 0xc9, 0xf6,
+	0xc0, 0xef, 0x0e, 0x0b, 0xb1, 0x0a, 0x45, 0x8b, 0x1b, 0xa3, 0xd6, 0xbc, 0x10, 0x1a, 0x1a, 0x96,
+	0x6e, 0x81, 0xa6, 0xf2, 0x14, 0xfc, 0x76, 0x01, 0xac, 0x91, 0x55, 0xd4, 0xf0, 0x6a, 0x62, 0x2a,
+	0x25, 0x3f, 0x89, 0xa5, 0xe4, 0xa9, 0xb7, 0x61, 0x65, 0xf4, 0x3d, 0x24, 0xf0, 0xe5, 0xd5, 0xa8,
+	0x1f, 0x10, 0x68, 0xaa, 0x2c, 0x29, 0x5c, 0x0a, 0x67, 0xe3, 0x92, 0x5f, 0xd3, 0xa4, 0x7d, 0x7c,
+	0x9f, 0x78, 0x41, 0xfc, 0xdc, 0xf6, 0xb1, 0x32, 0x5e, 0xb4, 0x96, 0x58, 0xf2, 0x38, 0x6d, 0x1f,
+	0x2b, 0x23, 0xc9, 0x6b, 0x49, 0x11, 0x4b, 0xde, 0xc7, 0x19, 0xcc, 0xfe, 0x8f, 0x7d, 0x7c, 0x26,
+	0xe2, 0x85, 0xbc, 0x88, 0xe7, 0x37, 0x1b, 0x9f, 0x22, 0x58, 0xe6, 0x9c, 0x1e, 0xd8, 0x16, 0xa1,
+	0xf7, 0xed, 0x8e, 0x6b, 0x3a, 0x4c, 0x7a, 0x6c, 0x19, 0x61, 0x6c, 0x6c, 0x1a, 0x8a, 0xc2, 0xd2,
+	0xb0, 0xf0, 0xae, 0x02, 0xc4, 0x59, 0xea, 0xfa, 0x04, 0xc1, 0x8a, 0x02, 0x83, 0xa8, 0x66, 0x03,
+	0x2e, 0x1c, 0x46, 0x76, 0x51, 0xc5, 0x57, 0xe2, 0x55, 0x94, 0x5c, 0xc7, 0xaa, 0x27, 0xfb, 0xe6,
+	0x56, 0xb5, 0xcd, 0xef, 0x2e, 0xc1, 0xf3, 0x1c, 0x31, 0x3e, 0x80, 0xf9, 0x50, 0x08, 0x63, 0x3d,
+	0x0e, 0x29, 0xa9, 0xb5, 0xb5, 0xf5, 0xcc, 0x3b, 0x61, 0x22, 0xbd, 0xfc, 0xd9, 0x6f, 0x7f, 0x7f,
+	0x75, 0x6e, 0x19, 0x97, 0x0c, 0xa5, 0xd4, 0xc7, 0x3f, 0x23, 0x58, 0x50, 0x88, 0x38, 0xbc, 0xa9,
+	0x0c, 0x9e, 0x29, 0xc2, 0xb5, 0xad, 0x99, 0x7c, 0x04, 0xc0, 0xb7, 0x39, 0xc0, 0xdb, 0xf8, 0xcd,
+	0x04, 0xc0, 0xd0, 0x69, 0x5f, 0x7e, 0x30, 0x8c, 0xa3, 0xd8, 0xab, 0x72, 0x8c, 0x9f, 0x20, 0x28,
+	0x29, 0xe2, 0x6f, 0x3b, 0x4e, 0x0a, 0x87, 0x4c, 0xb9, 0xad, 0x6d, 0xcd, 0xe4, 0x23, 0x38, 0xdc,
+	0xe0, 0x1c, 0x36, 0xf0, 0xb5, 0x69, 0x38, 0xe0, 0x5f, 0x10, 0x2c, 0xaa, 0x34, 0x11, 0x4e, 0xad,
+	0x5f, 0x86, 0xfa, 0xd3, 0xde, 0x98, 0xcd, 0x49, 0x20, 0x7e, 0x87, 0x23, 0xbe, 0x83, 0x6f, 0xc5,
+	0x11, 0x0f, 0xa5, 0xd8, 0xa4, 0xb2, 0xff, 0x84, 0x60, 0x49, 0x95, 0x21, 0xa8, 0x7b, 0x6a, 0x0d,
+	0x67, 0xe7, 0x31, 0x41, 0x8a, 0xea, 0xaf, 0x71, 0x1e, 0x15, 0xfc, 0xea, 0x54, 0x3c, 0xf0, 0x63,
+	0x04, 0xe7, 0x85, 0xb6, 0xc1, 0x1b, 0x69, 0x85, 0x1b, 0x57, 0x64, 0x5a, 0x65, 0xe2, 0xbd, 0x49,
+	0x53, 0x20, 0x84, 0x93, 0x71, 0x14, 0x89, 0xcd, 0x63, 0xfc, 0x09, 0x80, 0x08, 0x10, 0x94, 0x6c,
+	0x23, 0x8d, 0xfd, 0x54, 0x60, 0x92, 0x1a, 0x4f, 0xbf, 0xc2, 0xc1, 0xac, 0xe0, 0xa5, 0x14, 0x30,
+	0xf8, 0x6b, 0x04, 0x10, 0xe9, 0x06, 0x5c, 0x4d, 0x1d, 0xa3, 0xb8, 0xf2, 0xd1, 0x6a, 0xd3, 0x5c,
+	0x15, 0x30, 0x0c, 0x0e, 0xa3, 0x8a, 0x2b, 0x89, 0xfe, 0x84, 0xda, 0xce, 0x1c, 0xf8, 0x5d, 0xe3,
+	0x68, 0x24, 0xf4, 0x8e, 0xf1, 0xe7, 0x08, 0x5e, 0x8a, 0xe2, 0x04, 0xa5, 0xa9, 0xa6, 0x0e, 0xc6,
+	0x94, 0xc8, 0x94, 0xc2, 0x4a, 0x5f, 0xe7, 0xc8, 0xd6, 0xf0, 0x6a, 0x06, 0x32, 0xfe, 0x3a, 0x2a,
+	0x56, 0x6a, 0xfa, 0xeb, 0x98, 0x2e, 0x1c, 0xb4, 0xad, 0x99, 0x7c, 0x26, 0xbd, 0x8e, 0x62, 0x8f,
+	0x4f, 0xf3, 0x3a, 0x2a, 0xe2, 0x67, 0xbe, 0x8e, 0x33, 0x73, 0xc8, 0x56, 0x30, 0xe9, 0xdf, 0x85,
+	0x8a, 0x03, 0xfe, 0x06, 0xc1, 0x8b, 0xf2, 0xea, 0xc6, 0xd7, 0x95, 0x39, 0x15, 0x0a, 0x43, 0xab,
+	0x4e, 0x71, 0x53, 0x60, 0xda, 0xe4, 0x98, 0x6e, 0xe0, 0x5a, 0x1c, 0x13, 0xdf, 0xf0, 0xfb, 0x2c,
+	0xbc, 0x6e, 0x1c, 0x45, 0x8a, 0xe5, 0x78, 0xe7, 0xf5, 0xa7, 0x27, 0x65, 0xf4, 0xec, 0xa4, 0x8c,
+	0xfe, 0x3a, 0x29, 0xa3, 0x2f, 0x4f, 0xcb, 0x73, 0xcf, 0x4e, 0xcb, 0x73, 0x7f, 0x9c, 0x96, 0xe7,
+	0x3e, 0x2c, 0x05, 0x41, 0x3e, 0x96, 0xc3, 0xf8, 0x8f, 0xfa, 0x84, 0xb5, 0xe6, 0xf9, 0xbf, 0xca,
+	0xb6, 0xfe, 0x1d, 0x00, 0x6d, 0x67, 0x9c, 0x53, 0x06, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ProfileVerification items.
+	ProfileVerification(ctx context.Context, in *QueryGetProfileVerificationRequest, opts ...grpc.CallOption) (*QueryGetProfileVerificationResponse, error)
+	ProfileVerificationAll(ctx context.Context, in *QueryAllProfileVerificationRequest, opts ...grpc.CallOption) (*QueryAllProfileVerificationResponse, error)
+	// Queries a list of DocumentVerification items.
+	DocumentVerification(ctx context.Context, in *QueryGetDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryGetDocumentVerificationResponse, error)
+	DocumentVerificationAll(ctx context.Context, in *QueryAllDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryAllDocumentVerificationResponse, error)
+	// Queries a list of Message items.
+	Message(ctx context.Context, in *QueryGetMessageRequest, opts ...grpc.CallOption) (*QueryGetMessageResponse, error)
+	MessageAll(ctx context.Context, in *QueryAllMessageRequest, opts ...grpc.CallOption) (*QueryAllMessageResponse, error)
+	// Queries a list of DeviceAuth items.
+	DeviceAuth(ctx context.Context, in *QueryGetDeviceAuthRequest, opts ...grpc.CallOption) (*QueryGetDeviceAuthResponse, error)
+	DeviceAuthAll(ctx context.Context, in *QueryAllDeviceAuthRequest, opts ...grpc.CallOption) (*QueryAllDeviceAuthResponse, error)
+	// Queries a list of ServiceVerification items.
+	ServiceVerification(ctx context.Context, in *QueryGetServiceVerificationRequest, opts ...grpc.CallOption) (*QueryGetServiceVerificationResponse, error)
+	ServiceVerificationAll(ctx context.Context, in *QueryAllServiceVerificationRequest, opts ...grpc.CallOption) (*QueryAllServiceVerificationResponse, error)
+	// Queries the video signals exchanged in a session.
+	VideoSignals(ctx context.Context, in *QueryVideoSignalsRequest, opts ...grpc.CallOption) (*QueryVideoSignalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileVerification(ctx context.Context, in *QueryGetProfileVerificationRequest, opts ...grpc.CallOption) (*QueryGetProfileVerificationResponse, error) {
+	out := new(QueryGetProfileVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/ProfileVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileVerificationAll(ctx context.Context, in *QueryAllProfileVerificationRequest, opts ...grpc.CallOption) (*QueryAllProfileVerificationResponse, error) {
+	out := new(QueryAllProfileVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/ProfileVerificationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentVerification(ctx context.Context, in *QueryGetDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryGetDocumentVerificationResponse, error) {
+	out := new(QueryGetDocumentVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/DocumentVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DocumentVerificationAll(ctx context.Context, in *QueryAllDocumentVerificationRequest, opts ...grpc.CallOption) (*QueryAllDocumentVerificationResponse, error) {
+	out := new(QueryAllDocumentVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/DocumentVerificationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Message(ctx context.Context, in *QueryGetMessageRequest, opts ...grpc.CallOption) (*QueryGetMessageResponse, error) {
+	out := new(QueryGetMessageResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/Message", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MessageAll(ctx context.Context, in *QueryAllMessageRequest, opts ...grpc.CallOption) (*QueryAllMessageResponse, error) {
+	out := new(QueryAllMessageResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/MessageAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviceAuth(ctx context.Context, in *QueryGetDeviceAuthRequest, opts ...grpc.CallOption) (*QueryGetDeviceAuthResponse, error) {
+	out := new(QueryGetDeviceAuthResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/DeviceAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeviceAuthAll(ctx context.Context, in *QueryAllDeviceAuthRequest, opts ...grpc.CallOption) (*QueryAllDeviceAuthResponse, error) {
+	out := new(QueryAllDeviceAuthResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/DeviceAuthAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceVerification(ctx context.Context, in *QueryGetServiceVerificationRequest, opts ...grpc.CallOption) (*QueryGetServiceVerificationResponse, error) {
+	out := new(QueryGetServiceVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/ServiceVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceVerificationAll(ctx context.Context, in *QueryAllServiceVerificationRequest, opts ...grpc.CallOption) (*QueryAllServiceVerificationResponse, error) {
+	out := new(QueryAllServiceVerificationResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/ServiceVerificationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VideoSignals(ctx context.Context, in *QueryVideoSignalsRequest, opts ...grpc.CallOption) (*QueryVideoSignalsResponse, error) {
+	out := new(QueryVideoSignalsResponse)
+	err := c.cc.Invoke(ctx, "/hub.crosschain.Query/VideoSignals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ProfileVerification items.
+	ProfileVerification(context.Context, *QueryGetProfileVerificationRequest) (*QueryGetProfileVerificationResponse, error)
+	ProfileVerificationAll(context.Context, *QueryAllProfileVerificationRequest) (*QueryAllProfileVerificationResponse, error)
+	// Queries a list of DocumentVerification items.
+	DocumentVerification(context.Context, *QueryGetDocumentVerificationRequest) (*QueryGetDocumentVerificationResponse, error)
+	DocumentVerificationAll(context.Context, *QueryAllDocumentVerificationRequest) (*QueryAllDocumentVerificationResponse, error)
+	// Queries a list of Message items.
+	Message(context.Context, *QueryGetMessageRequest) (*QueryGetMessageResponse, error)
+	MessageAll(context.Context, *QueryAllMessageRequest) (*QueryAllMessageResponse, error)
+	// Queries a list of DeviceAuth items.
+	DeviceAuth(context.Context, *QueryGetDeviceAuthRequest) (*QueryGetDeviceAuthResponse, error)
+	DeviceAuthAll(context.Context, *QueryAllDeviceAuthRequest) (*QueryAllDeviceAuthResponse, error)
+	// Queries a list of ServiceVerification items.
+	ServiceVerification(context.Context, *QueryGetServiceVerificationRequest) (*QueryGetServiceVerificationResponse, error)
+	ServiceVerificationAll(context.Context, *QueryAllServiceVerificationRequest) (*QueryAllServiceVerificationResponse, error)
+	// Queries the video signals exchanged in a session.
+	VideoSignals(context.Context, *QueryVideoSignalsRequest) (*QueryVideoSignalsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ProfileVerification(ctx context.Context, req *QueryGetProfileVerificationRequest) (*QueryGetProfileVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileVerification not implemented")
+}
+func (*UnimplementedQueryServer) ProfileVerificationAll(ctx context.Context, req *QueryAllProfileVerificationRequest) (*QueryAllProfileVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileVerificationAll not implemented")
+}
+func (*UnimplementedQueryServer) DocumentVerification(ctx context.Context, req *QueryGetDocumentVerificationRequest) (*QueryGetDocumentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentVerification not implemented")
+}
+func (*UnimplementedQueryServer) DocumentVerificationAll(ctx context.Context, req *QueryAllDocumentVerificationRequest) (*QueryAllDocumentVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DocumentVerificationAll not implemented")
+}
+func (*UnimplementedQueryServer) Message(ctx context.Context, req *QueryGetMessageRequest) (*QueryGetMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Message not implemented")
+}
+func (*UnimplementedQueryServer) MessageAll(ctx context.Context, req *QueryAllMessageRequest) (*QueryAllMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageAll not implemented")
+}
+func (*UnimplementedQueryServer) DeviceAuth(ctx context.Context, req *QueryGetDeviceAuthRequest) (*QueryGetDeviceAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAuth not implemented")
+}
+func (*UnimplementedQueryServer) DeviceAuthAll(ctx context.Context, req *QueryAllDeviceAuthRequest) (*QueryAllDeviceAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceAuthAll not implemented")
+}
+func (*UnimplementedQueryServer) ServiceVerification(ctx context.Context, req *QueryGetServiceVerificationRequest) (*QueryGetServiceVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceVerification not implemented")
+}
+func (*UnimplementedQueryServer) ServiceVerificationAll(ctx context.Context, req *QueryAllServiceVerificationRequest) (*QueryAllServiceVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceVerificationAll not implemented")
+}
+func (*UnimplementedQueryServer) VideoSignals(ctx context.Context, req *QueryVideoSignalsRequest) (*QueryVideoSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VideoSignals not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProfileVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/ProfileVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileVerification(ctx, req.(*QueryGetProfileVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProfileVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/ProfileVerificationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileVerificationAll(ctx, req.(*QueryAllProfileVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDocumentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/DocumentVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentVerification(ctx, req.(*QueryGetDocumentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DocumentVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDocumentVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DocumentVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/DocumentVerificationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DocumentVerificationAll(ctx, req.(*QueryAllDocumentVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Message_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Message(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/Message",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Message(ctx, req.(*QueryGetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MessageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/MessageAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageAll(ctx, req.(*QueryAllMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviceAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDeviceAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviceAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/DeviceAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviceAuth(ctx, req.(*QueryGetDeviceAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeviceAuthAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDeviceAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeviceAuthAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/DeviceAuthAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeviceAuthAll(ctx, req.(*QueryAllDeviceAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetServiceVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/ServiceVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceVerification(ctx, req.(*QueryGetServiceVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceVerificationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllServiceVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceVerificationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/ServiceVerificationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceVerificationAll(ctx, req.(*QueryAllServiceVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VideoSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVideoSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VideoSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.crosschain.Query/VideoSignals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VideoSignals(ctx, req.(*QueryVideoSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hub.crosschain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ProfileVerification",
+			Handler:    _Query_ProfileVerification_Handler,
+		},
+		{
+			MethodName: "ProfileVerificationAll",
+			Handler:    _Query_ProfileVerificationAll_Handler,
+		},
+		{
+			MethodName: "DocumentVerification",
+			Handler:    _Query_DocumentVerification_Handler,
+		},
+		{
+			MethodName: "DocumentVerificationAll",
+			Handler:    _Query_DocumentVerificationAll_Handler,
+		},
+		{
+			MethodName: "Message",
+			Handler:    _Query_Message_Handler,
+		},
+		{
+			MethodName: "MessageAll",
+			Handler:    _Query_MessageAll_Handler,
+		},
+		{
+			MethodName: "DeviceAuth",
+			Handler:    _Query_DeviceAuth_Handler,
+		},
+		{
+			MethodName: "DeviceAuthAll",
+			Handler:    _Query_DeviceAuthAll_Handler,
+		},
+		{
+			MethodName: "ServiceVerification",
+			Handler:    _Query_ServiceVerification_Handler,
+		},
+		{
+			MethodName: "ServiceVerificationAll",
+			Handler:    _Query_ServiceVerificationAll_Handler,
+		},
+		{
+			MethodName: "VideoSignals",
+			Handler:    _Query_VideoSignals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hub/crosschain/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProfileVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProfileVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProfileVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProfileVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProfileVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProfileVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProfileVerification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProfileVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProfileVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProfileVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProfileVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProfileVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProfileVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProfileVerification) > 0 {
+		for iNdEx := len(m.ProfileVerification) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfileVerification[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDocumentVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDocumentVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDocumentVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDocumentVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDocumentVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDocumentVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DocumentVerification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDocumentVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDocumentVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDocumentVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDocumentVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDocumentVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDocumentVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DocumentVerification) > 0 {
+		for iNdEx := len(m.DocumentVerification) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentVerification[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Message) > 0 {
+		for iNdEx := len(m.Message) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Message[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDeviceAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeviceAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeviceAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDeviceAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDeviceAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDeviceAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeviceAuth.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDeviceAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDeviceAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDeviceAuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDeviceAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDeviceAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDeviceAuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeviceAuth) > 0 {
+		for iNdEx := len(m.DeviceAuth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeviceAuth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetServiceVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetServiceVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetServiceVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetServiceVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetServiceVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetServiceVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ServiceVerification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllServiceVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllServiceVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllServiceVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllServiceVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllServiceVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllServiceVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServiceVerification) > 0 {
+		for iNdEx := len(m.ServiceVerification) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceVerification[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVideoSignalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVideoSignalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVideoSignalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVideoSignalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVideoSignalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVideoSignalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VideoSignal) > 0 {
+		for iNdEx := len(m.VideoSignal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VideoSignal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProfileVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProfileVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProfileVerification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProfileVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProfileVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProfileVerification) > 0 {
+		for _, e := range m.ProfileVerification {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDocumentVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDocumentVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DocumentVerification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDocumentVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDocumentVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DocumentVerification) > 0 {
+		for _, e := range m.DocumentVerification {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Message.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		for _, e := range m.Message {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDeviceAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDeviceAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeviceAuth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDeviceAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDeviceAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeviceAuth) > 0 {
+		for _, e := range m.DeviceAuth {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetServiceVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetServiceVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ServiceVerification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllServiceVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllServiceVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ServiceVerification) > 0 {
+		for _, e := range m.ServiceVerification {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVideoSignalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVideoSignalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VideoSignal) > 0 {
+		for _, e := range m.VideoSignal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProfileVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProfileVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProfileVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProfileVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProfileVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProfileVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProfileVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProfileVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProfileVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProfileVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProfileVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProfileVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProfileVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileVerification = append(m.ProfileVerification, ProfileVerificationRecord{})
+			if err := m.ProfileVerification[len(m.ProfileVerification)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDocumentVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDocumentVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDocumentVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDocumentVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDocumentVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDocumentVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DocumentVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDocumentVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDocumentVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDocumentVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDocumentVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDocumentVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDocumentVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentVerification = append(m.DocumentVerification, DocumentVerificationRecord{})
+			if err := m.DocumentVerification[len(m.DocumentVerification)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message, MessageRecord{})
+			if err := m.Message[len(m.Message)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDeviceAuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDeviceAuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDeviceAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDeviceAuthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDeviceAuthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDeviceAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviceAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDeviceAuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDeviceAuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDeviceAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDeviceAuthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDeviceAuthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDeviceAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceAuth = append(m.DeviceAuth, DeviceAuthRecord{})
+			if err := m.DeviceAuth[len(m.DeviceAuth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetServiceVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetServiceVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetServiceVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetServiceVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetServiceVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetServiceVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ServiceVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllServiceVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllServiceVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllServiceVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllServiceVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllServiceVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllServiceVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceVerification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceVerification = append(m.ServiceVerification, ServiceVerificationRecord{})
+			if err := m.ServiceVerification[len(m.ServiceVerification)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVideoSignalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVideoSignalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVideoSignalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVideoSignalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVideoSignalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVideoSignalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoSignal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoSignal = append(m.VideoSignal, VideoSignalRecord{})
+			if err := m.VideoSignal[len(m.VideoSignal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
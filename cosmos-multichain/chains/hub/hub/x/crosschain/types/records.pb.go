@@ -0,0 +1,2832 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/crosschain/records.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProfileVerificationRecord stores a CrossChainService/VerifyUserProfile call.
+type ProfileVerificationRecord struct {
+	VerificationId      string             `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator             string             `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Profile             *UserProfile       `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	RequiredCredentials []string           `protobuf:"bytes,4,rep,name=required_credentials,json=requiredCredentials,proto3" json:"required_credentials,omitempty"`
+	VerifiedCredentials []string           `protobuf:"bytes,5,rep,name=verified_credentials,json=verifiedCredentials,proto3" json:"verified_credentials,omitempty"`
+	Status              VerificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage        string             `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt           int64              `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *ProfileVerificationRecord) Reset()         { *m = ProfileVerificationRecord{} }
+func (m *ProfileVerificationRecord) String() string { return proto.CompactTextString(m) }
+func (*ProfileVerificationRecord) ProtoMessage()    {}
+func (*ProfileVerificationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{0}
+}
+func (m *ProfileVerificationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileVerificationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileVerificationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileVerificationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileVerificationRecord.Merge(m, src)
+}
+func (m *ProfileVerificationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileVerificationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileVerificationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileVerificationRecord proto.InternalMessageInfo
+
+func (m *ProfileVerificationRecord) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *ProfileVerificationRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ProfileVerificationRecord) GetProfile() *UserProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+func (m *ProfileVerificationRecord) GetRequiredCredentials() []string {
+	if m != nil {
+		return m.RequiredCredentials
+	}
+	return nil
+}
+
+func (m *ProfileVerificationRecord) GetVerifiedCredentials() []string {
+	if m != nil {
+		return m.VerifiedCredentials
+	}
+	return nil
+}
+
+func (m *ProfileVerificationRecord) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *ProfileVerificationRecord) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *ProfileVerificationRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// DocumentVerificationRecord stores a CrossChainService/VerifyDocument call.
+type DocumentVerificationRecord struct {
+	VerificationId         string             `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator                string             `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Document               *Document          `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"`
+	RequiredVerifications  []string           `protobuf:"bytes,4,rep,name=required_verifications,json=requiredVerifications,proto3" json:"required_verifications,omitempty"`
+	CompletedVerifications []string           `protobuf:"bytes,5,rep,name=completed_verifications,json=completedVerifications,proto3" json:"completed_verifications,omitempty"`
+	Status                 VerificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage           string             `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt              int64              `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *DocumentVerificationRecord) Reset()         { *m = DocumentVerificationRecord{} }
+func (m *DocumentVerificationRecord) String() string { return proto.CompactTextString(m) }
+func (*DocumentVerificationRecord) ProtoMessage()    {}
+func (*DocumentVerificationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{1}
+}
+func (m *DocumentVerificationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentVerificationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentVerificationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DocumentVerificationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentVerificationRecord.Merge(m, src)
+}
+func (m *DocumentVerificationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentVerificationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentVerificationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentVerificationRecord proto.InternalMessageInfo
+
+func (m *DocumentVerificationRecord) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *DocumentVerificationRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DocumentVerificationRecord) GetDocument() *Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *DocumentVerificationRecord) GetRequiredVerifications() []string {
+	if m != nil {
+		return m.RequiredVerifications
+	}
+	return nil
+}
+
+func (m *DocumentVerificationRecord) GetCompletedVerifications() []string {
+	if m != nil {
+		return m.CompletedVerifications
+	}
+	return nil
+}
+
+func (m *DocumentVerificationRecord) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *DocumentVerificationRecord) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *DocumentVerificationRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// MessageRecord stores a CrossChainService/SendMessage call and its delivery outcome.
+type MessageRecord struct {
+	Creator                     string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Message                     *RealTimeMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RequireDeliveryConfirmation bool             `protobuf:"varint,3,opt,name=require_delivery_confirmation,json=requireDeliveryConfirmation,proto3" json:"require_delivery_confirmation,omitempty"`
+	DeliveredTo                 []string         `protobuf:"bytes,4,rep,name=delivered_to,json=deliveredTo,proto3" json:"delivered_to,omitempty"`
+	FailedDeliveries            []string         `protobuf:"bytes,5,rep,name=failed_deliveries,json=failedDeliveries,proto3" json:"failed_deliveries,omitempty"`
+	CreatedAt                   int64            `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *MessageRecord) Reset()         { *m = MessageRecord{} }
+func (m *MessageRecord) String() string { return proto.CompactTextString(m) }
+func (*MessageRecord) ProtoMessage()    {}
+func (*MessageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{2}
+}
+func (m *MessageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRecord.Merge(m, src)
+}
+func (m *MessageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRecord proto.InternalMessageInfo
+
+func (m *MessageRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetMessage() *RealTimeMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *MessageRecord) GetRequireDeliveryConfirmation() bool {
+	if m != nil {
+		return m.RequireDeliveryConfirmation
+	}
+	return false
+}
+
+func (m *MessageRecord) GetDeliveredTo() []string {
+	if m != nil {
+		return m.DeliveredTo
+	}
+	return nil
+}
+
+func (m *MessageRecord) GetFailedDeliveries() []string {
+	if m != nil {
+		return m.FailedDeliveries
+	}
+	return nil
+}
+
+func (m *MessageRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// VideoSignalRecord stores a CrossChainService/SignalVideo call.
+type VideoSignalRecord struct {
+	SignalId     string       `protobuf:"bytes,1,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	Creator      string       `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Signal       *VideoSignal `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Acknowledged bool         `protobuf:"varint,4,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	CreatedAt    int64        `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *VideoSignalRecord) Reset()         { *m = VideoSignalRecord{} }
+func (m *VideoSignalRecord) String() string { return proto.CompactTextString(m) }
+func (*VideoSignalRecord) ProtoMessage()    {}
+func (*VideoSignalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{3}
+}
+func (m *VideoSignalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoSignalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoSignalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VideoSignalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoSignalRecord.Merge(m, src)
+}
+func (m *VideoSignalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoSignalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoSignalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoSignalRecord proto.InternalMessageInfo
+
+func (m *VideoSignalRecord) GetSignalId() string {
+	if m != nil {
+		return m.SignalId
+	}
+	return ""
+}
+
+func (m *VideoSignalRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *VideoSignalRecord) GetSignal() *VideoSignal {
+	if m != nil {
+		return m.Signal
+	}
+	return nil
+}
+
+func (m *VideoSignalRecord) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
+}
+
+func (m *VideoSignalRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// DeviceAuthRecord stores the latest CrossChainService/AuthenticateDevice
+// outcome for a device.
+type DeviceAuthRecord struct {
+	Creator         string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Auth            *DeviceAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	RequiredFactors []string    `protobuf:"bytes,3,rep,name=required_factors,json=requiredFactors,proto3" json:"required_factors,omitempty"`
+	AuthToken       string      `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	TokenExpiry     int64       `protobuf:"varint,5,opt,name=token_expiry,json=tokenExpiry,proto3" json:"token_expiry,omitempty"`
+}
+
+func (m *DeviceAuthRecord) Reset()         { *m = DeviceAuthRecord{} }
+func (m *DeviceAuthRecord) String() string { return proto.CompactTextString(m) }
+func (*DeviceAuthRecord) ProtoMessage()    {}
+func (*DeviceAuthRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{4}
+}
+func (m *DeviceAuthRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceAuthRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceAuthRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceAuthRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceAuthRecord.Merge(m, src)
+}
+func (m *DeviceAuthRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceAuthRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceAuthRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceAuthRecord proto.InternalMessageInfo
+
+func (m *DeviceAuthRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DeviceAuthRecord) GetAuth() *DeviceAuth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *DeviceAuthRecord) GetRequiredFactors() []string {
+	if m != nil {
+		return m.RequiredFactors
+	}
+	return nil
+}
+
+func (m *DeviceAuthRecord) GetAuthToken() string {
+	if m != nil {
+		return m.AuthToken
+	}
+	return ""
+}
+
+func (m *DeviceAuthRecord) GetTokenExpiry() int64 {
+	if m != nil {
+		return m.TokenExpiry
+	}
+	return 0
+}
+
+// ServiceVerificationRecord stores a CrossChainService/VerifyService call.
+type ServiceVerificationRecord struct {
+	VerificationId       string               `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Creator              string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Service              *ServiceVerification `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	RequiredCapabilities []string             `protobuf:"bytes,4,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	VerifiedCapabilities []string             `protobuf:"bytes,5,rep,name=verified_capabilities,json=verifiedCapabilities,proto3" json:"verified_capabilities,omitempty"`
+	Status               VerificationStatus   `protobuf:"varint,6,opt,name=status,proto3,enum=bloqz.ibc.v1.VerificationStatus" json:"status,omitempty"`
+	ErrorMessage         string               `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *ServiceVerificationRecord) Reset()         { *m = ServiceVerificationRecord{} }
+func (m *ServiceVerificationRecord) String() string { return proto.CompactTextString(m) }
+func (*ServiceVerificationRecord) ProtoMessage()    {}
+func (*ServiceVerificationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bba40121c634c7f7, []int{5}
+}
+func (m *ServiceVerificationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceVerificationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceVerificationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceVerificationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceVerificationRecord.Merge(m, src)
+}
+func (m *ServiceVerificationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceVerificationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceVerificationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceVerificationRecord proto.InternalMessageInfo
+
+func (m *ServiceVerificationRecord) GetVerificationId() string {
+	if m != nil {
+		return m.VerificationId
+	}
+	return ""
+}
+
+func (m *ServiceVerificationRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ServiceVerificationRecord) GetService() *ServiceVerification {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *ServiceVerificationRecord) GetRequiredCapabilities() []string {
+	if m != nil {
+		return m.RequiredCapabilities
+	}
+	return nil
+}
+
+func (m *ServiceVerificationRecord) GetVerifiedCapabilities() []string {
+	if m != nil {
+		return m.VerifiedCapabilities
+	}
+	return nil
+}
+
+func (m *ServiceVerificationRecord) GetStatus() VerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VerificationStatus_STATUS_UNSPECIFIED
+}
+
+func (m *ServiceVerificationRecord) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ProfileVerificationRecord)(nil), "hub.crosschain.ProfileVerificationRecord")
+	proto.RegisterType((*DocumentVerificationRecord)(nil), "hub.crosschain.DocumentVerificationRecord")
+	proto.RegisterType((*MessageRecord)(nil), "hub.crosschain.MessageRecord")
+	proto.RegisterType((*VideoSignalRecord)(nil), "hub.crosschain.VideoSignalRecord")
+	proto.RegisterType((*DeviceAuthRecord)(nil), "hub.crosschain.DeviceAuthRecord")
+	proto.RegisterType((*ServiceVerificationRecord)(nil), "hub.crosschain.ServiceVerificationRecord")
+}
+
+func init() { proto.RegisterFile("hub/crosschain/records.proto", fileDescriptor_bba40121c634c7f7) }
+
+var fileDescriptor_bba40121c634c7f7 = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0xad, 0x93, 0x36, 0x1f, 0xb7, 0xdf, 0xf3, 0x5e, 0xf3, 0xdc, 0xf6, 0x35, 0x4a, 0xf3, 0x16,
+	0x2f, 0x08, 0x94, 0x90, 0x56, 0xa8, 0x48, 0xac, 0xda, 0x06, 0x24, 0x16, 0x48, 0xc8, 0x2d, 0x5d,
+	0xb0, 0xb1, 0x26, 0xf6, 0x4d, 0x33, 0xaa, 0xe3, 0x49, 0xc7, 0x93, 0xd0, 0xb2, 0xe3, 0x1f, 0xb0,
+	0xe1, 0xff, 0xb0, 0x83, 0x65, 0x97, 0x2c, 0x51, 0xcb, 0x7f, 0x60, 0x8b, 0x3c, 0x1e, 0x3b, 0x76,
+	0xa8, 0x60, 0x55, 0xb1, 0xf4, 0xb9, 0xe7, 0xcc, 0xbd, 0xf7, 0xdc, 0xf1, 0x1d, 0xf8, 0xb7, 0x3f,
+	0xea, 0xb6, 0x1c, 0xc1, 0x83, 0xc0, 0xe9, 0x53, 0xe6, 0xb7, 0x04, 0x3a, 0x5c, 0xb8, 0x41, 0x73,
+	0x28, 0xb8, 0xe4, 0x64, 0xa9, 0x3f, 0xea, 0x36, 0x27, 0xd1, 0x0d, 0xb3, 0xeb, 0xf1, 0xf3, 0xb7,
+	0x2d, 0xd6, 0x75, 0x5a, 0xe3, 0x76, 0x4b, 0x5e, 0x0e, 0x51, 0x33, 0xeb, 0xdf, 0x73, 0xb0, 0xfe,
+	0x52, 0xf0, 0x1e, 0xf3, 0xf0, 0x04, 0x05, 0xeb, 0x31, 0x87, 0x4a, 0xc6, 0x7d, 0x4b, 0x1d, 0x47,
+	0xfe, 0x87, 0xe5, 0x71, 0x0a, 0xb5, 0x99, 0x6b, 0x1a, 0x35, 0xa3, 0x51, 0xb6, 0x96, 0xd2, 0xf0,
+	0x73, 0x97, 0x98, 0x50, 0x74, 0x04, 0x52, 0xc9, 0x85, 0x99, 0x53, 0x84, 0xf8, 0x93, 0xec, 0x42,
+	0x71, 0x18, 0x9d, 0x6f, 0xe6, 0x6b, 0x46, 0x63, 0x7e, 0x67, 0xbd, 0xa9, 0x8a, 0x69, 0xb2, 0xae,
+	0xd3, 0x1c, 0xb7, 0x9b, 0xaf, 0x02, 0x14, 0xba, 0x00, 0x2b, 0x66, 0x92, 0x36, 0xfc, 0x2d, 0xf0,
+	0x7c, 0xc4, 0x04, 0xba, 0xb6, 0x23, 0xd0, 0x45, 0x5f, 0x32, 0xea, 0x05, 0xe6, 0x6c, 0x2d, 0xdf,
+	0x28, 0x5b, 0x7f, 0xc5, 0xb1, 0xc3, 0x49, 0x28, 0x94, 0x44, 0x35, 0x4d, 0x49, 0xe6, 0x22, 0x49,
+	0x1c, 0x4b, 0x4b, 0x1e, 0x43, 0x21, 0x90, 0x54, 0x8e, 0x02, 0xb3, 0x50, 0x33, 0x1a, 0x4b, 0x3b,
+	0xb5, 0x6c, 0x65, 0x69, 0x3f, 0x8e, 0x14, 0xcf, 0xd2, 0x7c, 0xf2, 0x1f, 0x2c, 0xa2, 0x10, 0x5c,
+	0xd8, 0x03, 0x0c, 0x02, 0x7a, 0x8a, 0x66, 0x51, 0x35, 0xbd, 0xa0, 0xc0, 0x17, 0x11, 0x46, 0xb6,
+	0x00, 0x94, 0x09, 0xe8, 0xda, 0x54, 0x9a, 0xa5, 0x9a, 0xd1, 0xc8, 0x5b, 0x65, 0x8d, 0xec, 0xcb,
+	0xfa, 0xbb, 0x3c, 0x6c, 0x74, 0xb8, 0x33, 0x1a, 0xa0, 0x2f, 0xef, 0xc6, 0xfa, 0x1d, 0x28, 0xb9,
+	0x3a, 0x81, 0xf6, 0xbe, 0x92, 0xed, 0x30, 0x4e, 0x6f, 0x25, 0x3c, 0xf2, 0x08, 0x2a, 0x89, 0xf3,
+	0xe9, 0x44, 0xb1, 0xf7, 0x6b, 0x71, 0x34, 0x5d, 0x72, 0x40, 0xf6, 0xe0, 0x1f, 0x87, 0x0f, 0x86,
+	0x1e, 0xca, 0x9f, 0x74, 0xd1, 0x00, 0x2a, 0x49, 0x38, 0x2b, 0xfc, 0xb3, 0x33, 0xf8, 0x90, 0x83,
+	0x45, 0x4d, 0xd5, 0xb6, 0xa7, 0xdc, 0x34, 0xb2, 0x6e, 0xee, 0x41, 0x31, 0xce, 0x94, 0x53, 0x66,
+	0x6e, 0x65, 0x4b, 0xb5, 0x90, 0x7a, 0xc7, 0x6c, 0x80, 0xf1, 0x79, 0x31, 0x9b, 0x1c, 0xc0, 0x96,
+	0x36, 0xcd, 0x76, 0xd1, 0x63, 0x63, 0x14, 0x97, 0xb6, 0xc3, 0xfd, 0x1e, 0x13, 0x03, 0xd5, 0x97,
+	0x9a, 0x4d, 0xc9, 0xda, 0xd4, 0xa4, 0x8e, 0xe6, 0x1c, 0xa6, 0x28, 0x64, 0x1b, 0x16, 0xb4, 0x16,
+	0x5d, 0x5b, 0x72, 0x3d, 0x8c, 0xf9, 0x04, 0x3b, 0xe6, 0xe4, 0x3e, 0xac, 0xf6, 0x28, 0xf3, 0xd0,
+	0x8d, 0xb3, 0x30, 0x8c, 0xcd, 0x5f, 0x89, 0x02, 0x9d, 0x04, 0x9f, 0xf2, 0xa5, 0x30, 0xed, 0xcb,
+	0x47, 0x03, 0x56, 0x4f, 0x98, 0x8b, 0xfc, 0x88, 0x9d, 0xfa, 0xd4, 0xd3, 0xde, 0x6c, 0x42, 0x39,
+	0x50, 0xdf, 0x93, 0xcb, 0x58, 0x8a, 0x80, 0x5f, 0x5e, 0xc3, 0x36, 0x14, 0x22, 0xd6, 0xed, 0x0b,
+	0x20, 0x9d, 0x47, 0x13, 0x49, 0x1d, 0x16, 0xa8, 0x73, 0xe6, 0xf3, 0x37, 0x1e, 0xba, 0xa7, 0xe8,
+	0x9a, 0xb3, 0xca, 0xa1, 0x0c, 0x36, 0xd5, 0xc2, 0xdc, 0x74, 0x0b, 0x9f, 0x0c, 0x58, 0xe9, 0xe0,
+	0x98, 0x39, 0xb8, 0x3f, 0x92, 0xfd, 0xdf, 0x4e, 0xf7, 0x01, 0xcc, 0xd2, 0x91, 0xec, 0xeb, 0xd1,
+	0x9a, 0x53, 0xff, 0xc9, 0xe4, 0x1c, 0xc5, 0x22, 0xf7, 0x60, 0x25, 0xf9, 0x4b, 0x7a, 0xd4, 0x91,
+	0x5c, 0x04, 0x66, 0x5e, 0x59, 0xbd, 0x1c, 0xe3, 0xcf, 0x22, 0x38, 0x2c, 0x33, 0x94, 0xd8, 0x92,
+	0x9f, 0xa1, 0xaf, 0x1a, 0x29, 0x5b, 0xe5, 0x10, 0x39, 0x0e, 0x81, 0x70, 0xb0, 0x2a, 0x62, 0xe3,
+	0xc5, 0x90, 0x89, 0x4b, 0xdd, 0xc7, 0xbc, 0xc2, 0x9e, 0x2a, 0xa8, 0xfe, 0x2d, 0x07, 0xeb, 0x47,
+	0x28, 0xc2, 0x12, 0xee, 0x66, 0x4f, 0x3c, 0x81, 0x62, 0x10, 0x9d, 0xaf, 0x27, 0xb4, 0x9d, 0x6d,
+	0xff, 0xb6, 0xe4, 0xb1, 0x82, 0xec, 0xc2, 0xda, 0x64, 0x55, 0xd3, 0x21, 0xed, 0x32, 0x8f, 0xc9,
+	0xf0, 0xea, 0x45, 0x57, 0x34, 0xd9, 0xe3, 0x87, 0xa9, 0x58, 0x28, 0x9a, 0x2c, 0xeb, 0xb4, 0x28,
+	0xba, 0xaf, 0xc9, 0x26, 0xcf, 0x88, 0xee, 0x76, 0x55, 0x1c, 0x3c, 0xfc, 0x7c, 0x5d, 0x35, 0xae,
+	0xae, 0xab, 0xc6, 0xd7, 0xeb, 0xaa, 0xf1, 0xfe, 0xa6, 0x3a, 0x73, 0x75, 0x53, 0x9d, 0xf9, 0x72,
+	0x53, 0x9d, 0x79, 0x5d, 0x09, 0xdf, 0xda, 0x8b, 0xf4, 0x6b, 0xab, 0x5e, 0xd0, 0x6e, 0x41, 0x3d,
+	0xa1, 0xbb, 0x3f, 0x06, 0x00, 0x0a, 0x11, 0x26, 0xc0, 0x8c, 0x07, 0x00, 0x00,
+}
+
+func (m *ProfileVerificationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileVerificationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileVerificationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VerifiedCredentials) > 0 {
+		for iNdEx := len(m.VerifiedCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedCredentials[iNdEx])
+			copy(dAtA[i:], m.VerifiedCredentials[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.VerifiedCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RequiredCredentials) > 0 {
+		for iNdEx := len(m.RequiredCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCredentials[iNdEx])
+			copy(dAtA[i:], m.RequiredCredentials[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.RequiredCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentVerificationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentVerificationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentVerificationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CompletedVerifications) > 0 {
+		for iNdEx := len(m.CompletedVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CompletedVerifications[iNdEx])
+			copy(dAtA[i:], m.CompletedVerifications[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.CompletedVerifications[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RequiredVerifications) > 0 {
+		for iNdEx := len(m.RequiredVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredVerifications[iNdEx])
+			copy(dAtA[i:], m.RequiredVerifications[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.RequiredVerifications[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FailedDeliveries) > 0 {
+		for iNdEx := len(m.FailedDeliveries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedDeliveries[iNdEx])
+			copy(dAtA[i:], m.FailedDeliveries[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.FailedDeliveries[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeliveredTo) > 0 {
+		for iNdEx := len(m.DeliveredTo) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeliveredTo[iNdEx])
+			copy(dAtA[i:], m.DeliveredTo[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.DeliveredTo[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RequireDeliveryConfirmation {
+		i--
+		if m.RequireDeliveryConfirmation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoSignalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoSignalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoSignalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Acknowledged {
+		i--
+		if m.Acknowledged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Signal != nil {
+		{
+			size, err := m.Signal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalId) > 0 {
+		i -= len(m.SignalId)
+		copy(dAtA[i:], m.SignalId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.SignalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviceAuthRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceAuthRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceAuthRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenExpiry != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.TokenExpiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuthToken) > 0 {
+		i -= len(m.AuthToken)
+		copy(dAtA[i:], m.AuthToken)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.AuthToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequiredFactors) > 0 {
+		for iNdEx := len(m.RequiredFactors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredFactors[iNdEx])
+			copy(dAtA[i:], m.RequiredFactors[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.RequiredFactors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceVerificationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceVerificationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceVerificationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecords(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for iNdEx := len(m.VerifiedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedCapabilities[iNdEx])
+			copy(dAtA[i:], m.VerifiedCapabilities[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.VerifiedCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RequiredCapabilities) > 0 {
+		for iNdEx := len(m.RequiredCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCapabilities[iNdEx])
+			copy(dAtA[i:], m.RequiredCapabilities[iNdEx])
+			i = encodeVarintRecords(dAtA, i, uint64(len(m.RequiredCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecords(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationId) > 0 {
+		i -= len(m.VerificationId)
+		copy(dAtA[i:], m.VerificationId)
+		i = encodeVarintRecords(dAtA, i, uint64(len(m.VerificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecords(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecords(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProfileVerificationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if len(m.RequiredCredentials) > 0 {
+		for _, s := range m.RequiredCredentials {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if len(m.VerifiedCredentials) > 0 {
+		for _, s := range m.VerifiedCredentials {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecords(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRecords(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *DocumentVerificationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if len(m.RequiredVerifications) > 0 {
+		for _, s := range m.RequiredVerifications {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if len(m.CompletedVerifications) > 0 {
+		for _, s := range m.CompletedVerifications {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecords(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRecords(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *MessageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.RequireDeliveryConfirmation {
+		n += 2
+	}
+	if len(m.DeliveredTo) > 0 {
+		for _, s := range m.DeliveredTo {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if len(m.FailedDeliveries) > 0 {
+		for _, s := range m.FailedDeliveries {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRecords(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *VideoSignalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Signal != nil {
+		l = m.Signal.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Acknowledged {
+		n += 2
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRecords(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *DeviceAuthRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if len(m.RequiredFactors) > 0 {
+		for _, s := range m.RequiredFactors {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	l = len(m.AuthToken)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.TokenExpiry != 0 {
+		n += 1 + sovRecords(uint64(m.TokenExpiry))
+	}
+	return n
+}
+
+func (m *ServiceVerificationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationId)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	if len(m.RequiredCapabilities) > 0 {
+		for _, s := range m.RequiredCapabilities {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for _, s := range m.VerifiedCapabilities {
+			l = len(s)
+			n += 1 + l + sovRecords(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecords(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovRecords(uint64(l))
+	}
+	return n
+}
+
+func sovRecords(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecords(x uint64) (n int) {
+	return sovRecords(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProfileVerificationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileVerificationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &UserProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCredentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredCredentials = append(m.RequiredCredentials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCredentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCredentials = append(m.VerifiedCredentials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocumentVerificationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentVerificationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &Document{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredVerifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredVerifications = append(m.RequiredVerifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedVerifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedVerifications = append(m.CompletedVerifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &RealTimeMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDeliveryConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDeliveryConfirmation = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredTo = append(m.DeliveredTo, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeliveries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeliveries = append(m.FailedDeliveries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoSignalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoSignalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoSignalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signal == nil {
+				m.Signal = &VideoSignal{}
+			}
+			if err := m.Signal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acknowledged = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceAuthRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceAuthRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceAuthRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &DeviceAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFactors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredFactors = append(m.RequiredFactors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpiry", wireType)
+			}
+			m.TokenExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceVerificationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceVerificationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceVerificationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &ServiceVerification{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredCapabilities = append(m.RequiredCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCapabilities = append(m.VerifiedCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecords
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecords(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecords
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecords
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecords
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecords
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecords        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecords          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecords = fmt.Errorf("proto: unexpected end of group")
+)
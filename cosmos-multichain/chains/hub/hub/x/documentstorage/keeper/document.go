@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/documentstorage/types"
+)
+
+// SetDocument set a specific document in the store from its index and
+// refreshes its secondary index entries
+func (k Keeper) SetDocument(ctx context.Context, document types.DocumentRecord) {
+	documentId := document.Document.GetDocumentId()
+	if existing, found := k.GetDocument(ctx, documentId); found {
+		k.removeDocumentIndexes(ctx, existing)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentKeyPrefix))
+	b := k.cdc.MustMarshal(&document)
+	store.Set(types.DocumentKey(
+		documentId,
+	), b)
+
+	k.setDocumentIndexes(ctx, document)
+}
+
+// GetDocument returns a document from its index
+func (k Keeper) GetDocument(
+	ctx context.Context,
+	documentId string,
+) (val types.DocumentRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentKeyPrefix))
+
+	b := store.Get(types.DocumentKey(
+		documentId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDocument removes a document and its secondary index entries from the store
+func (k Keeper) RemoveDocument(
+	ctx context.Context,
+	documentId string,
+) {
+	existing, found := k.GetDocument(ctx, documentId)
+	if !found {
+		return
+	}
+	k.removeDocumentIndexes(ctx, existing)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentKeyPrefix))
+	store.Delete(types.DocumentKey(
+		documentId,
+	))
+}
+
+// GetAllDocument returns all document
+func (k Keeper) GetAllDocument(ctx context.Context) (list []types.DocumentRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DocumentRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// documentIndexEntries lists the (prefix, key) pairs indexing a document.
+func documentIndexEntries(document types.DocumentRecord) [][2][]byte {
+	documentId := document.Document.GetDocumentId()
+	return [][2][]byte{
+		{types.KeyPrefix(types.DocumentOwnerIndexPrefix), types.DocumentIndexKey(document.Owner, documentId)},
+		{types.KeyPrefix(types.DocumentTypeIndexPrefix), types.DocumentIndexKey(document.Document.GetDocumentType(), documentId)},
+		{types.KeyPrefix(types.DocumentIndustryIndexPrefix), types.DocumentIndexKey(document.Industry, documentId)},
+		{types.KeyPrefix(types.DocumentCreationDateIndexPrefix), types.DocumentTimeIndexKey(document.CreatedAt, documentId)},
+		{types.KeyPrefix(types.DocumentExpiryQueuePrefix), types.DocumentTimeIndexKey(document.ExpiresAt, documentId)},
+	}
+}
+
+func (k Keeper) setDocumentIndexes(ctx context.Context, document types.DocumentRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	documentId := []byte(document.Document.GetDocumentId())
+	for _, entry := range documentIndexEntries(document) {
+		prefix.NewStore(storeAdapter, entry[0]).Set(entry[1], documentId)
+	}
+}
+
+func (k Keeper) removeDocumentIndexes(ctx context.Context, document types.DocumentRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, entry := range documentIndexEntries(document) {
+		prefix.NewStore(storeAdapter, entry[0]).Delete(entry[1])
+	}
+}
+
+// PruneExpiredDocuments removes every document whose retention period ended
+// at or before the current block time and returns the pruned IDs
+func (k Keeper) PruneExpiredDocuments(ctx context.Context, now int64) (pruned []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DocumentExpiryQueuePrefix))
+	iterator := queue.Iterator(nil, types.DocumentTimeKey(now+1))
+
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, documentId := range pruned {
+		k.RemoveDocument(ctx, documentId)
+	}
+	return pruned
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	"hub/testutil/sample"
+	"hub/x/documentstorage/keeper"
+	"hub/x/documentstorage/types"
+)
+
+func registerMsg(creator, id, industry string) *types.MsgRegisterDocument {
+	return &types.MsgRegisterDocument{
+		Creator:       creator,
+		DocumentId:    id,
+		DocumentType:  "deed",
+		HashAlgorithm: "sha256",
+		DocumentHash:  make([]byte, 32),
+		Size_:         2048,
+		Industry:      industry,
+	}
+}
+
+func TestRegisterDocument(t *testing.T) {
+	k, ctx := keepertest.DocumentstorageKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	owner := sample.AccAddress()
+
+	res, err := srv.RegisterDocument(ctx, registerMsg(owner, "doc-1", "realestate"))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultRetentionPeriod).Unix(), res.ExpiresAt)
+
+	_, err = srv.RegisterDocument(ctx, registerMsg(owner, "doc-1", "realestate"))
+	require.ErrorIs(t, err, types.ErrDocumentExists)
+
+	big := registerMsg(owner, "doc-2", "realestate")
+	big.Size_ = types.DefaultMaxDocumentSize + 1
+	_, err = srv.RegisterDocument(ctx, big)
+	require.ErrorIs(t, err, types.ErrDocumentTooLarge)
+
+	params := k.GetParams(ctx)
+	params.SupportedHashAlgorithms = []string{"sha512"}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = srv.RegisterDocument(ctx, registerMsg(owner, "doc-3", "realestate"))
+	require.ErrorIs(t, err, types.ErrUnsupportedHashAlgorithm)
+}
+
+func TestDocumentIndexQueries(t *testing.T) {
+	k, ctx := keepertest.DocumentstorageKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	for i, m := range []*types.MsgRegisterDocument{
+		registerMsg(alice, "a1", "finance"),
+		registerMsg(alice, "a2", "healthcare"),
+		registerMsg(bob, "b1", "finance"),
+	} {
+		ctx = ctx.WithBlockTime(time.Unix(int64(1000+i*100), 0))
+		_, err := srv.RegisterDocument(ctx, m)
+		require.NoError(t, err)
+	}
+
+	res, err := k.DocumentsByOwner(ctx, &types.QueryDocumentsByOwnerRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Document, 2)
+
+	res, err = k.DocumentsByIndustry(ctx, &types.QueryDocumentsByIndustryRequest{
+		Industry:   "finance",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Document, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = k.DocumentsByType(ctx, &types.QueryDocumentsByTypeRequest{DocumentType: "deed"})
+	require.NoError(t, err)
+	require.Len(t, res.Document, 3)
+
+	res, err = k.DocumentsByCreationDate(ctx, &types.QueryDocumentsByCreationDateRequest{Start: 1050, End: 1200})
+	require.NoError(t, err)
+	require.Len(t, res.Document, 2)
+
+	params := k.GetParams(ctx)
+	params.SearchFields = []string{types.SearchFieldOwner}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.DocumentsByIndustry(ctx, &types.QueryDocumentsByIndustryRequest{Industry: "finance"})
+	require.Error(t, err)
+}
+
+func TestPruneExpiredDocuments(t *testing.T) {
+	k, ctx := keepertest.DocumentstorageKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	srv := keeper.NewMsgServerImpl(k)
+	owner := sample.AccAddress()
+
+	res, err := srv.RegisterDocument(ctx, registerMsg(owner, "doc-1", "finance"))
+	require.NoError(t, err)
+
+	require.Empty(t, k.PruneExpiredDocuments(ctx, res.ExpiresAt-1))
+	require.Equal(t, []string{"doc-1"}, k.PruneExpiredDocuments(ctx, res.ExpiresAt))
+
+	_, found := k.GetDocument(ctx, "doc-1")
+	require.False(t, found)
+	byOwner, err := k.DocumentsByOwner(ctx, &types.QueryDocumentsByOwnerRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Document)
+}
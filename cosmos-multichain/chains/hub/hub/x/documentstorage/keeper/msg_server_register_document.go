@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/documentstorage/types"
+)
+
+func (k msgServer) RegisterDocument(goCtx context.Context, msg *types.MsgRegisterDocument) (*types.MsgRegisterDocumentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if !params.IsSupportedHashAlgorithm(msg.HashAlgorithm) {
+		return nil, errorsmod.Wrap(types.ErrUnsupportedHashAlgorithm, msg.HashAlgorithm)
+	}
+	if msg.Size_ > params.MaxDocumentSize {
+		return nil, errorsmod.Wrapf(types.ErrDocumentTooLarge, "%d bytes exceeds %d", msg.Size_, params.MaxDocumentSize)
+	}
+	if _, found := k.GetDocument(ctx, msg.DocumentId); found {
+		return nil, errorsmod.Wrap(types.ErrDocumentExists, msg.DocumentId)
+	}
+
+	now := ctx.BlockTime()
+	document := types.DocumentRecord{
+		Document: &crosschaintypes.Document{
+			DocumentId:   msg.DocumentId,
+			DocumentType: msg.DocumentType,
+			DocumentHash: msg.DocumentHash,
+			Metadata:     msg.Metadata,
+		},
+		Owner:         msg.Creator,
+		HashAlgorithm: msg.HashAlgorithm,
+		Size_:         msg.Size_,
+		Industry:      msg.Industry,
+		IpfsLink:      msg.IpfsLink,
+		CreatedAt:     now.Unix(),
+		ExpiresAt:     now.Add(params.RetentionPeriod).Unix(),
+	}
+	k.SetDocument(ctx, document)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeDocumentRegistered,
+			sdk.NewAttribute(types.AttributeKeyDocumentId, msg.DocumentId),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyDocumentType, msg.DocumentType),
+			sdk.NewAttribute(types.AttributeKeyIndustry, msg.Industry),
+		),
+	)
+
+	return &types.MsgRegisterDocumentResponse{ExpiresAt: document.ExpiresAt}, nil
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"hub/x/documentstorage/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/documentstorage/types"
+)
+
+func (k Keeper) DocumentAll(ctx context.Context, req *types.QueryAllDocumentRequest) (*types.QueryAllDocumentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var documents []types.DocumentRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	documentStore := prefix.NewStore(store, types.KeyPrefix(types.DocumentKeyPrefix))
+
+	pageRes, err := query.Paginate(documentStore, req.Pagination, func(key []byte, value []byte) error {
+		var document types.DocumentRecord
+		if err := k.cdc.Unmarshal(value, &document); err != nil {
+			return err
+		}
+
+		documents = append(documents, document)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDocumentResponse{Document: documents, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Document(ctx context.Context, req *types.QueryGetDocumentRequest) (*types.QueryGetDocumentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetDocument(
+		ctx,
+		req.DocumentId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDocumentResponse{Document: val}, nil
+}
+
+func (k Keeper) DocumentsByOwner(ctx context.Context, req *types.QueryDocumentsByOwnerRequest) (*types.QueryDocumentsResponse, error) {
+	if req == nil || req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return k.documentsByIndex(ctx, types.SearchFieldOwner, types.DocumentOwnerIndexPrefix, req.Owner, req.Pagination)
+}
+
+func (k Keeper) DocumentsByType(ctx context.Context, req *types.QueryDocumentsByTypeRequest) (*types.QueryDocumentsResponse, error) {
+	if req == nil || req.DocumentType == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return k.documentsByIndex(ctx, types.SearchFieldDocumentType, types.DocumentTypeIndexPrefix, req.DocumentType, req.Pagination)
+}
+
+func (k Keeper) DocumentsByIndustry(ctx context.Context, req *types.QueryDocumentsByIndustryRequest) (*types.QueryDocumentsResponse, error) {
+	if req == nil || req.Industry == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return k.documentsByIndex(ctx, types.SearchFieldIndustry, types.DocumentIndustryIndexPrefix, req.Industry, req.Pagination)
+}
+
+func (k Keeper) DocumentsByCreationDate(ctx context.Context, req *types.QueryDocumentsByCreationDateRequest) (*types.QueryDocumentsResponse, error) {
+	if req == nil || req.Start < 0 || req.End < req.Start {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !k.GetParams(ctx).IsSearchable(types.SearchFieldCreationDate) {
+		return nil, status.Error(codes.FailedPrecondition, "creation_date index is disabled")
+	}
+
+	var documents []types.DocumentRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.DocumentCreationDateIndexPrefix))
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		created := int64(binary.BigEndian.Uint64(key[:8]))
+		if created < req.Start || created > req.End {
+			return false, nil
+		}
+		if accumulate {
+			document, found := k.GetDocument(ctx, string(value))
+			if !found {
+				return false, nil
+			}
+			documents = append(documents, document)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDocumentsResponse{Document: documents, Pagination: pageRes}, nil
+}
+
+// documentsByIndex pages through the documents filed under value in a string
+// secondary index.
+func (k Keeper) documentsByIndex(ctx context.Context, field, indexPrefix, value string, pagination *query.PageRequest) (*types.QueryDocumentsResponse, error) {
+	if !k.GetParams(ctx).IsSearchable(field) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s index is disabled", field)
+	}
+
+	var documents []types.DocumentRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, append(types.KeyPrefix(indexPrefix), types.DocumentIndexValueKey(value)...))
+
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		document, found := k.GetDocument(ctx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "dangling index entry for document %s", value)
+		}
+
+		documents = append(documents, document)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDocumentsResponse{Document: documents, Pagination: pageRes}, nil
+}
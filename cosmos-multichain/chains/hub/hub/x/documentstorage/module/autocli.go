@@ -0,0 +1,76 @@
+package documentstorage
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "hub/api/hub/documentstorage"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "DocumentAll",
+					Use:       "list-document",
+					Short:     "List all document",
+				},
+				{
+					RpcMethod:      "Document",
+					Use:            "show-document [document-id]",
+					Short:          "Shows a document",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "document_id"}},
+				},
+				{
+					RpcMethod:      "DocumentsByOwner",
+					Use:            "documents-by-owner [owner]",
+					Short:          "List the documents registered by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "DocumentsByType",
+					Use:            "documents-by-type [document-type]",
+					Short:          "List the documents of a type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "document_type"}},
+				},
+				{
+					RpcMethod:      "DocumentsByIndustry",
+					Use:            "documents-by-industry [industry]",
+					Short:          "List the documents anchored by an industry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "industry"}},
+				},
+				{
+					RpcMethod:      "DocumentsByCreationDate",
+					Use:            "documents-by-creation-date [start] [end]",
+					Short:          "List the documents created between two unix times",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start"}, {ProtoField: "end"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "RegisterDocument",
+					Use:            "register-document [document-id] [document-type] [hash-algorithm] [document-hash] [size] [industry]",
+					Short:          "Anchor a document hash on the hub",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "document_id"}, {ProtoField: "document_type"}, {ProtoField: "hash_algorithm"}, {ProtoField: "document_hash"}, {ProtoField: "size"}, {ProtoField: "industry"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/documentstorage/document.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "hub/x/crosschain/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DocumentRecord anchors a document hash on the hub.
+type DocumentRecord struct {
+	Document      *types.Document `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Owner         string          `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	HashAlgorithm string          `protobuf:"bytes,3,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	Size_         uint64          `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Industry      string          `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	IpfsLink      string          `protobuf:"bytes,6,opt,name=ipfs_link,json=ipfsLink,proto3" json:"ipfs_link,omitempty"`
+	CreatedAt     int64           `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64           `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *DocumentRecord) Reset()         { *m = DocumentRecord{} }
+func (m *DocumentRecord) String() string { return proto.CompactTextString(m) }
+func (*DocumentRecord) ProtoMessage()    {}
+func (*DocumentRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42456f589fa4bac0, []int{0}
+}
+func (m *DocumentRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DocumentRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentRecord.Merge(m, src)
+}
+func (m *DocumentRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DocumentRecord proto.InternalMessageInfo
+
+func (m *DocumentRecord) GetDocument() *types.Document {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *DocumentRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *DocumentRecord) GetHashAlgorithm() string {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return ""
+}
+
+func (m *DocumentRecord) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *DocumentRecord) GetIndustry() string {
+	if m != nil {
+		return m.Industry
+	}
+	return ""
+}
+
+func (m *DocumentRecord) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
+func (m *DocumentRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DocumentRecord) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DocumentRecord)(nil), "hub.documentstorage.DocumentRecord")
+}
+
+func init() {
+	proto.RegisterFile("hub/documentstorage/document.proto", fileDescriptor_42456f589fa4bac0)
+}
+
+var fileDescriptor_42456f589fa4bac0 = []byte{
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0xeb, 0xfe, 0xd1, 0x1a, 0xd1, 0xc1, 0x20, 0x64, 0xb5, 0xc2, 0x8a, 0x2a, 0x21, 0x65,
+	0x72, 0xd4, 0x22, 0x1e, 0xa0, 0x88, 0x91, 0x29, 0x23, 0x4b, 0x15, 0x27, 0x6e, 0x63, 0xb5, 0x8d,
+	0x83, 0xed, 0x94, 0xb6, 0xcf, 0xc0, 0xc0, 0x63, 0x31, 0x76, 0x64, 0x44, 0xcd, 0x8b, 0xa0, 0x38,
+	0x69, 0x06, 0xd8, 0xee, 0x3d, 0xdf, 0x77, 0x96, 0x03, 0xc7, 0x71, 0xc6, 0xbc, 0x48, 0x86, 0xd9,
+	0x86, 0x27, 0x46, 0x1b, 0xa9, 0x82, 0x25, 0xaf, 0x7f, 0x9a, 0x2a, 0x69, 0x24, 0xba, 0x8e, 0x33,
+	0x46, 0xff, 0x38, 0x43, 0xcc, 0xd6, 0xf2, 0xed, 0xe0, 0x09, 0x16, 0x7a, 0xdb, 0x89, 0x67, 0xf6,
+	0x29, 0xd7, 0xa5, 0x3e, 0xfe, 0x68, 0xc2, 0xc1, 0x73, 0x65, 0xfb, 0x3c, 0x94, 0x2a, 0x42, 0x53,
+	0xd8, 0x3b, 0xf7, 0x31, 0x70, 0x80, 0x7b, 0x39, 0xbd, 0xa5, 0xb6, 0x4f, 0x05, 0x0b, 0xe9, 0x76,
+	0x42, 0x6b, 0xbf, 0xf6, 0xd0, 0x0d, 0xec, 0xc8, 0xf7, 0x84, 0x2b, 0xdc, 0x74, 0x80, 0xdb, 0xf7,
+	0xcb, 0x07, 0xdd, 0xc3, 0x41, 0x1c, 0xe8, 0x78, 0x1e, 0xac, 0x97, 0x52, 0x09, 0x13, 0x6f, 0x70,
+	0xcb, 0xe2, 0xab, 0x22, 0x9d, 0x9d, 0x43, 0x84, 0x60, 0x5b, 0x8b, 0x03, 0xc7, 0x6d, 0x07, 0xb8,
+	0x6d, 0xdf, 0xde, 0x68, 0x08, 0x7b, 0x22, 0x89, 0x32, 0x6d, 0xd4, 0x1e, 0x77, 0x6c, 0xa9, 0xfe,
+	0xd1, 0x08, 0xf6, 0x45, 0xba, 0xd0, 0xf3, 0xb5, 0x48, 0x56, 0xb8, 0x5b, 0xc1, 0x74, 0xa1, 0x5f,
+	0x44, 0xb2, 0x42, 0x77, 0x10, 0x86, 0x8a, 0x07, 0x86, 0x47, 0xf3, 0xc0, 0xe0, 0x0b, 0x07, 0xb8,
+	0x2d, 0xbf, 0x5f, 0x25, 0x33, 0x53, 0x60, 0xbe, 0x4b, 0x85, 0xe2, 0xba, 0xc0, 0xbd, 0x12, 0x57,
+	0xc9, 0xcc, 0x3c, 0x3d, 0x7e, 0x9d, 0x08, 0x38, 0x9e, 0x08, 0xf8, 0x39, 0x11, 0xf0, 0x99, 0x93,
+	0xc6, 0x31, 0x27, 0x8d, 0xef, 0x9c, 0x34, 0x5e, 0x47, 0xc5, 0xf6, 0xbb, 0x7f, 0xeb, 0xdb, 0x2d,
+	0x59, 0xd7, 0x8e, 0xf9, 0xf0, 0x3b, 0x00, 0xd8, 0xaa, 0xba, 0xc0, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *DocumentRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintDocument(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintDocument(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintDocument(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Industry) > 0 {
+		i -= len(m.Industry)
+		copy(dAtA[i:], m.Industry)
+		i = encodeVarintDocument(dAtA, i, uint64(len(m.Industry)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintDocument(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HashAlgorithm) > 0 {
+		i -= len(m.HashAlgorithm)
+		copy(dAtA[i:], m.HashAlgorithm)
+		i = encodeVarintDocument(dAtA, i, uint64(len(m.HashAlgorithm)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDocument(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDocument(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDocument(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDocument(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DocumentRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovDocument(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDocument(uint64(l))
+	}
+	l = len(m.HashAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovDocument(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovDocument(uint64(m.Size_))
+	}
+	l = len(m.Industry)
+	if l > 0 {
+		n += 1 + l + sovDocument(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovDocument(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovDocument(uint64(m.CreatedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovDocument(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovDocument(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDocument(x uint64) (n int) {
+	return sovDocument(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DocumentRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDocument
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDocument
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &types.Document{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Industry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Industry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDocument(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDocument
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDocument(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDocument
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDocument
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDocument
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDocument
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDocument
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDocument        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDocument          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDocument = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/documentstorage module sentinel errors
+var (
+	ErrInvalidSigner            = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnsupportedHashAlgorithm = sdkerrors.Register(ModuleName, 1101, "unsupported hash algorithm")
+	ErrInvalidDocumentHash      = sdkerrors.Register(ModuleName, 1102, "invalid document hash")
+	ErrDocumentTooLarge         = sdkerrors.Register(ModuleName, 1103, "document exceeds the maximum size")
+	ErrDocumentExists           = sdkerrors.Register(ModuleName, 1104, "document already registered")
+	ErrInvalidDocument          = sdkerrors.Register(ModuleName, 1105, "invalid document")
+)
@@ -0,0 +1,12 @@
+package types
+
+// documentstorage module event types
+const (
+	EventTypeDocumentRegistered = "document_registered"
+	EventTypeDocumentPruned     = "document_pruned"
+
+	AttributeKeyDocumentId   = "document_id"
+	AttributeKeyOwner        = "owner"
+	AttributeKeyDocumentType = "document_type"
+	AttributeKeyIndustry     = "industry"
+)
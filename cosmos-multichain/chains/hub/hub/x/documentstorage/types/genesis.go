@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		DocumentList: []DocumentRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in document
+	documentIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DocumentList {
+		if elem.Document == nil {
+			return fmt.Errorf("document record without document")
+		}
+		index := string(DocumentKey(elem.Document.DocumentId))
+		if _, ok := documentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for document")
+		}
+		documentIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,70 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DocumentKeyPrefix is the prefix to retrieve all Document
+	DocumentKeyPrefix = "Document/value/"
+
+	// DocumentOwnerIndexPrefix indexes documents by owner
+	DocumentOwnerIndexPrefix = "Document/owner/"
+	// DocumentTypeIndexPrefix indexes documents by document type
+	DocumentTypeIndexPrefix = "Document/type/"
+	// DocumentIndustryIndexPrefix indexes documents by industry
+	DocumentIndustryIndexPrefix = "Document/industry/"
+	// DocumentCreationDateIndexPrefix indexes documents by creation time
+	DocumentCreationDateIndexPrefix = "Document/creation_date/"
+	// DocumentExpiryQueuePrefix orders documents by the end of their retention
+	DocumentExpiryQueuePrefix = "Document/expiry/"
+)
+
+// DocumentKey returns the store key to retrieve a Document from the index fields
+func DocumentKey(
+	documentId string,
+) []byte {
+	var key []byte
+
+	documentIdBytes := []byte(documentId)
+	key = append(key, documentIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DocumentIndexValueKey returns the prefix of a string secondary index entry
+func DocumentIndexValueKey(
+	value string,
+) []byte {
+	var key []byte
+
+	valueBytes := []byte(value)
+	key = append(key, valueBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DocumentIndexKey returns the key of a string secondary index entry
+func DocumentIndexKey(
+	value string,
+	documentId string,
+) []byte {
+	return append(DocumentIndexValueKey(value), DocumentKey(documentId)...)
+}
+
+// DocumentTimeKey returns the prefix of a time ordered index entry
+func DocumentTimeKey(
+	unix int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(unix))
+	return key
+}
+
+// DocumentTimeIndexKey returns the key of a time ordered index entry
+func DocumentTimeIndexKey(
+	unix int64,
+	documentId string,
+) []byte {
+	return append(DocumentTimeKey(unix), DocumentKey(documentId)...)
+}
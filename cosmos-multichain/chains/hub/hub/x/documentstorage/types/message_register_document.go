@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterDocument{}
+
+func NewMsgRegisterDocument(
+	creator string,
+	documentId string,
+	documentType string,
+	hashAlgorithm string,
+	documentHash []byte,
+	size uint64,
+	industry string,
+	ipfsLink string,
+	metadata map[string]string,
+) *MsgRegisterDocument {
+	return &MsgRegisterDocument{
+		Creator:       creator,
+		DocumentId:    documentId,
+		DocumentType:  documentType,
+		HashAlgorithm: hashAlgorithm,
+		DocumentHash:  documentHash,
+		Size_:         size,
+		Industry:      industry,
+		IpfsLink:      ipfsLink,
+		Metadata:      metadata,
+	}
+}
+
+func (msg *MsgRegisterDocument) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.DocumentId == "" {
+		return errorsmod.Wrap(ErrInvalidDocument, "document id cannot be empty")
+	}
+	if msg.DocumentType == "" {
+		return errorsmod.Wrap(ErrInvalidDocument, "document type cannot be empty")
+	}
+	if strings.Contains(msg.DocumentType, "/") || strings.Contains(msg.Industry, "/") {
+		return errorsmod.Wrap(ErrInvalidDocument, "document type and industry cannot contain '/'")
+	}
+	if msg.Size_ == 0 {
+		return errorsmod.Wrap(ErrInvalidDocument, "document size cannot be zero")
+	}
+
+	hashSize, ok := HashSize(msg.HashAlgorithm)
+	if !ok {
+		return errorsmod.Wrap(ErrUnsupportedHashAlgorithm, msg.HashAlgorithm)
+	}
+	if len(msg.DocumentHash) != hashSize {
+		return errorsmod.Wrapf(ErrInvalidDocumentHash, "%s digest must be %d bytes, got %d", msg.HashAlgorithm, hashSize, len(msg.DocumentHash))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"hub/testutil/sample"
+)
+
+func TestMsgRegisterDocument_ValidateBasic(t *testing.T) {
+	valid := func() MsgRegisterDocument {
+		return MsgRegisterDocument{
+			Creator:       sample.AccAddress(),
+			DocumentId:    "doc-1",
+			DocumentType:  "deed",
+			HashAlgorithm: "sha256",
+			DocumentHash:  make([]byte, 32),
+			Size_:         1024,
+			Industry:      "realestate",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*MsgRegisterDocument)
+		err    error
+	}{
+		{
+			name:   "valid",
+			mutate: func(*MsgRegisterDocument) {},
+		},
+		{
+			name:   "invalid address",
+			mutate: func(m *MsgRegisterDocument) { m.Creator = "invalid_address" },
+			err:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "unsupported algorithm",
+			mutate: func(m *MsgRegisterDocument) { m.HashAlgorithm = "md5" },
+			err:    ErrUnsupportedHashAlgorithm,
+		},
+		{
+			name:   "digest length mismatch",
+			mutate: func(m *MsgRegisterDocument) { m.HashAlgorithm = "sha512" },
+			err:    ErrInvalidDocumentHash,
+		},
+		{
+			name:   "slash in industry",
+			mutate: func(m *MsgRegisterDocument) { m.Industry = "real/estate" },
+			err:    ErrInvalidDocument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := valid()
+			tt.mutate(&msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Search fields that can be indexed.
+const (
+	SearchFieldOwner        = "owner"
+	SearchFieldDocumentType = "document_type"
+	SearchFieldCreationDate = "creation_date"
+	SearchFieldIndustry     = "industry"
+)
+
+// hashSizes maps each known hash algorithm to its digest length in bytes.
+var hashSizes = map[string]int{
+	"sha256": 32,
+	"sha512": 64,
+}
+
+var (
+	KeyMaxDocumentSize = []byte("MaxDocumentSize")
+	// DefaultMaxDocumentSize mirrors document_storage.max_document_size in config.yml
+	DefaultMaxDocumentSize uint64 = 10485760
+)
+
+var (
+	KeySupportedHashAlgorithms = []byte("SupportedHashAlgorithms")
+	// DefaultSupportedHashAlgorithms mirrors document_storage.supported_hash_algorithms in config.yml
+	DefaultSupportedHashAlgorithms = []string{"sha256", "sha512"}
+)
+
+var (
+	KeyRetentionPeriod = []byte("RetentionPeriod")
+	// DefaultRetentionPeriod mirrors document_storage.retention_period in config.yml
+	DefaultRetentionPeriod = 87600 * time.Hour
+)
+
+var (
+	KeySearchFields = []byte("SearchFields")
+	// DefaultSearchFields mirrors document_storage.indexing.search_fields in config.yml
+	DefaultSearchFields = []string{
+		SearchFieldOwner,
+		SearchFieldDocumentType,
+		SearchFieldCreationDate,
+		SearchFieldIndustry,
+	}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxDocumentSize uint64,
+	supportedHashAlgorithms []string,
+	retentionPeriod time.Duration,
+	searchFields []string,
+) Params {
+	return Params{
+		MaxDocumentSize:         maxDocumentSize,
+		SupportedHashAlgorithms: supportedHashAlgorithms,
+		RetentionPeriod:         retentionPeriod,
+		SearchFields:            searchFields,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxDocumentSize,
+		DefaultSupportedHashAlgorithms,
+		DefaultRetentionPeriod,
+		DefaultSearchFields,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxDocumentSize, &p.MaxDocumentSize, validateMaxDocumentSize),
+		paramtypes.NewParamSetPair(KeySupportedHashAlgorithms, &p.SupportedHashAlgorithms, validateSupportedHashAlgorithms),
+		paramtypes.NewParamSetPair(KeyRetentionPeriod, &p.RetentionPeriod, validateRetentionPeriod),
+		paramtypes.NewParamSetPair(KeySearchFields, &p.SearchFields, validateSearchFields),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxDocumentSize(p.MaxDocumentSize); err != nil {
+		return err
+	}
+	if err := validateSupportedHashAlgorithms(p.SupportedHashAlgorithms); err != nil {
+		return err
+	}
+	if err := validateRetentionPeriod(p.RetentionPeriod); err != nil {
+		return err
+	}
+	return validateSearchFields(p.SearchFields)
+}
+
+// IsSupportedHashAlgorithm reports whether algorithm is enabled by the params
+func (p Params) IsSupportedHashAlgorithm(algorithm string) bool {
+	for _, a := range p.SupportedHashAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSearchable reports whether the secondary index on field is exposed
+func (p Params) IsSearchable(field string) bool {
+	for _, f := range p.SearchFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// HashSize returns the digest length of a known hash algorithm
+func HashSize(algorithm string) (int, bool) {
+	size, ok := hashSizes[algorithm]
+	return size, ok
+}
+
+// validateMaxDocumentSize validates the MaxDocumentSize param
+func validateMaxDocumentSize(v interface{}) error {
+	size, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if size == 0 {
+		return fmt.Errorf("max document size must be positive")
+	}
+	return nil
+}
+
+// validateSupportedHashAlgorithms validates the SupportedHashAlgorithms param
+func validateSupportedHashAlgorithms(v interface{}) error {
+	algorithms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if len(algorithms) == 0 {
+		return fmt.Errorf("at least one hash algorithm is required")
+	}
+	seen := make(map[string]struct{}, len(algorithms))
+	for _, a := range algorithms {
+		if _, ok := hashSizes[a]; !ok {
+			return fmt.Errorf("unknown hash algorithm %s", a)
+		}
+		if _, ok := seen[a]; ok {
+			return fmt.Errorf("duplicated hash algorithm %s", a)
+		}
+		seen[a] = struct{}{}
+	}
+	return nil
+}
+
+// validateRetentionPeriod validates the RetentionPeriod param
+func validateRetentionPeriod(v interface{}) error {
+	period, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if period <= 0 {
+		return fmt.Errorf("retention period must be positive: %s", period)
+	}
+	return nil
+}
+
+// validateSearchFields validates the SearchFields param
+func validateSearchFields(v interface{}) error {
+	fields, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case SearchFieldOwner, SearchFieldDocumentType, SearchFieldCreationDate, SearchFieldIndustry:
+		default:
+			return fmt.Errorf("unknown search field %s", f)
+		}
+		if _, ok := seen[f]; ok {
+			return fmt.Errorf("duplicated search field %s", f)
+		}
+		seen[f] = struct{}{}
+	}
+	return nil
+}
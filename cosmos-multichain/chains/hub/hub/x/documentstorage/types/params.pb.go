@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/documentstorage/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// max_document_size is the largest document, in bytes, that may be anchored.
+	MaxDocumentSize uint64 `protobuf:"varint,1,opt,name=max_document_size,json=maxDocumentSize,proto3" json:"max_document_size,omitempty"`
+	// supported_hash_algorithms lists the accepted document digest algorithms.
+	SupportedHashAlgorithms []string `protobuf:"bytes,2,rep,name=supported_hash_algorithms,json=supportedHashAlgorithms,proto3" json:"supported_hash_algorithms,omitempty"`
+	// retention_period is how long a document stays registered before pruning.
+	RetentionPeriod time.Duration `protobuf:"bytes,3,opt,name=retention_period,json=retentionPeriod,proto3,stdduration" json:"retention_period"`
+	// search_fields lists the secondary indexes exposed through queries.
+	SearchFields []string `protobuf:"bytes,4,rep,name=search_fields,json=searchFields,proto3" json:"search_fields,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60756be80b0917c9, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxDocumentSize() uint64 {
+	if m != nil {
+		return m.MaxDocumentSize
+	}
+	return 0
+}
+
+func (m *Params) GetSupportedHashAlgorithms() []string {
+	if m != nil {
+		return m.SupportedHashAlgorithms
+	}
+	return nil
+}
+
+func (m *Params) GetRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSearchFields() []string {
+	if m != nil {
+		return m.SearchFields
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hub.documentstorage.Params")
+}
+
+func init() { proto.RegisterFile("hub/documentstorage/params.proto", fileDescriptor_60756be80b0917c9) }
+
+var fileDescriptor_60756be80b0917c9 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4f, 0xfa, 0x40,
+	0x14, 0xc7, 0x7b, 0x40, 0x48, 0x7e, 0xfd, 0x49, 0x90, 0x6a, 0x62, 0x41, 0x53, 0x1a, 0x8d, 0x09,
+	0x61, 0x68, 0x13, 0x8d, 0x0b, 0x9b, 0x84, 0x18, 0x47, 0x02, 0x9b, 0x4b, 0x73, 0xa5, 0x47, 0xef,
+	0x12, 0xda, 0xd7, 0xdc, 0x5d, 0x13, 0xe4, 0x4f, 0x70, 0xd2, 0xcd, 0xd1, 0xd1, 0x91, 0x3f, 0x83,
+	0x91, 0xd1, 0x49, 0x0d, 0x0c, 0xf8, 0x67, 0x18, 0xae, 0xc0, 0xa0, 0x2e, 0x97, 0x77, 0xdf, 0xf7,
+	0x7d, 0xef, 0x7d, 0xf2, 0xd5, 0x6d, 0x9a, 0xfa, 0x6e, 0x00, 0x83, 0x34, 0x22, 0xb1, 0x14, 0x12,
+	0x38, 0x0e, 0x89, 0x9b, 0x60, 0x8e, 0x23, 0xe1, 0x24, 0x1c, 0x24, 0x18, 0x07, 0x34, 0xf5, 0x9d,
+	0x1f, 0x8e, 0x5a, 0x05, 0x47, 0x2c, 0x06, 0x57, 0xbd, 0x99, 0xaf, 0x76, 0x18, 0x42, 0x08, 0xaa,
+	0x74, 0xd7, 0xd5, 0x46, 0xb5, 0x42, 0x80, 0x70, 0x44, 0x5c, 0xf5, 0xf3, 0xd3, 0xa1, 0x1b, 0xa4,
+	0x1c, 0x4b, 0x06, 0x71, 0xd6, 0x3f, 0x7d, 0xca, 0xe9, 0xc5, 0xae, 0x3a, 0x67, 0x34, 0xf5, 0x4a,
+	0x84, 0xc7, 0xde, 0xf6, 0x94, 0x27, 0xd8, 0x84, 0x98, 0xc8, 0x46, 0x8d, 0x42, 0xaf, 0x1c, 0xe1,
+	0x71, 0x67, 0xa3, 0xf7, 0xd9, 0x84, 0x18, 0x2d, 0xbd, 0x2a, 0xd2, 0x24, 0x01, 0x2e, 0x49, 0xe0,
+	0x51, 0x2c, 0xa8, 0x87, 0x47, 0x21, 0x70, 0x26, 0x69, 0x24, 0xcc, 0x9c, 0x9d, 0x6f, 0xfc, 0xeb,
+	0x1d, 0xed, 0x0c, 0xb7, 0x58, 0xd0, 0xeb, 0x5d, 0xdb, 0xe8, 0xeb, 0xfb, 0x9c, 0x48, 0x12, 0xaf,
+	0x29, 0xbc, 0x84, 0x70, 0x06, 0x81, 0x99, 0xb7, 0x51, 0xe3, 0xff, 0x45, 0xd5, 0xc9, 0x68, 0x9d,
+	0x2d, 0xad, 0xd3, 0xd9, 0xd0, 0xb6, 0x4b, 0xb3, 0xf7, 0xba, 0xf6, 0xfc, 0x51, 0x47, 0xaf, 0xab,
+	0x69, 0x13, 0xf5, 0xca, 0xbb, 0x0d, 0x5d, 0xb5, 0xc0, 0x38, 0xd3, 0x4b, 0x82, 0x60, 0x3e, 0xa0,
+	0xde, 0x90, 0x91, 0x51, 0x20, 0xcc, 0x82, 0x82, 0xd8, 0xcb, 0xc4, 0x1b, 0xa5, 0xb5, 0xce, 0xbf,
+	0x5e, 0xea, 0xe8, 0x61, 0x35, 0x6d, 0x9e, 0xac, 0x53, 0x1f, 0xff, 0xca, 0x3d, 0x0b, 0xa2, 0x7d,
+	0x35, 0x5b, 0x58, 0x68, 0xbe, 0xb0, 0xd0, 0xe7, 0xc2, 0x42, 0x8f, 0x4b, 0x4b, 0x9b, 0x2f, 0x2d,
+	0xed, 0x6d, 0x69, 0x69, 0x77, 0xc7, 0x7f, 0xcf, 0xc9, 0xfb, 0x84, 0x08, 0xbf, 0xa8, 0xa8, 0x2f,
+	0xbf, 0x07, 0x00, 0xc4, 0xe7, 0x29, 0x7d, 0xd3, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxDocumentSize != that1.MaxDocumentSize {
+		return false
+	}
+	if len(this.SupportedHashAlgorithms) != len(that1.SupportedHashAlgorithms) {
+		return false
+	}
+	for i := range this.SupportedHashAlgorithms {
+		if this.SupportedHashAlgorithms[i] != that1.SupportedHashAlgorithms[i] {
+			return false
+		}
+	}
+	if this.RetentionPeriod != that1.RetentionPeriod {
+		return false
+	}
+	if len(this.SearchFields) != len(that1.SearchFields) {
+		return false
+	}
+	for i := range this.SearchFields {
+		if this.SearchFields[i] != that1.SearchFields[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SearchFields) > 0 {
+		for iNdEx := len(m.SearchFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SearchFields[iNdEx])
+			copy(dAtA[i:], m.SearchFields[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SearchFields[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SupportedHashAlgorithms) > 0 {
+		for iNdEx := len(m.SupportedHashAlgorithms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SupportedHashAlgorithms[iNdEx])
+			copy(dAtA[i:], m.SupportedHashAlgorithms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SupportedHashAlgorithms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxDocumentSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDocumentSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDocumentSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxDocumentSize))
+	}
+	if len(m.SupportedHashAlgorithms) > 0 {
+		for _, s := range m.SupportedHashAlgorithms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.SearchFields) > 0 {
+		for _, s := range m.SearchFields {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDocumentSize", wireType)
+			}
+			m.MaxDocumentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDocumentSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedHashAlgorithms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupportedHashAlgorithms = append(m.SupportedHashAlgorithms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SearchFields = append(m.SearchFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/integrationverification/types"
+)
+
+func (k msgServer) AttestService(goCtx context.Context, msg *types.MsgAttestService) (*types.MsgAttestServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if !params.IsVerifier(msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedVerifier, msg.Creator)
+	}
+
+	service, found := k.GetService(ctx, msg.ServiceId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrServiceNotFound, msg.ServiceId)
+	}
+	serviceType, found := params.ServiceType(service.Service.ServiceType)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrUnknownServiceType, service.Service.ServiceType)
+	}
+	if msg.VerificationMethod != serviceType.VerificationMethod {
+		return nil, errorsmod.Wrapf(types.ErrMethodMismatch, "%s services are verified with %s", serviceType.Name, serviceType.VerificationMethod)
+	}
+	for _, name := range msg.VerifiedCapabilities {
+		if _, ok := service.Service.Capabilities[name]; !ok {
+			return nil, errorsmod.Wrap(types.ErrUnknownCapability, name)
+		}
+	}
+
+	now := ctx.BlockTime()
+	service.Service.VerificationTimestamp = now.Unix()
+	service.LastVerifier = msg.Creator
+	service.RetryCount = 0
+	if msg.Passed {
+		service.Service.Status = crosschaintypes.VerificationStatus_STATUS_VERIFIED
+		service.VerifiedCapabilities = msg.VerifiedCapabilities
+		service.NextVerification = now.Add(params.VerificationFrequency).Unix()
+	} else {
+		service.Service.Status = crosschaintypes.VerificationStatus_STATUS_REJECTED
+		service.VerifiedCapabilities = nil
+		service.NextVerification = 0
+	}
+	k.SetService(ctx, service)
+
+	if err := k.emitServiceVerification(ctx, service); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestServiceResponse{
+		Status:           service.Service.Status,
+		NextVerification: service.NextVerification,
+	}, nil
+}
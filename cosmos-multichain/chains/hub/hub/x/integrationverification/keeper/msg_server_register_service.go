@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/integrationverification/types"
+)
+
+func (k msgServer) RegisterService(goCtx context.Context, msg *types.MsgRegisterService) (*types.MsgRegisterServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetParams(ctx).ServiceType(msg.ServiceType); !found {
+		return nil, errorsmod.Wrap(types.ErrUnknownServiceType, msg.ServiceType)
+	}
+	if _, found := k.GetService(ctx, msg.ServiceId); found {
+		return nil, errorsmod.Wrap(types.ErrServiceExists, msg.ServiceId)
+	}
+
+	k.SetService(ctx, types.ServiceRecord{
+		Service: &crosschaintypes.ServiceVerification{
+			ServiceId:    msg.ServiceId,
+			ServiceType:  msg.ServiceType,
+			Capabilities: msg.Capabilities,
+			Status:       crosschaintypes.VerificationStatus_STATUS_PENDING,
+		},
+		Owner: msg.Creator,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeServiceRegistered,
+			sdk.NewAttribute(types.AttributeKeyServiceId, msg.ServiceId),
+			sdk.NewAttribute(types.AttributeKeyServiceType, msg.ServiceType),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Creator),
+		),
+	)
+
+	return &types.MsgRegisterServiceResponse{}, nil
+}
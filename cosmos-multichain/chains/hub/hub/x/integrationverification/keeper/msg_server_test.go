@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	"hub/testutil/sample"
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/integrationverification/keeper"
+	"hub/x/integrationverification/types"
+)
+
+func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, sdk.Context, string) {
+	k, ctx := keepertest.IntegrationverificationKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	verifier := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, keeper.NewMsgServerImpl(k), ctx, verifier
+}
+
+func registerAPI(t *testing.T, srv types.MsgServer, ctx sdk.Context) {
+	_, err := srv.RegisterService(ctx, &types.MsgRegisterService{
+		Creator:      sample.AccAddress(),
+		ServiceId:    "svc",
+		ServiceType:  "api",
+		Capabilities: map[string]string{"payments": "v1", "webhooks": "v2"},
+	})
+	require.NoError(t, err)
+}
+
+func TestAttestService(t *testing.T) {
+	k, srv, ctx, verifier := setupMsgServer(t)
+
+	_, err := srv.RegisterService(ctx, &types.MsgRegisterService{Creator: sample.AccAddress(), ServiceId: "x", ServiceType: "ftp"})
+	require.ErrorIs(t, err, types.ErrUnknownServiceType)
+
+	registerAPI(t, srv, ctx)
+
+	_, err = srv.AttestService(ctx, &types.MsgAttestService{Creator: sample.AccAddress(), ServiceId: "svc", VerificationMethod: "oauth2", Passed: true})
+	require.ErrorIs(t, err, types.ErrUnauthorizedVerifier)
+
+	_, err = srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "api_key", Passed: true})
+	require.ErrorIs(t, err, types.ErrMethodMismatch)
+
+	_, err = srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "oauth2", Passed: true, VerifiedCapabilities: []string{"sso"}})
+	require.ErrorIs(t, err, types.ErrUnknownCapability)
+
+	res, err := srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "oauth2", Passed: true, VerifiedCapabilities: []string{"payments"}})
+	require.NoError(t, err)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, res.Status)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultVerificationFrequency).Unix(), res.NextVerification)
+
+	service, found := k.GetService(ctx, "svc")
+	require.True(t, found)
+	require.Equal(t, []string{"payments"}, service.VerifiedCapabilities)
+	require.Equal(t, verifier, service.LastVerifier)
+}
+
+func TestProcessVerificationQueue(t *testing.T) {
+	k, srv, ctx, verifier := setupMsgServer(t)
+	registerAPI(t, srv, ctx)
+
+	res, err := srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "oauth2", Passed: true})
+	require.NoError(t, err)
+
+	// Nothing happens before the verification lapses.
+	require.NoError(t, k.ProcessVerificationQueue(ctx))
+	service, _ := k.GetService(ctx, "svc")
+	require.Zero(t, service.RetryCount)
+
+	due := time.Unix(res.NextVerification, 0)
+	for attempt := uint32(1); attempt <= types.DefaultMaxRetryAttempts; attempt++ {
+		ctx = ctx.WithBlockTime(due)
+		require.NoError(t, k.ProcessVerificationQueue(ctx))
+		service, _ = k.GetService(ctx, "svc")
+		require.Equal(t, attempt, service.RetryCount)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, service.Service.Status)
+		due = due.Add(types.DefaultTimeout)
+	}
+
+	ctx = ctx.WithBlockTime(due)
+	require.NoError(t, k.ProcessVerificationQueue(ctx))
+	service, _ = k.GetService(ctx, "svc")
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_PENDING, service.Service.Status)
+	require.Zero(t, service.NextVerification)
+	require.Empty(t, k.GetDueServices(ctx, due.Add(time.Hour).Unix()))
+}
+
+func TestAttestationResetsRetries(t *testing.T) {
+	k, srv, ctx, verifier := setupMsgServer(t)
+	registerAPI(t, srv, ctx)
+
+	res, err := srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "oauth2", Passed: true})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Unix(res.NextVerification, 0))
+	require.NoError(t, k.ProcessVerificationQueue(ctx))
+
+	res, err = srv.AttestService(ctx, &types.MsgAttestService{Creator: verifier, ServiceId: "svc", VerificationMethod: "oauth2", Passed: true})
+	require.NoError(t, err)
+
+	service, _ := k.GetService(ctx, "svc")
+	require.Zero(t, service.RetryCount)
+	require.Equal(t, []string{"svc"}, k.GetDueServices(ctx, res.NextVerification))
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/integrationverification/types"
+)
+
+func (k Keeper) ServiceAll(ctx context.Context, req *types.QueryAllServiceRequest) (*types.QueryAllServiceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var services []types.ServiceRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	serviceStore := prefix.NewStore(store, types.KeyPrefix(types.ServiceKeyPrefix))
+
+	pageRes, err := query.Paginate(serviceStore, req.Pagination, func(key []byte, value []byte) error {
+		var service types.ServiceRecord
+		if err := k.cdc.Unmarshal(value, &service); err != nil {
+			return err
+		}
+
+		services = append(services, service)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllServiceResponse{Service: services, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Service(ctx context.Context, req *types.QueryGetServiceRequest) (*types.QueryGetServiceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetService(
+		ctx,
+		req.ServiceId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetServiceResponse{Service: val}, nil
+}
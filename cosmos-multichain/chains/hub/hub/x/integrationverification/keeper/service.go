@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/integrationverification/types"
+)
+
+// SetService set a specific service in the store from its index and keeps its
+// re-verification queue entry in step with NextVerification
+func (k Keeper) SetService(ctx context.Context, service types.ServiceRecord) {
+	serviceId := service.Service.GetServiceId()
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceQueueKeyPrefix))
+
+	if existing, found := k.GetService(ctx, serviceId); found && existing.NextVerification != 0 {
+		queue.Delete(types.ServiceQueueKey(existing.NextVerification, serviceId))
+	}
+
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceKeyPrefix))
+	b := k.cdc.MustMarshal(&service)
+	store.Set(types.ServiceKey(
+		serviceId,
+	), b)
+
+	if service.NextVerification != 0 {
+		queue.Set(types.ServiceQueueKey(service.NextVerification, serviceId), []byte(serviceId))
+	}
+}
+
+// GetService returns a service from its index
+func (k Keeper) GetService(
+	ctx context.Context,
+	serviceId string,
+) (val types.ServiceRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceKeyPrefix))
+
+	b := store.Get(types.ServiceKey(
+		serviceId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveService removes a service and its queue entry from the store
+func (k Keeper) RemoveService(
+	ctx context.Context,
+	serviceId string,
+) {
+	existing, found := k.GetService(ctx, serviceId)
+	if !found {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if existing.NextVerification != 0 {
+		queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceQueueKeyPrefix))
+		queue.Delete(types.ServiceQueueKey(existing.NextVerification, serviceId))
+	}
+
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceKeyPrefix))
+	store.Delete(types.ServiceKey(
+		serviceId,
+	))
+}
+
+// GetAllService returns all service
+func (k Keeper) GetAllService(ctx context.Context) (list []types.ServiceRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ServiceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDueServices returns the services whose verification lapses at or before
+// the given unix time, in due order
+func (k Keeper) GetDueServices(ctx context.Context, now int64) (serviceIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ServiceQueueKeyPrefix))
+	iterator := queue.Iterator(nil, types.ServiceQueueTimeKey(now+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		serviceIds = append(serviceIds, string(iterator.Value()))
+	}
+
+	return
+}
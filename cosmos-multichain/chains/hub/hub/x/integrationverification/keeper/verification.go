@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/integrationverification/types"
+)
+
+// ProcessVerificationQueue handles services whose verification window has
+// lapsed. Each lapse opens another retry window of Params.Timeout until
+// Params.MaxRetryAttempts is exhausted, after which the service is downgraded
+// to pending and taken off the schedule.
+func (k Keeper) ProcessVerificationQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	params := k.GetParams(ctx)
+
+	for _, serviceId := range k.GetDueServices(ctx, now.Unix()) {
+		service, found := k.GetService(ctx, serviceId)
+		if !found {
+			continue
+		}
+
+		if service.RetryCount < params.MaxRetryAttempts {
+			service.RetryCount++
+			service.NextVerification = now.Add(params.Timeout).Unix()
+			k.SetService(ctx, service)
+
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeVerificationRetried,
+					sdk.NewAttribute(types.AttributeKeyServiceId, serviceId),
+					sdk.NewAttribute(types.AttributeKeyRetryCount, strconv.FormatUint(uint64(service.RetryCount), 10)),
+				),
+			)
+			continue
+		}
+
+		service.Service.Status = crosschaintypes.VerificationStatus_STATUS_PENDING
+		service.VerifiedCapabilities = nil
+		service.NextVerification = 0
+		service.RetryCount = 0
+		k.SetService(ctx, service)
+
+		if err := k.emitServiceVerification(sdkCtx, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) emitServiceVerification(ctx sdk.Context, service types.ServiceRecord) error {
+	return ctx.EventManager().EmitTypedEvent(&crosschaintypes.ServiceVerificationEvent{
+		EventId:              fmt.Sprintf("%s-%d", service.Service.ServiceId, ctx.BlockHeight()),
+		ServiceId:            service.Service.ServiceId,
+		Status:               service.Service.Status,
+		VerifiedCapabilities: service.VerifiedCapabilities,
+		Timestamp:            ctx.BlockTime().Unix(),
+	})
+}
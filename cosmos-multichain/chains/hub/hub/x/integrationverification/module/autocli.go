@@ -0,0 +1,58 @@
+package integrationverification
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "hub/api/hub/integrationverification"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ServiceAll",
+					Use:       "list-service",
+					Short:     "List all service",
+				},
+				{
+					RpcMethod:      "Service",
+					Use:            "show-service [service-id]",
+					Short:          "Shows a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "service_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "RegisterService",
+					Use:            "register-service [service-id] [service-type]",
+					Short:          "Register a third-party service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "service_id"}, {ProtoField: "service_type"}},
+				},
+				{
+					RpcMethod:      "AttestService",
+					Use:            "attest-service [service-id] [verification-method] [passed]",
+					Short:          "Record a verifier's check of a service",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "service_id"}, {ProtoField: "verification_method"}, {ProtoField: "passed"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
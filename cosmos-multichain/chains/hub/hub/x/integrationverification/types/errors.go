@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/integrationverification module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnknownServiceType   = sdkerrors.Register(ModuleName, 1101, "unknown service type")
+	ErrServiceExists        = sdkerrors.Register(ModuleName, 1102, "service already registered")
+	ErrServiceNotFound      = sdkerrors.Register(ModuleName, 1103, "service not found")
+	ErrUnauthorizedVerifier = sdkerrors.Register(ModuleName, 1104, "account is not an authorized verifier")
+	ErrMethodMismatch       = sdkerrors.Register(ModuleName, 1105, "verification method does not match the service type")
+	ErrUnknownCapability    = sdkerrors.Register(ModuleName, 1106, "capability not advertised by the service")
+	ErrInvalidService       = sdkerrors.Register(ModuleName, 1107, "invalid service")
+)
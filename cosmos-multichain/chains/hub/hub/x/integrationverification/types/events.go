@@ -0,0 +1,12 @@
+package types
+
+// integrationverification module event types
+const (
+	EventTypeServiceRegistered   = "service_registered"
+	EventTypeVerificationRetried = "service_verification_retried"
+
+	AttributeKeyServiceId   = "service_id"
+	AttributeKeyServiceType = "service_type"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyRetryCount  = "retry_count"
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ServiceList: []ServiceRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in service
+	serviceIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ServiceList {
+		if elem.Service == nil {
+			return fmt.Errorf("service record without service")
+		}
+		index := string(ServiceKey(elem.Service.ServiceId))
+		if _, ok := serviceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for service")
+		}
+		serviceIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
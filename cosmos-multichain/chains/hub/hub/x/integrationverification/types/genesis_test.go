@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/integrationverification/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ServiceList: []types.ServiceRecord{
+					{Service: &crosschaintypes.ServiceVerification{ServiceId: "0"}},
+					{Service: &crosschaintypes.ServiceVerification{ServiceId: "1"}},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated service",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ServiceList: []types.ServiceRecord{
+					{Service: &crosschaintypes.ServiceVerification{ServiceId: "0"}},
+					{Service: &crosschaintypes.ServiceVerification{ServiceId: "0"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid verifier",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultServiceTypes,
+					types.DefaultVerificationFrequency,
+					types.DefaultMaxRetryAttempts,
+					types.DefaultTimeout,
+					[]string{"invalid"},
+				),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
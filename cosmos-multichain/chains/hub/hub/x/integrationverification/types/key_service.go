@@ -0,0 +1,42 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ServiceKeyPrefix is the prefix to retrieve all Service
+	ServiceKeyPrefix = "Service/value/"
+
+	// ServiceQueueKeyPrefix is the prefix of the block-time ordered
+	// re-verification queue
+	ServiceQueueKeyPrefix = "Service/queue/"
+)
+
+// ServiceKey returns the store key to retrieve a Service from the index fields
+func ServiceKey(
+	serviceId string,
+) []byte {
+	var key []byte
+
+	serviceIdBytes := []byte(serviceId)
+	key = append(key, serviceIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ServiceQueueTimeKey returns the queue prefix for services due at unix time
+func ServiceQueueTimeKey(
+	due int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(due))
+	return key
+}
+
+// ServiceQueueKey returns the queue key of a service due at unix time
+func ServiceQueueKey(
+	due int64,
+	serviceId string,
+) []byte {
+	return append(ServiceQueueTimeKey(due), ServiceKey(serviceId)...)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestService{}
+
+func NewMsgAttestService(creator string, serviceId string, verificationMethod string, passed bool, verifiedCapabilities []string) *MsgAttestService {
+	return &MsgAttestService{
+		Creator:              creator,
+		ServiceId:            serviceId,
+		VerificationMethod:   verificationMethod,
+		Passed:               passed,
+		VerifiedCapabilities: verifiedCapabilities,
+	}
+}
+
+func (msg *MsgAttestService) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ServiceId == "" {
+		return errorsmod.Wrap(ErrInvalidService, "service id cannot be empty")
+	}
+	if msg.VerificationMethod == "" {
+		return errorsmod.Wrap(ErrMethodMismatch, "verification method cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterService{}
+
+func NewMsgRegisterService(creator string, serviceId string, serviceType string, capabilities map[string]string) *MsgRegisterService {
+	return &MsgRegisterService{
+		Creator:      creator,
+		ServiceId:    serviceId,
+		ServiceType:  serviceType,
+		Capabilities: capabilities,
+	}
+}
+
+func (msg *MsgRegisterService) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ServiceId == "" {
+		return errorsmod.Wrap(ErrInvalidService, "service id cannot be empty")
+	}
+	if msg.ServiceType == "" {
+		return errorsmod.Wrap(ErrUnknownServiceType, "service type cannot be empty")
+	}
+	for name := range msg.Capabilities {
+		if name == "" {
+			return errorsmod.Wrap(ErrInvalidService, "capability name cannot be empty")
+		}
+	}
+	return nil
+}
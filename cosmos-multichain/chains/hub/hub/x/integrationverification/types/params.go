@@ -0,0 +1,187 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyServiceTypes = []byte("ServiceTypes")
+	// DefaultServiceTypes mirrors integration_verification.service_types in config.yml
+	DefaultServiceTypes = []ServiceType{
+		{Name: "api", VerificationMethod: "oauth2"},
+		{Name: "database", VerificationMethod: "certificate"},
+		{Name: "messaging", VerificationMethod: "api_key"},
+	}
+)
+
+var (
+	KeyVerificationFrequency = []byte("VerificationFrequency")
+	// DefaultVerificationFrequency mirrors integration_verification.verification_frequency in config.yml
+	DefaultVerificationFrequency = 24 * time.Hour
+)
+
+var (
+	KeyMaxRetryAttempts = []byte("MaxRetryAttempts")
+	// DefaultMaxRetryAttempts mirrors integration_verification.max_retry_attempts in config.yml
+	DefaultMaxRetryAttempts uint32 = 3
+)
+
+var (
+	KeyTimeout = []byte("Timeout")
+	// DefaultTimeout mirrors integration_verification.timeout in config.yml
+	DefaultTimeout = 30 * time.Second
+)
+
+var (
+	KeyVerifiers = []byte("Verifiers")
+	// DefaultVerifiers is empty; verifier accounts are assigned at genesis or by governance
+	DefaultVerifiers = []string{}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	serviceTypes []ServiceType,
+	verificationFrequency time.Duration,
+	maxRetryAttempts uint32,
+	timeout time.Duration,
+	verifiers []string,
+) Params {
+	return Params{
+		ServiceTypes:          serviceTypes,
+		VerificationFrequency: verificationFrequency,
+		MaxRetryAttempts:      maxRetryAttempts,
+		Timeout:               timeout,
+		Verifiers:             verifiers,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultServiceTypes,
+		DefaultVerificationFrequency,
+		DefaultMaxRetryAttempts,
+		DefaultTimeout,
+		DefaultVerifiers,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyServiceTypes, &p.ServiceTypes, validateServiceTypes),
+		paramtypes.NewParamSetPair(KeyVerificationFrequency, &p.VerificationFrequency, validatePositiveDuration),
+		paramtypes.NewParamSetPair(KeyMaxRetryAttempts, &p.MaxRetryAttempts, validateMaxRetryAttempts),
+		paramtypes.NewParamSetPair(KeyTimeout, &p.Timeout, validatePositiveDuration),
+		paramtypes.NewParamSetPair(KeyVerifiers, &p.Verifiers, validateVerifiers),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateServiceTypes(p.ServiceTypes); err != nil {
+		return err
+	}
+	if err := validatePositiveDuration(p.VerificationFrequency); err != nil {
+		return fmt.Errorf("verification frequency: %w", err)
+	}
+	if err := validateMaxRetryAttempts(p.MaxRetryAttempts); err != nil {
+		return err
+	}
+	if err := validatePositiveDuration(p.Timeout); err != nil {
+		return fmt.Errorf("timeout: %w", err)
+	}
+	return validateVerifiers(p.Verifiers)
+}
+
+// ServiceType returns the configured service type with the given name
+func (p Params) ServiceType(name string) (ServiceType, bool) {
+	for _, st := range p.ServiceTypes {
+		if st.Name == name {
+			return st, true
+		}
+	}
+	return ServiceType{}, false
+}
+
+// IsVerifier reports whether addr may attest services
+func (p Params) IsVerifier(addr string) bool {
+	for _, v := range p.Verifiers {
+		if v == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// validateServiceTypes validates the ServiceTypes param
+func validateServiceTypes(v interface{}) error {
+	serviceTypes, ok := v.([]ServiceType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if len(serviceTypes) == 0 {
+		return fmt.Errorf("at least one service type is required")
+	}
+	names := make(map[string]struct{}, len(serviceTypes))
+	for _, st := range serviceTypes {
+		if st.Name == "" || st.VerificationMethod == "" {
+			return fmt.Errorf("service type name and verification method cannot be empty")
+		}
+		if _, ok := names[st.Name]; ok {
+			return fmt.Errorf("duplicated service type %s", st.Name)
+		}
+		names[st.Name] = struct{}{}
+	}
+	return nil
+}
+
+// validatePositiveDuration validates a duration param
+func validatePositiveDuration(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("duration must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateMaxRetryAttempts validates the MaxRetryAttempts param
+func validateMaxRetryAttempts(v interface{}) error {
+	if _, ok := v.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateVerifiers validates the Verifiers param
+func validateVerifiers(v interface{}) error {
+	verifiers, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(verifiers))
+	for _, addr := range verifiers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid verifier address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicated verifier %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
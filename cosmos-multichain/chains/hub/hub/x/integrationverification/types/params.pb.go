@@ -0,0 +1,846 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/integrationverification/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// service_types lists the accepted service types and how each is verified.
+	ServiceTypes []ServiceType `protobuf:"bytes,1,rep,name=service_types,json=serviceTypes,proto3" json:"service_types"`
+	// verification_frequency is how long a verification stays valid.
+	VerificationFrequency time.Duration `protobuf:"bytes,2,opt,name=verification_frequency,json=verificationFrequency,proto3,stdduration" json:"verification_frequency"`
+	// max_retry_attempts is how many timeout windows a due service gets before
+	// it is downgraded.
+	MaxRetryAttempts uint32 `protobuf:"varint,3,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	// timeout is the length of each retry window.
+	Timeout time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// verifiers are the accounts authorized to attest services.
+	Verifiers []string `protobuf:"bytes,5,rep,name=verifiers,proto3" json:"verifiers,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eef37927dc2b90de, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetServiceTypes() []ServiceType {
+	if m != nil {
+		return m.ServiceTypes
+	}
+	return nil
+}
+
+func (m *Params) GetVerificationFrequency() time.Duration {
+	if m != nil {
+		return m.VerificationFrequency
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetryAttempts() uint32 {
+	if m != nil {
+		return m.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *Params) GetVerifiers() []string {
+	if m != nil {
+		return m.Verifiers
+	}
+	return nil
+}
+
+// ServiceType pairs a service type with its verification method.
+type ServiceType struct {
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VerificationMethod string `protobuf:"bytes,2,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+}
+
+func (m *ServiceType) Reset()         { *m = ServiceType{} }
+func (m *ServiceType) String() string { return proto.CompactTextString(m) }
+func (*ServiceType) ProtoMessage()    {}
+func (*ServiceType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eef37927dc2b90de, []int{1}
+}
+func (m *ServiceType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceType.Merge(m, src)
+}
+func (m *ServiceType) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceType) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceType proto.InternalMessageInfo
+
+func (m *ServiceType) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceType) GetVerificationMethod() string {
+	if m != nil {
+		return m.VerificationMethod
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hub.integrationverification.Params")
+	proto.RegisterType((*ServiceType)(nil), "hub.integrationverification.ServiceType")
+}
+
+func init() {
+	proto.RegisterFile("hub/integrationverification/params.proto", fileDescriptor_eef37927dc2b90de)
+}
+
+var fileDescriptor_eef37927dc2b90de = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x24, 0x14, 0xe5, 0x42, 0x24, 0x38, 0x0a, 0x72, 0x8b, 0xe4, 0x58, 0x85, 0xc1,
+	0xaa, 0xa8, 0x2d, 0x15, 0x89, 0xa1, 0x12, 0x43, 0x2d, 0xc4, 0x86, 0x84, 0x1c, 0x06, 0xc4, 0x62,
+	0x5d, 0xe2, 0x17, 0xe7, 0x24, 0xce, 0x67, 0xee, 0xce, 0x55, 0xfc, 0x15, 0x98, 0xd8, 0x60, 0x64,
+	0x64, 0xec, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x31, 0x90, 0xef, 0x1c,
+	0xd5, 0x0c, 0x8d, 0xd4, 0xe5, 0xf4, 0xde, 0xfb, 0xbf, 0x7b, 0xf7, 0xd3, 0xff, 0x1e, 0xf6, 0xe7,
+	0xe5, 0x24, 0x64, 0xb9, 0x86, 0x4c, 0x52, 0xcd, 0x44, 0x7e, 0x02, 0x92, 0xcd, 0xd8, 0xd4, 0xc4,
+	0x61, 0x41, 0x25, 0xe5, 0x2a, 0x28, 0xa4, 0xd0, 0x82, 0x3c, 0x9c, 0x97, 0x93, 0xe0, 0x8a, 0xce,
+	0xdd, 0xbb, 0x94, 0xb3, 0x5c, 0x84, 0xe6, 0xb4, 0xfd, 0xbb, 0x3b, 0x53, 0xa1, 0xb8, 0x50, 0x89,
+	0xc9, 0x42, 0x9b, 0x34, 0xd2, 0x76, 0x26, 0x32, 0x61, 0xeb, 0x75, 0xd4, 0x54, 0xdd, 0x4c, 0x88,
+	0xec, 0x3d, 0x84, 0x26, 0x9b, 0x94, 0xb3, 0x30, 0x2d, 0xed, 0x4b, 0x56, 0xdf, 0xfb, 0xdc, 0xc5,
+	0x5b, 0xaf, 0x0d, 0x11, 0x19, 0xe3, 0xa1, 0x02, 0x79, 0xc2, 0xa6, 0x90, 0xe8, 0xaa, 0x00, 0xe5,
+	0x20, 0xaf, 0xeb, 0x0f, 0x0e, 0xfd, 0x60, 0x03, 0x63, 0x30, 0xb6, 0x37, 0xde, 0x54, 0x05, 0x44,
+	0xbd, 0xb3, 0x5f, 0xa3, 0x4e, 0x7c, 0x5b, 0x5d, 0x96, 0x14, 0x49, 0xf0, 0x83, 0x76, 0x7f, 0x32,
+	0x93, 0xf0, 0xa1, 0x84, 0x7c, 0x5a, 0x39, 0x37, 0x3c, 0xe4, 0x0f, 0x0e, 0x77, 0x02, 0x0b, 0x18,
+	0xac, 0x01, 0x83, 0x17, 0x0d, 0x60, 0x34, 0xac, 0xc7, 0x7d, 0xf9, 0x3d, 0x42, 0xdf, 0x2e, 0x4e,
+	0xf7, 0x51, 0x7c, 0xbf, 0x3d, 0xe7, 0xe5, 0x7a, 0x0c, 0x79, 0x82, 0x09, 0xa7, 0x8b, 0x44, 0x82,
+	0x96, 0x55, 0x42, 0xb5, 0x06, 0x5e, 0x68, 0xe5, 0x74, 0x3d, 0xe4, 0x0f, 0xe3, 0x3b, 0x9c, 0x2e,
+	0xe2, 0x5a, 0x38, 0x6e, 0xea, 0x24, 0xc2, 0xb7, 0x34, 0xe3, 0x20, 0x4a, 0xed, 0xf4, 0xae, 0xf9,
+	0xfe, 0xfa, 0x22, 0x79, 0x86, 0xfb, 0x16, 0x05, 0xa4, 0x72, 0x6e, 0x7a, 0x5d, 0xbf, 0x1f, 0x39,
+	0x3f, 0xbe, 0x1f, 0x6c, 0x37, 0xbf, 0x71, 0x9c, 0xa6, 0x12, 0x94, 0x1a, 0x6b, 0xc9, 0xf2, 0x2c,
+	0xbe, 0x6c, 0x3d, 0x3a, 0xf8, 0xfb, 0x75, 0x84, 0x3e, 0x5e, 0x9c, 0xee, 0x3f, 0xae, 0xd7, 0x63,
+	0x71, 0xe5, 0x82, 0xd8, 0xef, 0xd8, 0x7b, 0x8b, 0x07, 0x2d, 0x73, 0x09, 0xc1, 0xbd, 0x9c, 0x72,
+	0x70, 0x90, 0x87, 0xfc, 0x7e, 0x6c, 0x62, 0x12, 0xe2, 0x7b, 0xff, 0x99, 0xcb, 0x41, 0xcf, 0x45,
+	0x6a, 0x9c, 0xed, 0xc7, 0xa4, 0x2d, 0xbd, 0x32, 0xca, 0x51, 0xaf, 0x46, 0x88, 0x9e, 0x9f, 0x2d,
+	0x5d, 0x74, 0xbe, 0x74, 0xd1, 0x9f, 0xa5, 0x8b, 0x3e, 0xad, 0xdc, 0xce, 0xf9, 0xca, 0xed, 0xfc,
+	0x5c, 0xb9, 0x9d, 0x77, 0x8f, 0x36, 0x93, 0x99, 0xb5, 0x98, 0x6c, 0x19, 0xab, 0x9e, 0xfe, 0x1b,
+	0x00, 0x25, 0x49, 0x30, 0x78, 0xe6, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ServiceTypes) != len(that1.ServiceTypes) {
+		return false
+	}
+	for i := range this.ServiceTypes {
+		if !this.ServiceTypes[i].Equal(&that1.ServiceTypes[i]) {
+			return false
+		}
+	}
+	if this.VerificationFrequency != that1.VerificationFrequency {
+		return false
+	}
+	if this.MaxRetryAttempts != that1.MaxRetryAttempts {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	if len(this.Verifiers) != len(that1.Verifiers) {
+		return false
+	}
+	for i := range this.Verifiers {
+		if this.Verifiers[i] != that1.Verifiers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ServiceType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServiceType)
+	if !ok {
+		that2, ok := that.(ServiceType)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.VerificationMethod != that1.VerificationMethod {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifiers) > 0 {
+		for iNdEx := len(m.Verifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verifiers[iNdEx])
+			copy(dAtA[i:], m.Verifiers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Verifiers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxRetryAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VerificationFrequency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VerificationFrequency):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ServiceTypes) > 0 {
+		for iNdEx := len(m.ServiceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationMethod) > 0 {
+		i -= len(m.VerificationMethod)
+		copy(dAtA[i:], m.VerificationMethod)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VerificationMethod)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ServiceTypes) > 0 {
+		for _, e := range m.ServiceTypes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VerificationFrequency)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxRetryAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryAttempts))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Verifiers) > 0 {
+		for _, s := range m.Verifiers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.VerificationMethod)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceTypes = append(m.ServiceTypes, ServiceType{})
+			if err := m.ServiceTypes[len(m.ServiceTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFrequency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VerificationFrequency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttempts", wireType)
+			}
+			m.MaxRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiers = append(m.Verifiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,566 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/integrationverification/service.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "hub/x/crosschain/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ServiceRecord tracks a registered third-party service and its verification
+// schedule.
+type ServiceRecord struct {
+	Service              *types.ServiceVerification `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Owner                string                     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	VerifiedCapabilities []string                   `protobuf:"bytes,3,rep,name=verified_capabilities,json=verifiedCapabilities,proto3" json:"verified_capabilities,omitempty"`
+	LastVerifier         string                     `protobuf:"bytes,4,opt,name=last_verifier,json=lastVerifier,proto3" json:"last_verifier,omitempty"`
+	// next_verification is the unix time the current verification lapses; zero
+	// when the service is not scheduled.
+	NextVerification int64  `protobuf:"varint,5,opt,name=next_verification,json=nextVerification,proto3" json:"next_verification,omitempty"`
+	RetryCount       uint32 `protobuf:"varint,6,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+}
+
+func (m *ServiceRecord) Reset()         { *m = ServiceRecord{} }
+func (m *ServiceRecord) String() string { return proto.CompactTextString(m) }
+func (*ServiceRecord) ProtoMessage()    {}
+func (*ServiceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6d1a254280543a4, []int{0}
+}
+func (m *ServiceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceRecord.Merge(m, src)
+}
+func (m *ServiceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceRecord proto.InternalMessageInfo
+
+func (m *ServiceRecord) GetService() *types.ServiceVerification {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *ServiceRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ServiceRecord) GetVerifiedCapabilities() []string {
+	if m != nil {
+		return m.VerifiedCapabilities
+	}
+	return nil
+}
+
+func (m *ServiceRecord) GetLastVerifier() string {
+	if m != nil {
+		return m.LastVerifier
+	}
+	return ""
+}
+
+func (m *ServiceRecord) GetNextVerification() int64 {
+	if m != nil {
+		return m.NextVerification
+	}
+	return 0
+}
+
+func (m *ServiceRecord) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ServiceRecord)(nil), "hub.integrationverification.ServiceRecord")
+}
+
+func init() {
+	proto.RegisterFile("hub/integrationverification/service.proto", fileDescriptor_d6d1a254280543a4)
+}
+
+var fileDescriptor_d6d1a254280543a4 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcf, 0x4a, 0xc3, 0x30,
+	0x1c, 0x5e, 0x36, 0x37, 0x59, 0xe6, 0x40, 0xc3, 0x84, 0xa0, 0x10, 0xab, 0xbb, 0x54, 0x84, 0x94,
+	0xb9, 0xa3, 0x78, 0x71, 0x6f, 0x10, 0x61, 0x07, 0x2f, 0xa3, 0xc9, 0xa2, 0x0b, 0x8c, 0x66, 0xa6,
+	0x69, 0xdd, 0x7c, 0x04, 0x4f, 0x3e, 0x96, 0xc7, 0x1d, 0x3d, 0x4a, 0xfb, 0x22, 0xd2, 0xb4, 0x85,
+	0x5e, 0x76, 0x4b, 0xbe, 0x7f, 0x7c, 0xfc, 0x3e, 0x78, 0xbb, 0x4a, 0x78, 0xa0, 0x22, 0x2b, 0xdf,
+	0x4c, 0x68, 0x95, 0x8e, 0x52, 0x69, 0xd4, 0xab, 0x12, 0xee, 0x1d, 0xc4, 0xd2, 0xa4, 0x4a, 0x48,
+	0xba, 0x31, 0xda, 0x6a, 0x74, 0xb9, 0x4a, 0x38, 0x3d, 0x20, 0xbd, 0xc0, 0x7c, 0xad, 0xdf, 0x3f,
+	0x03, 0xc5, 0x45, 0x90, 0x4e, 0x02, 0xbb, 0xdb, 0xc8, 0xb8, 0xb4, 0xdd, 0x7c, 0xb5, 0xe1, 0xf0,
+	0xb9, 0x0c, 0x62, 0x52, 0x68, 0xb3, 0x44, 0x0f, 0xf0, 0xb8, 0x4a, 0xc6, 0xc0, 0x03, 0xfe, 0xe0,
+	0xfe, 0x9a, 0x3a, 0x37, 0x55, 0x5c, 0xd0, 0x74, 0x42, 0x2b, 0xf5, 0xbc, 0x91, 0xcf, 0x6a, 0x07,
+	0x1a, 0xc1, 0xae, 0xfe, 0x88, 0xa4, 0xc1, 0x6d, 0x0f, 0xf8, 0x7d, 0x56, 0x7e, 0xd0, 0x14, 0x9e,
+	0x97, 0x75, 0xe4, 0x72, 0x21, 0xc2, 0x4d, 0xc8, 0xd5, 0x5a, 0x59, 0x25, 0x63, 0xdc, 0xf1, 0x3a,
+	0x7e, 0x9f, 0x8d, 0x6a, 0x72, 0xd6, 0xe0, 0xd0, 0x18, 0x0e, 0xd7, 0x61, 0x6c, 0x17, 0x15, 0x69,
+	0xf0, 0x91, 0x8b, 0x3c, 0x29, 0xc0, 0x79, 0x85, 0xa1, 0x3b, 0x78, 0x16, 0xc9, 0x6d, 0x2d, 0x2a,
+	0xdb, 0xe0, 0xae, 0x07, 0xfc, 0x0e, 0x3b, 0x2d, 0x88, 0x66, 0x4b, 0x74, 0x05, 0x07, 0x46, 0x5a,
+	0xb3, 0x5b, 0x08, 0x9d, 0x44, 0x16, 0xf7, 0x3c, 0xe0, 0x0f, 0x19, 0x74, 0xd0, 0xac, 0x40, 0x9e,
+	0x1e, 0x7f, 0x32, 0x02, 0xf6, 0x19, 0x01, 0x7f, 0x19, 0x01, 0xdf, 0x39, 0x69, 0xed, 0x73, 0xd2,
+	0xfa, 0xcd, 0x49, 0xeb, 0x65, 0x5c, 0x0c, 0xb1, 0x3d, 0x38, 0x85, 0xbb, 0x28, 0xef, 0xb9, 0x93,
+	0x4e, 0xff, 0x07, 0x00, 0xa5, 0x08, 0x34, 0xcc, 0xb6, 0x01, 0x00, 0x00,
+}
+
+func (m *ServiceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextVerification != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextVerification))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LastVerifier) > 0 {
+		i -= len(m.LastVerifier)
+		copy(dAtA[i:], m.LastVerifier)
+		i = encodeVarintService(dAtA, i, uint64(len(m.LastVerifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for iNdEx := len(m.VerifiedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedCapabilities[iNdEx])
+			copy(dAtA[i:], m.VerifiedCapabilities[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.VerifiedCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ServiceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.VerifiedCapabilities) > 0 {
+		for _, s := range m.VerifiedCapabilities {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.LastVerifier)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.NextVerification != 0 {
+		n += 1 + sovService(uint64(m.NextVerification))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovService(uint64(m.RetryCount))
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ServiceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &types.ServiceVerification{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedCapabilities = append(m.VerifiedCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVerifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastVerifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVerification", wireType)
+			}
+			m.NextVerification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVerification |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/profileverification/types"
+)
+
+// SetAttestation set a specific attestation in the store from its index
+func (k Keeper) SetAttestation(ctx context.Context, attestation types.Attestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	b := k.cdc.MustMarshal(&attestation)
+	store.Set(types.AttestationKey(
+		attestation.UserId,
+		attestation.CredentialType,
+		attestation.Attestor,
+	), b)
+}
+
+// GetAttestation returns an attestation from its index
+func (k Keeper) GetAttestation(
+	ctx context.Context,
+	userId string,
+	credentialType string,
+	attestor string,
+) (val types.Attestation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+
+	b := store.Get(types.AttestationKey(
+		userId,
+		credentialType,
+		attestor,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetUserAttestations returns every attestation recorded for a user
+func (k Keeper) GetUserAttestations(ctx context.Context, userId string) (list []types.Attestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.AttestationUserKey(userId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveUserAttestations removes every attestation recorded for a user
+func (k Keeper) RemoveUserAttestations(ctx context.Context, userId string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.AttestationUserKey(userId))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllAttestation returns all attestation
+func (k Keeper) GetAllAttestation(ctx context.Context) (list []types.Attestation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AttestationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Attestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+func (k msgServer) AttestCredential(goCtx context.Context, msg *types.MsgAttestCredential) (*types.MsgAttestCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+
+	provider, found := k.GetParams(ctx).ProviderFor(msg.Creator)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrUnauthorizedAttestor, msg.Creator)
+	}
+
+	profile, found := k.GetProfile(ctx, msg.UserId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrProfileNotFound, msg.UserId)
+	}
+	if _, found := k.GetVerificationRequest(ctx, msg.UserId); !found {
+		return nil, errorsmod.Wrap(types.ErrNoPendingRequest, msg.UserId)
+	}
+	if _, held := findCredential(profile, msg.CredentialType, now); !held {
+		return nil, errorsmod.Wrap(types.ErrCredentialNotFound, msg.CredentialType)
+	}
+	if _, found := k.GetAttestation(ctx, msg.UserId, msg.CredentialType, msg.Creator); found {
+		return nil, errorsmod.Wrap(types.ErrDuplicateAttestation, msg.CredentialType)
+	}
+
+	k.SetAttestation(ctx, types.Attestation{
+		UserId:         msg.UserId,
+		CredentialType: msg.CredentialType,
+		Attestor:       msg.Creator,
+		Provider:       provider.Name,
+		Weight:         provider.Weight,
+		Timestamp:      now,
+	})
+
+	if k.levelRequirementsMet(ctx, profile) {
+		profile.Status = crosschaintypes.VerificationStatus_STATUS_VERIFIED
+		profile.VerificationTimestamp = now
+		profile.VerifierId = msg.Creator
+		k.SetProfile(ctx, profile)
+		k.RemoveVerificationRequest(ctx, msg.UserId)
+
+		if err := k.emitProfileVerification(ctx, profile); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAttestCredentialResponse{
+		Status: profile.Status,
+		Weight: k.AttestedWeight(ctx, msg.UserId, msg.CredentialType),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+func (k msgServer) SubmitProfile(goCtx context.Context, msg *types.MsgSubmitProfile) (*types.MsgSubmitProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if _, found := params.Level(msg.Level); !found {
+		return nil, errorsmod.Wrap(types.ErrUnknownLevel, msg.Level)
+	}
+
+	// Resubmitting replaces the credentials, so earlier attestations no
+	// longer apply.
+	k.RemoveUserAttestations(ctx, msg.Creator)
+
+	profile := crosschaintypes.UserProfile{
+		UserId:      msg.Creator,
+		ProfileType: msg.Level,
+		Credentials: msg.Credentials,
+		Status:      crosschaintypes.VerificationStatus_STATUS_PENDING,
+	}
+	k.SetProfile(ctx, profile)
+
+	now := ctx.BlockTime()
+	request := types.VerificationRequest{
+		UserId:      msg.Creator,
+		Level:       msg.Level,
+		SubmittedAt: now.Unix(),
+		Deadline:    now.Add(params.VerificationTimeout).Unix(),
+	}
+	k.SetVerificationRequest(ctx, request)
+
+	if err := k.emitProfileVerification(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitProfileResponse{
+		UserId:   msg.Creator,
+		Deadline: request.Deadline,
+	}, nil
+}
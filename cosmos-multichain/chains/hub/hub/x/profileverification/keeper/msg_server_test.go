@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	"hub/testutil/sample"
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/keeper"
+	"hub/x/profileverification/types"
+)
+
+type attestors struct {
+	internal   []string
+	thirdParty string
+}
+
+func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, sdk.Context, attestors) {
+	k, ctx := keepertest.ProfileverificationKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	a := attestors{
+		internal:   []string{sample.AccAddress(), sample.AccAddress()},
+		thirdParty: sample.AccAddress(),
+	}
+	params := types.DefaultParams()
+	params.VerificationProviders = []types.VerificationProvider{
+		{Name: "internal", Weight: 1, Attestors: a.internal},
+		{Name: "third_party", Weight: 2, Attestors: []string{a.thirdParty}},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return k, keeper.NewMsgServerImpl(k), ctx, a
+}
+
+func basicCredentials() []*crosschaintypes.Credential {
+	return []*crosschaintypes.Credential{
+		{CredentialType: "email", Issuer: "mail"},
+		{CredentialType: "phone", Issuer: "carrier"},
+	}
+}
+
+func TestProfileVerifiedOnceRequirementsMet(t *testing.T) {
+	k, srv, ctx, a := setupMsgServer(t)
+	user := sample.AccAddress()
+
+	_, err := srv.SubmitProfile(ctx, &types.MsgSubmitProfile{Creator: user, Level: "basic", Credentials: basicCredentials()})
+	require.NoError(t, err)
+
+	// A single internal attestation is below the threshold.
+	res, err := srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.internal[0], UserId: user, CredentialType: "email"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Weight)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_PENDING, res.Status)
+
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.internal[0], UserId: user, CredentialType: "email"})
+	require.ErrorIs(t, err, types.ErrDuplicateAttestation)
+
+	res, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.internal[1], UserId: user, CredentialType: "email"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Weight)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_PENDING, res.Status)
+
+	res, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.thirdParty, UserId: user, CredentialType: "phone"})
+	require.NoError(t, err)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, res.Status)
+
+	profile, found := k.GetProfile(ctx, user)
+	require.True(t, found)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, profile.Status)
+	require.Equal(t, a.thirdParty, profile.VerifierId)
+
+	_, found = k.GetVerificationRequest(ctx, user)
+	require.False(t, found)
+}
+
+func TestAttestCredentialRejections(t *testing.T) {
+	_, srv, ctx, a := setupMsgServer(t)
+	user := sample.AccAddress()
+
+	_, err := srv.SubmitProfile(ctx, &types.MsgSubmitProfile{Creator: user, Level: "platinum"})
+	require.ErrorIs(t, err, types.ErrUnknownLevel)
+
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.thirdParty, UserId: user, CredentialType: "email"})
+	require.ErrorIs(t, err, types.ErrProfileNotFound)
+
+	_, err = srv.SubmitProfile(ctx, &types.MsgSubmitProfile{Creator: user, Level: "basic", Credentials: basicCredentials()})
+	require.NoError(t, err)
+
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: sample.AccAddress(), UserId: user, CredentialType: "email"})
+	require.ErrorIs(t, err, types.ErrUnauthorizedAttestor)
+
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.thirdParty, UserId: user, CredentialType: "government_id"})
+	require.ErrorIs(t, err, types.ErrCredentialNotFound)
+}
+
+func TestExpireVerificationRequests(t *testing.T) {
+	k, srv, ctx, _ := setupMsgServer(t)
+	user := sample.AccAddress()
+
+	res, err := srv.SubmitProfile(ctx, &types.MsgSubmitProfile{Creator: user, Level: "basic", Credentials: basicCredentials()})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultVerificationTimeout).Unix(), res.Deadline)
+
+	require.NoError(t, k.ExpireVerificationRequests(ctx))
+	profile, _ := k.GetProfile(ctx, user)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_PENDING, profile.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(res.Deadline, 0))
+	require.NoError(t, k.ExpireVerificationRequests(ctx))
+	profile, _ = k.GetProfile(ctx, user)
+	require.Equal(t, crosschaintypes.VerificationStatus_STATUS_REJECTED, profile.Status)
+	require.Empty(t, k.GetAllVerificationRequest(ctx))
+}
+
+func TestIsCredentialAttested(t *testing.T) {
+	k, srv, ctx, a := setupMsgServer(t)
+	user := sample.AccAddress()
+	email := crosschaintypes.Credential{CredentialType: "email", Issuer: "mail"}
+
+	require.False(t, k.IsCredentialAttested(ctx, user, email))
+
+	_, err := srv.SubmitProfile(ctx, &types.MsgSubmitProfile{Creator: user, Level: "basic", Credentials: basicCredentials()})
+	require.NoError(t, err)
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.internal[0], UserId: user, CredentialType: "email"})
+	require.NoError(t, err)
+	require.False(t, k.IsCredentialAttested(ctx, user, email))
+
+	_, err = srv.AttestCredential(ctx, &types.MsgAttestCredential{Creator: a.thirdParty, UserId: user, CredentialType: "email"})
+	require.NoError(t, err)
+	require.True(t, k.IsCredentialAttested(ctx, user, email))
+
+	// the attestation covers the registered credential only
+	require.False(t, k.IsCredentialAttested(ctx, user, crosschaintypes.Credential{CredentialType: "email", Issuer: "self"}))
+	require.False(t, k.IsCredentialAttested(ctx, user, crosschaintypes.Credential{CredentialType: "phone", Issuer: "carrier"}))
+	require.False(t, k.IsCredentialAttested(ctx, sample.AccAddress(), email))
+}
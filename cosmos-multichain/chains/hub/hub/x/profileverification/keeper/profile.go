@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+// SetProfile set a specific profile in the store from its index
+func (k Keeper) SetProfile(ctx context.Context, profile crosschaintypes.UserProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKeyPrefix))
+	b := k.cdc.MustMarshal(&profile)
+	store.Set(types.ProfileKey(
+		profile.UserId,
+	), b)
+}
+
+// GetProfile returns a profile from its index
+func (k Keeper) GetProfile(
+	ctx context.Context,
+	userId string,
+) (val crosschaintypes.UserProfile, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKeyPrefix))
+
+	b := store.Get(types.ProfileKey(
+		userId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProfile removes a profile from the store
+func (k Keeper) RemoveProfile(
+	ctx context.Context,
+	userId string,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKeyPrefix))
+	store.Delete(types.ProfileKey(
+		userId,
+	))
+}
+
+// GetAllProfile returns all profile
+func (k Keeper) GetAllProfile(ctx context.Context) (list []crosschaintypes.UserProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val crosschaintypes.UserProfile
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
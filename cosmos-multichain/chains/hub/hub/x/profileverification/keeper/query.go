@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"hub/x/profileverification/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/profileverification/types"
+)
+
+func (k Keeper) Attestations(ctx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var attestations []types.Attestation
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	attestationStore := prefix.NewStore(store, append(types.KeyPrefix(types.AttestationKeyPrefix), types.AttestationUserKey(req.UserId)...))
+
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.Attestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsResponse{Attestation: attestations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+func (k Keeper) ProfileAll(ctx context.Context, req *types.QueryAllProfileRequest) (*types.QueryAllProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var profiles []crosschaintypes.UserProfile
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	profileStore := prefix.NewStore(store, types.KeyPrefix(types.ProfileKeyPrefix))
+
+	pageRes, err := query.Paginate(profileStore, req.Pagination, func(key []byte, value []byte) error {
+		var profile crosschaintypes.UserProfile
+		if err := k.cdc.Unmarshal(value, &profile); err != nil {
+			return err
+		}
+
+		profiles = append(profiles, profile)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProfileResponse{Profile: profiles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Profile(ctx context.Context, req *types.QueryGetProfileRequest) (*types.QueryGetProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetProfile(
+		ctx,
+		req.UserId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	res := &types.QueryGetProfileResponse{
+		Profile: val,
+		Tallies: k.RequirementTallies(ctx, val),
+	}
+	if request, found := k.GetVerificationRequest(ctx, req.UserId); found {
+		res.Request = &request
+	}
+
+	return res, nil
+}
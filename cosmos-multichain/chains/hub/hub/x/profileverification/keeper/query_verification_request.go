@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/profileverification/types"
+)
+
+func (k Keeper) VerificationRequestAll(ctx context.Context, req *types.QueryAllVerificationRequestRequest) (*types.QueryAllVerificationRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var verificationRequests []types.VerificationRequest
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	verificationRequestStore := prefix.NewStore(store, types.KeyPrefix(types.VerificationRequestKeyPrefix))
+
+	pageRes, err := query.Paginate(verificationRequestStore, req.Pagination, func(key []byte, value []byte) error {
+		var verificationRequest types.VerificationRequest
+		if err := k.cdc.Unmarshal(value, &verificationRequest); err != nil {
+			return err
+		}
+
+		verificationRequests = append(verificationRequests, verificationRequest)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllVerificationRequestResponse{VerificationRequest: verificationRequests, Pagination: pageRes}, nil
+}
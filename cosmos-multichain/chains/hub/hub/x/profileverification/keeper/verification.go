@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+// AttestedWeight returns the combined provider weight attesting a credential.
+func (k Keeper) AttestedWeight(ctx context.Context, userId, credentialType string) (weight uint64) {
+	for _, att := range k.GetUserAttestations(ctx, userId) {
+		if att.CredentialType == credentialType {
+			weight += att.Weight
+		}
+	}
+	return weight
+}
+
+// IsCredentialAttested reports whether the user's profile holds the
+// credential, unexpired, and its attested weight reaches the attestation
+// threshold.
+func (k Keeper) IsCredentialAttested(ctx context.Context, userId string, credential crosschaintypes.Credential) bool {
+	profile, found := k.GetProfile(ctx, userId)
+	if !found {
+		return false
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	held, found := findCredential(profile, credential.CredentialType, now)
+	if !found || held.Issuer != credential.Issuer || !bytes.Equal(held.CredentialData, credential.CredentialData) {
+		return false
+	}
+	return k.AttestedWeight(ctx, userId, credential.CredentialType) >= k.GetParams(ctx).AttestationThreshold
+}
+
+// RequirementTallies reports, for each requirement of the profile's level,
+// the attested weight and whether the requirement is met. A requirement is
+// met when the profile carries an unexpired credential of that type whose
+// attested weight reaches the attestation threshold.
+func (k Keeper) RequirementTallies(ctx context.Context, profile crosschaintypes.UserProfile) []types.RequirementTally {
+	params := k.GetParams(ctx)
+	level, found := params.Level(profile.ProfileType)
+	if !found {
+		return nil
+	}
+
+	weights := make(map[string]uint64)
+	for _, att := range k.GetUserAttestations(ctx, profile.UserId) {
+		weights[att.CredentialType] += att.Weight
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	tallies := make([]types.RequirementTally, 0, len(level.Requirements))
+	for _, req := range level.Requirements {
+		_, held := findCredential(profile, req, now)
+		tallies = append(tallies, types.RequirementTally{
+			CredentialType: req,
+			Weight:         weights[req],
+			Satisfied:      held && weights[req] >= params.AttestationThreshold,
+		})
+	}
+	return tallies
+}
+
+// levelRequirementsMet reports whether every requirement of the profile's
+// level is satisfied.
+func (k Keeper) levelRequirementsMet(ctx context.Context, profile crosschaintypes.UserProfile) bool {
+	tallies := k.RequirementTallies(ctx, profile)
+	if len(tallies) == 0 {
+		return false
+	}
+	for _, tally := range tallies {
+		if !tally.Satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpireVerificationRequests rejects every pending profile whose request
+// deadline has passed.
+func (k Keeper) ExpireVerificationRequests(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	for _, userId := range k.GetDueVerificationRequests(ctx, now) {
+		k.RemoveVerificationRequest(ctx, userId)
+
+		profile, found := k.GetProfile(ctx, userId)
+		if !found || profile.Status != crosschaintypes.VerificationStatus_STATUS_PENDING {
+			continue
+		}
+		profile.Status = crosschaintypes.VerificationStatus_STATUS_REJECTED
+		profile.VerificationTimestamp = now
+		k.SetProfile(ctx, profile)
+
+		if err := k.emitProfileVerification(sdkCtx, profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) emitProfileVerification(ctx sdk.Context, profile crosschaintypes.UserProfile) error {
+	return ctx.EventManager().EmitTypedEvent(&crosschaintypes.ProfileVerificationEvent{
+		EventId:    fmt.Sprintf("%s-%d", profile.UserId, ctx.BlockHeight()),
+		ProfileId:  profile.UserId,
+		Status:     profile.Status,
+		Timestamp:  ctx.BlockTime().Unix(),
+		VerifierId: profile.VerifierId,
+	})
+}
+
+// findCredential returns the profile credential of the given type if it has
+// not expired.
+func findCredential(profile crosschaintypes.UserProfile, credentialType string, now int64) (*crosschaintypes.Credential, bool) {
+	for _, c := range profile.Credentials {
+		if c.CredentialType != credentialType {
+			continue
+		}
+		if c.ExpiryDate != 0 && c.ExpiryDate <= now {
+			return nil, false
+		}
+		return c, true
+	}
+	return nil, false
+}
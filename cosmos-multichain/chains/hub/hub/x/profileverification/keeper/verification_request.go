@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/profileverification/types"
+)
+
+// SetVerificationRequest set a specific verificationRequest in the store from
+// its index and queues it under its deadline. Any previous request for the
+// same user is replaced.
+func (k Keeper) SetVerificationRequest(ctx context.Context, verificationRequest types.VerificationRequest) {
+	k.RemoveVerificationRequest(ctx, verificationRequest.UserId)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationRequestKeyPrefix))
+	b := k.cdc.MustMarshal(&verificationRequest)
+	store.Set(types.VerificationRequestKey(
+		verificationRequest.UserId,
+	), b)
+
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationQueueKeyPrefix))
+	queue.Set(types.VerificationQueueKey(
+		verificationRequest.Deadline,
+		verificationRequest.UserId,
+	), []byte(verificationRequest.UserId))
+}
+
+// GetVerificationRequest returns a verificationRequest from its index
+func (k Keeper) GetVerificationRequest(
+	ctx context.Context,
+	userId string,
+) (val types.VerificationRequest, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationRequestKeyPrefix))
+
+	b := store.Get(types.VerificationRequestKey(
+		userId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveVerificationRequest removes a verificationRequest and its queue entry
+func (k Keeper) RemoveVerificationRequest(
+	ctx context.Context,
+	userId string,
+) {
+	existing, found := k.GetVerificationRequest(ctx, userId)
+	if !found {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationRequestKeyPrefix))
+	store.Delete(types.VerificationRequestKey(
+		userId,
+	))
+
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationQueueKeyPrefix))
+	queue.Delete(types.VerificationQueueKey(
+		existing.Deadline,
+		userId,
+	))
+}
+
+// GetAllVerificationRequest returns all verificationRequest
+func (k Keeper) GetAllVerificationRequest(ctx context.Context) (list []types.VerificationRequest) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationRequestKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VerificationRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDueVerificationRequests returns the users whose request deadline is at or
+// before the given unix time, in deadline order
+func (k Keeper) GetDueVerificationRequests(ctx context.Context, now int64) (userIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.VerificationQueueKeyPrefix))
+	iterator := queue.Iterator(nil, types.VerificationQueueTimeKey(now+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		userIds = append(userIds, string(iterator.Value()))
+	}
+
+	return
+}
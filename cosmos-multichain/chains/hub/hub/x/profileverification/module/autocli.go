@@ -0,0 +1,69 @@
+package profileverification
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "hub/api/hub/profileverification"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ProfileAll",
+					Use:       "list-profile",
+					Short:     "List all profiles",
+				},
+				{
+					RpcMethod:      "Profile",
+					Use:            "show-profile [user-id]",
+					Short:          "Shows a profile with its requirement tallies",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user_id"}},
+				},
+				{
+					RpcMethod:      "Attestations",
+					Use:            "attestations [user-id]",
+					Short:          "Lists the attestations recorded for a user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user_id"}},
+				},
+				{
+					RpcMethod: "VerificationRequestAll",
+					Use:       "list-verification-request",
+					Short:     "List all pending verification requests",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "SubmitProfile",
+					Use:            "submit-profile [level]",
+					Short:          "Submit the signer's profile for verification at a level",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "level"}},
+				},
+				{
+					RpcMethod:      "AttestCredential",
+					Use:            "attest-credential [user-id] [credential-type]",
+					Short:          "Attest a user's credential on behalf of a verification provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user_id"}, {ProtoField: "credential_type"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package profileverification
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"hub/x/profileverification/keeper"
+	"hub/x/profileverification/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the profile
+	for _, elem := range genState.ProfileList {
+		k.SetProfile(ctx, elem)
+	}
+	// Set all the attestation
+	for _, elem := range genState.AttestationList {
+		k.SetAttestation(ctx, elem)
+	}
+	// Set all the verificationRequest
+	for _, elem := range genState.VerificationRequestList {
+		k.SetVerificationRequest(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.ProfileList = k.GetAllProfile(ctx)
+	genesis.AttestationList = k.GetAllAttestation(ctx)
+	genesis.VerificationRequestList = k.GetAllVerificationRequest(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
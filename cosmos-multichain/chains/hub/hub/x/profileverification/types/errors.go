@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/profileverification module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnknownLevel         = sdkerrors.Register(ModuleName, 1101, "unknown verification level")
+	ErrInvalidCredential    = sdkerrors.Register(ModuleName, 1102, "invalid credential")
+	ErrProfileNotFound      = sdkerrors.Register(ModuleName, 1103, "profile not found")
+	ErrNoPendingRequest     = sdkerrors.Register(ModuleName, 1104, "profile has no pending verification request")
+	ErrUnauthorizedAttestor = sdkerrors.Register(ModuleName, 1105, "account is not an attestor of any verification provider")
+	ErrCredentialNotFound   = sdkerrors.Register(ModuleName, 1106, "credential not found or expired")
+	ErrDuplicateAttestation = sdkerrors.Register(ModuleName, 1107, "credential already attested by this account")
+)
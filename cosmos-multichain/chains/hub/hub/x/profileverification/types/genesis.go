@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ProfileList:             []crosschaintypes.UserProfile{},
+		AttestationList:         []Attestation{},
+		VerificationRequestList: []VerificationRequest{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in profile
+	profileIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProfileList {
+		index := string(ProfileKey(elem.UserId))
+		if _, ok := profileIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for profile")
+		}
+		profileIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in attestation
+	attestationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.AttestationList {
+		if _, ok := profileIndexMap[string(ProfileKey(elem.UserId))]; !ok {
+			return fmt.Errorf("attestation references unknown profile %s", elem.UserId)
+		}
+		index := string(AttestationKey(elem.UserId, elem.CredentialType, elem.Attestor))
+		if _, ok := attestationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for attestation")
+		}
+		attestationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in verificationRequest
+	verificationRequestIndexMap := make(map[string]struct{})
+	for _, elem := range gs.VerificationRequestList {
+		if _, ok := profileIndexMap[string(ProfileKey(elem.UserId))]; !ok {
+			return fmt.Errorf("verification request references unknown profile %s", elem.UserId)
+		}
+		index := string(VerificationRequestKey(elem.UserId))
+		if _, ok := verificationRequestIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for verificationRequest")
+		}
+		verificationRequestIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
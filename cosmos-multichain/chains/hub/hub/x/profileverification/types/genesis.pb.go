@@ -0,0 +1,520 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/profileverification/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "hub/x/crosschain/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the profileverification module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params                  Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ProfileList             []types.UserProfile   `protobuf:"bytes,2,rep,name=profileList,proto3" json:"profileList"`
+	AttestationList         []Attestation         `protobuf:"bytes,3,rep,name=attestationList,proto3" json:"attestationList"`
+	VerificationRequestList []VerificationRequest `protobuf:"bytes,4,rep,name=verificationRequestList,proto3" json:"verificationRequestList"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e1b8bb73dc5b29, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetProfileList() []types.UserProfile {
+	if m != nil {
+		return m.ProfileList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationList() []Attestation {
+	if m != nil {
+		return m.AttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerificationRequestList() []VerificationRequest {
+	if m != nil {
+		return m.VerificationRequestList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "hub.profileverification.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("hub/profileverification/genesis.proto", fileDescriptor_c8e1b8bb73dc5b29)
+}
+
+var fileDescriptor_c8e1b8bb73dc5b29 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x27, 0x6d, 0x29, 0x7c, 0xe9, 0x07, 0xe2, 0x20, 0xb4, 0x76, 0x91, 0x16, 0xa9, 0x50,
+	0x44, 0x12, 0x5a, 0x57, 0x2e, 0x3b, 0x1b, 0x37, 0x2e, 0x4a, 0xfd, 0xb3, 0x70, 0x97, 0x94, 0xb4,
+	0x0d, 0xb4, 0xcd, 0x74, 0x92, 0x19, 0xd4, 0xa7, 0xf0, 0x31, 0x5c, 0xfa, 0x18, 0x5d, 0x76, 0xa9,
+	0x1b, 0x91, 0x99, 0x85, 0xaf, 0x21, 0x93, 0x89, 0x58, 0xb4, 0xd9, 0x84, 0x70, 0x39, 0xe7, 0x77,
+	0xcf, 0xe5, 0xc0, 0xe3, 0x59, 0xcc, 0x48, 0x18, 0xc9, 0x89, 0x98, 0xf3, 0x84, 0x47, 0x62, 0x22,
+	0xc6, 0x54, 0x0b, 0xb9, 0x24, 0x53, 0xbe, 0xe4, 0x4a, 0x28, 0x1c, 0x46, 0x52, 0x4b, 0xbf, 0x3e,
+	0x8b, 0x19, 0xde, 0x21, 0x6b, 0xee, 0xd3, 0x85, 0x58, 0x4a, 0x62, 0xde, 0x42, 0xdb, 0x3c, 0x98,
+	0xca, 0xa9, 0x34, 0x5f, 0x92, 0xff, 0xec, 0xb4, 0xc1, 0xe6, 0x72, 0xf5, 0x48, 0x04, 0x1b, 0x93,
+	0xa4, 0x47, 0xf4, 0x43, 0xc8, 0x2d, 0xbb, 0xd9, 0x71, 0x45, 0x08, 0x69, 0x44, 0x17, 0xdf, 0x2a,
+	0x67, 0x50, 0x3b, 0x2b, 0x64, 0x47, 0x6f, 0x25, 0xf8, 0xff, 0xa2, 0x88, 0x7e, 0xa5, 0xa9, 0xe6,
+	0x7e, 0x00, 0xab, 0x05, 0xa7, 0x01, 0xda, 0xa0, 0x5b, 0xeb, 0xb7, 0xb0, 0xe3, 0x14, 0x3c, 0x34,
+	0xb2, 0xe0, 0xdf, 0xfa, 0xbd, 0xe5, 0x3d, 0x7f, 0xbe, 0x9c, 0x80, 0x91, 0x75, 0xfa, 0x03, 0x58,
+	0xb3, 0x86, 0x4b, 0xa1, 0x74, 0xa3, 0xd4, 0x2e, 0x77, 0x6b, 0xfd, 0x43, 0x6c, 0x2e, 0xc2, 0x82,
+	0x8d, 0x71, 0xd2, 0xc3, 0x37, 0x8a, 0x47, 0xc3, 0x42, 0x14, 0x54, 0x72, 0xc4, 0x68, 0xdb, 0xe3,
+	0x5f, 0xc3, 0x3d, 0xaa, 0x35, 0x57, 0xda, 0xec, 0x32, 0x98, 0xb2, 0xc1, 0x74, 0x9c, 0x79, 0x06,
+	0x3f, 0x7a, 0x4b, 0xfc, 0x8d, 0xf0, 0xe7, 0xb0, 0xbe, 0x6d, 0x19, 0xf1, 0x55, 0xcc, 0x95, 0x36,
+	0xf4, 0x8a, 0xa1, 0x9f, 0x3a, 0xe9, 0xb7, 0x7f, 0x7d, 0x76, 0x8b, 0x0b, 0x19, 0x9c, 0xaf, 0x53,
+	0x04, 0x36, 0x29, 0x02, 0x1f, 0x29, 0x02, 0x4f, 0x19, 0xf2, 0x36, 0x19, 0xf2, 0x5e, 0x33, 0xe4,
+	0xdd, 0xb5, 0xf2, 0x72, 0xee, 0x77, 0xd6, 0x63, 0x9a, 0x66, 0x55, 0xd3, 0xce, 0xd9, 0xd7, 0x00,
+	0xea, 0xb8, 0x4c, 0xa1, 0x6f, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VerificationRequestList) > 0 {
+		for iNdEx := len(m.VerificationRequestList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationRequestList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for iNdEx := len(m.AttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProfileList) > 0 {
+		for iNdEx := len(m.ProfileList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfileList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProfileList) > 0 {
+		for _, e := range m.ProfileList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for _, e := range m.AttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerificationRequestList) > 0 {
+		for _, e := range m.VerificationRequestList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileList = append(m.ProfileList, types.UserProfile{})
+			if err := m.ProfileList[len(m.ProfileList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationList = append(m.AttestationList, Attestation{})
+			if err := m.AttestationList[len(m.AttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationRequestList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationRequestList = append(m.VerificationRequestList, VerificationRequest{})
+			if err := m.VerificationRequestList[len(m.VerificationRequestList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
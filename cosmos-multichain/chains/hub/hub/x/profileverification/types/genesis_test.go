@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/profileverification/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				ProfileList: []crosschaintypes.UserProfile{{UserId: "0"}, {UserId: "1"}},
+				AttestationList: []types.Attestation{
+					{UserId: "0", CredentialType: "email", Attestor: "a"},
+					{UserId: "0", CredentialType: "email", Attestor: "b"},
+				},
+				VerificationRequestList: []types.VerificationRequest{{UserId: "1"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated profile",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				ProfileList: []crosschaintypes.UserProfile{{UserId: "0"}, {UserId: "0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation for unknown profile",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				AttestationList: []types.Attestation{{UserId: "0", CredentialType: "email", Attestor: "a"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated verificationRequest",
+			genState: &types.GenesisState{
+				Params:                  types.DefaultParams(),
+				ProfileList:             []crosschaintypes.UserProfile{{UserId: "0"}},
+				VerificationRequestList: []types.VerificationRequest{{UserId: "0"}, {UserId: "0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "level without requirements",
+			genState: &types.GenesisState{
+				Params: types.NewParams(
+					[]types.VerificationLevel{{Name: "basic"}},
+					types.DefaultVerificationTimeout,
+					types.DefaultVerificationProviders,
+					types.DefaultAttestationThreshold,
+				),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
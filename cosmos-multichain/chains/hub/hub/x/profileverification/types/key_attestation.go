@@ -0,0 +1,43 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// AttestationKeyPrefix is the prefix to retrieve all Attestation
+	AttestationKeyPrefix = "Attestation/value/"
+)
+
+// AttestationUserKey returns the store key prefix holding every Attestation
+// recorded for a user
+func AttestationUserKey(
+	userId string,
+) []byte {
+	var key []byte
+
+	userIdBytes := []byte(userId)
+	key = append(key, userIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AttestationKey returns the store key to retrieve an Attestation from the index fields
+func AttestationKey(
+	userId string,
+	credentialType string,
+	attestor string,
+) []byte {
+	key := AttestationUserKey(userId)
+
+	credentialTypeBytes := []byte(credentialType)
+	key = append(key, credentialTypeBytes...)
+	key = append(key, []byte("/")...)
+
+	attestorBytes := []byte(attestor)
+	key = append(key, attestorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ProfileKeyPrefix is the prefix to retrieve all Profile
+	ProfileKeyPrefix = "Profile/value/"
+)
+
+// ProfileKey returns the store key to retrieve a Profile from the index fields
+func ProfileKey(
+	userId string,
+) []byte {
+	var key []byte
+
+	userIdBytes := []byte(userId)
+	key = append(key, userIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,44 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// VerificationRequestKeyPrefix is the prefix to retrieve all VerificationRequest
+	VerificationRequestKeyPrefix = "VerificationRequest/value/"
+
+	// VerificationQueueKeyPrefix is the prefix of the deadline-ordered queue of
+	// pending VerificationRequest
+	VerificationQueueKeyPrefix = "VerificationRequest/queue/"
+)
+
+// VerificationRequestKey returns the store key to retrieve a VerificationRequest from the index fields
+func VerificationRequestKey(
+	userId string,
+) []byte {
+	var key []byte
+
+	userIdBytes := []byte(userId)
+	key = append(key, userIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// VerificationQueueTimeKey returns the queue prefix for requests due at deadline
+func VerificationQueueTimeKey(
+	deadline int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(deadline))
+	return key
+}
+
+// VerificationQueueKey returns the queue key of a request due at deadline
+func VerificationQueueKey(
+	deadline int64,
+	userId string,
+) []byte {
+	key := VerificationQueueTimeKey(deadline)
+	key = append(key, VerificationRequestKey(userId)...)
+	return key
+}
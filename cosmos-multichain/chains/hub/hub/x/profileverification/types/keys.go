@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "profileverification"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_profileverification"
+)
+
+var (
+	ParamsKey = []byte("p_profileverification")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
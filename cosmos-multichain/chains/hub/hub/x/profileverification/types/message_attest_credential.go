@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAttestCredential{}
+
+func NewMsgAttestCredential(creator string, userId string, credentialType string) *MsgAttestCredential {
+	return &MsgAttestCredential{
+		Creator:        creator,
+		UserId:         userId,
+		CredentialType: credentialType,
+	}
+}
+
+func (msg *MsgAttestCredential) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.UserId == "" {
+		return errorsmod.Wrap(ErrProfileNotFound, "user id cannot be empty")
+	}
+	if msg.CredentialType == "" {
+		return errorsmod.Wrap(ErrInvalidCredential, "credential type cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	crosschaintypes "hub/x/crosschain/types"
+)
+
+var _ sdk.Msg = &MsgSubmitProfile{}
+
+func NewMsgSubmitProfile(creator string, level string, credentials []*crosschaintypes.Credential) *MsgSubmitProfile {
+	return &MsgSubmitProfile{
+		Creator:     creator,
+		Level:       level,
+		Credentials: credentials,
+	}
+}
+
+func (msg *MsgSubmitProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Level == "" {
+		return errorsmod.Wrap(ErrUnknownLevel, "level cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(msg.Credentials))
+	for _, c := range msg.Credentials {
+		if c == nil || c.CredentialType == "" {
+			return errorsmod.Wrap(ErrInvalidCredential, "credential type cannot be empty")
+		}
+		if _, ok := seen[c.CredentialType]; ok {
+			return errorsmod.Wrapf(ErrInvalidCredential, "duplicated credential %s", c.CredentialType)
+		}
+		seen[c.CredentialType] = struct{}{}
+	}
+	return nil
+}
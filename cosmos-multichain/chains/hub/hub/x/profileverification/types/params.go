@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyVerificationLevels = []byte("VerificationLevels")
+	// DefaultVerificationLevels mirrors profile_verification.verification_levels in config.yml
+	DefaultVerificationLevels = []VerificationLevel{
+		{Name: "basic", Requirements: []string{"email", "phone"}},
+		{Name: "advanced", Requirements: []string{"government_id", "proof_of_address"}},
+		{Name: "enterprise", Requirements: []string{"business_registration", "tax_documents"}},
+	}
+)
+
+var (
+	KeyVerificationTimeout = []byte("VerificationTimeout")
+	// DefaultVerificationTimeout mirrors profile_verification.verification_timeout in config.yml
+	DefaultVerificationTimeout = 720 * time.Hour
+)
+
+var (
+	KeyVerificationProviders = []byte("VerificationProviders")
+	// DefaultVerificationProviders mirrors profile_verification.verification_providers
+	// in config.yml. Attestor accounts are assigned at genesis or by governance.
+	DefaultVerificationProviders = []VerificationProvider{
+		{Name: "internal", Weight: 1},
+		{Name: "third_party", Weight: 2},
+	}
+)
+
+var (
+	KeyAttestationThreshold = []byte("AttestationThreshold")
+	// DefaultAttestationThreshold accepts one third_party or two internal attestations
+	DefaultAttestationThreshold uint64 = 2
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	verificationLevels []VerificationLevel,
+	verificationTimeout time.Duration,
+	verificationProviders []VerificationProvider,
+	attestationThreshold uint64,
+) Params {
+	return Params{
+		VerificationLevels:    verificationLevels,
+		VerificationTimeout:   verificationTimeout,
+		VerificationProviders: verificationProviders,
+		AttestationThreshold:  attestationThreshold,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVerificationLevels,
+		DefaultVerificationTimeout,
+		DefaultVerificationProviders,
+		DefaultAttestationThreshold,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVerificationLevels, &p.VerificationLevels, validateVerificationLevels),
+		paramtypes.NewParamSetPair(KeyVerificationTimeout, &p.VerificationTimeout, validateVerificationTimeout),
+		paramtypes.NewParamSetPair(KeyVerificationProviders, &p.VerificationProviders, validateVerificationProviders),
+		paramtypes.NewParamSetPair(KeyAttestationThreshold, &p.AttestationThreshold, validateAttestationThreshold),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateVerificationLevels(p.VerificationLevels); err != nil {
+		return err
+	}
+	if err := validateVerificationTimeout(p.VerificationTimeout); err != nil {
+		return err
+	}
+	if err := validateVerificationProviders(p.VerificationProviders); err != nil {
+		return err
+	}
+	return validateAttestationThreshold(p.AttestationThreshold)
+}
+
+// Level returns the verification level with the given name.
+func (p Params) Level(name string) (VerificationLevel, bool) {
+	for _, level := range p.VerificationLevels {
+		if level.Name == name {
+			return level, true
+		}
+	}
+	return VerificationLevel{}, false
+}
+
+// ProviderFor returns the provider the attestor account belongs to.
+func (p Params) ProviderFor(attestor string) (VerificationProvider, bool) {
+	for _, provider := range p.VerificationProviders {
+		for _, addr := range provider.Attestors {
+			if addr == attestor {
+				return provider, true
+			}
+		}
+	}
+	return VerificationProvider{}, false
+}
+
+// validateVerificationLevels validates the VerificationLevels param
+func validateVerificationLevels(v interface{}) error {
+	levels, ok := v.([]VerificationLevel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if len(levels) == 0 {
+		return fmt.Errorf("at least one verification level is required")
+	}
+
+	names := make(map[string]struct{}, len(levels))
+	for _, level := range levels {
+		if level.Name == "" {
+			return fmt.Errorf("verification level name cannot be empty")
+		}
+		if _, ok := names[level.Name]; ok {
+			return fmt.Errorf("duplicated verification level %s", level.Name)
+		}
+		names[level.Name] = struct{}{}
+
+		if len(level.Requirements) == 0 {
+			return fmt.Errorf("verification level %s has no requirements", level.Name)
+		}
+		for _, req := range level.Requirements {
+			if req == "" {
+				return fmt.Errorf("verification level %s has an empty requirement", level.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateVerificationTimeout validates the VerificationTimeout param
+func validateVerificationTimeout(v interface{}) error {
+	timeout, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("verification timeout must be positive: %s", timeout)
+	}
+	return nil
+}
+
+// validateVerificationProviders validates the VerificationProviders param
+func validateVerificationProviders(v interface{}) error {
+	providers, ok := v.([]VerificationProvider)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if len(providers) == 0 {
+		return fmt.Errorf("at least one verification provider is required")
+	}
+
+	names := make(map[string]struct{}, len(providers))
+	attestors := make(map[string]struct{})
+	for _, provider := range providers {
+		if provider.Name == "" {
+			return fmt.Errorf("verification provider name cannot be empty")
+		}
+		if _, ok := names[provider.Name]; ok {
+			return fmt.Errorf("duplicated verification provider %s", provider.Name)
+		}
+		names[provider.Name] = struct{}{}
+
+		if provider.Weight == 0 {
+			return fmt.Errorf("verification provider %s must have a positive weight", provider.Name)
+		}
+		for _, addr := range provider.Attestors {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return fmt.Errorf("invalid attestor address %s: %w", addr, err)
+			}
+			if _, ok := attestors[addr]; ok {
+				return fmt.Errorf("attestor %s belongs to more than one provider", addr)
+			}
+			attestors[addr] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// validateAttestationThreshold validates the AttestationThreshold param
+func validateAttestationThreshold(v interface{}) error {
+	threshold, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if threshold == 0 {
+		return fmt.Errorf("attestation threshold must be positive")
+	}
+	return nil
+}
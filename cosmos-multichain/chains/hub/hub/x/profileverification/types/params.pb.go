@@ -0,0 +1,1105 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/profileverification/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// verification_levels lists the profile levels and the credentials each requires.
+	VerificationLevels []VerificationLevel `protobuf:"bytes,1,rep,name=verification_levels,json=verificationLevels,proto3" json:"verification_levels"`
+	// verification_timeout is how long a submitted profile may stay pending.
+	VerificationTimeout time.Duration `protobuf:"bytes,2,opt,name=verification_timeout,json=verificationTimeout,proto3,stdduration" json:"verification_timeout"`
+	// verification_providers lists the attesting providers and their weights.
+	VerificationProviders []VerificationProvider `protobuf:"bytes,3,rep,name=verification_providers,json=verificationProviders,proto3" json:"verification_providers"`
+	// attestation_threshold is the combined provider weight a credential needs
+	// before it counts towards its level's requirements.
+	AttestationThreshold uint64 `protobuf:"varint,4,opt,name=attestation_threshold,json=attestationThreshold,proto3" json:"attestation_threshold,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96625d7901f74b4b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVerificationLevels() []VerificationLevel {
+	if m != nil {
+		return m.VerificationLevels
+	}
+	return nil
+}
+
+func (m *Params) GetVerificationTimeout() time.Duration {
+	if m != nil {
+		return m.VerificationTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetVerificationProviders() []VerificationProvider {
+	if m != nil {
+		return m.VerificationProviders
+	}
+	return nil
+}
+
+func (m *Params) GetAttestationThreshold() uint64 {
+	if m != nil {
+		return m.AttestationThreshold
+	}
+	return 0
+}
+
+// VerificationLevel is a named set of credential requirements.
+type VerificationLevel struct {
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Requirements []string `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements,omitempty"`
+}
+
+func (m *VerificationLevel) Reset()         { *m = VerificationLevel{} }
+func (m *VerificationLevel) String() string { return proto.CompactTextString(m) }
+func (*VerificationLevel) ProtoMessage()    {}
+func (*VerificationLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96625d7901f74b4b, []int{1}
+}
+func (m *VerificationLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationLevel.Merge(m, src)
+}
+func (m *VerificationLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationLevel proto.InternalMessageInfo
+
+func (m *VerificationLevel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VerificationLevel) GetRequirements() []string {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+// VerificationProvider is a weighted source of credential attestations.
+type VerificationProvider struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// attestors are the accounts allowed to attest on behalf of the provider.
+	Attestors []string `protobuf:"bytes,3,rep,name=attestors,proto3" json:"attestors,omitempty"`
+}
+
+func (m *VerificationProvider) Reset()         { *m = VerificationProvider{} }
+func (m *VerificationProvider) String() string { return proto.CompactTextString(m) }
+func (*VerificationProvider) ProtoMessage()    {}
+func (*VerificationProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96625d7901f74b4b, []int{2}
+}
+func (m *VerificationProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationProvider.Merge(m, src)
+}
+func (m *VerificationProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationProvider proto.InternalMessageInfo
+
+func (m *VerificationProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VerificationProvider) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *VerificationProvider) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "hub.profileverification.Params")
+	proto.RegisterType((*VerificationLevel)(nil), "hub.profileverification.VerificationLevel")
+	proto.RegisterType((*VerificationProvider)(nil), "hub.profileverification.VerificationProvider")
+}
+
+func init() {
+	proto.RegisterFile("hub/profileverification/params.proto", fileDescriptor_96625d7901f74b4b)
+}
+
+var fileDescriptor_96625d7901f74b4b = []byte{
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0x3e, 0xf7, 0xa2, 0x93, 0xce, 0x85, 0xa1, 0x26, 0x2d, 0x69, 0x87, 0x5c, 0x74, 0x62, 0x08,
+	0x27, 0x35, 0x91, 0x5a, 0x09, 0x89, 0x6e, 0x44, 0x8c, 0x0c, 0x25, 0x54, 0x0c, 0x30, 0x54, 0x49,
+	0xe3, 0x4b, 0x8c, 0x92, 0x38, 0xd8, 0x4e, 0x80, 0x8d, 0x99, 0x89, 0x81, 0x81, 0x91, 0x91, 0xb1,
+	0x03, 0x3f, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0xe8, 0x6e, 0x28, 0x3f, 0x03, 0xc5, 0x76, 0xc5, 0x05,
+	0x72, 0x12, 0x8b, 0xf5, 0x9e, 0xbf, 0xe7, 0xef, 0x7d, 0xdf, 0xf3, 0x83, 0x77, 0xb2, 0x3a, 0xf6,
+	0x2b, 0x46, 0xe7, 0x24, 0xc7, 0x0d, 0x66, 0x64, 0x4e, 0xce, 0x22, 0x41, 0x68, 0xe9, 0x57, 0x11,
+	0x8b, 0x0a, 0xee, 0x55, 0x8c, 0x0a, 0x8a, 0x6e, 0x67, 0x75, 0xec, 0xf5, 0x54, 0xed, 0x6d, 0x45,
+	0x05, 0x29, 0xa9, 0x2f, 0x4f, 0x55, 0xbb, 0x67, 0xa6, 0x34, 0xa5, 0x32, 0xf4, 0xdb, 0x48, 0xdf,
+	0xee, 0x9e, 0x51, 0x5e, 0x50, 0x7e, 0xaa, 0x00, 0x95, 0x68, 0xc8, 0x4e, 0x29, 0x4d, 0x73, 0xec,
+	0xcb, 0x2c, 0xae, 0xe7, 0x7e, 0x52, 0x33, 0xc9, 0xae, 0xf0, 0xe9, 0x87, 0x21, 0x1c, 0x1d, 0x4b,
+	0x35, 0x28, 0x82, 0xb7, 0x56, 0xdb, 0x9f, 0xb6, 0x6a, 0x72, 0x6e, 0x01, 0x67, 0xe8, 0x6e, 0x1e,
+	0xcc, 0xbc, 0x35, 0x2a, 0xbd, 0xa7, 0x2b, 0xc9, 0xa3, 0xf6, 0x49, 0x60, 0x5c, 0x7c, 0x9f, 0x0c,
+	0x42, 0xd4, 0xfc, 0x0d, 0x70, 0xf4, 0x1c, 0x9a, 0x9d, 0x16, 0x82, 0x14, 0x98, 0xd6, 0xc2, 0xda,
+	0x70, 0x80, 0xbb, 0x79, 0xb0, 0xeb, 0x29, 0xb1, 0xde, 0xb5, 0x58, 0xef, 0xa1, 0x16, 0x1b, 0xdc,
+	0x6c, 0x29, 0x3f, 0xfe, 0x98, 0x80, 0xcf, 0x57, 0xe7, 0x33, 0x10, 0x76, 0x84, 0x9e, 0x28, 0x12,
+	0xf4, 0x02, 0xee, 0x74, 0xc8, 0x2b, 0x46, 0x1b, 0x92, 0x60, 0xc6, 0xad, 0xa1, 0xb4, 0xb0, 0xff,
+	0x5f, 0x16, 0x8e, 0xf5, 0x2b, 0xed, 0x62, 0xbb, 0xe9, 0xc1, 0x38, 0x3a, 0x84, 0xdb, 0x91, 0x10,
+	0x98, 0x0b, 0xed, 0x23, 0x63, 0x98, 0x67, 0x34, 0x4f, 0x2c, 0xc3, 0x01, 0xae, 0x11, 0x9a, 0x2b,
+	0xe0, 0xc9, 0x35, 0x76, 0x74, 0xf7, 0xd7, 0xa7, 0x09, 0x78, 0x77, 0x75, 0x3e, 0x73, 0xda, 0xbd,
+	0x78, 0xdd, 0xbb, 0x19, 0xea, 0x2f, 0xa6, 0x8f, 0xe1, 0xd6, 0x3f, 0x73, 0x45, 0x08, 0x1a, 0x65,
+	0x54, 0x60, 0x0b, 0x38, 0xc0, 0x1d, 0x87, 0x32, 0x46, 0x53, 0x78, 0x83, 0xe1, 0x97, 0x35, 0x61,
+	0xb8, 0xc0, 0xa5, 0xe0, 0xd6, 0x86, 0x33, 0x74, 0xc7, 0x61, 0xe7, 0xee, 0xc8, 0x68, 0xfb, 0x4e,
+	0xdf, 0x02, 0x68, 0xf6, 0x19, 0xed, 0xa5, 0xdd, 0x81, 0xa3, 0x57, 0x98, 0xa4, 0x99, 0xfa, 0x1a,
+	0x23, 0xd4, 0x19, 0xba, 0x07, 0xc7, 0xca, 0x1a, 0xd5, 0x63, 0x1d, 0x07, 0xd6, 0xd7, 0x2f, 0xfb,
+	0xa6, 0xde, 0xb9, 0x07, 0x49, 0xc2, 0x30, 0xe7, 0x4f, 0x04, 0x23, 0x65, 0x1a, 0xfe, 0x29, 0x55,
+	0x12, 0x82, 0xfb, 0x17, 0x0b, 0x1b, 0x5c, 0x2e, 0x6c, 0xf0, 0x73, 0x61, 0x83, 0xf7, 0x4b, 0x7b,
+	0x70, 0xb9, 0xb4, 0x07, 0xdf, 0x96, 0xf6, 0xe0, 0xd9, 0x64, 0xfd, 0x44, 0xc4, 0x9b, 0x0a, 0xf3,
+	0x78, 0x24, 0x77, 0xe2, 0xf0, 0xf7, 0x00, 0x62, 0x94, 0x43, 0x60, 0x53, 0x03, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VerificationLevels) != len(that1.VerificationLevels) {
+		return false
+	}
+	for i := range this.VerificationLevels {
+		if !this.VerificationLevels[i].Equal(&that1.VerificationLevels[i]) {
+			return false
+		}
+	}
+	if this.VerificationTimeout != that1.VerificationTimeout {
+		return false
+	}
+	if len(this.VerificationProviders) != len(that1.VerificationProviders) {
+		return false
+	}
+	for i := range this.VerificationProviders {
+		if !this.VerificationProviders[i].Equal(&that1.VerificationProviders[i]) {
+			return false
+		}
+	}
+	if this.AttestationThreshold != that1.AttestationThreshold {
+		return false
+	}
+	return true
+}
+func (this *VerificationLevel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerificationLevel)
+	if !ok {
+		that2, ok := that.(VerificationLevel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Requirements) != len(that1.Requirements) {
+		return false
+	}
+	for i := range this.Requirements {
+		if this.Requirements[i] != that1.Requirements[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *VerificationProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerificationProvider)
+	if !ok {
+		that2, ok := that.(VerificationProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if len(this.Attestors) != len(that1.Attestors) {
+		return false
+	}
+	for i := range this.Attestors {
+		if this.Attestors[i] != that1.Attestors[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VerificationProviders) > 0 {
+		for iNdEx := len(m.VerificationProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VerificationTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VerificationTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.VerificationLevels) > 0 {
+		for iNdEx := len(m.VerificationLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Requirements[iNdEx])
+			copy(dAtA[i:], m.Requirements[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Requirements[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Weight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VerificationLevels) > 0 {
+		for _, e := range m.VerificationLevels {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VerificationTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.VerificationProviders) > 0 {
+		for _, e := range m.VerificationProviders {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AttestationThreshold != 0 {
+		n += 1 + sovParams(uint64(m.AttestationThreshold))
+	}
+	return n
+}
+
+func (m *VerificationLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, s := range m.Requirements {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerificationProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovParams(uint64(m.Weight))
+	}
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationLevels = append(m.VerificationLevels, VerificationLevel{})
+			if err := m.VerificationLevels[len(m.VerificationLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VerificationTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationProviders = append(m.VerificationProviders, VerificationProvider{})
+			if err := m.VerificationProviders[len(m.VerificationProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThreshold", wireType)
+			}
+			m.AttestationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = append(m.Requirements, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/verification/types"
+)
+
+func (k msgServer) SendVerificationRequest(goCtx context.Context, msg *types.MsgSendVerificationRequest) (*types.MsgSendVerificationRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, err := k.ChannelPolicy(ctx, msg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.AllowsDataType(msg.DataType) {
+		return nil, errorsmod.Wrapf(types.ErrDataTypeNotAllowed, "%s for %s", msg.DataType, policy.ChainId)
+	}
+
+	// Construct the packet
+	var packet types.VerificationRequestPacketData
+
+	packet.DataType = msg.DataType
+	packet.Envelope = &crosschaintypes.IBCPacket{
+		SourceChain:      ctx.ChainID(),
+		DestinationChain: policy.ChainId,
+		Sender:           msg.Creator,
+		Receiver:         msg.Receiver,
+		Timestamp:        ctx.BlockTime().Unix(),
+		Payload:          msg.Payload,
+	}
+	if err := packet.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Transmit the packet
+	sequence, err := k.TransmitVerificationRequestPacket(
+		ctx,
+		packet,
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetOutgoingRequest(ctx, types.OutgoingRequest{
+		ChannelId: msg.ChannelID,
+		Sequence:  sequence,
+		Creator:   msg.Creator,
+		DataType:  msg.DataType,
+		Envelope:  packet.Envelope,
+	})
+
+	return &types.MsgSendVerificationRequestResponse{Sequence: sequence}, nil
+}
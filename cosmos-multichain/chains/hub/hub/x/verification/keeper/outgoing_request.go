@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"hub/x/verification/types"
+)
+
+// SetOutgoingRequest set a specific outgoingRequest in the store from its index
+func (k Keeper) SetOutgoingRequest(ctx context.Context, outgoingRequest types.OutgoingRequest) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OutgoingRequestKeyPrefix))
+	b := k.cdc.MustMarshal(&outgoingRequest)
+	store.Set(types.OutgoingRequestKey(
+		outgoingRequest.ChannelId,
+		outgoingRequest.Sequence,
+	), b)
+}
+
+// GetOutgoingRequest returns an outgoingRequest from its index
+func (k Keeper) GetOutgoingRequest(
+	ctx context.Context,
+	channelId string,
+	sequence uint64,
+) (val types.OutgoingRequest, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OutgoingRequestKeyPrefix))
+
+	b := store.Get(types.OutgoingRequestKey(
+		channelId,
+		sequence,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllOutgoingRequest returns all outgoingRequest
+func (k Keeper) GetAllOutgoingRequest(ctx context.Context) (list []types.OutgoingRequest) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OutgoingRequestKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OutgoingRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
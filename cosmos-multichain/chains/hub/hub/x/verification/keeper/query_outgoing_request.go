@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"hub/x/verification/types"
+)
+
+func (k Keeper) OutgoingRequestAll(ctx context.Context, req *types.QueryAllOutgoingRequestRequest) (*types.QueryAllOutgoingRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var outgoingRequests []types.OutgoingRequest
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	outgoingRequestStore := prefix.NewStore(store, types.KeyPrefix(types.OutgoingRequestKeyPrefix))
+
+	pageRes, err := query.Paginate(outgoingRequestStore, req.Pagination, func(key []byte, value []byte) error {
+		var outgoingRequest types.OutgoingRequest
+		if err := k.cdc.Unmarshal(value, &outgoingRequest); err != nil {
+			return err
+		}
+
+		outgoingRequests = append(outgoingRequests, outgoingRequest)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllOutgoingRequestResponse{OutgoingRequest: outgoingRequests, Pagination: pageRes}, nil
+}
+
+func (k Keeper) OutgoingRequest(ctx context.Context, req *types.QueryGetOutgoingRequestRequest) (*types.QueryGetOutgoingRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetOutgoingRequest(
+		ctx,
+		req.ChannelId,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetOutgoingRequestResponse{OutgoingRequest: val}, nil
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	crosschaintypes "hub/x/crosschain/types"
+	"hub/x/verification/types"
+)
+
+// TransmitVerificationRequestPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitVerificationRequestPacket(
+	ctx sdk.Context,
+	packetData types.VerificationRequestPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	channelCap, ok := k.ScopedKeeper().GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvVerificationRequestPacket processes packet reception. The data type
+// must be allowlisted for the chain the channel was opened with and the
+// envelope must name that chain as its source. A verified request is
+// reported by a single event; a rejected one by its error acknowledgement.
+func (k Keeper) OnRecvVerificationRequestPacket(ctx sdk.Context, packet channeltypes.Packet, data types.VerificationRequestPacketData) (packetAck types.VerificationRequestPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	policy, err := k.ChannelPolicy(ctx, packet.DestinationChannel)
+	if err != nil {
+		return packetAck, err
+	}
+	if !policy.AllowsDataType(data.DataType) {
+		return packetAck, errorsmod.Wrapf(types.ErrDataTypeNotAllowed, "%s for %s", data.DataType, policy.ChainId)
+	}
+	if data.Envelope.SourceChain != policy.ChainId {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidEnvelope, "source chain %s does not match channel counterparty %s", data.Envelope.SourceChain, policy.ChainId)
+	}
+	if data.Envelope.DestinationChain != ctx.ChainID() {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidEnvelope, "destination chain %s is not %s", data.Envelope.DestinationChain, ctx.ChainID())
+	}
+
+	switch data.DataType {
+	case types.DataTypeProfile, types.DataTypeIdentity:
+		packetAck, err = k.verifyProfile(ctx, data.Envelope.Payload)
+	case types.DataTypeDocument, types.DataTypeCertification:
+		packetAck, err = k.verifyDocument(ctx, data.Envelope.Payload)
+	case types.DataTypeIntegration:
+		packetAck, err = k.verifyService(ctx, data.Envelope.Payload)
+	default:
+		err = errorsmod.Wrapf(types.ErrDataTypeNotAllowed, "unsupported data type %q", data.DataType)
+	}
+	if err != nil {
+		return packetAck, err
+	}
+	packetAck.DataType = data.DataType
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeVerificationRequestPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, policy.ChainId),
+			sdk.NewAttribute(types.AttributeKeyDataType, data.DataType),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+			sdk.NewAttribute(types.AttributeKeySubjectId, packetAck.SubjectId),
+			sdk.NewAttribute(types.AttributeKeyStatus, packetAck.Status.String()),
+		),
+	)
+	return packetAck, nil
+}
+
+// verifyProfile reports the hub verification status of the profile in the payload
+func (k Keeper) verifyProfile(ctx sdk.Context, payload []byte) (packetAck types.VerificationRequestPacketAck, err error) {
+	var profile crosschaintypes.UserProfile
+	if err := k.cdc.Unmarshal(payload, &profile); err != nil {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidEnvelope, "cannot decode profile: %s", err)
+	}
+	if profile.UserId == "" {
+		return packetAck, errorsmod.Wrap(types.ErrInvalidEnvelope, "user id cannot be empty")
+	}
+	packetAck.SubjectId = profile.UserId
+
+	stored, found := k.profileKeeper.GetProfile(ctx, profile.UserId)
+	if !found {
+		packetAck.ErrorMessage = fmt.Sprintf("profile %s is not registered", profile.UserId)
+		return packetAck, nil
+	}
+
+	packetAck.Status = stored.Status
+	packetAck.VerificationTimestamp = stored.VerificationTimestamp
+	packetAck.VerifierId = stored.VerifierId
+	for _, credential := range stored.Credentials {
+		packetAck.Details = append(packetAck.Details, credential.CredentialType)
+	}
+	return packetAck, nil
+}
+
+// verifyDocument checks the payload document against the registered hash
+func (k Keeper) verifyDocument(ctx sdk.Context, payload []byte) (packetAck types.VerificationRequestPacketAck, err error) {
+	var document crosschaintypes.Document
+	if err := k.cdc.Unmarshal(payload, &document); err != nil {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidEnvelope, "cannot decode document: %s", err)
+	}
+	if document.DocumentId == "" {
+		return packetAck, errorsmod.Wrap(types.ErrInvalidEnvelope, "document id cannot be empty")
+	}
+	packetAck.SubjectId = document.DocumentId
+
+	stored, found := k.documentKeeper.GetDocument(ctx, document.DocumentId)
+	if !found {
+		packetAck.ErrorMessage = fmt.Sprintf("document %s is not registered", document.DocumentId)
+		return packetAck, nil
+	}
+
+	packetAck.VerificationTimestamp = stored.CreatedAt
+	packetAck.VerifierId = ctx.ChainID()
+	packetAck.Details = []string{stored.HashAlgorithm, stored.Industry}
+	switch {
+	case !bytes.Equal(stored.Document.GetDocumentHash(), document.DocumentHash):
+		packetAck.Status = crosschaintypes.VerificationStatus_STATUS_REJECTED
+		packetAck.ErrorMessage = "document hash mismatch"
+	case document.DocumentType != "" && stored.Document.GetDocumentType() != document.DocumentType:
+		packetAck.Status = crosschaintypes.VerificationStatus_STATUS_REJECTED
+		packetAck.ErrorMessage = "document type mismatch"
+	default:
+		packetAck.Status = crosschaintypes.VerificationStatus_STATUS_VERIFIED
+	}
+	return packetAck, nil
+}
+
+// verifyService reports the hub verification status of the service in the payload
+func (k Keeper) verifyService(ctx sdk.Context, payload []byte) (packetAck types.VerificationRequestPacketAck, err error) {
+	var service crosschaintypes.ServiceVerification
+	if err := k.cdc.Unmarshal(payload, &service); err != nil {
+		return packetAck, errorsmod.Wrapf(types.ErrInvalidEnvelope, "cannot decode service: %s", err)
+	}
+	if service.ServiceId == "" {
+		return packetAck, errorsmod.Wrap(types.ErrInvalidEnvelope, "service id cannot be empty")
+	}
+	packetAck.SubjectId = service.ServiceId
+
+	stored, found := k.serviceKeeper.GetService(ctx, service.ServiceId)
+	if !found {
+		packetAck.ErrorMessage = fmt.Sprintf("service %s is not registered", service.ServiceId)
+		return packetAck, nil
+	}
+
+	packetAck.Status = stored.Service.GetStatus()
+	packetAck.VerificationTimestamp = stored.Service.GetVerificationTimestamp()
+	packetAck.VerifierId = stored.LastVerifier
+	packetAck.Details = stored.VerifiedCapabilities
+	return packetAck, nil
+}
+
+// OnAcknowledgementVerificationRequestPacket records the counterparty result
+// of a verification request sent from this chain
+func (k Keeper) OnAcknowledgementVerificationRequestPacket(ctx sdk.Context, packet channeltypes.Packet, data types.VerificationRequestPacketData, ack channeltypes.Acknowledgement) error {
+	request, found := k.GetOutgoingRequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		request = types.OutgoingRequest{
+			ChannelId: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+			DataType:  data.DataType,
+			Envelope:  data.Envelope,
+		}
+	}
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		request.Result = &types.VerificationRequestPacketAck{
+			DataType:     data.DataType,
+			ErrorMessage: dispatchedAck.Error,
+		}
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.VerificationRequestPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		request.Result = &packetAck
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+
+	k.SetOutgoingRequest(ctx, request)
+	return nil
+}
+
+// OnTimeoutVerificationRequestPacket marks a verification request as timed out
+func (k Keeper) OnTimeoutVerificationRequestPacket(ctx sdk.Context, packet channeltypes.Packet, data types.VerificationRequestPacketData) error {
+	request, found := k.GetOutgoingRequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		request = types.OutgoingRequest{
+			ChannelId: packet.SourceChannel,
+			Sequence:  packet.Sequence,
+			DataType:  data.DataType,
+			Envelope:  data.Envelope,
+		}
+	}
+	request.TimedOut = true
+	k.SetOutgoingRequest(ctx, request)
+	return nil
+}
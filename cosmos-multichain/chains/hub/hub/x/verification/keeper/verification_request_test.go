@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "hub/testutil/keeper"
+	crosschaintypes "hub/x/crosschain/types"
+	documentstoragetypes "hub/x/documentstorage/types"
+	"hub/x/verification/types"
+)
+
+const hubChainID = "bloqz-hub-1"
+
+func requestPacket(t *testing.T, sourceChain, dataType string, payload interface{ Marshal() ([]byte, error) }) types.VerificationRequestPacketData {
+	bz, err := payload.Marshal()
+	require.NoError(t, err)
+	return types.VerificationRequestPacketData{
+		DataType: dataType,
+		Envelope: &crosschaintypes.IBCPacket{
+			SourceChain:      sourceChain,
+			DestinationChain: hubChainID,
+			Sender:           "sender",
+			Payload:          bz,
+		},
+	}
+}
+
+// recvEvents returns the verification request packet events emitted in ctx
+func recvEvents(ctx sdk.Context) (events []sdk.Event) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeVerificationRequestPacket {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestOnRecvVerificationRequestPacket(t *testing.T) {
+	k, registries, ctx := keepertest.VerificationKeeperWithRegistries(t)
+	ctx = ctx.WithChainID(hubChainID)
+
+	k.SetChannelCounterparty(ctx, types.ChannelCounterparty{ChannelId: "channel-0", ChainId: "bloqz-finance-1"})
+	k.SetChannelCounterparty(ctx, types.ChannelCounterparty{ChannelId: "channel-1", ChainId: "bloqz-government-1"})
+	finance := channeltypes.Packet{DestinationChannel: "channel-0", Sequence: 1}
+	government := channeltypes.Packet{DestinationChannel: "channel-1", Sequence: 1}
+
+	registries.Profiles.SetProfile(ctx, crosschaintypes.UserProfile{
+		UserId:      "alice",
+		Status:      crosschaintypes.VerificationStatus_STATUS_VERIFIED,
+		VerifierId:  "verifier",
+		Credentials: []*crosschaintypes.Credential{{CredentialType: "government_id"}},
+	})
+	registries.Documents.SetDocument(ctx, documentstoragetypes.DocumentRecord{
+		Document: &crosschaintypes.Document{DocumentId: "deed", DocumentType: "title", DocumentHash: []byte{1, 2, 3}},
+	})
+
+	t.Run("profile verified", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		ack, err := k.OnRecvVerificationRequestPacket(ctx, finance, requestPacket(t, "bloqz-finance-1", types.DataTypeProfile, &crosschaintypes.UserProfile{UserId: "alice"}))
+		require.NoError(t, err)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, ack.Status)
+		require.Equal(t, "alice", ack.SubjectId)
+		require.Equal(t, []string{"government_id"}, ack.Details)
+
+		events := recvEvents(ctx)
+		require.Len(t, events, 1)
+		status, found := events[0].GetAttribute(types.AttributeKeyStatus)
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED.String(), status.Value)
+		counterparty, _ := events[0].GetAttribute(types.AttributeKeyCounterparty)
+		require.Equal(t, "bloqz-finance-1", counterparty.Value)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		ack, err := k.OnRecvVerificationRequestPacket(ctx, finance, requestPacket(t, "bloqz-finance-1", types.DataTypeProfile, &crosschaintypes.UserProfile{UserId: "bob"}))
+		require.NoError(t, err)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_UNSPECIFIED, ack.Status)
+		require.NotEmpty(t, ack.ErrorMessage)
+	})
+
+	t.Run("document hash mismatch", func(t *testing.T) {
+		ack, err := k.OnRecvVerificationRequestPacket(ctx, finance, requestPacket(t, "bloqz-finance-1", types.DataTypeDocument, &crosschaintypes.Document{DocumentId: "deed", DocumentHash: []byte{9}}))
+		require.NoError(t, err)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_REJECTED, ack.Status)
+
+		ack, err = k.OnRecvVerificationRequestPacket(ctx, government, requestPacket(t, "bloqz-government-1", types.DataTypeCertification, &crosschaintypes.Document{DocumentId: "deed", DocumentHash: []byte{1, 2, 3}}))
+		require.NoError(t, err)
+		require.Equal(t, crosschaintypes.VerificationStatus_STATUS_VERIFIED, ack.Status)
+	})
+
+	t.Run("data type not allowlisted", func(t *testing.T) {
+		_, err := k.OnRecvVerificationRequestPacket(ctx, government, requestPacket(t, "bloqz-government-1", types.DataTypeIntegration, &crosschaintypes.ServiceVerification{ServiceId: "svc"}))
+		require.ErrorIs(t, err, types.ErrDataTypeNotAllowed)
+
+		_, err = k.OnRecvVerificationRequestPacket(ctx, finance, requestPacket(t, "bloqz-finance-1", types.DataTypeIdentity, &crosschaintypes.UserProfile{UserId: "alice"}))
+		require.ErrorIs(t, err, types.ErrDataTypeNotAllowed)
+	})
+
+	t.Run("envelope source must match channel", func(t *testing.T) {
+		_, err := k.OnRecvVerificationRequestPacket(ctx, finance, requestPacket(t, "bloqz-healthcare-1", types.DataTypeProfile, &crosschaintypes.UserProfile{UserId: "alice"}))
+		require.ErrorIs(t, err, types.ErrInvalidEnvelope)
+	})
+
+	t.Run("unbound channel", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		packet := channeltypes.Packet{DestinationChannel: "channel-9"}
+		_, err := k.OnRecvVerificationRequestPacket(ctx, packet, requestPacket(t, "bloqz-finance-1", types.DataTypeProfile, &crosschaintypes.UserProfile{UserId: "alice"}))
+		require.ErrorIs(t, err, types.ErrUnknownChannel)
+
+		require.Empty(t, recvEvents(ctx))
+	})
+}
+
+func TestOnAcknowledgementVerificationRequestPacket(t *testing.T) {
+	k, ctx := keepertest.VerificationKeeper(t)
+	packet := channeltypes.Packet{SourceChannel: "channel-0", Sequence: 4}
+	data := types.VerificationRequestPacketData{DataType: types.DataTypeProfile}
+
+	result := types.VerificationRequestPacketAck{DataType: types.DataTypeProfile, SubjectId: "alice", Status: crosschaintypes.VerificationStatus_STATUS_VERIFIED}
+	bz, err := types.ModuleCdc.MarshalJSON(&result)
+	require.NoError(t, err)
+
+	require.NoError(t, k.OnAcknowledgementVerificationRequestPacket(ctx, packet, data, channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(bz))))
+	request, found := k.GetOutgoingRequest(ctx, "channel-0", 4)
+	require.True(t, found)
+	require.Equal(t, result, *request.Result)
+
+	require.NoError(t, k.OnTimeoutVerificationRequestPacket(ctx, channeltypes.Packet{SourceChannel: "channel-0", Sequence: 5}, data))
+	request, found = k.GetOutgoingRequest(ctx, "channel-0", 5)
+	require.True(t, found)
+	require.True(t, request.TimedOut)
+}
@@ -0,0 +1,63 @@
+package verification
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "hub/api/hub/verification"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ChannelCounterpartyAll",
+					Use:       "list-channel-counterparty",
+					Short:     "List the counterparty chain of every verification channel",
+				},
+				{
+					RpcMethod:      "ChannelCounterparty",
+					Use:            "show-channel-counterparty [channel-id]",
+					Short:          "Shows the counterparty chain of a verification channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "OutgoingRequestAll",
+					Use:       "list-outgoing-request",
+					Short:     "List all outgoing verification requests",
+				},
+				{
+					RpcMethod:      "OutgoingRequest",
+					Use:            "show-outgoing-request [channel-id] [sequence]",
+					Short:          "Shows an outgoing verification request and its result",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "sequence"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "SendVerificationRequest",
+					Use:            "send-verification-request [port] [channel-id] [data-type] [receiver] [payload]",
+					Short:          "Send a verification request over IBC",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port"}, {ProtoField: "channelID"}, {ProtoField: "data_type"}, {ProtoField: "receiver"}, {ProtoField: "payload"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/verification module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrUnknownCounterparty  = sdkerrors.Register(ModuleName, 1101, "counterparty chain has no verification policy")
+	ErrInvalidOrdering      = sdkerrors.Register(ModuleName, 1102, "channel ordering does not match the counterparty policy")
+	ErrDataTypeNotAllowed   = sdkerrors.Register(ModuleName, 1103, "data type is not allowlisted for the counterparty")
+	ErrInvalidEnvelope      = sdkerrors.Register(ModuleName, 1104, "invalid packet envelope")
+	ErrUnknownChannel       = sdkerrors.Register(ModuleName, 1105, "channel is not bound to a counterparty chain")
+	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
+)
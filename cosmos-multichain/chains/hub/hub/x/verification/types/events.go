@@ -0,0 +1,17 @@
+package types
+
+// verification module event types
+const (
+	EventTypeVerificationRequestPacket = "verification_request_packet"
+	EventTypeTimeout                   = "timeout"
+
+	AttributeKeyChannel      = "channel"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyCounterparty = "counterparty_chain"
+	AttributeKeyDataType     = "data_type"
+	AttributeKeySubjectId    = "subject_id"
+	AttributeKeyStatus       = "status"
+	AttributeKeyAck          = "acknowledgement"
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+)
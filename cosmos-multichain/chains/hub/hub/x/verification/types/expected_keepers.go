@@ -0,0 +1,44 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crosschaintypes "hub/x/crosschain/types"
+	documentstoragetypes "hub/x/documentstorage/types"
+	integrationverificationtypes "hub/x/integrationverification/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	// Methods imported from account should be defined here
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	// Methods imported from bank should be defined here
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
+
+// ProfileKeeper defines the expected interface for the profileverification module.
+type ProfileKeeper interface {
+	GetProfile(ctx context.Context, userId string) (crosschaintypes.UserProfile, bool)
+}
+
+// DocumentKeeper defines the expected interface for the documentstorage module.
+type DocumentKeeper interface {
+	GetDocument(ctx context.Context, documentId string) (documentstoragetypes.DocumentRecord, bool)
+}
+
+// ServiceKeeper defines the expected interface for the integrationverification module.
+type ServiceKeeper interface {
+	GetService(ctx context.Context, serviceId string) (integrationverificationtypes.ServiceRecord, bool)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	// this line is used by starport scaffolding # genesis/types/import
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PortId:                  PortID,
+		ChannelCounterpartyList: []ChannelCounterparty{},
+		OutgoingRequestList:     []OutgoingRequest{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	// Check for duplicated index in channelCounterparty
+	channelCounterpartyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChannelCounterpartyList {
+		index := string(ChannelCounterpartyKey(elem.ChannelId))
+		if _, ok := channelCounterpartyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for channelCounterparty")
+		}
+		channelCounterpartyIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in outgoingRequest
+	outgoingRequestIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OutgoingRequestList {
+		index := string(OutgoingRequestKey(elem.ChannelId, elem.Sequence))
+		if _, ok := outgoingRequestIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for outgoingRequest")
+		}
+		outgoingRequestIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
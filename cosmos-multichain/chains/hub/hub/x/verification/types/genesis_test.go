@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hub/x/verification/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				ChannelCounterpartyList: []types.ChannelCounterparty{
+					{ChannelId: "channel-0", ChainId: "bloqz-finance-1"},
+					{ChannelId: "channel-1", ChainId: "bloqz-government-1"},
+				},
+				OutgoingRequestList: []types.OutgoingRequest{
+					{ChannelId: "channel-0", Sequence: 1},
+					{ChannelId: "channel-0", Sequence: 2},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated channelCounterparty",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				ChannelCounterpartyList: []types.ChannelCounterparty{
+					{ChannelId: "channel-0", ChainId: "bloqz-finance-1"},
+					{ChannelId: "channel-0", ChainId: "bloqz-healthcare-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated outgoingRequest",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				OutgoingRequestList: []types.OutgoingRequest{
+					{ChannelId: "channel-0", Sequence: 1},
+					{ChannelId: "channel-0", Sequence: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid ordering",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(types.DefaultVersion, []types.CounterpartyPolicy{
+					{ChainId: "bloqz-finance-1", Ordering: "SORTED", DataTypes: []string{types.DataTypeProfile}},
+				}),
+			},
+			valid: false,
+		},
+		{
+			desc: "unsupported data type",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(types.DefaultVersion, []types.CounterpartyPolicy{
+					{ChainId: "bloqz-finance-1", Ordering: "ORDERED", DataTypes: []string{"payments"}},
+				}),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ChannelCounterpartyKeyPrefix is the prefix to retrieve all ChannelCounterparty
+	ChannelCounterpartyKeyPrefix = "ChannelCounterparty/value/"
+)
+
+// ChannelCounterpartyKey returns the store key to retrieve a ChannelCounterparty from the index fields
+func ChannelCounterpartyKey(
+	channelId string,
+) []byte {
+	var key []byte
+
+	channelIdBytes := []byte(channelId)
+	key = append(key, channelIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,27 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// OutgoingRequestKeyPrefix is the prefix to retrieve all OutgoingRequest
+	OutgoingRequestKeyPrefix = "OutgoingRequest/value/"
+)
+
+// OutgoingRequestKey returns the store key to retrieve an OutgoingRequest from the index fields
+func OutgoingRequestKey(
+	channelId string,
+	sequence uint64,
+) []byte {
+	var key []byte
+
+	channelIdBytes := []byte(channelId)
+	key = append(key, channelIdBytes...)
+	key = append(key, []byte("/")...)
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	key = append(key, sequenceBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
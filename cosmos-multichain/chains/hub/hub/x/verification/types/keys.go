@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "verification"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_verification"
+
+	// Version defines the current version the IBC module supports
+	Version = "ics27-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "verification"
+)
+
+var (
+	ParamsKey = []byte("p_verification")
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = KeyPrefix("verification-port-")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSendVerificationRequest{}
+
+func NewMsgSendVerificationRequest(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	dataType string,
+	receiver string,
+	payload []byte,
+) *MsgSendVerificationRequest {
+	return &MsgSendVerificationRequest{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		DataType:         dataType,
+		Receiver:         receiver,
+		Payload:          payload,
+	}
+}
+
+func (msg *MsgSendVerificationRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "invalid packet timeout")
+	}
+	if _, ok := supportedDataTypes[msg.DataType]; !ok {
+		return errorsmod.Wrapf(ErrDataTypeNotAllowed, "unsupported data type %q", msg.DataType)
+	}
+	if len(msg.Payload) == 0 {
+		return errorsmod.Wrap(ErrInvalidEnvelope, "payload cannot be empty")
+	}
+	return nil
+}
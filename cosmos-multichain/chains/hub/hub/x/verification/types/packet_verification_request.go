@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p VerificationRequestPacketData) ValidateBasic() error {
+	if _, ok := supportedDataTypes[p.DataType]; !ok {
+		return errorsmod.Wrapf(ErrDataTypeNotAllowed, "unsupported data type %q", p.DataType)
+	}
+	if p.Envelope == nil {
+		return errorsmod.Wrap(ErrInvalidEnvelope, "envelope cannot be empty")
+	}
+	if p.Envelope.SourceChain == "" || p.Envelope.DestinationChain == "" {
+		return errorsmod.Wrap(ErrInvalidEnvelope, "source and destination chains are required")
+	}
+	if len(p.Envelope.Payload) == 0 {
+		return errorsmod.Wrap(ErrInvalidEnvelope, "payload cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p VerificationRequestPacketData) GetBytes() ([]byte, error) {
+	var modulePacket VerificationPacketData
+
+	modulePacket.Packet = &VerificationPacketData_VerificationRequestPacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Verification data types carried on the verification port
+const (
+	DataTypeProfile       = "profile"
+	DataTypeIdentity      = "identity"
+	DataTypeDocument      = "document"
+	DataTypeCertification = "certification"
+	DataTypeIntegration   = "integration"
+)
+
+var (
+	KeyVersion = []byte("Version")
+	// DefaultVersion mirrors the verification path version in ibc-config.yml
+	DefaultVersion = Version
+)
+
+var (
+	KeyCounterpartyPolicies = []byte("CounterpartyPolicies")
+	// DefaultCounterpartyPolicies mirrors the verification paths in ibc-config.yml
+	DefaultCounterpartyPolicies = []CounterpartyPolicy{
+		{
+			ChainId:   "bloqz-finance-1",
+			Ordering:  channeltypes.ORDERED.String(),
+			DataTypes: []string{DataTypeProfile, DataTypeDocument, DataTypeIntegration},
+		},
+		{
+			ChainId:   "bloqz-healthcare-1",
+			Ordering:  channeltypes.ORDERED.String(),
+			DataTypes: []string{DataTypeProfile, DataTypeDocument, DataTypeIntegration},
+		},
+		{
+			ChainId:   "bloqz-government-1",
+			Ordering:  channeltypes.ORDERED.String(),
+			DataTypes: []string{DataTypeIdentity, DataTypeDocument, DataTypeCertification},
+		},
+	}
+)
+
+// supportedDataTypes are the data types the module knows how to verify
+var supportedDataTypes = map[string]struct{}{
+	DataTypeProfile:       {},
+	DataTypeIdentity:      {},
+	DataTypeDocument:      {},
+	DataTypeCertification: {},
+	DataTypeIntegration:   {},
+}
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	version string,
+	counterpartyPolicies []CounterpartyPolicy,
+) Params {
+	return Params{
+		Version:              version,
+		CounterpartyPolicies: counterpartyPolicies,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVersion,
+		DefaultCounterpartyPolicies,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVersion, &p.Version, validateVersion),
+		paramtypes.NewParamSetPair(KeyCounterpartyPolicies, &p.CounterpartyPolicies, validateCounterpartyPolicies),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateVersion(p.Version); err != nil {
+		return err
+	}
+	return validateCounterpartyPolicies(p.CounterpartyPolicies)
+}
+
+// CounterpartyPolicy returns the policy configured for the given chain
+func (p Params) CounterpartyPolicy(chainId string) (CounterpartyPolicy, bool) {
+	for _, policy := range p.CounterpartyPolicies {
+		if policy.ChainId == chainId {
+			return policy, true
+		}
+	}
+	return CounterpartyPolicy{}, false
+}
+
+// Order returns the channel ordering required by the policy
+func (p CounterpartyPolicy) Order() channeltypes.Order {
+	return channeltypes.Order(channeltypes.Order_value[p.Ordering])
+}
+
+// AllowsDataType reports whether the data type is allowlisted by the policy
+func (p CounterpartyPolicy) AllowsDataType(dataType string) bool {
+	for _, dt := range p.DataTypes {
+		if dt == dataType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateVersion validates the Version param
+func validateVersion(v interface{}) error {
+	version, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if version == "" {
+		return fmt.Errorf("version cannot be empty")
+	}
+	return nil
+}
+
+// validateCounterpartyPolicies validates the CounterpartyPolicies param
+func validateCounterpartyPolicies(v interface{}) error {
+	policies, ok := v.([]CounterpartyPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chains := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		if policy.ChainId == "" {
+			return fmt.Errorf("counterparty chain id cannot be empty")
+		}
+		if _, ok := chains[policy.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty policy for %s", policy.ChainId)
+		}
+		chains[policy.ChainId] = struct{}{}
+
+		if order := policy.Order(); order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+			return fmt.Errorf("invalid ordering %q for %s", policy.Ordering, policy.ChainId)
+		}
+		if len(policy.DataTypes) == 0 {
+			return fmt.Errorf("counterparty %s must allow at least one data type", policy.ChainId)
+		}
+		for _, dt := range policy.DataTypes {
+			if _, ok := supportedDataTypes[dt]; !ok {
+				return fmt.Errorf("unsupported data type %q for %s", dt, policy.ChainId)
+			}
+		}
+	}
+	return nil
+}
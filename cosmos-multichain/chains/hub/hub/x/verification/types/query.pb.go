@@ -0,0 +1,2270 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hub/verification/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetChannelCounterpartyRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryGetChannelCounterpartyRequest) Reset()         { *m = QueryGetChannelCounterpartyRequest{} }
+func (m *QueryGetChannelCounterpartyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChannelCounterpartyRequest) ProtoMessage()    {}
+func (*QueryGetChannelCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{2}
+}
+func (m *QueryGetChannelCounterpartyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChannelCounterpartyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChannelCounterpartyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChannelCounterpartyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChannelCounterpartyRequest.Merge(m, src)
+}
+func (m *QueryGetChannelCounterpartyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChannelCounterpartyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChannelCounterpartyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChannelCounterpartyRequest proto.InternalMessageInfo
+
+func (m *QueryGetChannelCounterpartyRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+type QueryGetChannelCounterpartyResponse struct {
+	ChannelCounterparty ChannelCounterparty `protobuf:"bytes,1,opt,name=channelCounterparty,proto3" json:"channelCounterparty"`
+}
+
+func (m *QueryGetChannelCounterpartyResponse) Reset()         { *m = QueryGetChannelCounterpartyResponse{} }
+func (m *QueryGetChannelCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChannelCounterpartyResponse) ProtoMessage()    {}
+func (*QueryGetChannelCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{3}
+}
+func (m *QueryGetChannelCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChannelCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChannelCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChannelCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChannelCounterpartyResponse.Merge(m, src)
+}
+func (m *QueryGetChannelCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChannelCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChannelCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChannelCounterpartyResponse proto.InternalMessageInfo
+
+func (m *QueryGetChannelCounterpartyResponse) GetChannelCounterparty() ChannelCounterparty {
+	if m != nil {
+		return m.ChannelCounterparty
+	}
+	return ChannelCounterparty{}
+}
+
+type QueryAllChannelCounterpartyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllChannelCounterpartyRequest) Reset()         { *m = QueryAllChannelCounterpartyRequest{} }
+func (m *QueryAllChannelCounterpartyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelCounterpartyRequest) ProtoMessage()    {}
+func (*QueryAllChannelCounterpartyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{4}
+}
+func (m *QueryAllChannelCounterpartyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelCounterpartyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelCounterpartyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelCounterpartyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelCounterpartyRequest.Merge(m, src)
+}
+func (m *QueryAllChannelCounterpartyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelCounterpartyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelCounterpartyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelCounterpartyRequest proto.InternalMessageInfo
+
+func (m *QueryAllChannelCounterpartyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllChannelCounterpartyResponse struct {
+	ChannelCounterparty []ChannelCounterparty `protobuf:"bytes,1,rep,name=channelCounterparty,proto3" json:"channelCounterparty"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllChannelCounterpartyResponse) Reset()         { *m = QueryAllChannelCounterpartyResponse{} }
+func (m *QueryAllChannelCounterpartyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelCounterpartyResponse) ProtoMessage()    {}
+func (*QueryAllChannelCounterpartyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{5}
+}
+func (m *QueryAllChannelCounterpartyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelCounterpartyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelCounterpartyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelCounterpartyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelCounterpartyResponse.Merge(m, src)
+}
+func (m *QueryAllChannelCounterpartyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelCounterpartyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelCounterpartyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelCounterpartyResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelCounterpartyResponse) GetChannelCounterparty() []ChannelCounterparty {
+	if m != nil {
+		return m.ChannelCounterparty
+	}
+	return nil
+}
+
+func (m *QueryAllChannelCounterpartyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetOutgoingRequestRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetOutgoingRequestRequest) Reset()         { *m = QueryGetOutgoingRequestRequest{} }
+func (m *QueryGetOutgoingRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutgoingRequestRequest) ProtoMessage()    {}
+func (*QueryGetOutgoingRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{6}
+}
+func (m *QueryGetOutgoingRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutgoingRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutgoingRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutgoingRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutgoingRequestRequest.Merge(m, src)
+}
+func (m *QueryGetOutgoingRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutgoingRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutgoingRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutgoingRequestRequest proto.InternalMessageInfo
+
+func (m *QueryGetOutgoingRequestRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetOutgoingRequestRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetOutgoingRequestResponse struct {
+	OutgoingRequest OutgoingRequest `protobuf:"bytes,1,opt,name=outgoingRequest,proto3" json:"outgoingRequest"`
+}
+
+func (m *QueryGetOutgoingRequestResponse) Reset()         { *m = QueryGetOutgoingRequestResponse{} }
+func (m *QueryGetOutgoingRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutgoingRequestResponse) ProtoMessage()    {}
+func (*QueryGetOutgoingRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{7}
+}
+func (m *QueryGetOutgoingRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutgoingRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutgoingRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutgoingRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutgoingRequestResponse.Merge(m, src)
+}
+func (m *QueryGetOutgoingRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutgoingRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutgoingRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutgoingRequestResponse proto.InternalMessageInfo
+
+func (m *QueryGetOutgoingRequestResponse) GetOutgoingRequest() OutgoingRequest {
+	if m != nil {
+		return m.OutgoingRequest
+	}
+	return OutgoingRequest{}
+}
+
+type QueryAllOutgoingRequestRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllOutgoingRequestRequest) Reset()         { *m = QueryAllOutgoingRequestRequest{} }
+func (m *QueryAllOutgoingRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutgoingRequestRequest) ProtoMessage()    {}
+func (*QueryAllOutgoingRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{8}
+}
+func (m *QueryAllOutgoingRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOutgoingRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutgoingRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllOutgoingRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutgoingRequestRequest.Merge(m, src)
+}
+func (m *QueryAllOutgoingRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOutgoingRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutgoingRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllOutgoingRequestRequest proto.InternalMessageInfo
+
+func (m *QueryAllOutgoingRequestRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllOutgoingRequestResponse struct {
+	OutgoingRequest []OutgoingRequest   `protobuf:"bytes,1,rep,name=outgoingRequest,proto3" json:"outgoingRequest"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllOutgoingRequestResponse) Reset()         { *m = QueryAllOutgoingRequestResponse{} }
+func (m *QueryAllOutgoingRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutgoingRequestResponse) ProtoMessage()    {}
+func (*QueryAllOutgoingRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d55f3d51ea118c, []int{9}
+}
+func (m *QueryAllOutgoingRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOutgoingRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutgoingRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllOutgoingRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutgoingRequestResponse.Merge(m, src)
+}
+func (m *QueryAllOutgoingRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOutgoingRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutgoingRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllOutgoingRequestResponse proto.InternalMessageInfo
+
+func (m *QueryAllOutgoingRequestResponse) GetOutgoingRequest() []OutgoingRequest {
+	if m != nil {
+		return m.OutgoingRequest
+	}
+	return nil
+}
+
+func (m *QueryAllOutgoingRequestResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hub.verification.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hub.verification.QueryParamsResponse")
+	proto.RegisterType((*QueryGetChannelCounterpartyRequest)(nil), "hub.verification.QueryGetChannelCounterpartyRequest")
+	proto.RegisterType((*QueryGetChannelCounterpartyResponse)(nil), "hub.verification.QueryGetChannelCounterpartyResponse")
+	proto.RegisterType((*QueryAllChannelCounterpartyRequest)(nil), "hub.verification.QueryAllChannelCounterpartyRequest")
+	proto.RegisterType((*QueryAllChannelCounterpartyResponse)(nil), "hub.verification.QueryAllChannelCounterpartyResponse")
+	proto.RegisterType((*QueryGetOutgoingRequestRequest)(nil), "hub.verification.QueryGetOutgoingRequestRequest")
+	proto.RegisterType((*QueryGetOutgoingRequestResponse)(nil), "hub.verification.QueryGetOutgoingRequestResponse")
+	proto.RegisterType((*QueryAllOutgoingRequestRequest)(nil), "hub.verification.QueryAllOutgoingRequestRequest")
+	proto.RegisterType((*QueryAllOutgoingRequestResponse)(nil), "hub.verification.QueryAllOutgoingRequestResponse")
+}
+
+func init() { proto.RegisterFile("hub/verification/query.proto", fileDescriptor_a9d55f3d51ea118c) }
+
+var fileDescriptor_a9d55f3d51ea118c = []byte{
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0x01, 0x7e, 0x9b, 0x1f, 0xe3, 0x01, 0x1d, 0x88, 0xd9, 0x34, 0x50, 0x70, 0x04, 0x24,
+	0x1c, 0x3a, 0xfc, 0x91, 0x8b, 0x78, 0x61, 0x49, 0x24, 0x9e, 0x84, 0x1e, 0x35, 0x86, 0xcc, 0x96,
+	0xb1, 0xdb, 0xa4, 0x74, 0x4a, 0x3b, 0x45, 0x09, 0xe1, 0x62, 0xfc, 0x00, 0x26, 0x7e, 0x06, 0x13,
+	0x8f, 0x5e, 0xbc, 0x98, 0x18, 0xaf, 0x5c, 0x4c, 0x48, 0xbc, 0x78, 0x52, 0x03, 0x26, 0x7e, 0x0d,
+	0xd3, 0x99, 0xa9, 0x6c, 0xb7, 0xdb, 0x5d, 0x36, 0xee, 0x85, 0x94, 0x79, 0xe7, 0x79, 0xde, 0xe7,
+	0x79, 0xdf, 0x77, 0xde, 0x2c, 0x9c, 0x6c, 0x26, 0x0d, 0x72, 0xc8, 0x22, 0xef, 0x99, 0xe7, 0x50,
+	0xe1, 0xf1, 0x80, 0x1c, 0x24, 0x2c, 0x3a, 0xb2, 0xc2, 0x88, 0x0b, 0x8e, 0xae, 0x37, 0x93, 0x86,
+	0xd5, 0x1a, 0x35, 0x6e, 0xd0, 0x7d, 0x2f, 0xe0, 0x44, 0xfe, 0x55, 0x97, 0x8c, 0x09, 0x97, 0xbb,
+	0x5c, 0x7e, 0x92, 0xf4, 0x4b, 0x9f, 0x4e, 0xba, 0x9c, 0xbb, 0x3e, 0x23, 0x34, 0xf4, 0x08, 0x0d,
+	0x02, 0x2e, 0x24, 0x3e, 0xd6, 0xd1, 0x45, 0x87, 0xc7, 0xfb, 0x3c, 0x26, 0x0d, 0x1a, 0x33, 0x95,
+	0x91, 0x1c, 0x2e, 0x37, 0x98, 0xa0, 0xcb, 0x24, 0xa4, 0xae, 0x17, 0xc8, 0xcb, 0xfa, 0xee, 0x54,
+	0x41, 0x62, 0x48, 0x23, 0xba, 0x9f, 0x51, 0x15, 0x1d, 0xc4, 0x82, 0x0a, 0xa6, 0xa2, 0x78, 0x02,
+	0xa2, 0x9d, 0x94, 0x7e, 0x5b, 0x42, 0x6c, 0x76, 0x90, 0xb0, 0x58, 0x60, 0x1b, 0x8e, 0xe7, 0x4e,
+	0xe3, 0x90, 0x07, 0x31, 0x43, 0xeb, 0xb0, 0xaa, 0xa8, 0x6b, 0x60, 0x06, 0x2c, 0x5c, 0x5b, 0xa9,
+	0x59, 0xed, 0xfe, 0x2d, 0x85, 0xa8, 0x8f, 0x9e, 0x7e, 0x9f, 0xae, 0xbc, 0xfb, 0xfd, 0x7e, 0x11,
+	0xd8, 0x1a, 0x82, 0x37, 0x21, 0x96, 0x9c, 0x5b, 0x4c, 0x6c, 0x36, 0x69, 0x10, 0x30, 0x7f, 0x93,
+	0x27, 0x81, 0x60, 0x51, 0x48, 0x23, 0x71, 0xa4, 0x33, 0xa3, 0x29, 0x08, 0x1d, 0x15, 0xdd, 0xf5,
+	0xf6, 0x64, 0x9a, 0x51, 0x7b, 0x54, 0x9f, 0x3c, 0xdc, 0xc3, 0xaf, 0x00, 0xbc, 0xdd, 0x95, 0x45,
+	0x2b, 0x7d, 0x0a, 0xc7, 0x9d, 0x62, 0x58, 0xcb, 0x9e, 0x2b, 0xca, 0xee, 0xc0, 0x55, 0x1f, 0x49,
+	0x3d, 0xd8, 0x9d, 0x78, 0xb0, 0xaf, 0xbd, 0x6c, 0xf8, 0x7e, 0x17, 0x2f, 0x0f, 0x20, 0xbc, 0x6c,
+	0x96, 0xce, 0x3d, 0x6f, 0xa9, 0xce, 0x5a, 0x69, 0x67, 0x2d, 0x35, 0x4b, 0xba, 0xb3, 0xd6, 0x36,
+	0x75, 0x99, 0xc6, 0xda, 0x2d, 0x48, 0xfc, 0x25, 0x33, 0x5d, 0x96, 0xae, 0x97, 0xe9, 0xe1, 0x41,
+	0x98, 0x46, 0x5b, 0x39, 0x3b, 0x43, 0xd2, 0xce, 0x9d, 0x9e, 0x76, 0x94, 0xb6, 0x9c, 0x9f, 0x27,
+	0xd0, 0xcc, 0x7a, 0xf8, 0x28, 0x11, 0x2e, 0xf7, 0x02, 0x37, 0xb3, 0x7d, 0xa5, 0x29, 0x40, 0x06,
+	0xfc, 0x3f, 0x4e, 0x6f, 0x06, 0x0e, 0x93, 0x3a, 0x46, 0xec, 0xbf, 0xff, 0x63, 0x01, 0xa7, 0x4b,
+	0xc9, 0x75, 0x9d, 0x76, 0xe0, 0x18, 0xcf, 0x87, 0x74, 0x73, 0x6e, 0x15, 0x6b, 0xd4, 0xc6, 0xa1,
+	0xeb, 0xd3, 0x8e, 0xc7, 0x4d, 0x6d, 0x69, 0xc3, 0xf7, 0x4b, 0x2c, 0x0d, 0x6a, 0x18, 0x3e, 0x01,
+	0x38, 0x5d, 0x9a, 0xaa, 0x9b, 0xc1, 0xe1, 0x7f, 0x31, 0x38, 0xb0, 0xe6, 0xaf, 0xfc, 0xa8, 0xc2,
+	0xff, 0xa4, 0x7e, 0xf4, 0x1c, 0x56, 0xd5, 0xb6, 0x40, 0xb3, 0x45, 0x59, 0xc5, 0xa5, 0x64, 0xcc,
+	0xf5, 0xb8, 0xa5, 0x92, 0xe1, 0x99, 0x97, 0x5f, 0x7f, 0xbd, 0x19, 0x32, 0x50, 0x8d, 0x94, 0xec,
+	0x45, 0xf4, 0x19, 0xc0, 0xf1, 0x0e, 0xb3, 0x8f, 0xee, 0x96, 0x24, 0xe8, 0xba, 0xb1, 0x8c, 0xb5,
+	0x3e, 0x51, 0x5a, 0xe6, 0xba, 0x94, 0xb9, 0x86, 0x56, 0x8b, 0x32, 0xb3, 0xd1, 0x77, 0x5a, 0x70,
+	0xe4, 0xf8, 0xf2, 0x41, 0x9c, 0xa0, 0x0f, 0x00, 0xde, 0xec, 0x40, 0xbe, 0xe1, 0xfb, 0xa5, 0x26,
+	0xba, 0xae, 0x2a, 0x63, 0xad, 0x4f, 0x94, 0x36, 0x61, 0x49, 0x13, 0x0b, 0x68, 0xfe, 0x6a, 0x26,
+	0xd0, 0x47, 0x00, 0xc7, 0xda, 0x06, 0x0e, 0x2d, 0x95, 0xd7, 0xaf, 0xf3, 0x53, 0x32, 0x96, 0xfb,
+	0x40, 0x68, 0xa1, 0x75, 0x29, 0xf4, 0x3e, 0xba, 0x57, 0x14, 0x9a, 0x4d, 0xfa, 0x6e, 0xa4, 0x30,
+	0xb9, 0x4a, 0x93, 0xe3, 0x6c, 0xb1, 0x9c, 0xa0, 0xb7, 0x00, 0xa2, 0x36, 0xfe, 0xb4, 0xe0, 0x4b,
+	0xe5, 0xa5, 0xeb, 0x53, 0x7f, 0xf9, 0x8b, 0xc6, 0x8b, 0x52, 0xff, 0x2c, 0xc2, 0xbd, 0xf5, 0xd7,
+	0x57, 0x4e, 0xcf, 0x4d, 0x70, 0x76, 0x6e, 0x82, 0x9f, 0xe7, 0x26, 0x78, 0x7d, 0x61, 0x56, 0xce,
+	0x2e, 0xcc, 0xca, 0xb7, 0x0b, 0xb3, 0xf2, 0xb8, 0x96, 0x82, 0x5f, 0xe4, 0xe1, 0xe2, 0x28, 0x64,
+	0x71, 0xa3, 0x2a, 0x7f, 0x0d, 0xac, 0xfe, 0x19, 0x00, 0x21, 0x30, 0xbf, 0xce, 0xef, 0x08, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ChannelCounterparty items.
+	ChannelCounterparty(ctx context.Context, in *QueryGetChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryGetChannelCounterpartyResponse, error)
+	ChannelCounterpartyAll(ctx context.Context, in *QueryAllChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryAllChannelCounterpartyResponse, error)
+	// Queries a list of OutgoingRequest items.
+	OutgoingRequest(ctx context.Context, in *QueryGetOutgoingRequestRequest, opts ...grpc.CallOption) (*QueryGetOutgoingRequestResponse, error)
+	OutgoingRequestAll(ctx context.Context, in *QueryAllOutgoingRequestRequest, opts ...grpc.CallOption) (*QueryAllOutgoingRequestResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hub.verification.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelCounterparty(ctx context.Context, in *QueryGetChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryGetChannelCounterpartyResponse, error) {
+	out := new(QueryGetChannelCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/hub.verification.Query/ChannelCounterparty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelCounterpartyAll(ctx context.Context, in *QueryAllChannelCounterpartyRequest, opts ...grpc.CallOption) (*QueryAllChannelCounterpartyResponse, error) {
+	out := new(QueryAllChannelCounterpartyResponse)
+	err := c.cc.Invoke(ctx, "/hub.verification.Query/ChannelCounterpartyAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutgoingRequest(ctx context.Context, in *QueryGetOutgoingRequestRequest, opts ...grpc.CallOption) (*QueryGetOutgoingRequestResponse, error) {
+	out := new(QueryGetOutgoingRequestResponse)
+	err := c.cc.Invoke(ctx, "/hub.verification.Query/OutgoingRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutgoingRequestAll(ctx context.Context, in *QueryAllOutgoingRequestRequest, opts ...grpc.CallOption) (*QueryAllOutgoingRequestResponse, error) {
+	out := new(QueryAllOutgoingRequestResponse)
+	err := c.cc.Invoke(ctx, "/hub.verification.Query/OutgoingRequestAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ChannelCounterparty items.
+	ChannelCounterparty(context.Context, *QueryGetChannelCounterpartyRequest) (*QueryGetChannelCounterpartyResponse, error)
+	ChannelCounterpartyAll(context.Context, *QueryAllChannelCounterpartyRequest) (*QueryAllChannelCounterpartyResponse, error)
+	// Queries a list of OutgoingRequest items.
+	OutgoingRequest(context.Context, *QueryGetOutgoingRequestRequest) (*QueryGetOutgoingRequestResponse, error)
+	OutgoingRequestAll(context.Context, *QueryAllOutgoingRequestRequest) (*QueryAllOutgoingRequestResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ChannelCounterparty(ctx context.Context, req *QueryGetChannelCounterpartyRequest) (*QueryGetChannelCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCounterparty not implemented")
+}
+func (*UnimplementedQueryServer) ChannelCounterpartyAll(ctx context.Context, req *QueryAllChannelCounterpartyRequest) (*QueryAllChannelCounterpartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelCounterpartyAll not implemented")
+}
+func (*UnimplementedQueryServer) OutgoingRequest(ctx context.Context, req *QueryGetOutgoingRequestRequest) (*QueryGetOutgoingRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingRequest not implemented")
+}
+func (*UnimplementedQueryServer) OutgoingRequestAll(ctx context.Context, req *QueryAllOutgoingRequestRequest) (*QueryAllOutgoingRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingRequestAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.verification.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelCounterparty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChannelCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelCounterparty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.verification.Query/ChannelCounterparty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelCounterparty(ctx, req.(*QueryGetChannelCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelCounterpartyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelCounterpartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelCounterpartyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.verification.Query/ChannelCounterpartyAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelCounterpartyAll(ctx, req.(*QueryAllChannelCounterpartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutgoingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutgoingRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.verification.Query/OutgoingRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingRequest(ctx, req.(*QueryGetOutgoingRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutgoingRequestAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutgoingRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingRequestAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.verification.Query/OutgoingRequestAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingRequestAll(ctx, req.(*QueryAllOutgoingRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hub.verification.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ChannelCounterparty",
+			Handler:    _Query_ChannelCounterparty_Handler,
+		},
+		{
+			MethodName: "ChannelCounterpartyAll",
+			Handler:    _Query_ChannelCounterpartyAll_Handler,
+		},
+		{
+			MethodName: "OutgoingRequest",
+			Handler:    _Query_OutgoingRequest_Handler,
+		},
+		{
+			MethodName: "OutgoingRequestAll",
+			Handler:    _Query_OutgoingRequestAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hub/verification/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChannelCounterpartyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChannelCounterpartyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChannelCounterpartyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChannelCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChannelCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChannelCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChannelCounterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelCounterpartyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelCounterpartyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelCounterpartyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChannelCounterpartyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChannelCounterpartyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChannelCounterpartyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelCounterparty) > 0 {
+		for iNdEx := len(m.ChannelCounterparty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelCounterparty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutgoingRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutgoingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutgoingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutgoingRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutgoingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutgoingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OutgoingRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutgoingRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutgoingRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutgoingRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutgoingRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutgoingRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutgoingRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutgoingRequest) > 0 {
+		for iNdEx := len(m.OutgoingRequest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingRequest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetChannelCounterpartyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChannelCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChannelCounterparty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChannelCounterpartyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChannelCounterpartyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelCounterparty) > 0 {
+		for _, e := range m.ChannelCounterparty {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOutgoingRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetOutgoingRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutgoingRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutgoingRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutgoingRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutgoingRequest) > 0 {
+		for _, e := range m.OutgoingRequest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChannelCounterpartyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChannelCounterpartyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChannelCounterpartyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChannelCounterpartyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChannelCounterpartyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChannelCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelCounterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelCounterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelCounterpartyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelCounterpartyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelCounterpartyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelCounterpartyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelCounterpartyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelCounterpartyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelCounterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelCounterparty = append(m.ChannelCounterparty, ChannelCounterparty{})
+			if err := m.ChannelCounterparty[len(m.ChannelCounterparty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutgoingRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutgoingRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutgoingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutgoingRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutgoingRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutgoingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutgoingRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutgoingRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutgoingRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutgoingRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutgoingRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutgoingRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutgoingRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingRequest = append(m.OutgoingRequest, OutgoingRequest{})
+			if err := m.OutgoingRequest[len(m.OutgoingRequest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
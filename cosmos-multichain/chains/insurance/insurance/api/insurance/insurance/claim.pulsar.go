@@ -0,0 +1,3950 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package insurance
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Claim_7_list)(nil)
+
+type _Claim_7_list struct {
+	list *[]*ClaimEvidence
+}
+
+func (x *_Claim_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Claim_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Claim_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimEvidence)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Claim_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimEvidence)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Claim_7_list) AppendMutable() protoreflect.Value {
+	v := new(ClaimEvidence)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Claim_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Claim_7_list) NewElement() protoreflect.Value {
+	v := new(ClaimEvidence)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Claim_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Claim_22_list)(nil)
+
+type _Claim_22_list struct {
+	list *[]*FraudSignal
+}
+
+func (x *_Claim_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Claim_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Claim_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FraudSignal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Claim_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FraudSignal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Claim_22_list) AppendMutable() protoreflect.Value {
+	v := new(FraudSignal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Claim_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Claim_22_list) NewElement() protoreflect.Value {
+	v := new(FraudSignal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Claim_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Claim                     protoreflect.MessageDescriptor
+	fd_Claim_claim_id            protoreflect.FieldDescriptor
+	fd_Claim_policy_id           protoreflect.FieldDescriptor
+	fd_Claim_claimant            protoreflect.FieldDescriptor
+	fd_Claim_claim_type          protoreflect.FieldDescriptor
+	fd_Claim_amount              protoreflect.FieldDescriptor
+	fd_Claim_description         protoreflect.FieldDescriptor
+	fd_Claim_evidence            protoreflect.FieldDescriptor
+	fd_Claim_status              protoreflect.FieldDescriptor
+	fd_Claim_adjuster            protoreflect.FieldDescriptor
+	fd_Claim_evidence_request    protoreflect.FieldDescriptor
+	fd_Claim_decision_reason     protoreflect.FieldDescriptor
+	fd_Claim_appealed            protoreflect.FieldDescriptor
+	fd_Claim_appeal_reason       protoreflect.FieldDescriptor
+	fd_Claim_deadline            protoreflect.FieldDescriptor
+	fd_Claim_payment             protoreflect.FieldDescriptor
+	fd_Claim_filed_at            protoreflect.FieldDescriptor
+	fd_Claim_updated_at          protoreflect.FieldDescriptor
+	fd_Claim_source_chain        protoreflect.FieldDescriptor
+	fd_Claim_source_id           protoreflect.FieldDescriptor
+	fd_Claim_decision            protoreflect.FieldDescriptor
+	fd_Claim_fraud_score         protoreflect.FieldDescriptor
+	fd_Claim_fraud_signals       protoreflect.FieldDescriptor
+	fd_Claim_corroboration       protoreflect.FieldDescriptor
+	fd_Claim_corroboration_query protoreflect.FieldDescriptor
+	fd_Claim_payout_attempts     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_insurance_insurance_claim_proto_init()
+	md_Claim = File_insurance_insurance_claim_proto.Messages().ByName("Claim")
+	fd_Claim_claim_id = md_Claim.Fields().ByName("claim_id")
+	fd_Claim_policy_id = md_Claim.Fields().ByName("policy_id")
+	fd_Claim_claimant = md_Claim.Fields().ByName("claimant")
+	fd_Claim_claim_type = md_Claim.Fields().ByName("claim_type")
+	fd_Claim_amount = md_Claim.Fields().ByName("amount")
+	fd_Claim_description = md_Claim.Fields().ByName("description")
+	fd_Claim_evidence = md_Claim.Fields().ByName("evidence")
+	fd_Claim_status = md_Claim.Fields().ByName("status")
+	fd_Claim_adjuster = md_Claim.Fields().ByName("adjuster")
+	fd_Claim_evidence_request = md_Claim.Fields().ByName("evidence_request")
+	fd_Claim_decision_reason = md_Claim.Fields().ByName("decision_reason")
+	fd_Claim_appealed = md_Claim.Fields().ByName("appealed")
+	fd_Claim_appeal_reason = md_Claim.Fields().ByName("appeal_reason")
+	fd_Claim_deadline = md_Claim.Fields().ByName("deadline")
+	fd_Claim_payment = md_Claim.Fields().ByName("payment")
+	fd_Claim_filed_at = md_Claim.Fields().ByName("filed_at")
+	fd_Claim_updated_at = md_Claim.Fields().ByName("updated_at")
+	fd_Claim_source_chain = md_Claim.Fields().ByName("source_chain")
+	fd_Claim_source_id = md_Claim.Fields().ByName("source_id")
+	fd_Claim_decision = md_Claim.Fields().ByName("decision")
+	fd_Claim_fraud_score = md_Claim.Fields().ByName("fraud_score")
+	fd_Claim_fraud_signals = md_Claim.Fields().ByName("fraud_signals")
+	fd_Claim_corroboration = md_Claim.Fields().ByName("corroboration")
+	fd_Claim_corroboration_query = md_Claim.Fields().ByName("corroboration_query")
+	fd_Claim_payout_attempts = md_Claim.Fields().ByName("payout_attempts")
+}
+
+var _ protoreflect.Message = (*fastReflection_Claim)(nil)
+
+type fastReflection_Claim Claim
+
+func (x *Claim) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Claim)(x)
+}
+
+func (x *Claim) slowProtoReflect() protoreflect.Message {
+	mi := &file_insurance_insurance_claim_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Claim_messageType fastReflection_Claim_messageType
+var _ protoreflect.MessageType = fastReflection_Claim_messageType{}
+
+type fastReflection_Claim_messageType struct{}
+
+func (x fastReflection_Claim_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Claim)(nil)
+}
+func (x fastReflection_Claim_messageType) New() protoreflect.Message {
+	return new(fastReflection_Claim)
+}
+func (x fastReflection_Claim_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Claim
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Claim) Descriptor() protoreflect.MessageDescriptor {
+	return md_Claim
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Claim) Type() protoreflect.MessageType {
+	return _fastReflection_Claim_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Claim) New() protoreflect.Message {
+	return new(fastReflection_Claim)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Claim) Interface() protoreflect.ProtoMessage {
+	return (*Claim)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Claim) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClaimId != "" {
+		value := protoreflect.ValueOfString(x.ClaimId)
+		if !f(fd_Claim_claim_id, value) {
+			return
+		}
+	}
+	if x.PolicyId != "" {
+		value := protoreflect.ValueOfString(x.PolicyId)
+		if !f(fd_Claim_policy_id, value) {
+			return
+		}
+	}
+	if x.Claimant != "" {
+		value := protoreflect.ValueOfString(x.Claimant)
+		if !f(fd_Claim_claimant, value) {
+			return
+		}
+	}
+	if x.ClaimType != "" {
+		value := protoreflect.ValueOfString(x.ClaimType)
+		if !f(fd_Claim_claim_type, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Claim_amount, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_Claim_description, value) {
+			return
+		}
+	}
+	if len(x.Evidence) != 0 {
+		value := protoreflect.ValueOfList(&_Claim_7_list{list: &x.Evidence})
+		if !f(fd_Claim_evidence, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_Claim_status, value) {
+			return
+		}
+	}
+	if x.Adjuster != "" {
+		value := protoreflect.ValueOfString(x.Adjuster)
+		if !f(fd_Claim_adjuster, value) {
+			return
+		}
+	}
+	if x.EvidenceRequest != "" {
+		value := protoreflect.ValueOfString(x.EvidenceRequest)
+		if !f(fd_Claim_evidence_request, value) {
+			return
+		}
+	}
+	if x.DecisionReason != "" {
+		value := protoreflect.ValueOfString(x.DecisionReason)
+		if !f(fd_Claim_decision_reason, value) {
+			return
+		}
+	}
+	if x.Appealed != false {
+		value := protoreflect.ValueOfBool(x.Appealed)
+		if !f(fd_Claim_appealed, value) {
+			return
+		}
+	}
+	if x.AppealReason != "" {
+		value := protoreflect.ValueOfString(x.AppealReason)
+		if !f(fd_Claim_appeal_reason, value) {
+			return
+		}
+	}
+	if x.Deadline != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Deadline)
+		if !f(fd_Claim_deadline, value) {
+			return
+		}
+	}
+	if len(x.Payment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payment)
+		if !f(fd_Claim_payment, value) {
+			return
+		}
+	}
+	if x.FiledAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FiledAt)
+		if !f(fd_Claim_filed_at, value) {
+			return
+		}
+	}
+	if x.UpdatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedAt)
+		if !f(fd_Claim_updated_at, value) {
+			return
+		}
+	}
+	if x.SourceChain != "" {
+		value := protoreflect.ValueOfString(x.SourceChain)
+		if !f(fd_Claim_source_chain, value) {
+			return
+		}
+	}
+	if x.SourceId != "" {
+		value := protoreflect.ValueOfString(x.SourceId)
+		if !f(fd_Claim_source_id, value) {
+			return
+		}
+	}
+	if len(x.Decision) != 0 {
+		value := protoreflect.ValueOfBytes(x.Decision)
+		if !f(fd_Claim_decision, value) {
+			return
+		}
+	}
+	if x.FraudScore != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FraudScore)
+		if !f(fd_Claim_fraud_score, value) {
+			return
+		}
+	}
+	if len(x.FraudSignals) != 0 {
+		value := protoreflect.ValueOfList(&_Claim_22_list{list: &x.FraudSignals})
+		if !f(fd_Claim_fraud_signals, value) {
+			return
+		}
+	}
+	if x.Corroboration != "" {
+		value := protoreflect.ValueOfString(x.Corroboration)
+		if !f(fd_Claim_corroboration, value) {
+			return
+		}
+	}
+	if len(x.CorroborationQuery) != 0 {
+		value := protoreflect.ValueOfBytes(x.CorroborationQuery)
+		if !f(fd_Claim_corroboration_query, value) {
+			return
+		}
+	}
+	if x.PayoutAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayoutAttempts)
+		if !f(fd_Claim_payout_attempts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Claim) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "insurance.insurance.Claim.claim_id":
+		return x.ClaimId != ""
+	case "insurance.insurance.Claim.policy_id":
+		return x.PolicyId != ""
+	case "insurance.insurance.Claim.claimant":
+		return x.Claimant != ""
+	case "insurance.insurance.Claim.claim_type":
+		return x.ClaimType != ""
+	case "insurance.insurance.Claim.amount":
+		return x.Amount != ""
+	case "insurance.insurance.Claim.description":
+		return x.Description != ""
+	case "insurance.insurance.Claim.evidence":
+		return len(x.Evidence) != 0
+	case "insurance.insurance.Claim.status":
+		return x.Status != ""
+	case "insurance.insurance.Claim.adjuster":
+		return x.Adjuster != ""
+	case "insurance.insurance.Claim.evidence_request":
+		return x.EvidenceRequest != ""
+	case "insurance.insurance.Claim.decision_reason":
+		return x.DecisionReason != ""
+	case "insurance.insurance.Claim.appealed":
+		return x.Appealed != false
+	case "insurance.insurance.Claim.appeal_reason":
+		return x.AppealReason != ""
+	case "insurance.insurance.Claim.deadline":
+		return x.Deadline != int64(0)
+	case "insurance.insurance.Claim.payment":
+		return len(x.Payment) != 0
+	case "insurance.insurance.Claim.filed_at":
+		return x.FiledAt != int64(0)
+	case "insurance.insurance.Claim.updated_at":
+		return x.UpdatedAt != int64(0)
+	case "insurance.insurance.Claim.source_chain":
+		return x.SourceChain != ""
+	case "insurance.insurance.Claim.source_id":
+		return x.SourceId != ""
+	case "insurance.insurance.Claim.decision":
+		return len(x.Decision) != 0
+	case "insurance.insurance.Claim.fraud_score":
+		return x.FraudScore != uint64(0)
+	case "insurance.insurance.Claim.fraud_signals":
+		return len(x.FraudSignals) != 0
+	case "insurance.insurance.Claim.corroboration":
+		return x.Corroboration != ""
+	case "insurance.insurance.Claim.corroboration_query":
+		return len(x.CorroborationQuery) != 0
+	case "insurance.insurance.Claim.payout_attempts":
+		return x.PayoutAttempts != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Claim) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "insurance.insurance.Claim.claim_id":
+		x.ClaimId = ""
+	case "insurance.insurance.Claim.policy_id":
+		x.PolicyId = ""
+	case "insurance.insurance.Claim.claimant":
+		x.Claimant = ""
+	case "insurance.insurance.Claim.claim_type":
+		x.ClaimType = ""
+	case "insurance.insurance.Claim.amount":
+		x.Amount = ""
+	case "insurance.insurance.Claim.description":
+		x.Description = ""
+	case "insurance.insurance.Claim.evidence":
+		x.Evidence = nil
+	case "insurance.insurance.Claim.status":
+		x.Status = ""
+	case "insurance.insurance.Claim.adjuster":
+		x.Adjuster = ""
+	case "insurance.insurance.Claim.evidence_request":
+		x.EvidenceRequest = ""
+	case "insurance.insurance.Claim.decision_reason":
+		x.DecisionReason = ""
+	case "insurance.insurance.Claim.appealed":
+		x.Appealed = false
+	case "insurance.insurance.Claim.appeal_reason":
+		x.AppealReason = ""
+	case "insurance.insurance.Claim.deadline":
+		x.Deadline = int64(0)
+	case "insurance.insurance.Claim.payment":
+		x.Payment = nil
+	case "insurance.insurance.Claim.filed_at":
+		x.FiledAt = int64(0)
+	case "insurance.insurance.Claim.updated_at":
+		x.UpdatedAt = int64(0)
+	case "insurance.insurance.Claim.source_chain":
+		x.SourceChain = ""
+	case "insurance.insurance.Claim.source_id":
+		x.SourceId = ""
+	case "insurance.insurance.Claim.decision":
+		x.Decision = nil
+	case "insurance.insurance.Claim.fraud_score":
+		x.FraudScore = uint64(0)
+	case "insurance.insurance.Claim.fraud_signals":
+		x.FraudSignals = nil
+	case "insurance.insurance.Claim.corroboration":
+		x.Corroboration = ""
+	case "insurance.insurance.Claim.corroboration_query":
+		x.CorroborationQuery = nil
+	case "insurance.insurance.Claim.payout_attempts":
+		x.PayoutAttempts = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Claim) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "insurance.insurance.Claim.claim_id":
+		value := x.ClaimId
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.policy_id":
+		value := x.PolicyId
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.claimant":
+		value := x.Claimant
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.claim_type":
+		value := x.ClaimType
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.evidence":
+		if len(x.Evidence) == 0 {
+			return protoreflect.ValueOfList(&_Claim_7_list{})
+		}
+		listValue := &_Claim_7_list{list: &x.Evidence}
+		return protoreflect.ValueOfList(listValue)
+	case "insurance.insurance.Claim.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.adjuster":
+		value := x.Adjuster
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.evidence_request":
+		value := x.EvidenceRequest
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.decision_reason":
+		value := x.DecisionReason
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.appealed":
+		value := x.Appealed
+		return protoreflect.ValueOfBool(value)
+	case "insurance.insurance.Claim.appeal_reason":
+		value := x.AppealReason
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.deadline":
+		value := x.Deadline
+		return protoreflect.ValueOfInt64(value)
+	case "insurance.insurance.Claim.payment":
+		value := x.Payment
+		return protoreflect.ValueOfBytes(value)
+	case "insurance.insurance.Claim.filed_at":
+		value := x.FiledAt
+		return protoreflect.ValueOfInt64(value)
+	case "insurance.insurance.Claim.updated_at":
+		value := x.UpdatedAt
+		return protoreflect.ValueOfInt64(value)
+	case "insurance.insurance.Claim.source_chain":
+		value := x.SourceChain
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.source_id":
+		value := x.SourceId
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.decision":
+		value := x.Decision
+		return protoreflect.ValueOfBytes(value)
+	case "insurance.insurance.Claim.fraud_score":
+		value := x.FraudScore
+		return protoreflect.ValueOfUint64(value)
+	case "insurance.insurance.Claim.fraud_signals":
+		if len(x.FraudSignals) == 0 {
+			return protoreflect.ValueOfList(&_Claim_22_list{})
+		}
+		listValue := &_Claim_22_list{list: &x.FraudSignals}
+		return protoreflect.ValueOfList(listValue)
+	case "insurance.insurance.Claim.corroboration":
+		value := x.Corroboration
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.Claim.corroboration_query":
+		value := x.CorroborationQuery
+		return protoreflect.ValueOfBytes(value)
+	case "insurance.insurance.Claim.payout_attempts":
+		value := x.PayoutAttempts
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Claim) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "insurance.insurance.Claim.claim_id":
+		x.ClaimId = value.Interface().(string)
+	case "insurance.insurance.Claim.policy_id":
+		x.PolicyId = value.Interface().(string)
+	case "insurance.insurance.Claim.claimant":
+		x.Claimant = value.Interface().(string)
+	case "insurance.insurance.Claim.claim_type":
+		x.ClaimType = value.Interface().(string)
+	case "insurance.insurance.Claim.amount":
+		x.Amount = value.Interface().(string)
+	case "insurance.insurance.Claim.description":
+		x.Description = value.Interface().(string)
+	case "insurance.insurance.Claim.evidence":
+		lv := value.List()
+		clv := lv.(*_Claim_7_list)
+		x.Evidence = *clv.list
+	case "insurance.insurance.Claim.status":
+		x.Status = value.Interface().(string)
+	case "insurance.insurance.Claim.adjuster":
+		x.Adjuster = value.Interface().(string)
+	case "insurance.insurance.Claim.evidence_request":
+		x.EvidenceRequest = value.Interface().(string)
+	case "insurance.insurance.Claim.decision_reason":
+		x.DecisionReason = value.Interface().(string)
+	case "insurance.insurance.Claim.appealed":
+		x.Appealed = value.Bool()
+	case "insurance.insurance.Claim.appeal_reason":
+		x.AppealReason = value.Interface().(string)
+	case "insurance.insurance.Claim.deadline":
+		x.Deadline = value.Int()
+	case "insurance.insurance.Claim.payment":
+		x.Payment = value.Bytes()
+	case "insurance.insurance.Claim.filed_at":
+		x.FiledAt = value.Int()
+	case "insurance.insurance.Claim.updated_at":
+		x.UpdatedAt = value.Int()
+	case "insurance.insurance.Claim.source_chain":
+		x.SourceChain = value.Interface().(string)
+	case "insurance.insurance.Claim.source_id":
+		x.SourceId = value.Interface().(string)
+	case "insurance.insurance.Claim.decision":
+		x.Decision = value.Bytes()
+	case "insurance.insurance.Claim.fraud_score":
+		x.FraudScore = value.Uint()
+	case "insurance.insurance.Claim.fraud_signals":
+		lv := value.List()
+		clv := lv.(*_Claim_22_list)
+		x.FraudSignals = *clv.list
+	case "insurance.insurance.Claim.corroboration":
+		x.Corroboration = value.Interface().(string)
+	case "insurance.insurance.Claim.corroboration_query":
+		x.CorroborationQuery = value.Bytes()
+	case "insurance.insurance.Claim.payout_attempts":
+		x.PayoutAttempts = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Claim) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.Claim.evidence":
+		if x.Evidence == nil {
+			x.Evidence = []*ClaimEvidence{}
+		}
+		value := &_Claim_7_list{list: &x.Evidence}
+		return protoreflect.ValueOfList(value)
+	case "insurance.insurance.Claim.fraud_signals":
+		if x.FraudSignals == nil {
+			x.FraudSignals = []*FraudSignal{}
+		}
+		value := &_Claim_22_list{list: &x.FraudSignals}
+		return protoreflect.ValueOfList(value)
+	case "insurance.insurance.Claim.claim_id":
+		panic(fmt.Errorf("field claim_id of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.policy_id":
+		panic(fmt.Errorf("field policy_id of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.claimant":
+		panic(fmt.Errorf("field claimant of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.claim_type":
+		panic(fmt.Errorf("field claim_type of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.amount":
+		panic(fmt.Errorf("field amount of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.description":
+		panic(fmt.Errorf("field description of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.status":
+		panic(fmt.Errorf("field status of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.adjuster":
+		panic(fmt.Errorf("field adjuster of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.evidence_request":
+		panic(fmt.Errorf("field evidence_request of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.decision_reason":
+		panic(fmt.Errorf("field decision_reason of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.appealed":
+		panic(fmt.Errorf("field appealed of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.appeal_reason":
+		panic(fmt.Errorf("field appeal_reason of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.deadline":
+		panic(fmt.Errorf("field deadline of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.payment":
+		panic(fmt.Errorf("field payment of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.filed_at":
+		panic(fmt.Errorf("field filed_at of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.updated_at":
+		panic(fmt.Errorf("field updated_at of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.source_chain":
+		panic(fmt.Errorf("field source_chain of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.source_id":
+		panic(fmt.Errorf("field source_id of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.decision":
+		panic(fmt.Errorf("field decision of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.fraud_score":
+		panic(fmt.Errorf("field fraud_score of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.corroboration":
+		panic(fmt.Errorf("field corroboration of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.corroboration_query":
+		panic(fmt.Errorf("field corroboration_query of message insurance.insurance.Claim is not mutable"))
+	case "insurance.insurance.Claim.payout_attempts":
+		panic(fmt.Errorf("field payout_attempts of message insurance.insurance.Claim is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Claim) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.Claim.claim_id":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.policy_id":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.claimant":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.claim_type":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.amount":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.description":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.evidence":
+		list := []*ClaimEvidence{}
+		return protoreflect.ValueOfList(&_Claim_7_list{list: &list})
+	case "insurance.insurance.Claim.status":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.adjuster":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.evidence_request":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.decision_reason":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.appealed":
+		return protoreflect.ValueOfBool(false)
+	case "insurance.insurance.Claim.appeal_reason":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.deadline":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "insurance.insurance.Claim.payment":
+		return protoreflect.ValueOfBytes(nil)
+	case "insurance.insurance.Claim.filed_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "insurance.insurance.Claim.updated_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "insurance.insurance.Claim.source_chain":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.source_id":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.decision":
+		return protoreflect.ValueOfBytes(nil)
+	case "insurance.insurance.Claim.fraud_score":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "insurance.insurance.Claim.fraud_signals":
+		list := []*FraudSignal{}
+		return protoreflect.ValueOfList(&_Claim_22_list{list: &list})
+	case "insurance.insurance.Claim.corroboration":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.Claim.corroboration_query":
+		return protoreflect.ValueOfBytes(nil)
+	case "insurance.insurance.Claim.payout_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.Claim"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.Claim does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Claim) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in insurance.insurance.Claim", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Claim) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Claim) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Claim) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Claim) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Claim)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClaimId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PolicyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Claimant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClaimType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Evidence) > 0 {
+			for _, e := range x.Evidence {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Adjuster)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceRequest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DecisionReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Appealed {
+			n += 2
+		}
+		l = len(x.AppealReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.Deadline))
+		}
+		l = len(x.Payment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FiledAt != 0 {
+			n += 2 + runtime.Sov(uint64(x.FiledAt))
+		}
+		if x.UpdatedAt != 0 {
+			n += 2 + runtime.Sov(uint64(x.UpdatedAt))
+		}
+		l = len(x.SourceChain)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SourceId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Decision)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.FraudScore != 0 {
+			n += 2 + runtime.Sov(uint64(x.FraudScore))
+		}
+		if len(x.FraudSignals) > 0 {
+			for _, e := range x.FraudSignals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Corroboration)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CorroborationQuery)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PayoutAttempts != 0 {
+			n += 2 + runtime.Sov(uint64(x.PayoutAttempts))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Claim)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PayoutAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayoutAttempts))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
+		if len(x.CorroborationQuery) > 0 {
+			i -= len(x.CorroborationQuery)
+			copy(dAtA[i:], x.CorroborationQuery)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CorroborationQuery)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.Corroboration) > 0 {
+			i -= len(x.Corroboration)
+			copy(dAtA[i:], x.Corroboration)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Corroboration)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.FraudSignals) > 0 {
+			for iNdEx := len(x.FraudSignals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FraudSignals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if x.FraudScore != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FraudScore))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.Decision) > 0 {
+			i -= len(x.Decision)
+			copy(dAtA[i:], x.Decision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Decision)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.SourceId) > 0 {
+			i -= len(x.SourceId)
+			copy(dAtA[i:], x.SourceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.SourceChain) > 0 {
+			i -= len(x.SourceChain)
+			copy(dAtA[i:], x.SourceChain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceChain)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.UpdatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedAt))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.FiledAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FiledAt))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.Payment) > 0 {
+			i -= len(x.Payment)
+			copy(dAtA[i:], x.Payment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payment)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Deadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deadline))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.AppealReason) > 0 {
+			i -= len(x.AppealReason)
+			copy(dAtA[i:], x.AppealReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppealReason)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.Appealed {
+			i--
+			if x.Appealed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.DecisionReason) > 0 {
+			i -= len(x.DecisionReason)
+			copy(dAtA[i:], x.DecisionReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DecisionReason)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.EvidenceRequest) > 0 {
+			i -= len(x.EvidenceRequest)
+			copy(dAtA[i:], x.EvidenceRequest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceRequest)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Adjuster) > 0 {
+			i -= len(x.Adjuster)
+			copy(dAtA[i:], x.Adjuster)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Adjuster)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Evidence) > 0 {
+			for iNdEx := len(x.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Evidence[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ClaimType) > 0 {
+			i -= len(x.ClaimType)
+			copy(dAtA[i:], x.ClaimType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClaimType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Claimant) > 0 {
+			i -= len(x.Claimant)
+			copy(dAtA[i:], x.Claimant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Claimant)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PolicyId) > 0 {
+			i -= len(x.PolicyId)
+			copy(dAtA[i:], x.PolicyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolicyId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClaimId) > 0 {
+			i -= len(x.ClaimId)
+			copy(dAtA[i:], x.ClaimId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClaimId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Claim)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Claim: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClaimId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolicyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claimant = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClaimType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidence = append(x.Evidence, &ClaimEvidence{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Evidence[len(x.Evidence)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adjuster", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Adjuster = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceRequest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceRequest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecisionReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Appealed = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppealReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				}
+				x.Deadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deadline |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payment = append(x.Payment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payment == nil {
+					x.Payment = []byte{}
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FiledAt", wireType)
+				}
+				x.FiledAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FiledAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				}
+				x.UpdatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceChain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Decision = append(x.Decision[:0], dAtA[iNdEx:postIndex]...)
+				if x.Decision == nil {
+					x.Decision = []byte{}
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FraudScore", wireType)
+				}
+				x.FraudScore = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FraudScore |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FraudSignals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FraudSignals = append(x.FraudSignals, &FraudSignal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FraudSignals[len(x.FraudSignals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Corroboration", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Corroboration = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CorroborationQuery", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CorroborationQuery = append(x.CorroborationQuery[:0], dAtA[iNdEx:postIndex]...)
+				if x.CorroborationQuery == nil {
+					x.CorroborationQuery = []byte{}
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutAttempts", wireType)
+				}
+				x.PayoutAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayoutAttempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FraudSignal                  protoreflect.MessageDescriptor
+	fd_FraudSignal_kind             protoreflect.FieldDescriptor
+	fd_FraudSignal_detail           protoreflect.FieldDescriptor
+	fd_FraudSignal_weight           protoreflect.FieldDescriptor
+	fd_FraudSignal_related_claim_id protoreflect.FieldDescriptor
+	fd_FraudSignal_detected_at      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_insurance_insurance_claim_proto_init()
+	md_FraudSignal = File_insurance_insurance_claim_proto.Messages().ByName("FraudSignal")
+	fd_FraudSignal_kind = md_FraudSignal.Fields().ByName("kind")
+	fd_FraudSignal_detail = md_FraudSignal.Fields().ByName("detail")
+	fd_FraudSignal_weight = md_FraudSignal.Fields().ByName("weight")
+	fd_FraudSignal_related_claim_id = md_FraudSignal.Fields().ByName("related_claim_id")
+	fd_FraudSignal_detected_at = md_FraudSignal.Fields().ByName("detected_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_FraudSignal)(nil)
+
+type fastReflection_FraudSignal FraudSignal
+
+func (x *FraudSignal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FraudSignal)(x)
+}
+
+func (x *FraudSignal) slowProtoReflect() protoreflect.Message {
+	mi := &file_insurance_insurance_claim_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FraudSignal_messageType fastReflection_FraudSignal_messageType
+var _ protoreflect.MessageType = fastReflection_FraudSignal_messageType{}
+
+type fastReflection_FraudSignal_messageType struct{}
+
+func (x fastReflection_FraudSignal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FraudSignal)(nil)
+}
+func (x fastReflection_FraudSignal_messageType) New() protoreflect.Message {
+	return new(fastReflection_FraudSignal)
+}
+func (x fastReflection_FraudSignal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FraudSignal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FraudSignal) Descriptor() protoreflect.MessageDescriptor {
+	return md_FraudSignal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FraudSignal) Type() protoreflect.MessageType {
+	return _fastReflection_FraudSignal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FraudSignal) New() protoreflect.Message {
+	return new(fastReflection_FraudSignal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FraudSignal) Interface() protoreflect.ProtoMessage {
+	return (*FraudSignal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FraudSignal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kind != "" {
+		value := protoreflect.ValueOfString(x.Kind)
+		if !f(fd_FraudSignal_kind, value) {
+			return
+		}
+	}
+	if x.Detail != "" {
+		value := protoreflect.ValueOfString(x.Detail)
+		if !f(fd_FraudSignal_detail, value) {
+			return
+		}
+	}
+	if x.Weight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Weight)
+		if !f(fd_FraudSignal_weight, value) {
+			return
+		}
+	}
+	if x.RelatedClaimId != "" {
+		value := protoreflect.ValueOfString(x.RelatedClaimId)
+		if !f(fd_FraudSignal_related_claim_id, value) {
+			return
+		}
+	}
+	if x.DetectedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DetectedAt)
+		if !f(fd_FraudSignal_detected_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FraudSignal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		return x.Kind != ""
+	case "insurance.insurance.FraudSignal.detail":
+		return x.Detail != ""
+	case "insurance.insurance.FraudSignal.weight":
+		return x.Weight != uint64(0)
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		return x.RelatedClaimId != ""
+	case "insurance.insurance.FraudSignal.detected_at":
+		return x.DetectedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FraudSignal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		x.Kind = ""
+	case "insurance.insurance.FraudSignal.detail":
+		x.Detail = ""
+	case "insurance.insurance.FraudSignal.weight":
+		x.Weight = uint64(0)
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		x.RelatedClaimId = ""
+	case "insurance.insurance.FraudSignal.detected_at":
+		x.DetectedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FraudSignal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		value := x.Kind
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.FraudSignal.detail":
+		value := x.Detail
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.FraudSignal.weight":
+		value := x.Weight
+		return protoreflect.ValueOfUint64(value)
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		value := x.RelatedClaimId
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.FraudSignal.detected_at":
+		value := x.DetectedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FraudSignal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		x.Kind = value.Interface().(string)
+	case "insurance.insurance.FraudSignal.detail":
+		x.Detail = value.Interface().(string)
+	case "insurance.insurance.FraudSignal.weight":
+		x.Weight = value.Uint()
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		x.RelatedClaimId = value.Interface().(string)
+	case "insurance.insurance.FraudSignal.detected_at":
+		x.DetectedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FraudSignal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		panic(fmt.Errorf("field kind of message insurance.insurance.FraudSignal is not mutable"))
+	case "insurance.insurance.FraudSignal.detail":
+		panic(fmt.Errorf("field detail of message insurance.insurance.FraudSignal is not mutable"))
+	case "insurance.insurance.FraudSignal.weight":
+		panic(fmt.Errorf("field weight of message insurance.insurance.FraudSignal is not mutable"))
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		panic(fmt.Errorf("field related_claim_id of message insurance.insurance.FraudSignal is not mutable"))
+	case "insurance.insurance.FraudSignal.detected_at":
+		panic(fmt.Errorf("field detected_at of message insurance.insurance.FraudSignal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FraudSignal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.FraudSignal.kind":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.FraudSignal.detail":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.FraudSignal.weight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "insurance.insurance.FraudSignal.related_claim_id":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.FraudSignal.detected_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.FraudSignal"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.FraudSignal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FraudSignal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in insurance.insurance.FraudSignal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FraudSignal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FraudSignal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FraudSignal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FraudSignal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FraudSignal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kind)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Detail)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Weight != 0 {
+			n += 1 + runtime.Sov(uint64(x.Weight))
+		}
+		l = len(x.RelatedClaimId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DetectedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.DetectedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FraudSignal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DetectedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DetectedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.RelatedClaimId) > 0 {
+			i -= len(x.RelatedClaimId)
+			copy(dAtA[i:], x.RelatedClaimId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelatedClaimId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Weight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Weight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Detail) > 0 {
+			i -= len(x.Detail)
+			copy(dAtA[i:], x.Detail)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Detail)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kind) > 0 {
+			i -= len(x.Kind)
+			copy(dAtA[i:], x.Kind)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kind)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FraudSignal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FraudSignal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FraudSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kind = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Detail = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				x.Weight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Weight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelatedClaimId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelatedClaimId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+				}
+				x.DetectedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DetectedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ClaimEvidence              protoreflect.MessageDescriptor
+	fd_ClaimEvidence_type         protoreflect.FieldDescriptor
+	fd_ClaimEvidence_description  protoreflect.FieldDescriptor
+	fd_ClaimEvidence_hash         protoreflect.FieldDescriptor
+	fd_ClaimEvidence_ipfs_link    protoreflect.FieldDescriptor
+	fd_ClaimEvidence_submitted_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_insurance_insurance_claim_proto_init()
+	md_ClaimEvidence = File_insurance_insurance_claim_proto.Messages().ByName("ClaimEvidence")
+	fd_ClaimEvidence_type = md_ClaimEvidence.Fields().ByName("type")
+	fd_ClaimEvidence_description = md_ClaimEvidence.Fields().ByName("description")
+	fd_ClaimEvidence_hash = md_ClaimEvidence.Fields().ByName("hash")
+	fd_ClaimEvidence_ipfs_link = md_ClaimEvidence.Fields().ByName("ipfs_link")
+	fd_ClaimEvidence_submitted_at = md_ClaimEvidence.Fields().ByName("submitted_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_ClaimEvidence)(nil)
+
+type fastReflection_ClaimEvidence ClaimEvidence
+
+func (x *ClaimEvidence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ClaimEvidence)(x)
+}
+
+func (x *ClaimEvidence) slowProtoReflect() protoreflect.Message {
+	mi := &file_insurance_insurance_claim_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ClaimEvidence_messageType fastReflection_ClaimEvidence_messageType
+var _ protoreflect.MessageType = fastReflection_ClaimEvidence_messageType{}
+
+type fastReflection_ClaimEvidence_messageType struct{}
+
+func (x fastReflection_ClaimEvidence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ClaimEvidence)(nil)
+}
+func (x fastReflection_ClaimEvidence_messageType) New() protoreflect.Message {
+	return new(fastReflection_ClaimEvidence)
+}
+func (x fastReflection_ClaimEvidence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimEvidence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ClaimEvidence) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimEvidence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ClaimEvidence) Type() protoreflect.MessageType {
+	return _fastReflection_ClaimEvidence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ClaimEvidence) New() protoreflect.Message {
+	return new(fastReflection_ClaimEvidence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ClaimEvidence) Interface() protoreflect.ProtoMessage {
+	return (*ClaimEvidence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ClaimEvidence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != "" {
+		value := protoreflect.ValueOfString(x.Type_)
+		if !f(fd_ClaimEvidence_type, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_ClaimEvidence_description, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_ClaimEvidence_hash, value) {
+			return
+		}
+	}
+	if x.IpfsLink != "" {
+		value := protoreflect.ValueOfString(x.IpfsLink)
+		if !f(fd_ClaimEvidence_ipfs_link, value) {
+			return
+		}
+	}
+	if x.SubmittedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SubmittedAt)
+		if !f(fd_ClaimEvidence_submitted_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClaimEvidence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		return x.Type_ != ""
+	case "insurance.insurance.ClaimEvidence.description":
+		return x.Description != ""
+	case "insurance.insurance.ClaimEvidence.hash":
+		return x.Hash != ""
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		return x.IpfsLink != ""
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		return x.SubmittedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimEvidence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		x.Type_ = ""
+	case "insurance.insurance.ClaimEvidence.description":
+		x.Description = ""
+	case "insurance.insurance.ClaimEvidence.hash":
+		x.Hash = ""
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		x.IpfsLink = ""
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		x.SubmittedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClaimEvidence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		value := x.Type_
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.ClaimEvidence.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.ClaimEvidence.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		value := x.IpfsLink
+		return protoreflect.ValueOfString(value)
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		value := x.SubmittedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimEvidence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		x.Type_ = value.Interface().(string)
+	case "insurance.insurance.ClaimEvidence.description":
+		x.Description = value.Interface().(string)
+	case "insurance.insurance.ClaimEvidence.hash":
+		x.Hash = value.Interface().(string)
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		x.IpfsLink = value.Interface().(string)
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		x.SubmittedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimEvidence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		panic(fmt.Errorf("field type of message insurance.insurance.ClaimEvidence is not mutable"))
+	case "insurance.insurance.ClaimEvidence.description":
+		panic(fmt.Errorf("field description of message insurance.insurance.ClaimEvidence is not mutable"))
+	case "insurance.insurance.ClaimEvidence.hash":
+		panic(fmt.Errorf("field hash of message insurance.insurance.ClaimEvidence is not mutable"))
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		panic(fmt.Errorf("field ipfs_link of message insurance.insurance.ClaimEvidence is not mutable"))
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		panic(fmt.Errorf("field submitted_at of message insurance.insurance.ClaimEvidence is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClaimEvidence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "insurance.insurance.ClaimEvidence.type":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.ClaimEvidence.description":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.ClaimEvidence.hash":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.ClaimEvidence.ipfs_link":
+		return protoreflect.ValueOfString("")
+	case "insurance.insurance.ClaimEvidence.submitted_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: insurance.insurance.ClaimEvidence"))
+		}
+		panic(fmt.Errorf("message insurance.insurance.ClaimEvidence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClaimEvidence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in insurance.insurance.ClaimEvidence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClaimEvidence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimEvidence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClaimEvidence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClaimEvidence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClaimEvidence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Type_)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IpfsLink)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmittedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.SubmittedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimEvidence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SubmittedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SubmittedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.IpfsLink) > 0 {
+			i -= len(x.IpfsLink)
+			copy(dAtA[i:], x.IpfsLink)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IpfsLink)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Type_) > 0 {
+			i -= len(x.Type_)
+			copy(dAtA[i:], x.Type_)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Type_)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimEvidence)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimEvidence: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Type_ = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IpfsLink = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+				}
+				x.SubmittedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SubmittedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        v3.21.0
+// source: insurance/insurance/claim.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Claim is a claim filed against a policy. It is FILED, then UNDER_REVIEW by
+// an adjuster who may request evidence from the claimant, and is APPROVED or
+// DENIED. A denied claim may be APPEALED once. Approved claims are PAID from
+// the policy escrow or through the finance chain. The decisions on a claim
+// submitted by another chain are sent back to it. A filed claim whose fraud
+// score reaches the review threshold is FLAGGED instead, and an appeal
+// adjuster reviews it manually.
+type Claim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClaimId     string           `protobuf:"bytes,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+	PolicyId    string           `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Claimant    string           `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	ClaimType   string           `protobuf:"bytes,4,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
+	Amount      string           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description string           `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Evidence    []*ClaimEvidence `protobuf:"bytes,7,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Status      string           `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// adjuster is the adjuster reviewing the claim.
+	Adjuster string `protobuf:"bytes,9,opt,name=adjuster,proto3" json:"adjuster,omitempty"`
+	// evidence_request describes the evidence requested from the claimant.
+	EvidenceRequest string `protobuf:"bytes,10,opt,name=evidence_request,json=evidenceRequest,proto3" json:"evidence_request,omitempty"`
+	DecisionReason  string `protobuf:"bytes,11,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	Appealed        bool   `protobuf:"varint,12,opt,name=appealed,proto3" json:"appealed,omitempty"`
+	AppealReason    string `protobuf:"bytes,13,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	// deadline is when the requested evidence is due, or when the appeal
+	// period of a denied claim ends.
+	Deadline int64 `protobuf:"varint,14,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// payment is the payment message sent to the finance chain when the claim
+	// is paid through it.
+	Payment   []byte `protobuf:"bytes,15,opt,name=payment,proto3" json:"payment,omitempty"`
+	FiledAt   int64  `protobuf:"varint,16,opt,name=filed_at,json=filedAt,proto3" json:"filed_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// source_chain is the chain the claim was submitted from, empty for the
+	// claims filed on the insurance chain.
+	SourceChain string `protobuf:"bytes,18,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	// source_id identifies the claim on its source chain.
+	SourceId string `protobuf:"bytes,19,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// decision is the last decision message prepared for the source chain.
+	Decision []byte `protobuf:"bytes,20,opt,name=decision,proto3" json:"decision,omitempty"`
+	// fraud_score is the sum of the weights of the fraud signals of the claim.
+	FraudScore   uint64         `protobuf:"varint,21,opt,name=fraud_score,json=fraudScore,proto3" json:"fraud_score,omitempty"`
+	FraudSignals []*FraudSignal `protobuf:"bytes,22,rep,name=fraud_signals,json=fraudSignals,proto3" json:"fraud_signals,omitempty"`
+	// corroboration is PENDING while the chain of record of the policy type
+	// is queried for a record corroborating the claim, then CORROBORATED or
+	// UNCORROBORATED. It is empty for the claims that need no corroboration,
+	// like those submitted by the chain of record.
+	Corroboration string `protobuf:"bytes,23,opt,name=corroboration,proto3" json:"corroboration,omitempty"`
+	// corroboration_query is the query sent to the chain of record.
+	CorroborationQuery []byte `protobuf:"bytes,24,opt,name=corroboration_query,json=corroborationQuery,proto3" json:"corroboration_query,omitempty"`
+	// payout_attempts counts the payments sent to the finance chain for the
+	// claim. A payout that fails takes the claim back to adjudication, and its
+	// next payment gets a new transaction ID.
+	PayoutAttempts uint64 `protobuf:"varint,25,opt,name=payout_attempts,json=payoutAttempts,proto3" json:"payout_attempts,omitempty"`
+}
+
+func (x *Claim) Reset() {
+	*x = Claim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insurance_insurance_claim_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Claim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Claim) ProtoMessage() {}
+
+// Deprecated: Use Claim.ProtoReflect.Descriptor instead.
+func (*Claim) Descriptor() ([]byte, []int) {
+	return file_insurance_insurance_claim_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Claim) GetClaimId() string {
+	if x != nil {
+		return x.ClaimId
+	}
+	return ""
+}
+
+func (x *Claim) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *Claim) GetClaimant() string {
+	if x != nil {
+		return x.Claimant
+	}
+	return ""
+}
+
+func (x *Claim) GetClaimType() string {
+	if x != nil {
+		return x.ClaimType
+	}
+	return ""
+}
+
+func (x *Claim) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Claim) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Claim) GetEvidence() []*ClaimEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *Claim) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Claim) GetAdjuster() string {
+	if x != nil {
+		return x.Adjuster
+	}
+	return ""
+}
+
+func (x *Claim) GetEvidenceRequest() string {
+	if x != nil {
+		return x.EvidenceRequest
+	}
+	return ""
+}
+
+func (x *Claim) GetDecisionReason() string {
+	if x != nil {
+		return x.DecisionReason
+	}
+	return ""
+}
+
+func (x *Claim) GetAppealed() bool {
+	if x != nil {
+		return x.Appealed
+	}
+	return false
+}
+
+func (x *Claim) GetAppealReason() string {
+	if x != nil {
+		return x.AppealReason
+	}
+	return ""
+}
+
+func (x *Claim) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *Claim) GetPayment() []byte {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Claim) GetFiledAt() int64 {
+	if x != nil {
+		return x.FiledAt
+	}
+	return 0
+}
+
+func (x *Claim) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Claim) GetSourceChain() string {
+	if x != nil {
+		return x.SourceChain
+	}
+	return ""
+}
+
+func (x *Claim) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *Claim) GetDecision() []byte {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+func (x *Claim) GetFraudScore() uint64 {
+	if x != nil {
+		return x.FraudScore
+	}
+	return 0
+}
+
+func (x *Claim) GetFraudSignals() []*FraudSignal {
+	if x != nil {
+		return x.FraudSignals
+	}
+	return nil
+}
+
+func (x *Claim) GetCorroboration() string {
+	if x != nil {
+		return x.Corroboration
+	}
+	return ""
+}
+
+func (x *Claim) GetCorroborationQuery() []byte {
+	if x != nil {
+		return x.CorroborationQuery
+	}
+	return nil
+}
+
+func (x *Claim) GetPayoutAttempts() uint64 {
+	if x != nil {
+		return x.PayoutAttempts
+	}
+	return 0
+}
+
+// FraudSignal is a sign of fraud detected on a claim
+type FraudSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is reused_evidence, claim_velocity or missing_record.
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Detail string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	Weight uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// related_claim_id is the other claim the signal involves, if any.
+	RelatedClaimId string `protobuf:"bytes,4,opt,name=related_claim_id,json=relatedClaimId,proto3" json:"related_claim_id,omitempty"`
+	DetectedAt     int64  `protobuf:"varint,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (x *FraudSignal) Reset() {
+	*x = FraudSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insurance_insurance_claim_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FraudSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudSignal) ProtoMessage() {}
+
+// Deprecated: Use FraudSignal.ProtoReflect.Descriptor instead.
+func (*FraudSignal) Descriptor() ([]byte, []int) {
+	return file_insurance_insurance_claim_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FraudSignal) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FraudSignal) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *FraudSignal) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *FraudSignal) GetRelatedClaimId() string {
+	if x != nil {
+		return x.RelatedClaimId
+	}
+	return ""
+}
+
+func (x *FraudSignal) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+// ClaimEvidence is a document supporting a claim
+type ClaimEvidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type_       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	IpfsLink    string `protobuf:"bytes,4,opt,name=ipfs_link,json=ipfsLink,proto3" json:"ipfs_link,omitempty"`
+	SubmittedAt int64  `protobuf:"varint,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (x *ClaimEvidence) Reset() {
+	*x = ClaimEvidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insurance_insurance_claim_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimEvidence) ProtoMessage() {}
+
+// Deprecated: Use ClaimEvidence.ProtoReflect.Descriptor instead.
+func (*ClaimEvidence) Descriptor() ([]byte, []int) {
+	return file_insurance_insurance_claim_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimEvidence) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *ClaimEvidence) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ClaimEvidence) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ClaimEvidence) GetIpfsLink() string {
+	if x != nil {
+		return x.IpfsLink
+	}
+	return ""
+}
+
+func (x *ClaimEvidence) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+var File_insurance_insurance_claim_proto protoreflect.FileDescriptor
+
+var file_insurance_insurance_claim_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x73, 0x75,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x73,
+	0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d,
+	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x07, 0x0a, 0x05, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x10, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x72, 0x61, 0x75, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x4b, 0x0a, 0x0d, 0x66, 0x72, 0x61, 0x75, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x46, 0x72, 0x61,
+	0x75, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
+	0x66, 0x72, 0x61, 0x75, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x72, 0x72, 0x6f, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x6f, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x72, 0x72, 0x6f, 0x62, 0x6f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x63, 0x6f, 0x72, 0x72, 0x6f, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x9c, 0x01, 0x0a,
+	0x0b, 0x46, 0x72, 0x61, 0x75, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0d,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x66, 0x73, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x23, 0x5a, 0x21, 0x69, 0x6e, 0x73, 0x75, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_insurance_insurance_claim_proto_rawDescOnce sync.Once
+	file_insurance_insurance_claim_proto_rawDescData = file_insurance_insurance_claim_proto_rawDesc
+)
+
+func file_insurance_insurance_claim_proto_rawDescGZIP() []byte {
+	file_insurance_insurance_claim_proto_rawDescOnce.Do(func() {
+		file_insurance_insurance_claim_proto_rawDescData = protoimpl.X.CompressGZIP(file_insurance_insurance_claim_proto_rawDescData)
+	})
+	return file_insurance_insurance_claim_proto_rawDescData
+}
+
+var file_insurance_insurance_claim_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_insurance_insurance_claim_proto_goTypes = []interface{}{
+	(*Claim)(nil),         // 0: insurance.insurance.Claim
+	(*FraudSignal)(nil),   // 1: insurance.insurance.FraudSignal
+	(*ClaimEvidence)(nil), // 2: insurance.insurance.ClaimEvidence
+}
+var file_insurance_insurance_claim_proto_depIdxs = []int32{
+	2, // 0: insurance.insurance.Claim.evidence:type_name -> insurance.insurance.ClaimEvidence
+	1, // 1: insurance.insurance.Claim.fraud_signals:type_name -> insurance.insurance.FraudSignal
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_insurance_insurance_claim_proto_init() }
+func file_insurance_insurance_claim_proto_init() {
+	if File_insurance_insurance_claim_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_insurance_insurance_claim_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Claim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insurance_insurance_claim_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FraudSignal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insurance_insurance_claim_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_insurance_insurance_claim_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_insurance_insurance_claim_proto_goTypes,
+		DependencyIndexes: file_insurance_insurance_claim_proto_depIdxs,
+		MessageInfos:      file_insurance_insurance_claim_proto_msgTypes,
+	}.Build()
+	File_insurance_insurance_claim_proto = out.File
+	file_insurance_insurance_claim_proto_rawDesc = nil
+	file_insurance_insurance_claim_proto_goTypes = nil
+	file_insurance_insurance_claim_proto_depIdxs = nil
+}
@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: insurance/insurance/query.proto
+
+package insurance
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName             = "/insurance.insurance.Query/Params"
+	Query_Transaction_FullMethodName        = "/insurance.insurance.Query/Transaction"
+	Query_TransactionAll_FullMethodName     = "/insurance.insurance.Query/TransactionAll"
+	Query_ContractRecord_FullMethodName     = "/insurance.insurance.Query/ContractRecord"
+	Query_AuditTrail_FullMethodName         = "/insurance.insurance.Query/AuditTrail"
+	Query_Claim_FullMethodName              = "/insurance.insurance.Query/Claim"
+	Query_ClaimAll_FullMethodName           = "/insurance.insurance.Query/ClaimAll"
+	Query_ClaimsByPolicy_FullMethodName     = "/insurance.insurance.Query/ClaimsByPolicy"
+	Query_FlaggedClaims_FullMethodName      = "/insurance.insurance.Query/FlaggedClaims"
+	Query_RatingModel_FullMethodName        = "/insurance.insurance.Query/RatingModel"
+	Query_RatingModelAll_FullMethodName     = "/insurance.insurance.Query/RatingModelAll"
+	Query_QuotePremium_FullMethodName       = "/insurance.insurance.Query/QuotePremium"
+	Query_OracleReporter_FullMethodName     = "/insurance.insurance.Query/OracleReporter"
+	Query_OracleReporterAll_FullMethodName  = "/insurance.insurance.Query/OracleReporterAll"
+	Query_MetricObservations_FullMethodName = "/insurance.insurance.Query/MetricObservations"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error)
+	TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error)
+	// Queries a list of Claim items.
+	Claim(ctx context.Context, in *QueryGetClaimRequest, opts ...grpc.CallOption) (*QueryGetClaimResponse, error)
+	ClaimAll(ctx context.Context, in *QueryAllClaimRequest, opts ...grpc.CallOption) (*QueryAllClaimResponse, error)
+	// Queries the claims filed against a policy.
+	ClaimsByPolicy(ctx context.Context, in *QueryClaimsByPolicyRequest, opts ...grpc.CallOption) (*QueryClaimsByPolicyResponse, error)
+	// Queries the claims flagged for manual review.
+	FlaggedClaims(ctx context.Context, in *QueryFlaggedClaimsRequest, opts ...grpc.CallOption) (*QueryFlaggedClaimsResponse, error)
+	// Queries a version of the rating model, the latest one by default.
+	RatingModel(ctx context.Context, in *QueryGetRatingModelRequest, opts ...grpc.CallOption) (*QueryGetRatingModelResponse, error)
+	RatingModelAll(ctx context.Context, in *QueryAllRatingModelRequest, opts ...grpc.CallOption) (*QueryAllRatingModelResponse, error)
+	// Prices a policy type for a set of risk factors, with the latest rating
+	// model by default.
+	QuotePremium(ctx context.Context, in *QueryQuotePremiumRequest, opts ...grpc.CallOption) (*QueryQuotePremiumResponse, error)
+	// Queries the oracle reporters and their stake.
+	OracleReporter(ctx context.Context, in *QueryGetOracleReporterRequest, opts ...grpc.CallOption) (*QueryGetOracleReporterResponse, error)
+	OracleReporterAll(ctx context.Context, in *QueryAllOracleReporterRequest, opts ...grpc.CallOption) (*QueryAllOracleReporterResponse, error)
+	// Queries the observations of a metric, and its settled value once it
+	// settled.
+	MetricObservations(ctx context.Context, in *QueryMetricObservationsRequest, opts ...grpc.CallOption) (*QueryMetricObservationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Transaction(ctx context.Context, in *QueryGetTransactionRequest, opts ...grpc.CallOption) (*QueryGetTransactionResponse, error) {
+	out := new(QueryGetTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_Transaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransactionAll(ctx context.Context, in *QueryAllTransactionRequest, opts ...grpc.CallOption) (*QueryAllTransactionResponse, error) {
+	out := new(QueryAllTransactionResponse)
+	err := c.cc.Invoke(ctx, Query_TransactionAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRecord(ctx context.Context, in *QueryGetContractRecordRequest, opts ...grpc.CallOption) (*QueryGetContractRecordResponse, error) {
+	out := new(QueryGetContractRecordResponse)
+	err := c.cc.Invoke(ctx, Query_ContractRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditTrail(ctx context.Context, in *QueryAuditTrailRequest, opts ...grpc.CallOption) (*QueryAuditTrailResponse, error) {
+	out := new(QueryAuditTrailResponse)
+	err := c.cc.Invoke(ctx, Query_AuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Claim(ctx context.Context, in *QueryGetClaimRequest, opts ...grpc.CallOption) (*QueryGetClaimResponse, error) {
+	out := new(QueryGetClaimResponse)
+	err := c.cc.Invoke(ctx, Query_Claim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimAll(ctx context.Context, in *QueryAllClaimRequest, opts ...grpc.CallOption) (*QueryAllClaimResponse, error) {
+	out := new(QueryAllClaimResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimsByPolicy(ctx context.Context, in *QueryClaimsByPolicyRequest, opts ...grpc.CallOption) (*QueryClaimsByPolicyResponse, error) {
+	out := new(QueryClaimsByPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimsByPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlaggedClaims(ctx context.Context, in *QueryFlaggedClaimsRequest, opts ...grpc.CallOption) (*QueryFlaggedClaimsResponse, error) {
+	out := new(QueryFlaggedClaimsResponse)
+	err := c.cc.Invoke(ctx, Query_FlaggedClaims_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RatingModel(ctx context.Context, in *QueryGetRatingModelRequest, opts ...grpc.CallOption) (*QueryGetRatingModelResponse, error) {
+	out := new(QueryGetRatingModelResponse)
+	err := c.cc.Invoke(ctx, Query_RatingModel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RatingModelAll(ctx context.Context, in *QueryAllRatingModelRequest, opts ...grpc.CallOption) (*QueryAllRatingModelResponse, error) {
+	out := new(QueryAllRatingModelResponse)
+	err := c.cc.Invoke(ctx, Query_RatingModelAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuotePremium(ctx context.Context, in *QueryQuotePremiumRequest, opts ...grpc.CallOption) (*QueryQuotePremiumResponse, error) {
+	out := new(QueryQuotePremiumResponse)
+	err := c.cc.Invoke(ctx, Query_QuotePremium_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleReporter(ctx context.Context, in *QueryGetOracleReporterRequest, opts ...grpc.CallOption) (*QueryGetOracleReporterResponse, error) {
+	out := new(QueryGetOracleReporterResponse)
+	err := c.cc.Invoke(ctx, Query_OracleReporter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleReporterAll(ctx context.Context, in *QueryAllOracleReporterRequest, opts ...grpc.CallOption) (*QueryAllOracleReporterResponse, error) {
+	out := new(QueryAllOracleReporterResponse)
+	err := c.cc.Invoke(ctx, Query_OracleReporterAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MetricObservations(ctx context.Context, in *QueryMetricObservationsRequest, opts ...grpc.CallOption) (*QueryMetricObservationsResponse, error) {
+	out := new(QueryMetricObservationsResponse)
+	err := c.cc.Invoke(ctx, Query_MetricObservations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Transaction items.
+	Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error)
+	TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error)
+	// Queries a ContractRecord by kind and id.
+	ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error)
+	// Queries the audit trail of a subject.
+	AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error)
+	// Queries a list of Claim items.
+	Claim(context.Context, *QueryGetClaimRequest) (*QueryGetClaimResponse, error)
+	ClaimAll(context.Context, *QueryAllClaimRequest) (*QueryAllClaimResponse, error)
+	// Queries the claims filed against a policy.
+	ClaimsByPolicy(context.Context, *QueryClaimsByPolicyRequest) (*QueryClaimsByPolicyResponse, error)
+	// Queries the claims flagged for manual review.
+	FlaggedClaims(context.Context, *QueryFlaggedClaimsRequest) (*QueryFlaggedClaimsResponse, error)
+	// Queries a version of the rating model, the latest one by default.
+	RatingModel(context.Context, *QueryGetRatingModelRequest) (*QueryGetRatingModelResponse, error)
+	RatingModelAll(context.Context, *QueryAllRatingModelRequest) (*QueryAllRatingModelResponse, error)
+	// Prices a policy type for a set of risk factors, with the latest rating
+	// model by default.
+	QuotePremium(context.Context, *QueryQuotePremiumRequest) (*QueryQuotePremiumResponse, error)
+	// Queries the oracle reporters and their stake.
+	OracleReporter(context.Context, *QueryGetOracleReporterRequest) (*QueryGetOracleReporterResponse, error)
+	OracleReporterAll(context.Context, *QueryAllOracleReporterRequest) (*QueryAllOracleReporterResponse, error)
+	// Queries the observations of a metric, and its settled value once it
+	// settled.
+	MetricObservations(context.Context, *QueryMetricObservationsRequest) (*QueryMetricObservationsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Transaction(context.Context, *QueryGetTransactionRequest) (*QueryGetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedQueryServer) TransactionAll(context.Context, *QueryAllTransactionRequest) (*QueryAllTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionAll not implemented")
+}
+func (UnimplementedQueryServer) ContractRecord(context.Context, *QueryGetContractRecordRequest) (*QueryGetContractRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRecord not implemented")
+}
+func (UnimplementedQueryServer) AuditTrail(context.Context, *QueryAuditTrailRequest) (*QueryAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditTrail not implemented")
+}
+func (UnimplementedQueryServer) Claim(context.Context, *QueryGetClaimRequest) (*QueryGetClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (UnimplementedQueryServer) ClaimAll(context.Context, *QueryAllClaimRequest) (*QueryAllClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAll not implemented")
+}
+func (UnimplementedQueryServer) ClaimsByPolicy(context.Context, *QueryClaimsByPolicyRequest) (*QueryClaimsByPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimsByPolicy not implemented")
+}
+func (UnimplementedQueryServer) FlaggedClaims(context.Context, *QueryFlaggedClaimsRequest) (*QueryFlaggedClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlaggedClaims not implemented")
+}
+func (UnimplementedQueryServer) RatingModel(context.Context, *QueryGetRatingModelRequest) (*QueryGetRatingModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatingModel not implemented")
+}
+func (UnimplementedQueryServer) RatingModelAll(context.Context, *QueryAllRatingModelRequest) (*QueryAllRatingModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatingModelAll not implemented")
+}
+func (UnimplementedQueryServer) QuotePremium(context.Context, *QueryQuotePremiumRequest) (*QueryQuotePremiumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePremium not implemented")
+}
+func (UnimplementedQueryServer) OracleReporter(context.Context, *QueryGetOracleReporterRequest) (*QueryGetOracleReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleReporter not implemented")
+}
+func (UnimplementedQueryServer) OracleReporterAll(context.Context, *QueryAllOracleReporterRequest) (*QueryAllOracleReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleReporterAll not implemented")
+}
+func (UnimplementedQueryServer) MetricObservations(context.Context, *QueryMetricObservationsRequest) (*QueryMetricObservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricObservations not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Transaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Transaction(ctx, req.(*QueryGetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransactionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransactionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransactionAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransactionAll(ctx, req.(*QueryAllTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContractRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRecord(ctx, req.(*QueryGetContractRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditTrailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditTrail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditTrail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditTrail(ctx, req.(*QueryAuditTrailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Claim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claim(ctx, req.(*QueryGetClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimAll(ctx, req.(*QueryAllClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimsByPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimsByPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimsByPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimsByPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimsByPolicy(ctx, req.(*QueryClaimsByPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlaggedClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlaggedClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlaggedClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FlaggedClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlaggedClaims(ctx, req.(*QueryFlaggedClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RatingModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRatingModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RatingModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RatingModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RatingModel(ctx, req.(*QueryGetRatingModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RatingModelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRatingModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RatingModelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RatingModelAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RatingModelAll(ctx, req.(*QueryAllRatingModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuotePremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuotePremiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuotePremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuotePremium_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuotePremium(ctx, req.(*QueryQuotePremiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOracleReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleReporter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleReporter(ctx, req.(*QueryGetOracleReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleReporterAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOracleReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleReporterAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleReporterAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleReporterAll(ctx, req.(*QueryAllOracleReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MetricObservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetricObservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MetricObservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MetricObservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MetricObservations(ctx, req.(*QueryMetricObservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "insurance.insurance.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _Query_Transaction_Handler,
+		},
+		{
+			MethodName: "TransactionAll",
+			Handler:    _Query_TransactionAll_Handler,
+		},
+		{
+			MethodName: "ContractRecord",
+			Handler:    _Query_ContractRecord_Handler,
+		},
+		{
+			MethodName: "AuditTrail",
+			Handler:    _Query_AuditTrail_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Query_Claim_Handler,
+		},
+		{
+			MethodName: "ClaimAll",
+			Handler:    _Query_ClaimAll_Handler,
+		},
+		{
+			MethodName: "ClaimsByPolicy",
+			Handler:    _Query_ClaimsByPolicy_Handler,
+		},
+		{
+			MethodName: "FlaggedClaims",
+			Handler:    _Query_FlaggedClaims_Handler,
+		},
+		{
+			MethodName: "RatingModel",
+			Handler:    _Query_RatingModel_Handler,
+		},
+		{
+			MethodName: "RatingModelAll",
+			Handler:    _Query_RatingModelAll_Handler,
+		},
+		{
+			MethodName: "QuotePremium",
+			Handler:    _Query_QuotePremium_Handler,
+		},
+		{
+			MethodName: "OracleReporter",
+			Handler:    _Query_OracleReporter_Handler,
+		},
+		{
+			MethodName: "OracleReporterAll",
+			Handler:    _Query_OracleReporterAll_Handler,
+		},
+		{
+			MethodName: "MetricObservations",
+			Handler:    _Query_MetricObservations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "insurance/insurance/query.proto",
+}
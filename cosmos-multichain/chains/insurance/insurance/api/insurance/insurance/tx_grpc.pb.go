@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: insurance/insurance/tx.proto
+
+package insurance
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateParams_FullMethodName            = "/insurance.insurance.Msg/UpdateParams"
+	Msg_InitiateTransaction_FullMethodName     = "/insurance.insurance.Msg/InitiateTransaction"
+	Msg_UpdateTransactionStatus_FullMethodName = "/insurance.insurance.Msg/UpdateTransactionStatus"
+	Msg_FinalizeTransaction_FullMethodName     = "/insurance.insurance.Msg/FinalizeTransaction"
+	Msg_ReviewClaim_FullMethodName             = "/insurance.insurance.Msg/ReviewClaim"
+	Msg_RequestEvidence_FullMethodName         = "/insurance.insurance.Msg/RequestEvidence"
+	Msg_DecideClaim_FullMethodName             = "/insurance.insurance.Msg/DecideClaim"
+	Msg_AppealClaim_FullMethodName             = "/insurance.insurance.Msg/AppealClaim"
+	Msg_UpdateRatingModel_FullMethodName       = "/insurance.insurance.Msg/UpdateRatingModel"
+	Msg_BondReporter_FullMethodName            = "/insurance.insurance.Msg/BondReporter"
+	Msg_UnbondReporter_FullMethodName          = "/insurance.insurance.Msg/UnbondReporter"
+	Msg_SubmitObservation_FullMethodName       = "/insurance.insurance.Msg/SubmitObservation"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	InitiateTransaction(ctx context.Context, in *MsgInitiateTransaction, opts ...grpc.CallOption) (*MsgInitiateTransactionResponse, error)
+	UpdateTransactionStatus(ctx context.Context, in *MsgUpdateTransactionStatus, opts ...grpc.CallOption) (*MsgUpdateTransactionStatusResponse, error)
+	FinalizeTransaction(ctx context.Context, in *MsgFinalizeTransaction, opts ...grpc.CallOption) (*MsgFinalizeTransactionResponse, error)
+	ReviewClaim(ctx context.Context, in *MsgReviewClaim, opts ...grpc.CallOption) (*MsgReviewClaimResponse, error)
+	RequestEvidence(ctx context.Context, in *MsgRequestEvidence, opts ...grpc.CallOption) (*MsgRequestEvidenceResponse, error)
+	DecideClaim(ctx context.Context, in *MsgDecideClaim, opts ...grpc.CallOption) (*MsgDecideClaimResponse, error)
+	AppealClaim(ctx context.Context, in *MsgAppealClaim, opts ...grpc.CallOption) (*MsgAppealClaimResponse, error)
+	// UpdateRatingModel defines a (governance) operation for adding the next
+	// version of the rating model.
+	UpdateRatingModel(ctx context.Context, in *MsgUpdateRatingModel, opts ...grpc.CallOption) (*MsgUpdateRatingModelResponse, error)
+	BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error)
+	UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error)
+	SubmitObservation(ctx context.Context, in *MsgSubmitObservation, opts ...grpc.CallOption) (*MsgSubmitObservationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateTransaction(ctx context.Context, in *MsgInitiateTransaction, opts ...grpc.CallOption) (*MsgInitiateTransactionResponse, error) {
+	out := new(MsgInitiateTransactionResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTransactionStatus(ctx context.Context, in *MsgUpdateTransactionStatus, opts ...grpc.CallOption) (*MsgUpdateTransactionStatusResponse, error) {
+	out := new(MsgUpdateTransactionStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTransactionStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeTransaction(ctx context.Context, in *MsgFinalizeTransaction, opts ...grpc.CallOption) (*MsgFinalizeTransactionResponse, error) {
+	out := new(MsgFinalizeTransactionResponse)
+	err := c.cc.Invoke(ctx, Msg_FinalizeTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReviewClaim(ctx context.Context, in *MsgReviewClaim, opts ...grpc.CallOption) (*MsgReviewClaimResponse, error) {
+	out := new(MsgReviewClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_ReviewClaim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestEvidence(ctx context.Context, in *MsgRequestEvidence, opts ...grpc.CallOption) (*MsgRequestEvidenceResponse, error) {
+	out := new(MsgRequestEvidenceResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DecideClaim(ctx context.Context, in *MsgDecideClaim, opts ...grpc.CallOption) (*MsgDecideClaimResponse, error) {
+	out := new(MsgDecideClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_DecideClaim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppealClaim(ctx context.Context, in *MsgAppealClaim, opts ...grpc.CallOption) (*MsgAppealClaimResponse, error) {
+	out := new(MsgAppealClaimResponse)
+	err := c.cc.Invoke(ctx, Msg_AppealClaim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRatingModel(ctx context.Context, in *MsgUpdateRatingModel, opts ...grpc.CallOption) (*MsgUpdateRatingModelResponse, error) {
+	out := new(MsgUpdateRatingModelResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRatingModel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BondReporter(ctx context.Context, in *MsgBondReporter, opts ...grpc.CallOption) (*MsgBondReporterResponse, error) {
+	out := new(MsgBondReporterResponse)
+	err := c.cc.Invoke(ctx, Msg_BondReporter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error) {
+	out := new(MsgUnbondReporterResponse)
+	err := c.cc.Invoke(ctx, Msg_UnbondReporter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitObservation(ctx context.Context, in *MsgSubmitObservation, opts ...grpc.CallOption) (*MsgSubmitObservationResponse, error) {
+	out := new(MsgSubmitObservationResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitObservation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	InitiateTransaction(context.Context, *MsgInitiateTransaction) (*MsgInitiateTransactionResponse, error)
+	UpdateTransactionStatus(context.Context, *MsgUpdateTransactionStatus) (*MsgUpdateTransactionStatusResponse, error)
+	FinalizeTransaction(context.Context, *MsgFinalizeTransaction) (*MsgFinalizeTransactionResponse, error)
+	ReviewClaim(context.Context, *MsgReviewClaim) (*MsgReviewClaimResponse, error)
+	RequestEvidence(context.Context, *MsgRequestEvidence) (*MsgRequestEvidenceResponse, error)
+	DecideClaim(context.Context, *MsgDecideClaim) (*MsgDecideClaimResponse, error)
+	AppealClaim(context.Context, *MsgAppealClaim) (*MsgAppealClaimResponse, error)
+	// UpdateRatingModel defines a (governance) operation for adding the next
+	// version of the rating model.
+	UpdateRatingModel(context.Context, *MsgUpdateRatingModel) (*MsgUpdateRatingModelResponse, error)
+	BondReporter(context.Context, *MsgBondReporter) (*MsgBondReporterResponse, error)
+	UnbondReporter(context.Context, *MsgUnbondReporter) (*MsgUnbondReporterResponse, error)
+	SubmitObservation(context.Context, *MsgSubmitObservation) (*MsgSubmitObservationResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) InitiateTransaction(context.Context, *MsgInitiateTransaction) (*MsgInitiateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateTransaction not implemented")
+}
+func (UnimplementedMsgServer) UpdateTransactionStatus(context.Context, *MsgUpdateTransactionStatus) (*MsgUpdateTransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransactionStatus not implemented")
+}
+func (UnimplementedMsgServer) FinalizeTransaction(context.Context, *MsgFinalizeTransaction) (*MsgFinalizeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeTransaction not implemented")
+}
+func (UnimplementedMsgServer) ReviewClaim(context.Context, *MsgReviewClaim) (*MsgReviewClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewClaim not implemented")
+}
+func (UnimplementedMsgServer) RequestEvidence(context.Context, *MsgRequestEvidence) (*MsgRequestEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEvidence not implemented")
+}
+func (UnimplementedMsgServer) DecideClaim(context.Context, *MsgDecideClaim) (*MsgDecideClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideClaim not implemented")
+}
+func (UnimplementedMsgServer) AppealClaim(context.Context, *MsgAppealClaim) (*MsgAppealClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealClaim not implemented")
+}
+func (UnimplementedMsgServer) UpdateRatingModel(context.Context, *MsgUpdateRatingModel) (*MsgUpdateRatingModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRatingModel not implemented")
+}
+func (UnimplementedMsgServer) BondReporter(context.Context, *MsgBondReporter) (*MsgBondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondReporter not implemented")
+}
+func (UnimplementedMsgServer) UnbondReporter(context.Context, *MsgUnbondReporter) (*MsgUnbondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondReporter not implemented")
+}
+func (UnimplementedMsgServer) SubmitObservation(context.Context, *MsgSubmitObservation) (*MsgSubmitObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitObservation not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateTransaction(ctx, req.(*MsgInitiateTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTransactionStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTransactionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTransactionStatus(ctx, req.(*MsgUpdateTransactionStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FinalizeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeTransaction(ctx, req.(*MsgFinalizeTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReviewClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewClaim(ctx, req.(*MsgReviewClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestEvidence(ctx, req.(*MsgRequestEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecideClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecideClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecideClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecideClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecideClaim(ctx, req.(*MsgDecideClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppealClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealClaim(ctx, req.(*MsgAppealClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRatingModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRatingModel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRatingModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRatingModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRatingModel(ctx, req.(*MsgUpdateRatingModel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BondReporter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondReporter(ctx, req.(*MsgBondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnbondReporter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondReporter(ctx, req.(*MsgUnbondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitObservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitObservation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitObservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitObservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitObservation(ctx, req.(*MsgSubmitObservation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "insurance.insurance.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "InitiateTransaction",
+			Handler:    _Msg_InitiateTransaction_Handler,
+		},
+		{
+			MethodName: "UpdateTransactionStatus",
+			Handler:    _Msg_UpdateTransactionStatus_Handler,
+		},
+		{
+			MethodName: "FinalizeTransaction",
+			Handler:    _Msg_FinalizeTransaction_Handler,
+		},
+		{
+			MethodName: "ReviewClaim",
+			Handler:    _Msg_ReviewClaim_Handler,
+		},
+		{
+			MethodName: "RequestEvidence",
+			Handler:    _Msg_RequestEvidence_Handler,
+		},
+		{
+			MethodName: "DecideClaim",
+			Handler:    _Msg_DecideClaim_Handler,
+		},
+		{
+			MethodName: "AppealClaim",
+			Handler:    _Msg_AppealClaim_Handler,
+		},
+		{
+			MethodName: "UpdateRatingModel",
+			Handler:    _Msg_UpdateRatingModel_Handler,
+		},
+		{
+			MethodName: "BondReporter",
+			Handler:    _Msg_BondReporter_Handler,
+		},
+		{
+			MethodName: "UnbondReporter",
+			Handler:    _Msg_UnbondReporter_Handler,
+		},
+		{
+			MethodName: "SubmitObservation",
+			Handler:    _Msg_SubmitObservation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "insurance/insurance/tx.proto",
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: insurance/policy/query.proto
+
+package policy
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName           = "/insurance.policy.Query/Params"
+	Query_Policy_FullMethodName           = "/insurance.policy.Query/Policy"
+	Query_PolicyAll_FullMethodName        = "/insurance.policy.Query/PolicyAll"
+	Query_PoliciesByHolder_FullMethodName = "/insurance.policy.Query/PoliciesByHolder"
+	Query_PoliciesByType_FullMethodName   = "/insurance.policy.Query/PoliciesByType"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Policy items.
+	Policy(ctx context.Context, in *QueryGetPolicyRequest, opts ...grpc.CallOption) (*QueryGetPolicyResponse, error)
+	PolicyAll(ctx context.Context, in *QueryAllPolicyRequest, opts ...grpc.CallOption) (*QueryAllPolicyResponse, error)
+	// Queries the policies of a holder.
+	PoliciesByHolder(ctx context.Context, in *QueryPoliciesByHolderRequest, opts ...grpc.CallOption) (*QueryPoliciesByHolderResponse, error)
+	// Queries the policies of a type.
+	PoliciesByType(ctx context.Context, in *QueryPoliciesByTypeRequest, opts ...grpc.CallOption) (*QueryPoliciesByTypeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Policy(ctx context.Context, in *QueryGetPolicyRequest, opts ...grpc.CallOption) (*QueryGetPolicyResponse, error) {
+	out := new(QueryGetPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_Policy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PolicyAll(ctx context.Context, in *QueryAllPolicyRequest, opts ...grpc.CallOption) (*QueryAllPolicyResponse, error) {
+	out := new(QueryAllPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_PolicyAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoliciesByHolder(ctx context.Context, in *QueryPoliciesByHolderRequest, opts ...grpc.CallOption) (*QueryPoliciesByHolderResponse, error) {
+	out := new(QueryPoliciesByHolderResponse)
+	err := c.cc.Invoke(ctx, Query_PoliciesByHolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoliciesByType(ctx context.Context, in *QueryPoliciesByTypeRequest, opts ...grpc.CallOption) (*QueryPoliciesByTypeResponse, error) {
+	out := new(QueryPoliciesByTypeResponse)
+	err := c.cc.Invoke(ctx, Query_PoliciesByType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Policy items.
+	Policy(context.Context, *QueryGetPolicyRequest) (*QueryGetPolicyResponse, error)
+	PolicyAll(context.Context, *QueryAllPolicyRequest) (*QueryAllPolicyResponse, error)
+	// Queries the policies of a holder.
+	PoliciesByHolder(context.Context, *QueryPoliciesByHolderRequest) (*QueryPoliciesByHolderResponse, error)
+	// Queries the policies of a type.
+	PoliciesByType(context.Context, *QueryPoliciesByTypeRequest) (*QueryPoliciesByTypeResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Policy(context.Context, *QueryGetPolicyRequest) (*QueryGetPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Policy not implemented")
+}
+func (UnimplementedQueryServer) PolicyAll(context.Context, *QueryAllPolicyRequest) (*QueryAllPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyAll not implemented")
+}
+func (UnimplementedQueryServer) PoliciesByHolder(context.Context, *QueryPoliciesByHolderRequest) (*QueryPoliciesByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoliciesByHolder not implemented")
+}
+func (UnimplementedQueryServer) PoliciesByType(context.Context, *QueryPoliciesByTypeRequest) (*QueryPoliciesByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoliciesByType not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Policy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Policy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Policy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Policy(ctx, req.(*QueryGetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PolicyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PolicyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PolicyAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PolicyAll(ctx, req.(*QueryAllPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoliciesByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoliciesByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoliciesByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoliciesByHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoliciesByHolder(ctx, req.(*QueryPoliciesByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoliciesByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoliciesByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoliciesByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoliciesByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoliciesByType(ctx, req.(*QueryPoliciesByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "insurance.policy.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Policy",
+			Handler:    _Query_Policy_Handler,
+		},
+		{
+			MethodName: "PolicyAll",
+			Handler:    _Query_PolicyAll_Handler,
+		},
+		{
+			MethodName: "PoliciesByHolder",
+			Handler:    _Query_PoliciesByHolder_Handler,
+		},
+		{
+			MethodName: "PoliciesByType",
+			Handler:    _Query_PoliciesByType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "insurance/policy/query.proto",
+}
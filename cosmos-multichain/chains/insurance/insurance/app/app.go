@@ -75,6 +75,8 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	insurancemodulekeeper "insurance/x/insurance/keeper"
+	policymodulekeeper "insurance/x/policy/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"insurance/docs"
@@ -140,6 +142,8 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	InsuranceKeeper insurancemodulekeeper.Keeper
+	PolicyKeeper    policymodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -243,6 +247,8 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.InsuranceKeeper,
+		&app.PolicyKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
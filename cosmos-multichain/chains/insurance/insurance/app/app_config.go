@@ -52,6 +52,12 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"google.golang.org/protobuf/types/known/durationpb"
+	insurancemodulev1 "insurance/api/insurance/insurance/module"
+	policymodulev1 "insurance/api/insurance/policy/module"
+	_ "insurance/x/insurance/module" // import for side-effects
+	insurancemoduletypes "insurance/x/insurance/types"
+	_ "insurance/x/policy/module" // import for side-effects
+	policymoduletypes "insurance/x/policy/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -89,6 +95,8 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		insurancemoduletypes.ModuleName,
+		policymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +121,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		insurancemoduletypes.ModuleName,
+		policymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +141,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		insurancemoduletypes.ModuleName,
+		policymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -151,6 +163,8 @@ var (
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: ibcfeetypes.ModuleName},
 		{Account: icatypes.ModuleName},
+		{Account: policymoduletypes.ModuleName},
+		{Account: insurancemoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -162,6 +176,8 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		policymoduletypes.ModuleName,
+		insurancemoduletypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -286,6 +302,14 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   insurancemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&insurancemodulev1.Module{}),
+			},
+			{
+				Name:   policymoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&policymodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
@@ -35,6 +35,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -162,6 +163,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostModule)
 
+	ibcRouter.AddRoute(interchain.PortID, interchain.NewIBCModule(app.InsuranceKeeper.Port(), app.InsuranceKeeper))
+
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
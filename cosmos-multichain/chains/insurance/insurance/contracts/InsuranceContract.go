@@ -2,41 +2,76 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/insurance/contracts/interfaces"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts/interfaces"
+)
+
+// MessageTypeClaimPayout is the interchain message type of the claim payouts
+// paid through the finance chain
+const MessageTypeClaimPayout = "claim_payout"
+
+// Claim statuses. A claim is FILED, or FLAGGED for manual review when it
+// signals fraud, then UNDER_REVIEW, possibly EVIDENCE_REQUESTED, then
+// APPROVED or DENIED. A denied claim may be APPEALED once, and approved
+// claims end PAID.
+const (
+	ClaimStatusFiled             = "FILED"
+	ClaimStatusFlagged           = "FLAGGED"
+	ClaimStatusUnderReview       = "UNDER_REVIEW"
+	ClaimStatusEvidenceRequested = "EVIDENCE_REQUESTED"
+	ClaimStatusApproved          = "APPROVED"
+	ClaimStatusDenied            = "DENIED"
+	ClaimStatusAppealed          = "APPEALED"
+	ClaimStatusPaid              = "PAID"
+)
+
+// Statuses of a payment on the finance chain
+const (
+	PaymentStatusFinalized = "FINALIZED"
+	PaymentStatusHeld      = "HELD"
+	PaymentStatusRejected  = "REJECTED"
 )
 
 // Policy represents an insurance policy
 type Policy struct {
-	PolicyID      string            `json:"policy_id"`
-	HolderID      string            `json:"holder_id"`
-	Type          string            `json:"type"` // Health, Life, Property, etc.
-	Coverage      sdk.Int           `json:"coverage"`
-	Premium       sdk.Int           `json:"premium"`
-	Status        string            `json:"status"`
-	StartDate     time.Time         `json:"start_date"`
-	EndDate       time.Time         `json:"end_date"`
-	RiskLevel     string            `json:"risk_level"`
-	Terms         map[string]string `json:"terms"`
-	Documents     []Document        `json:"documents"`
-	LastModified  time.Time         `json:"last_modified"`
+	PolicyID     string            `json:"policy_id"`
+	HolderID     string            `json:"holder_id"`
+	Type         string            `json:"type"` // Health, Life, Property, etc.
+	Coverage     sdkmath.Int       `json:"coverage"`
+	Premium      sdkmath.Int       `json:"premium"`
+	Status       string            `json:"status"`
+	StartDate    time.Time         `json:"start_date"`
+	EndDate      time.Time         `json:"end_date"`
+	RiskLevel    string            `json:"risk_level"`
+	Terms        map[string]string `json:"terms"`
+	Documents    []Document        `json:"documents"`
+	LastModified time.Time         `json:"last_modified"`
+
+	// RatingModelVersion is the version of the rating model that quoted the
+	// premium, zero when the premium was not quoted
+	RatingModelVersion uint64 `json:"rating_model_version"`
 }
 
 // Claim represents an insurance claim
 type Claim struct {
-	ClaimID       string         `json:"claim_id"`
-	PolicyID      string         `json:"policy_id"`
-	HolderID      string         `json:"holder_id"`
-	Type          string         `json:"type"`
-	Amount        sdk.Int        `json:"amount"`
-	Status        string         `json:"status"`
-	Description   string         `json:"description"`
-	Evidence      []Evidence     `json:"evidence"`
-	FiledDate     time.Time      `json:"filed_date"`
-	ProcessedDate time.Time      `json:"processed_date"`
+	ClaimID       string      `json:"claim_id"`
+	PolicyID      string      `json:"policy_id"`
+	HolderID      string      `json:"holder_id"`
+	Type          string      `json:"type"`
+	Amount        sdkmath.Int `json:"amount"`
+	Status        string      `json:"status"`
+	Description   string      `json:"description"`
+	Evidence      []Evidence  `json:"evidence"`
+	FiledDate     time.Time   `json:"filed_date"`
+	ProcessedDate time.Time   `json:"processed_date"`
+	SourceChain   string      `json:"source_chain"` // Chain the claim was submitted from, if any
+	SourceID      string      `json:"source_id"`    // ID of the claim on its source chain
 }
 
 // Document represents policy-related documents
@@ -57,12 +92,55 @@ type Evidence struct {
 	UploadedAt  time.Time `json:"uploaded_at"`
 }
 
+// ClaimPayout is the payout of an approved claim the policy escrow cannot
+// cover, paid by the insurer through the finance chain
+type ClaimPayout struct {
+	ClaimID string `json:"claim_id"`
+	// PaymentID identifies the payment on the finance chain. It changes each
+	// time a failed payout of the claim is retried.
+	PaymentID string      `json:"payment_id"`
+	PolicyID  string      `json:"policy_id"`
+	Payer     string      `json:"payer"`
+	Payee     string      `json:"payee"`
+	Amount    sdkmath.Int `json:"amount"`
+	Currency  string      `json:"currency"`
+}
+
+// Payment is a payment in the format of the finance chain transactions
+type Payment struct {
+	TransactionID string          `json:"transaction_id"`
+	FromAddress   string          `json:"from_address"`
+	ToAddress     string          `json:"to_address"`
+	Amount        sdkmath.Int     `json:"amount"`
+	Currency      string          `json:"currency"`
+	TxType        string          `json:"tx_type"`
+	Status        string          `json:"status"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Metadata      PaymentMetadata `json:"metadata"`
+}
+
+// PaymentMetadata describes what a payment is for
+type PaymentMetadata struct {
+	Purpose    string   `json:"purpose"`
+	References []string `json:"references"`
+}
+
+// ClaimID returns the claim a claim payout payment pays, its first reference
+func (p Payment) ClaimID() string {
+	if len(p.Metadata.References) == 0 {
+		return ""
+	}
+	return p.Metadata.References[0]
+}
+
 // InsuranceContract implements the IInsuranceContract interface
 type InsuranceContract struct {
 	policyManager     interfaces.IPolicyManager
 	claimProcessor    interfaces.IClaimProcessor
 	riskAssessor      interfaces.IRiskAssessor
 	complianceManager interfaces.IComplianceManager
+	fraudDetector     interfaces.IFraudDetector
+	router            *interchain.Router
 }
 
 func NewInsuranceContract(
@@ -70,13 +148,44 @@ func NewInsuranceContract(
 	claimProcessor interfaces.IClaimProcessor,
 	riskAssessor interfaces.IRiskAssessor,
 	complianceManager interfaces.IComplianceManager,
+	fraudDetector interfaces.IFraudDetector,
 ) *InsuranceContract {
-	return &InsuranceContract{
+	c := &InsuranceContract{
 		policyManager:     policyManager,
 		claimProcessor:    claimProcessor,
 		riskAssessor:      riskAssessor,
 		complianceManager: complianceManager,
+		fraudDetector:     fraudDetector,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainHealthcare, MessageTypeTreatmentClaim, interchain.Route{
+			Process: c.handleTreatmentClaim,
+			Prepare: c.prepareClaimDecision,
+		}).
+		AddRoute(interchain.ChainHealthcare, MessageTypeClaimCorroboration, interchain.Route{
+			Prepare:  c.prepareCorroborationQuery,
+			Callback: c.handleCorroboration(interchain.ChainHealthcare),
+		}).
+		AddRoute(interchain.ChainHealthcare, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleHealthcareMessage,
+			Prepare:  c.prepareHealthcareMessage,
+			Callback: c.handleHealthcareCallback,
+		}).
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleFinanceMessage,
+			Prepare:  c.prepareFinanceMessage,
+			Callback: c.handleFinanceCallback,
+		}).
+		AddRoute(interchain.ChainRealEstate, MessageTypeClaimCorroboration, interchain.Route{
+			Prepare:  c.prepareCorroborationQuery,
+			Callback: c.handleCorroboration(interchain.ChainRealEstate),
+		}).
+		AddRoute(interchain.ChainRealEstate, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleRealEstateMessage,
+			Prepare:  c.prepareRealEstateMessage,
+			Callback: c.handleRealEstateCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IInsuranceContract
@@ -86,7 +195,7 @@ func (c *InsuranceContract) ValidateInterchainData(ctx sdk.Context, data []byte)
 		Type string `json:"type"`
 	}
 	if err := json.Unmarshal(data, &dataType); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid data format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid data format")
 	}
 
 	switch dataType.Type {
@@ -95,50 +204,23 @@ func (c *InsuranceContract) ValidateInterchainData(ctx sdk.Context, data []byte)
 	case "claim":
 		return c.claimProcessor.ValidateClaim(ctx, data)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported data type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported data type")
 	}
 }
 
 // ProcessInterchainMessage implements IInsuranceContract
-func (c *InsuranceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	switch sourceChain {
-	case "healthcare":
-		return c.handleHealthcareMessage(ctx, message)
-	case "finance":
-		return c.handleFinanceMessage(ctx, message)
-	case "realestate":
-		return c.handleRealEstateMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+func (c *InsuranceContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IInsuranceContract
-func (c *InsuranceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	switch targetChain {
-	case "healthcare":
-		return c.prepareHealthcareMessage(data)
-	case "finance":
-		return c.prepareFinanceMessage(data)
-	case "realestate":
-		return c.prepareRealEstateMessage(data)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+func (c *InsuranceContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IInsuranceContract
-func (c *InsuranceContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
-	switch sourceChain {
-	case "healthcare":
-		return c.handleHealthcareCallback(ctx, response)
-	case "finance":
-		return c.handleFinanceCallback(ctx, response)
-	case "realestate":
-		return c.handleRealEstateCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+func (c *InsuranceContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ProcessClaim implements IInsuranceContract
@@ -149,39 +231,82 @@ func (c *InsuranceContract) ProcessClaim(ctx sdk.Context, claim []byte) error {
 	}
 
 	// Process the claim
-	return c.claimProcessor.ProcessClaim(ctx, claim)
+	if err := c.claimProcessor.ProcessClaim(ctx, claim); err != nil {
+		return err
+	}
+	if err := c.requestCorroboration(ctx, claim); err != nil {
+		return err
+	}
+
+	// Score the filed claim for fraud
+	return c.fraudDetector.ScoreClaim(ctx, claim)
+}
+
+// UpdateClaim adds the evidence of an update to a claim being adjudicated,
+// and scores the claim again with its new evidence
+func (c *InsuranceContract) UpdateClaim(ctx sdk.Context, claim []byte) error {
+	if err := c.claimProcessor.UpdateClaim(ctx, claim); err != nil {
+		return err
+	}
+	return c.fraudDetector.ScoreClaim(ctx, claim)
 }
 
 // ValidatePolicy implements IInsuranceContract
 func (c *InsuranceContract) ValidatePolicy(ctx sdk.Context, policy []byte) error {
 	var p Policy
 	if err := json.Unmarshal(policy, &p); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid policy format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid policy format")
 	}
 
 	// Validate required fields
 	if p.PolicyID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "policy ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "policy ID is required")
 	}
 	if p.HolderID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "holder ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "holder ID is required")
 	}
 	if p.Coverage.IsNil() || p.Coverage.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid coverage amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid coverage amount")
 	}
 	if p.Premium.IsNil() || p.Premium.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid premium amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid premium amount")
+	}
+	if p.Type == PolicyTypeParametric {
+		if err := validateParametricPolicy(p); err != nil {
+			return err
+		}
 	}
 
 	// Validate compliance
 	return c.complianceManager.ValidateCompliance(ctx, policy)
 }
 
+// CreatePolicy stores a new policy
+func (c *InsuranceContract) CreatePolicy(ctx sdk.Context, policy []byte) error {
+	return c.policyManager.CreatePolicy(ctx, policy)
+}
+
+// UpdatePolicy replaces an existing policy
+func (c *InsuranceContract) UpdatePolicy(ctx sdk.Context, policy []byte) error {
+	return c.policyManager.UpdatePolicy(ctx, policy)
+}
+
+// CancelPolicy cancels an existing policy
+func (c *InsuranceContract) CancelPolicy(ctx sdk.Context, policyID string) error {
+	return c.policyManager.CancelPolicy(ctx, policyID)
+}
+
 // CalculatePremium implements IInsuranceContract
-func (c *InsuranceContract) CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdk.Int, error) {
+func (c *InsuranceContract) CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, error) {
 	return c.riskAssessor.CalculatePremium(ctx, policyType, riskFactors)
 }
 
+// QuotePremium prices a policy with the rating model in force, returning the
+// version of the model that priced it
+func (c *InsuranceContract) QuotePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, uint64, error) {
+	return c.riskAssessor.QuotePremium(ctx, policyType, riskFactors)
+}
+
 // AssessRisk implements IInsuranceContract
 func (c *InsuranceContract) AssessRisk(ctx sdk.Context, data []byte) (string, error) {
 	return c.riskAssessor.AssessRisk(ctx, data)
@@ -204,17 +329,41 @@ func (c *InsuranceContract) handleRealEstateMessage(ctx sdk.Context, message []b
 }
 
 // Internal message preparation
-func (c *InsuranceContract) prepareHealthcareMessage(data []byte) ([]byte, error) {
+func (c *InsuranceContract) prepareHealthcareMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for healthcare chain
 	return data, nil
 }
 
-func (c *InsuranceContract) prepareFinanceMessage(data []byte) ([]byte, error) {
-	// Prepare message for finance chain
-	return data, nil
+// prepareFinanceMessage turns a claim payout into a payment of the finance
+// chain, referencing the claim it pays
+func (c *InsuranceContract) prepareFinanceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
+	var payout ClaimPayout
+	if err := json.Unmarshal(data, &payout); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim payout format")
+	}
+	if payout.ClaimID == "" || payout.PaymentID == "" || payout.Payer == "" || payout.Payee == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claim payout needs a claim, a payment, a payer and a payee")
+	}
+	if payout.Amount.IsNil() || !payout.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim payout amount")
+	}
+
+	return json.Marshal(Payment{
+		TransactionID: payout.PaymentID,
+		FromAddress:   payout.Payer,
+		ToAddress:     payout.Payee,
+		Amount:        payout.Amount,
+		Currency:      payout.Currency,
+		TxType:        "PAYMENT",
+		Timestamp:     ctx.BlockTime().UTC(),
+		Metadata: PaymentMetadata{
+			Purpose:    "claim payout",
+			References: []string{payout.ClaimID, payout.PolicyID},
+		},
+	})
 }
 
-func (c *InsuranceContract) prepareRealEstateMessage(data []byte) ([]byte, error) {
+func (c *InsuranceContract) prepareRealEstateMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for real estate chain
 	return data, nil
 }
@@ -225,9 +374,22 @@ func (c *InsuranceContract) handleHealthcareCallback(ctx sdk.Context, response [
 	return nil
 }
 
+// handleFinanceCallback marks a claim PAID once the finance chain finalized
+// the payment of its payout, and takes it back to adjudication when the
+// finance chain rejected the payment
 func (c *InsuranceContract) handleFinanceCallback(ctx sdk.Context, response []byte) error {
-	// Handle finance chain responses
-	return nil
+	var payment Payment
+	if err := json.Unmarshal(response, &payment); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	switch payment.Status {
+	case PaymentStatusFinalized:
+		return c.claimProcessor.UpdateClaimStatus(ctx, payment.ClaimID(), ClaimStatusPaid)
+	case PaymentStatusRejected:
+		return c.claimProcessor.FailPayout(ctx, payment.ClaimID(), payment.TransactionID, "payout rejected by the finance chain")
+	default:
+		return nil
+	}
 }
 
 func (c *InsuranceContract) handleRealEstateCallback(ctx sdk.Context, response []byte) error {
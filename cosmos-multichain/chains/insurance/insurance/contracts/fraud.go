@@ -0,0 +1,135 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// MessageTypeClaimCorroboration is the interchain message type of the
+// queries asking the chain of record of a policy type for the records
+// corroborating a claim, and of their results
+const MessageTypeClaimCorroboration = "claim_corroboration"
+
+// PolicyTypeProperty is the type of the property policies, whose claims the
+// real estate chain corroborates
+const PolicyTypeProperty = "property"
+
+// Kinds of the fraud signals raised on claims
+const (
+	FraudSignalReusedEvidence = "reused_evidence" // Evidence hash already supporting an earlier claim
+	FraudSignalClaimVelocity  = "claim_velocity"  // Claimant filing more claims than the velocity limit
+	FraudSignalMissingRecord  = "missing_record"  // No record of the claim on the chain of record
+)
+
+// Corroboration statuses of a claim
+const (
+	CorroborationPending        = "PENDING"
+	CorroborationCorroborated   = "CORROBORATED"
+	CorroborationUncorroborated = "UNCORROBORATED"
+)
+
+// CorroborationQuery asks the chain of record of a policy type for the
+// records corroborating a claim
+type CorroborationQuery struct {
+	ClaimID   string    `json:"claim_id"`
+	Subject   string    `json:"subject"` // Claimant, the patient or owner on the chain of record
+	ClaimType string    `json:"claim_type"`
+	Evidence  []string  `json:"evidence"` // Hashes of the claim evidence
+	FiledAt   time.Time `json:"filed_at"`
+}
+
+// CorroborationResult is the answer of the chain of record to a
+// corroboration query
+type CorroborationResult struct {
+	ClaimID string   `json:"claim_id"`
+	Records []string `json:"records"` // Corroborating records, none when the claim is not corroborated
+}
+
+// RecordChain returns the chain keeping the records that corroborate the
+// claims on a policy type, and an empty chain when none does
+func RecordChain(policyType string) string {
+	switch policyType {
+	case PolicyTypeHealth:
+		return interchain.ChainHealthcare
+	case PolicyTypeProperty:
+		return interchain.ChainRealEstate
+	default:
+		return ""
+	}
+}
+
+// requestCorroboration queries the chain of record of the policy type of a
+// filed claim for the records corroborating it; its answer is handled by
+// handleCorroboration. Claims submitted by the chain of record need no
+// corroboration.
+func (c *InsuranceContract) requestCorroboration(ctx sdk.Context, claim []byte) error {
+	var filed Claim
+	if err := json.Unmarshal(claim, &filed); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim format")
+	}
+	data, err := c.policyManager.GetPolicy(ctx, filed.PolicyID)
+	if err != nil {
+		return err
+	}
+	var policy Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid policy format")
+	}
+	chain := RecordChain(policy.Type)
+	if chain == "" || chain == filed.SourceChain {
+		return nil
+	}
+
+	query := CorroborationQuery{
+		ClaimID:   filed.ClaimID,
+		Subject:   policy.HolderID,
+		ClaimType: filed.Type,
+		FiledAt:   ctx.BlockTime().UTC(),
+	}
+	for _, evidence := range filed.Evidence {
+		if evidence.Hash != "" {
+			query.Evidence = append(query.Evidence, evidence.Hash)
+		}
+	}
+	data, err = json.Marshal(query)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal corroboration query")
+	}
+	prepared, err := c.PrepareInterchainMessage(ctx, chain, MessageTypeClaimCorroboration, data)
+	if err != nil {
+		return err
+	}
+	return c.fraudDetector.AwaitCorroboration(ctx, filed.ClaimID, chain, prepared)
+}
+
+// prepareCorroborationQuery checks a corroboration query sent to a chain of
+// record
+func (c *InsuranceContract) prepareCorroborationQuery(ctx sdk.Context, data []byte) ([]byte, error) {
+	var query CorroborationQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid corroboration query format")
+	}
+	if query.ClaimID == "" || query.Subject == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claim ID and subject are required")
+	}
+	return json.Marshal(query)
+}
+
+// handleCorroboration returns the callback recording the results of the
+// corroboration queries answered by a chain of record
+func (c *InsuranceContract) handleCorroboration(chainID string) interchain.CallbackHandler {
+	return func(ctx sdk.Context, response []byte) error {
+		var result CorroborationResult
+		if err := json.Unmarshal(response, &result); err != nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "invalid corroboration result format")
+		}
+		if result.ClaimID == "" {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "claim ID is required")
+		}
+		return c.fraudDetector.RecordCorroboration(ctx, chainID, response)
+	}
+}
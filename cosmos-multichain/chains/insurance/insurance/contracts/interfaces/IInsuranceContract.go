@@ -1,21 +1,20 @@
 package interfaces
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IInsuranceContract extends the base interchain contract with insurance features
 type IInsuranceContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// Insurance-specific functionality
 	ProcessClaim(ctx sdk.Context, claim []byte) error
 	ValidatePolicy(ctx sdk.Context, policy []byte) error
-	CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdk.Int, error)
+	CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, error)
 	AssessRisk(ctx sdk.Context, data []byte) (string, error)
 }
 
@@ -33,6 +32,9 @@ type IPolicyManager interface {
 	// GetPolicy retrieves policy information
 	GetPolicy(ctx sdk.Context, policyID string) ([]byte, error)
 
+	// GetActivePolicies retrieves the active policies of a holder
+	GetActivePolicies(ctx sdk.Context, holderID string) ([]byte, error)
+
 	// ValidatePolicy validates policy details
 	ValidatePolicy(ctx sdk.Context, policy []byte) error
 }
@@ -45,9 +47,16 @@ type IClaimProcessor interface {
 	// ProcessClaim processes an insurance claim
 	ProcessClaim(ctx sdk.Context, claim []byte) error
 
+	// UpdateClaim adds evidence to a claim being adjudicated
+	UpdateClaim(ctx sdk.Context, claim []byte) error
+
 	// UpdateClaimStatus updates the status of a claim
 	UpdateClaimStatus(ctx sdk.Context, claimID string, status string) error
 
+	// FailPayout takes an approved claim whose payout payment failed back to
+	// adjudication
+	FailPayout(ctx sdk.Context, claimID, paymentID, reason string) error
+
 	// ValidateClaim validates claim details
 	ValidateClaim(ctx sdk.Context, claim []byte) error
 }
@@ -58,7 +67,15 @@ type IRiskAssessor interface {
 	AssessRisk(ctx sdk.Context, data []byte) (string, error)
 
 	// CalculatePremium calculates insurance premium
-	CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdk.Int, error)
+	CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, error)
+
+	// QuotePremium calculates insurance premium and returns the version of
+	// the risk model that priced it
+	QuotePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, uint64, error)
+
+	// EvaluateTrigger returns the payout an observation triggers on a
+	// parametric policy, zero when it does not trigger it
+	EvaluateTrigger(ctx sdk.Context, policy []byte, observation []byte) (sdkmath.Int, error)
 
 	// UpdateRiskModel updates the risk assessment model
 	UpdateRiskModel(ctx sdk.Context, model []byte) error
@@ -67,6 +84,21 @@ type IRiskAssessor interface {
 	ValidateRiskFactors(ctx sdk.Context, factors []byte) error
 }
 
+// IFraudDetector defines the interface for claim fraud detection
+type IFraudDetector interface {
+	// ScoreClaim raises the fraud signals of a claim, flagging it for
+	// manual review when its fraud score reaches the review threshold
+	ScoreClaim(ctx sdk.Context, claim []byte) error
+
+	// AwaitCorroboration sends the corroboration query of a claim to the
+	// chain of record of its policy type, and records it until answered
+	AwaitCorroboration(ctx sdk.Context, claimID, chain string, query []byte) error
+
+	// RecordCorroboration records the result of a corroboration query
+	// answered by a chain of record
+	RecordCorroboration(ctx sdk.Context, sourceChain string, result []byte) error
+}
+
 // IComplianceManager defines the interface for insurance compliance
 type IComplianceManager interface {
 	// ValidateCompliance checks regulatory compliance
@@ -0,0 +1,169 @@
+package contracts
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// PolicyTypeParametric is the type of the parametric policies, which pay out
+// automatically when the oracle observes their trigger metric crossing a
+// threshold. Their trigger is kept in their terms.
+const PolicyTypeParametric = "parametric"
+
+// ClaimTypeParametric is the type of the claims paying out parametric policies
+const ClaimTypeParametric = "PARAMETRIC"
+
+// Terms of a parametric policy describing its trigger
+const (
+	TermTriggerMetric    = "trigger_metric"    // Metric observed by the oracle, e.g. flight:LH400:2026-10-17:delay_minutes
+	TermTriggerOperator  = "trigger_operator"  // One of the trigger operators
+	TermTriggerThreshold = "trigger_threshold" // Decimal the observed value is compared to
+	TermTriggerPayout    = "trigger_payout"    // Amount paid out when triggered
+)
+
+// Trigger operators, comparing the observed value of the metric to the
+// threshold
+const (
+	TriggerAbove   = ">"
+	TriggerAtLeast = ">="
+	TriggerBelow   = "<"
+	TriggerAtMost  = "<="
+)
+
+// ParametricTrigger is the trigger condition of a parametric policy
+type ParametricTrigger struct {
+	Metric    string
+	Operator  string
+	Threshold sdkmath.LegacyDec
+	Payout    sdkmath.Int
+}
+
+// Observation is the value of a metric settled by the oracle
+type Observation struct {
+	Metric     string            `json:"metric"`
+	Value      sdkmath.LegacyDec `json:"value"`
+	ObservedAt time.Time         `json:"observed_at"`
+}
+
+// ParseTrigger reads the trigger of a parametric policy from its terms
+func ParseTrigger(terms map[string]string) (ParametricTrigger, error) {
+	trigger := ParametricTrigger{
+		Metric:   terms[TermTriggerMetric],
+		Operator: terms[TermTriggerOperator],
+	}
+	// Metrics key the oracle store, where a slash separates the keys
+	if trigger.Metric == "" || strings.Contains(trigger.Metric, "/") {
+		return trigger, errorsmod.Wrapf(interchain.ErrInvalidData, "invalid trigger metric %q", trigger.Metric)
+	}
+	switch trigger.Operator {
+	case TriggerAbove, TriggerAtLeast, TriggerBelow, TriggerAtMost:
+	default:
+		return trigger, errorsmod.Wrapf(interchain.ErrInvalidData, "invalid trigger operator %q", trigger.Operator)
+	}
+
+	threshold, err := sdkmath.LegacyNewDecFromStr(terms[TermTriggerThreshold])
+	if err != nil {
+		return trigger, errorsmod.Wrapf(interchain.ErrInvalidData, "invalid trigger threshold %q", terms[TermTriggerThreshold])
+	}
+	payout, ok := sdkmath.NewIntFromString(terms[TermTriggerPayout])
+	if !ok || !payout.IsPositive() {
+		return trigger, errorsmod.Wrapf(interchain.ErrInvalidData, "invalid trigger payout %q", terms[TermTriggerPayout])
+	}
+	trigger.Threshold = threshold
+	trigger.Payout = payout
+	return trigger, nil
+}
+
+// Triggered reports whether an observed value of the metric meets the trigger
+func (t ParametricTrigger) Triggered(value sdkmath.LegacyDec) bool {
+	switch t.Operator {
+	case TriggerAbove:
+		return value.GT(t.Threshold)
+	case TriggerAtLeast:
+		return value.GTE(t.Threshold)
+	case TriggerBelow:
+		return value.LT(t.Threshold)
+	case TriggerAtMost:
+		return value.LTE(t.Threshold)
+	default:
+		return false
+	}
+}
+
+// ParametricClaimID returns the ID of the claim paying out a parametric
+// policy triggered by a metric
+func ParametricClaimID(policyID, metric string) string {
+	return PolicyTypeParametric + "-" + policyID + "-" + metric
+}
+
+// validateParametricPolicy checks the trigger of a parametric policy, whose
+// payout its coverage must cover
+func validateParametricPolicy(p Policy) error {
+	trigger, err := ParseTrigger(p.Terms)
+	if err != nil {
+		return err
+	}
+	if trigger.Payout.GT(p.Coverage) {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "trigger payout exceeds the coverage")
+	}
+	return nil
+}
+
+// FileParametricClaim files the claim of a parametric policy for the payout
+// an observation triggers, up to the remaining coverage given. It returns
+// the ID of the claim, or an empty ID when the observation does not trigger
+// the policy.
+func (c *InsuranceContract) FileParametricClaim(ctx sdk.Context, policyID string, observation []byte, remaining sdkmath.Int) (string, error) {
+	policy, err := c.policyManager.GetPolicy(ctx, policyID)
+	if err != nil {
+		return "", err
+	}
+	payout, err := c.riskAssessor.EvaluateTrigger(ctx, policy, observation)
+	if err != nil || !payout.IsPositive() {
+		return "", err
+	}
+	if payout.GT(remaining) {
+		payout = remaining
+	}
+	if !payout.IsPositive() {
+		return "", nil
+	}
+
+	var (
+		p Policy
+		o Observation
+	)
+	if err := json.Unmarshal(policy, &p); err != nil {
+		return "", errorsmod.Wrap(interchain.ErrInvalidData, "invalid policy format")
+	}
+	if err := json.Unmarshal(observation, &o); err != nil {
+		return "", errorsmod.Wrap(interchain.ErrInvalidData, "invalid observation format")
+	}
+	claimID := ParametricClaimID(policyID, o.Metric)
+	claim, err := json.Marshal(Claim{
+		ClaimID:     claimID,
+		PolicyID:    policyID,
+		HolderID:    p.HolderID,
+		Type:        ClaimTypeParametric,
+		Amount:      payout,
+		Description: "triggered by " + o.Metric + " = " + o.Value.String(),
+		Evidence: []Evidence{{
+			Type:        "oracle_observation",
+			Description: string(observation),
+			UploadedAt:  o.ObservedAt,
+		}},
+	})
+	if err != nil {
+		return "", errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal parametric claim")
+	}
+	if err := c.ProcessClaim(ctx, claim); err != nil {
+		return "", err
+	}
+	return claimID, nil
+}
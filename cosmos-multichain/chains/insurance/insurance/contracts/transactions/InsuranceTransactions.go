@@ -2,10 +2,14 @@ package transactions
 
 import (
 	"encoding/json"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/insurance/contracts"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
 )
 
 // TransactionType defines the type of insurance transaction
@@ -31,25 +35,31 @@ type InsuranceTransactionRequest struct {
 
 // PolicyData contains policy-related information
 type PolicyData struct {
-	PolicyID   string            `json:"policy_id"`
-	HolderID   string            `json:"holder_id"`
-	Type       string            `json:"type"`
-	Coverage   sdk.Int           `json:"coverage"`
-	Premium    sdk.Int           `json:"premium"`
-	StartDate  time.Time         `json:"start_date"`
-	EndDate    time.Time         `json:"end_date"`
-	Terms      map[string]string `json:"terms"`
-	Documents  []Document        `json:"documents"`
+	PolicyID  string            `json:"policy_id"`
+	HolderID  string            `json:"holder_id"`
+	Type      string            `json:"type"`
+	Coverage  sdkmath.Int       `json:"coverage"`
+	Premium   sdkmath.Int       `json:"premium"`
+	StartDate time.Time         `json:"start_date"`
+	EndDate   time.Time         `json:"end_date"`
+	Terms     map[string]string `json:"terms"`
+	Documents []Document        `json:"documents"`
+
+	// RiskFactors are required and have the premium quoted by the rating
+	// model in force, which the policy records the version of
+	RiskFactors        json.RawMessage `json:"risk_factors,omitempty"`
+	RatingModelVersion uint64          `json:"rating_model_version"`
 }
 
 // ClaimData contains claim-related information
 type ClaimData struct {
-	ClaimID     string     `json:"claim_id"`
-	PolicyID    string     `json:"policy_id"`
-	Type        string     `json:"type"`
-	Amount      sdk.Int    `json:"amount"`
-	Description string     `json:"description"`
-	Evidence    []Evidence `json:"evidence"`
+	ClaimID     string      `json:"claim_id"`
+	PolicyID    string      `json:"policy_id"`
+	HolderID    string      `json:"holder_id"` // Holder of the policy, who files the claim
+	Type        string      `json:"type"`
+	Amount      sdkmath.Int `json:"amount"`
+	Description string      `json:"description"`
+	Evidence    []Evidence  `json:"evidence"`
 }
 
 // Document represents supporting documentation
@@ -71,9 +81,9 @@ type Evidence struct {
 
 // Metadata contains additional transaction information
 type Metadata struct {
-	References  []string          `json:"references"`
-	Notes       string            `json:"notes"`
-	Extra       map[string]string `json:"extra"`
+	References []string          `json:"references"`
+	Notes      string            `json:"notes"`
+	Extra      map[string]string `json:"extra"`
 }
 
 // InsuranceTransactionHandler handles insurance transactions
@@ -107,72 +117,110 @@ func (h *InsuranceTransactionHandler) InitiateTransaction(ctx sdk.Context, req I
 	case UpdateClaim:
 		return h.processUpdateClaim(ctx, req)
 	default:
-		return errors.Wrap(errors.ErrInvalidRequest, "unsupported transaction type")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported transaction type")
 	}
 }
 
 // ValidateRequest validates the transaction request
 func (h *InsuranceTransactionHandler) validateRequest(req InsuranceTransactionRequest) error {
 	if req.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 
 	switch req.TransactionType {
 	case NewPolicy, UpdatePolicy:
 		if req.PolicyData == nil {
-			return errors.Wrap(errors.ErrInvalidRequest, "policy data is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "policy data is required")
 		}
 		if req.PolicyData.PolicyID == "" {
-			return errors.Wrap(errors.ErrInvalidRequest, "policy ID is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "policy ID is required")
 		}
 		if req.PolicyData.Coverage.IsNil() || req.PolicyData.Coverage.IsNegative() {
-			return errors.Wrap(errors.ErrInvalidRequest, "invalid coverage amount")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "invalid coverage amount")
 		}
 	case FileClaim, UpdateClaim:
 		if req.ClaimData == nil {
-			return errors.Wrap(errors.ErrInvalidRequest, "claim data is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "claim data is required")
 		}
 		if req.ClaimData.ClaimID == "" {
-			return errors.Wrap(errors.ErrInvalidRequest, "claim ID is required")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "claim ID is required")
 		}
 		if req.ClaimData.Amount.IsNil() || req.ClaimData.Amount.IsNegative() {
-			return errors.Wrap(errors.ErrInvalidRequest, "invalid claim amount")
+			return errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim amount")
 		}
 	}
 
 	return nil
 }
 
+// quotePolicy returns the policy data of a request, with its premium quoted
+// by the rating model in force for the risk factors of the request. A premium
+// given along the risk factors must match the quote.
+func (h *InsuranceTransactionHandler) quotePolicy(ctx sdk.Context, data PolicyData) ([]byte, error) {
+	if len(data.RiskFactors) == 0 {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "risk factors are required")
+	}
+	premium, version, err := h.contract.QuotePremium(ctx, data.Type, data.RiskFactors)
+	if err != nil {
+		return nil, err
+	}
+	if !data.Premium.IsNil() && !data.Premium.IsZero() && !data.Premium.Equal(premium) {
+		return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "premium %s differs from the quote %s", data.Premium, premium)
+	}
+	data.Premium = premium
+	data.RatingModelVersion = version
+
+	policyData, err := json.Marshal(data)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal policy data")
+	}
+	return policyData, nil
+}
+
 // Process different types of transactions
 func (h *InsuranceTransactionHandler) processNewPolicy(ctx sdk.Context, req InsuranceTransactionRequest) error {
-	policyData, err := json.Marshal(req.PolicyData)
+	policyData, err := h.quotePolicy(ctx, *req.PolicyData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal policy data")
+		return err
 	}
-	return h.contract.ValidatePolicy(ctx, policyData)
+	if err := h.contract.ValidatePolicy(ctx, policyData); err != nil {
+		return err
+	}
+	return h.contract.CreatePolicy(ctx, policyData)
 }
 
 func (h *InsuranceTransactionHandler) processUpdatePolicy(ctx sdk.Context, req InsuranceTransactionRequest) error {
-	// Handle policy update logic
-	return nil
+	policyData, err := h.quotePolicy(ctx, *req.PolicyData)
+	if err != nil {
+		return err
+	}
+	if err := h.contract.ValidatePolicy(ctx, policyData); err != nil {
+		return err
+	}
+	return h.contract.UpdatePolicy(ctx, policyData)
 }
 
 func (h *InsuranceTransactionHandler) processCancelPolicy(ctx sdk.Context, req InsuranceTransactionRequest) error {
-	// Handle policy cancellation logic
-	return nil
+	if req.PolicyData == nil || req.PolicyData.PolicyID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "policy ID is required")
+	}
+	return h.contract.CancelPolicy(ctx, req.PolicyData.PolicyID)
 }
 
 func (h *InsuranceTransactionHandler) processFileClaim(ctx sdk.Context, req InsuranceTransactionRequest) error {
 	claimData, err := json.Marshal(req.ClaimData)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal claim data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal claim data")
 	}
 	return h.contract.ProcessClaim(ctx, claimData)
 }
 
 func (h *InsuranceTransactionHandler) processUpdateClaim(ctx sdk.Context, req InsuranceTransactionRequest) error {
-	// Handle claim update logic
-	return nil
+	claimData, err := json.Marshal(req.ClaimData)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal claim data")
+	}
+	return h.contract.UpdateClaim(ctx, claimData)
 }
 
 // UpdateTransactionStatus updates the status of an insurance transaction
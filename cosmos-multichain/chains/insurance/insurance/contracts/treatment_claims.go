@@ -0,0 +1,115 @@
+package contracts
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// MessageTypeTreatmentClaim is the interchain message type of the treatment
+// claims submitted by the healthcare chain, and of the decisions sent back
+// to it
+const MessageTypeTreatmentClaim = "treatment_claim"
+
+// PolicyTypeHealth is the type of the policies treatments are claimed on
+const PolicyTypeHealth = "health"
+
+// TreatmentClaim is the claim of a treatment recorded on the healthcare
+// chain, submitted on behalf of the patient
+type TreatmentClaim struct {
+	ClaimID    string      `json:"claim_id"` // ID of the treatment medical record
+	PatientID  string      `json:"patient"`
+	ProviderID string      `json:"provider"`
+	Amount     sdkmath.Int `json:"amount"`
+	DataHash   string      `json:"data_hash"`
+}
+
+// ClaimDecision is the decision on a treatment claim sent back to the
+// healthcare chain
+type ClaimDecision struct {
+	ClaimID string `json:"claim_id"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+}
+
+// TreatmentClaimID returns the ID of the claim filed for a treatment claim
+// of the healthcare chain
+func TreatmentClaimID(claimID string) string {
+	return interchain.ChainHealthcare + "-" + claimID
+}
+
+// handleTreatmentClaim files a treatment claim against the active health
+// policy of the patient, who is the claimant
+func (c *InsuranceContract) handleTreatmentClaim(ctx sdk.Context, message []byte) error {
+	var treatment TreatmentClaim
+	if err := json.Unmarshal(message, &treatment); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid treatment claim format")
+	}
+	if treatment.ClaimID == "" || treatment.PatientID == "" || treatment.ProviderID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "claim ID, patient and provider are required")
+	}
+
+	policyID, err := c.healthPolicy(ctx, treatment.PatientID)
+	if err != nil {
+		return err
+	}
+	claim, err := json.Marshal(Claim{
+		ClaimID:     TreatmentClaimID(treatment.ClaimID),
+		PolicyID:    policyID,
+		HolderID:    treatment.PatientID,
+		Type:        "TREATMENT",
+		Amount:      treatment.Amount,
+		Description: "treatment by " + treatment.ProviderID,
+		Evidence: []Evidence{{
+			Type:        "medical_record",
+			Description: "treatment medical record " + treatment.ClaimID,
+			Hash:        treatment.DataHash,
+			UploadedAt:  ctx.BlockTime().UTC(),
+		}},
+		SourceChain: interchain.ChainHealthcare,
+		SourceID:    treatment.ClaimID,
+	})
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal claim")
+	}
+	return c.ProcessClaim(ctx, claim)
+}
+
+// healthPolicy returns the ID of the first active health policy of a holder
+func (c *InsuranceContract) healthPolicy(ctx sdk.Context, holderID string) (string, error) {
+	data, err := c.policyManager.GetActivePolicies(ctx, holderID)
+	if err != nil {
+		return "", err
+	}
+	var policies []Policy
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return "", errorsmod.Wrap(interchain.ErrInvalidData, "invalid policies format")
+	}
+	for _, policy := range policies {
+		if policy.Type == PolicyTypeHealth {
+			return policy.PolicyID, nil
+		}
+	}
+	return "", errorsmod.Wrapf(interchain.ErrInvalidData, "%s has no active %s policy", holderID, PolicyTypeHealth)
+}
+
+// prepareClaimDecision checks the decision on a treatment claim sent back to
+// the healthcare chain
+func (c *InsuranceContract) prepareClaimDecision(ctx sdk.Context, data []byte) ([]byte, error) {
+	var decision ClaimDecision
+	if err := json.Unmarshal(data, &decision); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim decision format")
+	}
+	if decision.ClaimID == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claim ID is required")
+	}
+	switch decision.Status {
+	case ClaimStatusApproved, ClaimStatusDenied, ClaimStatusPaid:
+	default:
+		return nil, errorsmod.Wrapf(interchain.ErrInvalidData, "%s is not a claim decision", decision.Status)
+	}
+	return json.Marshal(decision)
+}
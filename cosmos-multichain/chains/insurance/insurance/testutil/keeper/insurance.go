@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+	policykeeper "insurance/x/policy/keeper"
+	policytypes "insurance/x/policy/types"
+)
+
+func InsuranceKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, _, ctx := InsuranceKeeperWithPolicy(t)
+	return k, ctx
+}
+
+// InsuranceKeeperWithPolicy returns an insurance keeper keeping its policies
+// in a policy keeper, which escrows premiums through an in-memory bank keeper
+// also holding the stake of the oracle reporters
+func InsuranceKeeperWithPolicy(t testing.TB) (keeper.Keeper, policykeeper.Keeper, *BankKeeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	policyStoreKey := storetypes.NewKVStoreKey(policytypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(policyStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bankKeeper := NewBankKeeper()
+
+	policyKeeper := policykeeper.NewKeeper(cdc, runtime.NewKVStoreService(policyStoreKey), log.NewNopLogger(), authority.String(), bankKeeper)
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority.String(),
+		nil,
+		nil,
+		bankKeeper,
+		policyKeeper,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+
+	// Initialize params
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	if err := policyKeeper.SetParams(ctx, policytypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return k, policyKeeper, bankKeeper, ctx
+}
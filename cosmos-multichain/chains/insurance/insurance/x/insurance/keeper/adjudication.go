@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
+	"insurance/x/insurance/types"
+)
+
+// evidenceOverdue is the decision reason of the claims denied because the
+// evidence requested from their claimant was not provided in time
+const evidenceOverdue = "requested evidence not provided"
+
+// ReviewClaim takes a FILED claim UNDER_REVIEW by an adjuster, who then
+// handles the claim until it is decided. Claims FLAGGED for fraud are
+// reviewed manually by an appeal adjuster.
+func (k Keeper) ReviewClaim(ctx context.Context, adjuster string, claimId string) error {
+	claim, found := k.GetClaim(ctx, claimId)
+	if !found {
+		return errorsmod.Wrap(types.ErrClaimNotFound, claimId)
+	}
+
+	params := k.GetParams(ctx)
+	switch claim.Status {
+	case contracts.ClaimStatusFiled:
+		if !params.IsAdjuster(adjuster) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an adjuster", adjuster)
+		}
+	case contracts.ClaimStatusFlagged:
+		if !params.IsAppealAdjuster(adjuster) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "flagged claim %s is reviewed by an appeal adjuster", claimId)
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s is %s", claimId, claim.Status)
+	}
+
+	claim.Adjuster = adjuster
+	k.setClaimStatus(ctx, &claim, contracts.ClaimStatusUnderReview)
+	return nil
+}
+
+// RequestEvidence asks the claimant of a claim under review for more
+// evidence, due within the evidence period. It returns the deadline of the
+// evidence.
+func (k Keeper) RequestEvidence(ctx context.Context, adjuster string, claimId string, request string) (int64, error) {
+	claim, err := k.claimInStatus(ctx, claimId, contracts.ClaimStatusUnderReview)
+	if err != nil {
+		return 0, err
+	}
+	if claim.Adjuster != adjuster {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "claim %s is reviewed by %s", claimId, claim.Adjuster)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	claim.EvidenceRequest = request
+	claim.Deadline = now.Add(k.GetParams(ctx).EvidencePeriod).Unix()
+	k.setClaimStatus(ctx, &claim, contracts.ClaimStatusEvidenceRequested)
+	return claim.Deadline, nil
+}
+
+// DecideClaim approves or denies a claim. Claims under review are decided by
+// their adjuster, and appealed claims by an appeal adjuster other than the
+// adjuster who denied them. A denied claim may be appealed within the appeal
+// period unless it was already appealed. An approved claim is paid.
+func (k Keeper) DecideClaim(ctx context.Context, adjuster string, claimId string, approve bool, reason string) (types.Claim, error) {
+	claim, found := k.GetClaim(ctx, claimId)
+	if !found {
+		return claim, errorsmod.Wrap(types.ErrClaimNotFound, claimId)
+	}
+
+	params := k.GetParams(ctx)
+	switch claim.Status {
+	case contracts.ClaimStatusUnderReview:
+		if claim.Adjuster != adjuster {
+			return claim, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "claim %s is reviewed by %s", claimId, claim.Adjuster)
+		}
+	case contracts.ClaimStatusAppealed:
+		if !params.IsAppealAdjuster(adjuster) || claim.Adjuster == adjuster {
+			return claim, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the appeal of claim %s is decided by another appeal adjuster", claimId)
+		}
+	default:
+		return claim, errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s is %s", claimId, claim.Status)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	claim.DecisionReason = reason
+	claim.Deadline = 0
+	if !approve {
+		if !claim.Appealed {
+			claim.Deadline = now.Add(params.AppealPeriod).Unix()
+		}
+		k.setClaimStatus(ctx, &claim, contracts.ClaimStatusDenied)
+		return claim, nil
+	}
+
+	return k.payClaim(ctx, claim)
+}
+
+// AppealClaim appeals a denied claim on behalf of its claimant. A claim is
+// appealed once, within the appeal period.
+func (k Keeper) AppealClaim(ctx context.Context, claimant string, claimId string, reason string) error {
+	claim, err := k.claimInStatus(ctx, claimId, contracts.ClaimStatusDenied)
+	if err != nil {
+		return err
+	}
+	if claim.Claimant != claimant {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "claim %s is appealed by its claimant", claimId)
+	}
+	if claim.Appealed {
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s was already appealed", claimId)
+	}
+	if now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix(); now >= claim.Deadline {
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "the appeal period of claim %s is over", claimId)
+	}
+
+	claim.Appealed = true
+	claim.AppealReason = reason
+	claim.Deadline = 0
+	k.setClaimStatus(ctx, &claim, contracts.ClaimStatusAppealed)
+	return nil
+}
+
+// ApplyClaimDeadlines denies the claims whose requested evidence was due at
+// or before now. They may still be appealed.
+func (k Keeper) ApplyClaimDeadlines(ctx context.Context, now time.Time) {
+	appealPeriod := k.GetParams(ctx).AppealPeriod
+
+	for _, claimId := range k.overdueClaims(ctx, now.Unix()) {
+		claim, found := k.GetClaim(ctx, claimId)
+		if !found || claim.Status != contracts.ClaimStatusEvidenceRequested {
+			continue
+		}
+
+		claim.DecisionReason = evidenceOverdue
+		claim.Deadline = now.Add(appealPeriod).Unix()
+		k.setClaimStatus(ctx, &claim, contracts.ClaimStatusDenied)
+	}
+}
+
+// payClaim uses the coverage of the policy of an approved claim and pays the
+// claim to its claimant from the policy escrow. When the escrow cannot cover
+// it, the payout is sent to the finance chain as a payment from the
+// interchain account of the insurance chain, and the claim stays APPROVED
+// until the finance chain acknowledges the payment as finalized.
+func (k Keeper) payClaim(ctx context.Context, claim types.Claim) (types.Claim, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	denom := k.policyKeeper.GetParams(ctx).PremiumDenom
+	payout := sdk.NewCoin(denom, claim.Amount)
+
+	if k.policyKeeper.EscrowBalance(ctx, denom).IsGTE(payout) {
+		if err := k.policyKeeper.ClaimCoverage(ctx, claim.PolicyId, claim.Amount); err != nil {
+			return claim, err
+		}
+		if err := k.policyKeeper.PayFromEscrow(ctx, claim.Claimant, payout); err != nil {
+			return claim, err
+		}
+		k.setClaimStatus(ctx, &claim, contracts.ClaimStatusPaid)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeClaimPaid,
+				sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+				sdk.NewAttribute(types.AttributeKeyClaimant, claim.Claimant),
+				sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+			),
+		)
+		return claim, nil
+	}
+
+	if err := k.policyKeeper.ClaimCoverage(ctx, claim.PolicyId, claim.Amount); err != nil {
+		return claim, err
+	}
+	claim.PayoutAttempts++
+	data, err := json.Marshal(contracts.ClaimPayout{
+		ClaimID:   claim.ClaimId,
+		PaymentID: payoutPaymentID(claim),
+		PolicyID:  claim.PolicyId,
+		Payer:     interchain.ChainAccount(interchain.ChainInsurance).String(),
+		Payee:     claim.Claimant,
+		Amount:    claim.Amount,
+		Currency:  denom,
+	})
+	if err != nil {
+		return claim, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal claim payout")
+	}
+	claim.Payment, err = k.contract.PrepareInterchainMessage(sdkCtx, interchain.ChainFinance, contracts.MessageTypeClaimPayout, data)
+	if err != nil {
+		return claim, err
+	}
+	if err := k.QueuePacket(sdkCtx, interchain.ChainFinance, interchain.NewMessagePacket(contracts.MessageTypeClaimPayout, claim.Payment)); err != nil {
+		return claim, err
+	}
+
+	k.setClaimStatus(ctx, &claim, contracts.ClaimStatusApproved)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimPayoutRouted,
+			sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyClaimant, claim.Claimant),
+			sdk.NewAttribute(types.AttributeKeyAmount, payout.String()),
+		),
+	)
+	return claim, nil
+}
+
+// failPayout gives back the coverage used by the failed finance payout of an
+// approved claim, and takes the claim back to its adjuster, or to the appeal
+// adjusters when it was appealed, to be decided again. Only the latest
+// payment of the claim can fail it.
+func (k Keeper) failPayout(ctx context.Context, claimId, paymentId, reason string) error {
+	claim, err := k.claimInStatus(ctx, claimId, contracts.ClaimStatusApproved)
+	if err != nil {
+		return err
+	}
+	if len(claim.Payment) == 0 || payoutPaymentID(claim) != paymentId {
+		return errorsmod.Wrapf(types.ErrInvalidClaim, "payment %s is not the pending payout of claim %s", paymentId, claimId)
+	}
+	if err := k.policyKeeper.ReleaseCoverage(ctx, claim.PolicyId, claim.Amount); err != nil {
+		return err
+	}
+
+	claim.Payment = nil
+	claim.DecisionReason = reason
+	status := contracts.ClaimStatusUnderReview
+	if claim.Appealed {
+		status = contracts.ClaimStatusAppealed
+	}
+	k.setClaimStatus(ctx, &claim, status)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimPayoutFailed,
+			sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyTransactionId, paymentId),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// payoutPaymentID identifies the payment of the latest payout of a claim on
+// the finance chain. The first payment is identified by the claim itself.
+func payoutPaymentID(claim types.Claim) string {
+	if claim.PayoutAttempts <= 1 {
+		return claim.ClaimId
+	}
+	return claim.ClaimId + "/" + strconv.FormatUint(claim.PayoutAttempts, 10)
+}
+
+// claimInStatus returns a claim, which must be in the given status
+func (k Keeper) claimInStatus(ctx context.Context, claimId string, status string) (types.Claim, error) {
+	claim, found := k.GetClaim(ctx, claimId)
+	if !found {
+		return claim, errorsmod.Wrap(types.ErrClaimNotFound, claimId)
+	}
+	if claim.Status != status {
+		return claim, errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s is %s", claimId, claim.Status)
+	}
+	return claim, nil
+}
+
+// setClaimStatus stores a claim in a new status and emits its status change.
+// The decisions on a claim submitted by another chain are prepared for it.
+func (k Keeper) setClaimStatus(ctx context.Context, claim *types.Claim, status string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	claim.Status = status
+	claim.UpdatedAt = sdkCtx.BlockTime().Unix()
+	if claim.SourceChain != "" {
+		k.prepareDecision(sdkCtx, claim)
+	}
+	k.SetClaim(ctx, *claim)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimStatus,
+			sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyPolicyId, claim.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyStatus, claim.Status),
+			sdk.NewAttribute(types.AttributeKeyAdjuster, claim.Adjuster),
+			sdk.NewAttribute(types.AttributeKeyDeadline, strconv.FormatInt(claim.Deadline, 10)),
+		),
+	)
+}
+
+// prepareDecision prepares the decision on a claim and sends it back to its
+// source chain when the claim is approved, denied or paid. A decision that
+// cannot be prepared is logged, and the source chain keeps the previous one.
+func (k Keeper) prepareDecision(ctx sdk.Context, claim *types.Claim) {
+	switch claim.Status {
+	case contracts.ClaimStatusApproved, contracts.ClaimStatusDenied, contracts.ClaimStatusPaid:
+	default:
+		return
+	}
+
+	data, err := json.Marshal(contracts.ClaimDecision{
+		ClaimID: claim.SourceId,
+		Status:  claim.Status,
+		Reason:  claim.DecisionReason,
+	})
+	if err == nil {
+		data, err = k.contract.PrepareInterchainMessage(ctx, claim.SourceChain, contracts.MessageTypeTreatmentClaim, data)
+	}
+	if err == nil {
+		err = k.QueuePacket(ctx, claim.SourceChain, interchain.NewCallbackPacket(contracts.MessageTypeTreatmentClaim, data))
+	}
+	if err != nil {
+		k.Logger().Error("failed to prepare claim decision", "claim_id", claim.ClaimId, "source_chain", claim.SourceChain, "error", err)
+		return
+	}
+	claim.Decision = data
+}
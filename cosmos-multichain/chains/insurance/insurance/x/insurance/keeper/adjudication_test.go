@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	keepertest "insurance/testutil/keeper"
+	"insurance/testutil/sample"
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+	policykeeper "insurance/x/policy/keeper"
+	policytypes "insurance/x/policy/types"
+)
+
+var (
+	adjuster       = sample.AccAddress()
+	appealAdjuster = sample.AccAddress()
+)
+
+// adjudicationSetup returns a keeper with an adjuster and an appeal adjuster,
+// and an active policy-1 whose premium of 100 is escrowed
+func adjudicationSetup(t *testing.T) (keeper.Keeper, policykeeper.Keeper, *keepertest.BankKeeper, sdk.Context) {
+	k, policyKeeper, bank, ctx := keepertest.InsuranceKeeperWithPolicy(t)
+	ctx = ctx.WithBlockTime(policyStart)
+	require.NoError(t, k.SetParams(ctx, types.NewParams(
+		[]string{adjuster}, []string{adjuster, appealAdjuster}, types.DefaultEvidencePeriod, types.DefaultAppealPeriod,
+		types.DefaultOracleMinStake, types.DefaultOracleQuorum,
+		types.DefaultFraudReviewThreshold, types.DefaultFraudVelocityWindow, types.DefaultFraudVelocityLimit,
+		types.DefaultCounterparties,
+		types.DefaultOracleUnbondingPeriod, types.DefaultOracleMaxDeviation, types.DefaultOracleSlashFraction,
+	)))
+
+	bank.Fund(sdk.MustAccAddressFromBech32(policyHolder), sdk.NewCoins(sdk.NewInt64Coin(policytypes.DefaultPremiumDenom, 100)))
+	srv := keeper.NewMsgServerImpl(k)
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, policyRequest("tx-policy", transactions.NewPolicy, "health"))))
+	require.NoError(t, err)
+	_, err = policyKeeper.PayPremium(ctx, policyHolder, "policy-1")
+	require.NoError(t, err)
+	return k, policyKeeper, bank, ctx
+}
+
+func fileClaim(t *testing.T, k keeper.Keeper, ctx sdk.Context, id string, amount int64) string {
+	_, err := keeper.NewMsgServerImpl(k).InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, claimRequest(id, amount))))
+	require.NoError(t, err)
+	return "claim-" + id
+}
+
+func claimStatus(t *testing.T, k keeper.Keeper, ctx sdk.Context, claimId string) string {
+	claim, found := k.GetClaim(ctx, claimId)
+	require.True(t, found)
+	return claim.Status
+}
+
+func TestClaimAdjudication(t *testing.T) {
+	k, policyKeeper, bank, ctx := adjudicationSetup(t)
+	srv := keeper.NewMsgServerImpl(k)
+	claimId := fileClaim(t, k, ctx, "tx-1", 80)
+
+	_, err := srv.ReviewClaim(ctx, types.NewMsgReviewClaim(appealAdjuster, claimId))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ReviewClaim(ctx, types.NewMsgReviewClaim(adjuster, claimId))
+	require.NoError(t, err)
+	_, err = srv.ReviewClaim(ctx, types.NewMsgReviewClaim(adjuster, claimId))
+	require.ErrorIs(t, err, types.ErrInvalidClaimStatus)
+
+	// Only the adjuster reviewing a claim requests evidence
+	_, err = srv.RequestEvidence(ctx, types.NewMsgRequestEvidence(appealAdjuster, claimId, "invoice"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	res, err := srv.RequestEvidence(ctx, types.NewMsgRequestEvidence(adjuster, claimId, "invoice"))
+	require.NoError(t, err)
+	require.Equal(t, policyStart.Add(types.DefaultEvidencePeriod).Unix(), res.Deadline)
+	require.Equal(t, contracts.ClaimStatusEvidenceRequested, claimStatus(t, k, ctx, claimId))
+
+	// The evidence provided by the claimant takes the claim back under review
+	update := claimRequest("tx-2", 0)
+	update.TransactionType = transactions.UpdateClaim
+	update.ClaimData.ClaimID = claimId
+	update.ClaimData.Evidence = []transactions.Evidence{{Type: "invoice", Hash: "abc"}}
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(sample.AccAddress(), insuranceData(t, update)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, update)))
+	require.NoError(t, err)
+	claim, _ := k.GetClaim(ctx, claimId)
+	require.Equal(t, contracts.ClaimStatusUnderReview, claim.Status)
+	require.Len(t, claim.Evidence, 1)
+	require.Zero(t, claim.Deadline)
+
+	denied, err := srv.DecideClaim(ctx, types.NewMsgDecideClaim(adjuster, claimId, false, "not covered"))
+	require.NoError(t, err)
+	require.Equal(t, contracts.ClaimStatusDenied, denied.Status)
+
+	// The claimant appeals, and another appeal adjuster approves the claim
+	_, err = srv.AppealClaim(ctx, types.NewMsgAppealClaim(adjuster, claimId, "covered by the terms"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AppealClaim(ctx, types.NewMsgAppealClaim(policyHolder, claimId, "covered by the terms"))
+	require.NoError(t, err)
+	_, err = srv.DecideClaim(ctx, types.NewMsgDecideClaim(adjuster, claimId, true, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	paid, err := srv.DecideClaim(ctx, types.NewMsgDecideClaim(appealAdjuster, claimId, true, ""))
+	require.NoError(t, err)
+	require.Equal(t, contracts.ClaimStatusPaid, paid.Status)
+
+	require.Equal(t, int64(80), bank.Balance(sdk.MustAccAddressFromBech32(policyHolder)).AmountOf(policytypes.DefaultPremiumDenom).Int64())
+	policy, _ := policyKeeper.GetPolicy(ctx, "policy-1")
+	require.Equal(t, int64(920), policy.RemainingCoverage().Int64())
+
+	// Claims are checked against the remaining coverage
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, claimRequest("tx-3", 950))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	byPolicy, err := k.ClaimsByPolicy(ctx, &types.QueryClaimsByPolicyRequest{PolicyId: "policy-1"})
+	require.NoError(t, err)
+	require.Len(t, byPolicy.Claim, 1)
+}
+
+func TestClaimEvidenceDeadline(t *testing.T) {
+	k, _, _, ctx := adjudicationSetup(t)
+	srv := keeper.NewMsgServerImpl(k)
+	claimId := fileClaim(t, k, ctx, "tx-1", 80)
+	require.NoError(t, k.ReviewClaim(ctx, adjuster, claimId))
+	deadline, err := k.RequestEvidence(ctx, adjuster, claimId, "invoice")
+	require.NoError(t, err)
+
+	k.ApplyClaimDeadlines(ctx, time.Unix(deadline-1, 0))
+	require.Equal(t, contracts.ClaimStatusEvidenceRequested, claimStatus(t, k, ctx, claimId))
+
+	k.ApplyClaimDeadlines(ctx, time.Unix(deadline, 0))
+	claim, _ := k.GetClaim(ctx, claimId)
+	require.Equal(t, contracts.ClaimStatusDenied, claim.Status)
+	require.Equal(t, time.Unix(deadline, 0).Add(types.DefaultAppealPeriod).Unix(), claim.Deadline)
+
+	// The appeal period of the denied claim runs out
+	ctx = ctx.WithBlockTime(time.Unix(claim.Deadline, 0))
+	_, err = srv.AppealClaim(ctx, types.NewMsgAppealClaim(policyHolder, claimId, "late invoice"))
+	require.ErrorIs(t, err, types.ErrInvalidClaimStatus)
+}
+
+func TestClaimPayoutRouting(t *testing.T) {
+	k, _, _, ctx := adjudicationSetup(t)
+	claimId := fileClaim(t, k, ctx, "tx-1", 500)
+	require.NoError(t, k.ReviewClaim(ctx, adjuster, claimId))
+
+	// The escrow cannot pay the claim, so the interchain account of the
+	// insurance chain pays it on the finance chain
+	claim, err := k.DecideClaim(ctx, adjuster, claimId, true, "")
+	require.NoError(t, err)
+	require.Equal(t, contracts.ClaimStatusApproved, claim.Status)
+
+	var payment contracts.Payment
+	require.NoError(t, json.Unmarshal(claim.Payment, &payment))
+	require.Equal(t, claimId, payment.TransactionID)
+	require.Equal(t, interchain.ChainAccount(interchain.ChainInsurance).String(), payment.FromAddress)
+	require.Equal(t, policyHolder, payment.ToAddress)
+	require.Equal(t, int64(500), payment.Amount.Int64())
+	require.Equal(t, "PAYMENT", payment.TxType)
+
+	// The payment is queued for the finance chain until a channel opens
+	packet := interchain.NewMessagePacket(contracts.MessageTypeClaimPayout, claim.Payment)
+	require.Contains(t, k.GetQueuedPackets(ctx), interchain.QueuedPacket{Chain: interchain.ChainFinance, Packet: packet})
+	queued := len(k.GetQueuedPackets(ctx))
+	k.SendQueuedPackets(ctx)
+	require.Len(t, k.GetQueuedPackets(ctx), queued)
+
+	// A payment the finance chain holds leaves the claim APPROVED
+	payment.Status = contracts.PaymentStatusHeld
+	response, _ := json.Marshal(payment)
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainFinance, packet, response, nil))
+	require.Equal(t, contracts.ClaimStatusApproved, claimStatus(t, k, ctx, claimId))
+
+	// The claim is PAID once the finance chain finalizes the payment
+	payment.Status = contracts.PaymentStatusFinalized
+	response, _ = json.Marshal(payment)
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainFinance, packet, response, nil))
+	require.Equal(t, contracts.ClaimStatusPaid, claimStatus(t, k, ctx, claimId))
+	err = k.OnRecvCallback(ctx, interchain.ChainFinance, contracts.MessageTypeClaimPayout, response)
+	require.ErrorIs(t, err, types.ErrInvalidClaimStatus)
+}
+
+func TestClaimPayoutFailure(t *testing.T) {
+	k, policyKeeper, _, ctx := adjudicationSetup(t)
+	claimId := fileClaim(t, k, ctx, "tx-1", 500)
+	require.NoError(t, k.ReviewClaim(ctx, adjuster, claimId))
+	remaining := func() int64 {
+		policy, _ := policyKeeper.GetPolicy(ctx, "policy-1")
+		return policy.RemainingCoverage().Int64()
+	}
+	approve := func() (interchain.PacketData, contracts.Payment) {
+		claim, err := k.DecideClaim(ctx, adjuster, claimId, true, "")
+		require.NoError(t, err)
+		require.Equal(t, contracts.ClaimStatusApproved, claim.Status)
+		require.Equal(t, int64(500), remaining())
+		var payment contracts.Payment
+		require.NoError(t, json.Unmarshal(claim.Payment, &payment))
+		return interchain.NewMessagePacket(contracts.MessageTypeClaimPayout, claim.Payment), payment
+	}
+	requireFailed := func(reason string) {
+		claim, _ := k.GetClaim(ctx, claimId)
+		require.Equal(t, contracts.ClaimStatusUnderReview, claim.Status)
+		require.Equal(t, reason, claim.DecisionReason)
+		require.Empty(t, claim.Payment)
+		require.Equal(t, int64(1000), remaining())
+	}
+
+	// The finance chain rejects the payment: the coverage is given back and
+	// the adjuster decides the claim again
+	rejected, payment := approve()
+	require.Equal(t, claimId, payment.TransactionID)
+	payment.Status = contracts.PaymentStatusRejected
+	response, _ := json.Marshal(payment)
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainFinance, rejected, response, nil))
+	requireFailed("payout rejected by the finance chain")
+	err := k.OnRecvCallback(ctx, interchain.ChainFinance, contracts.MessageTypeClaimPayout, response)
+	require.ErrorIs(t, err, types.ErrInvalidClaimStatus)
+
+	// The next payment gets a new ID, so the rejected one cannot fail it
+	errored, payment := approve()
+	require.Equal(t, claimId+"/2", payment.TransactionID)
+	err = k.OnAcknowledgement(ctx, interchain.ChainFinance, rejected, response, nil)
+	require.ErrorIs(t, err, types.ErrInvalidClaim)
+	require.Equal(t, int64(500), remaining())
+
+	// The finance chain fails to process the payment
+	require.NoError(t, k.OnAcknowledgement(ctx, interchain.ChainFinance, errored, nil, interchain.ErrInvalidData))
+	requireFailed(interchain.ErrInvalidData.Error())
+
+	// The payment times out, and is not sent again
+	timedOut, payment := approve()
+	require.Equal(t, claimId+"/3", payment.TransactionID)
+	queued := len(k.GetQueuedPackets(ctx))
+	require.NoError(t, k.OnTimeout(ctx, interchain.ChainFinance, timedOut))
+	requireFailed("payout timed out")
+	require.Len(t, k.GetQueuedPackets(ctx), queued)
+
+	// The last payment is finalized
+	_, payment = approve()
+	payment.Status = contracts.PaymentStatusFinalized
+	response, _ = json.Marshal(payment)
+	require.NoError(t, k.OnRecvCallback(ctx, interchain.ChainFinance, contracts.MessageTypeClaimPayout, response))
+	require.Equal(t, contracts.ClaimStatusPaid, claimStatus(t, k, ctx, claimId))
+	require.Equal(t, int64(500), remaining())
+}
+
+func TestTreatmentClaim(t *testing.T) {
+	k, _, _, ctx := adjudicationSetup(t)
+	provider := sample.AccAddress()
+	submit := func(claimId, patient string, amount int64) error {
+		message, _ := json.Marshal(contracts.TreatmentClaim{
+			ClaimID:    claimId,
+			PatientID:  patient,
+			ProviderID: provider,
+			Amount:     sdkmath.NewInt(amount),
+			DataHash:   "abc",
+		})
+		return k.Contract().ProcessInterchainMessage(ctx, interchain.ChainHealthcare, contracts.MessageTypeTreatmentClaim, message)
+	}
+	decision := func(claim types.Claim) contracts.ClaimDecision {
+		var d contracts.ClaimDecision
+		require.NoError(t, json.Unmarshal(claim.Decision, &d))
+		return d
+	}
+
+	// The treatment is claimed on the active health policy of the patient
+	require.ErrorIs(t, submit("tx-1", sample.AccAddress(), 60), interchain.ErrInvalidData)
+	require.ErrorIs(t, submit("tx-1", policyHolder, 2_000), interchain.ErrInvalidData)
+	require.NoError(t, submit("tx-1", policyHolder, 60))
+	require.ErrorIs(t, submit("tx-1", policyHolder, 60), types.ErrClaimExists)
+
+	claimId := contracts.TreatmentClaimID("tx-1")
+	claim, found := k.GetClaim(ctx, claimId)
+	require.True(t, found)
+	require.Equal(t, "policy-1", claim.PolicyId)
+	require.Equal(t, policyHolder, claim.Claimant)
+	require.Equal(t, contracts.ClaimStatusFiled, claim.Status)
+	require.Equal(t, interchain.ChainHealthcare, claim.SourceChain)
+	require.Equal(t, "abc", claim.Evidence[0].Hash)
+	require.Empty(t, claim.Decision)
+	require.Empty(t, claim.Corroboration)
+
+	// Every decision on the claim is sent back to the healthcare chain
+	require.NoError(t, k.ReviewClaim(ctx, adjuster, claimId))
+	claim, err := k.DecideClaim(ctx, adjuster, claimId, false, "not covered")
+	require.NoError(t, err)
+	require.Equal(t, contracts.ClaimDecision{ClaimID: "tx-1", Status: contracts.ClaimStatusDenied, Reason: "not covered"}, decision(claim))
+
+	require.NoError(t, k.AppealClaim(ctx, policyHolder, claimId, "covered by the terms"))
+	claim, _ = k.GetClaim(ctx, claimId)
+	require.Equal(t, contracts.ClaimStatusDenied, decision(claim).Status)
+	claim, err = k.DecideClaim(ctx, appealAdjuster, claimId, true, "")
+	require.NoError(t, err)
+	require.Equal(t, contracts.ClaimStatusPaid, decision(claim).Status)
+
+	// The decisions are queued for the healthcare chain until a channel opens
+	var sent []string
+	for _, queued := range k.GetQueuedPackets(ctx) {
+		require.Equal(t, interchain.ChainHealthcare, queued.Chain)
+		require.Equal(t, interchain.PacketKindCallback, queued.Packet.Kind)
+		require.Equal(t, contracts.MessageTypeTreatmentClaim, queued.Packet.MessageType)
+		var d contracts.ClaimDecision
+		require.NoError(t, json.Unmarshal(queued.Packet.Payload, &d))
+		sent = append(sent, d.Status)
+	}
+	require.ElementsMatch(t, []string{contracts.ClaimStatusDenied, contracts.ClaimStatusPaid}, sent)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"insurance/x/insurance/types"
+)
+
+// GetAuditEntryCount get the total number of auditEntry
+func (k Keeper) GetAuditEntryCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.AuditEntryCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAuditEntryCount set the total number of auditEntry
+func (k Keeper) SetAuditEntryCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.AuditEntryCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendAuditEntry appends an entry to the audit trail of its subject at the current block time
+func (k Keeper) AppendAuditEntry(ctx context.Context, subjectId, action, detail string) uint64 {
+	count := k.GetAuditEntryCount(ctx)
+	k.SetAuditEntry(ctx, types.AuditEntry{
+		Id:        count,
+		SubjectId: subjectId,
+		Action:    action,
+		Detail:    detail,
+		Timestamp: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	})
+	k.SetAuditEntryCount(ctx, count+1)
+	return count
+}
+
+// SetAuditEntry set a specific auditEntry in the store
+func (k Keeper) SetAuditEntry(ctx context.Context, auditEntry types.AuditEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuditEntryKeyPrefix))
+	b := k.cdc.MustMarshal(&auditEntry)
+	store.Set(types.AuditEntryKey(auditEntry.SubjectId, auditEntry.Id), b)
+}
+
+// GetAuditTrail returns the audit entries of a subject in the order they were appended
+func (k Keeper) GetAuditTrail(ctx context.Context, subjectId string) (list []types.AuditEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.AuditEntryKeyPrefix), types.AuditTrailKey(subjectId)...))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuditEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllAuditEntry returns all auditEntry
+func (k Keeper) GetAllAuditEntry(ctx context.Context) (list []types.AuditEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuditEntryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuditEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"insurance/contracts"
+	"insurance/x/insurance/types"
+)
+
+// SetClaim set a specific claim in the store from its index, indexes it by
+// policy, claimant and evidence hash, queues the claims awaiting evidence by
+// their deadline, and indexes the flagged claims
+func (k Keeper) SetClaim(ctx context.Context, claim types.Claim) {
+	if previous, found := k.GetClaim(ctx, claim.ClaimId); found {
+		k.unqueueClaim(ctx, previous)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimKeyPrefix))
+	b := k.cdc.MustMarshal(&claim)
+	store.Set(types.ClaimKey(
+		claim.ClaimId,
+	), b)
+
+	claimId := []byte(claim.ClaimId)
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimPolicyIndexPrefix)).
+		Set(types.ClaimPolicyIndexKey(claim.PolicyId, claim.ClaimId), claimId)
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimClaimantIndexPrefix)).
+		Set(types.ClaimClaimantIndexKey(claim.Claimant, claim.FiledAt, claim.ClaimId), claimId)
+	evidenceIndex := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimEvidenceIndexPrefix))
+	for _, evidence := range claim.Evidence {
+		if evidence.Hash != "" {
+			evidenceIndex.Set(types.ClaimEvidenceIndexKey(evidence.Hash, claim.ClaimId), claimId)
+		}
+	}
+	if claim.Status == contracts.ClaimStatusEvidenceRequested {
+		queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimDeadlineQueuePrefix))
+		queue.Set(types.ClaimDeadlineQueueKey(claim.Deadline, claim.ClaimId), claimId)
+	}
+	if claim.Status == contracts.ClaimStatusFlagged {
+		prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimFlaggedIndexPrefix)).
+			Set(types.ClaimKey(claim.ClaimId), claimId)
+	}
+}
+
+// GetClaim returns a claim from its index
+func (k Keeper) GetClaim(
+	ctx context.Context,
+	claimId string,
+) (val types.Claim, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimKeyPrefix))
+
+	b := store.Get(types.ClaimKey(
+		claimId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllClaim returns all claim
+func (k Keeper) GetAllClaim(ctx context.Context) (list []types.Claim) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Claim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// unqueueClaim removes a claim from the deadline queue and the flagged
+// claims. The policy, claimant and filing time of a claim never change, and
+// its evidence is only added to, so its other index entries stay.
+func (k Keeper) unqueueClaim(ctx context.Context, claim types.Claim) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimDeadlineQueuePrefix)).
+		Delete(types.ClaimDeadlineQueueKey(claim.Deadline, claim.ClaimId))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimFlaggedIndexPrefix)).
+		Delete(types.ClaimKey(claim.ClaimId))
+}
+
+// overdueClaims returns the IDs of the claims whose evidence was due at or
+// before now
+func (k Keeper) overdueClaims(ctx context.Context, now int64) (claimIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimDeadlineQueuePrefix))
+	iterator := queue.Iterator(nil, types.ClaimTimeKey(now+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		claimIds = append(claimIds, string(iterator.Value()))
+	}
+	return claimIds
+}
@@ -0,0 +1,544 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
+	"insurance/x/insurance/types"
+	policytypes "insurance/x/policy/types"
+)
+
+// ContractRecord kinds kept by the insurance contract
+const (
+	RecordKindRegulations = "regulations"
+)
+
+const (
+	RiskLevelLow    = "low"
+	RiskLevelMedium = "medium"
+	RiskLevelHigh   = "high"
+
+	// complianceSubject is the audit trail subject of compliance checks
+	complianceSubject = "compliance"
+	// currentRegulations is the record id of the regulations in force
+	currentRegulations = "current"
+)
+
+// RiskAttributeScore is the risk attribute the rating models read the risk
+// score under
+const RiskAttributeScore = "risk_score"
+
+// DefaultBasePremium is the premium of a low risk policy until a rating model
+// is adopted
+var DefaultBasePremium = sdkmath.NewInt(100)
+
+// Risk scores from which policies are assessed at a medium and a high risk
+var (
+	mediumRiskScore = sdkmath.LegacyNewDecWithPrec(3, 1)
+	highRiskScore   = sdkmath.LegacyNewDecWithPrec(7, 1)
+)
+
+// RiskFactors are the inputs of a risk assessment
+type RiskFactors struct {
+	RiskScore  sdkmath.LegacyDec `json:"risk_score"`
+	Attributes map[string]string `json:"attributes"` // Risk attributes rated by the rating model
+}
+
+// UnmarshalJSON reads the risk score as a decimal from a JSON number or
+// string, so that no float reaches the state. A missing score is zero.
+func (f *RiskFactors) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		RiskScore  json.RawMessage   `json:"risk_score"`
+		Attributes map[string]string `json:"attributes"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	f.Attributes = raw.Attributes
+	f.RiskScore = sdkmath.LegacyZeroDec()
+	if len(raw.RiskScore) == 0 || string(raw.RiskScore) == "null" {
+		return nil
+	}
+	score, err := sdkmath.LegacyNewDecFromStr(strings.Trim(string(raw.RiskScore), `"`))
+	if err != nil {
+		return err
+	}
+	f.RiskScore = score
+	return nil
+}
+
+// attributes returns the risk attributes of the factors, the risk score included
+func (f RiskFactors) attributes() map[string]string {
+	attributes := make(map[string]string, len(f.Attributes)+1)
+	for key, value := range f.Attributes {
+		attributes[key] = value
+	}
+	attributes[RiskAttributeScore] = f.RiskScore.String()
+	return attributes
+}
+
+// Regulations are the rules policies are checked against
+type Regulations struct {
+	RestrictedPolicyTypes []string `json:"restricted_policy_types"`
+}
+
+// newContract builds the insurance contract on top of the module state. The
+// managers share the keeper being built, so they reach the contract it is
+// given.
+func newContract(k *Keeper) *contracts.InsuranceContract {
+	return contracts.NewInsuranceContract(policyManager{k}, claimProcessor{k}, riskAssessor{k}, complianceManager{k}, fraudDetector{k})
+}
+
+func unmarshalPolicy(policy []byte) (contracts.Policy, error) {
+	var p contracts.Policy
+	if err := json.Unmarshal(policy, &p); err != nil {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "invalid policy format")
+	}
+	return p, nil
+}
+
+func unmarshalClaim(claim []byte) (contracts.Claim, error) {
+	var c contracts.Claim
+	if err := json.Unmarshal(claim, &c); err != nil {
+		return c, errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim format")
+	}
+	return c, nil
+}
+
+// policyManager keeps policies in the policy module, which runs their
+// lifecycle and escrows their premiums
+type policyManager struct {
+	k *Keeper
+}
+
+// CreatePolicy stores a new policy. Parametric policies are indexed by the
+// metric of their trigger.
+func (m policyManager) CreatePolicy(ctx sdk.Context, policy []byte) error {
+	p, err := unmarshalPolicy(policy)
+	if err != nil {
+		return err
+	}
+	created := m.toPolicy(ctx, p)
+	if err := m.k.policyKeeper.CreatePolicy(ctx, created); err != nil {
+		return err
+	}
+	m.k.indexParametricPolicy(ctx, nil, created)
+	return nil
+}
+
+func (m policyManager) UpdatePolicy(ctx sdk.Context, policy []byte) error {
+	p, err := unmarshalPolicy(policy)
+	if err != nil {
+		return err
+	}
+	previous, found := m.k.policyKeeper.GetPolicy(ctx, p.PolicyID)
+	if !found {
+		return errorsmod.Wrap(policytypes.ErrPolicyNotFound, p.PolicyID)
+	}
+	updated := m.toPolicy(ctx, p)
+	if err := m.k.policyKeeper.UpdatePolicy(ctx, updated); err != nil {
+		return err
+	}
+	m.k.indexParametricPolicy(ctx, &previous, updated)
+	return nil
+}
+
+// CancelPolicy cancels a policy on behalf of the insurer. Its holder is
+// refunded the premium for the time left on the policy.
+func (m policyManager) CancelPolicy(ctx sdk.Context, policyID string) error {
+	_, err := m.k.policyKeeper.CancelPolicy(ctx, policyID)
+	return err
+}
+
+func (m policyManager) GetPolicy(ctx sdk.Context, policyID string) ([]byte, error) {
+	policy, found := m.k.policyKeeper.GetPolicy(ctx, policyID)
+	if !found {
+		return nil, errorsmod.Wrap(policytypes.ErrPolicyNotFound, policyID)
+	}
+	return json.Marshal(fromPolicy(policy))
+}
+
+// GetActivePolicies returns the JSON encoded active policies of a holder
+func (m policyManager) GetActivePolicies(ctx sdk.Context, holderID string) ([]byte, error) {
+	active := []contracts.Policy{}
+	for _, policy := range m.k.policyKeeper.GetHolderPolicies(ctx, holderID) {
+		if policy.Status == policytypes.PolicyStatusActive {
+			active = append(active, fromPolicy(policy))
+		}
+	}
+	return json.Marshal(active)
+}
+
+func (m policyManager) ValidatePolicy(ctx sdk.Context, policy []byte) error {
+	p, err := unmarshalPolicy(policy)
+	if err != nil {
+		return err
+	}
+	if p.PolicyID == "" || p.HolderID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "policy and holder IDs are required")
+	}
+	return nil
+}
+
+// toPolicy converts a contract policy to a policy of the policy module, with
+// its premium in the premium denom of the module
+func (m policyManager) toPolicy(ctx sdk.Context, p contracts.Policy) policytypes.Policy {
+	terms := make([]policytypes.Term, 0, len(p.Terms))
+	for key, value := range p.Terms {
+		terms = append(terms, policytypes.Term{Key: key, Value: value})
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].Key < terms[j].Key })
+
+	return policytypes.Policy{
+		PolicyId:   p.PolicyID,
+		Holder:     p.HolderID,
+		PolicyType: p.Type,
+		Coverage:   p.Coverage,
+		Premium:    sdk.Coin{Denom: m.k.policyKeeper.GetParams(ctx).PremiumDenom, Amount: p.Premium},
+		StartDate:  p.StartDate.Unix(),
+		EndDate:    p.EndDate.Unix(),
+		RiskLevel:  p.RiskLevel,
+		Terms:      terms,
+
+		RatingModelVersion: p.RatingModelVersion,
+	}
+}
+
+// fromPolicy converts a policy of the policy module to a contract policy
+func fromPolicy(policy policytypes.Policy) contracts.Policy {
+	terms := make(map[string]string, len(policy.Terms))
+	for _, term := range policy.Terms {
+		terms[term.Key] = term.Value
+	}
+
+	return contracts.Policy{
+		PolicyID:     policy.PolicyId,
+		HolderID:     policy.Holder,
+		Type:         policy.PolicyType,
+		Coverage:     policy.Coverage,
+		Premium:      policy.Premium.Amount,
+		Status:       policy.Status,
+		StartDate:    time.Unix(policy.StartDate, 0).UTC(),
+		EndDate:      time.Unix(policy.EndDate, 0).UTC(),
+		RiskLevel:    policy.RiskLevel,
+		Terms:        terms,
+		LastModified: time.Unix(policy.UpdatedAt, 0).UTC(),
+
+		RatingModelVersion: policy.RatingModelVersion,
+	}
+}
+
+// claimProcessor files claims against their policy and keeps them in the
+// claim store, where adjusters decide them
+type claimProcessor struct {
+	k *Keeper
+}
+
+// SubmitClaim files a claim on behalf of its claimant, the holder of its
+// policy
+func (p claimProcessor) SubmitClaim(ctx sdk.Context, claim []byte) error {
+	c, err := unmarshalClaim(claim)
+	if err != nil {
+		return err
+	}
+	if _, found := p.k.GetClaim(ctx, c.ClaimID); found {
+		return errorsmod.Wrap(types.ErrClaimExists, c.ClaimID)
+	}
+	policy, found := p.k.policyKeeper.GetPolicy(ctx, c.PolicyID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, c.PolicyID)
+	}
+
+	now := ctx.BlockTime().Unix()
+	filed := types.Claim{
+		ClaimId:     c.ClaimID,
+		PolicyId:    c.PolicyID,
+		Claimant:    policy.Holder,
+		ClaimType:   c.Type,
+		Amount:      c.Amount,
+		Description: c.Description,
+		Evidence:    toClaimEvidence(c.Evidence, now),
+		Status:      contracts.ClaimStatusFiled,
+		FiledAt:     now,
+		UpdatedAt:   now,
+		SourceChain: c.SourceChain,
+		SourceId:    c.SourceID,
+	}
+	p.k.SetClaim(ctx, filed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimFiled,
+			sdk.NewAttribute(types.AttributeKeyClaimId, filed.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyPolicyId, filed.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyClaimant, filed.Claimant),
+			sdk.NewAttribute(types.AttributeKeyAmount, filed.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// ProcessClaim files a claim of the holder of an active policy whose
+// remaining coverage covers its amount
+func (p claimProcessor) ProcessClaim(ctx sdk.Context, claim []byte) error {
+	c, err := unmarshalClaim(claim)
+	if err != nil {
+		return err
+	}
+
+	policy, found := p.k.policyKeeper.GetPolicy(ctx, c.PolicyID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, c.PolicyID)
+	}
+	if policy.Status != policytypes.PolicyStatusActive {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "policy %s is not active", c.PolicyID)
+	}
+	if c.HolderID == "" || c.HolderID != policy.Holder {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "claims on policy %s are filed by its holder", c.PolicyID)
+	}
+	if c.Amount.GT(policy.RemainingCoverage()) {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "claim amount exceeds the remaining coverage of policy %s", c.PolicyID)
+	}
+
+	return p.SubmitClaim(ctx, claim)
+}
+
+// UpdateClaim adds evidence to a claim not decided yet. Providing evidence
+// for a claim awaiting it takes the claim back under review.
+func (p claimProcessor) UpdateClaim(ctx sdk.Context, claim []byte) error {
+	c, err := unmarshalClaim(claim)
+	if err != nil {
+		return err
+	}
+	stored, found := p.k.GetClaim(ctx, c.ClaimID)
+	if !found {
+		return errorsmod.Wrap(types.ErrClaimNotFound, c.ClaimID)
+	}
+	if len(c.Evidence) == 0 {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "no evidence for claim %s", c.ClaimID)
+	}
+
+	switch stored.Status {
+	case contracts.ClaimStatusFiled, contracts.ClaimStatusFlagged, contracts.ClaimStatusUnderReview:
+	case contracts.ClaimStatusEvidenceRequested:
+		stored.Status = contracts.ClaimStatusUnderReview
+		stored.Deadline = 0
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s is %s", c.ClaimID, stored.Status)
+	}
+
+	stored.Evidence = append(stored.Evidence, toClaimEvidence(c.Evidence, ctx.BlockTime().Unix())...)
+	p.k.setClaimStatus(ctx, &stored, stored.Status)
+	return nil
+}
+
+// UpdateClaimStatus records the outcome of the payment of an approved claim
+// paid through the finance chain. Only APPROVED claims become PAID this way;
+// the other statuses follow the decisions of the adjusters.
+func (p claimProcessor) UpdateClaimStatus(ctx sdk.Context, claimID string, status string) error {
+	claim, err := p.k.claimInStatus(ctx, claimID, contracts.ClaimStatusApproved)
+	if err != nil {
+		return err
+	}
+	if status != contracts.ClaimStatusPaid {
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "approved claim %s cannot become %s", claimID, status)
+	}
+
+	p.k.setClaimStatus(ctx, &claim, status)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimPaid,
+			sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyClaimant, claim.Claimant),
+			sdk.NewAttribute(types.AttributeKeyAmount, claim.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// FailPayout takes an approved claim back to adjudication when the finance
+// chain rejected the payment of its payout
+func (p claimProcessor) FailPayout(ctx sdk.Context, claimID, paymentID, reason string) error {
+	return p.k.failPayout(ctx, claimID, paymentID, reason)
+}
+
+func (p claimProcessor) ValidateClaim(ctx sdk.Context, claim []byte) error {
+	c, err := unmarshalClaim(claim)
+	if err != nil {
+		return err
+	}
+	if c.ClaimID == "" || c.PolicyID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "claim and policy IDs are required")
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid claim amount")
+	}
+	return nil
+}
+
+// toClaimEvidence converts contract evidence to claim evidence submitted at now
+func toClaimEvidence(evidence []contracts.Evidence, now int64) []types.ClaimEvidence {
+	converted := make([]types.ClaimEvidence, 0, len(evidence))
+	for _, e := range evidence {
+		converted = append(converted, types.ClaimEvidence{
+			Type:        e.Type,
+			Description: e.Description,
+			Hash:        e.Hash,
+			IpfsLink:    e.IPFSLink,
+			SubmittedAt: now,
+		})
+	}
+	return converted
+}
+
+// riskAssessor prices policies with the rating model in force
+type riskAssessor struct {
+	k *Keeper
+}
+
+func (r riskAssessor) AssessRisk(ctx sdk.Context, data []byte) (string, error) {
+	factors, err := unmarshalRiskFactors(data)
+	if err != nil {
+		return "", err
+	}
+	return riskLevel(factors.RiskScore), nil
+}
+
+func riskLevel(score sdkmath.LegacyDec) string {
+	switch {
+	case score.GTE(highRiskScore):
+		return RiskLevelHigh
+	case score.GTE(mediumRiskScore):
+		return RiskLevelMedium
+	default:
+		return RiskLevelLow
+	}
+}
+
+func (r riskAssessor) CalculatePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, error) {
+	premium, _, err := r.QuotePremium(ctx, policyType, riskFactors)
+	return premium, err
+}
+
+func (r riskAssessor) QuotePremium(ctx sdk.Context, policyType string, riskFactors []byte) (sdkmath.Int, uint64, error) {
+	factors, err := unmarshalRiskFactors(riskFactors)
+	if err != nil {
+		return sdkmath.Int{}, 0, err
+	}
+	return r.k.PricePolicy(ctx, policyType, factors, 0)
+}
+
+// EvaluateTrigger pays out the trigger payout of a parametric policy when the
+// observation of its metric, made during the policy period, meets its trigger
+func (riskAssessor) EvaluateTrigger(ctx sdk.Context, policy []byte, observation []byte) (sdkmath.Int, error) {
+	p, err := unmarshalPolicy(policy)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	var o contracts.Observation
+	if err := json.Unmarshal(observation, &o); err != nil || o.Value.IsNil() {
+		return sdkmath.Int{}, errorsmod.Wrap(interchain.ErrInvalidData, "invalid observation format")
+	}
+	if p.Type != contracts.PolicyTypeParametric {
+		return sdkmath.Int{}, errorsmod.Wrapf(interchain.ErrInvalidData, "policy %s is not parametric", p.PolicyID)
+	}
+	trigger, err := contracts.ParseTrigger(p.Terms)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if o.Metric != trigger.Metric || o.ObservedAt.Before(p.StartDate) || !o.ObservedAt.Before(p.EndDate) || !trigger.Triggered(o.Value) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return trigger.Payout, nil
+}
+
+// UpdateRiskModel adopts a JSON rating model as the next version. Models
+// adopted through the contract record no authority.
+func (r riskAssessor) UpdateRiskModel(ctx sdk.Context, model []byte) error {
+	var m types.RatingModel
+	if err := json.Unmarshal(model, &m); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid rating model format")
+	}
+	m.Authority = ""
+	_, err := r.k.AddRatingModel(ctx, m)
+	return err
+}
+
+func (riskAssessor) ValidateRiskFactors(ctx sdk.Context, factors []byte) error {
+	_, err := unmarshalRiskFactors(factors)
+	return err
+}
+
+func unmarshalRiskFactors(factors []byte) (RiskFactors, error) {
+	var f RiskFactors
+	if err := json.Unmarshal(factors, &f); err != nil {
+		return f, errorsmod.Wrap(interchain.ErrInvalidData, "invalid risk factors format")
+	}
+	if f.RiskScore.IsNegative() || f.RiskScore.GT(sdkmath.LegacyOneDec()) {
+		return f, errorsmod.Wrap(interchain.ErrInvalidData, "risk score must be between 0 and 1")
+	}
+	return f, nil
+}
+
+// complianceManager checks policies against the regulations in force
+type complianceManager struct {
+	k *Keeper
+}
+
+func (m complianceManager) ValidateCompliance(ctx sdk.Context, data []byte) error {
+	p, err := unmarshalPolicy(data)
+	if err != nil {
+		return err
+	}
+
+	if record, found := m.k.GetContractRecord(ctx, RecordKindRegulations, currentRegulations); found {
+		var regulations Regulations
+		if err := json.Unmarshal(record.Data, &regulations); err != nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "invalid regulations")
+		}
+		for _, restricted := range regulations.RestrictedPolicyTypes {
+			if p.Type == restricted {
+				return errorsmod.Wrapf(interchain.ErrInvalidData, "policy type %s is restricted", p.Type)
+			}
+		}
+	}
+
+	return m.LogCompliance(ctx, p.PolicyID, true)
+}
+
+func (m complianceManager) LogCompliance(ctx sdk.Context, action string, result bool) error {
+	m.k.AppendAuditEntry(ctx, complianceSubject, action, fmt.Sprintf("passed=%t", result))
+	return nil
+}
+
+func (m complianceManager) UpdateRegulations(ctx sdk.Context, regulations []byte) error {
+	var r Regulations
+	if err := json.Unmarshal(regulations, &r); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid regulations format")
+	}
+	m.k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:      RecordKindRegulations,
+		RecordId:  currentRegulations,
+		Data:      regulations,
+		UpdatedAt: ctx.BlockTime().Unix(),
+	})
+	return nil
+}
+
+func (m complianceManager) GetComplianceReport(ctx sdk.Context, reportType string) ([]byte, error) {
+	trail := []types.AuditEntry{}
+	for _, entry := range m.k.GetAuditTrail(ctx, complianceSubject) {
+		if reportType == "" || entry.Action == reportType {
+			trail = append(trail, entry)
+		}
+	}
+	return json.Marshal(trail)
+}
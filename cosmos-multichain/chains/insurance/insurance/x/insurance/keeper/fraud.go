@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
+	"insurance/x/insurance/types"
+	policytypes "insurance/x/policy/types"
+)
+
+// Weights of the fraud signals in the fraud score of a claim
+const (
+	reusedEvidenceWeight = 60
+	claimVelocityWeight  = 40
+	missingRecordWeight  = 60
+)
+
+// fraudDetector scores claims for fraud and routes the suspicious ones to
+// manual review
+type fraudDetector struct {
+	k *Keeper
+}
+
+// ScoreClaim raises the fraud signals of a claim: evidence already supporting
+// other claims, and a claimant filing more claims than the velocity limit
+// within the velocity window. Reused evidence is only signaled on the claim
+// it was submitted with last, not on the claims it supported before.
+func (d fraudDetector) ScoreClaim(ctx sdk.Context, claim []byte) error {
+	c, err := unmarshalClaim(claim)
+	if err != nil {
+		return err
+	}
+	scored, found := d.k.GetClaim(ctx, c.ClaimID)
+	if !found {
+		return errorsmod.Wrap(types.ErrClaimNotFound, c.ClaimID)
+	}
+	params := d.k.GetParams(ctx)
+
+	for _, shared := range d.k.claimsSharingEvidence(ctx, scored) {
+		d.k.raiseFraudSignal(ctx, &scored, types.FraudSignal{
+			Kind:           contracts.FraudSignalReusedEvidence,
+			Detail:         "evidence " + shared.hash + " also supports claim " + shared.claimId,
+			Weight:         reusedEvidenceWeight,
+			RelatedClaimId: shared.claimId,
+		})
+	}
+
+	since := ctx.BlockTime().Add(-params.FraudVelocityWindow).Unix()
+	if since < 0 {
+		since = 0
+	}
+	if filed := d.k.countClaimsSince(ctx, scored.Claimant, since); filed > params.FraudVelocityLimit {
+		d.k.raiseFraudSignal(ctx, &scored, types.FraudSignal{
+			Kind:   contracts.FraudSignalClaimVelocity,
+			Detail: fmt.Sprintf("%d claims filed by %s within %s", filed, scored.Claimant, params.FraudVelocityWindow),
+			Weight: claimVelocityWeight,
+		})
+	}
+	d.k.setScoredClaim(ctx, &scored)
+	return nil
+}
+
+// AwaitCorroboration queues the corroboration query of a claim for the chain
+// of record of its policy type, and keeps it until the chain answers it in
+// the acknowledgement of the query
+func (d fraudDetector) AwaitCorroboration(ctx sdk.Context, claimID, chain string, query []byte) error {
+	claim, found := d.k.GetClaim(ctx, claimID)
+	if !found {
+		return errorsmod.Wrap(types.ErrClaimNotFound, claimID)
+	}
+	if err := d.k.QueuePacket(ctx, chain, interchain.NewMessagePacket(contracts.MessageTypeClaimCorroboration, query)); err != nil {
+		return err
+	}
+	claim.Corroboration = contracts.CorroborationPending
+	claim.CorroborationQuery = query
+	d.k.SetClaim(ctx, claim)
+	return nil
+}
+
+// RecordCorroboration records the answer of the chain of record of the
+// policy type of a claim awaiting corroboration. A claim without any
+// corroborating record signals fraud.
+func (d fraudDetector) RecordCorroboration(ctx sdk.Context, sourceChain string, result []byte) error {
+	var r contracts.CorroborationResult
+	if err := json.Unmarshal(result, &r); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid corroboration result format")
+	}
+	claim, found := d.k.GetClaim(ctx, r.ClaimID)
+	if !found {
+		return errorsmod.Wrap(types.ErrClaimNotFound, r.ClaimID)
+	}
+	if claim.Corroboration != contracts.CorroborationPending {
+		return errorsmod.Wrapf(types.ErrInvalidClaimStatus, "claim %s is not awaiting corroboration", r.ClaimID)
+	}
+	policy, found := d.k.policyKeeper.GetPolicy(ctx, claim.PolicyId)
+	if !found {
+		return errorsmod.Wrap(policytypes.ErrPolicyNotFound, claim.PolicyId)
+	}
+	if chain := contracts.RecordChain(policy.PolicyType); chain != sourceChain {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "claim %s is corroborated by %s", r.ClaimID, chain)
+	}
+
+	claim.Corroboration = contracts.CorroborationCorroborated
+	if len(r.Records) == 0 {
+		claim.Corroboration = contracts.CorroborationUncorroborated
+		d.k.raiseFraudSignal(ctx, &claim, types.FraudSignal{
+			Kind:   contracts.FraudSignalMissingRecord,
+			Detail: "no record of the claim on " + sourceChain,
+			Weight: missingRecordWeight,
+		})
+	}
+	d.k.setScoredClaim(ctx, &claim)
+	return nil
+}
+
+// raiseFraudSignal adds a fraud signal to a claim, unless the claim already
+// has a signal of its kind about the same claim. It reports whether the
+// signal was added.
+func (k Keeper) raiseFraudSignal(ctx context.Context, claim *types.Claim, signal types.FraudSignal) bool {
+	for _, raised := range claim.FraudSignals {
+		if raised.Kind == signal.Kind && raised.RelatedClaimId == signal.RelatedClaimId {
+			return false
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signal.DetectedAt = sdkCtx.BlockTime().Unix()
+	claim.FraudSignals = append(claim.FraudSignals, signal)
+	claim.FraudScore += signal.Weight
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClaimFraudSignal,
+			sdk.NewAttribute(types.AttributeKeyClaimId, claim.ClaimId),
+			sdk.NewAttribute(types.AttributeKeyKind, signal.Kind),
+			sdk.NewAttribute(types.AttributeKeyScore, strconv.FormatUint(claim.FraudScore, 10)),
+		),
+	)
+	return true
+}
+
+// setScoredClaim stores a scored claim. A FILED claim whose fraud score
+// reaches the review threshold is FLAGGED for manual review; the claims
+// already in adjudication keep their status and show their signals to
+// their adjuster.
+func (k Keeper) setScoredClaim(ctx context.Context, claim *types.Claim) {
+	if claim.Status == contracts.ClaimStatusFiled && claim.FraudScore >= k.GetParams(ctx).FraudReviewThreshold {
+		k.setClaimStatus(ctx, claim, contracts.ClaimStatusFlagged)
+		return
+	}
+	k.SetClaim(ctx, *claim)
+}
+
+// sharedEvidence is an evidence hash of a claim also supporting another claim
+type sharedEvidence struct {
+	hash    string
+	claimId string
+}
+
+// claimsSharingEvidence returns the other claims supported by the evidence
+// of a claim
+func (k Keeper) claimsSharingEvidence(ctx context.Context, claim types.Claim) (shared []sharedEvidence) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	index := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimEvidenceIndexPrefix))
+
+	seen := make(map[string]bool)
+	for _, evidence := range claim.Evidence {
+		if evidence.Hash == "" || seen[evidence.Hash] {
+			continue
+		}
+		seen[evidence.Hash] = true
+
+		iterator := prefix.NewStore(index, types.ClaimKey(evidence.Hash)).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			claimId := string(iterator.Value())
+			// Skip the entries of longer hashes sharing the prefix of the hash
+			if claimId != claim.ClaimId && bytes.Equal(iterator.Key(), types.ClaimKey(claimId)) {
+				shared = append(shared, sharedEvidence{hash: evidence.Hash, claimId: claimId})
+			}
+		}
+		iterator.Close()
+	}
+	return shared
+}
+
+// countClaimsSince returns the number of claims a claimant filed at or after
+// since
+func (k Keeper) countClaimsSince(ctx context.Context, claimant string, since int64) (count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	index := prefix.NewStore(prefix.NewStore(storeAdapter, types.KeyPrefix(types.ClaimClaimantIndexPrefix)), types.ClaimKey(claimant))
+	iterator := index.Iterator(types.ClaimTimeKey(since), nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
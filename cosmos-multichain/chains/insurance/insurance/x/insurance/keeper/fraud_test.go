@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+)
+
+func TestClaimFraudSignals(t *testing.T) {
+	k, _, _, ctx := adjudicationSetup(t)
+	srv := keeper.NewMsgServerImpl(k)
+	file := func(id string, hash string) types.Claim {
+		req := claimRequest(id, 10)
+		req.ClaimData.Evidence = []transactions.Evidence{{Type: "invoice", Hash: hash}}
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, req)))
+		require.NoError(t, err)
+		claim, found := k.GetClaim(ctx, "claim-"+id)
+		require.True(t, found)
+		return claim
+	}
+	flagged := func() (ids []string) {
+		res, err := k.FlaggedClaims(ctx, &types.QueryFlaggedClaimsRequest{})
+		require.NoError(t, err)
+		for _, claim := range res.Claim {
+			ids = append(ids, claim.ClaimId)
+		}
+		return ids
+	}
+
+	// Health claims are corroborated by the healthcare chain, which answers
+	// the query in its acknowledgement
+	first := file("tx-1", "invoice-1")
+	require.Equal(t, contracts.ClaimStatusFiled, first.Status)
+	require.Zero(t, first.FraudScore)
+	require.Equal(t, contracts.CorroborationPending, first.Corroboration)
+	var query contracts.CorroborationQuery
+	require.NoError(t, json.Unmarshal(first.CorroborationQuery, &query))
+	require.Equal(t, contracts.CorroborationQuery{
+		ClaimID:   "claim-tx-1",
+		Subject:   policyHolder,
+		ClaimType: "",
+		Evidence:  []string{"invoice-1"},
+		FiledAt:   policyStart.UTC(),
+	}, query)
+	queryPacket := func(claimId string) interchain.PacketData {
+		claim, _ := k.GetClaim(ctx, claimId)
+		packet := interchain.NewMessagePacket(contracts.MessageTypeClaimCorroboration, claim.CorroborationQuery)
+		require.Contains(t, k.GetQueuedPackets(ctx), interchain.QueuedPacket{Chain: interchain.ChainHealthcare, Packet: packet})
+		return packet
+	}
+	corroborate := func(chain, claimId string, records ...string) error {
+		result, err := json.Marshal(contracts.CorroborationResult{ClaimID: claimId, Records: records})
+		require.NoError(t, err)
+		return k.OnAcknowledgement(ctx, chain, queryPacket(claimId), result, nil)
+	}
+
+	// Reused evidence flags the later claim for manual review, not the
+	// claim it supported first
+	second := file("tx-2", "invoice-1")
+	require.Equal(t, contracts.ClaimStatusFlagged, second.Status)
+	require.Equal(t, uint64(60), second.FraudScore)
+	require.Equal(t, contracts.FraudSignalReusedEvidence, second.FraudSignals[0].Kind)
+	require.Equal(t, "claim-tx-1", second.FraudSignals[0].RelatedClaimId)
+	first, _ = k.GetClaim(ctx, "claim-tx-1")
+	require.Equal(t, contracts.ClaimStatusFiled, first.Status)
+	require.Empty(t, first.FraudSignals)
+	require.Equal(t, []string{"claim-tx-2"}, flagged())
+
+	params := k.GetParams(ctx)
+	params.AppealAdjusters = []string{appealAdjuster}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err := srv.ReviewClaim(ctx, types.NewMsgReviewClaim(adjuster, "claim-tx-2"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ReviewClaim(ctx, types.NewMsgReviewClaim(appealAdjuster, "claim-tx-2"))
+	require.NoError(t, err)
+	require.Empty(t, flagged())
+
+	// Claims beyond the velocity limit signal fraud, without reaching the
+	// review threshold alone
+	file("tx-3", "invoice-3")
+	fourth := file("tx-4", "invoice-4")
+	require.Equal(t, contracts.ClaimStatusFiled, fourth.Status)
+	require.Equal(t, uint64(40), fourth.FraudScore)
+	require.Equal(t, contracts.FraudSignalClaimVelocity, fourth.FraudSignals[0].Kind)
+
+	fifth := file("tx-5", "invoice-4")
+	require.Equal(t, contracts.ClaimStatusFlagged, fifth.Status)
+	require.Equal(t, uint64(100), fifth.FraudScore)
+	require.Equal(t, contracts.ClaimStatusFiled, claimStatus(t, k, ctx, "claim-tx-4"))
+	require.Equal(t, []string{"claim-tx-5"}, flagged())
+
+	// The healthcare chain finds no record of the fourth claim
+	require.ErrorIs(t, corroborate(interchain.ChainRealEstate, "claim-tx-4"), interchain.ErrInvalidData)
+	require.NoError(t, corroborate(interchain.ChainHealthcare, "claim-tx-4"))
+	fourth, _ = k.GetClaim(ctx, "claim-tx-4")
+	require.Equal(t, contracts.CorroborationUncorroborated, fourth.Corroboration)
+	require.Equal(t, uint64(100), fourth.FraudScore)
+	require.Equal(t, contracts.FraudSignalMissingRecord, fourth.FraudSignals[1].Kind)
+	require.Equal(t, contracts.ClaimStatusFlagged, fourth.Status)
+	require.Equal(t, []string{"claim-tx-4", "claim-tx-5"}, flagged())
+
+	require.NoError(t, corroborate(interchain.ChainHealthcare, "claim-tx-3", "record-3"))
+	third, _ := k.GetClaim(ctx, "claim-tx-3")
+	require.Equal(t, contracts.CorroborationCorroborated, third.Corroboration)
+	require.Equal(t, contracts.ClaimStatusFiled, third.Status)
+	require.ErrorIs(t, corroborate(interchain.ChainHealthcare, "claim-tx-3", "record-3"), types.ErrInvalidClaimStatus)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
+)
+
+var _ interchain.PacketHandler = Keeper{}
+
+// counterpartyChain resolves a counterparty chain ID from the params
+func (k Keeper) counterpartyChain(ctx sdk.Context, chainId string) (string, bool) {
+	return k.GetParams(ctx).CounterpartyChain(chainId)
+}
+
+// OnAcknowledgement hands the response to a message to the insurance
+// contract. A claim payout the finance chain failed to process takes its
+// claim back to adjudication.
+func (k Keeper) OnAcknowledgement(ctx sdk.Context, targetChain string, packet interchain.PacketData, response []byte, ackErr error) error {
+	if ackErr != nil && isClaimPayout(packet) {
+		return k.failPayoutPacket(ctx, packet.Payload, ackErr.Error())
+	}
+	return k.ContractHandler.OnAcknowledgement(ctx, targetChain, packet, response, ackErr)
+}
+
+// OnTimeout queues a packet the counterparty did not receive again, except a
+// claim payout, which takes its claim back to adjudication instead
+func (k Keeper) OnTimeout(ctx sdk.Context, targetChain string, packet interchain.PacketData) error {
+	if isClaimPayout(packet) {
+		return k.failPayoutPacket(ctx, packet.Payload, "payout timed out")
+	}
+	return k.ContractHandler.OnTimeout(ctx, targetChain, packet)
+}
+
+func isClaimPayout(packet interchain.PacketData) bool {
+	return packet.Kind == interchain.PacketKindMessage && packet.MessageType == contracts.MessageTypeClaimPayout
+}
+
+// failPayoutPacket fails the payout of the claim a payment packet pays
+func (k Keeper) failPayoutPacket(ctx sdk.Context, data []byte, reason string) error {
+	var payment contracts.Payment
+	if err := json.Unmarshal(data, &payment); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid payment format")
+	}
+	return k.failPayout(ctx, payment.ClaimID(), payment.TransactionID, reason)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	"insurance/x/insurance/types"
+)
+
+type (
+	Keeper struct {
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
+		logger       log.Logger
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		bankKeeper   types.BankKeeper
+		policyKeeper types.PolicyKeeper
+
+		port interchain.Port
+		interchain.PacketQueue[types.ContractRecord, *types.ContractRecord]
+		interchain.ContractHandler
+
+		contract     *contracts.InsuranceContract
+		transactions *transactions.InsuranceTransactionHandler
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	logger log.Logger,
+	authority string,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
+
+	bankKeeper types.BankKeeper,
+	policyKeeper types.PolicyKeeper,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+		bankKeeper:   bankKeeper,
+		policyKeeper: policyKeeper,
+	}
+	k.port = interchain.NewPort(types.ModuleName, ibcKeeperFn, capabilityScopedFn, k.counterpartyChain)
+	k.PacketQueue = interchain.NewPacketQueue[types.ContractRecord](cdc, storeService, types.KeyPrefix(types.ContractRecordKeyPrefix), types.NewPacketRecord, k.port, k.Logger())
+	k.contract = newContract(&k)
+	k.ContractHandler = interchain.NewContractHandler(k.contract, k.PacketQueue)
+	k.transactions = transactions.NewInsuranceTransactionHandler(k.contract)
+	return k
+}
+
+// Contract returns the insurance contract backed by the module state.
+func (k Keeper) Contract() *contracts.InsuranceContract {
+	return k.contract
+}
+
+// Port returns the interchain port of the module.
+func (k Keeper) Port() interchain.Port {
+	return k.port
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
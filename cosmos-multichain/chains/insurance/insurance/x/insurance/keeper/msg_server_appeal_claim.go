@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) AppealClaim(goCtx context.Context, msg *types.MsgAppealClaim) (*types.MsgAppealClaimResponse, error) {
+	if err := k.Keeper.AppealClaim(goCtx, msg.Creator, msg.ClaimId, msg.Reason); err != nil {
+		return nil, err
+	}
+	return &types.MsgAppealClaimResponse{}, nil
+}
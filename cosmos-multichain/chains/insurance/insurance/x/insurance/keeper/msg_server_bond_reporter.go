@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) BondReporter(goCtx context.Context, msg *types.MsgBondReporter) (*types.MsgBondReporterResponse, error) {
+	reporter, err := k.Keeper.BondReporter(goCtx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgBondReporterResponse{Stake: reporter.Stake}, nil
+}
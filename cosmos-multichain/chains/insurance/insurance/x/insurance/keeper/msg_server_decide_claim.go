@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) DecideClaim(goCtx context.Context, msg *types.MsgDecideClaim) (*types.MsgDecideClaimResponse, error) {
+	claim, err := k.Keeper.DecideClaim(goCtx, msg.Creator, msg.ClaimId, msg.Approve, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDecideClaimResponse{Status: claim.Status}, nil
+}
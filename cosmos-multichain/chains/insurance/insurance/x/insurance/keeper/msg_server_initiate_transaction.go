@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.InsuranceTransactionRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	if err := k.authorizeTransaction(ctx, msg.Creator, req); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TransactionType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TransactionType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
+
+// authorizeTransaction checks the creator of a transaction may initiate it.
+// Policies are bought, changed and cancelled by their holder, who files the
+// claims on them. Claim evidence is added by the adjudication: the adjuster
+// reviewing the claim, or any adjuster before the claim is reviewed.
+func (k msgServer) authorizeTransaction(ctx sdk.Context, creator string, req transactions.InsuranceTransactionRequest) error {
+	var policyId string
+	switch req.TransactionType {
+	case transactions.NewPolicy:
+		if req.PolicyData != nil && req.PolicyData.HolderID != creator {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "policies are bought by their holder")
+		}
+		return nil
+	case transactions.UpdatePolicy, transactions.CancelPolicy:
+		if req.PolicyData == nil {
+			return nil
+		}
+		if req.TransactionType == transactions.UpdatePolicy && req.PolicyData.HolderID != creator {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the holder of a policy cannot be changed")
+		}
+		policyId = req.PolicyData.PolicyID
+	case transactions.FileClaim:
+		if req.ClaimData == nil {
+			return nil
+		}
+		if req.ClaimData.HolderID != creator {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "claims are filed by the holder of their policy")
+		}
+		policyId = req.ClaimData.PolicyID
+	case transactions.UpdateClaim:
+		if req.ClaimData == nil {
+			return nil
+		}
+		claim, found := k.GetClaim(ctx, req.ClaimData.ClaimID)
+		if !found {
+			return errorsmod.Wrap(types.ErrClaimNotFound, req.ClaimData.ClaimID)
+		}
+		// The claimant answers the evidence requests of the adjuster
+		if claim.Status == contracts.ClaimStatusEvidenceRequested && claim.Claimant == creator {
+			return nil
+		}
+		if claim.Adjuster != "" && claim.Adjuster != creator {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "claim %s is reviewed by %s", claim.ClaimId, claim.Adjuster)
+		}
+		if params := k.GetParams(ctx); !params.IsAdjuster(creator) && !params.IsAppealAdjuster(creator) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an adjuster", creator)
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	policy, found := k.policyKeeper.GetPolicy(ctx, policyId)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, policyId)
+	}
+	if policy.Holder != creator {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only the holder of policy %s can initiate a %s", policyId, req.TransactionType)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) RequestEvidence(goCtx context.Context, msg *types.MsgRequestEvidence) (*types.MsgRequestEvidenceResponse, error) {
+	deadline, err := k.Keeper.RequestEvidence(goCtx, msg.Creator, msg.ClaimId, msg.Request)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRequestEvidenceResponse{Deadline: deadline}, nil
+}
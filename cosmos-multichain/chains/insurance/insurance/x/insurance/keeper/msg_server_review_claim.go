@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) ReviewClaim(goCtx context.Context, msg *types.MsgReviewClaim) (*types.MsgReviewClaimResponse, error) {
+	if err := k.Keeper.ReviewClaim(goCtx, msg.Creator, msg.ClaimId); err != nil {
+		return nil, err
+	}
+	return &types.MsgReviewClaimResponse{}, nil
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) SubmitObservation(goCtx context.Context, msg *types.MsgSubmitObservation) (*types.MsgSubmitObservationResponse, error) {
+	if err := k.Keeper.SubmitObservation(goCtx, msg.Creator, msg.Metric, msg.Value); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitObservationResponse{}, nil
+}
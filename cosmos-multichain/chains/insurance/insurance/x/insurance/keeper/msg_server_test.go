@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	keepertest "insurance/testutil/keeper"
+	"insurance/testutil/sample"
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+	policytypes "insurance/x/policy/types"
+)
+
+var (
+	policyHolder = sample.AccAddress()
+	policyStart  = time.Unix(1_000_000, 0)
+)
+
+func insuranceData(t *testing.T, req transactions.InsuranceTransactionRequest) []byte {
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	return data
+}
+
+func policyRequest(id string, txType transactions.TransactionType, policyType string) transactions.InsuranceTransactionRequest {
+	return transactions.InsuranceTransactionRequest{
+		TransactionID:   id,
+		TransactionType: txType,
+		PolicyData: &transactions.PolicyData{
+			PolicyID:  "policy-1",
+			HolderID:  policyHolder,
+			Type:      policyType,
+			Coverage:  sdkmath.NewInt(1000),
+			Premium:   sdkmath.NewInt(100),
+			StartDate: policyStart,
+			EndDate:   policyStart.Add(100 * time.Hour),
+
+			RiskFactors: json.RawMessage(`{"risk_score":0}`),
+		},
+	}
+}
+
+func claimRequest(id string, amount int64) transactions.InsuranceTransactionRequest {
+	return transactions.InsuranceTransactionRequest{
+		TransactionID:   id,
+		TransactionType: transactions.FileClaim,
+		ClaimData: &transactions.ClaimData{
+			ClaimID:  "claim-" + id,
+			PolicyID: "policy-1",
+			HolderID: policyHolder,
+			Amount:   sdkmath.NewInt(amount),
+		},
+	}
+}
+
+func TestPolicyAndClaims(t *testing.T) {
+	k, policyKeeper, bank, ctx := keepertest.InsuranceKeeperWithPolicy(t)
+	ctx = ctx.WithBlockTime(policyStart)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := policyHolder
+	holder := sdk.MustAccAddressFromBech32(policyHolder)
+	bank.Fund(holder, sdk.NewCoins(sdk.NewInt64Coin(policytypes.DefaultPremiumDenom, 100)))
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, claimRequest("tx-0", 10))))
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, policyRequest("tx-1", transactions.NewPolicy, "health"))))
+	require.NoError(t, err)
+	policy, found := policyKeeper.GetPolicy(ctx, "policy-1")
+	require.True(t, found)
+	require.Equal(t, policytypes.PolicyStatusQuoted, policy.Status)
+	require.Equal(t, sdk.NewInt64Coin(policytypes.DefaultPremiumDenom, 100), policy.Premium)
+
+	// Claims are only processed against active policies
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, claimRequest("tx-2", 500))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	policy, err = policyKeeper.PayPremium(ctx, policyHolder, "policy-1")
+	require.NoError(t, err)
+	require.Equal(t, policytypes.PolicyStatusActive, policy.Status)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, claimRequest("tx-3", 2000))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, claimRequest("tx-4", 500))))
+	require.NoError(t, err)
+	claim, found := k.GetClaim(ctx, "claim-tx-4")
+	require.True(t, found)
+	require.Equal(t, contracts.ClaimStatusFiled, claim.Status)
+	require.Equal(t, policyHolder, claim.Claimant)
+
+	// Cancelling a quarter into the policy refunds three quarters of its premium
+	ctx = ctx.WithBlockTime(policyStart.Add(25 * time.Hour))
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, policyRequest("tx-5", transactions.CancelPolicy, "health"))))
+	require.NoError(t, err)
+	require.Equal(t, int64(75), bank.Balance(holder).AmountOf(policytypes.DefaultPremiumDenom).Int64())
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, claimRequest("tx-6", 500))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	policy, _ = policyKeeper.GetPolicy(ctx, "policy-1")
+	require.Equal(t, policytypes.PolicyStatusCancelled, policy.Status)
+}
+
+func TestRestrictedPolicyType(t *testing.T) {
+	k, ctx := keepertest.InsuranceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := policyHolder
+
+	k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:     keeper.RecordKindRegulations,
+		RecordId: "current",
+		Data:     []byte(`{"restricted_policy_types":["crypto"]}`),
+	})
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, policyRequest("tx-1", transactions.NewPolicy, "crypto"))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, policyRequest("tx-1", transactions.NewPolicy, "life"))))
+	require.NoError(t, err)
+	require.Len(t, k.GetAuditTrail(ctx, "compliance"), 1)
+}
+
+func TestCalculatePremium(t *testing.T) {
+	k, ctx := keepertest.InsuranceKeeper(t)
+
+	premium, err := k.Contract().CalculatePremium(ctx, "life", []byte(`{"risk_score":0.5}`))
+	require.NoError(t, err)
+	require.Equal(t, keeper.DefaultBasePremium.MulRaw(2), premium)
+
+	_, err = k.AddRatingModel(ctx, types.RatingModel{
+		BaseRates: []types.BaseRate{{PolicyType: "life", Rate: sdkmath.NewInt(50)}},
+		Factors:   []types.RatingFactor{{Attribute: keeper.RiskAttributeScore, Min: "0.7", Multiplier: sdkmath.LegacyNewDec(4)}},
+	})
+	require.NoError(t, err)
+	premium, err = k.Contract().CalculatePremium(ctx, "life", []byte(`{"risk_score":0.9}`))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(200), premium)
+
+	_, err = k.Contract().CalculatePremium(ctx, "life", []byte(`{"risk_score":2}`))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	k, ctx := keepertest.InsuranceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator, other := policyHolder, sample.AccAddress()
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, policyRequest("tx-1", transactions.NewPolicy, "health"))))
+	require.NoError(t, err)
+
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(other, "tx-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
+
+func TestTransactionAuthorization(t *testing.T) {
+	k, _, _, ctx := adjudicationSetup(t)
+	srv := keeper.NewMsgServerImpl(k)
+	other := sample.AccAddress()
+	initiate := func(creator string, req transactions.InsuranceTransactionRequest) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, insuranceData(t, req)))
+		return err
+	}
+
+	// Policies are bought, changed and cancelled by their holder only
+	newPolicy := policyRequest("tx-1", transactions.NewPolicy, "health")
+	newPolicy.PolicyData.PolicyID = "policy-2"
+	require.ErrorIs(t, initiate(other, newPolicy), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, initiate(other, policyRequest("tx-2", transactions.UpdatePolicy, "health")), sdkerrors.ErrUnauthorized)
+	transfer := policyRequest("tx-3", transactions.UpdatePolicy, "health")
+	transfer.PolicyData.HolderID = other
+	require.ErrorIs(t, initiate(other, transfer), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, initiate(policyHolder, transfer), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, initiate(other, policyRequest("tx-4", transactions.CancelPolicy, "health")), sdkerrors.ErrUnauthorized)
+
+	// Claims are filed by the holder and completed by the adjudication
+	require.ErrorIs(t, initiate(other, claimRequest("tx-5", 10)), sdkerrors.ErrUnauthorized)
+	impersonated := claimRequest("tx-5", 10)
+	impersonated.ClaimData.HolderID = other
+	require.ErrorIs(t, initiate(other, impersonated), sdkerrors.ErrUnauthorized)
+	anonymous, err := json.Marshal(contracts.Claim{ClaimID: "claim-anonymous", PolicyID: "policy-1", Amount: sdkmath.NewInt(10)})
+	require.NoError(t, err)
+	require.ErrorIs(t, k.Contract().ProcessClaim(ctx, anonymous), interchain.ErrInvalidData)
+	claimId := fileClaim(t, k, ctx, "tx-6", 10)
+	update := claimRequest("tx-7", 0)
+	update.TransactionType = transactions.UpdateClaim
+	update.ClaimData.ClaimID = claimId
+	update.ClaimData.Evidence = []transactions.Evidence{{Type: "invoice", Hash: "abc"}}
+	require.ErrorIs(t, initiate(other, update), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, initiate(policyHolder, update), sdkerrors.ErrUnauthorized)
+	require.NoError(t, k.ReviewClaim(ctx, adjuster, claimId))
+	require.ErrorIs(t, initiate(appealAdjuster, update), sdkerrors.ErrUnauthorized)
+	require.NoError(t, initiate(adjuster, update))
+
+	_, found := k.GetTransaction(ctx, "tx-5")
+	require.False(t, found)
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) UnbondReporter(goCtx context.Context, msg *types.MsgUnbondReporter) (*types.MsgUnbondReporterResponse, error) {
+	reporter, err := k.Keeper.UnbondReporter(goCtx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUnbondReporterResponse{Amount: reporter.Stake, UnbondingUntil: reporter.UnbondingUntil}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"insurance/x/insurance/types"
+)
+
+func (k msgServer) UpdateRatingModel(goCtx context.Context, msg *types.MsgUpdateRatingModel) (*types.MsgUpdateRatingModelResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	model, err := k.AddRatingModel(goCtx, msg.RatingModel())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateRatingModelResponse{Version: model.Version}, nil
+}
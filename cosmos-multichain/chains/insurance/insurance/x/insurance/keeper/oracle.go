@@ -0,0 +1,528 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"insurance/contracts"
+	"insurance/x/insurance/types"
+	policytypes "insurance/x/policy/types"
+)
+
+// SetOracleReporter set a specific oracleReporter in the store from its index
+func (k Keeper) SetOracleReporter(ctx context.Context, oracleReporter types.OracleReporter) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OracleReporterKeyPrefix))
+	b := k.cdc.MustMarshal(&oracleReporter)
+	store.Set(types.OracleReporterKey(oracleReporter.Address), b)
+}
+
+// GetOracleReporter returns an oracleReporter from its index
+func (k Keeper) GetOracleReporter(ctx context.Context, address string) (val types.OracleReporter, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OracleReporterKeyPrefix))
+
+	b := store.Get(types.OracleReporterKey(address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOracleReporter removes an oracleReporter from the store
+func (k Keeper) RemoveOracleReporter(ctx context.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OracleReporterKeyPrefix))
+	store.Delete(types.OracleReporterKey(address))
+}
+
+// GetAllOracleReporter returns all oracleReporter
+func (k Keeper) GetAllOracleReporter(ctx context.Context) (list []types.OracleReporter) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.OracleReporterKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OracleReporter
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetObservation set a specific observation in the store from its index
+func (k Keeper) SetObservation(ctx context.Context, observation types.Observation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ObservationKeyPrefix))
+	b := k.cdc.MustMarshal(&observation)
+	store.Set(types.ObservationKey(observation.Metric, observation.Reporter), b)
+}
+
+// GetMetricObservations returns the pending observations of a metric
+func (k Keeper) GetMetricObservations(ctx context.Context, metric string) (list []types.Observation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.ObservationKeyPrefix), types.MetricKey(metric)...))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Observation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// removeMetricObservations removes the pending observations of a metric
+func (k Keeper) removeMetricObservations(ctx context.Context, metric string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ObservationKeyPrefix))
+	for _, observation := range k.GetMetricObservations(ctx, metric) {
+		store.Delete(types.ObservationKey(observation.Metric, observation.Reporter))
+	}
+}
+
+// GetAllObservation returns all pending observation
+func (k Keeper) GetAllObservation(ctx context.Context) (list []types.Observation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ObservationKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Observation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetMetricSettlement set a specific metricSettlement in the store from its index
+func (k Keeper) SetMetricSettlement(ctx context.Context, metricSettlement types.MetricSettlement) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MetricSettlementKeyPrefix))
+	b := k.cdc.MustMarshal(&metricSettlement)
+	store.Set(types.MetricKey(metricSettlement.Metric), b)
+}
+
+// GetMetricSettlement returns a metricSettlement from its index
+func (k Keeper) GetMetricSettlement(ctx context.Context, metric string) (val types.MetricSettlement, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MetricSettlementKeyPrefix))
+
+	b := store.Get(types.MetricKey(metric))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllMetricSettlement returns all metricSettlement
+func (k Keeper) GetAllMetricSettlement(ctx context.Context) (list []types.MetricSettlement) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MetricSettlementKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MetricSettlement
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetParametricPolicy indexes a parametric policy by its trigger metric
+func (k Keeper) SetParametricPolicy(ctx context.Context, parametricPolicy types.ParametricPolicy) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ParametricPolicyIndexPrefix))
+	b := k.cdc.MustMarshal(&parametricPolicy)
+	store.Set(types.ParametricPolicyIndexKey(parametricPolicy.Metric, parametricPolicy.PolicyId), b)
+}
+
+// RemoveParametricPolicy removes a parametric policy from the index
+func (k Keeper) RemoveParametricPolicy(ctx context.Context, metric string, policyId string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ParametricPolicyIndexPrefix))
+	store.Delete(types.ParametricPolicyIndexKey(metric, policyId))
+}
+
+// GetAllParametricPolicy returns all parametricPolicy, ordered by metric
+func (k Keeper) GetAllParametricPolicy(ctx context.Context) (list []types.ParametricPolicy) {
+	return k.parametricPolicies(ctx, types.KeyPrefix(types.ParametricPolicyIndexPrefix))
+}
+
+// parametricPolicies returns the parametric policies indexed under a prefix
+func (k Keeper) parametricPolicies(ctx context.Context, indexPrefix []byte) (list []types.ParametricPolicy) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, indexPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ParametricPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// indexParametricPolicy indexes a parametric policy by the metric of its
+// trigger, replacing the entry of the trigger it had before
+func (k Keeper) indexParametricPolicy(ctx context.Context, previous *policytypes.Policy, policy policytypes.Policy) {
+	if previous != nil {
+		if metric := triggerMetric(*previous); metric != "" {
+			k.RemoveParametricPolicy(ctx, metric, previous.PolicyId)
+		}
+	}
+	if metric := triggerMetric(policy); metric != "" {
+		k.SetParametricPolicy(ctx, types.ParametricPolicy{Metric: metric, PolicyId: policy.PolicyId})
+	}
+}
+
+// triggerMetric returns the trigger metric of a parametric policy, and an
+// empty metric for the other policies
+func triggerMetric(policy policytypes.Policy) string {
+	if policy.PolicyType != contracts.PolicyTypeParametric {
+		return ""
+	}
+	for _, term := range policy.Terms {
+		if term.Key == contracts.TermTriggerMetric {
+			return term.Value
+		}
+	}
+	return ""
+}
+
+// BondReporter bonds stake from a reporter, which reports metrics once its
+// stake reaches the oracle min stake
+func (k Keeper) BondReporter(ctx context.Context, address string, amount sdk.Coin) (types.OracleReporter, error) {
+	minStake := k.GetParams(ctx).OracleMinStake
+	reporter, found := k.GetOracleReporter(ctx, address)
+	if !found {
+		reporter = types.OracleReporter{
+			Address:  address,
+			Stake:    sdk.NewCoin(minStake.Denom, sdkmath.ZeroInt()),
+			BondedAt: sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+		}
+	}
+	if reporter.UnbondingUntil != 0 {
+		return reporter, errorsmod.Wrap(types.ErrReporterUnbonding, address)
+	}
+	if amount.Denom != minStake.Denom || reporter.Stake.Denom != minStake.Denom {
+		return reporter, errorsmod.Wrapf(types.ErrInvalidStake, "reporters bond %s", minStake.Denom)
+	}
+	reporter.Stake = reporter.Stake.Add(amount)
+	if reporter.Stake.IsLT(minStake) {
+		return reporter, errorsmod.Wrapf(types.ErrInvalidStake, "stake %s is below the min stake %s", reporter.Stake, minStake)
+	}
+
+	from, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return reporter, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return reporter, err
+	}
+	k.SetOracleReporter(ctx, reporter)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReporterBonded,
+			sdk.NewAttribute(types.AttributeKeyReporter, address),
+			sdk.NewAttribute(types.AttributeKeyAmount, reporter.Stake.String()),
+		),
+	)
+	return reporter, nil
+}
+
+// UnbondReporter starts unbonding the stake of a reporter, whose pending
+// observations no longer count towards the quorum. The stake can still be
+// slashed for the observations the reporter submitted until it is returned
+// at the end of the oracle unbonding period.
+func (k Keeper) UnbondReporter(ctx context.Context, address string) (types.OracleReporter, error) {
+	reporter, found := k.GetOracleReporter(ctx, address)
+	if !found {
+		return reporter, errorsmod.Wrap(types.ErrReporterNotFound, address)
+	}
+	if reporter.UnbondingUntil != 0 {
+		return reporter, errorsmod.Wrap(types.ErrReporterUnbonding, address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reporter.UnbondingUntil = sdkCtx.BlockTime().Add(k.GetParams(ctx).OracleUnbondingPeriod).Unix()
+	k.SetOracleReporter(ctx, reporter)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReporterUnbonding,
+			sdk.NewAttribute(types.AttributeKeyReporter, address),
+			sdk.NewAttribute(types.AttributeKeyAmount, reporter.Stake.String()),
+			sdk.NewAttribute(types.AttributeKeyDeadline, strconv.FormatInt(reporter.UnbondingUntil, 10)),
+		),
+	)
+	return reporter, nil
+}
+
+// ReleaseUnbondedReporters returns their stake to the reporters whose
+// unbonding period ended. A stake that cannot be returned is logged and
+// retried at the end of the next block.
+func (k Keeper) ReleaseUnbondedReporters(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	for _, reporter := range k.GetAllOracleReporter(ctx) {
+		if reporter.UnbondingUntil == 0 || reporter.UnbondingUntil > now {
+			continue
+		}
+		to, err := sdk.AccAddressFromBech32(reporter.Address)
+		if err == nil && reporter.Stake.IsPositive() {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(reporter.Stake))
+		}
+		if err != nil {
+			k.Logger().Error("failed to return reporter stake", "reporter", reporter.Address, "error", err)
+			continue
+		}
+		k.RemoveOracleReporter(ctx, reporter.Address)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReporterUnbonded,
+				sdk.NewAttribute(types.AttributeKeyReporter, reporter.Address),
+				sdk.NewAttribute(types.AttributeKeyAmount, reporter.Stake.String()),
+			),
+		)
+	}
+}
+
+// SubmitObservation records the value of a metric observed by a bonded
+// reporter, replacing its previous observation of the metric. The metric is
+// checked for settlement at the end of the block.
+func (k Keeper) SubmitObservation(ctx context.Context, address string, metric string, value sdkmath.LegacyDec) error {
+	if err := types.ValidateMetric(metric); err != nil {
+		return err
+	}
+	reporter, found := k.GetOracleReporter(ctx, address)
+	if !found {
+		return errorsmod.Wrap(types.ErrReporterNotFound, address)
+	}
+	if reporter.UnbondingUntil != 0 {
+		return errorsmod.Wrap(types.ErrReporterUnbonding, address)
+	}
+	if _, found := k.GetMetricSettlement(ctx, metric); found {
+		return errorsmod.Wrap(types.ErrMetricSettled, metric)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.SetObservation(ctx, types.Observation{
+		Metric:     metric,
+		Reporter:   address,
+		Value:      value,
+		ReportedAt: sdkCtx.BlockTime().Unix(),
+	})
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReportedMetricKeyPrefix)).Set(types.MetricKey(metric), []byte(metric))
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeObservationSubmitted,
+			sdk.NewAttribute(types.AttributeKeyReporter, address),
+			sdk.NewAttribute(types.AttributeKeyMetric, metric),
+			sdk.NewAttribute(types.AttributeKeyValue, value.String()),
+		),
+	)
+	return nil
+}
+
+// takeReportedMetrics returns the metrics reported during the block and
+// clears them
+func (k Keeper) takeReportedMetrics(ctx context.Context) (metrics []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReportedMetricKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		metrics = append(metrics, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return metrics
+}
+
+// SettleMetrics settles the metrics reported during the block once reporters
+// holding the oracle quorum of the bonded stake reported them, slashes the
+// reporters of deviating values, and pays out the parametric policies the
+// metrics trigger. Unbonding reporters hold no bonded stake.
+func (k Keeper) SettleMetrics(ctx context.Context) {
+	metrics := k.takeReportedMetrics(ctx)
+	if len(metrics) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	stakes := make(map[string]sdkmath.Int)
+	total := sdkmath.ZeroInt()
+	for _, reporter := range k.GetAllOracleReporter(ctx) {
+		if reporter.UnbondingUntil == 0 && reporter.Stake.Denom == params.OracleMinStake.Denom {
+			stakes[reporter.Address] = reporter.Stake.Amount
+			total = total.Add(reporter.Stake.Amount)
+		}
+	}
+	if !total.IsPositive() {
+		return
+	}
+	quorum := params.OracleQuorum.MulInt(total)
+
+	for _, metric := range metrics {
+		observations := k.GetMetricObservations(ctx, metric)
+		settlement, settled := settle(metric, observations, stakes, quorum)
+		if !settled {
+			continue
+		}
+		settlement.SettledAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+		k.SetMetricSettlement(ctx, settlement)
+		k.removeMetricObservations(ctx, metric)
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeMetricSettled,
+				sdk.NewAttribute(types.AttributeKeyMetric, metric),
+				sdk.NewAttribute(types.AttributeKeyValue, settlement.Value.String()),
+			),
+		)
+		k.slashDeviatingReporters(ctx, settlement, observations, params)
+		k.payParametricPolicies(ctx, settlement)
+	}
+}
+
+// slashDeviatingReporters burns the oracle slash fraction of the stake of the
+// reporters, bonded or unbonding, whose observation of a settled metric
+// deviates from its value by more than the oracle max deviation
+func (k Keeper) slashDeviatingReporters(ctx context.Context, settlement types.MetricSettlement, observations []types.Observation, params types.Params) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	maxDeviation := params.OracleMaxDeviation.Mul(settlement.Value.Abs())
+	for _, observation := range observations {
+		if observation.Value.Sub(settlement.Value).Abs().LTE(maxDeviation) {
+			continue
+		}
+		reporter, found := k.GetOracleReporter(ctx, observation.Reporter)
+		if !found {
+			continue
+		}
+		slashed := sdk.NewCoin(reporter.Stake.Denom, params.OracleSlashFraction.MulInt(reporter.Stake.Amount).TruncateInt())
+		if !slashed.IsPositive() {
+			continue
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed)); err != nil {
+			k.Logger().Error("failed to slash reporter", "reporter", reporter.Address, "metric", settlement.Metric, "error", err)
+			continue
+		}
+		reporter.Stake = reporter.Stake.Sub(slashed)
+		k.SetOracleReporter(ctx, reporter)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReporterSlashed,
+				sdk.NewAttribute(types.AttributeKeyReporter, reporter.Address),
+				sdk.NewAttribute(types.AttributeKeyMetric, settlement.Metric),
+				sdk.NewAttribute(types.AttributeKeyValue, observation.Value.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+			),
+		)
+	}
+}
+
+// settle returns the settlement of a metric, the stake-weighted median of
+// its observations, once their reporters hold the quorum stake
+func settle(metric string, observations []types.Observation, stakes map[string]sdkmath.Int, quorum sdkmath.LegacyDec) (types.MetricSettlement, bool) {
+	var (
+		counted  []types.Observation
+		reported = sdkmath.ZeroInt()
+	)
+	for _, observation := range observations {
+		if stake, bonded := stakes[observation.Reporter]; bonded {
+			counted = append(counted, observation)
+			reported = reported.Add(stake)
+		}
+	}
+	if len(counted) == 0 || sdkmath.LegacyNewDecFromInt(reported).LT(quorum) {
+		return types.MetricSettlement{}, false
+	}
+
+	sort.SliceStable(counted, func(i, j int) bool { return counted[i].Value.LT(counted[j].Value) })
+	settlement := types.MetricSettlement{Metric: metric}
+	cumulated := sdkmath.ZeroInt()
+	for _, observation := range counted {
+		settlement.Reporters = append(settlement.Reporters, observation.Reporter)
+		cumulated = cumulated.Add(stakes[observation.Reporter])
+		if settlement.Value.IsNil() && cumulated.MulRaw(2).GTE(reported) {
+			settlement.Value = observation.Value
+		}
+	}
+	sort.Strings(settlement.Reporters)
+	return settlement, true
+}
+
+// payParametricPolicies files and pays the claims of the active parametric
+// policies a settled metric triggers. Claims flagged for fraud are left to
+// manual review. A policy whose claim cannot be filed or paid is logged and
+// left unpaid, without affecting the others.
+func (k Keeper) payParametricPolicies(ctx context.Context, settlement types.MetricSettlement) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	observation, err := json.Marshal(contracts.Observation{
+		Metric:     settlement.Metric,
+		Value:      settlement.Value,
+		ObservedAt: time.Unix(settlement.SettledAt, 0).UTC(),
+	})
+	if err != nil {
+		k.Logger().Error("failed to marshal observation", "metric", settlement.Metric, "error", err)
+		return
+	}
+
+	indexed := k.parametricPolicies(ctx, append(types.KeyPrefix(types.ParametricPolicyIndexPrefix), types.MetricKey(settlement.Metric)...))
+	for _, entry := range indexed {
+		k.RemoveParametricPolicy(ctx, entry.Metric, entry.PolicyId)
+		policy, found := k.policyKeeper.GetPolicy(ctx, entry.PolicyId)
+		if !found || policy.Status != policytypes.PolicyStatusActive {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		claimId, err := k.contract.FileParametricClaim(cacheCtx, entry.PolicyId, observation, policy.RemainingCoverage())
+		if err == nil && claimId != "" {
+			claim, _ := k.GetClaim(cacheCtx, claimId)
+			if claim.Status != contracts.ClaimStatusFlagged {
+				claim.DecisionReason = "triggered by " + settlement.Metric + " settled at " + settlement.Value.String()
+				_, err = k.payClaim(cacheCtx, claim)
+			}
+		}
+		if err != nil {
+			k.Logger().Error("failed to pay out parametric policy", "policy_id", entry.PolicyId, "metric", settlement.Metric, "error", err)
+			continue
+		}
+		write()
+	}
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"insurance/contracts"
+	"insurance/contracts/transactions"
+	keepertest "insurance/testutil/keeper"
+	"insurance/testutil/sample"
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+	policytypes "insurance/x/policy/types"
+)
+
+const delayMetric = "flight:LH400:2026-10-17:delay_minutes"
+
+// parametricPolicy returns a request for a flight delay policy paying out
+// payout once the delay is at least threshold minutes
+func parametricPolicy(id, policyId, threshold, payout string) transactions.InsuranceTransactionRequest {
+	req := policyRequest(id, transactions.NewPolicy, contracts.PolicyTypeParametric)
+	req.PolicyData.PolicyID = policyId
+	req.PolicyData.Terms = map[string]string{
+		contracts.TermTriggerMetric:    delayMetric,
+		contracts.TermTriggerOperator:  contracts.TriggerAtLeast,
+		contracts.TermTriggerThreshold: threshold,
+		contracts.TermTriggerPayout:    payout,
+	}
+	return req
+}
+
+func TestParametricPayout(t *testing.T) {
+	k, policyKeeper, bank, ctx := keepertest.InsuranceKeeperWithPolicy(t)
+	ctx = ctx.WithBlockTime(policyStart)
+	srv := keeper.NewMsgServerImpl(k)
+	holder := sdk.MustAccAddressFromBech32(policyHolder)
+	bank.Fund(holder, sdk.NewCoins(sdk.NewInt64Coin(policytypes.DefaultPremiumDenom, 200)))
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, parametricPolicy("tx-0", "policy-1", "120", "2000"))))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+	for i, threshold := range []string{"120", "180"} {
+		policyId := []string{"policy-1", "policy-2"}[i]
+		_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, parametricPolicy("tx-"+policyId, policyId, threshold, "80"))))
+		require.NoError(t, err)
+		_, err = policyKeeper.PayPremium(ctx, policyHolder, policyId)
+		require.NoError(t, err)
+	}
+	require.Len(t, k.GetAllParametricPolicy(ctx), 2)
+
+	// Reporters bond at least the min stake
+	reporters := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	for i, stake := range []int64{1000, 1000, 2000} {
+		bank.Fund(sdk.MustAccAddressFromBech32(reporters[i]), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, stake)))
+		_, err = srv.BondReporter(ctx, types.NewMsgBondReporter(reporters[i], sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)))
+		require.ErrorIs(t, err, types.ErrInvalidStake)
+		res, err := srv.BondReporter(ctx, types.NewMsgBondReporter(reporters[i], sdk.NewInt64Coin(sdk.DefaultBondDenom, stake)))
+		require.NoError(t, err)
+		require.Equal(t, stake, res.Stake.Amount.Int64())
+	}
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(sample.AccAddress(), delayMetric, sdkmath.LegacyNewDec(150)))
+	require.ErrorIs(t, err, types.ErrReporterNotFound)
+
+	// Half of the stake is below the quorum
+	submit := func(reporter string, value int64) {
+		_, err := srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporter, delayMetric, sdkmath.LegacyNewDec(value)))
+		require.NoError(t, err)
+	}
+	submit(reporters[0], 150)
+	submit(reporters[1], 200)
+	k.SettleMetrics(ctx)
+	observations, err := k.MetricObservations(ctx, &types.QueryMetricObservationsRequest{Metric: delayMetric})
+	require.NoError(t, err)
+	require.Len(t, observations.Observations, 2)
+	require.Nil(t, observations.Settlement)
+
+	// The metric settles at the stake-weighted median, slashes the reporter
+	// of the deviating value, and pays out the policies it triggers from the
+	// escrow
+	submit(reporters[2], 160)
+	k.SettleMetrics(ctx)
+	observations, err = k.MetricObservations(ctx, &types.QueryMetricObservationsRequest{Metric: delayMetric})
+	require.NoError(t, err)
+	require.Empty(t, observations.Observations)
+	require.Equal(t, sdkmath.LegacyNewDec(160), observations.Settlement.Value)
+	require.Len(t, observations.Settlement.Reporters, 3)
+	stakeOf := func(reporter string) int64 {
+		bonded, found := k.GetOracleReporter(ctx, reporter)
+		require.True(t, found)
+		return bonded.Stake.Amount.Int64()
+	}
+	require.Equal(t, []int64{1000, 950, 2000}, []int64{stakeOf(reporters[0]), stakeOf(reporters[1]), stakeOf(reporters[2])})
+	require.Equal(t, int64(3950), bank.ModuleBalance(types.ModuleName).AmountOf(sdk.DefaultBondDenom).Int64())
+
+	claim, found := k.GetClaim(ctx, contracts.ParametricClaimID("policy-1", delayMetric))
+	require.True(t, found)
+	require.Equal(t, contracts.ClaimStatusPaid, claim.Status)
+	require.Equal(t, contracts.ClaimTypeParametric, claim.ClaimType)
+	require.Equal(t, int64(80), bank.Balance(holder).AmountOf(policytypes.DefaultPremiumDenom).Int64())
+	policy, _ := policyKeeper.GetPolicy(ctx, "policy-1")
+	require.Equal(t, int64(920), policy.RemainingCoverage().Int64())
+	_, found = k.GetClaim(ctx, contracts.ParametricClaimID("policy-2", delayMetric))
+	require.False(t, found)
+	require.Empty(t, k.GetAllParametricPolicy(ctx))
+
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporters[0], delayMetric, sdkmath.LegacyNewDec(190)))
+	require.ErrorIs(t, err, types.ErrMetricSettled)
+
+	// An unbonding reporter stops reporting, but stays slashable for the
+	// values it reported until its stake is returned
+	const rainMetric = "station:FRA:2026-10-17:rain_mm"
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporters[1], rainMetric, sdkmath.LegacyNewDec(90)))
+	require.NoError(t, err)
+	unbonding, err := srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporters[1]))
+	require.NoError(t, err)
+	require.Equal(t, int64(950), unbonding.Amount.Amount.Int64())
+	unbondingUntil := ctx.BlockTime().Add(types.DefaultOracleUnbondingPeriod)
+	require.Equal(t, unbondingUntil.Unix(), unbonding.UnbondingUntil)
+	_, err = srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporters[1]))
+	require.ErrorIs(t, err, types.ErrReporterUnbonding)
+	_, err = srv.BondReporter(ctx, types.NewMsgBondReporter(reporters[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	require.ErrorIs(t, err, types.ErrReporterUnbonding)
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporters[1], rainMetric, sdkmath.LegacyNewDec(40)))
+	require.ErrorIs(t, err, types.ErrReporterUnbonding)
+
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporters[0], rainMetric, sdkmath.LegacyNewDec(40)))
+	require.NoError(t, err)
+	_, err = srv.SubmitObservation(ctx, types.NewMsgSubmitObservation(reporters[2], rainMetric, sdkmath.LegacyNewDec(42)))
+	require.NoError(t, err)
+	k.SettleMetrics(ctx)
+	rain, found := k.GetMetricSettlement(ctx, rainMetric)
+	require.True(t, found)
+	require.Equal(t, sdkmath.LegacyNewDec(42), rain.Value)
+	require.ElementsMatch(t, []string{reporters[0], reporters[2]}, rain.Reporters)
+	require.Equal(t, int64(903), stakeOf(reporters[1]))
+
+	// The stake is returned at the end of the unbonding period
+	reporter1 := sdk.MustAccAddressFromBech32(reporters[1])
+	k.ReleaseUnbondedReporters(ctx.WithBlockTime(unbondingUntil.Add(-time.Second)))
+	require.True(t, bank.Balance(reporter1).IsZero())
+	k.ReleaseUnbondedReporters(ctx.WithBlockTime(unbondingUntil))
+	require.Equal(t, int64(903), bank.Balance(reporter1).AmountOf(sdk.DefaultBondDenom).Int64())
+	_, found = k.GetOracleReporter(ctx, reporters[1])
+	require.False(t, found)
+	_, err = srv.UnbondReporter(ctx, types.NewMsgUnbondReporter(reporters[1]))
+	require.ErrorIs(t, err, types.ErrReporterNotFound)
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"insurance/x/insurance/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/x/insurance/types"
+)
+
+func (k Keeper) AuditTrail(ctx context.Context, req *types.QueryAuditTrailRequest) (*types.QueryAuditTrailResponse, error) {
+	if req == nil || req.SubjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []types.AuditEntry
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	trailStore := prefix.NewStore(store, append(types.KeyPrefix(types.AuditEntryKeyPrefix), types.AuditTrailKey(req.SubjectId)...))
+
+	pageRes, err := query.Paginate(trailStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.AuditEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuditTrailResponse{AuditEntry: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/x/insurance/types"
+)
+
+func (k Keeper) ClaimAll(ctx context.Context, req *types.QueryAllClaimRequest) (*types.QueryAllClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var claims []types.Claim
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	claimStore := prefix.NewStore(store, types.KeyPrefix(types.ClaimKeyPrefix))
+
+	pageRes, err := query.Paginate(claimStore, req.Pagination, func(key []byte, value []byte) error {
+		var claim types.Claim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllClaimResponse{Claim: claims, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Claim(ctx context.Context, req *types.QueryGetClaimRequest) (*types.QueryGetClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetClaim(
+		ctx,
+		req.ClaimId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetClaimResponse{Claim: val}, nil
+}
+
+func (k Keeper) ClaimsByPolicy(ctx context.Context, req *types.QueryClaimsByPolicyRequest) (*types.QueryClaimsByPolicyResponse, error) {
+	if req == nil || req.PolicyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var claims []types.Claim
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(prefix.NewStore(store, types.KeyPrefix(types.ClaimPolicyIndexPrefix)), types.ClaimKey(req.PolicyId))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		claim, found := k.GetClaim(ctx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed claim %s not found", value)
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimsByPolicyResponse{Claim: claims, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FlaggedClaims(ctx context.Context, req *types.QueryFlaggedClaimsRequest) (*types.QueryFlaggedClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var claims []types.Claim
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, types.KeyPrefix(types.ClaimFlaggedIndexPrefix))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		claim, found := k.GetClaim(ctx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "flagged claim %s not found", value)
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFlaggedClaimsResponse{Claim: claims, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/x/insurance/types"
+)
+
+func (k Keeper) OracleReporterAll(ctx context.Context, req *types.QueryAllOracleReporterRequest) (*types.QueryAllOracleReporterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var oracleReporters []types.OracleReporter
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	oracleReporterStore := prefix.NewStore(store, types.KeyPrefix(types.OracleReporterKeyPrefix))
+
+	pageRes, err := query.Paginate(oracleReporterStore, req.Pagination, func(key []byte, value []byte) error {
+		var oracleReporter types.OracleReporter
+		if err := k.cdc.Unmarshal(value, &oracleReporter); err != nil {
+			return err
+		}
+
+		oracleReporters = append(oracleReporters, oracleReporter)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllOracleReporterResponse{OracleReporter: oracleReporters, Pagination: pageRes}, nil
+}
+
+func (k Keeper) OracleReporter(ctx context.Context, req *types.QueryGetOracleReporterRequest) (*types.QueryGetOracleReporterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetOracleReporter(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetOracleReporterResponse{OracleReporter: val}, nil
+}
+
+func (k Keeper) MetricObservations(ctx context.Context, req *types.QueryMetricObservationsRequest) (*types.QueryMetricObservationsResponse, error) {
+	if req == nil || types.ValidateMetric(req.Metric) != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res := &types.QueryMetricObservationsResponse{Observations: k.GetMetricObservations(ctx, req.Metric)}
+	if settlement, found := k.GetMetricSettlement(ctx, req.Metric); found {
+		res.Settlement = &settlement
+	}
+	return res, nil
+}
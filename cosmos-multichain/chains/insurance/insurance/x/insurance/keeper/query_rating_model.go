@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/x/insurance/types"
+)
+
+func (k Keeper) RatingModelAll(ctx context.Context, req *types.QueryAllRatingModelRequest) (*types.QueryAllRatingModelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var ratingModels []types.RatingModel
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	ratingModelStore := prefix.NewStore(store, types.KeyPrefix(types.RatingModelKeyPrefix))
+
+	pageRes, err := query.Paginate(ratingModelStore, req.Pagination, func(key []byte, value []byte) error {
+		var ratingModel types.RatingModel
+		if err := k.cdc.Unmarshal(value, &ratingModel); err != nil {
+			return err
+		}
+
+		ratingModels = append(ratingModels, ratingModel)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRatingModelResponse{RatingModel: ratingModels, Pagination: pageRes}, nil
+}
+
+// RatingModel returns a version of the rating model, the one in force when
+// no version is requested
+func (k Keeper) RatingModel(ctx context.Context, req *types.QueryGetRatingModelRequest) (*types.QueryGetRatingModelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		val   types.RatingModel
+		found bool
+	)
+	if req.Version == 0 {
+		val, found = k.GetLatestRatingModel(ctx)
+	} else {
+		val, found = k.GetRatingModel(ctx, req.Version)
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRatingModelResponse{RatingModel: val}, nil
+}
+
+// QuotePremium prices a hypothetical policy from its JSON risk factors
+// without storing a quote
+func (k Keeper) QuotePremium(ctx context.Context, req *types.QueryQuotePremiumRequest) (*types.QueryQuotePremiumResponse, error) {
+	if req == nil || req.PolicyType == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	riskFactors := []byte(req.RiskFactors)
+	if len(riskFactors) == 0 {
+		riskFactors = []byte("{}")
+	}
+	factors, err := unmarshalRiskFactors(riskFactors)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	premium, version, err := k.PricePolicy(ctx, req.PolicyType, factors, req.Version)
+	if errors.Is(err, types.ErrRatingModelNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryQuotePremiumResponse{Premium: premium, Version: version}, nil
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"insurance/x/insurance/types"
+)
+
+// SetRatingModel set a version of the rating model in the store
+func (k Keeper) SetRatingModel(ctx context.Context, ratingModel types.RatingModel) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RatingModelKeyPrefix))
+	b := k.cdc.MustMarshal(&ratingModel)
+	store.Set(types.RatingModelKey(ratingModel.Version), b)
+}
+
+// GetRatingModel returns a version of the rating model
+func (k Keeper) GetRatingModel(ctx context.Context, version uint64) (val types.RatingModel, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RatingModelKeyPrefix))
+
+	b := store.Get(types.RatingModelKey(version))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetLatestRatingModel returns the rating model in force, its latest version
+func (k Keeper) GetLatestRatingModel(ctx context.Context) (val types.RatingModel, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RatingModelKeyPrefix))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetAllRatingModel returns all the versions of the rating model
+func (k Keeper) GetAllRatingModel(ctx context.Context) (list []types.RatingModel) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.RatingModelKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RatingModel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddRatingModel adopts a rating model as the next version, which prices the
+// quotes from now on. The previous versions are kept so that the quotes they
+// priced can be audited.
+func (k Keeper) AddRatingModel(ctx context.Context, model types.RatingModel) (types.RatingModel, error) {
+	if err := model.Validate(); err != nil {
+		return model, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	latest, _ := k.GetLatestRatingModel(ctx)
+	model.Version = latest.Version + 1
+	model.CreatedAt = sdkCtx.BlockTime().Unix()
+	k.SetRatingModel(ctx, model)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRatingModelUpdated,
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(model.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, model.Authority),
+		),
+	)
+	return model, nil
+}
+
+// PricePolicy prices a policy type for risk factors with a version of the
+// rating model, the one in force when version is zero, and returns the
+// version that priced it. Until a rating model is adopted, policies are priced
+// from DefaultBasePremium by their risk level, at version zero.
+func (k Keeper) PricePolicy(ctx context.Context, policyType string, factors RiskFactors, version uint64) (sdkmath.Int, uint64, error) {
+	var (
+		model types.RatingModel
+		found bool
+	)
+	if version == 0 {
+		model, found = k.GetLatestRatingModel(ctx)
+		if !found {
+			return legacyPremium(factors), 0, nil
+		}
+	} else if model, found = k.GetRatingModel(ctx, version); !found {
+		return sdkmath.Int{}, 0, errorsmod.Wrapf(types.ErrRatingModelNotFound, "version %d", version)
+	}
+
+	premium, err := model.Price(policyType, factors.attributes())
+	if err != nil {
+		return sdkmath.Int{}, 0, err
+	}
+	return premium, model.Version, nil
+}
+
+// legacyPremium prices a policy by its risk level: twice the default base
+// premium for medium risks, four times for high risks
+func legacyPremium(factors RiskFactors) sdkmath.Int {
+	switch riskLevel(factors.RiskScore) {
+	case RiskLevelHigh:
+		return DefaultBasePremium.MulRaw(4)
+	case RiskLevelMedium:
+		return DefaultBasePremium.MulRaw(2)
+	default:
+		return DefaultBasePremium
+	}
+}
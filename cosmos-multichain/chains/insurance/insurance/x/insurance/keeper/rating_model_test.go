@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/contracts/transactions"
+	keepertest "insurance/testutil/keeper"
+	"insurance/testutil/sample"
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+)
+
+// healthRating rates health policies from a base rate, between a floor of 80
+// and a cap of 300, by age, smoking and risk score
+func healthRating(authority string, rate int64) *types.MsgUpdateRatingModel {
+	return types.NewMsgUpdateRatingModel(authority, "health rating",
+		[]types.BaseRate{{PolicyType: "health", Rate: sdkmath.NewInt(rate), Floor: sdkmath.NewInt(80), Cap: sdkmath.NewInt(300)}},
+		[]types.RatingFactor{
+			{Attribute: "age", Max: "25", Multiplier: sdkmath.LegacyNewDecWithPrec(5, 1)},
+			{Attribute: "age", Min: "60", Multiplier: sdkmath.LegacyNewDec(2)},
+			{Attribute: "age", Min: "50", Multiplier: sdkmath.LegacyNewDec(10)},
+			{Attribute: "smoker", Value: "yes", Multiplier: sdkmath.LegacyNewDecWithPrec(15, 1)},
+			{Attribute: keeper.RiskAttributeScore, Min: "0.7", Multiplier: sdkmath.LegacyNewDec(3)},
+		},
+	)
+}
+
+func riskFactors(t *testing.T, score sdkmath.LegacyDec, attributes map[string]string) string {
+	data, err := json.Marshal(keeper.RiskFactors{RiskScore: score, Attributes: attributes})
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestRatingModel(t *testing.T) {
+	k, ctx := keepertest.InsuranceKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	quote := func(version uint64, factors string) (*types.QueryQuotePremiumResponse, error) {
+		return k.QuotePremium(ctx, &types.QueryQuotePremiumRequest{PolicyType: "health", RiskFactors: factors, Version: version})
+	}
+
+	// Until a rating model is adopted, policies are priced by risk level
+	res, err := quote(0, "")
+	require.NoError(t, err)
+	require.Equal(t, keeper.DefaultBasePremium, res.Premium)
+	require.Zero(t, res.Version)
+
+	_, err = srv.UpdateRatingModel(ctx, healthRating(sample.AccAddress(), 100))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	invalid := healthRating(k.GetAuthority(), 100)
+	invalid.Factors[0].Min = "30"
+	require.ErrorIs(t, invalid.ValidateBasic(), types.ErrInvalidRatingModel)
+
+	updated, err := srv.UpdateRatingModel(ctx, healthRating(k.GetAuthority(), 100))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), updated.Version)
+
+	for _, tc := range []struct {
+		desc       string
+		score      sdkmath.LegacyDec
+		attributes map[string]string
+		premium    int64
+	}{
+		{desc: "base rate", attributes: map[string]string{"age": "40"}, premium: 100},
+		{desc: "first matching factor", attributes: map[string]string{"age": "65", "smoker": "no"}, premium: 200},
+		{desc: "floor", attributes: map[string]string{"age": "20"}, premium: 80},
+		{desc: "cap", score: sdkmath.LegacyNewDecWithPrec(9, 1), attributes: map[string]string{"age": "40", "smoker": "yes"}, premium: 300},
+		{desc: "fractional", attributes: map[string]string{"smoker": "yes", "age": "not a number"}, premium: 150},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := quote(0, riskFactors(t, tc.score, tc.attributes))
+			require.NoError(t, err)
+			require.Equal(t, sdkmath.NewInt(tc.premium), res.Premium)
+			require.Equal(t, uint64(1), res.Version)
+		})
+	}
+
+	// Risk scores are read as decimals, from JSON numbers as well
+	res, err = quote(0, `{"risk_score":0.9,"attributes":{"age":"40","smoker":"yes"}}`)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(300), res.Premium)
+	_, err = quote(0, `{"risk_score":1.5}`)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Actuaries reprice with a new version, while the old ones still quote
+	updated, err = srv.UpdateRatingModel(ctx, healthRating(k.GetAuthority(), 120))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), updated.Version)
+	factors := riskFactors(t, sdkmath.LegacyZeroDec(), map[string]string{"age": "40"})
+	res, err = quote(0, factors)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(120), res.Premium)
+	require.Equal(t, uint64(2), res.Version)
+	res, err = quote(1, factors)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), res.Premium)
+
+	latest, err := k.RatingModel(ctx, &types.QueryGetRatingModelRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest.RatingModel.Version)
+	require.Equal(t, k.GetAuthority(), latest.RatingModel.Authority)
+
+	_, err = quote(3, factors)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = k.QuotePremium(ctx, &types.QueryQuotePremiumRequest{PolicyType: "life", RiskFactors: factors})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = quote(0, `{"risk_score":2}`)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQuotedPolicy(t *testing.T) {
+	k, policyKeeper, _, ctx := keepertest.InsuranceKeeperWithPolicy(t)
+	ctx = ctx.WithBlockTime(policyStart)
+	srv := keeper.NewMsgServerImpl(k)
+	_, err := srv.UpdateRatingModel(ctx, healthRating(k.GetAuthority(), 100))
+	require.NoError(t, err)
+
+	// Every policy is priced by the rating model, so it needs risk factors
+	req := policyRequest("tx-1", transactions.NewPolicy, "health")
+	req.PolicyData.RiskFactors = nil
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, req)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	req.PolicyData.RiskFactors = json.RawMessage(riskFactors(t, sdkmath.LegacyZeroDec(), map[string]string{"age": "65"}))
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, req)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	// Policies record the version of the rating model that quoted them
+	req.PolicyData.Premium = sdkmath.Int{}
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, req)))
+	require.NoError(t, err)
+	policy, found := policyKeeper.GetPolicy(ctx, "policy-1")
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(200), policy.Premium.Amount)
+	require.Equal(t, uint64(1), policy.RatingModelVersion)
+
+	_, err = srv.UpdateRatingModel(ctx, healthRating(k.GetAuthority(), 120))
+	require.NoError(t, err)
+	req = policyRequest("tx-2", transactions.UpdatePolicy, "health")
+	req.PolicyData.RiskFactors = json.RawMessage(riskFactors(t, sdkmath.LegacyZeroDec(), map[string]string{"age": "40"}))
+	req.PolicyData.Premium = sdkmath.NewInt(120)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(policyHolder, insuranceData(t, req)))
+	require.NoError(t, err)
+	policy, _ = policyKeeper.GetPolicy(ctx, "policy-1")
+	require.Equal(t, sdkmath.NewInt(120), policy.Premium.Amount)
+	require.Equal(t, uint64(2), policy.RatingModelVersion)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"insurance/x/insurance/types"
+)
+
+// SetTransaction set a specific transaction in the store from its index
+func (k Keeper) SetTransaction(ctx context.Context, transaction types.Transaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransactionKeyPrefix))
+	b := k.cdc.MustMarshal(&transaction)
+	store.Set(types.TransactionKey(
+		transaction.TransactionId,
+	), b)
+}
+
+// GetTransaction returns a transaction from its index
+func (k Keeper) GetTransaction(
+	ctx context.Context,
+	transactionId string,
+) (val types.Transaction, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransactionKeyPrefix))
+
+	b := store.Get(types.TransactionKey(
+		transactionId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTransaction returns all transaction
+func (k Keeper) GetAllTransaction(ctx context.Context) (list []types.Transaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TransactionKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Transaction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,177 @@
+package insurance
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "insurance/api/insurance/insurance"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "TransactionAll",
+					Use:       "list-transaction",
+					Short:     "List all transaction",
+				},
+				{
+					RpcMethod:      "Transaction",
+					Use:            "show-transaction [transaction-id]",
+					Short:          "Shows a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "ContractRecord",
+					Use:            "show-contract-record [kind] [record-id]",
+					Short:          "Shows a record kept by the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kind"}, {ProtoField: "record_id"}},
+				},
+				{
+					RpcMethod:      "AuditTrail",
+					Use:            "audit-trail [subject-id]",
+					Short:          "List the audit trail of a subject",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subject_id"}},
+				},
+				{
+					RpcMethod: "ClaimAll",
+					Use:       "list-claim",
+					Short:     "List all claim",
+				},
+				{
+					RpcMethod:      "Claim",
+					Use:            "show-claim [claim-id]",
+					Short:          "Shows a claim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}},
+				},
+				{
+					RpcMethod:      "ClaimsByPolicy",
+					Use:            "claims-by-policy [policy-id]",
+					Short:          "List the claims filed against a policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_id"}},
+				},
+				{
+					RpcMethod: "FlaggedClaims",
+					Use:       "flagged-claims",
+					Short:     "List the claims flagged for manual review",
+				},
+				{
+					RpcMethod: "RatingModelAll",
+					Use:       "list-rating-model",
+					Short:     "List all the versions of the rating model",
+				},
+				{
+					RpcMethod:      "RatingModel",
+					Use:            "show-rating-model [version]",
+					Short:          "Shows a version of the rating model, the one in force by default",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "version", Optional: true}},
+				},
+				{
+					RpcMethod:      "QuotePremium",
+					Use:            "quote-premium [policy-type] [risk-factors]",
+					Short:          "Price a policy from its JSON risk factors with the rating model",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_type"}, {ProtoField: "risk_factors"}},
+				},
+				{
+					RpcMethod: "OracleReporterAll",
+					Use:       "list-oracle-reporter",
+					Short:     "List all oracle reporter",
+				},
+				{
+					RpcMethod:      "OracleReporter",
+					Use:            "show-oracle-reporter [address]",
+					Short:          "Shows an oracle reporter and its stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "MetricObservations",
+					Use:            "metric-observations [metric]",
+					Short:          "Shows the pending observations of a metric and its settled value",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metric"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateRatingModel",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "InitiateTransaction",
+					Use:            "initiate-transaction [data]",
+					Short:          "Run a JSON encoded insurance transaction through the contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data"}},
+				},
+				{
+					RpcMethod:      "UpdateTransactionStatus",
+					Use:            "update-transaction-status [transaction-id] [status]",
+					Short:          "Update the status of a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}, {ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "FinalizeTransaction",
+					Use:            "finalize-transaction [transaction-id]",
+					Short:          "Finalize a transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "transaction_id"}},
+				},
+				{
+					RpcMethod:      "ReviewClaim",
+					Use:            "review-claim [claim-id]",
+					Short:          "Take a filed claim under review",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}},
+				},
+				{
+					RpcMethod:      "RequestEvidence",
+					Use:            "request-evidence [claim-id] [request]",
+					Short:          "Request more evidence from the claimant of a claim under review",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}, {ProtoField: "request"}},
+				},
+				{
+					RpcMethod:      "DecideClaim",
+					Use:            "decide-claim [claim-id] [approve] [reason]",
+					Short:          "Approve or deny a claim under review or appealed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}, {ProtoField: "approve"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "AppealClaim",
+					Use:            "appeal-claim [claim-id] [reason]",
+					Short:          "Appeal a denied claim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "BondReporter",
+					Use:            "bond-reporter [amount]",
+					Short:          "Bond stake to report metrics as an oracle reporter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "UnbondReporter",
+					Use:       "unbond-reporter",
+					Short:     "Unbond the stake of an oracle reporter at the end of the unbonding period",
+				},
+				{
+					RpcMethod:      "SubmitObservation",
+					Use:            "submit-observation [metric] [value]",
+					Short:          "Report the observed value of a metric",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metric"}, {ProtoField: "value"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package insurance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"insurance/x/insurance/keeper"
+	"insurance/x/insurance/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the transaction
+	for _, elem := range genState.TransactionList {
+		k.SetTransaction(ctx, elem)
+	}
+	// Set all the contractRecord
+	for _, elem := range genState.ContractRecordList {
+		k.SetContractRecord(ctx, elem)
+	}
+	// Set all the auditEntry
+	for _, elem := range genState.AuditEntryList {
+		k.SetAuditEntry(ctx, elem)
+	}
+
+	// Set auditEntry count
+	k.SetAuditEntryCount(ctx, genState.AuditEntryCount)
+	// Set all the claim
+	for _, elem := range genState.ClaimList {
+		k.SetClaim(ctx, elem)
+	}
+	// Set all the ratingModel
+	for _, elem := range genState.RatingModelList {
+		k.SetRatingModel(ctx, elem)
+	}
+	// Set all the oracleReporter
+	for _, elem := range genState.OracleReporterList {
+		k.SetOracleReporter(ctx, elem)
+	}
+	// Set all the observation
+	for _, elem := range genState.ObservationList {
+		k.SetObservation(ctx, elem)
+	}
+	// Set all the metricSettlement
+	for _, elem := range genState.MetricSettlementList {
+		k.SetMetricSettlement(ctx, elem)
+	}
+	// Set all the parametricPolicy
+	for _, elem := range genState.ParametricPolicyList {
+		k.SetParametricPolicy(ctx, elem)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	if err := k.Port().BindPort(ctx); err != nil {
+		panic("could not claim port capability: " + err.Error())
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.TransactionList = k.GetAllTransaction(ctx)
+	genesis.ContractRecordList = k.GetAllContractRecord(ctx)
+	genesis.AuditEntryList = k.GetAllAuditEntry(ctx)
+	genesis.AuditEntryCount = k.GetAuditEntryCount(ctx)
+	genesis.ClaimList = k.GetAllClaim(ctx)
+	genesis.RatingModelList = k.GetAllRatingModel(ctx)
+	genesis.OracleReporterList = k.GetAllOracleReporter(ctx)
+	genesis.ObservationList = k.GetAllObservation(ctx)
+	genesis.MetricSettlementList = k.GetAllMetricSettlement(ctx)
+	genesis.ParametricPolicyList = k.GetAllParametricPolicy(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,2264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insurance/insurance/claim.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Claim is a claim filed against a policy. It is FILED, then UNDER_REVIEW by
+// an adjuster who may request evidence from the claimant, and is APPROVED or
+// DENIED. A denied claim may be APPEALED once. Approved claims are PAID from
+// the policy escrow or through the finance chain. The decisions on a claim
+// submitted by another chain are sent back to it. A filed claim whose fraud
+// score reaches the review threshold is FLAGGED instead, and an appeal
+// adjuster reviews it manually.
+type Claim struct {
+	ClaimId     string                `protobuf:"bytes,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+	PolicyId    string                `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Claimant    string                `protobuf:"bytes,3,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	ClaimType   string                `protobuf:"bytes,4,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Description string                `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Evidence    []ClaimEvidence       `protobuf:"bytes,7,rep,name=evidence,proto3" json:"evidence"`
+	Status      string                `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// adjuster is the adjuster reviewing the claim.
+	Adjuster string `protobuf:"bytes,9,opt,name=adjuster,proto3" json:"adjuster,omitempty"`
+	// evidence_request describes the evidence requested from the claimant.
+	EvidenceRequest string `protobuf:"bytes,10,opt,name=evidence_request,json=evidenceRequest,proto3" json:"evidence_request,omitempty"`
+	DecisionReason  string `protobuf:"bytes,11,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	Appealed        bool   `protobuf:"varint,12,opt,name=appealed,proto3" json:"appealed,omitempty"`
+	AppealReason    string `protobuf:"bytes,13,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	// deadline is when the requested evidence is due, or when the appeal
+	// period of a denied claim ends.
+	Deadline int64 `protobuf:"varint,14,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// payment is the payment message sent to the finance chain when the claim
+	// is paid through it.
+	Payment   []byte `protobuf:"bytes,15,opt,name=payment,proto3" json:"payment,omitempty"`
+	FiledAt   int64  `protobuf:"varint,16,opt,name=filed_at,json=filedAt,proto3" json:"filed_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// source_chain is the chain the claim was submitted from, empty for the
+	// claims filed on the insurance chain.
+	SourceChain string `protobuf:"bytes,18,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	// source_id identifies the claim on its source chain.
+	SourceId string `protobuf:"bytes,19,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// decision is the last decision message prepared for the source chain.
+	Decision []byte `protobuf:"bytes,20,opt,name=decision,proto3" json:"decision,omitempty"`
+	// fraud_score is the sum of the weights of the fraud signals of the claim.
+	FraudScore   uint64        `protobuf:"varint,21,opt,name=fraud_score,json=fraudScore,proto3" json:"fraud_score,omitempty"`
+	FraudSignals []FraudSignal `protobuf:"bytes,22,rep,name=fraud_signals,json=fraudSignals,proto3" json:"fraud_signals"`
+	// corroboration is PENDING while the chain of record of the policy type
+	// is queried for a record corroborating the claim, then CORROBORATED or
+	// UNCORROBORATED. It is empty for the claims that need no corroboration,
+	// like those submitted by the chain of record.
+	Corroboration string `protobuf:"bytes,23,opt,name=corroboration,proto3" json:"corroboration,omitempty"`
+	// corroboration_query is the query sent to the chain of record.
+	CorroborationQuery []byte `protobuf:"bytes,24,opt,name=corroboration_query,json=corroborationQuery,proto3" json:"corroboration_query,omitempty"`
+	// payout_attempts counts the payments sent to the finance chain for the
+	// claim. A payout that fails takes the claim back to adjudication, and its
+	// next payment gets a new transaction ID.
+	PayoutAttempts uint64 `protobuf:"varint,25,opt,name=payout_attempts,json=payoutAttempts,proto3" json:"payout_attempts,omitempty"`
+}
+
+func (m *Claim) Reset()         { *m = Claim{} }
+func (m *Claim) String() string { return proto.CompactTextString(m) }
+func (*Claim) ProtoMessage()    {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8861c20e24db2d9, []int{0}
+}
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return m.Size()
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
+func (m *Claim) GetClaimId() string {
+	if m != nil {
+		return m.ClaimId
+	}
+	return ""
+}
+
+func (m *Claim) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Claim) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
+func (m *Claim) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+func (m *Claim) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Claim) GetEvidence() []ClaimEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *Claim) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Claim) GetAdjuster() string {
+	if m != nil {
+		return m.Adjuster
+	}
+	return ""
+}
+
+func (m *Claim) GetEvidenceRequest() string {
+	if m != nil {
+		return m.EvidenceRequest
+	}
+	return ""
+}
+
+func (m *Claim) GetDecisionReason() string {
+	if m != nil {
+		return m.DecisionReason
+	}
+	return ""
+}
+
+func (m *Claim) GetAppealed() bool {
+	if m != nil {
+		return m.Appealed
+	}
+	return false
+}
+
+func (m *Claim) GetAppealReason() string {
+	if m != nil {
+		return m.AppealReason
+	}
+	return ""
+}
+
+func (m *Claim) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Claim) GetPayment() []byte {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *Claim) GetFiledAt() int64 {
+	if m != nil {
+		return m.FiledAt
+	}
+	return 0
+}
+
+func (m *Claim) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Claim) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *Claim) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *Claim) GetDecision() []byte {
+	if m != nil {
+		return m.Decision
+	}
+	return nil
+}
+
+func (m *Claim) GetFraudScore() uint64 {
+	if m != nil {
+		return m.FraudScore
+	}
+	return 0
+}
+
+func (m *Claim) GetFraudSignals() []FraudSignal {
+	if m != nil {
+		return m.FraudSignals
+	}
+	return nil
+}
+
+func (m *Claim) GetCorroboration() string {
+	if m != nil {
+		return m.Corroboration
+	}
+	return ""
+}
+
+func (m *Claim) GetCorroborationQuery() []byte {
+	if m != nil {
+		return m.CorroborationQuery
+	}
+	return nil
+}
+
+func (m *Claim) GetPayoutAttempts() uint64 {
+	if m != nil {
+		return m.PayoutAttempts
+	}
+	return 0
+}
+
+// FraudSignal is a sign of fraud detected on a claim
+type FraudSignal struct {
+	// kind is reused_evidence, claim_velocity or missing_record.
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Detail string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	Weight uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// related_claim_id is the other claim the signal involves, if any.
+	RelatedClaimId string `protobuf:"bytes,4,opt,name=related_claim_id,json=relatedClaimId,proto3" json:"related_claim_id,omitempty"`
+	DetectedAt     int64  `protobuf:"varint,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (m *FraudSignal) Reset()         { *m = FraudSignal{} }
+func (m *FraudSignal) String() string { return proto.CompactTextString(m) }
+func (*FraudSignal) ProtoMessage()    {}
+func (*FraudSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8861c20e24db2d9, []int{1}
+}
+func (m *FraudSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FraudSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FraudSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FraudSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudSignal.Merge(m, src)
+}
+func (m *FraudSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FraudSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FraudSignal proto.InternalMessageInfo
+
+func (m *FraudSignal) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *FraudSignal) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *FraudSignal) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *FraudSignal) GetRelatedClaimId() string {
+	if m != nil {
+		return m.RelatedClaimId
+	}
+	return ""
+}
+
+func (m *FraudSignal) GetDetectedAt() int64 {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return 0
+}
+
+// ClaimEvidence is a document supporting a claim
+type ClaimEvidence struct {
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Hash        string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	IpfsLink    string `protobuf:"bytes,4,opt,name=ipfs_link,json=ipfsLink,proto3" json:"ipfs_link,omitempty"`
+	SubmittedAt int64  `protobuf:"varint,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+}
+
+func (m *ClaimEvidence) Reset()         { *m = ClaimEvidence{} }
+func (m *ClaimEvidence) String() string { return proto.CompactTextString(m) }
+func (*ClaimEvidence) ProtoMessage()    {}
+func (*ClaimEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8861c20e24db2d9, []int{2}
+}
+func (m *ClaimEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimEvidence.Merge(m, src)
+}
+func (m *ClaimEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimEvidence proto.InternalMessageInfo
+
+func (m *ClaimEvidence) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ClaimEvidence) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ClaimEvidence) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ClaimEvidence) GetIpfsLink() string {
+	if m != nil {
+		return m.IpfsLink
+	}
+	return ""
+}
+
+func (m *ClaimEvidence) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Claim)(nil), "insurance.insurance.Claim")
+	proto.RegisterType((*FraudSignal)(nil), "insurance.insurance.FraudSignal")
+	proto.RegisterType((*ClaimEvidence)(nil), "insurance.insurance.ClaimEvidence")
+}
+
+func init() { proto.RegisterFile("insurance/insurance/claim.proto", fileDescriptor_a8861c20e24db2d9) }
+
+var fileDescriptor_a8861c20e24db2d9 = []byte{
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcd, 0x8e, 0x23, 0x35,
+	0x10, 0xc7, 0xd3, 0x3b, 0x99, 0x7c, 0x38, 0x1f, 0x33, 0xeb, 0xd9, 0x5d, 0x3c, 0xb3, 0x22, 0x69,
+	0x02, 0x12, 0x01, 0x89, 0x04, 0x81, 0x78, 0x80, 0xcc, 0x00, 0x22, 0x82, 0x0b, 0x0d, 0x27, 0x2e,
+	0x2d, 0x4f, 0xdb, 0x49, 0x4c, 0xba, 0xed, 0xde, 0xb6, 0x1b, 0xc8, 0x5b, 0x70, 0x45, 0xe2, 0x01,
+	0xb8, 0xc1, 0x81, 0x87, 0xd8, 0xe3, 0x8a, 0x13, 0xe2, 0x30, 0x42, 0x33, 0x07, 0x5e, 0x03, 0xb9,
+	0xec, 0xee, 0x4c, 0x60, 0x2e, 0xad, 0xfa, 0xff, 0xaa, 0x6c, 0x57, 0xbb, 0xaa, 0x8c, 0xc6, 0x42,
+	0xea, 0xb2, 0xa0, 0x32, 0xe1, 0xf3, 0xbd, 0x95, 0xa4, 0x54, 0x64, 0xb3, 0xbc, 0x50, 0x46, 0xe1,
+	0xb3, 0x1a, 0xcf, 0x6a, 0xeb, 0xe2, 0x31, 0xcd, 0x84, 0x54, 0x73, 0xf8, 0xba, 0xb8, 0x8b, 0xf3,
+	0x44, 0xe9, 0x4c, 0xe9, 0x18, 0xd4, 0xdc, 0x09, 0xef, 0x7a, 0xb2, 0x56, 0x6b, 0xe5, 0xb8, 0xb5,
+	0x1c, 0x9d, 0xfc, 0xda, 0x46, 0xc7, 0x57, 0xf6, 0x20, 0x7c, 0x8e, 0x3a, 0x70, 0x62, 0x2c, 0x18,
+	0x09, 0xc2, 0x60, 0xda, 0x8d, 0xda, 0xa0, 0x97, 0x0c, 0x3f, 0x47, 0xdd, 0x5c, 0xa5, 0x22, 0xd9,
+	0x59, 0xdf, 0x23, 0xf0, 0x75, 0x1c, 0x58, 0x32, 0x7c, 0xe1, 0xd7, 0x51, 0x69, 0xc8, 0x91, 0xf3,
+	0x55, 0x1a, 0xbf, 0x8e, 0x90, 0xdb, 0xd3, 0xec, 0x72, 0x4e, 0x9a, 0xe0, 0xed, 0x02, 0xf9, 0x7a,
+	0x97, 0x73, 0xfc, 0x19, 0x6a, 0xd1, 0x4c, 0x95, 0xd2, 0x90, 0x63, 0xeb, 0xba, 0x7c, 0xff, 0xe5,
+	0xcd, 0xb8, 0xf1, 0xd7, 0xcd, 0xf8, 0xa9, 0x4b, 0x5c, 0xb3, 0xed, 0x4c, 0xa8, 0x79, 0x46, 0xcd,
+	0x66, 0xb6, 0x94, 0xe6, 0x8f, 0xdf, 0xdf, 0x43, 0xfe, 0x8f, 0x96, 0xd2, 0xfc, 0xf2, 0xcf, 0x6f,
+	0xef, 0x06, 0x91, 0x5f, 0x8f, 0x43, 0xd4, 0x63, 0x5c, 0x27, 0x85, 0xc8, 0x8d, 0x50, 0x92, 0xb4,
+	0xe0, 0xa4, 0xfb, 0x08, 0x7f, 0x8c, 0x3a, 0xfc, 0x3b, 0xc1, 0xb8, 0x4c, 0x38, 0x69, 0x87, 0x47,
+	0xd3, 0xde, 0x07, 0x93, 0xd9, 0x03, 0x97, 0x3a, 0x83, 0xcb, 0xf8, 0xc4, 0x47, 0x5e, 0x36, 0x6d,
+	0x46, 0x51, 0xbd, 0x12, 0x3f, 0x43, 0x2d, 0x6d, 0xa8, 0x29, 0x35, 0xe9, 0xc0, 0x11, 0x5e, 0xd9,
+	0x4b, 0xa0, 0xec, 0xdb, 0x52, 0x1b, 0x5e, 0x90, 0xae, 0xbb, 0x84, 0x4a, 0xe3, 0x77, 0xd0, 0x69,
+	0xb5, 0x3e, 0x2e, 0xf8, 0x8b, 0x92, 0x6b, 0x43, 0x10, 0xc4, 0x9c, 0x54, 0x3c, 0x72, 0x18, 0xbf,
+	0x8d, 0x4e, 0x18, 0x4f, 0x84, 0x16, 0x4a, 0xc6, 0x05, 0xa7, 0x5a, 0x49, 0xd2, 0x83, 0xc8, 0x61,
+	0x85, 0x23, 0xa0, 0x70, 0x5e, 0x9e, 0x73, 0x9a, 0x72, 0x46, 0xfa, 0x61, 0x30, 0xed, 0x44, 0xb5,
+	0xc6, 0x6f, 0xa2, 0x81, 0xb3, 0xab, 0x2d, 0x06, 0xb0, 0x45, 0xdf, 0xc1, 0xfd, 0x06, 0x8c, 0x53,
+	0x96, 0x0a, 0xc9, 0xc9, 0x30, 0x0c, 0xa6, 0x47, 0x51, 0xad, 0x31, 0x41, 0xed, 0x9c, 0xee, 0x32,
+	0x2e, 0x0d, 0x39, 0x09, 0x83, 0x69, 0x3f, 0xaa, 0xa4, 0xed, 0x91, 0x95, 0x48, 0x39, 0x8b, 0xa9,
+	0x21, 0xa7, 0xb0, 0xaa, 0x0d, 0x7a, 0x01, 0xa5, 0x2e, 0x73, 0x46, 0x8d, 0x73, 0x3e, 0x06, 0x67,
+	0xd7, 0x93, 0x85, 0xc1, 0x6f, 0xa0, 0xbe, 0x56, 0x65, 0x91, 0xf0, 0x38, 0xd9, 0x50, 0x21, 0x09,
+	0x76, 0x15, 0x72, 0xec, 0xca, 0x22, 0xdb, 0x65, 0x3e, 0x44, 0x30, 0x72, 0xe6, 0x2e, 0xd1, 0x01,
+	0xd7, 0x65, 0xd5, 0x15, 0x90, 0x27, 0x90, 0x54, 0xad, 0xf1, 0x18, 0xf5, 0x56, 0x05, 0x2d, 0x59,
+	0xac, 0x13, 0x55, 0x70, 0xf2, 0x34, 0x0c, 0xa6, 0xcd, 0x08, 0x01, 0xfa, 0xca, 0x12, 0xfc, 0x39,
+	0x1a, 0xf8, 0x00, 0xb1, 0x96, 0x34, 0xd5, 0xe4, 0x19, 0x34, 0x40, 0xf8, 0x60, 0x03, 0x7c, 0x0a,
+	0xeb, 0x20, 0xd0, 0x97, 0xbf, 0xbf, 0xda, 0x23, 0x8d, 0xdf, 0x42, 0x83, 0x44, 0x15, 0x85, 0xba,
+	0x56, 0x05, 0x85, 0x66, 0x7b, 0x0d, 0x52, 0x3d, 0x84, 0x78, 0x8e, 0xce, 0x0e, 0x40, 0xfc, 0xa2,
+	0xe4, 0xc5, 0x8e, 0x10, 0x48, 0x1d, 0x1f, 0xb8, 0xbe, 0xb4, 0x1e, 0x5b, 0xfa, 0x9c, 0xee, 0x54,
+	0x69, 0x62, 0x6a, 0x0c, 0xcf, 0x72, 0xa3, 0xc9, 0x39, 0xfc, 0xc8, 0xd0, 0xe1, 0x85, 0xa7, 0x93,
+	0x9f, 0x03, 0xd4, 0xbb, 0x97, 0x23, 0xc6, 0xa8, 0xb9, 0x15, 0xb2, 0x9a, 0x59, 0xb0, 0x6d, 0x9b,
+	0x32, 0x6e, 0xa8, 0x48, 0xfd, 0xb4, 0x7a, 0x65, 0xf9, 0xf7, 0x5c, 0xac, 0x37, 0x6e, 0x52, 0x9b,
+	0x91, 0x57, 0x78, 0x8a, 0x4e, 0x0b, 0x9e, 0x42, 0xf1, 0xea, 0x37, 0xc0, 0x4d, 0xeb, 0xd0, 0xf3,
+	0x2b, 0xff, 0x14, 0x8c, 0xed, 0xa0, 0x19, 0x9e, 0xf8, 0x3a, 0x1f, 0x43, 0x9d, 0x51, 0x85, 0x16,
+	0x66, 0xf2, 0x53, 0x80, 0x06, 0x07, 0x33, 0x64, 0x13, 0x84, 0xf1, 0xf7, 0x09, 0x5a, 0xfb, 0xbf,
+	0xf3, 0xfa, 0xe8, 0xff, 0xf3, 0x8a, 0x51, 0x73, 0x43, 0xf5, 0xc6, 0x3f, 0x29, 0x60, 0xdb, 0x0e,
+	0x11, 0xf9, 0x4a, 0xc7, 0xa9, 0x90, 0x5b, 0x9f, 0x5f, 0xc7, 0x82, 0x2f, 0x84, 0xdc, 0x42, 0x87,
+	0x95, 0xd7, 0x99, 0x30, 0x07, 0xa9, 0xf5, 0x6a, 0xb6, 0x30, 0x97, 0x1f, 0xbd, 0xbc, 0x1d, 0x05,
+	0xaf, 0x6e, 0x47, 0xc1, 0xdf, 0xb7, 0xa3, 0xe0, 0xc7, 0xbb, 0x51, 0xe3, 0xd5, 0xdd, 0xa8, 0xf1,
+	0xe7, 0xdd, 0xa8, 0xf1, 0xcd, 0xf3, 0xfd, 0xb3, 0xfb, 0xc3, 0xbd, 0x27, 0xd8, 0xe6, 0xaa, 0xaf,
+	0x5b, 0xf0, 0x54, 0x7e, 0xf8, 0xef, 0x00, 0xec, 0xf3, 0x63, 0xc3, 0xa6, 0x05, 0x00, 0x00,
+}
+
+func (m *Claim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutAttempts != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.PayoutAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.CorroborationQuery) > 0 {
+		i -= len(m.CorroborationQuery)
+		copy(dAtA[i:], m.CorroborationQuery)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.CorroborationQuery)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.Corroboration) > 0 {
+		i -= len(m.Corroboration)
+		copy(dAtA[i:], m.Corroboration)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Corroboration)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.FraudSignals) > 0 {
+		for iNdEx := len(m.FraudSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FraudSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.FraudScore != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.FraudScore))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Decision) > 0 {
+		i -= len(m.Decision)
+		copy(dAtA[i:], m.Decision)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Decision)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.SourceId) > 0 {
+		i -= len(m.SourceId)
+		copy(dAtA[i:], m.SourceId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.SourceId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.FiledAt != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.FiledAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Payment) > 0 {
+		i -= len(m.Payment)
+		copy(dAtA[i:], m.Payment)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Payment)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AppealReason) > 0 {
+		i -= len(m.AppealReason)
+		copy(dAtA[i:], m.AppealReason)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.AppealReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Appealed {
+		i--
+		if m.Appealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.DecisionReason) > 0 {
+		i -= len(m.DecisionReason)
+		copy(dAtA[i:], m.DecisionReason)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.DecisionReason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.EvidenceRequest) > 0 {
+		i -= len(m.EvidenceRequest)
+		copy(dAtA[i:], m.EvidenceRequest)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.EvidenceRequest)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Adjuster) > 0 {
+		i -= len(m.Adjuster)
+		copy(dAtA[i:], m.Adjuster)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Adjuster)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClaimType) > 0 {
+		i -= len(m.ClaimType)
+		copy(dAtA[i:], m.ClaimType)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.ClaimType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimId) > 0 {
+		i -= len(m.ClaimId)
+		copy(dAtA[i:], m.ClaimId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.ClaimId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FraudSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FraudSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FraudSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DetectedAt != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.DetectedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RelatedClaimId) > 0 {
+		i -= len(m.RelatedClaimId)
+		copy(dAtA[i:], m.RelatedClaimId)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.RelatedClaimId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Weight != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedAt != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.IpfsLink) > 0 {
+		i -= len(m.IpfsLink)
+		copy(dAtA[i:], m.IpfsLink)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.IpfsLink)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaim(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.ClaimType)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovClaim(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Adjuster)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.EvidenceRequest)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.DecisionReason)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Appealed {
+		n += 2
+	}
+	l = len(m.AppealReason)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovClaim(uint64(m.Deadline))
+	}
+	l = len(m.Payment)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.FiledAt != 0 {
+		n += 2 + sovClaim(uint64(m.FiledAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 2 + sovClaim(uint64(m.UpdatedAt))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 2 + l + sovClaim(uint64(l))
+	}
+	l = len(m.SourceId)
+	if l > 0 {
+		n += 2 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Decision)
+	if l > 0 {
+		n += 2 + l + sovClaim(uint64(l))
+	}
+	if m.FraudScore != 0 {
+		n += 2 + sovClaim(uint64(m.FraudScore))
+	}
+	if len(m.FraudSignals) > 0 {
+		for _, e := range m.FraudSignals {
+			l = e.Size()
+			n += 2 + l + sovClaim(uint64(l))
+		}
+	}
+	l = len(m.Corroboration)
+	if l > 0 {
+		n += 2 + l + sovClaim(uint64(l))
+	}
+	l = len(m.CorroborationQuery)
+	if l > 0 {
+		n += 2 + l + sovClaim(uint64(l))
+	}
+	if m.PayoutAttempts != 0 {
+		n += 2 + sovClaim(uint64(m.PayoutAttempts))
+	}
+	return n
+}
+
+func (m *FraudSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovClaim(uint64(m.Weight))
+	}
+	l = len(m.RelatedClaimId)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.DetectedAt != 0 {
+		n += 1 + sovClaim(uint64(m.DetectedAt))
+	}
+	return n
+}
+
+func (m *ClaimEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	l = len(m.IpfsLink)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovClaim(uint64(m.SubmittedAt))
+	}
+	return n
+}
+
+func sovClaim(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaim(x uint64) (n int) {
+	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, ClaimEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjuster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adjuster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceRequest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceRequest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecisionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Appealed = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payment = append(m.Payment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payment == nil {
+				m.Payment = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiledAt", wireType)
+			}
+			m.FiledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FiledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decision = append(m.Decision[:0], dAtA[iNdEx:postIndex]...)
+			if m.Decision == nil {
+				m.Decision = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudScore", wireType)
+			}
+			m.FraudScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FraudSignals = append(m.FraudSignals, FraudSignal{})
+			if err := m.FraudSignals[len(m.FraudSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corroboration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corroboration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorroborationQuery", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorroborationQuery = append(m.CorroborationQuery[:0], dAtA[iNdEx:postIndex]...)
+			if m.CorroborationQuery == nil {
+				m.CorroborationQuery = []byte{}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAttempts", wireType)
+			}
+			m.PayoutAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FraudSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FraudSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FraudSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedClaimId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelatedClaimId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			m.DetectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsLink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsLink = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaim(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaim
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaim
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaim
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaim        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaim          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaim = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	// this line is used by starport scaffolding # 1
+)
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgInitiateTransaction{},
+		&MsgUpdateTransactionStatus{},
+		&MsgFinalizeTransaction{},
+		&MsgReviewClaim{},
+		&MsgRequestEvidence{},
+		&MsgDecideClaim{},
+		&MsgAppealClaim{},
+		&MsgBondReporter{},
+		&MsgUnbondReporter{},
+		&MsgSubmitObservation{},
+	)
+	// this line is used by starport scaffolding # 3
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgUpdateRatingModel{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
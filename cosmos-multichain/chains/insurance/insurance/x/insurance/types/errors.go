@@ -0,0 +1,28 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/insurance module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTransaction   = sdkerrors.Register(ModuleName, 1101, "invalid transaction")
+	ErrTransactionExists    = sdkerrors.Register(ModuleName, 1102, "transaction already exists")
+	ErrTransactionNotFound  = sdkerrors.Register(ModuleName, 1103, "transaction not found")
+	ErrTransactionFinalized = sdkerrors.Register(ModuleName, 1104, "transaction is finalized")
+	ErrRecordNotFound       = sdkerrors.Register(ModuleName, 1105, "contract record not found")
+	ErrInvalidClaim         = sdkerrors.Register(ModuleName, 1106, "invalid claim")
+	ErrClaimExists          = sdkerrors.Register(ModuleName, 1107, "claim already exists")
+	ErrClaimNotFound        = sdkerrors.Register(ModuleName, 1108, "claim not found")
+	ErrInvalidClaimStatus   = sdkerrors.Register(ModuleName, 1109, "invalid claim status")
+	ErrInvalidRatingModel   = sdkerrors.Register(ModuleName, 1110, "invalid rating model")
+	ErrRatingModelNotFound  = sdkerrors.Register(ModuleName, 1111, "rating model not found")
+	ErrInvalidStake         = sdkerrors.Register(ModuleName, 1112, "invalid reporter stake")
+	ErrReporterNotFound     = sdkerrors.Register(ModuleName, 1113, "oracle reporter not found")
+	ErrInvalidObservation   = sdkerrors.Register(ModuleName, 1114, "invalid observation")
+	ErrMetricSettled        = sdkerrors.Register(ModuleName, 1115, "metric already settled")
+	ErrReporterUnbonding    = sdkerrors.Register(ModuleName, 1116, "oracle reporter is unbonding")
+)
@@ -0,0 +1,40 @@
+package types
+
+// insurance module event types
+const (
+	EventTypeTransactionInitiated     = "transaction_initiated"
+	EventTypeTransactionStatusUpdated = "transaction_status_updated"
+	EventTypeTransactionFinalized     = "transaction_finalized"
+	EventTypeClaimFiled               = "claim_filed"
+	EventTypeClaimStatus              = "claim_status"
+	EventTypeClaimPaid                = "claim_paid"
+	EventTypeClaimPayoutRouted        = "claim_payout_routed"
+	EventTypeClaimPayoutFailed        = "claim_payout_failed"
+	EventTypeRatingModelUpdated       = "rating_model_updated"
+	EventTypeReporterBonded           = "reporter_bonded"
+	EventTypeReporterUnbonding        = "reporter_unbonding"
+	EventTypeReporterUnbonded         = "reporter_unbonded"
+	EventTypeReporterSlashed          = "reporter_slashed"
+	EventTypeObservationSubmitted     = "observation_submitted"
+	EventTypeMetricSettled            = "metric_settled"
+	EventTypeClaimFraudSignal         = "claim_fraud_signal"
+
+	AttributeKeyTransactionId   = "transaction_id"
+	AttributeKeyTransactionType = "transaction_type"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyStatus          = "status"
+	AttributeKeyClaimId         = "claim_id"
+	AttributeKeyPolicyId        = "policy_id"
+	AttributeKeyClaimant        = "claimant"
+	AttributeKeyAdjuster        = "adjuster"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyDeadline        = "deadline"
+	AttributeKeyVersion         = "version"
+	AttributeKeyAuthority       = "authority"
+	AttributeKeyReporter        = "reporter"
+	AttributeKeyMetric          = "metric"
+	AttributeKeyValue           = "value"
+	AttributeKeyKind            = "kind"
+	AttributeKeyScore           = "score"
+	AttributeKeyReason          = "reason"
+)
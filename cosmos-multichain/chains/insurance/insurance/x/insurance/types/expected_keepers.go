@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	policytypes "insurance/x/policy/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	// Methods imported from account should be defined here
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	// Methods imported from bank should be defined here
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
+
+// PolicyKeeper defines the expected interface for the policy module.
+type PolicyKeeper interface {
+	CreatePolicy(ctx context.Context, policy policytypes.Policy) error
+	UpdatePolicy(ctx context.Context, policy policytypes.Policy) error
+	CancelPolicy(ctx context.Context, policyId string) (sdk.Coin, error)
+	GetPolicy(ctx context.Context, policyId string) (policytypes.Policy, bool)
+	GetHolderPolicies(ctx context.Context, holder string) []policytypes.Policy
+	GetParams(ctx context.Context) policytypes.Params
+	ClaimCoverage(ctx context.Context, policyId string, amount sdkmath.Int) error
+	ReleaseCoverage(ctx context.Context, policyId string, amount sdkmath.Int) error
+	EscrowBalance(ctx context.Context, denom string) sdk.Coin
+	PayFromEscrow(ctx context.Context, recipient string, amount sdk.Coin) error
+}
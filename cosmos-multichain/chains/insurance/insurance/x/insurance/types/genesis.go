@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		TransactionList:      []Transaction{},
+		ContractRecordList:   []ContractRecord{},
+		AuditEntryList:       []AuditEntry{},
+		ClaimList:            []Claim{},
+		RatingModelList:      []RatingModel{},
+		OracleReporterList:   []OracleReporter{},
+		ObservationList:      []Observation{},
+		MetricSettlementList: []MetricSettlement{},
+		ParametricPolicyList: []ParametricPolicy{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in transaction
+	transactionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TransactionList {
+		index := string(TransactionKey(elem.TransactionId))
+		if _, ok := transactionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transaction")
+		}
+		transactionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in contractRecord
+	contractRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ContractRecordList {
+		index := string(ContractRecordKey(elem.Kind, elem.RecordId))
+		if _, ok := contractRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for contractRecord")
+		}
+		contractRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in auditEntry
+	auditEntryIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AuditEntryList {
+		if _, ok := auditEntryIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auditEntry")
+		}
+		if elem.Id >= gs.AuditEntryCount {
+			return fmt.Errorf("auditEntry id should be lower or equal than the last id")
+		}
+		auditEntryIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in claim
+	claimIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ClaimList {
+		index := string(ClaimKey(elem.ClaimId))
+		if _, ok := claimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for claim")
+		}
+		if elem.PolicyId == "" {
+			return fmt.Errorf("claim %s has no policy", elem.ClaimId)
+		}
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() {
+			return fmt.Errorf("claim %s has an invalid amount", elem.ClaimId)
+		}
+		claimIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated version in ratingModel
+	ratingModelVersionMap := make(map[uint64]bool)
+	for _, elem := range gs.RatingModelList {
+		if elem.Version == 0 || ratingModelVersionMap[elem.Version] {
+			return fmt.Errorf("invalid or duplicated version %d for ratingModel", elem.Version)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("ratingModel %d: %w", elem.Version, err)
+		}
+		ratingModelVersionMap[elem.Version] = true
+	}
+	// Check for duplicated index in oracleReporter
+	oracleReporterIndexMap := make(map[string]struct{})
+	for _, elem := range gs.OracleReporterList {
+		index := string(OracleReporterKey(elem.Address))
+		if _, ok := oracleReporterIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for oracleReporter")
+		}
+		if !elem.Stake.IsValid() || !elem.Stake.IsPositive() {
+			return fmt.Errorf("oracleReporter %s has an invalid stake", elem.Address)
+		}
+		oracleReporterIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in metricSettlement
+	metricSettlementIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MetricSettlementList {
+		if err := ValidateMetric(elem.Metric); err != nil {
+			return err
+		}
+		index := string(MetricKey(elem.Metric))
+		if _, ok := metricSettlementIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for metricSettlement")
+		}
+		metricSettlementIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in observation, whose metric is pending
+	observationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ObservationList {
+		if err := ValidateMetric(elem.Metric); err != nil {
+			return err
+		}
+		index := string(ObservationKey(elem.Metric, elem.Reporter))
+		if _, ok := observationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for observation")
+		}
+		if _, ok := metricSettlementIndexMap[string(MetricKey(elem.Metric))]; ok {
+			return fmt.Errorf("observation of settled metric %s", elem.Metric)
+		}
+		observationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in parametricPolicy
+	parametricPolicyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ParametricPolicyList {
+		if err := ValidateMetric(elem.Metric); err != nil {
+			return err
+		}
+		index := string(ParametricPolicyIndexKey(elem.Metric, elem.PolicyId))
+		if _, ok := parametricPolicyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for parametricPolicy")
+		}
+		parametricPolicyIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
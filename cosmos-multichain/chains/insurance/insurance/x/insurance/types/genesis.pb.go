@@ -0,0 +1,936 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insurance/insurance/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the insurance module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransactionList      []Transaction      `protobuf:"bytes,2,rep,name=transaction_list,json=transactionList,proto3" json:"transaction_list"`
+	ContractRecordList   []ContractRecord   `protobuf:"bytes,3,rep,name=contract_record_list,json=contractRecordList,proto3" json:"contract_record_list"`
+	AuditEntryList       []AuditEntry       `protobuf:"bytes,4,rep,name=audit_entry_list,json=auditEntryList,proto3" json:"audit_entry_list"`
+	AuditEntryCount      uint64             `protobuf:"varint,5,opt,name=audit_entry_count,json=auditEntryCount,proto3" json:"audit_entry_count,omitempty"`
+	ClaimList            []Claim            `protobuf:"bytes,6,rep,name=claim_list,json=claimList,proto3" json:"claim_list"`
+	RatingModelList      []RatingModel      `protobuf:"bytes,7,rep,name=rating_model_list,json=ratingModelList,proto3" json:"rating_model_list"`
+	OracleReporterList   []OracleReporter   `protobuf:"bytes,8,rep,name=oracle_reporter_list,json=oracleReporterList,proto3" json:"oracle_reporter_list"`
+	ObservationList      []Observation      `protobuf:"bytes,9,rep,name=observation_list,json=observationList,proto3" json:"observation_list"`
+	MetricSettlementList []MetricSettlement `protobuf:"bytes,10,rep,name=metric_settlement_list,json=metricSettlementList,proto3" json:"metric_settlement_list"`
+	ParametricPolicyList []ParametricPolicy `protobuf:"bytes,11,rep,name=parametric_policy_list,json=parametricPolicyList,proto3" json:"parametric_policy_list"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84d8ae3c6d9fab2f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTransactionList() []Transaction {
+	if m != nil {
+		return m.TransactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRecordList() []ContractRecord {
+	if m != nil {
+		return m.ContractRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryList() []AuditEntry {
+	if m != nil {
+		return m.AuditEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntryCount() uint64 {
+	if m != nil {
+		return m.AuditEntryCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetClaimList() []Claim {
+	if m != nil {
+		return m.ClaimList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRatingModelList() []RatingModel {
+	if m != nil {
+		return m.RatingModelList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleReporterList() []OracleReporter {
+	if m != nil {
+		return m.OracleReporterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservationList() []Observation {
+	if m != nil {
+		return m.ObservationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMetricSettlementList() []MetricSettlement {
+	if m != nil {
+		return m.MetricSettlementList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParametricPolicyList() []ParametricPolicy {
+	if m != nil {
+		return m.ParametricPolicyList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "insurance.insurance.GenesisState")
+}
+
+func init() { proto.RegisterFile("insurance/insurance/genesis.proto", fileDescriptor_84d8ae3c6d9fab2f) }
+
+var fileDescriptor_84d8ae3c6d9fab2f = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0x3a, 0xea, 0x22, 0xd6, 0x86, 0x0a, 0x55, 0x9d, 0x94, 0x16, 0xd0, 0x50,
+	0xb5, 0x43, 0x2b, 0x0d, 0x71, 0x05, 0xd1, 0x09, 0x71, 0x61, 0x6a, 0x97, 0x71, 0x82, 0x43, 0xe4,
+	0xb9, 0x56, 0x65, 0x29, 0xb1, 0x23, 0xdb, 0x45, 0xec, 0x0b, 0x70, 0xe6, 0x63, 0x70, 0xe4, 0x63,
+	0xec, 0xb8, 0x23, 0x27, 0x84, 0xda, 0x03, 0x5f, 0x63, 0xca, 0x6b, 0xe7, 0xcf, 0x26, 0x2b, 0x97,
+	0xca, 0x7a, 0xfb, 0x3c, 0xbf, 0x27, 0x7e, 0x62, 0x07, 0x3d, 0x67, 0x5c, 0x6d, 0x24, 0xe6, 0x84,
+	0xce, 0xca, 0xd5, 0x9a, 0x72, 0xaa, 0x98, 0x9a, 0xa6, 0x52, 0x68, 0xe1, 0x3f, 0x2d, 0xfe, 0x98,
+	0x16, 0xab, 0x61, 0x0f, 0x27, 0x8c, 0x8b, 0x19, 0xfc, 0x1a, 0xdd, 0xb0, 0xbf, 0x16, 0x6b, 0x01,
+	0xcb, 0x59, 0xb6, 0xb2, 0xd3, 0xb1, 0x2b, 0x20, 0xc5, 0x12, 0x27, 0x96, 0x3f, 0x3c, 0x72, 0x29,
+	0xb4, 0xc4, 0x5c, 0x61, 0xa2, 0x99, 0xe0, 0x75, 0x20, 0x49, 0x89, 0x90, 0x2b, 0xab, 0x18, 0xb9,
+	0x14, 0x24, 0xc6, 0x2c, 0xb1, 0x82, 0x57, 0x4e, 0x04, 0xd6, 0x8c, 0xaf, 0xa3, 0x44, 0xac, 0x68,
+	0x5c, 0x17, 0x25, 0x24, 0x26, 0x31, 0x35, 0x8a, 0x17, 0x3f, 0xf6, 0xd1, 0xe3, 0x8f, 0xa6, 0xa5,
+	0x0b, 0x8d, 0x35, 0xf5, 0xdf, 0xa2, 0x96, 0xd9, 0xd4, 0xc0, 0x1b, 0x7b, 0x93, 0xce, 0xc9, 0xe1,
+	0xd4, 0xd1, 0xda, 0x74, 0x09, 0x92, 0x79, 0xfb, 0xfa, 0xef, 0xa8, 0xf1, 0xeb, 0xff, 0xef, 0x63,
+	0x2f, 0xb4, 0x2e, 0xff, 0x1c, 0x75, 0x2b, 0x5b, 0x8e, 0x62, 0xa6, 0xf4, 0xe0, 0xc1, 0x78, 0x6f,
+	0xd2, 0x39, 0x19, 0x3b, 0x49, 0x9f, 0x4b, 0xf1, 0xbc, 0x99, 0xe1, 0xc2, 0x83, 0x8a, 0xff, 0x13,
+	0x53, 0xda, 0xff, 0x8a, 0xfa, 0x44, 0x70, 0x2d, 0x31, 0xd1, 0x91, 0xe9, 0xc9, 0x60, 0xf7, 0x00,
+	0xfb, 0xd2, 0x89, 0x3d, 0xb5, 0x86, 0x10, 0xf4, 0x96, 0xec, 0x93, 0x3b, 0x53, 0x80, 0x2f, 0x50,
+	0x17, 0x6f, 0x56, 0x4c, 0x47, 0x94, 0x6b, 0x79, 0x65, 0xc0, 0x4d, 0x00, 0x8f, 0x9c, 0xe0, 0xf7,
+	0x99, 0xf8, 0x43, 0xa6, 0xb5, 0xd0, 0x27, 0xb8, 0x98, 0x00, 0xf0, 0x18, 0xf5, 0xaa, 0x40, 0x22,
+	0x36, 0x5c, 0x0f, 0x1e, 0x8e, 0xbd, 0x49, 0x33, 0x3c, 0x28, 0xa5, 0xa7, 0xd9, 0xd8, 0x7f, 0x87,
+	0x10, 0xbc, 0x56, 0x13, 0xdb, 0x82, 0xd8, 0xa1, 0x7b, 0x3f, 0x99, 0xcc, 0x26, 0xb6, 0xc1, 0x03,
+	0x61, 0x21, 0xea, 0x55, 0x5f, 0xbb, 0xe1, 0xec, 0xd7, 0xd4, 0x1d, 0x82, 0xfa, 0x2c, 0x13, 0xe7,
+	0x75, 0xcb, 0x72, 0x94, 0xd7, 0x6d, 0x8e, 0x48, 0x24, 0x69, 0x2a, 0xa4, 0xa6, 0xd2, 0x60, 0x1f,
+	0xd5, 0xd4, 0xbd, 0x00, 0x43, 0x68, 0xf5, 0x79, 0xdd, 0xe2, 0xce, 0x14, 0xe0, 0xe7, 0xa8, 0x2b,
+	0x2e, 0x15, 0x95, 0xdf, 0x70, 0x79, 0x3c, 0xda, 0x35, 0xcf, 0xbb, 0x28, 0xc5, 0xf9, 0xf3, 0x56,
+	0xfc, 0x80, 0xc4, 0xe8, 0x59, 0x42, 0xb5, 0x64, 0x24, 0x52, 0x54, 0xeb, 0x98, 0x26, 0x94, 0x6b,
+	0x03, 0x46, 0x00, 0x3e, 0x72, 0x82, 0xcf, 0xc0, 0x72, 0x51, 0x38, 0x2c, 0xbd, 0x9f, 0xdc, 0x9b,
+	0xe7, 0x11, 0x70, 0xbc, 0x4d, 0x4c, 0x2a, 0x62, 0x46, 0xec, 0x51, 0xe9, 0xd4, 0x44, 0x2c, 0x0b,
+	0xcb, 0x12, 0x1c, 0x79, 0x44, 0x7a, 0x6f, 0x9e, 0x45, 0xcc, 0xdf, 0x5c, 0x6f, 0x03, 0xef, 0x66,
+	0x1b, 0x78, 0xff, 0xb6, 0x81, 0xf7, 0x73, 0x17, 0x34, 0x6e, 0x76, 0x41, 0xe3, 0xcf, 0x2e, 0x68,
+	0x7c, 0x39, 0x2c, 0xaf, 0xee, 0xf7, 0xea, 0x87, 0xe5, 0x2a, 0xa5, 0xea, 0xb2, 0x05, 0xd7, 0xf8,
+	0xf5, 0xed, 0x00, 0xe5, 0x23, 0x11, 0x41, 0xff, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParametricPolicyList) > 0 {
+		for iNdEx := len(m.ParametricPolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParametricPolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MetricSettlementList) > 0 {
+		for iNdEx := len(m.MetricSettlementList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetricSettlementList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ObservationList) > 0 {
+		for iNdEx := len(m.ObservationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.OracleReporterList) > 0 {
+		for iNdEx := len(m.OracleReporterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleReporterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RatingModelList) > 0 {
+		for iNdEx := len(m.RatingModelList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatingModelList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClaimList) > 0 {
+		for iNdEx := len(m.ClaimList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditEntryCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AuditEntryList) > 0 {
+		for iNdEx := len(m.AuditEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for iNdEx := len(m.ContractRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransactionList) > 0 {
+		for iNdEx := len(m.TransactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransactionList) > 0 {
+		for _, e := range m.TransactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRecordList) > 0 {
+		for _, e := range m.ContractRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntryList) > 0 {
+		for _, e := range m.AuditEntryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditEntryCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditEntryCount))
+	}
+	if len(m.ClaimList) > 0 {
+		for _, e := range m.ClaimList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RatingModelList) > 0 {
+		for _, e := range m.RatingModelList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleReporterList) > 0 {
+		for _, e := range m.OracleReporterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservationList) > 0 {
+		for _, e := range m.ObservationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MetricSettlementList) > 0 {
+		for _, e := range m.MetricSettlementList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParametricPolicyList) > 0 {
+		for _, e := range m.ParametricPolicyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionList = append(m.TransactionList, Transaction{})
+			if err := m.TransactionList[len(m.TransactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractRecordList = append(m.ContractRecordList, ContractRecord{})
+			if err := m.ContractRecordList[len(m.ContractRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntryList = append(m.AuditEntryList, AuditEntry{})
+			if err := m.AuditEntryList[len(m.AuditEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntryCount", wireType)
+			}
+			m.AuditEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimList = append(m.ClaimList, Claim{})
+			if err := m.ClaimList[len(m.ClaimList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingModelList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatingModelList = append(m.RatingModelList, RatingModel{})
+			if err := m.RatingModelList[len(m.RatingModelList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleReporterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleReporterList = append(m.OracleReporterList, OracleReporter{})
+			if err := m.OracleReporterList[len(m.OracleReporterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationList = append(m.ObservationList, Observation{})
+			if err := m.ObservationList[len(m.ObservationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricSettlementList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricSettlementList = append(m.MetricSettlementList, MetricSettlement{})
+			if err := m.MetricSettlementList[len(m.MetricSettlementList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametricPolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParametricPolicyList = append(m.ParametricPolicyList, ParametricPolicy{})
+			if err := m.ParametricPolicyList[len(m.ParametricPolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
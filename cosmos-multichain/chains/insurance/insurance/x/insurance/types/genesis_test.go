@@ -0,0 +1,237 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"insurance/x/insurance/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "1"},
+				},
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "b", RecordId: "0"},
+				},
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0, SubjectId: "0"},
+					{Id: 1, SubjectId: "0"},
+				},
+				AuditEntryCount: 2,
+				ClaimList: []types.Claim{
+					{ClaimId: "0", PolicyId: "0", Amount: sdkmath.NewInt(1)},
+					{ClaimId: "1", PolicyId: "0", Amount: sdkmath.NewInt(1)},
+				},
+				RatingModelList: []types.RatingModel{
+					{Version: 1, BaseRates: []types.BaseRate{{PolicyType: "health", Rate: sdkmath.NewInt(100)}}},
+					{Version: 2, BaseRates: []types.BaseRate{{PolicyType: "health", Rate: sdkmath.NewInt(120)}}},
+				},
+				OracleReporterList: []types.OracleReporter{
+					{Address: "0", Stake: sdk.NewInt64Coin("stake", 1000)},
+					{Address: "1", Stake: sdk.NewInt64Coin("stake", 1000)},
+				},
+				ObservationList: []types.Observation{
+					{Metric: "a", Reporter: "0", Value: sdkmath.LegacyNewDec(1)},
+					{Metric: "a", Reporter: "1", Value: sdkmath.LegacyNewDec(2)},
+				},
+				MetricSettlementList: []types.MetricSettlement{
+					{Metric: "b", Value: sdkmath.LegacyNewDec(1)},
+				},
+				ParametricPolicyList: []types.ParametricPolicy{
+					{Metric: "a", PolicyId: "0"},
+					{Metric: "a", PolicyId: "1"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated transaction",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TransactionList: []types.Transaction{
+					{TransactionId: "0"},
+					{TransactionId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contractRecord",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractRecordList: []types.ContractRecord{
+					{Kind: "a", RecordId: "0"},
+					{Kind: "a", RecordId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auditEntry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 0},
+					{Id: 0},
+				},
+				AuditEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auditEntry count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AuditEntryList: []types.AuditEntry{
+					{Id: 1},
+				},
+				AuditEntryCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated claim",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ClaimList: []types.Claim{
+					{ClaimId: "0", PolicyId: "0", Amount: sdkmath.NewInt(1)},
+					{ClaimId: "0", PolicyId: "0", Amount: sdkmath.NewInt(1)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "claim without amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ClaimList: []types.Claim{
+					{ClaimId: "0", PolicyId: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid adjuster",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"invalid"}, nil, types.DefaultEvidencePeriod, types.DefaultAppealPeriod, types.DefaultOracleMinStake, types.DefaultOracleQuorum,
+					types.DefaultFraudReviewThreshold, types.DefaultFraudVelocityWindow, types.DefaultFraudVelocityLimit, types.DefaultCounterparties,
+					types.DefaultOracleUnbondingPeriod, types.DefaultOracleMaxDeviation, types.DefaultOracleSlashFraction),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ratingModel",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RatingModelList: []types.RatingModel{
+					{Version: 1, BaseRates: []types.BaseRate{{PolicyType: "health", Rate: sdkmath.NewInt(100)}}},
+					{Version: 1, BaseRates: []types.BaseRate{{PolicyType: "health", Rate: sdkmath.NewInt(120)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ratingModel without base rates",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RatingModelList: []types.RatingModel{
+					{Version: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid oracle quorum",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, nil, types.DefaultEvidencePeriod, types.DefaultAppealPeriod, types.DefaultOracleMinStake, sdkmath.LegacyNewDecWithPrec(15, 1),
+					types.DefaultFraudReviewThreshold, types.DefaultFraudVelocityWindow, types.DefaultFraudVelocityLimit, types.DefaultCounterparties,
+					types.DefaultOracleUnbondingPeriod, types.DefaultOracleMaxDeviation, types.DefaultOracleSlashFraction),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero fraud review threshold",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, nil, types.DefaultEvidencePeriod, types.DefaultAppealPeriod, types.DefaultOracleMinStake, types.DefaultOracleQuorum,
+					0, types.DefaultFraudVelocityWindow, types.DefaultFraudVelocityLimit, types.DefaultCounterparties,
+					types.DefaultOracleUnbondingPeriod, types.DefaultOracleMaxDeviation, types.DefaultOracleSlashFraction),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid oracle slash fraction",
+			genState: &types.GenesisState{
+				Params: types.NewParams(nil, nil, types.DefaultEvidencePeriod, types.DefaultAppealPeriod, types.DefaultOracleMinStake, types.DefaultOracleQuorum,
+					types.DefaultFraudReviewThreshold, types.DefaultFraudVelocityWindow, types.DefaultFraudVelocityLimit, types.DefaultCounterparties,
+					types.DefaultOracleUnbondingPeriod, types.DefaultOracleMaxDeviation, sdkmath.LegacyNewDecWithPrec(15, 1)),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated oracleReporter",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OracleReporterList: []types.OracleReporter{
+					{Address: "0", Stake: sdk.NewInt64Coin("stake", 1000)},
+					{Address: "0", Stake: sdk.NewInt64Coin("stake", 1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "observation of a settled metric",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ObservationList: []types.Observation{
+					{Metric: "a", Reporter: "0", Value: sdkmath.LegacyNewDec(1)},
+				},
+				MetricSettlementList: []types.MetricSettlement{
+					{Metric: "a", Value: sdkmath.LegacyNewDec(1)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated parametricPolicy",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ParametricPolicyList: []types.ParametricPolicy{
+					{Metric: "a", PolicyId: "0"},
+					{Metric: "a", PolicyId: "0"},
+				},
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
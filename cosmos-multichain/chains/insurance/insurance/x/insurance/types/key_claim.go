@@ -0,0 +1,85 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ClaimKeyPrefix is the prefix to retrieve all Claim
+	ClaimKeyPrefix = "Claim/value/"
+
+	// ClaimPolicyIndexPrefix indexes the claims by policy
+	ClaimPolicyIndexPrefix = "Claim/policy/"
+
+	// ClaimDeadlineQueuePrefix orders the claims awaiting evidence by the
+	// deadline of the evidence
+	ClaimDeadlineQueuePrefix = "Claim/deadline/"
+
+	// ClaimEvidenceIndexPrefix indexes the claims by the hashes of their
+	// evidence
+	ClaimEvidenceIndexPrefix = "Claim/evidence/"
+
+	// ClaimClaimantIndexPrefix orders the claims of each claimant by the time
+	// they were filed
+	ClaimClaimantIndexPrefix = "Claim/claimant/"
+
+	// ClaimFlaggedIndexPrefix indexes the claims flagged for manual review
+	ClaimFlaggedIndexPrefix = "Claim/flagged/"
+)
+
+// ClaimKey returns the store key to retrieve a Claim from the index fields
+func ClaimKey(
+	claimId string,
+) []byte {
+	var key []byte
+
+	claimIdBytes := []byte(claimId)
+	key = append(key, claimIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ClaimPolicyIndexKey returns the key of the entry of a claim in the policy
+// index, under the prefix of its policy
+func ClaimPolicyIndexKey(
+	policyId string,
+	claimId string,
+) []byte {
+	return append(ClaimKey(policyId), ClaimKey(claimId)...)
+}
+
+// ClaimTimeKey returns the prefix of a time ordered queue entry
+func ClaimTimeKey(
+	unix int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(unix))
+	return key
+}
+
+// ClaimDeadlineQueueKey returns the key of a deadline queue entry
+func ClaimDeadlineQueueKey(
+	unix int64,
+	claimId string,
+) []byte {
+	return append(ClaimTimeKey(unix), ClaimKey(claimId)...)
+}
+
+// ClaimEvidenceIndexKey returns the key of the entry of a claim in the
+// evidence index, under the prefix of the evidence hash
+func ClaimEvidenceIndexKey(
+	hash string,
+	claimId string,
+) []byte {
+	return append(ClaimKey(hash), ClaimKey(claimId)...)
+}
+
+// ClaimClaimantIndexKey returns the key of the entry of a claim in the
+// claimant index, under the prefix of its claimant
+func ClaimClaimantIndexKey(
+	claimant string,
+	filedAt int64,
+	claimId string,
+) []byte {
+	key := append(ClaimKey(claimant), ClaimTimeKey(filedAt)...)
+	return append(key, ClaimKey(claimId)...)
+}
@@ -0,0 +1,67 @@
+package types
+
+const (
+	// OracleReporterKeyPrefix is the prefix to retrieve all OracleReporter
+	OracleReporterKeyPrefix = "OracleReporter/value/"
+
+	// ObservationKeyPrefix is the prefix to retrieve all Observation, grouped
+	// by metric
+	ObservationKeyPrefix = "Observation/value/"
+
+	// ReportedMetricKeyPrefix marks the metrics reported during the block,
+	// which are checked for settlement at its end
+	ReportedMetricKeyPrefix = "Observation/reported/"
+
+	// MetricSettlementKeyPrefix is the prefix to retrieve all MetricSettlement
+	MetricSettlementKeyPrefix = "MetricSettlement/value/"
+
+	// ParametricPolicyIndexPrefix indexes the parametric policies by the
+	// metric triggering them
+	ParametricPolicyIndexPrefix = "ParametricPolicy/metric/"
+)
+
+// OracleReporterKey returns the store key to retrieve an OracleReporter from
+// the index fields
+func OracleReporterKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// MetricKey returns the store key of a metric, and the prefix of the entries
+// of the metric in the observation store and parametric policy index
+func MetricKey(
+	metric string,
+) []byte {
+	var key []byte
+
+	metricBytes := []byte(metric)
+	key = append(key, metricBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ObservationKey returns the store key to retrieve an Observation from the
+// index fields
+func ObservationKey(
+	metric string,
+	reporter string,
+) []byte {
+	return append(MetricKey(metric), OracleReporterKey(reporter)...)
+}
+
+// ParametricPolicyIndexKey returns the key of the entry of a policy in the
+// parametric policy index, under the prefix of its metric
+func ParametricPolicyIndexKey(
+	metric string,
+	policyId string,
+) []byte {
+	return append(MetricKey(metric), ClaimKey(policyId)...)
+}
@@ -0,0 +1,18 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// RatingModelKeyPrefix is the prefix to retrieve all RatingModel
+	RatingModelKeyPrefix = "RatingModel/value/"
+)
+
+// RatingModelKey returns the store key of a version of the rating model,
+// ordered by version
+func RatingModelKey(
+	version uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, version)
+	return key
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAppealClaim{}
+
+func NewMsgAppealClaim(
+	creator string,
+	claimId string,
+	reason string,
+) *MsgAppealClaim {
+	return &MsgAppealClaim{
+		Creator: creator,
+		ClaimId: claimId,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgAppealClaim) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ClaimId == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "claim id cannot be empty")
+	}
+	if msg.Reason == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "an appeal needs a reason")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBondReporter{}
+
+func NewMsgBondReporter(creator string, amount sdk.Coin) *MsgBondReporter {
+	return &MsgBondReporter{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgBondReporter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidStake, "invalid amount %s", msg.Amount)
+	}
+	return nil
+}
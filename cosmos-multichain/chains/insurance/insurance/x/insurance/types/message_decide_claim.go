@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDecideClaim{}
+
+func NewMsgDecideClaim(
+	creator string,
+	claimId string,
+	approve bool,
+	reason string,
+) *MsgDecideClaim {
+	return &MsgDecideClaim{
+		Creator: creator,
+		ClaimId: claimId,
+		Approve: approve,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgDecideClaim) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ClaimId == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "claim id cannot be empty")
+	}
+	if !msg.Approve && msg.Reason == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "a denial needs a reason")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgInitiateTransaction{}
+
+func NewMsgInitiateTransaction(
+	creator string,
+	data []byte,
+) *MsgInitiateTransaction {
+	return &MsgInitiateTransaction{
+		Creator: creator,
+		Data:    data,
+	}
+}
+
+func (msg *MsgInitiateTransaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidTransaction, "transaction data cannot be empty")
+	}
+	return nil
+}
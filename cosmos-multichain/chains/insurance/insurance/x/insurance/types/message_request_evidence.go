@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRequestEvidence{}
+
+func NewMsgRequestEvidence(
+	creator string,
+	claimId string,
+	request string,
+) *MsgRequestEvidence {
+	return &MsgRequestEvidence{
+		Creator: creator,
+		ClaimId: claimId,
+		Request: request,
+	}
+}
+
+func (msg *MsgRequestEvidence) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ClaimId == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "claim id cannot be empty")
+	}
+	if msg.Request == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "the requested evidence must be described")
+	}
+	return nil
+}
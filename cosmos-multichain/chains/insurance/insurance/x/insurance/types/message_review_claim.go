@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReviewClaim{}
+
+func NewMsgReviewClaim(
+	creator string,
+	claimId string,
+) *MsgReviewClaim {
+	return &MsgReviewClaim{
+		Creator: creator,
+		ClaimId: claimId,
+	}
+}
+
+func (msg *MsgReviewClaim) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ClaimId == "" {
+		return errorsmod.Wrap(ErrInvalidClaim, "claim id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitObservation{}
+
+func NewMsgSubmitObservation(creator string, metric string, value sdkmath.LegacyDec) *MsgSubmitObservation {
+	return &MsgSubmitObservation{
+		Creator: creator,
+		Metric:  metric,
+		Value:   value,
+	}
+}
+
+func (msg *MsgSubmitObservation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateMetric(msg.Metric); err != nil {
+		return err
+	}
+	if msg.Value.IsNil() {
+		return errorsmod.Wrap(ErrInvalidObservation, "observed value cannot be empty")
+	}
+	return nil
+}
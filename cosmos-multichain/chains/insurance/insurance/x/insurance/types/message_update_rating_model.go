@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateRatingModel{}
+
+func NewMsgUpdateRatingModel(
+	authority string,
+	description string,
+	baseRates []BaseRate,
+	factors []RatingFactor,
+) *MsgUpdateRatingModel {
+	return &MsgUpdateRatingModel{
+		Authority:   authority,
+		Description: description,
+		BaseRates:   baseRates,
+		Factors:     factors,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateRatingModel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.RatingModel().Validate()
+}
+
+// RatingModel returns the rating model adopted by the message, which is
+// versioned when it is stored
+func (m *MsgUpdateRatingModel) RatingModel() RatingModel {
+	return RatingModel{
+		Description: m.Description,
+		BaseRates:   m.BaseRates,
+		Factors:     m.Factors,
+		Authority:   m.Authority,
+	}
+}
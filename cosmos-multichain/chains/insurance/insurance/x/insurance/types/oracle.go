@@ -0,0 +1,17 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateMetric checks the name of a metric reported by the oracle. Metrics
+// name the event they observe, e.g. flight:LH400:2026-10-17:delay_minutes,
+// and cannot contain a slash, which separates the store keys.
+func ValidateMetric(metric string) error {
+	if metric == "" || strings.Contains(metric, "/") {
+		return errorsmod.Wrapf(ErrInvalidObservation, "invalid metric %q", metric)
+	}
+	return nil
+}
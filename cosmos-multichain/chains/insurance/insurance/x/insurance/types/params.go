@@ -0,0 +1,362 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyAdjusters = []byte("Adjusters")
+	// DefaultAdjusters is empty; adjusters are accredited at genesis or by governance
+	DefaultAdjusters = []string{}
+)
+
+var (
+	KeyAppealAdjusters = []byte("AppealAdjusters")
+	// DefaultAppealAdjusters is empty; appeal adjusters are accredited at
+	// genesis or by governance
+	DefaultAppealAdjusters = []string{}
+)
+
+var (
+	KeyEvidencePeriod = []byte("EvidencePeriod")
+	// DefaultEvidencePeriod gives claimants two weeks to provide requested evidence
+	DefaultEvidencePeriod = 14 * 24 * time.Hour
+)
+
+var (
+	KeyAppealPeriod = []byte("AppealPeriod")
+	// DefaultAppealPeriod gives claimants thirty days to appeal a denied claim
+	DefaultAppealPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	KeyOracleMinStake = []byte("OracleMinStake")
+	// DefaultOracleMinStake has oracle reporters bond at least 1000 of the bond denom
+	DefaultOracleMinStake = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+)
+
+var (
+	KeyOracleQuorum = []byte("OracleQuorum")
+	// DefaultOracleQuorum settles a metric once reporters holding 67% of the
+	// bonded stake reported it
+	DefaultOracleQuorum = sdkmath.LegacyNewDecWithPrec(67, 2)
+)
+
+var (
+	KeyFraudReviewThreshold = []byte("FraudReviewThreshold")
+	// DefaultFraudReviewThreshold flags the claims with reused evidence or
+	// without a corroborating record, and those combining lesser signals
+	DefaultFraudReviewThreshold uint64 = 50
+)
+
+var (
+	KeyFraudVelocityWindow = []byte("FraudVelocityWindow")
+	// DefaultFraudVelocityWindow counts the claims of a holder over thirty days
+	DefaultFraudVelocityWindow = 30 * 24 * time.Hour
+)
+
+var (
+	KeyFraudVelocityLimit = []byte("FraudVelocityLimit")
+	// DefaultFraudVelocityLimit lets a holder file three claims within the
+	// velocity window before their claims signal fraud
+	DefaultFraudVelocityLimit uint64 = 3
+)
+
+var (
+	KeyCounterparties = []byte("Counterparties")
+	// DefaultCounterparties are the chains of the network the insurance
+	// contract exchanges messages with
+	DefaultCounterparties = []Counterparty{
+		{ChainId: "bloqz-finance-1", Chain: interchain.ChainFinance},
+		{ChainId: "bloqz-healthcare-1", Chain: interchain.ChainHealthcare},
+		{ChainId: "bloqz-realestate-1", Chain: interchain.ChainRealEstate},
+	}
+)
+
+var (
+	KeyOracleUnbondingPeriod = []byte("OracleUnbondingPeriod")
+	// DefaultOracleUnbondingPeriod keeps the stake of unbonding reporters
+	// slashable for three weeks
+	DefaultOracleUnbondingPeriod = 21 * 24 * time.Hour
+)
+
+var (
+	KeyOracleMaxDeviation = []byte("OracleMaxDeviation")
+	// DefaultOracleMaxDeviation slashes the reporters of values more than 10%
+	// off the settled value
+	DefaultOracleMaxDeviation = sdkmath.LegacyNewDecWithPrec(10, 2)
+)
+
+var (
+	KeyOracleSlashFraction = []byte("OracleSlashFraction")
+	// DefaultOracleSlashFraction slashes 5% of the stake of a reporter per
+	// deviating value
+	DefaultOracleSlashFraction = sdkmath.LegacyNewDecWithPrec(5, 2)
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	adjusters []string,
+	appealAdjusters []string,
+	evidencePeriod time.Duration,
+	appealPeriod time.Duration,
+	oracleMinStake sdk.Coin,
+	oracleQuorum sdkmath.LegacyDec,
+	fraudReviewThreshold uint64,
+	fraudVelocityWindow time.Duration,
+	fraudVelocityLimit uint64,
+	counterparties []Counterparty,
+	oracleUnbondingPeriod time.Duration,
+	oracleMaxDeviation sdkmath.LegacyDec,
+	oracleSlashFraction sdkmath.LegacyDec,
+) Params {
+	return Params{
+		Adjusters:             adjusters,
+		AppealAdjusters:       appealAdjusters,
+		EvidencePeriod:        evidencePeriod,
+		AppealPeriod:          appealPeriod,
+		OracleMinStake:        oracleMinStake,
+		OracleQuorum:          oracleQuorum,
+		FraudReviewThreshold:  fraudReviewThreshold,
+		FraudVelocityWindow:   fraudVelocityWindow,
+		FraudVelocityLimit:    fraudVelocityLimit,
+		Counterparties:        counterparties,
+		OracleUnbondingPeriod: oracleUnbondingPeriod,
+		OracleMaxDeviation:    oracleMaxDeviation,
+		OracleSlashFraction:   oracleSlashFraction,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultAdjusters,
+		DefaultAppealAdjusters,
+		DefaultEvidencePeriod,
+		DefaultAppealPeriod,
+		DefaultOracleMinStake,
+		DefaultOracleQuorum,
+		DefaultFraudReviewThreshold,
+		DefaultFraudVelocityWindow,
+		DefaultFraudVelocityLimit,
+		DefaultCounterparties,
+		DefaultOracleUnbondingPeriod,
+		DefaultOracleMaxDeviation,
+		DefaultOracleSlashFraction,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAdjusters, &p.Adjusters, validateAdjusters),
+		paramtypes.NewParamSetPair(KeyAppealAdjusters, &p.AppealAdjusters, validateAdjusters),
+		paramtypes.NewParamSetPair(KeyEvidencePeriod, &p.EvidencePeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyAppealPeriod, &p.AppealPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyOracleMinStake, &p.OracleMinStake, validateOracleMinStake),
+		paramtypes.NewParamSetPair(KeyOracleQuorum, &p.OracleQuorum, validateOracleQuorum),
+		paramtypes.NewParamSetPair(KeyFraudReviewThreshold, &p.FraudReviewThreshold, validatePositive),
+		paramtypes.NewParamSetPair(KeyFraudVelocityWindow, &p.FraudVelocityWindow, validatePeriod),
+		paramtypes.NewParamSetPair(KeyFraudVelocityLimit, &p.FraudVelocityLimit, validatePositive),
+		paramtypes.NewParamSetPair(KeyCounterparties, &p.Counterparties, validateCounterparties),
+		paramtypes.NewParamSetPair(KeyOracleUnbondingPeriod, &p.OracleUnbondingPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyOracleMaxDeviation, &p.OracleMaxDeviation, validateOracleMaxDeviation),
+		paramtypes.NewParamSetPair(KeyOracleSlashFraction, &p.OracleSlashFraction, validateOracleSlashFraction),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateAdjusters(p.Adjusters); err != nil {
+		return err
+	}
+	if err := validateAdjusters(p.AppealAdjusters); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.EvidencePeriod); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.AppealPeriod); err != nil {
+		return err
+	}
+	if err := validateOracleMinStake(p.OracleMinStake); err != nil {
+		return err
+	}
+	if err := validateOracleQuorum(p.OracleQuorum); err != nil {
+		return err
+	}
+	if err := validatePositive(p.FraudReviewThreshold); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.FraudVelocityWindow); err != nil {
+		return err
+	}
+	if err := validatePositive(p.FraudVelocityLimit); err != nil {
+		return err
+	}
+	if err := validateCounterparties(p.Counterparties); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.OracleUnbondingPeriod); err != nil {
+		return err
+	}
+	if err := validateOracleMaxDeviation(p.OracleMaxDeviation); err != nil {
+		return err
+	}
+	return validateOracleSlashFraction(p.OracleSlashFraction)
+}
+
+// IsAdjuster reports whether addr is accredited to review and decide claims
+func (p Params) IsAdjuster(addr string) bool {
+	for _, a := range p.Adjusters {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAppealAdjuster reports whether addr is accredited to decide appealed claims
+func (p Params) IsAppealAdjuster(addr string) bool {
+	for _, a := range p.AppealAdjusters {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// CounterpartyChain returns the chain of a counterparty chain ID
+func (p Params) CounterpartyChain(chainId string) (string, bool) {
+	for _, counterparty := range p.Counterparties {
+		if counterparty.ChainId == chainId {
+			return counterparty.Chain, true
+		}
+	}
+	return "", false
+}
+
+// validateAdjusters validates the Adjusters and AppealAdjusters params
+func validateAdjusters(v interface{}) error {
+	adjusters, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(adjusters))
+	for _, addr := range adjusters {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid adjuster address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicated adjuster %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// validatePeriod validates the EvidencePeriod, AppealPeriod,
+// FraudVelocityWindow and OracleUnbondingPeriod params
+func validatePeriod(v interface{}) error {
+	d, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("period must be positive: %s", d)
+	}
+	return nil
+}
+
+// validateOracleMinStake validates the OracleMinStake param
+func validateOracleMinStake(v interface{}) error {
+	stake, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if !stake.IsValid() || !stake.IsPositive() {
+		return fmt.Errorf("oracle min stake must be positive: %s", stake)
+	}
+	return nil
+}
+
+// validateOracleQuorum validates the OracleQuorum param
+func validateOracleQuorum(v interface{}) error {
+	quorum, ok := v.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if quorum.IsNil() || !quorum.IsPositive() || quorum.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("oracle quorum must be in (0, 1]: %s", quorum)
+	}
+	return nil
+}
+
+// validateOracleMaxDeviation validates the OracleMaxDeviation param
+func validateOracleMaxDeviation(v interface{}) error {
+	deviation, ok := v.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if deviation.IsNil() || deviation.IsNegative() {
+		return fmt.Errorf("oracle max deviation must not be negative: %s", deviation)
+	}
+	return nil
+}
+
+// validateOracleSlashFraction validates the OracleSlashFraction param
+func validateOracleSlashFraction(v interface{}) error {
+	fraction, ok := v.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("oracle slash fraction must be in [0, 1]: %s", fraction)
+	}
+	return nil
+}
+
+// validatePositive validates the FraudReviewThreshold and FraudVelocityLimit
+// params
+func validatePositive(v interface{}) error {
+	n, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if n == 0 {
+		return fmt.Errorf("parameter must be positive: %d", n)
+	}
+	return nil
+}
+
+// validateCounterparties validates the Counterparties param
+func validateCounterparties(v interface{}) error {
+	counterparties, ok := v.([]Counterparty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chainIds := make(map[string]struct{}, len(counterparties))
+	for _, counterparty := range counterparties {
+		if counterparty.ChainId == "" || counterparty.Chain == "" {
+			return fmt.Errorf("counterparty needs a chain id and a chain")
+		}
+		if _, ok := chainIds[counterparty.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty %s", counterparty.ChainId)
+		}
+		chainIds[counterparty.ChainId] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,1297 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insurance/insurance/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// adjusters are the accounts accredited to review and decide claims.
+	Adjusters []string `protobuf:"bytes,1,rep,name=adjusters,proto3" json:"adjusters,omitempty"`
+	// appeal_adjusters are the accounts accredited to decide appealed claims.
+	AppealAdjusters []string `protobuf:"bytes,2,rep,name=appeal_adjusters,json=appealAdjusters,proto3" json:"appeal_adjusters,omitempty"`
+	// evidence_period is how long a claimant has to provide the evidence an
+	// adjuster requested before the claim is denied.
+	EvidencePeriod time.Duration `protobuf:"bytes,3,opt,name=evidence_period,json=evidencePeriod,proto3,stdduration" json:"evidence_period"`
+	// appeal_period is how long a claimant has to appeal a denied claim.
+	AppealPeriod time.Duration `protobuf:"bytes,4,opt,name=appeal_period,json=appealPeriod,proto3,stdduration" json:"appeal_period"`
+	// oracle_min_stake is the least stake an oracle reporter bonds, in the
+	// denom of the reporter stakes.
+	OracleMinStake types.Coin `protobuf:"bytes,6,opt,name=oracle_min_stake,json=oracleMinStake,proto3" json:"oracle_min_stake"`
+	// oracle_quorum is the share of the bonded reporter stake that must report
+	// a metric before its value settles.
+	OracleQuorum cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=oracle_quorum,json=oracleQuorum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_quorum"`
+	// fraud_review_threshold is the fraud score from which filed claims are
+	// flagged for manual review.
+	FraudReviewThreshold uint64 `protobuf:"varint,8,opt,name=fraud_review_threshold,json=fraudReviewThreshold,proto3" json:"fraud_review_threshold,omitempty"`
+	// fraud_velocity_window is the period over which the claims of a holder
+	// are counted against the velocity limit.
+	FraudVelocityWindow time.Duration `protobuf:"bytes,9,opt,name=fraud_velocity_window,json=fraudVelocityWindow,proto3,stdduration" json:"fraud_velocity_window"`
+	// fraud_velocity_limit is the number of claims a holder files within the
+	// velocity window before their claims signal fraud.
+	FraudVelocityLimit uint64 `protobuf:"varint,10,opt,name=fraud_velocity_limit,json=fraudVelocityLimit,proto3" json:"fraud_velocity_limit,omitempty"`
+	// counterparties are the chains allowed to open interchain channels with
+	// the insurance contract.
+	Counterparties []Counterparty `protobuf:"bytes,11,rep,name=counterparties,proto3" json:"counterparties"`
+	// oracle_unbonding_period is how long the stake of an unbonding reporter
+	// stays bonded, and can be slashed, before it is returned.
+	OracleUnbondingPeriod time.Duration `protobuf:"bytes,12,opt,name=oracle_unbonding_period,json=oracleUnbondingPeriod,proto3,stdduration" json:"oracle_unbonding_period"`
+	// oracle_max_deviation is the largest deviation from the settled value of
+	// a metric, relative to that value, a reported value may have before its
+	// reporter is slashed.
+	OracleMaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=oracle_max_deviation,json=oracleMaxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_max_deviation"`
+	// oracle_slash_fraction is the share of its stake a reporter loses for
+	// reporting a deviating value.
+	OracleSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=oracle_slash_fraction,json=oracleSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_slash_fraction"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b6f5cebf20d0eb0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAdjusters() []string {
+	if m != nil {
+		return m.Adjusters
+	}
+	return nil
+}
+
+func (m *Params) GetAppealAdjusters() []string {
+	if m != nil {
+		return m.AppealAdjusters
+	}
+	return nil
+}
+
+func (m *Params) GetEvidencePeriod() time.Duration {
+	if m != nil {
+		return m.EvidencePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAppealPeriod() time.Duration {
+	if m != nil {
+		return m.AppealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetOracleMinStake() types.Coin {
+	if m != nil {
+		return m.OracleMinStake
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetFraudReviewThreshold() uint64 {
+	if m != nil {
+		return m.FraudReviewThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetFraudVelocityWindow() time.Duration {
+	if m != nil {
+		return m.FraudVelocityWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFraudVelocityLimit() uint64 {
+	if m != nil {
+		return m.FraudVelocityLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCounterparties() []Counterparty {
+	if m != nil {
+		return m.Counterparties
+	}
+	return nil
+}
+
+func (m *Params) GetOracleUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.OracleUnbondingPeriod
+	}
+	return 0
+}
+
+// Counterparty maps the chain ID of a counterparty to the chain its messages
+// are routed for.
+type Counterparty struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Chain   string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *Counterparty) Reset()         { *m = Counterparty{} }
+func (m *Counterparty) String() string { return proto.CompactTextString(m) }
+func (*Counterparty) ProtoMessage()    {}
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b6f5cebf20d0eb0, []int{1}
+}
+func (m *Counterparty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Counterparty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Counterparty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Counterparty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Counterparty.Merge(m, src)
+}
+func (m *Counterparty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Counterparty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Counterparty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Counterparty proto.InternalMessageInfo
+
+func (m *Counterparty) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Counterparty) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "insurance.insurance.Params")
+	proto.RegisterType((*Counterparty)(nil), "insurance.insurance.Counterparty")
+}
+
+func init() { proto.RegisterFile("insurance/insurance/params.proto", fileDescriptor_9b6f5cebf20d0eb0) }
+
+var fileDescriptor_9b6f5cebf20d0eb0 = []byte{
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0xbb, 0x50, 0x4a, 0x3b, 0xd0, 0xc2, 0x6f, 0x28, 0x3f, 0x17, 0x30, 0x6d, 0x25, 0x31,
+	0x69, 0x48, 0xd8, 0x15, 0x54, 0x0e, 0xdc, 0x28, 0xd5, 0x44, 0x03, 0x06, 0x0a, 0x6a, 0xa2, 0x26,
+	0xeb, 0x74, 0x77, 0x68, 0x07, 0xda, 0x99, 0x75, 0x66, 0xb7, 0xd0, 0x7f, 0xc1, 0x93, 0x47, 0x8f,
+	0x1e, 0x3d, 0x72, 0xe0, 0x8f, 0xe0, 0x48, 0x38, 0x19, 0x0f, 0x68, 0x20, 0x06, 0xff, 0x0c, 0xb3,
+	0x33, 0xb3, 0x14, 0x0c, 0x89, 0x21, 0x5c, 0x36, 0xf3, 0xe6, 0xcd, 0xf7, 0xf3, 0xde, 0x9b, 0xf7,
+	0x76, 0x40, 0x89, 0x50, 0x11, 0x72, 0x44, 0x5d, 0x6c, 0xf7, 0x56, 0x3e, 0xe2, 0xa8, 0x2d, 0x2c,
+	0x9f, 0xb3, 0x80, 0xc1, 0xb1, 0x8b, 0x7d, 0xeb, 0x62, 0x35, 0xf9, 0x1f, 0x6a, 0x13, 0xca, 0x6c,
+	0xf9, 0x55, 0xe7, 0x26, 0x0b, 0x2e, 0x13, 0x6d, 0x26, 0xec, 0x3a, 0x12, 0xd8, 0xee, 0xcc, 0xd5,
+	0x71, 0x80, 0xe6, 0x6c, 0x97, 0x11, 0xaa, 0xfd, 0x13, 0xca, 0xef, 0x48, 0xcb, 0x56, 0x86, 0x76,
+	0xe5, 0x1b, 0xac, 0xc1, 0xd4, 0x7e, 0xb4, 0x8a, 0x81, 0x0d, 0xc6, 0x1a, 0x2d, 0x6c, 0x4b, 0xab,
+	0x1e, 0x6e, 0xd9, 0x5e, 0xc8, 0x51, 0x40, 0x98, 0x06, 0x4e, 0xff, 0x4a, 0x83, 0xd4, 0x9a, 0xcc,
+	0x14, 0x2e, 0x80, 0x0c, 0xf2, 0xb6, 0x43, 0x11, 0x60, 0x2e, 0x4c, 0xa3, 0xd4, 0x5f, 0xce, 0x54,
+	0xcc, 0xe3, 0x83, 0xd9, 0xbc, 0x8e, 0xb2, 0xe4, 0x79, 0x1c, 0x0b, 0xb1, 0x11, 0x70, 0x42, 0x1b,
+	0xb5, 0xde, 0x51, 0xb8, 0x0c, 0x46, 0x91, 0xef, 0x63, 0xd4, 0x72, 0x7a, 0xf2, 0xbe, 0x7f, 0xc8,
+	0x47, 0x94, 0x62, 0xe9, 0x02, 0xb2, 0x0e, 0x46, 0x70, 0x87, 0x78, 0x98, 0xba, 0xd8, 0xf1, 0x31,
+	0x27, 0xcc, 0x33, 0xfb, 0x4b, 0x46, 0x79, 0x68, 0x7e, 0xc2, 0x52, 0x15, 0x58, 0x71, 0x05, 0x56,
+	0x55, 0x57, 0x50, 0xc9, 0x1e, 0x9e, 0x14, 0x13, 0x9f, 0x7f, 0x14, 0x8d, 0xaf, 0xe7, 0xfb, 0x33,
+	0x46, 0x2d, 0x17, 0x03, 0xd6, 0xa4, 0x1e, 0xae, 0x82, 0xac, 0xce, 0x4b, 0x03, 0x93, 0x37, 0x04,
+	0x0e, 0x2b, 0xb9, 0xc6, 0xbd, 0x00, 0xa3, 0x8c, 0x23, 0xb7, 0x85, 0x9d, 0x36, 0xa1, 0x8e, 0x08,
+	0xd0, 0x0e, 0x36, 0x53, 0x9a, 0xa8, 0x6b, 0x8c, 0xba, 0x66, 0xe9, 0xae, 0x59, 0xcb, 0x8c, 0xd0,
+	0x4a, 0x26, 0x22, 0xea, 0xf4, 0x94, 0x7a, 0x95, 0xd0, 0x8d, 0x48, 0x0b, 0xdf, 0x82, 0xac, 0xe6,
+	0x7d, 0x08, 0x19, 0x0f, 0xdb, 0xe6, 0x60, 0xc9, 0x28, 0x67, 0x2a, 0x0b, 0x91, 0xe2, 0xfb, 0x49,
+	0x71, 0x4a, 0x31, 0x85, 0xb7, 0x63, 0x11, 0x66, 0xb7, 0x51, 0xd0, 0xb4, 0x56, 0x70, 0x03, 0xb9,
+	0xdd, 0x2a, 0x76, 0x8f, 0x0f, 0x66, 0x81, 0x0e, 0x59, 0xc5, 0xae, 0x4e, 0x56, 0xc1, 0xd6, 0x25,
+	0x0b, 0x3e, 0x02, 0xff, 0x6f, 0x71, 0x14, 0x7a, 0x0e, 0xc7, 0x1d, 0x82, 0x77, 0x9d, 0xa0, 0xc9,
+	0xb1, 0x68, 0xb2, 0x96, 0x67, 0xa6, 0x4b, 0x46, 0x39, 0x59, 0xcb, 0x4b, 0x6f, 0x4d, 0x3a, 0x37,
+	0x63, 0x1f, 0x7c, 0x07, 0xc6, 0x95, 0xaa, 0x83, 0x5b, 0xcc, 0x25, 0x41, 0xd7, 0xd9, 0x25, 0xd4,
+	0x63, 0xbb, 0x66, 0xe6, 0x86, 0x37, 0x37, 0x26, 0x31, 0xaf, 0x34, 0xe5, 0xb5, 0x84, 0xc0, 0x07,
+	0x20, 0xff, 0x17, 0xbd, 0x45, 0xda, 0x24, 0x30, 0x81, 0xcc, 0x08, 0x5e, 0x91, 0xac, 0x44, 0x1e,
+	0xb8, 0x09, 0x72, 0x2e, 0x0b, 0x69, 0x80, 0xb9, 0x8f, 0x78, 0x40, 0xb0, 0x30, 0x87, 0x4a, 0xfd,
+	0xe5, 0xa1, 0xf9, 0x7b, 0xd6, 0x35, 0xbf, 0x93, 0xb5, 0xdc, 0x3b, 0xda, 0xbd, 0x72, 0xf1, 0x57,
+	0x19, 0xf0, 0x3d, 0xb8, 0xa3, 0x2f, 0x3e, 0xa4, 0x75, 0x46, 0x3d, 0x42, 0x1b, 0xf1, 0x84, 0x0c,
+	0xdf, 0xb0, 0xce, 0x71, 0x05, 0x7a, 0x19, 0x73, 0xf4, 0xa8, 0x34, 0x41, 0x3e, 0x1e, 0x15, 0xb4,
+	0xe7, 0x78, 0xb8, 0x43, 0xa4, 0xda, 0xcc, 0xde, 0xaa, 0xc3, 0x50, 0x0f, 0x10, 0xda, 0xab, 0xc6,
+	0x44, 0xb8, 0x0d, 0x74, 0x0a, 0x8e, 0x68, 0x21, 0xd1, 0x74, 0xb6, 0x38, 0x72, 0x65, 0xa8, 0xdc,
+	0xad, 0x42, 0x8d, 0x29, 0xe8, 0x46, 0xc4, 0x7c, 0xaa, 0x91, 0x8b, 0xf7, 0x7f, 0x7f, 0x29, 0x1a,
+	0x1f, 0xcf, 0xf7, 0x67, 0xee, 0xf6, 0x1e, 0xb9, 0xbd, 0x4b, 0x0f, 0x9e, 0x7a, 0x46, 0x9e, 0x27,
+	0xd3, 0x03, 0xa3, 0xa9, 0x5a, 0xce, 0x47, 0x5d, 0x16, 0x06, 0x0e, 0x72, 0xe5, 0xed, 0x4f, 0x3f,
+	0x01, 0xc3, 0x97, 0xfb, 0x03, 0x27, 0x40, 0xda, 0x6d, 0x22, 0x42, 0x1d, 0xe2, 0x99, 0x46, 0x94,
+	0x6b, 0x6d, 0x50, 0xda, 0xcf, 0x3c, 0x98, 0x07, 0x03, 0x72, 0x69, 0xf6, 0xc9, 0x7d, 0x65, 0x2c,
+	0x26, 0xa3, 0xe8, 0x95, 0xc7, 0x87, 0xa7, 0x05, 0xe3, 0xe8, 0xb4, 0x60, 0xfc, 0x3c, 0x2d, 0x18,
+	0x9f, 0xce, 0x0a, 0x89, 0xa3, 0xb3, 0x42, 0xe2, 0xdb, 0x59, 0x21, 0xf1, 0x66, 0xea, 0xfa, 0xa4,
+	0x82, 0xae, 0x8f, 0x45, 0x3d, 0x25, 0x3b, 0xf9, 0xf0, 0xcf, 0x00, 0x3b, 0x1d, 0xff, 0xa7, 0xa9,
+	0x05, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Adjusters) != len(that1.Adjusters) {
+		return false
+	}
+	for i := range this.Adjusters {
+		if this.Adjusters[i] != that1.Adjusters[i] {
+			return false
+		}
+	}
+	if len(this.AppealAdjusters) != len(that1.AppealAdjusters) {
+		return false
+	}
+	for i := range this.AppealAdjusters {
+		if this.AppealAdjusters[i] != that1.AppealAdjusters[i] {
+			return false
+		}
+	}
+	if this.EvidencePeriod != that1.EvidencePeriod {
+		return false
+	}
+	if this.AppealPeriod != that1.AppealPeriod {
+		return false
+	}
+	if !this.OracleMinStake.Equal(&that1.OracleMinStake) {
+		return false
+	}
+	if !this.OracleQuorum.Equal(that1.OracleQuorum) {
+		return false
+	}
+	if this.FraudReviewThreshold != that1.FraudReviewThreshold {
+		return false
+	}
+	if this.FraudVelocityWindow != that1.FraudVelocityWindow {
+		return false
+	}
+	if this.FraudVelocityLimit != that1.FraudVelocityLimit {
+		return false
+	}
+	if len(this.Counterparties) != len(that1.Counterparties) {
+		return false
+	}
+	for i := range this.Counterparties {
+		if !this.Counterparties[i].Equal(&that1.Counterparties[i]) {
+			return false
+		}
+	}
+	if this.OracleUnbondingPeriod != that1.OracleUnbondingPeriod {
+		return false
+	}
+	if !this.OracleMaxDeviation.Equal(that1.OracleMaxDeviation) {
+		return false
+	}
+	if !this.OracleSlashFraction.Equal(that1.OracleSlashFraction) {
+		return false
+	}
+	return true
+}
+func (this *Counterparty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Counterparty)
+	if !ok {
+		that2, ok := that.(Counterparty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if this.Chain != that1.Chain {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OracleSlashFraction.Size()
+		i -= size
+		if _, err := m.OracleSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.OracleMaxDeviation.Size()
+		i -= size
+		if _, err := m.OracleMaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleUnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleUnbondingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if len(m.Counterparties) > 0 {
+		for iNdEx := len(m.Counterparties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counterparties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.FraudVelocityLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FraudVelocityLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FraudVelocityWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FraudVelocityWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if m.FraudReviewThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FraudReviewThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.OracleQuorum.Size()
+		i -= size
+		if _, err := m.OracleQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OracleMinStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AppealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AppealPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EvidencePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EvidencePeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AppealAdjusters) > 0 {
+		for iNdEx := len(m.AppealAdjusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AppealAdjusters[iNdEx])
+			copy(dAtA[i:], m.AppealAdjusters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AppealAdjusters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Adjusters) > 0 {
+		for iNdEx := len(m.Adjusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Adjusters[iNdEx])
+			copy(dAtA[i:], m.Adjusters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Adjusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Counterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Adjusters) > 0 {
+		for _, s := range m.Adjusters {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AppealAdjusters) > 0 {
+		for _, s := range m.AppealAdjusters {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EvidencePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AppealPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.OracleMinStake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.OracleQuorum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FraudReviewThreshold != 0 {
+		n += 1 + sovParams(uint64(m.FraudReviewThreshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FraudVelocityWindow)
+	n += 1 + l + sovParams(uint64(l))
+	if m.FraudVelocityLimit != 0 {
+		n += 1 + sovParams(uint64(m.FraudVelocityLimit))
+	}
+	if len(m.Counterparties) > 0 {
+		for _, e := range m.Counterparties {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleUnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.OracleMaxDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.OracleSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adjusters = append(m.Adjusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealAdjusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealAdjusters = append(m.AppealAdjusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidencePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EvidencePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AppealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMinStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleMinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudReviewThreshold", wireType)
+			}
+			m.FraudReviewThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudReviewThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudVelocityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FraudVelocityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudVelocityLimit", wireType)
+			}
+			m.FraudVelocityLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudVelocityLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparties = append(m.Counterparties, Counterparty{})
+			if err := m.Counterparties[len(m.Counterparties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleUnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleUnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleMaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
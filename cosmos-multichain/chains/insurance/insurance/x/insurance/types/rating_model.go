@@ -0,0 +1,130 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate checks the base rates and the factors of a rating model
+func (m RatingModel) Validate() error {
+	if len(m.BaseRates) == 0 {
+		return errorsmod.Wrap(ErrInvalidRatingModel, "a rating model needs base rates")
+	}
+	policyTypes := make(map[string]bool)
+	for _, rate := range m.BaseRates {
+		if rate.PolicyType == "" {
+			return errorsmod.Wrap(ErrInvalidRatingModel, "base rate without policy type")
+		}
+		if policyTypes[rate.PolicyType] {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "duplicate base rate for %s", rate.PolicyType)
+		}
+		policyTypes[rate.PolicyType] = true
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "base rate of %s must be positive", rate.PolicyType)
+		}
+		floor, cap := orZero(rate.Floor), orZero(rate.Cap)
+		if floor.IsNegative() || cap.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "floor and cap of %s cannot be negative", rate.PolicyType)
+		}
+		if cap.IsPositive() && cap.LT(floor) {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "cap of %s is below its floor", rate.PolicyType)
+		}
+	}
+
+	for _, factor := range m.Factors {
+		if factor.Attribute == "" {
+			return errorsmod.Wrap(ErrInvalidRatingModel, "factor without risk attribute")
+		}
+		if factor.Multiplier.IsNil() || !factor.Multiplier.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "multiplier of %s must be positive", factor.Attribute)
+		}
+		if factor.Value != "" {
+			if factor.Min != "" || factor.Max != "" {
+				return errorsmod.Wrapf(ErrInvalidRatingModel, "factor of %s matches either a value or a range", factor.Attribute)
+			}
+			continue
+		}
+		min, hasMin, err := parseBound(factor.Min)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "invalid min of %s: %s", factor.Attribute, err)
+		}
+		max, hasMax, err := parseBound(factor.Max)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "invalid max of %s: %s", factor.Attribute, err)
+		}
+		if hasMin && hasMax && !min.LT(max) {
+			return errorsmod.Wrapf(ErrInvalidRatingModel, "empty range of %s", factor.Attribute)
+		}
+	}
+	return nil
+}
+
+// Price returns the premium of a policy type with the given risk attributes:
+// its base rate multiplied by the first matching factor of every attribute,
+// truncated and kept between the floor and cap of the type
+func (m RatingModel) Price(policyType string, attributes map[string]string) (sdkmath.Int, error) {
+	var rate *BaseRate
+	for i := range m.BaseRates {
+		if m.BaseRates[i].PolicyType == policyType {
+			rate = &m.BaseRates[i]
+			break
+		}
+	}
+	if rate == nil {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidRatingModel, "version %d of the rating model has no base rate for %s", m.Version, policyType)
+	}
+
+	premium := sdkmath.LegacyNewDecFromInt(rate.Rate)
+	applied := make(map[string]bool)
+	for _, factor := range m.Factors {
+		value, found := attributes[factor.Attribute]
+		if !found || applied[factor.Attribute] || !factor.Matches(value) {
+			continue
+		}
+		premium = premium.Mul(factor.Multiplier)
+		applied[factor.Attribute] = true
+	}
+
+	price := premium.TruncateInt()
+	if floor := orZero(rate.Floor); price.LT(floor) {
+		price = floor
+	}
+	if cap := orZero(rate.Cap); cap.IsPositive() && price.GT(cap) {
+		price = cap
+	}
+	return price, nil
+}
+
+// Matches reports whether a value of the risk attribute of a factor matches it
+func (f RatingFactor) Matches(value string) bool {
+	if f.Value != "" {
+		return value == f.Value
+	}
+	v, err := sdkmath.LegacyNewDecFromStr(value)
+	if err != nil {
+		return false
+	}
+	min, hasMin, err := parseBound(f.Min)
+	if err != nil || (hasMin && v.LT(min)) {
+		return false
+	}
+	max, hasMax, err := parseBound(f.Max)
+	return err == nil && (!hasMax || v.LT(max))
+}
+
+// parseBound parses a bound of a factor range, which is unbounded when empty
+func parseBound(bound string) (sdkmath.LegacyDec, bool, error) {
+	if bound == "" {
+		return sdkmath.LegacyDec{}, false, nil
+	}
+	dec, err := sdkmath.LegacyNewDecFromStr(bound)
+	return dec, err == nil, err
+}
+
+// orZero returns zero for an unset amount
+func orZero(amount sdkmath.Int) sdkmath.Int {
+	if amount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return amount
+}
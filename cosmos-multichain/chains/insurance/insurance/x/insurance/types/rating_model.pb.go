@@ -0,0 +1,1278 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insurance/insurance/rating_model.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RatingModel is a version of the rating table pricing the policies. The
+// premium of a policy is the base rate of its type multiplied by the factors
+// matching its risk attributes, kept between the floor and the cap of the
+// type. Governance adds new versions; the latest one prices the quotes.
+type RatingModel struct {
+	Version     uint64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Description string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	BaseRates   []BaseRate     `protobuf:"bytes,3,rep,name=base_rates,json=baseRates,proto3" json:"base_rates"`
+	Factors     []RatingFactor `protobuf:"bytes,4,rep,name=factors,proto3" json:"factors"`
+	Authority   string         `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	CreatedAt   int64          `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *RatingModel) Reset()         { *m = RatingModel{} }
+func (m *RatingModel) String() string { return proto.CompactTextString(m) }
+func (*RatingModel) ProtoMessage()    {}
+func (*RatingModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ee616bb498bc29, []int{0}
+}
+func (m *RatingModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatingModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatingModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatingModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingModel.Merge(m, src)
+}
+func (m *RatingModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatingModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingModel proto.InternalMessageInfo
+
+func (m *RatingModel) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RatingModel) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RatingModel) GetBaseRates() []BaseRate {
+	if m != nil {
+		return m.BaseRates
+	}
+	return nil
+}
+
+func (m *RatingModel) GetFactors() []RatingFactor {
+	if m != nil {
+		return m.Factors
+	}
+	return nil
+}
+
+func (m *RatingModel) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *RatingModel) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// BaseRate is the base premium of a policy type, with the floor and cap of
+// its premiums. A zero cap leaves the premiums uncapped.
+type BaseRate struct {
+	PolicyType string                `protobuf:"bytes,1,opt,name=policy_type,json=policyType,proto3" json:"policy_type,omitempty"`
+	Rate       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.Int" json:"rate"`
+	Floor      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=floor,proto3,customtype=cosmossdk.io/math.Int" json:"floor"`
+	Cap        cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+}
+
+func (m *BaseRate) Reset()         { *m = BaseRate{} }
+func (m *BaseRate) String() string { return proto.CompactTextString(m) }
+func (*BaseRate) ProtoMessage()    {}
+func (*BaseRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ee616bb498bc29, []int{1}
+}
+func (m *BaseRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseRate.Merge(m, src)
+}
+func (m *BaseRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseRate proto.InternalMessageInfo
+
+func (m *BaseRate) GetPolicyType() string {
+	if m != nil {
+		return m.PolicyType
+	}
+	return ""
+}
+
+// RatingFactor multiplies the premium of the policies whose risk attribute
+// matches it. It matches the attribute value when value is set, and the
+// numeric attribute values in [min, max) otherwise, where an empty bound is
+// unbounded. The first matching factor of an attribute applies.
+type RatingFactor struct {
+	Attribute  string                      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Value      string                      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Min        string                      `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max        string                      `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *RatingFactor) Reset()         { *m = RatingFactor{} }
+func (m *RatingFactor) String() string { return proto.CompactTextString(m) }
+func (*RatingFactor) ProtoMessage()    {}
+func (*RatingFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46ee616bb498bc29, []int{2}
+}
+func (m *RatingFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatingFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatingFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatingFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingFactor.Merge(m, src)
+}
+func (m *RatingFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatingFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingFactor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingFactor proto.InternalMessageInfo
+
+func (m *RatingFactor) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *RatingFactor) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *RatingFactor) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *RatingFactor) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RatingModel)(nil), "insurance.insurance.RatingModel")
+	proto.RegisterType((*BaseRate)(nil), "insurance.insurance.BaseRate")
+	proto.RegisterType((*RatingFactor)(nil), "insurance.insurance.RatingFactor")
+}
+
+func init() {
+	proto.RegisterFile("insurance/insurance/rating_model.proto", fileDescriptor_46ee616bb498bc29)
+}
+
+var fileDescriptor_46ee616bb498bc29 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xce, 0x74, 0x93, 0xf6, 0x97, 0x97, 0xdf, 0x41, 0xc7, 0x0a, 0x6b, 0x6b, 0x37, 0x6b, 0x0e,
+	0x12, 0x04, 0x37, 0xa2, 0xe8, 0xbd, 0x4b, 0x28, 0x14, 0xf4, 0x32, 0x88, 0x07, 0x2f, 0xcb, 0x64,
+	0x32, 0x4d, 0x07, 0x77, 0x77, 0x96, 0x99, 0xd9, 0xd2, 0xfd, 0x23, 0x04, 0xff, 0x0c, 0x8f, 0x1e,
+	0x3c, 0x7b, 0xee, 0xb1, 0x78, 0x12, 0x0f, 0x45, 0x92, 0x83, 0x7f, 0x85, 0x20, 0x3b, 0xb3, 0x69,
+	0x16, 0xec, 0xa9, 0x97, 0xe1, 0xbd, 0xef, 0xcd, 0xfb, 0xe6, 0x9b, 0xef, 0xf1, 0xe0, 0xb1, 0xc8,
+	0x75, 0xa9, 0x68, 0xce, 0xf8, 0x64, 0x13, 0x29, 0x6a, 0x44, 0xbe, 0x48, 0x32, 0x39, 0xe7, 0x69,
+	0x54, 0x28, 0x69, 0x24, 0xbe, 0x77, 0x5d, 0x8d, 0xae, 0xa3, 0xbd, 0xbb, 0x34, 0x13, 0xb9, 0x9c,
+	0xd8, 0xd3, 0xdd, 0xdb, 0x7b, 0xc0, 0xa4, 0xce, 0xa4, 0x4e, 0x6c, 0x36, 0x71, 0x49, 0x53, 0xda,
+	0x5d, 0xc8, 0x85, 0x74, 0x78, 0x1d, 0x39, 0x74, 0xf4, 0x71, 0x0b, 0x06, 0xc4, 0xbe, 0xf7, 0xa6,
+	0x7e, 0x0e, 0xfb, 0xb0, 0x73, 0xc6, 0x95, 0x16, 0x32, 0xf7, 0x51, 0x88, 0xc6, 0x5d, 0xb2, 0x4e,
+	0x71, 0x08, 0x83, 0x39, 0xd7, 0x4c, 0x89, 0xc2, 0xd4, 0xd5, 0xad, 0x10, 0x8d, 0xfb, 0xa4, 0x0d,
+	0xe1, 0x18, 0x60, 0x46, 0x35, 0x4f, 0x14, 0x35, 0x5c, 0xfb, 0x5e, 0xe8, 0x8d, 0x07, 0xcf, 0x0f,
+	0xa2, 0x1b, 0x94, 0x47, 0x31, 0xd5, 0x9c, 0x50, 0xc3, 0xe3, 0xee, 0xc5, 0xd5, 0xb0, 0x43, 0xfa,
+	0xb3, 0x26, 0xd7, 0xf8, 0x10, 0x76, 0x4e, 0x28, 0x33, 0x52, 0x69, 0xbf, 0x6b, 0x09, 0x1e, 0xdd,
+	0x48, 0xe0, 0x24, 0x1f, 0xd9, 0x9b, 0x0d, 0xc9, 0xba, 0x0f, 0x3f, 0x84, 0x3e, 0x2d, 0xcd, 0xa9,
+	0x54, 0xc2, 0x54, 0x7e, 0xcf, 0xca, 0xdc, 0x00, 0xf8, 0x00, 0x80, 0x29, 0x4e, 0x0d, 0x9f, 0x27,
+	0xd4, 0xf8, 0xdb, 0x21, 0x1a, 0x7b, 0xa4, 0xdf, 0x20, 0x87, 0x66, 0xf4, 0x07, 0xc1, 0x7f, 0x6b,
+	0x75, 0x78, 0x08, 0x83, 0x42, 0xa6, 0x82, 0x55, 0x89, 0xa9, 0x0a, 0x6e, 0x0d, 0xe9, 0x13, 0x70,
+	0xd0, 0xdb, 0xaa, 0xe0, 0x78, 0x0a, 0xdd, 0xfa, 0xb3, 0xce, 0x8c, 0xf8, 0x59, 0xad, 0xe3, 0xe7,
+	0xd5, 0xf0, 0xbe, 0xf3, 0x5d, 0xcf, 0x3f, 0x44, 0x42, 0x4e, 0x32, 0x6a, 0x4e, 0xa3, 0xe3, 0xdc,
+	0x7c, 0xff, 0xfa, 0x14, 0x9a, 0x81, 0x1c, 0xe7, 0xe6, 0xf3, 0xef, 0x2f, 0x4f, 0x10, 0xb1, 0xdd,
+	0xf8, 0x08, 0x7a, 0x27, 0xa9, 0x94, 0xca, 0xf7, 0x6e, 0x49, 0xe3, 0xda, 0x71, 0x0c, 0x1e, 0xa3,
+	0x85, 0xdf, 0xbd, 0x25, 0x4b, 0xdd, 0x3c, 0xfa, 0x86, 0xe0, 0xff, 0xb6, 0xb9, 0xd6, 0x4d, 0x63,
+	0x94, 0x98, 0x95, 0x66, 0xed, 0xc0, 0x06, 0xc0, 0xbb, 0xd0, 0x3b, 0xa3, 0x69, 0xd9, 0x38, 0x40,
+	0x5c, 0x82, 0xef, 0x80, 0x97, 0x89, 0xdc, 0x7d, 0x87, 0xd4, 0xa1, 0x45, 0xe8, 0xb9, 0x93, 0x46,
+	0xea, 0x10, 0xbf, 0x03, 0xc8, 0xca, 0xd4, 0x88, 0x22, 0x15, 0x5c, 0xb9, 0x31, 0xc5, 0xaf, 0x1a,
+	0xcd, 0xfb, 0xff, 0x6a, 0x7e, 0xcd, 0x17, 0x94, 0x55, 0x53, 0xce, 0x5a, 0xca, 0xa7, 0x9c, 0x39,
+	0xe5, 0x2d, 0xa6, 0xf8, 0xe5, 0xc5, 0x32, 0x40, 0x97, 0xcb, 0x00, 0xfd, 0x5a, 0x06, 0xe8, 0xd3,
+	0x2a, 0xe8, 0x5c, 0xae, 0x82, 0xce, 0x8f, 0x55, 0xd0, 0x79, 0xbf, 0xbf, 0xd9, 0xb0, 0xf3, 0xd6,
+	0xb6, 0xd5, 0xa3, 0xd5, 0xb3, 0x6d, 0xbb, 0x0e, 0x2f, 0xfe, 0x0e, 0x00, 0xb1, 0xfe, 0xd5, 0x8d,
+	0x91, 0x03, 0x00, 0x00,
+}
+
+func (m *RatingModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatingModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatingModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRatingModel(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Factors) > 0 {
+		for iNdEx := len(m.Factors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Factors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatingModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BaseRates) > 0 {
+		for iNdEx := len(m.BaseRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatingModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintRatingModel(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatingModel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Floor.Size()
+		i -= size
+		if _, err := m.Floor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatingModel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatingModel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PolicyType) > 0 {
+		i -= len(m.PolicyType)
+		copy(dAtA[i:], m.PolicyType)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.PolicyType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RatingFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatingFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatingFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatingModel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintRatingModel(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatingModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatingModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RatingModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovRatingModel(uint64(m.Version))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	if len(m.BaseRates) > 0 {
+		for _, e := range m.BaseRates {
+			l = e.Size()
+			n += 1 + l + sovRatingModel(uint64(l))
+		}
+	}
+	if len(m.Factors) > 0 {
+		for _, e := range m.Factors {
+			l = e.Size()
+			n += 1 + l + sovRatingModel(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRatingModel(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *BaseRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyType)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRatingModel(uint64(l))
+	l = m.Floor.Size()
+	n += 1 + l + sovRatingModel(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovRatingModel(uint64(l))
+	return n
+}
+
+func (m *RatingFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovRatingModel(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovRatingModel(uint64(l))
+	return n
+}
+
+func sovRatingModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatingModel(x uint64) (n int) {
+	return sovRatingModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RatingModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatingModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatingModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatingModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRates = append(m.BaseRates, BaseRate{})
+			if err := m.BaseRates[len(m.BaseRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factors = append(m.Factors, RatingFactor{})
+			if err := m.Factors[len(m.Factors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatingModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatingModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Floor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatingModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RatingFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatingModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatingFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatingFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatingModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatingModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRatingModel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRatingModel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatingModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRatingModel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRatingModel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRatingModel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRatingModel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRatingModel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRatingModel = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"insurance/x/policy/types"
+)
+
+// ClaimCoverage uses amount of the remaining coverage of a policy for an
+// approved claim. Claims filed while a policy was active may still be approved
+// once it has expired.
+func (k Keeper) ClaimCoverage(ctx context.Context, policyId string, amount sdkmath.Int) error {
+	policy, found := k.GetPolicy(ctx, policyId)
+	if !found {
+		return errorsmod.Wrap(types.ErrPolicyNotFound, policyId)
+	}
+	if policy.Status != types.PolicyStatusActive && policy.Status != types.PolicyStatusExpired {
+		return errorsmod.Wrapf(types.ErrInvalidPolicyStatus, "policy %s is %s", policyId, policy.Status)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "claimed amount must be positive")
+	}
+	remaining := policy.RemainingCoverage()
+	if amount.GT(remaining) {
+		return errorsmod.Wrapf(types.ErrCoverageExceeded, "%s claimed on policy %s with %s of coverage left", amount, policyId, remaining)
+	}
+
+	if policy.Claimed.IsNil() {
+		policy.Claimed = sdkmath.ZeroInt()
+	}
+	policy.Claimed = policy.Claimed.Add(amount)
+	policy.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	k.SetPolicy(ctx, policy)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCoverageClaimed,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, policy.RemainingCoverage().String()),
+		),
+	)
+	return nil
+}
+
+// ReleaseCoverage gives back amount of the coverage a claim used on a policy
+// when its payout failed
+func (k Keeper) ReleaseCoverage(ctx context.Context, policyId string, amount sdkmath.Int) error {
+	policy, found := k.GetPolicy(ctx, policyId)
+	if !found {
+		return errorsmod.Wrap(types.ErrPolicyNotFound, policyId)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "released amount must be positive")
+	}
+	if policy.Claimed.IsNil() || amount.GT(policy.Claimed) {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "%s released on policy %s with %s claimed", amount, policyId, policy.Claimed)
+	}
+
+	policy.Claimed = policy.Claimed.Sub(amount)
+	policy.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	k.SetPolicy(ctx, policy)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCoverageReleased,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, policy.RemainingCoverage().String()),
+		),
+	)
+	return nil
+}
+
+// EscrowBalance returns the balance in denom of the module escrow account
+// holding the paid premiums
+func (k Keeper) EscrowBalance(ctx context.Context, denom string) sdk.Coin {
+	balance := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	return sdk.NewCoin(denom, balance.AmountOf(denom))
+}
+
+// PayFromEscrow pays amount to recipient out of the module escrow account
+func (k Keeper) PayFromEscrow(ctx context.Context, recipient string, amount sdk.Coin) error {
+	to, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEscrowPayout,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"insurance/x/policy/types"
+)
+
+// CreatePolicy records a QUOTED policy awaiting the payment of its premium.
+// The premium is paid in the premium denom of the module, and the policy must
+// cover a period ending after the current block time.
+func (k Keeper) CreatePolicy(ctx context.Context, policy types.Policy) error {
+	if err := k.validatePolicy(ctx, policy); err != nil {
+		return err
+	}
+	if _, found := k.GetPolicy(ctx, policy.PolicyId); found {
+		return errorsmod.Wrap(types.ErrPolicyExists, policy.PolicyId)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if policy.EndDate <= now {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "policy %s ends before the current block time", policy.PolicyId)
+	}
+	policy.Status = types.PolicyStatusQuoted
+	policy.PremiumPaid = sdk.NewInt64Coin(policy.Premium.Denom, 0)
+	policy.Claimed = sdkmath.ZeroInt()
+	policy.Terms = sortedTerms(policy.Terms)
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+	k.SetPolicy(ctx, policy)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePolicyCreated,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyHolder, policy.Holder),
+			sdk.NewAttribute(types.AttributeKeyPolicyType, policy.PolicyType),
+			sdk.NewAttribute(types.AttributeKeyAmount, policy.Premium.String()),
+		),
+	)
+	return nil
+}
+
+// UpdatePolicy replaces the terms of an open policy. The holder and type of a
+// policy never change, and neither do its premium, period, coverage and terms
+// once the premium is paid: the escrowed premium priced them.
+func (k Keeper) UpdatePolicy(ctx context.Context, policy types.Policy) error {
+	if err := k.validatePolicy(ctx, policy); err != nil {
+		return err
+	}
+	current, found := k.GetPolicy(ctx, policy.PolicyId)
+	if !found {
+		return errorsmod.Wrap(types.ErrPolicyNotFound, policy.PolicyId)
+	}
+	if !current.IsOpen() {
+		return errorsmod.Wrapf(types.ErrInvalidPolicyStatus, "policy %s is %s", policy.PolicyId, current.Status)
+	}
+	if policy.Holder != current.Holder || policy.PolicyType != current.PolicyType {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "the holder and type of a policy cannot be changed")
+	}
+	policy.Terms = sortedTerms(policy.Terms)
+	if current.IsPaid() && (!policy.Premium.IsEqual(current.Premium) || policy.StartDate != current.StartDate || policy.EndDate != current.EndDate) {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "the premium and period of policy %s are paid", policy.PolicyId)
+	}
+	if current.IsPaid() && (!policy.Coverage.Equal(current.Coverage) || !equalTerms(policy.Terms, current.Terms)) {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "the coverage and terms of policy %s are paid", policy.PolicyId)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if policy.EndDate <= now {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "policy %s ends before the current block time", policy.PolicyId)
+	}
+	policy.Status = current.Status
+	policy.PremiumPaid = current.PremiumPaid
+	policy.Claimed = current.Claimed
+	policy.CreatedAt = current.CreatedAt
+	policy.UpdatedAt = now
+	k.SetPolicy(ctx, policy)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePolicyUpdated,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyHolder, policy.Holder),
+			sdk.NewAttribute(types.AttributeKeyPolicyType, policy.PolicyType),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) validatePolicy(ctx context.Context, policy types.Policy) error {
+	if policy.PolicyId == "" {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "policy ID is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(policy.Holder); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "invalid holder address: %s", err)
+	}
+	if policy.PolicyType == "" {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "policy type is required")
+	}
+	if policy.Coverage.IsNil() || !policy.Coverage.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "coverage must be positive")
+	}
+	if !policy.Premium.IsValid() || !policy.Premium.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "invalid premium %s", policy.Premium)
+	}
+	if denom := k.GetParams(ctx).PremiumDenom; policy.Premium.Denom != denom {
+		return errorsmod.Wrapf(types.ErrInvalidPolicy, "premiums are paid in %s", denom)
+	}
+	if policy.StartDate <= 0 || policy.EndDate <= policy.StartDate {
+		return errorsmod.Wrap(types.ErrInvalidPolicy, "the policy must end after its start date")
+	}
+	return nil
+}
+
+// PayPremium escrows the premium of a quoted policy from its holder in the
+// module account. The policy is ACTIVE at once if its start date is reached,
+// otherwise at its start date.
+func (k Keeper) PayPremium(ctx context.Context, payer string, policyId string) (types.Policy, error) {
+	policy, found := k.GetPolicy(ctx, policyId)
+	if !found {
+		return policy, errorsmod.Wrap(types.ErrPolicyNotFound, policyId)
+	}
+	if policy.Holder != payer {
+		return policy, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the premium of policy %s is paid by %s", policyId, policy.Holder)
+	}
+	if policy.Status != types.PolicyStatusQuoted || policy.IsPaid() {
+		return policy, errorsmod.Wrapf(types.ErrInvalidPolicyStatus, "policy %s is %s and awaits no premium", policyId, policy.Status)
+	}
+	from, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return policy, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address: %s", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(policy.Premium)); err != nil {
+		return policy, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	policy.PremiumPaid = policy.Premium
+	if policy.StartDate <= now {
+		policy.Status = types.PolicyStatusActive
+	}
+	policy.UpdatedAt = now
+	k.SetPolicy(ctx, policy)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePremiumPaid,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyHolder, policy.Holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, policy.PremiumPaid.String()),
+		),
+	)
+	if policy.Status == types.PolicyStatusActive {
+		emitPolicyStatusEvent(sdkCtx, policy)
+	}
+	return policy, nil
+}
+
+// CancelPolicy cancels an open policy and refunds its holder the part of the
+// paid premium covering the time left on the policy, less the coverage
+// already claimed on it
+func (k Keeper) CancelPolicy(ctx context.Context, policyId string) (sdk.Coin, error) {
+	policy, found := k.GetPolicy(ctx, policyId)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrPolicyNotFound, policyId)
+	}
+	if !policy.IsOpen() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPolicyStatus, "policy %s is %s", policyId, policy.Status)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	refund := policy.Refund(now)
+	if refund.IsPositive() {
+		to, err := sdk.AccAddressFromBech32(policy.Holder)
+		if err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidPolicy, "invalid holder address: %s", err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, sdk.NewCoins(refund)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	policy.Status = types.PolicyStatusCancelled
+	policy.UpdatedAt = now
+	k.SetPolicy(ctx, policy)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePolicyCancelled,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyHolder, policy.Holder),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+	emitPolicyStatusEvent(sdkCtx, policy)
+	return refund, nil
+}
+
+// ApplySchedule changes the status of every policy queued for a status change
+// at or before now. Paid quoted policies become ACTIVE at their start date,
+// unpaid ones LAPSED, and active policies EXPIRED at their end date. The
+// earned premiums stay in the module account.
+func (k Keeper) ApplySchedule(ctx context.Context, now time.Time) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, policyId := range k.duePolicies(ctx, now.Unix()) {
+		policy, found := k.GetPolicy(ctx, policyId)
+		if !found {
+			continue
+		}
+
+		switch {
+		case policy.Status == types.PolicyStatusQuoted && !policy.IsPaid():
+			policy.Status = types.PolicyStatusLapsed
+		case policy.EndDate <= now.Unix():
+			policy.Status = types.PolicyStatusExpired
+		default:
+			policy.Status = types.PolicyStatusActive
+		}
+		policy.UpdatedAt = now.Unix()
+		k.SetPolicy(ctx, policy)
+		emitPolicyStatusEvent(sdkCtx, policy)
+	}
+}
+
+// sortedTerms returns the terms sorted by key, so that a policy is stored the
+// same way whatever the order its terms are given in
+func sortedTerms(terms []types.Term) []types.Term {
+	sort.SliceStable(terms, func(i, j int) bool { return terms[i].Key < terms[j].Key })
+	return terms
+}
+
+// equalTerms reports whether two sorted lists of terms are the same
+func equalTerms(a, b []types.Term) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func emitPolicyStatusEvent(ctx sdk.Context, policy types.Policy) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePolicyStatus,
+			sdk.NewAttribute(types.AttributeKeyPolicyId, policy.PolicyId),
+			sdk.NewAttribute(types.AttributeKeyHolder, policy.Holder),
+			sdk.NewAttribute(types.AttributeKeyStatus, policy.Status),
+		),
+	)
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "insurance/testutil/keeper"
+	"insurance/testutil/sample"
+	"insurance/x/policy/keeper"
+	"insurance/x/policy/types"
+)
+
+const day = 24 * time.Hour
+
+var start = time.Unix(1_000_000, 0)
+
+func policy(id, holder, policyType string) types.Policy {
+	return types.Policy{
+		PolicyId:   id,
+		Holder:     holder,
+		PolicyType: policyType,
+		Coverage:   sdkmath.NewInt(10_000),
+		Premium:    sdk.NewInt64Coin(types.DefaultPremiumDenom, 1_000),
+		StartDate:  start.Add(day).Unix(),
+		EndDate:    start.Add(11 * day).Unix(),
+		Terms:      []types.Term{{Key: "deductible", Value: "100"}, {Key: "copay", Value: "10"}},
+	}
+}
+
+func TestCreateAndUpdatePolicy(t *testing.T) {
+	k, ctx := keepertest.PolicyKeeper(t)
+	ctx = ctx.WithBlockTime(start)
+	holder := sample.AccAddress()
+
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-1", holder, "health")))
+	stored, found := k.GetPolicy(ctx, "policy-1")
+	require.True(t, found)
+	require.Equal(t, types.PolicyStatusQuoted, stored.Status)
+	require.False(t, stored.IsPaid())
+	require.Equal(t, "copay", stored.Terms[0].Key)
+	require.ErrorIs(t, k.CreatePolicy(ctx, policy("policy-1", holder, "health")), types.ErrPolicyExists)
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.Policy)
+	}{
+		{desc: "invalid holder", modify: func(p *types.Policy) { p.Holder = "invalid" }},
+		{desc: "no type", modify: func(p *types.Policy) { p.PolicyType = "" }},
+		{desc: "zero coverage", modify: func(p *types.Policy) { p.Coverage = sdkmath.ZeroInt() }},
+		{desc: "other denom", modify: func(p *types.Policy) { p.Premium = sdk.NewInt64Coin("uother", 1_000) }},
+		{desc: "ends before start", modify: func(p *types.Policy) { p.EndDate = p.StartDate }},
+		{desc: "already ended", modify: func(p *types.Policy) { p.StartDate, p.EndDate = 1, start.Unix() }},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := policy("policy-2", holder, "health")
+			tc.modify(&p)
+			require.ErrorIs(t, k.CreatePolicy(ctx, p), types.ErrInvalidPolicy)
+		})
+	}
+
+	updated := policy("policy-1", holder, "health")
+	updated.Coverage = sdkmath.NewInt(20_000)
+	require.NoError(t, k.UpdatePolicy(ctx, updated))
+	stored, _ = k.GetPolicy(ctx, "policy-1")
+	require.Equal(t, sdkmath.NewInt(20_000), stored.Coverage)
+	require.Equal(t, types.PolicyStatusQuoted, stored.Status)
+
+	updated.PolicyType = "life"
+	require.ErrorIs(t, k.UpdatePolicy(ctx, updated), types.ErrInvalidPolicy)
+	require.ErrorIs(t, k.UpdatePolicy(ctx, policy("policy-3", holder, "health")), types.ErrPolicyNotFound)
+}
+
+func TestPremiumEscrowAndRefund(t *testing.T) {
+	k, bank, ctx := keepertest.PolicyKeeperWithBank(t)
+	ctx = ctx.WithBlockTime(start)
+	srv := keeper.NewMsgServerImpl(k)
+	holder, other := sample.AccAddress(), sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(holder), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultPremiumDenom, 1_000)))
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-1", holder, "health")))
+
+	_, err := srv.PayPremium(ctx, types.NewMsgPayPremium(other, "policy-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	res, err := srv.PayPremium(ctx, types.NewMsgPayPremium(holder, "policy-1"))
+	require.NoError(t, err)
+	require.Equal(t, types.PolicyStatusQuoted, res.Status)
+	require.Equal(t, int64(1_000), bank.ModuleBalance(types.ModuleName).AmountOf(types.DefaultPremiumDenom).Int64())
+	_, err = srv.PayPremium(ctx, types.NewMsgPayPremium(holder, "policy-1"))
+	require.ErrorIs(t, err, types.ErrInvalidPolicyStatus)
+
+	// The premium, period, coverage and terms of a paid policy are fixed
+	updated := policy("policy-1", holder, "health")
+	updated.Premium = sdk.NewInt64Coin(types.DefaultPremiumDenom, 500)
+	require.ErrorIs(t, k.UpdatePolicy(ctx, updated), types.ErrInvalidPolicy)
+	updated = policy("policy-1", holder, "health")
+	updated.Coverage = sdkmath.NewInt(20_000)
+	require.ErrorIs(t, k.UpdatePolicy(ctx, updated), types.ErrInvalidPolicy)
+	updated = policy("policy-1", holder, "health")
+	updated.Terms[0].Value = "0"
+	require.ErrorIs(t, k.UpdatePolicy(ctx, updated), types.ErrInvalidPolicy)
+	updated = policy("policy-1", holder, "health")
+	updated.RiskLevel = "low"
+	require.NoError(t, k.UpdatePolicy(ctx, updated))
+
+	k.ApplySchedule(ctx, start.Add(day))
+	active, _ := k.GetPolicy(ctx, "policy-1")
+	require.Equal(t, types.PolicyStatusActive, active.Status)
+
+	// Cancelling with 4 of the 10 days left refunds 40% of the premium, less
+	// the 150 of coverage already claimed
+	require.NoError(t, k.ClaimCoverage(ctx, "policy-1", sdkmath.NewInt(150)))
+	ctx = ctx.WithBlockTime(start.Add(7 * day))
+	_, err = srv.CancelPolicy(ctx, types.NewMsgCancelPolicy(other, "policy-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	cancelled, err := srv.CancelPolicy(ctx, types.NewMsgCancelPolicy(holder, "policy-1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultPremiumDenom, 250), cancelled.Refund)
+	require.Equal(t, int64(250), bank.Balance(sdk.MustAccAddressFromBech32(holder)).AmountOf(types.DefaultPremiumDenom).Int64())
+	require.Equal(t, int64(750), bank.ModuleBalance(types.ModuleName).AmountOf(types.DefaultPremiumDenom).Int64())
+	_, err = srv.CancelPolicy(ctx, types.NewMsgCancelPolicy(holder, "policy-1"))
+	require.ErrorIs(t, err, types.ErrInvalidPolicyStatus)
+}
+
+func TestApplySchedule(t *testing.T) {
+	k, bank, ctx := keepertest.PolicyKeeperWithBank(t)
+	ctx = ctx.WithBlockTime(start)
+	holder := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(holder), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultPremiumDenom, 1_000)))
+	require.NoError(t, k.CreatePolicy(ctx, policy("paid", holder, "health")))
+	require.NoError(t, k.CreatePolicy(ctx, policy("unpaid", holder, "health")))
+	_, err := k.PayPremium(ctx, holder, "paid")
+	require.NoError(t, err)
+
+	// Nothing changes before the start date
+	k.ApplySchedule(ctx, start.Add(day-time.Second))
+	p, _ := k.GetPolicy(ctx, "paid")
+	require.Equal(t, types.PolicyStatusQuoted, p.Status)
+
+	k.ApplySchedule(ctx, start.Add(day))
+	p, _ = k.GetPolicy(ctx, "paid")
+	require.Equal(t, types.PolicyStatusActive, p.Status)
+	p, _ = k.GetPolicy(ctx, "unpaid")
+	require.Equal(t, types.PolicyStatusLapsed, p.Status)
+	_, err = k.CancelPolicy(ctx, "unpaid")
+	require.ErrorIs(t, err, types.ErrInvalidPolicyStatus)
+
+	k.ApplySchedule(ctx, start.Add(11*day))
+	p, _ = k.GetPolicy(ctx, "paid")
+	require.Equal(t, types.PolicyStatusExpired, p.Status)
+	require.Equal(t, int64(1_000), bank.ModuleBalance(types.ModuleName).AmountOf(types.DefaultPremiumDenom).Int64())
+}
+
+func TestPolicyIndexes(t *testing.T) {
+	k, ctx := keepertest.PolicyKeeper(t)
+	ctx = ctx.WithBlockTime(start)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-1", alice, "health")))
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-2", alice, "life")))
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-3", bob, "health")))
+
+	byHolder, err := k.PoliciesByHolder(ctx, &types.QueryPoliciesByHolderRequest{Holder: alice})
+	require.NoError(t, err)
+	require.Len(t, byHolder.Policy, 2)
+	byType, err := k.PoliciesByType(ctx, &types.QueryPoliciesByTypeRequest{PolicyType: "health", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, byType.Policy, 1)
+	require.Equal(t, uint64(2), byType.Pagination.Total)
+
+	// A prefix of a holder or type never matches another one
+	byType, err = k.PoliciesByType(ctx, &types.QueryPoliciesByTypeRequest{PolicyType: "heal"})
+	require.NoError(t, err)
+	require.Empty(t, byType.Policy)
+}
+
+func TestClaimCoverageAndEscrow(t *testing.T) {
+	k, bank, ctx := keepertest.PolicyKeeperWithBank(t)
+	ctx = ctx.WithBlockTime(start)
+	holder := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(holder), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultPremiumDenom, 1_000)))
+	require.NoError(t, k.CreatePolicy(ctx, policy("policy-1", holder, "health")))
+
+	// Only the coverage of active policies is claimed
+	require.ErrorIs(t, k.ClaimCoverage(ctx, "policy-1", sdkmath.NewInt(100)), types.ErrInvalidPolicyStatus)
+	_, err := k.PayPremium(ctx, holder, "policy-1")
+	require.NoError(t, err)
+	k.ApplySchedule(ctx, start.Add(day))
+
+	require.NoError(t, k.ClaimCoverage(ctx, "policy-1", sdkmath.NewInt(6_000)))
+	require.ErrorIs(t, k.ClaimCoverage(ctx, "policy-1", sdkmath.NewInt(4_001)), types.ErrCoverageExceeded)
+	require.NoError(t, k.ClaimCoverage(ctx, "policy-1", sdkmath.NewInt(4_000)))
+	p, _ := k.GetPolicy(ctx, "policy-1")
+	require.True(t, p.RemainingCoverage().IsZero())
+
+	// The coverage of a failed payout is given back
+	require.ErrorIs(t, k.ReleaseCoverage(ctx, "policy-1", sdkmath.NewInt(10_001)), types.ErrInvalidPolicy)
+	require.NoError(t, k.ReleaseCoverage(ctx, "policy-1", sdkmath.NewInt(4_000)))
+	p, _ = k.GetPolicy(ctx, "policy-1")
+	require.Equal(t, int64(4_000), p.RemainingCoverage().Int64())
+
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultPremiumDenom, 1_000), k.EscrowBalance(ctx, types.DefaultPremiumDenom))
+	require.NoError(t, k.PayFromEscrow(ctx, holder, sdk.NewInt64Coin(types.DefaultPremiumDenom, 300)))
+	require.Equal(t, int64(700), k.EscrowBalance(ctx, types.DefaultPremiumDenom).Amount.Int64())
+	require.ErrorIs(t, k.PayFromEscrow(ctx, holder, sdk.NewInt64Coin(types.DefaultPremiumDenom, 701)), sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"insurance/x/policy/types"
+)
+
+func (k msgServer) CancelPolicy(goCtx context.Context, msg *types.MsgCancelPolicy) (*types.MsgCancelPolicyResponse, error) {
+	policy, found := k.GetPolicy(goCtx, msg.PolicyId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrPolicyNotFound, msg.PolicyId)
+	}
+	if policy.Holder != msg.Creator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "policy %s is cancelled by %s", msg.PolicyId, policy.Holder)
+	}
+
+	refund, err := k.Keeper.CancelPolicy(goCtx, msg.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPolicyResponse{Refund: refund}, nil
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"insurance/x/policy/types"
+)
+
+func (k msgServer) PayPremium(goCtx context.Context, msg *types.MsgPayPremium) (*types.MsgPayPremiumResponse, error) {
+	policy, err := k.Keeper.PayPremium(goCtx, msg.Creator, msg.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayPremiumResponse{Status: policy.Status}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"insurance/x/policy/types"
+)
+
+// SetPolicy set a specific policy in the store from its index, indexes it by
+// holder and type, and queues open policies for their next status change
+func (k Keeper) SetPolicy(ctx context.Context, policy types.Policy) {
+	if previous, found := k.GetPolicy(ctx, policy.PolicyId); found {
+		k.unindexPolicy(ctx, previous)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyKeyPrefix))
+	b := k.cdc.MustMarshal(&policy)
+	store.Set(types.PolicyKey(
+		policy.PolicyId,
+	), b)
+
+	policyId := []byte(policy.PolicyId)
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyHolderIndexPrefix)).
+		Set(types.PolicyIndexKey(policy.Holder, policy.PolicyId), policyId)
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyTypeIndexPrefix)).
+		Set(types.PolicyIndexKey(policy.PolicyType, policy.PolicyId), policyId)
+	if policy.IsOpen() {
+		queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyScheduleQueuePrefix))
+		queue.Set(types.PolicyScheduleQueueKey(policy.NextTransition(), policy.PolicyId), policyId)
+	}
+}
+
+// GetPolicy returns a policy from its index
+func (k Keeper) GetPolicy(
+	ctx context.Context,
+	policyId string,
+) (val types.Policy, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyKeyPrefix))
+
+	b := store.Get(types.PolicyKey(
+		policyId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPolicy returns all policy
+func (k Keeper) GetAllPolicy(ctx context.Context) (list []types.Policy) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Policy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetHolderPolicies returns the policies of a holder
+func (k Keeper) GetHolderPolicies(ctx context.Context, holder string) (list []types.Policy) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	index := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyHolderIndexPrefix))
+	iterator := storetypes.KVStorePrefixIterator(index, types.PolicyKey(holder))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if policy, found := k.GetPolicy(ctx, string(iterator.Value())); found {
+			list = append(list, policy)
+		}
+	}
+
+	return
+}
+
+func (k Keeper) unindexPolicy(ctx context.Context, policy types.Policy) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyHolderIndexPrefix)).
+		Delete(types.PolicyIndexKey(policy.Holder, policy.PolicyId))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyTypeIndexPrefix)).
+		Delete(types.PolicyIndexKey(policy.PolicyType, policy.PolicyId))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyScheduleQueuePrefix)).
+		Delete(types.PolicyScheduleQueueKey(policy.NextTransition(), policy.PolicyId))
+}
+
+// duePolicies returns the IDs of the policies queued for a status change at
+// or before now
+func (k Keeper) duePolicies(ctx context.Context, now int64) (policyIds []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PolicyScheduleQueuePrefix))
+	iterator := queue.Iterator(nil, types.PolicyTimeKey(now+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		policyIds = append(policyIds, string(iterator.Value()))
+	}
+	return policyIds
+}
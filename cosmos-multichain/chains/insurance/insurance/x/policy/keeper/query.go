@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"insurance/x/policy/types"
+)
+
+var _ types.QueryServer = Keeper{}
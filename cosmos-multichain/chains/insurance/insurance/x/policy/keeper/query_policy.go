@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"insurance/x/policy/types"
+)
+
+func (k Keeper) PolicyAll(ctx context.Context, req *types.QueryAllPolicyRequest) (*types.QueryAllPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var policys []types.Policy
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	policyStore := prefix.NewStore(store, types.KeyPrefix(types.PolicyKeyPrefix))
+
+	pageRes, err := query.Paginate(policyStore, req.Pagination, func(key []byte, value []byte) error {
+		var policy types.Policy
+		if err := k.cdc.Unmarshal(value, &policy); err != nil {
+			return err
+		}
+
+		policys = append(policys, policy)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPolicyResponse{Policy: policys, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Policy(ctx context.Context, req *types.QueryGetPolicyRequest) (*types.QueryGetPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetPolicy(
+		ctx,
+		req.PolicyId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPolicyResponse{Policy: val}, nil
+}
+
+func (k Keeper) PoliciesByHolder(ctx context.Context, req *types.QueryPoliciesByHolderRequest) (*types.QueryPoliciesByHolderResponse, error) {
+	if req == nil || req.Holder == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	policies, pageRes, err := k.indexedPolicies(ctx, types.PolicyHolderIndexPrefix, req.Holder, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoliciesByHolderResponse{Policy: policies, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PoliciesByType(ctx context.Context, req *types.QueryPoliciesByTypeRequest) (*types.QueryPoliciesByTypeResponse, error) {
+	if req == nil || req.PolicyType == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	policies, pageRes, err := k.indexedPolicies(ctx, types.PolicyTypeIndexPrefix, req.PolicyType, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoliciesByTypeResponse{Policy: policies, Pagination: pageRes}, nil
+}
+
+// indexedPolicies paginates the policies indexed under indexed in the index
+// with the given prefix
+func (k Keeper) indexedPolicies(ctx context.Context, indexPrefix string, indexed string, pagination *query.PageRequest) ([]types.Policy, *query.PageResponse, error) {
+	var policies []types.Policy
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(prefix.NewStore(store, types.KeyPrefix(indexPrefix)), types.PolicyKey(indexed))
+
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		policy, found := k.GetPolicy(ctx, string(value))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed policy %s not found", value)
+		}
+
+		policies = append(policies, policy)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return policies, pageRes, nil
+}
@@ -0,0 +1,70 @@
+package policy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "insurance/api/insurance/policy"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "PolicyAll",
+					Use:       "list-policy",
+					Short:     "List all Policy",
+				},
+				{
+					RpcMethod:      "Policy",
+					Use:            "show-policy [policy-id]",
+					Short:          "Shows a Policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_id"}},
+				},
+				{
+					RpcMethod:      "PoliciesByHolder",
+					Use:            "policies-by-holder [holder]",
+					Short:          "List the policies of a holder",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "holder"}},
+				},
+				{
+					RpcMethod:      "PoliciesByType",
+					Use:            "policies-by-type [policy-type]",
+					Short:          "List the policies of a type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_type"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "PayPremium",
+					Use:            "pay-premium [policy-id]",
+					Short:          "Pay the premium of a quoted policy into escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_id"}},
+				},
+				{
+					RpcMethod:      "CancelPolicy",
+					Use:            "cancel-policy [policy-id]",
+					Short:          "Cancel a policy for a pro rata refund of its premium",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/policy module sentinel errors
+var (
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidPolicy       = sdkerrors.Register(ModuleName, 1101, "invalid policy")
+	ErrPolicyExists        = sdkerrors.Register(ModuleName, 1102, "policy already exists")
+	ErrPolicyNotFound      = sdkerrors.Register(ModuleName, 1103, "policy not found")
+	ErrInvalidPolicyStatus = sdkerrors.Register(ModuleName, 1104, "invalid policy status")
+	ErrCoverageExceeded    = sdkerrors.Register(ModuleName, 1105, "policy coverage exceeded")
+)
@@ -0,0 +1,22 @@
+package types
+
+// policy module event types
+const (
+	EventTypePolicyCreated    = "policy_created"
+	EventTypePolicyUpdated    = "policy_updated"
+	EventTypePremiumPaid      = "premium_paid"
+	EventTypePolicyCancelled  = "policy_cancelled"
+	EventTypePolicyStatus     = "policy_status"
+	EventTypeCoverageClaimed  = "coverage_claimed"
+	EventTypeCoverageReleased = "coverage_released"
+	EventTypeEscrowPayout     = "escrow_payout"
+
+	AttributeKeyPolicyId   = "policy_id"
+	AttributeKeyHolder     = "holder"
+	AttributeKeyPolicyType = "policy_type"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyStatus     = "status"
+	AttributeKeyRemaining  = "remaining"
+	AttributeKeyRecipient  = "recipient"
+)
@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		PolicyList: []Policy{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in policy
+	policyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PolicyList {
+		index := string(PolicyKey(elem.PolicyId))
+		if _, ok := policyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for policy")
+		}
+		policyIndexMap[index] = struct{}{}
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
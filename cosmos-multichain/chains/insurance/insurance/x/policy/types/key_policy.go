@@ -0,0 +1,57 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// PolicyKeyPrefix is the prefix to retrieve all Policy
+	PolicyKeyPrefix = "Policy/value/"
+
+	// PolicyHolderIndexPrefix indexes the policies by holder
+	PolicyHolderIndexPrefix = "Policy/holder/"
+
+	// PolicyTypeIndexPrefix indexes the policies by type
+	PolicyTypeIndexPrefix = "Policy/type/"
+
+	// PolicyScheduleQueuePrefix orders the policies by their next scheduled
+	// status change
+	PolicyScheduleQueuePrefix = "Policy/schedule/"
+)
+
+// PolicyKey returns the store key to retrieve a Policy from the index fields
+func PolicyKey(
+	policyId string,
+) []byte {
+	var key []byte
+
+	policyIdBytes := []byte(policyId)
+	key = append(key, policyIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PolicyIndexKey returns the key of the entry of a policy in the holder or
+// type index, under the prefix of its holder or type
+func PolicyIndexKey(
+	indexed string,
+	policyId string,
+) []byte {
+	return append(PolicyKey(indexed), PolicyKey(policyId)...)
+}
+
+// PolicyTimeKey returns the prefix of a time ordered queue entry
+func PolicyTimeKey(
+	unix int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(unix))
+	return key
+}
+
+// PolicyScheduleQueueKey returns the key of a schedule queue entry
+func PolicyScheduleQueueKey(
+	unix int64,
+	policyId string,
+) []byte {
+	return append(PolicyTimeKey(unix), PolicyKey(policyId)...)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCancelPolicy{}
+
+func NewMsgCancelPolicy(creator string, policyId string) *MsgCancelPolicy {
+	return &MsgCancelPolicy{
+		Creator:  creator,
+		PolicyId: policyId,
+	}
+}
+
+func (msg *MsgCancelPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PolicyId == "" {
+		return errorsmod.Wrap(ErrInvalidPolicy, "policy id cannot be empty")
+	}
+	return nil
+}
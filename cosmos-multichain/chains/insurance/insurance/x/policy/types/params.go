@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyPremiumDenom = []byte("PremiumDenom")
+	// DefaultPremiumDenom collects premiums in the bond denom
+	DefaultPremiumDenom = sdk.DefaultBondDenom
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	premiumDenom string,
+) Params {
+	return Params{
+		PremiumDenom: premiumDenom,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultPremiumDenom,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPremiumDenom, &p.PremiumDenom, validatePremiumDenom),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validatePremiumDenom(p.PremiumDenom)
+}
+
+// validatePremiumDenom validates the PremiumDenom param
+func validatePremiumDenom(v interface{}) error {
+	denom, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return sdk.ValidateDenom(denom)
+}
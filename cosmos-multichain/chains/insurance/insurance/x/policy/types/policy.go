@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Policy statuses. A policy is QUOTED until its premium is paid and its start
+// date is reached, then ACTIVE until its end date. A quoted policy whose
+// premium is not paid by its start date LAPSED, an active policy past its end
+// date EXPIRED, and a quoted or active policy can be CANCELLED.
+const (
+	PolicyStatusQuoted    = "QUOTED"
+	PolicyStatusActive    = "ACTIVE"
+	PolicyStatusLapsed    = "LAPSED"
+	PolicyStatusCancelled = "CANCELLED"
+	PolicyStatusExpired   = "EXPIRED"
+)
+
+// IsOpen reports whether the policy can still become or stay active
+func (p Policy) IsOpen() bool {
+	return p.Status == PolicyStatusQuoted || p.Status == PolicyStatusActive
+}
+
+// IsPaid reports whether the premium of the policy is paid
+func (p Policy) IsPaid() bool {
+	return p.PremiumPaid.IsValid() && p.PremiumPaid.IsPositive()
+}
+
+// RemainingCoverage returns the part of the coverage not used by approved
+// claims yet
+func (p Policy) RemainingCoverage() sdkmath.Int {
+	if p.Claimed.IsNil() {
+		return p.Coverage
+	}
+	return p.Coverage.Sub(p.Claimed)
+}
+
+// NextTransition returns the time of the next scheduled status change of the
+// policy, or zero when its status is final. A quoted policy is activated, or
+// lapses when unpaid, at its start date, and an active policy expires at its
+// end date.
+func (p Policy) NextTransition() int64 {
+	switch p.Status {
+	case PolicyStatusQuoted:
+		return p.StartDate
+	case PolicyStatusActive:
+		return p.EndDate
+	default:
+		return 0
+	}
+}
+
+// Refund returns the part of the paid premium covering the time left on the
+// policy at now, less the coverage already claimed on it. The whole premium
+// is refunded before the start date, and nothing after the end date.
+func (p Policy) Refund(now int64) sdk.Coin {
+	if !p.IsPaid() {
+		return sdk.NewInt64Coin(p.Premium.Denom, 0)
+	}
+	refund := p.PremiumPaid.Amount
+	switch {
+	case now <= p.StartDate:
+	case now >= p.EndDate:
+		return sdk.NewInt64Coin(p.PremiumPaid.Denom, 0)
+	default:
+		refund = refund.MulRaw(p.EndDate - now).QuoRaw(p.EndDate - p.StartDate)
+	}
+	if !p.Claimed.IsNil() {
+		refund = sdkmath.MaxInt(refund.Sub(p.Claimed), sdkmath.ZeroInt())
+	}
+	return sdk.NewCoin(p.PremiumPaid.Denom, refund)
+}
@@ -0,0 +1,1216 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: insurance/policy/policy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Policy is an insurance policy of a holder. It is QUOTED until its premium
+// is paid and its start date is reached, then ACTIVE until its end date. It
+// ends LAPSED, CANCELLED or EXPIRED.
+type Policy struct {
+	PolicyId   string                `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Holder     string                `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	PolicyType string                `protobuf:"bytes,3,opt,name=policy_type,json=policyType,proto3" json:"policy_type,omitempty"`
+	Coverage   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=coverage,proto3,customtype=cosmossdk.io/math.Int" json:"coverage"`
+	Premium    types.Coin            `protobuf:"bytes,5,opt,name=premium,proto3" json:"premium"`
+	// premium_paid is the part of the premium held in the module escrow
+	// account for the policy.
+	PremiumPaid types.Coin `protobuf:"bytes,6,opt,name=premium_paid,json=premiumPaid,proto3" json:"premium_paid"`
+	Status      string     `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	StartDate   int64      `protobuf:"varint,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     int64      `protobuf:"varint,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RiskLevel   string     `protobuf:"bytes,10,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	// terms are sorted by key.
+	Terms     []Term `protobuf:"bytes,11,rep,name=terms,proto3" json:"terms"`
+	CreatedAt int64  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// claimed is the part of the coverage used by the approved claims of the
+	// policy.
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// rating_model_version is the version of the rating model that quoted the
+	// premium of the policy, zero when its premium was not quoted.
+	RatingModelVersion uint64 `protobuf:"varint,15,opt,name=rating_model_version,json=ratingModelVersion,proto3" json:"rating_model_version,omitempty"`
+}
+
+func (m *Policy) Reset()         { *m = Policy{} }
+func (m *Policy) String() string { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()    {}
+func (*Policy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2cd52efb056fd98, []int{0}
+}
+func (m *Policy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Policy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Policy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Policy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Policy.Merge(m, src)
+}
+func (m *Policy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Policy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Policy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Policy proto.InternalMessageInfo
+
+func (m *Policy) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Policy) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Policy) GetPolicyType() string {
+	if m != nil {
+		return m.PolicyType
+	}
+	return ""
+}
+
+func (m *Policy) GetPremium() types.Coin {
+	if m != nil {
+		return m.Premium
+	}
+	return types.Coin{}
+}
+
+func (m *Policy) GetPremiumPaid() types.Coin {
+	if m != nil {
+		return m.PremiumPaid
+	}
+	return types.Coin{}
+}
+
+func (m *Policy) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Policy) GetStartDate() int64 {
+	if m != nil {
+		return m.StartDate
+	}
+	return 0
+}
+
+func (m *Policy) GetEndDate() int64 {
+	if m != nil {
+		return m.EndDate
+	}
+	return 0
+}
+
+func (m *Policy) GetRiskLevel() string {
+	if m != nil {
+		return m.RiskLevel
+	}
+	return ""
+}
+
+func (m *Policy) GetTerms() []Term {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *Policy) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Policy) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Policy) GetRatingModelVersion() uint64 {
+	if m != nil {
+		return m.RatingModelVersion
+	}
+	return 0
+}
+
+// Term is a term of the contract of a policy
+type Term struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Term) Reset()         { *m = Term{} }
+func (m *Term) String() string { return proto.CompactTextString(m) }
+func (*Term) ProtoMessage()    {}
+func (*Term) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2cd52efb056fd98, []int{1}
+}
+func (m *Term) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Term) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Term.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Term) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Term.Merge(m, src)
+}
+func (m *Term) XXX_Size() int {
+	return m.Size()
+}
+func (m *Term) XXX_DiscardUnknown() {
+	xxx_messageInfo_Term.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Term proto.InternalMessageInfo
+
+func (m *Term) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Term) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Policy)(nil), "insurance.policy.Policy")
+	proto.RegisterType((*Term)(nil), "insurance.policy.Term")
+}
+
+func init() { proto.RegisterFile("insurance/policy/policy.proto", fileDescriptor_d2cd52efb056fd98) }
+
+var fileDescriptor_d2cd52efb056fd98 = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0x63, 0xf2, 0xe9, 0x4d, 0x81, 0xb2, 0x2a, 0xd5, 0xb6, 0xa8, 0x4e, 0xd4, 0x53, 0x84,
+	0x84, 0xdd, 0x86, 0x3b, 0x52, 0x03, 0x12, 0x0a, 0x2a, 0x52, 0x15, 0x55, 0x1c, 0xb8, 0x58, 0x1b,
+	0xef, 0x28, 0x5d, 0xc5, 0xf6, 0x5a, 0xbb, 0x9b, 0x88, 0xbc, 0x05, 0x27, 0x9e, 0x81, 0x23, 0x07,
+	0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0xe2, 0x50, 0xa1, 0xe4, 0xc0, 0x6b, 0xa0, 0xfd, 0x48, 0x91, 0xb8,
+	0xc1, 0xc5, 0xde, 0xf9, 0xff, 0x76, 0xff, 0x3b, 0x1e, 0xcf, 0xa0, 0x23, 0x5e, 0xaa, 0x85, 0xa4,
+	0x65, 0x06, 0x49, 0x25, 0x72, 0x9e, 0xad, 0xfc, 0x2b, 0xae, 0xa4, 0xd0, 0x02, 0xef, 0xde, 0xe1,
+	0xd8, 0xe9, 0x87, 0x8f, 0x68, 0xc1, 0x4b, 0x91, 0xd8, 0xa7, 0xdb, 0x74, 0x18, 0x65, 0x42, 0x15,
+	0x42, 0x25, 0x53, 0xaa, 0x20, 0x59, 0x9e, 0x4e, 0x41, 0xd3, 0xd3, 0x24, 0x13, 0xbc, 0xf4, 0xfc,
+	0xc0, 0xf1, 0xd4, 0x46, 0x89, 0x0b, 0x3c, 0xda, 0x9b, 0x89, 0x99, 0x70, 0xba, 0x59, 0x39, 0xf5,
+	0xf8, 0x53, 0x13, 0xb5, 0x2e, 0xec, 0x75, 0xf8, 0x09, 0x0a, 0xdd, 0xc5, 0x29, 0x67, 0x24, 0xe8,
+	0x07, 0x83, 0x70, 0xd2, 0x71, 0xc2, 0x98, 0xe1, 0x7d, 0xd4, 0xba, 0x12, 0x39, 0x03, 0x49, 0xee,
+	0x59, 0xe2, 0x23, 0xdc, 0x43, 0x5d, 0x7f, 0x48, 0xaf, 0x2a, 0x20, 0x75, 0x0b, 0x91, 0x93, 0x2e,
+	0x57, 0x15, 0xe0, 0x73, 0xd4, 0xc9, 0xc4, 0x12, 0x24, 0x9d, 0x01, 0x69, 0x18, 0x3a, 0x3a, 0xb9,
+	0xbe, 0xed, 0xd5, 0x7e, 0xdc, 0xf6, 0x1e, 0xbb, 0xf4, 0x14, 0x9b, 0xc7, 0x5c, 0x24, 0x05, 0xd5,
+	0x57, 0xf1, 0xb8, 0xd4, 0xdf, 0xbe, 0x3e, 0x43, 0x3e, 0xef, 0x71, 0xa9, 0x3f, 0xff, 0xfa, 0xf2,
+	0x34, 0x98, 0xdc, 0x39, 0xe0, 0x17, 0xa8, 0x5d, 0x49, 0x28, 0xf8, 0xa2, 0x20, 0xcd, 0x7e, 0x30,
+	0xe8, 0x0e, 0x0f, 0x62, 0xbf, 0xd9, 0x54, 0x24, 0xf6, 0x15, 0x89, 0x5f, 0x0a, 0x5e, 0x8e, 0x42,
+	0x73, 0x8f, 0x33, 0xd8, 0x1e, 0xc2, 0xaf, 0xd1, 0x8e, 0x5f, 0xa6, 0x15, 0xe5, 0x8c, 0xb4, 0xfe,
+	0xc1, 0xa4, 0xeb, 0x4f, 0x5e, 0x50, 0x6e, 0xeb, 0xa1, 0x34, 0xd5, 0x0b, 0x45, 0xda, 0xae, 0x1e,
+	0x2e, 0xc2, 0x47, 0x08, 0x29, 0x4d, 0xa5, 0x4e, 0x19, 0xd5, 0x40, 0x3a, 0xfd, 0x60, 0x50, 0x9f,
+	0x84, 0x56, 0x79, 0x45, 0x35, 0xe0, 0x03, 0xd4, 0x81, 0x92, 0x39, 0x18, 0x5a, 0xd8, 0x86, 0x92,
+	0x59, 0x74, 0x84, 0x90, 0xe4, 0x6a, 0x9e, 0xe6, 0xb0, 0x84, 0x9c, 0x20, 0xeb, 0x1a, 0x1a, 0xe5,
+	0xdc, 0x08, 0x78, 0x88, 0x9a, 0x1a, 0x64, 0xa1, 0x48, 0xb7, 0x5f, 0x1f, 0x74, 0x87, 0xfb, 0xf1,
+	0xdf, 0xed, 0x12, 0x5f, 0x82, 0x2c, 0x46, 0x0d, 0x93, 0xef, 0xc4, 0x6d, 0x35, 0x96, 0x99, 0x04,
+	0xaa, 0x81, 0xa5, 0x54, 0x93, 0x1d, 0x97, 0x8c, 0x57, 0xce, 0xb4, 0xc1, 0x8b, 0x8a, 0x6d, 0xf1,
+	0x7d, 0x87, 0xbd, 0x72, 0xa6, 0xf1, 0x1b, 0xd4, 0xce, 0x72, 0xca, 0x0b, 0x60, 0xe4, 0xc1, 0x7f,
+	0xfe, 0xb8, 0xad, 0x01, 0x3e, 0x41, 0x7b, 0x92, 0x6a, 0x5e, 0xce, 0xd2, 0x42, 0x30, 0xc8, 0xd3,
+	0x25, 0x48, 0xc5, 0x45, 0x49, 0x1e, 0xf6, 0x83, 0x41, 0x63, 0x82, 0x1d, 0x7b, 0x6b, 0xd0, 0x3b,
+	0x47, 0x8e, 0x63, 0xd4, 0x30, 0x1f, 0x84, 0x77, 0x51, 0x7d, 0x0e, 0x2b, 0xdf, 0x8f, 0x66, 0x89,
+	0xf7, 0x50, 0x73, 0x49, 0xf3, 0x05, 0xf8, 0x4e, 0x74, 0xc1, 0x68, 0x78, 0xbd, 0x8e, 0x82, 0x9b,
+	0x75, 0x14, 0xfc, 0x5c, 0x47, 0xc1, 0xc7, 0x4d, 0x54, 0xbb, 0xd9, 0x44, 0xb5, 0xef, 0x9b, 0xa8,
+	0xf6, 0x9e, 0xfc, 0x99, 0xbb, 0x0f, 0xdb, 0xc9, 0x33, 0xcd, 0xaa, 0xa6, 0x2d, 0x3b, 0x03, 0xcf,
+	0x7f, 0x0f, 0x00, 0x0c, 0x75, 0xb7, 0xd6, 0x9a, 0x03, 0x00, 0x00,
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RatingModelVersion != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.RatingModelVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.UpdatedAt != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Terms) > 0 {
+		for iNdEx := len(m.Terms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Terms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RiskLevel) > 0 {
+		i -= len(m.RiskLevel)
+		copy(dAtA[i:], m.RiskLevel)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.RiskLevel)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EndDate != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.EndDate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartDate != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.StartDate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.PremiumPaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Premium.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Coverage.Size()
+		i -= size
+		if _, err := m.Coverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PolicyType) > 0 {
+		i -= len(m.PolicyType)
+		copy(dAtA[i:], m.PolicyType)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.PolicyType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Term) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Term) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Policy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.PolicyType)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = m.Coverage.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	l = m.Premium.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	l = m.PremiumPaid.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.StartDate != 0 {
+		n += 1 + sovPolicy(uint64(m.StartDate))
+	}
+	if m.EndDate != 0 {
+		n += 1 + sovPolicy(uint64(m.EndDate))
+	}
+	l = len(m.RiskLevel)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPolicy(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovPolicy(uint64(m.UpdatedAt))
+	}
+	l = m.Claimed.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	if m.RatingModelVersion != 0 {
+		n += 1 + sovPolicy(uint64(m.RatingModelVersion))
+	}
+	return n
+}
+
+func (m *Term) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func sovPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPolicy(x uint64) (n int) {
+	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Premium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			m.StartDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			m.EndDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, Term{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingModelVersion", wireType)
+			}
+			m.RatingModelVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatingModelVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Term) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Term: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Term: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPolicy = fmt.Errorf("proto: unexpected end of group")
+)
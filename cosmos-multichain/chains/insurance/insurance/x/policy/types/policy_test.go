@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"insurance/x/policy/types"
+)
+
+func TestPolicyRefund(t *testing.T) {
+	policy := types.Policy{
+		Premium:     sdk.NewInt64Coin("stake", 1_000),
+		PremiumPaid: sdk.NewInt64Coin("stake", 0),
+		StartDate:   100,
+		EndDate:     200,
+	}
+	require.True(t, policy.Refund(50).IsZero())
+
+	policy.PremiumPaid = policy.Premium
+	for _, tc := range []struct {
+		now    int64
+		refund int64
+	}{
+		{now: 50, refund: 1_000},
+		{now: 100, refund: 1_000},
+		{now: 125, refund: 750},
+		{now: 199, refund: 10},
+		{now: 200, refund: 0},
+		{now: 300, refund: 0},
+	} {
+		require.Equal(t, sdk.NewInt64Coin("stake", tc.refund), policy.Refund(tc.now), "at %d", tc.now)
+	}
+
+	// The coverage claimed on the policy is kept from the refund
+	policy.Claimed = sdkmath.NewInt(300)
+	require.Equal(t, sdk.NewInt64Coin("stake", 700), policy.Refund(50))
+	require.Equal(t, sdk.NewInt64Coin("stake", 450), policy.Refund(125))
+	require.True(t, policy.Refund(199).IsZero())
+}
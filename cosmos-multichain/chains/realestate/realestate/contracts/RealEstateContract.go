@@ -2,150 +2,143 @@ package contracts
 
 import (
 	"encoding/json"
-	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/realestate/contracts/interfaces"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"realestate/contracts/interfaces"
 )
 
 // PropertyData represents the core property information
 type PropertyData struct {
-	PropertyID    string  `json:"property_id"`
-	Address       string  `json:"address"`
-	OwnerAddress  string  `json:"owner_address"`
-	Price         sdk.Int `json:"price"`
-	Size          float64 `json:"size"`
-	PropertyType  string  `json:"property_type"`
-	Status        string  `json:"status"` // Available, Sold, Under Contract
-	DocumentHash  string  `json:"document_hash"`
-	LastModified  int64   `json:"last_modified"`
+	PropertyID   string      `json:"property_id"`
+	Address      string      `json:"address"`
+	OwnerAddress string      `json:"owner_address"`
+	Price        sdkmath.Int `json:"price"`
+	Size         float64     `json:"size"`
+	PropertyType string      `json:"property_type"`
+	Status       string      `json:"status"` // Available, Sold, Under Contract
+	DocumentHash string      `json:"document_hash"`
+	LastModified int64       `json:"last_modified"`
 }
 
 // RealEstateContract implements the IInterchainContract interface
 type RealEstateContract struct {
-	keeper interfaces.IDataValidator
+	keeper   interfaces.IDataValidator
+	registry interfaces.IPropertyRegistry
+	router   *interchain.Router
 }
 
-func NewRealEstateContract(keeper interfaces.IDataValidator) *RealEstateContract {
-	return &RealEstateContract{
-		keeper: keeper,
+func NewRealEstateContract(keeper interfaces.IDataValidator, registry interfaces.IPropertyRegistry) *RealEstateContract {
+	c := &RealEstateContract{
+		keeper:   keeper,
+		registry: registry,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleFinanceChainMessage,
+			Prepare:  c.prepareFinanceMessage,
+			Callback: c.handleFinanceCallback,
+		}).
+		AddRoute(interchain.ChainGovernment, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleGovernmentChainMessage,
+			Prepare:  c.prepareGovernmentMessage,
+			Callback: c.handleGovernmentCallback,
+		}).
+		AddRoute(interchain.ChainInsurance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleInsuranceChainMessage,
+			Prepare:  c.prepareInsuranceMessage,
+			Callback: c.handleInsuranceCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IInterchainContract
 func (c *RealEstateContract) ValidateInterchainData(ctx sdk.Context, data []byte) error {
 	var property PropertyData
 	if err := json.Unmarshal(data, &property); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid property data format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid property data format")
 	}
 
 	// Validate required fields
 	if property.PropertyID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "property ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "property ID is required")
 	}
 	if property.Address == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "address is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "address is required")
 	}
 	if property.OwnerAddress == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "owner address is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "owner address is required")
 	}
 	if property.Price.IsNil() || property.Price.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid price")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid price")
 	}
 
 	return nil
 }
 
 // ProcessInterchainMessage implements IInterchainContract
-func (c *RealEstateContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
+func (c *RealEstateContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
 	// Validate the incoming message
 	if err := c.ValidateInterchainData(ctx, message); err != nil {
 		return err
 	}
 
-	var property PropertyData
-	if err := json.Unmarshal(message, &property); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to unmarshal property data")
-	}
-
-	// Process based on source chain
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceChainMessage(ctx, property)
-	case "government":
-		return c.handleGovernmentChainMessage(ctx, property)
-	case "insurance":
-		return c.handleInsuranceChainMessage(ctx, property)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IInterchainContract
-func (c *RealEstateContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
+func (c *RealEstateContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
 	var property PropertyData
 	if err := json.Unmarshal(data, &property); err != nil {
-		return nil, errors.Wrap(errors.ErrInvalidRequest, "failed to unmarshal property data")
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to unmarshal property data")
 	}
-
-	// Add chain-specific data based on target chain
-	switch targetChain {
-	case "finance":
-		return c.prepareFinanceMessage(property)
-	case "government":
-		return c.prepareGovernmentMessage(property)
-	case "insurance":
-		return c.prepareInsuranceMessage(property)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
+	bz, err := json.Marshal(property)
+	if err != nil {
+		return nil, err
 	}
+
+	return c.router.Prepare(ctx, targetChain, messageType, bz)
 }
 
 // HandleCallback implements IInterchainContract
-func (c *RealEstateContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
+func (c *RealEstateContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
 	// Process callback responses from other chains
-	switch sourceChain {
-	case "finance":
-		return c.handleFinanceCallback(ctx, response)
-	case "government":
-		return c.handleGovernmentCallback(ctx, response)
-	case "insurance":
-		return c.handleInsuranceCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // Internal handlers for chain-specific messages
-func (c *RealEstateContract) handleFinanceChainMessage(ctx sdk.Context, property PropertyData) error {
+func (c *RealEstateContract) handleFinanceChainMessage(ctx sdk.Context, message []byte) error {
 	// Handle property financing updates
 	return nil
 }
 
-func (c *RealEstateContract) handleGovernmentChainMessage(ctx sdk.Context, property PropertyData) error {
+func (c *RealEstateContract) handleGovernmentChainMessage(ctx sdk.Context, message []byte) error {
 	// Handle property registration and regulatory updates
 	return nil
 }
 
-func (c *RealEstateContract) handleInsuranceChainMessage(ctx sdk.Context, property PropertyData) error {
+func (c *RealEstateContract) handleInsuranceChainMessage(ctx sdk.Context, message []byte) error {
 	// Handle property insurance updates
 	return nil
 }
 
 // Internal message preparation for different chains
-func (c *RealEstateContract) prepareFinanceMessage(property PropertyData) ([]byte, error) {
+func (c *RealEstateContract) prepareFinanceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for finance chain (mortgage, payments)
-	return json.Marshal(property)
+	return data, nil
 }
 
-func (c *RealEstateContract) prepareGovernmentMessage(property PropertyData) ([]byte, error) {
+func (c *RealEstateContract) prepareGovernmentMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for government chain (registration, taxes)
-	return json.Marshal(property)
+	return data, nil
 }
 
-func (c *RealEstateContract) prepareInsuranceMessage(property PropertyData) ([]byte, error) {
+func (c *RealEstateContract) prepareInsuranceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for insurance chain (property insurance)
-	return json.Marshal(property)
+	return data, nil
 }
 
 // Internal callback handlers
@@ -0,0 +1,57 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+// MessageTypeClaimCorroboration is the interchain message type of the
+// queries of the insurance chain for the property records corroborating a
+// claim
+const MessageTypeClaimCorroboration = "claim_corroboration"
+
+// CorroborationQuery is the query of the insurance chain for the property
+// records corroborating a claim filed by an owner
+type CorroborationQuery struct {
+	ClaimID   string    `json:"claim_id"`
+	Subject   string    `json:"subject"` // Claimant, the owner of the property
+	ClaimType string    `json:"claim_type"`
+	Evidence  []string  `json:"evidence"` // Hashes of the claim evidence
+	FiledAt   time.Time `json:"filed_at"`
+}
+
+// CorroborationResult is the answer to a corroboration query
+type CorroborationResult struct {
+	ClaimID string   `json:"claim_id"`
+	Records []string `json:"records"` // IDs of the corroborating transactions, none when the claim is not corroborated
+}
+
+// CorroborateClaim answers a corroboration query of the insurance chain with
+// the property transactions of the owner, with a document of one of the
+// hashes of the claim evidence if it has any
+func (c *RealEstateContract) CorroborateClaim(ctx sdk.Context, data []byte) ([]byte, error) {
+	var query CorroborationQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "invalid corroboration query format")
+	}
+	if query.ClaimID == "" || query.Subject == "" {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "claim ID and subject are required")
+	}
+
+	result := CorroborationResult{
+		ClaimID: query.ClaimID,
+		Records: c.registry.FindTransactions(ctx, query.Subject, query.Evidence),
+	}
+	if result.Records == nil {
+		result.Records = []string{}
+	}
+	response, err := json.Marshal(result)
+	if err != nil {
+		return nil, errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal corroboration result")
+	}
+	return response, nil
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+// IDataValidator defines the interface for data validation
+type IDataValidator interface {
+	// ValidateData validates the data structure and content
+	ValidateData(data []byte) error
+
+	// ValidateSignature validates the signature of the data
+	ValidateSignature(data []byte, signature []byte, pubKey []byte) error
+}
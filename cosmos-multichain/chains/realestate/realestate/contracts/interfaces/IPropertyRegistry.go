@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IPropertyRegistry defines the interface for looking up the property
+// transactions recorded on the chain
+type IPropertyRegistry interface {
+	// FindTransactions returns the IDs of the property transactions a party
+	// sends or receives a property in. With document hashes, only the
+	// transactions with a document of one of them are returned.
+	FindTransactions(ctx sdk.Context, party string, documentHashes []string) []string
+}
@@ -2,9 +2,13 @@ package transactions
 
 import (
 	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/realestate/contracts"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"realestate/contracts"
 )
 
 // TransactionType defines the type of property transaction
@@ -23,7 +27,7 @@ type PropertyTransaction struct {
 	TransactionType TransactionType `json:"transaction_type"`
 	FromAddress     string          `json:"from_address"`
 	ToAddress       string          `json:"to_address"`
-	Amount          sdk.Int         `json:"amount"`
+	Amount          sdkmath.Int     `json:"amount"`
 	Timestamp       int64           `json:"timestamp"`
 	Status          string          `json:"status"`
 	Documents       []Document      `json:"documents"`
@@ -31,12 +35,12 @@ type PropertyTransaction struct {
 
 // Document represents legal documents associated with the transaction
 type Document struct {
-	DocType    string `json:"doc_type"`
-	Hash       string `json:"hash"`
-	IPFSLink   string `json:"ipfs_link"`
-	Timestamp  int64  `json:"timestamp"`
-	SignedBy   string `json:"signed_by"`
-	Signature  string `json:"signature"`
+	DocType   string `json:"doc_type"`
+	Hash      string `json:"hash"`
+	IPFSLink  string `json:"ipfs_link"`
+	Timestamp int64  `json:"timestamp"`
+	SignedBy  string `json:"signed_by"`
+	Signature string `json:"signature"`
 }
 
 // PropertyTransactionHandler handles property transactions
@@ -68,16 +72,16 @@ func (h *PropertyTransactionHandler) InitiateTransaction(ctx sdk.Context, tx Pro
 // ValidateTransaction validates the transaction data
 func (h *PropertyTransactionHandler) validateTransaction(tx PropertyTransaction) error {
 	if tx.TransactionID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "transaction ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
 	}
 	if tx.PropertyID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "property ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "property ID is required")
 	}
 	if tx.FromAddress == "" || tx.ToAddress == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "from and to addresses are required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "from and to addresses are required")
 	}
 	if tx.Amount.IsNil() || tx.Amount.IsNegative() {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid transaction amount")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid transaction amount")
 	}
 
 	return nil
@@ -88,21 +92,21 @@ func (h *PropertyTransactionHandler) notifyRelevantChains(ctx sdk.Context, tx Pr
 	// Prepare transaction data
 	txData, err := json.Marshal(tx)
 	if err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "failed to marshal transaction data")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal transaction data")
 	}
 
 	// Notify finance chain for payment processing
-	if _, err := h.contract.PrepareInterchainMessage(ctx, "finance", txData); err != nil {
+	if _, err := h.contract.PrepareInterchainMessage(ctx, interchain.ChainFinance, string(tx.TransactionType), txData); err != nil {
 		return err
 	}
 
 	// Notify government chain for registration
-	if _, err := h.contract.PrepareInterchainMessage(ctx, "government", txData); err != nil {
+	if _, err := h.contract.PrepareInterchainMessage(ctx, interchain.ChainGovernment, string(tx.TransactionType), txData); err != nil {
 		return err
 	}
 
 	// Notify insurance chain for policy updates
-	if _, err := h.contract.PrepareInterchainMessage(ctx, "insurance", txData); err != nil {
+	if _, err := h.contract.PrepareInterchainMessage(ctx, interchain.ChainInsurance, string(tx.TransactionType), txData); err != nil {
 		return err
 	}
 
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"encoding/json"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"realestate/contracts"
+	"realestate/contracts/transactions"
+)
+
+// newContract builds the real estate contract on top of the module state
+func newContract(k Keeper) *contracts.RealEstateContract {
+	return contracts.NewRealEstateContract(propertyValidator{}, propertyRegistry{k: k})
+}
+
+// propertyValidator checks property data and the signatures of its documents
+type propertyValidator struct{}
+
+func (propertyValidator) ValidateData(data []byte) error {
+	var property contracts.PropertyData
+	if err := json.Unmarshal(data, &property); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid property data format")
+	}
+	if property.PropertyID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "property ID is required")
+	}
+	return nil
+}
+
+func (propertyValidator) ValidateSignature(data []byte, signature []byte, pubKey []byte) error {
+	if len(pubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid secp256k1 public key")
+	}
+	key := &secp256k1.PubKey{Key: pubKey}
+	if !key.VerifySignature(data, signature) {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid signature")
+	}
+	return nil
+}
+
+// propertyRegistry looks up the property transactions in the module state
+type propertyRegistry struct {
+	k Keeper
+}
+
+func (r propertyRegistry) FindTransactions(ctx sdk.Context, party string, documentHashes []string) (ids []string) {
+	for _, transaction := range r.k.GetAllTransaction(ctx) {
+		var tx transactions.PropertyTransaction
+		if err := json.Unmarshal(transaction.Data, &tx); err != nil {
+			continue
+		}
+		if tx.FromAddress != party && tx.ToAddress != party {
+			continue
+		}
+		if len(documentHashes) > 0 && !slices.ContainsFunc(tx.Documents, func(document transactions.Document) bool {
+			return slices.Contains(documentHashes, document.Hash)
+		}) {
+			continue
+		}
+		ids = append(ids, tx.TransactionID)
+	}
+	return ids
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"realestate/contracts"
+)
+
+var _ interchain.PacketHandler = Keeper{}
+
+// counterpartyChain resolves a counterparty chain ID from the params
+func (k Keeper) counterpartyChain(ctx sdk.Context, chainId string) (string, bool) {
+	return k.GetParams(ctx).CounterpartyChain(chainId)
+}
+
+// OnRecvMessage processes a message of a counterparty chain with the real
+// estate contract. The corroboration queries of the insurance chain are
+// answered with the transactions corroborating their claim.
+func (k Keeper) OnRecvMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) ([]byte, error) {
+	if sourceChain == interchain.ChainInsurance && messageType == contracts.MessageTypeClaimCorroboration {
+		return k.contract.CorroborateClaim(ctx, message)
+	}
+	return k.ContractHandler.OnRecvMessage(ctx, sourceChain, messageType, message)
+}
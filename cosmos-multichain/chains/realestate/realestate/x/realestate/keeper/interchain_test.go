@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"realestate/contracts"
+	"realestate/contracts/transactions"
+	keepertest "realestate/testutil/keeper"
+	"realestate/testutil/sample"
+	"realestate/x/realestate/keeper"
+	"realestate/x/realestate/types"
+)
+
+func TestClaimCorroboration(t *testing.T) {
+	k, ctx := keepertest.RealestateKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	owner := sample.AccAddress()
+	data, err := json.Marshal(transactions.PropertyTransaction{
+		TransactionID:   "tx-1",
+		PropertyID:      "property-1",
+		TransactionType: transactions.Transfer,
+		FromAddress:     owner,
+		ToAddress:       "heir",
+		Amount:          sdkmath.ZeroInt(),
+		Documents:       []transactions.Document{{DocType: "deed", Hash: "deed-1"}},
+	})
+	require.NoError(t, err)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(owner, data))
+	require.NoError(t, err)
+	corroborate := func(subject string, evidence ...string) ([]string, error) {
+		query, err := json.Marshal(contracts.CorroborationQuery{ClaimID: "claim-1", Subject: subject, Evidence: evidence})
+		require.NoError(t, err)
+		response, err := k.OnRecvMessage(ctx, interchain.ChainInsurance, contracts.MessageTypeClaimCorroboration, query)
+		if err != nil {
+			return nil, err
+		}
+		var result contracts.CorroborationResult
+		require.NoError(t, json.Unmarshal(response, &result))
+		require.Equal(t, "claim-1", result.ClaimID)
+		return result.Records, nil
+	}
+
+	// Claims are corroborated by the transactions of their claimant matching
+	// their evidence
+	records, err := corroborate(owner)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-1"}, records)
+	records, err = corroborate("heir", "deed-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-1"}, records)
+	records, err = corroborate(owner, "deed-2")
+	require.NoError(t, err)
+	require.Empty(t, records)
+	records, err = corroborate(sample.AccAddress())
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	_, err = corroborate("")
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+}
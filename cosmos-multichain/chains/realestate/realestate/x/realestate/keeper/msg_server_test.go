@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"realestate/contracts/transactions"
+	keepertest "realestate/testutil/keeper"
+	"realestate/testutil/sample"
+	"realestate/x/realestate/keeper"
+	"realestate/x/realestate/types"
+)
+
+func propertyData(t *testing.T, id, from string) []byte {
+	data, err := json.Marshal(transactions.PropertyTransaction{
+		TransactionID:   id,
+		PropertyID:      "property-1",
+		TransactionType: transactions.Sale,
+		FromAddress:     from,
+		ToAddress:       "buyer",
+		Amount:          sdkmath.NewInt(250_000),
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func TestPropertyTransactionLifecycle(t *testing.T) {
+	k, ctx := keepertest.RealestateKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+
+	// Only the owner sells the property
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, propertyData(t, "tx-1", sample.AccAddress())))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, found := k.GetTransaction(ctx, "tx-1")
+	require.False(t, found)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, propertyData(t, "tx-1", creator)))
+	require.NoError(t, err)
+	tx, found := k.GetTransaction(ctx, "tx-1")
+	require.True(t, found)
+	require.Equal(t, string(transactions.Sale), tx.TransactionType)
+
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, propertyData(t, "", creator)))
+	require.ErrorIs(t, err, interchain.ErrInvalidData)
+
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(creator, "tx-1", "UNDER_CONTRACT"))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.NoError(t, err)
+
+	tx, _ = k.GetTransaction(ctx, "tx-1")
+	require.True(t, tx.Finalized)
+	_, err = srv.UpdateTransactionStatus(ctx, types.NewMsgUpdateTransactionStatus(creator, "tx-1", "SOLD"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"realestate/x/realestate/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,9 @@
+package types
+
+import "github.com/example/cosmos-multichain/interchain"
+
+// NewPacketRecord builds the contract record keeping an interchain packet
+// waiting to be sent
+func NewPacketRecord(id string, data []byte, updatedAt int64) ContractRecord {
+	return ContractRecord{Kind: interchain.RecordKindPacket, RecordId: id, Data: data, UpdatedAt: updatedAt}
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/realestate module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTransaction   = sdkerrors.Register(ModuleName, 1101, "invalid transaction")
+	ErrTransactionExists    = sdkerrors.Register(ModuleName, 1102, "transaction already exists")
+	ErrTransactionNotFound  = sdkerrors.Register(ModuleName, 1103, "transaction not found")
+	ErrTransactionFinalized = sdkerrors.Register(ModuleName, 1104, "transaction is finalized")
+	ErrRecordNotFound       = sdkerrors.Register(ModuleName, 1105, "contract record not found")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+)
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		TransactionList:    []Transaction{},
+		ContractRecordList: []ContractRecord{},
+		AuditEntryList:     []AuditEntry{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in transaction
+	transactionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TransactionList {
+		index := string(TransactionKey(elem.TransactionId))
+		if _, ok := transactionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transaction")
+		}
+		transactionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in contractRecord
+	contractRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ContractRecordList {
+		index := string(ContractRecordKey(elem.Kind, elem.RecordId))
+		if _, ok := contractRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for contractRecord")
+		}
+		contractRecordIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in auditEntry
+	auditEntryIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AuditEntryList {
+		if _, ok := auditEntryIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auditEntry")
+		}
+		if elem.Id >= gs.AuditEntryCount {
+			return fmt.Errorf("auditEntry id should be lower or equal than the last id")
+		}
+		auditEntryIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
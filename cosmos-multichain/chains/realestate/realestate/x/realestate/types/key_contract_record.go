@@ -0,0 +1,28 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ContractRecordKeyPrefix is the prefix to retrieve all ContractRecord
+	ContractRecordKeyPrefix = "ContractRecord/value/"
+)
+
+// ContractRecordKey returns the store key to retrieve a ContractRecord from the index fields
+func ContractRecordKey(
+	kind string,
+	recordId string,
+) []byte {
+	var key []byte
+
+	kindBytes := []byte(kind)
+	key = append(key, kindBytes...)
+	key = append(key, []byte("/")...)
+
+	recordIdBytes := []byte(recordId)
+	key = append(key, recordIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
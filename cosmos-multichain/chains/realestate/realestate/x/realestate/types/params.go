@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyCounterparties = []byte("Counterparties")
+	// DefaultCounterparties are the chains of the network the real estate
+	// contract exchanges messages with
+	DefaultCounterparties = []Counterparty{
+		{ChainId: "bloqz-insurance-1", Chain: interchain.ChainInsurance},
+	}
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(counterparties []Counterparty) Params {
+	return Params{
+		Counterparties: counterparties,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultCounterparties)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCounterparties, &p.Counterparties, validateCounterparties),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateCounterparties(p.Counterparties)
+}
+
+// CounterpartyChain returns the chain of a counterparty chain ID
+func (p Params) CounterpartyChain(chainId string) (string, bool) {
+	for _, counterparty := range p.Counterparties {
+		if counterparty.ChainId == chainId {
+			return counterparty.Chain, true
+		}
+	}
+	return "", false
+}
+
+// validateCounterparties validates the Counterparties param
+func validateCounterparties(v interface{}) error {
+	counterparties, ok := v.([]Counterparty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	chainIds := make(map[string]struct{}, len(counterparties))
+	for _, counterparty := range counterparties {
+		if counterparty.ChainId == "" || counterparty.Chain == "" {
+			return fmt.Errorf("counterparty needs a chain id and a chain")
+		}
+		if _, ok := chainIds[counterparty.ChainId]; ok {
+			return fmt.Errorf("duplicated counterparty %s", counterparty.ChainId)
+		}
+		chainIds[counterparty.ChainId] = struct{}{}
+	}
+	return nil
+}
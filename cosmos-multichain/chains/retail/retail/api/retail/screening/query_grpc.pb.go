@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.0
+// source: retail/screening/query.proto
+
+package screening
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName            = "/retail.screening.Query/Params"
+	Query_ScreeningEntry_FullMethodName    = "/retail.screening.Query/ScreeningEntry"
+	Query_ScreeningEntryAll_FullMethodName = "/retail.screening.Query/ScreeningEntryAll"
+	Query_Screened_FullMethodName          = "/retail.screening.Query/Screened"
+	Query_ScreeningHistory_FullMethodName  = "/retail.screening.Query/ScreeningHistory"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ScreeningEntry items.
+	ScreeningEntry(ctx context.Context, in *QueryGetScreeningEntryRequest, opts ...grpc.CallOption) (*QueryGetScreeningEntryResponse, error)
+	ScreeningEntryAll(ctx context.Context, in *QueryAllScreeningEntryRequest, opts ...grpc.CallOption) (*QueryAllScreeningEntryResponse, error)
+	// Screened queries whether a party is listed by its address or by the
+	// hash of its external identifier.
+	Screened(ctx context.Context, in *QueryScreenedRequest, opts ...grpc.CallOption) (*QueryScreenedResponse, error)
+	// ScreeningHistory queries the listings, delistings and blocked attempts.
+	ScreeningHistory(ctx context.Context, in *QueryScreeningHistoryRequest, opts ...grpc.CallOption) (*QueryScreeningHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningEntry(ctx context.Context, in *QueryGetScreeningEntryRequest, opts ...grpc.CallOption) (*QueryGetScreeningEntryResponse, error) {
+	out := new(QueryGetScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, Query_ScreeningEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningEntryAll(ctx context.Context, in *QueryAllScreeningEntryRequest, opts ...grpc.CallOption) (*QueryAllScreeningEntryResponse, error) {
+	out := new(QueryAllScreeningEntryResponse)
+	err := c.cc.Invoke(ctx, Query_ScreeningEntryAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Screened(ctx context.Context, in *QueryScreenedRequest, opts ...grpc.CallOption) (*QueryScreenedResponse, error) {
+	out := new(QueryScreenedResponse)
+	err := c.cc.Invoke(ctx, Query_Screened_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScreeningHistory(ctx context.Context, in *QueryScreeningHistoryRequest, opts ...grpc.CallOption) (*QueryScreeningHistoryResponse, error) {
+	out := new(QueryScreeningHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ScreeningHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ScreeningEntry items.
+	ScreeningEntry(context.Context, *QueryGetScreeningEntryRequest) (*QueryGetScreeningEntryResponse, error)
+	ScreeningEntryAll(context.Context, *QueryAllScreeningEntryRequest) (*QueryAllScreeningEntryResponse, error)
+	// Screened queries whether a party is listed by its address or by the
+	// hash of its external identifier.
+	Screened(context.Context, *QueryScreenedRequest) (*QueryScreenedResponse, error)
+	// ScreeningHistory queries the listings, delistings and blocked attempts.
+	ScreeningHistory(context.Context, *QueryScreeningHistoryRequest) (*QueryScreeningHistoryResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ScreeningEntry(context.Context, *QueryGetScreeningEntryRequest) (*QueryGetScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningEntry not implemented")
+}
+func (UnimplementedQueryServer) ScreeningEntryAll(context.Context, *QueryAllScreeningEntryRequest) (*QueryAllScreeningEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningEntryAll not implemented")
+}
+func (UnimplementedQueryServer) Screened(context.Context, *QueryScreenedRequest) (*QueryScreenedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Screened not implemented")
+}
+func (UnimplementedQueryServer) ScreeningHistory(context.Context, *QueryScreeningHistoryRequest) (*QueryScreeningHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreeningHistory not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetScreeningEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScreeningEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningEntry(ctx, req.(*QueryGetScreeningEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningEntryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllScreeningEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningEntryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScreeningEntryAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningEntryAll(ctx, req.(*QueryAllScreeningEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Screened_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreenedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Screened(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Screened_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Screened(ctx, req.(*QueryScreenedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScreeningHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScreeningHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScreeningHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScreeningHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScreeningHistory(ctx, req.(*QueryScreeningHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "retail.screening.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ScreeningEntry",
+			Handler:    _Query_ScreeningEntry_Handler,
+		},
+		{
+			MethodName: "ScreeningEntryAll",
+			Handler:    _Query_ScreeningEntryAll_Handler,
+		},
+		{
+			MethodName: "Screened",
+			Handler:    _Query_Screened_Handler,
+		},
+		{
+			MethodName: "ScreeningHistory",
+			Handler:    _Query_ScreeningHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "retail/screening/query.proto",
+}
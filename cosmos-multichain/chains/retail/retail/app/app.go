@@ -75,6 +75,8 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
+	retailmodulekeeper "retail/x/retail/keeper"
+	screeningmodulekeeper "retail/x/screening/keeper"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"retail/docs"
@@ -140,6 +142,8 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
+	RetailKeeper    retailmodulekeeper.Keeper
+	ScreeningKeeper screeningmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -243,6 +247,8 @@ func New(
 		&app.NFTKeeper,
 		&app.GroupKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.RetailKeeper,
+		&app.ScreeningKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -255,6 +261,12 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// set the ante handler screening the parties of the transactions, the
+	// default one being skipped in the app config
+	if err := app.setAnteHandler(app.ScreeningKeeper.Screener()); err != nil {
+		return nil, err
+	}
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		return nil, err
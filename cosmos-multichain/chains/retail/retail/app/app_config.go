@@ -52,6 +52,12 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"google.golang.org/protobuf/types/known/durationpb"
+	retailmodulev1 "retail/api/retail/retail/module"
+	screeningmodulev1 "retail/api/retail/screening/module"
+	_ "retail/x/retail/module" // import for side-effects
+	retailmoduletypes "retail/x/retail/types"
+	_ "retail/x/screening/module" // import for side-effects
+	screeningmoduletypes "retail/x/screening/types"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
@@ -89,6 +95,8 @@ var (
 		consensustypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		retailmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -113,6 +121,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		retailmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -131,6 +141,8 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		retailmoduletypes.ModuleName,
+		screeningmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -232,8 +244,11 @@ var (
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
 			{
-				Name:   "tx",
-				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+				Name: "tx",
+				Config: appconfig.WrapAny(&txconfigv1.Config{
+					// the ante handler screening the transactions is set in app.go
+					SkipAnteHandler: true,
+				}),
 			},
 			{
 				Name:   genutiltypes.ModuleName,
@@ -286,6 +301,14 @@ var (
 				Name:   circuittypes.ModuleName,
 				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
 			},
+			{
+				Name:   retailmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&retailmodulev1.Module{}),
+			},
+			{
+				Name:   screeningmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&screeningmodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
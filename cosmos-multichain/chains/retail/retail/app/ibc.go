@@ -35,6 +35,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/example/cosmos-multichain/screening"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -145,7 +146,10 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
 	// Create IBC modules with ibcfee middleware
+	// Inbound transfers from or to screened parties are rejected under the
+	// ibcfee middleware, which wraps their error acknowledgement
 	var transferModule porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
+	transferModule = screening.NewIBCMiddleware(transferModule, app.ScreeningKeeper.Screener())
 	transferModule = ibcfee.NewIBCMiddleware(transferModule, app.IBCFeeKeeper)
 
 	// integration point for custom authentication modules
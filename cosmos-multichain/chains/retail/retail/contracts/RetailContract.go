@@ -1,1288 +1,62 @@
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
-
 package contracts
 
 import (
 	"encoding/json"
-	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/retail/contracts/interfaces"
 	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"retail/contracts/interfaces"
 )
 
 // Sale represents a retail sale
 type Sale struct {
-	SaleID        string         `json:"sale_id"`
-	CustomerID    string         `json:"customer_id"`
-	StoreID       string         `json:"store_id"`
-	Items         []SaleItem     `json:"items"`
-	TotalAmount   sdk.Int        `json:"total_amount"`
-	Discounts     []Discount     `json:"discounts"`
-	PaymentInfo   PaymentInfo    `json:"payment_info"`
-	LoyaltyPoints sdk.Int        `json:"loyalty_points"`
-	Status        string         `json:"status"`
-	Timestamp     time.Time      `json:"timestamp"`
+	SaleID        string      `json:"sale_id"`
+	CustomerID    string      `json:"customer_id"`
+	StoreID       string      `json:"store_id"`
+	Items         []SaleItem  `json:"items"`
+	TotalAmount   sdkmath.Int `json:"total_amount"`
+	Discounts     []Discount  `json:"discounts"`
+	PaymentInfo   PaymentInfo `json:"payment_info"`
+	LoyaltyPoints sdkmath.Int `json:"loyalty_points"`
+	Status        string      `json:"status"`
+	Timestamp     time.Time   `json:"timestamp"`
 }
 
 // SaleItem represents an item in a sale
 type SaleItem struct {
-	ProductID  string  `json:"product_id"`
-	Quantity   int64   `json:"quantity"`
-	UnitPrice  sdk.Int `json:"unit_price"`
-	Subtotal   sdk.Int `json:"subtotal"`
+	ProductID string      `json:"product_id"`
+	Quantity  int64       `json:"quantity"`
+	UnitPrice sdkmath.Int `json:"unit_price"`
+	Subtotal  sdkmath.Int `json:"subtotal"`
 }
 
 // Discount represents a discount applied to a sale
 type Discount struct {
-	Type        string  `json:"type"`
-	Amount      sdk.Int `json:"amount"`
-	Description string  `json:"description"`
+	Type        string      `json:"type"`
+	Amount      sdkmath.Int `json:"amount"`
+	Description string      `json:"description"`
 }
 
 // PaymentInfo contains payment details
 type PaymentInfo struct {
-	Method      string    `json:"method"`
-	Amount      sdk.Int   `json:"amount"`
-	Status      string    `json:"status"`
-	Reference   string    `json:"reference"`
-	ProcessedAt time.Time `json:"processed_at"`
+	Method      string      `json:"method"`
+	Amount      sdkmath.Int `json:"amount"`
+	Status      string      `json:"status"`
+	Reference   string      `json:"reference"`
+	ProcessedAt time.Time   `json:"processed_at"`
 }
 
 // RetailContract implements the IRetailContract interface
 type RetailContract struct {
 	inventoryManager interfaces.IInventoryManager
-	salesProcessor  interfaces.ISalesProcessor
-	loyaltyManager  interfaces.ILoyaltyManager
+	salesProcessor   interfaces.ISalesProcessor
+	loyaltyManager   interfaces.ILoyaltyManager
 	promotionManager interfaces.IPromotionManager
+	router           *interchain.Router
 }
 
 func NewRetailContract(
@@ -1291,75 +65,65 @@ func NewRetailContract(
 	loyaltyManager interfaces.ILoyaltyManager,
 	promotionManager interfaces.IPromotionManager,
 ) *RetailContract {
-	return &RetailContract{
+	c := &RetailContract{
 		inventoryManager: inventoryManager,
 		salesProcessor:   salesProcessor,
 		loyaltyManager:   loyaltyManager,
 		promotionManager: promotionManager,
 	}
+	c.router = interchain.NewRouter().
+		AddRoute(interchain.ChainEcommerce, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleEcommerceMessage,
+			Prepare:  c.prepareEcommerceMessage,
+			Callback: c.handleEcommerceCallback,
+		}).
+		AddRoute(interchain.ChainSupplyChain, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleSupplyChainMessage,
+			Prepare:  c.prepareSupplyChainMessage,
+			Callback: c.handleSupplyChainCallback,
+		}).
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process:  c.handleFinanceMessage,
+			Prepare:  c.prepareFinanceMessage,
+			Callback: c.handleFinanceCallback,
+		})
+	return c
 }
 
 // ValidateInterchainData implements IRetailContract
 func (c *RetailContract) ValidateInterchainData(ctx sdk.Context, data []byte) error {
 	var sale Sale
 	if err := json.Unmarshal(data, &sale); err != nil {
-		return errors.Wrap(errors.ErrInvalidRequest, "invalid sale format")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid sale format")
 	}
 
 	// Validate required fields
 	if sale.SaleID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "sale ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "sale ID is required")
 	}
 	if sale.CustomerID == "" {
-		return errors.Wrap(errors.ErrInvalidRequest, "customer ID is required")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "customer ID is required")
 	}
 	if len(sale.Items) == 0 {
-		return errors.Wrap(errors.ErrInvalidRequest, "sale must contain items")
+		return errorsmod.Wrap(interchain.ErrInvalidData, "sale must contain items")
 	}
 
 	return nil
 }
 
 // ProcessInterchainMessage implements IRetailContract
-func (c *RetailContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error {
-	switch sourceChain {
-	case "ecommerce":
-		return c.handleEcommerceMessage(ctx, message)
-	case "supplychain":
-		return c.handleSupplyChainMessage(ctx, message)
-	case "finance":
-		return c.handleFinanceMessage(ctx, message)
-	default:
-		return fmt.Errorf("unsupported source chain: %s", sourceChain)
-	}
+func (c *RetailContract) ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	return c.router.Process(ctx, sourceChain, messageType, message)
 }
 
 // PrepareInterchainMessage implements IRetailContract
-func (c *RetailContract) PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error) {
-	switch targetChain {
-	case "ecommerce":
-		return c.prepareEcommerceMessage(data)
-	case "supplychain":
-		return c.prepareSupplyChainMessage(data)
-	case "finance":
-		return c.prepareFinanceMessage(data)
-	default:
-		return nil, fmt.Errorf("unsupported target chain: %s", targetChain)
-	}
+func (c *RetailContract) PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	return c.router.Prepare(ctx, targetChain, messageType, data)
 }
 
 // HandleCallback implements IRetailContract
-func (c *RetailContract) HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error {
-	switch sourceChain {
-	case "ecommerce":
-		return c.handleEcommerceCallback(ctx, response)
-	case "supplychain":
-		return c.handleSupplyChainCallback(ctx, response)
-	case "finance":
-		return c.handleFinanceCallback(ctx, response)
-	default:
-		return fmt.Errorf("unsupported source chain for callback: %s", sourceChain)
-	}
+func (c *RetailContract) HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	return c.router.Callback(ctx, sourceChain, messageType, response)
 }
 
 // ProcessSale implements IRetailContract
@@ -1384,7 +148,7 @@ func (c *RetailContract) ProcessReturn(ctx sdk.Context, returnData []byte) error
 }
 
 // UpdateLoyaltyPoints implements IRetailContract
-func (c *RetailContract) UpdateLoyaltyPoints(ctx sdk.Context, customerID string, points sdk.Int) error {
+func (c *RetailContract) UpdateLoyaltyPoints(ctx sdk.Context, customerID string, points sdkmath.Int) error {
 	return c.loyaltyManager.UpdatePoints(ctx, customerID, points)
 }
 
@@ -1405,17 +169,17 @@ func (c *RetailContract) handleFinanceMessage(ctx sdk.Context, message []byte) e
 }
 
 // Internal message preparation
-func (c *RetailContract) prepareEcommerceMessage(data []byte) ([]byte, error) {
+func (c *RetailContract) prepareEcommerceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for e-commerce chain
 	return data, nil
 }
 
-func (c *RetailContract) prepareSupplyChainMessage(data []byte) ([]byte, error) {
+func (c *RetailContract) prepareSupplyChainMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for supply chain
 	return data, nil
 }
 
-func (c *RetailContract) prepareFinanceMessage(data []byte) ([]byte, error) {
+func (c *RetailContract) prepareFinanceMessage(ctx sdk.Context, data []byte) ([]byte, error) {
 	// Prepare message for finance chain
 	return data, nil
 }
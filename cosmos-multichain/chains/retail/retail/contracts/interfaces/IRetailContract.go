@@ -1,22 +1,21 @@
 package interfaces
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
 )
 
 // IRetailContract extends the base interchain contract with retail features
 type IRetailContract interface {
 	// Base interchain functionality
-	ValidateInterchainData(ctx sdk.Context, data []byte) error
-	ProcessInterchainMessage(ctx sdk.Context, sourceChain string, message []byte) error
-	PrepareInterchainMessage(ctx sdk.Context, targetChain string, data []byte) ([]byte, error)
-	HandleCallback(ctx sdk.Context, sourceChain string, response []byte) error
+	interchain.IInterchainContract
 
 	// Retail-specific functionality
 	ProcessSale(ctx sdk.Context, sale []byte) error
 	UpdateInventory(ctx sdk.Context, inventory []byte) error
 	ProcessReturn(ctx sdk.Context, returnData []byte) error
-	UpdateLoyaltyPoints(ctx sdk.Context, customerID string, points sdk.Int) error
+	UpdateLoyaltyPoints(ctx sdk.Context, customerID string, points sdkmath.Int) error
 }
 
 // IInventoryManager defines the interface for inventory management
@@ -46,16 +45,16 @@ type ISalesProcessor interface {
 	ValidateSale(ctx sdk.Context, sale []byte) error
 
 	// CalculateTotal calculates sale total with discounts
-	CalculateTotal(ctx sdk.Context, items []byte) (sdk.Int, error)
+	CalculateTotal(ctx sdk.Context, items []byte) (sdkmath.Int, error)
 }
 
 // ILoyaltyManager defines the interface for loyalty program management
 type ILoyaltyManager interface {
 	// UpdatePoints updates customer loyalty points
-	UpdatePoints(ctx sdk.Context, customerID string, points sdk.Int) error
+	UpdatePoints(ctx sdk.Context, customerID string, points sdkmath.Int) error
 
 	// GetPoints retrieves customer points balance
-	GetPoints(ctx sdk.Context, customerID string) (sdk.Int, error)
+	GetPoints(ctx sdk.Context, customerID string) (sdkmath.Int, error)
 
 	// ProcessReward processes loyalty reward redemption
 	ProcessReward(ctx sdk.Context, reward []byte) error
@@ -0,0 +1,191 @@
+package transactions
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"retail/contracts"
+)
+
+// TransactionType defines the type of retail transaction
+type TransactionType string
+
+const (
+	Sale            TransactionType = "SALE"
+	Return          TransactionType = "RETURN"
+	InventoryUpdate TransactionType = "INVENTORY_UPDATE"
+	LoyaltyUpdate   TransactionType = "LOYALTY_UPDATE"
+)
+
+// RetailTransactionRequest represents a retail transaction request
+type RetailTransactionRequest struct {
+	TransactionID   string          `json:"transaction_id"`
+	TransactionType TransactionType `json:"transaction_type"`
+	SaleData        *SaleData       `json:"sale_data,omitempty"`
+	ReturnData      *ReturnData     `json:"return_data,omitempty"`
+	InventoryData   *InventoryData  `json:"inventory_data,omitempty"`
+	LoyaltyData     *LoyaltyData    `json:"loyalty_data,omitempty"`
+	Timestamp       time.Time       `json:"timestamp"`
+	Metadata        Metadata        `json:"metadata"`
+}
+
+// SaleData contains sale details
+type SaleData struct {
+	SaleID      string                `json:"sale_id"`
+	CustomerID  string                `json:"customer_id"`
+	StoreID     string                `json:"store_id"`
+	Items       []contracts.SaleItem  `json:"items"`
+	PaymentInfo contracts.PaymentInfo `json:"payment_info"`
+}
+
+// ReturnData contains return details
+type ReturnData struct {
+	ReturnID string               `json:"return_id"`
+	SaleID   string               `json:"sale_id"`
+	Items    []contracts.SaleItem `json:"items"`
+	Reason   string               `json:"reason"`
+}
+
+// InventoryData contains the stock level of a product
+type InventoryData struct {
+	ProductID string `json:"product_id"`
+	StoreID   string `json:"store_id"`
+	Quantity  int64  `json:"quantity"`
+}
+
+// LoyaltyData contains a loyalty points adjustment
+type LoyaltyData struct {
+	CustomerID string      `json:"customer_id"`
+	Points     sdkmath.Int `json:"points"`
+}
+
+// Metadata contains additional transaction information
+type Metadata struct {
+	Channel    string            `json:"channel"`
+	Notes      string            `json:"notes"`
+	References []string          `json:"references"`
+	Extra      map[string]string `json:"extra"`
+}
+
+// RetailTransactionHandler handles retail transactions
+type RetailTransactionHandler struct {
+	contract *contracts.RetailContract
+}
+
+func NewRetailTransactionHandler(contract *contracts.RetailContract) *RetailTransactionHandler {
+	return &RetailTransactionHandler{
+		contract: contract,
+	}
+}
+
+// InitiateTransaction starts a new retail transaction
+func (h *RetailTransactionHandler) InitiateTransaction(ctx sdk.Context, req RetailTransactionRequest) error {
+	// Validate transaction request
+	if err := h.validateRequest(req); err != nil {
+		return err
+	}
+
+	// Process based on transaction type
+	switch req.TransactionType {
+	case Sale:
+		return h.processSale(ctx, req)
+	case Return:
+		return h.processReturn(ctx, req)
+	case InventoryUpdate:
+		return h.processInventoryUpdate(ctx, req)
+	case LoyaltyUpdate:
+		return h.processLoyaltyUpdate(ctx, req)
+	default:
+		return errorsmod.Wrap(interchain.ErrInvalidData, "unsupported transaction type")
+	}
+}
+
+// validateRequest validates the transaction request
+func (h *RetailTransactionHandler) validateRequest(req RetailTransactionRequest) error {
+	if req.TransactionID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "transaction ID is required")
+	}
+
+	switch req.TransactionType {
+	case Sale:
+		if req.SaleData == nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "sale data is required")
+		}
+	case Return:
+		if req.ReturnData == nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "return data is required")
+		}
+	case InventoryUpdate:
+		if req.InventoryData == nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "inventory data is required")
+		}
+	case LoyaltyUpdate:
+		if req.LoyaltyData == nil {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "loyalty data is required")
+		}
+	}
+
+	return nil
+}
+
+// Process different types of transactions
+func (h *RetailTransactionHandler) processSale(ctx sdk.Context, req RetailTransactionRequest) error {
+	// Convert request to sale format
+	saleData, err := json.Marshal(contracts.Sale{
+		SaleID:      req.SaleData.SaleID,
+		CustomerID:  req.SaleData.CustomerID,
+		StoreID:     req.SaleData.StoreID,
+		Items:       req.SaleData.Items,
+		PaymentInfo: req.SaleData.PaymentInfo,
+		Timestamp:   req.Timestamp,
+	})
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal sale data")
+	}
+
+	// Process the sale
+	return h.contract.ProcessSale(ctx, saleData)
+}
+
+func (h *RetailTransactionHandler) processReturn(ctx sdk.Context, req RetailTransactionRequest) error {
+	returnData, err := json.Marshal(req.ReturnData)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal return data")
+	}
+
+	return h.contract.ProcessReturn(ctx, returnData)
+}
+
+func (h *RetailTransactionHandler) processInventoryUpdate(ctx sdk.Context, req RetailTransactionRequest) error {
+	inventoryData, err := json.Marshal(req.InventoryData)
+	if err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "failed to marshal inventory data")
+	}
+
+	return h.contract.UpdateInventory(ctx, inventoryData)
+}
+
+func (h *RetailTransactionHandler) processLoyaltyUpdate(ctx sdk.Context, req RetailTransactionRequest) error {
+	if req.LoyaltyData.CustomerID == "" || req.LoyaltyData.Points.IsNil() {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "customer ID and points are required")
+	}
+
+	return h.contract.UpdateLoyaltyPoints(ctx, req.LoyaltyData.CustomerID, req.LoyaltyData.Points)
+}
+
+// UpdateTransactionStatus updates the status of a retail transaction
+func (h *RetailTransactionHandler) UpdateTransactionStatus(ctx sdk.Context, txID string, status string) error {
+	// Update transaction status and notify relevant chains
+	return nil
+}
+
+// FinalizeTransaction completes the retail transaction process
+func (h *RetailTransactionHandler) FinalizeTransaction(ctx sdk.Context, txID string) error {
+	// Finalize the transaction and update all relevant chains
+	return nil
+}
@@ -0,0 +1,449 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/interchain"
+
+	"retail/contracts"
+	"retail/x/retail/types"
+)
+
+// ContractRecord kinds kept by the retail contract
+const (
+	RecordKindStock     = "stock"
+	RecordKindSale      = "sale"
+	RecordKindReturn    = "return"
+	RecordKindLoyalty   = "loyalty"
+	RecordKindPromotion = "promotion"
+)
+
+const (
+	StockStatusAvailable = "AVAILABLE"
+
+	SaleStatusCompleted = "COMPLETED"
+	SaleStatusReturned  = "RETURNED"
+
+	ReturnStatusProcessed = "PROCESSED"
+
+	PromotionStatusActive = "ACTIVE"
+
+	// DiscountTypePromotion is the discount type recorded when a promotion is applied to a sale
+	DiscountTypePromotion = "promotion"
+)
+
+// LoyaltyPointValue is the sale amount that earns the customer one loyalty point
+var LoyaltyPointValue = sdkmath.NewInt(10)
+
+// newContract builds the retail contract on top of the module state
+func newContract(k Keeper) *contracts.RetailContract {
+	inventory := inventoryManager{k}
+	loyalty := loyaltyManager{k}
+	return contracts.NewRetailContract(inventory, salesProcessor{k, inventory, loyalty}, loyalty, promotionManager{k})
+}
+
+// setRecord stores data as the contract record of the given kind and id
+func (k Keeper) setRecord(ctx sdk.Context, kind, id string, data []byte, status string) {
+	k.SetContractRecord(ctx, types.ContractRecord{
+		Kind:      kind,
+		RecordId:  id,
+		Data:      data,
+		Status:    status,
+		UpdatedAt: ctx.BlockTime().Unix(),
+	})
+}
+
+// setJSONRecord stores v encoded as JSON as the contract record of the given kind and id
+func (k Keeper) setJSONRecord(ctx sdk.Context, kind, id string, v any, status string) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	k.setRecord(ctx, kind, id, bz, status)
+	return nil
+}
+
+// stockLevel is the available quantity of a product
+type stockLevel struct {
+	ProductID string `json:"product_id"`
+	Quantity  int64  `json:"quantity"`
+}
+
+// inventoryManager keeps the stock level of every product as contract records
+type inventoryManager struct {
+	k Keeper
+}
+
+// UpdateInventory sets the available quantity of a product
+func (m inventoryManager) UpdateInventory(ctx sdk.Context, inventory []byte) error {
+	var level stockLevel
+	if err := json.Unmarshal(inventory, &level); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid inventory format")
+	}
+	if level.ProductID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "product ID is required")
+	}
+	if level.Quantity < 0 {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "quantity cannot be negative")
+	}
+	return m.setStock(ctx, level)
+}
+
+func (m inventoryManager) CheckStock(ctx sdk.Context, productID string) (int64, error) {
+	level, err := m.stock(ctx, productID)
+	return level.Quantity, err
+}
+
+func (m inventoryManager) ReserveStock(ctx sdk.Context, productID string, quantity int64) error {
+	level, err := m.stock(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if level.Quantity < quantity {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "insufficient stock of product %s", productID)
+	}
+	level.Quantity -= quantity
+	return m.setStock(ctx, level)
+}
+
+func (m inventoryManager) ReleaseStock(ctx sdk.Context, productID string, quantity int64) error {
+	level, err := m.stock(ctx, productID)
+	if err != nil {
+		return err
+	}
+	level.Quantity += quantity
+	return m.setStock(ctx, level)
+}
+
+func (m inventoryManager) stock(ctx sdk.Context, productID string) (stockLevel, error) {
+	var level stockLevel
+	record, found := m.k.GetContractRecord(ctx, RecordKindStock, productID)
+	if !found {
+		return level, errorsmod.Wrap(types.ErrRecordNotFound, productID)
+	}
+	if err := json.Unmarshal(record.Data, &level); err != nil {
+		return level, errorsmod.Wrap(interchain.ErrInvalidData, "invalid inventory format")
+	}
+	return level, nil
+}
+
+func (m inventoryManager) setStock(ctx sdk.Context, level stockLevel) error {
+	return m.k.setJSONRecord(ctx, RecordKindStock, level.ProductID, level, StockStatusAvailable)
+}
+
+// saleReturn is a return of some of the items of a sale
+type saleReturn struct {
+	ReturnID string               `json:"return_id"`
+	SaleID   string               `json:"sale_id"`
+	Items    []contracts.SaleItem `json:"items"`
+	Reason   string               `json:"reason"`
+}
+
+// salesProcessor keeps sales as contract records, takes their items out of
+// stock and credits the customer with loyalty points
+type salesProcessor struct {
+	k         Keeper
+	inventory inventoryManager
+	loyalty   loyaltyManager
+}
+
+func unmarshalSale(sale []byte) (contracts.Sale, error) {
+	var s contracts.Sale
+	if err := json.Unmarshal(sale, &s); err != nil {
+		return s, errorsmod.Wrap(interchain.ErrInvalidData, "invalid sale format")
+	}
+	return s, nil
+}
+
+func (p salesProcessor) ValidateSale(ctx sdk.Context, sale []byte) error {
+	s, err := unmarshalSale(sale)
+	if err != nil {
+		return err
+	}
+	if s.SaleID == "" || s.CustomerID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "sale and customer IDs are required")
+	}
+	if len(s.Items) == 0 {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "sale must contain items")
+	}
+	for _, item := range s.Items {
+		if item.ProductID == "" || item.Quantity <= 0 {
+			return errorsmod.Wrap(interchain.ErrInvalidData, "sale items need a product and a positive quantity")
+		}
+		if item.UnitPrice.IsNil() || item.UnitPrice.IsNegative() {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "invalid unit price of product %s", item.ProductID)
+		}
+	}
+	return nil
+}
+
+// CalculateTotal sums the unit price times the quantity of every item
+func (p salesProcessor) CalculateTotal(ctx sdk.Context, items []byte) (sdkmath.Int, error) {
+	var saleItems []contracts.SaleItem
+	if err := json.Unmarshal(items, &saleItems); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrap(interchain.ErrInvalidData, "invalid sale items format")
+	}
+	total := sdkmath.ZeroInt()
+	for _, item := range saleItems {
+		total = total.Add(item.UnitPrice.MulRaw(item.Quantity))
+	}
+	return total, nil
+}
+
+func (p salesProcessor) ProcessSale(ctx sdk.Context, sale []byte) error {
+	s, err := unmarshalSale(sale)
+	if err != nil {
+		return err
+	}
+	if _, found := p.k.GetContractRecord(ctx, RecordKindSale, s.SaleID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "sale %s already exists", s.SaleID)
+	}
+
+	for i, item := range s.Items {
+		if err := p.inventory.ReserveStock(ctx, item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+		s.Items[i].Subtotal = item.UnitPrice.MulRaw(item.Quantity)
+	}
+	items, err := json.Marshal(s.Items)
+	if err != nil {
+		return err
+	}
+	if s.TotalAmount, err = p.CalculateTotal(ctx, items); err != nil {
+		return err
+	}
+
+	s.LoyaltyPoints = s.TotalAmount.Quo(LoyaltyPointValue)
+	if s.LoyaltyPoints.IsPositive() {
+		if err := p.loyalty.UpdatePoints(ctx, s.CustomerID, s.LoyaltyPoints); err != nil {
+			return err
+		}
+	}
+
+	s.Status = SaleStatusCompleted
+	if s.Timestamp.IsZero() {
+		s.Timestamp = ctx.BlockTime()
+	}
+	return p.k.setJSONRecord(ctx, RecordKindSale, s.SaleID, s, SaleStatusCompleted)
+}
+
+// ProcessReturn puts the returned items of a completed sale back in stock.
+// A sale can be returned once.
+func (p salesProcessor) ProcessReturn(ctx sdk.Context, returnData []byte) error {
+	var r saleReturn
+	if err := json.Unmarshal(returnData, &r); err != nil {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "invalid return format")
+	}
+	if r.ReturnID == "" || r.SaleID == "" {
+		return errorsmod.Wrap(interchain.ErrInvalidData, "return and sale IDs are required")
+	}
+	if _, found := p.k.GetContractRecord(ctx, RecordKindReturn, r.ReturnID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "return %s already exists", r.ReturnID)
+	}
+
+	record, found := p.k.GetContractRecord(ctx, RecordKindSale, r.SaleID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, r.SaleID)
+	}
+	if record.Status != SaleStatusCompleted {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "sale %s is %s", r.SaleID, record.Status)
+	}
+	s, err := unmarshalSale(record.Data)
+	if err != nil {
+		return err
+	}
+
+	sold := make(map[string]int64, len(s.Items))
+	for _, item := range s.Items {
+		sold[item.ProductID] += item.Quantity
+	}
+	for _, item := range r.Items {
+		if item.Quantity <= 0 || item.Quantity > sold[item.ProductID] {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "invalid return quantity of product %s", item.ProductID)
+		}
+		sold[item.ProductID] -= item.Quantity
+		if err := p.inventory.ReleaseStock(ctx, item.ProductID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	p.k.setRecord(ctx, RecordKindReturn, r.ReturnID, returnData, ReturnStatusProcessed)
+	p.k.setRecord(ctx, RecordKindSale, r.SaleID, record.Data, SaleStatusReturned)
+	return nil
+}
+
+// loyaltyBalance is the loyalty points balance of a customer
+type loyaltyBalance struct {
+	CustomerID string      `json:"customer_id"`
+	Points     sdkmath.Int `json:"points"`
+}
+
+// loyaltyManager keeps the loyalty points balance of every customer as contract records
+type loyaltyManager struct {
+	k Keeper
+}
+
+// UpdatePoints adds points, which may be negative, to the balance of a customer
+func (m loyaltyManager) UpdatePoints(ctx sdk.Context, customerID string, points sdkmath.Int) error {
+	balance, err := m.GetPoints(ctx, customerID)
+	if err != nil {
+		return err
+	}
+	balance = balance.Add(points)
+	if balance.IsNegative() {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "insufficient loyalty points of customer %s", customerID)
+	}
+	return m.k.setJSONRecord(ctx, RecordKindLoyalty, customerID, loyaltyBalance{customerID, balance}, "")
+}
+
+func (m loyaltyManager) GetPoints(ctx sdk.Context, customerID string) (sdkmath.Int, error) {
+	record, found := m.k.GetContractRecord(ctx, RecordKindLoyalty, customerID)
+	if !found {
+		return sdkmath.ZeroInt(), nil
+	}
+	var balance loyaltyBalance
+	if err := json.Unmarshal(record.Data, &balance); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrap(interchain.ErrInvalidData, "invalid loyalty balance format")
+	}
+	return balance.Points, nil
+}
+
+func (m loyaltyManager) ValidateReward(ctx sdk.Context, reward []byte) error {
+	_, err := m.unmarshalReward(ctx, reward)
+	return err
+}
+
+// ProcessReward redeems the points of a reward from the balance of the customer
+func (m loyaltyManager) ProcessReward(ctx sdk.Context, reward []byte) error {
+	r, err := m.unmarshalReward(ctx, reward)
+	if err != nil {
+		return err
+	}
+	return m.UpdatePoints(ctx, r.CustomerID, r.Points.Neg())
+}
+
+func (m loyaltyManager) unmarshalReward(ctx sdk.Context, reward []byte) (loyaltyBalance, error) {
+	var r loyaltyBalance
+	if err := json.Unmarshal(reward, &r); err != nil {
+		return r, errorsmod.Wrap(interchain.ErrInvalidData, "invalid reward format")
+	}
+	if r.CustomerID == "" || r.Points.IsNil() || !r.Points.IsPositive() {
+		return r, errorsmod.Wrap(interchain.ErrInvalidData, "reward needs a customer and positive points")
+	}
+	balance, err := m.GetPoints(ctx, r.CustomerID)
+	if err != nil {
+		return r, err
+	}
+	if balance.LT(r.Points) {
+		return r, errorsmod.Wrapf(interchain.ErrInvalidData, "insufficient loyalty points of customer %s", r.CustomerID)
+	}
+	return r, nil
+}
+
+// promotion is a percentage discount that can be applied to sales
+type promotion struct {
+	PromotionID     string `json:"promotion_id"`
+	DiscountPercent int64  `json:"discount_percent"`
+	Active          bool   `json:"active"`
+}
+
+// promotionManager keeps promotions as contract records
+type promotionManager struct {
+	k Keeper
+}
+
+func unmarshalPromotion(data []byte) (promotion, error) {
+	var p promotion
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "invalid promotion format")
+	}
+	if p.PromotionID == "" {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "promotion ID is required")
+	}
+	if p.DiscountPercent <= 0 || p.DiscountPercent > 100 {
+		return p, errorsmod.Wrap(interchain.ErrInvalidData, "discount percent must be between 1 and 100")
+	}
+	return p, nil
+}
+
+func (m promotionManager) ValidatePromotion(ctx sdk.Context, data []byte) error {
+	_, err := unmarshalPromotion(data)
+	return err
+}
+
+func (m promotionManager) CreatePromotion(ctx sdk.Context, data []byte) error {
+	p, err := unmarshalPromotion(data)
+	if err != nil {
+		return err
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindPromotion, p.PromotionID); found {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "promotion %s already exists", p.PromotionID)
+	}
+	m.setPromotion(ctx, p, data)
+	return nil
+}
+
+func (m promotionManager) UpdatePromotion(ctx sdk.Context, data []byte) error {
+	p, err := unmarshalPromotion(data)
+	if err != nil {
+		return err
+	}
+	if _, found := m.k.GetContractRecord(ctx, RecordKindPromotion, p.PromotionID); !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, p.PromotionID)
+	}
+	m.setPromotion(ctx, p, data)
+	return nil
+}
+
+func (m promotionManager) setPromotion(ctx sdk.Context, p promotion, data []byte) {
+	status := ""
+	if p.Active {
+		status = PromotionStatusActive
+	}
+	m.k.setRecord(ctx, RecordKindPromotion, p.PromotionID, data, status)
+}
+
+// ApplyPromotion discounts the total of a completed sale by the promotion percentage.
+// A promotion is applied to a sale at most once.
+func (m promotionManager) ApplyPromotion(ctx sdk.Context, saleID string, promotionID string) error {
+	promoRecord, found := m.k.GetContractRecord(ctx, RecordKindPromotion, promotionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, promotionID)
+	}
+	if promoRecord.Status != PromotionStatusActive {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "promotion %s is not active", promotionID)
+	}
+	p, err := unmarshalPromotion(promoRecord.Data)
+	if err != nil {
+		return err
+	}
+
+	saleRecord, found := m.k.GetContractRecord(ctx, RecordKindSale, saleID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRecordNotFound, saleID)
+	}
+	if saleRecord.Status != SaleStatusCompleted {
+		return errorsmod.Wrapf(interchain.ErrInvalidData, "sale %s is %s", saleID, saleRecord.Status)
+	}
+	s, err := unmarshalSale(saleRecord.Data)
+	if err != nil {
+		return err
+	}
+	for _, d := range s.Discounts {
+		if d.Type == DiscountTypePromotion && d.Description == promotionID {
+			return errorsmod.Wrapf(interchain.ErrInvalidData, "promotion %s is already applied to sale %s", promotionID, saleID)
+		}
+	}
+
+	amount := s.TotalAmount.MulRaw(p.DiscountPercent).QuoRaw(100)
+	s.Discounts = append(s.Discounts, contracts.Discount{
+		Type:        DiscountTypePromotion,
+		Amount:      amount,
+		Description: promotionID,
+	})
+	s.TotalAmount = s.TotalAmount.Sub(amount)
+	return m.k.setJSONRecord(ctx, RecordKindSale, saleID, s, SaleStatusCompleted)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"retail/contracts/transactions"
+	"retail/x/retail/types"
+)
+
+func (k msgServer) InitiateTransaction(goCtx context.Context, msg *types.MsgInitiateTransaction) (*types.MsgInitiateTransactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var req transactions.RetailTransactionRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransaction, "cannot decode transaction request: %s", err)
+	}
+	if err := k.authorizeInitiator(ctx, req, msg.Creator); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetTransaction(ctx, req.TransactionID); found {
+		return nil, errorsmod.Wrap(types.ErrTransactionExists, req.TransactionID)
+	}
+
+	if err := k.transactions.InitiateTransaction(ctx, req); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	k.SetTransaction(ctx, types.Transaction{
+		TransactionId:   req.TransactionID,
+		Creator:         msg.Creator,
+		TransactionType: string(req.TransactionType),
+		Status:          types.TransactionStatusInitiated,
+		Data:            msg.Data,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeTransactionInitiated,
+			sdk.NewAttribute(types.AttributeKeyTransactionId, req.TransactionID),
+			sdk.NewAttribute(types.AttributeKeyTransactionType, string(req.TransactionType)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgInitiateTransactionResponse{TransactionId: req.TransactionID}, nil
+}
+
+// authorizeInitiator checks creator signs a transaction of its own: stores
+// sell, take returns of their sales and update their inventory, and customers
+// redeem their loyalty points, which they only earn through sales. Requests
+// missing their data are left to the transaction handler to reject.
+func (k msgServer) authorizeInitiator(ctx sdk.Context, req transactions.RetailTransactionRequest, creator string) error {
+	var party string
+	switch {
+	case req.TransactionType == transactions.Sale && req.SaleData != nil:
+		party = req.SaleData.StoreID
+	case req.TransactionType == transactions.Return && req.ReturnData != nil:
+		record, found := k.GetContractRecord(ctx, RecordKindSale, req.ReturnData.SaleID)
+		if !found {
+			return nil
+		}
+		sale, err := unmarshalSale(record.Data)
+		if err != nil {
+			return err
+		}
+		party = sale.StoreID
+	case req.TransactionType == transactions.InventoryUpdate && req.InventoryData != nil:
+		party = req.InventoryData.StoreID
+	case req.TransactionType == transactions.LoyaltyUpdate && req.LoyaltyData != nil:
+		if !req.LoyaltyData.Points.IsNil() && req.LoyaltyData.Points.IsPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "loyalty points are only earned through sales")
+		}
+		party = req.LoyaltyData.CustomerID
+	default:
+		return nil
+	}
+	if party != creator {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot initiate a %s of %s", creator, req.TransactionType, party)
+	}
+	return nil
+}
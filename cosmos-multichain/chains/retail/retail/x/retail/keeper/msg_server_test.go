@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/example/cosmos-multichain/interchain"
+	"github.com/stretchr/testify/require"
+
+	"retail/contracts"
+	"retail/contracts/transactions"
+	keepertest "retail/testutil/keeper"
+	"retail/testutil/sample"
+	"retail/x/retail/keeper"
+	"retail/x/retail/types"
+)
+
+func retailData(t *testing.T, req transactions.RetailTransactionRequest) []byte {
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	return data
+}
+
+// store and customer are the parties of the sales of the tests
+var store, customer = sample.AccAddress(), sample.AccAddress()
+
+func stockRequest(id string, quantity int64) transactions.RetailTransactionRequest {
+	return transactions.RetailTransactionRequest{
+		TransactionID:   id,
+		TransactionType: transactions.InventoryUpdate,
+		InventoryData:   &transactions.InventoryData{ProductID: "product-1", StoreID: store, Quantity: quantity},
+	}
+}
+
+func saleRequest(id string, quantity int64) transactions.RetailTransactionRequest {
+	return transactions.RetailTransactionRequest{
+		TransactionID:   id,
+		TransactionType: transactions.Sale,
+		SaleData: &transactions.SaleData{
+			SaleID:     "sale-" + id,
+			CustomerID: customer,
+			StoreID:    store,
+			Items: []contracts.SaleItem{
+				{ProductID: "product-1", Quantity: quantity, UnitPrice: sdkmath.NewInt(25)},
+			},
+		},
+	}
+}
+
+func returnRequest(id, saleID string, quantity int64) transactions.RetailTransactionRequest {
+	return transactions.RetailTransactionRequest{
+		TransactionID:   id,
+		TransactionType: transactions.Return,
+		ReturnData: &transactions.ReturnData{
+			ReturnID: "return-" + id,
+			SaleID:   saleID,
+			Items:    []contracts.SaleItem{{ProductID: "product-1", Quantity: quantity}},
+		},
+	}
+}
+
+func TestSaleAndReturn(t *testing.T) {
+	k, ctx := keepertest.RetailKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	initiateAs := func(creator string, req transactions.RetailTransactionRequest) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, retailData(t, req)))
+		return err
+	}
+	initiate := func(req transactions.RetailTransactionRequest) error {
+		return initiateAs(store, req)
+	}
+	stock := func() int64 {
+		record, found := k.GetContractRecord(ctx, keeper.RecordKindStock, "product-1")
+		require.True(t, found)
+		var level struct {
+			Quantity int64 `json:"quantity"`
+		}
+		require.NoError(t, json.Unmarshal(record.Data, &level))
+		return level.Quantity
+	}
+
+	require.ErrorIs(t, initiate(saleRequest("tx-1", 2)), types.ErrRecordNotFound)
+	// Only the store updates its inventory and sells
+	require.ErrorIs(t, initiateAs(sample.AccAddress(), stockRequest("tx-2", 5)), sdkerrors.ErrUnauthorized)
+	_, found := k.GetContractRecord(ctx, keeper.RecordKindStock, "product-1")
+	require.False(t, found)
+	require.NoError(t, initiate(stockRequest("tx-2", 5)))
+	require.ErrorIs(t, initiateAs(customer, saleRequest("tx-8", 1)), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, initiate(saleRequest("tx-3", 10)), interchain.ErrInvalidData)
+
+	require.NoError(t, initiate(saleRequest("tx-4", 2)))
+	require.Equal(t, int64(3), stock())
+	record, found := k.GetContractRecord(ctx, keeper.RecordKindSale, "sale-tx-4")
+	require.True(t, found)
+	require.Equal(t, keeper.SaleStatusCompleted, record.Status)
+	var sale contracts.Sale
+	require.NoError(t, json.Unmarshal(record.Data, &sale))
+	require.Equal(t, sdkmath.NewInt(50), sale.TotalAmount)
+	require.Equal(t, sdkmath.NewInt(5), sale.LoyaltyPoints)
+
+	require.ErrorIs(t, initiate(returnRequest("tx-5", "sale-tx-4", 3)), interchain.ErrInvalidData)
+	require.ErrorIs(t, initiateAs(customer, returnRequest("tx-9", "sale-tx-4", 1)), sdkerrors.ErrUnauthorized)
+	require.Equal(t, int64(3), stock())
+	require.NoError(t, initiate(returnRequest("tx-6", "sale-tx-4", 1)))
+	require.Equal(t, int64(4), stock())
+	record, _ = k.GetContractRecord(ctx, keeper.RecordKindSale, "sale-tx-4")
+	require.Equal(t, keeper.SaleStatusReturned, record.Status)
+	require.ErrorIs(t, initiate(returnRequest("tx-7", "sale-tx-4", 1)), interchain.ErrInvalidData)
+}
+
+func TestLoyaltyUpdate(t *testing.T) {
+	k, ctx := keepertest.RetailKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	update := func(creator, id string, points int64) error {
+		_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, retailData(t, transactions.RetailTransactionRequest{
+			TransactionID:   id,
+			TransactionType: transactions.LoyaltyUpdate,
+			LoyaltyData:     &transactions.LoyaltyData{CustomerID: customer, Points: sdkmath.NewInt(points)},
+		})))
+		return err
+	}
+
+	// The customer earns points through a sale, and redeems them
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(store, retailData(t, stockRequest("tx-1", 10))))
+	require.NoError(t, err)
+	_, err = srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(store, retailData(t, saleRequest("tx-2", 8))))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, update(customer, "tx-3", 20), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, update(store, "tx-4", -5), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, update(customer, "tx-5", -30), interchain.ErrInvalidData)
+	require.NoError(t, update(customer, "tx-6", -15))
+
+	record, found := k.GetContractRecord(ctx, keeper.RecordKindLoyalty, customer)
+	require.True(t, found)
+	var balance struct {
+		Points sdkmath.Int `json:"points"`
+	}
+	require.NoError(t, json.Unmarshal(record.Data, &balance))
+	require.Equal(t, sdkmath.NewInt(5), balance.Points)
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	k, ctx := keepertest.RetailKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	creator, other := store, sample.AccAddress()
+
+	_, err := srv.InitiateTransaction(ctx, types.NewMsgInitiateTransaction(creator, retailData(t, stockRequest("tx-1", 5))))
+	require.NoError(t, err)
+
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(other, "tx-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.NoError(t, err)
+	_, err = srv.FinalizeTransaction(ctx, types.NewMsgFinalizeTransaction(creator, "tx-1"))
+	require.ErrorIs(t, err, types.ErrTransactionFinalized)
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"retail/x/retail/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// AuditEntryKeyPrefix is the prefix to retrieve all AuditEntry
+	AuditEntryKeyPrefix = "AuditEntry/value/"
+
+	// AuditEntryCountKey is the key of the number of audit entries ever appended
+	AuditEntryCountKey = "AuditEntry/count/"
+)
+
+// AuditTrailKey returns the prefix of the audit entries of a subject
+func AuditTrailKey(
+	subjectId string,
+) []byte {
+	var key []byte
+
+	subjectIdBytes := []byte(subjectId)
+	key = append(key, subjectIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuditEntryKey returns the store key of an audit entry, ordered by id within its subject
+func AuditEntryKey(
+	subjectId string,
+	id uint64,
+) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(AuditTrailKey(subjectId), bz...)
+}
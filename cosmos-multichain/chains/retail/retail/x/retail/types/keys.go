@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "retail"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_retail"
+)
+
+var (
+	ParamsKey = []byte("p_retail")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
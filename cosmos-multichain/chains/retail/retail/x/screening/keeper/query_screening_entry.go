@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"retail/x/screening/types"
+)
+
+func (k Keeper) ScreeningEntryAll(ctx context.Context, req *types.QueryAllScreeningEntryRequest) (*types.QueryAllScreeningEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var screeningEntries []types.ScreeningEntry
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	screeningEntryStore := prefix.NewStore(store, types.KeyPrefix(types.ScreeningEntryKeyPrefix))
+
+	pageRes, err := query.Paginate(screeningEntryStore, req.Pagination, func(key []byte, value []byte) error {
+		var screeningEntry types.ScreeningEntry
+		if err := k.cdc.Unmarshal(value, &screeningEntry); err != nil {
+			return err
+		}
+
+		screeningEntries = append(screeningEntries, screeningEntry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllScreeningEntryResponse{ScreeningEntry: screeningEntries, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ScreeningEntry(ctx context.Context, req *types.QueryGetScreeningEntryRequest) (*types.QueryGetScreeningEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetScreeningEntry(
+		ctx,
+		req.Value,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetScreeningEntryResponse{ScreeningEntry: val}, nil
+}
+
+func (k Keeper) Screened(ctx context.Context, req *types.QueryScreenedRequest) (*types.QueryScreenedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryScreenedResponse{Screened: k.IsScreened(ctx, req.Party)}, nil
+}
+
+func (k Keeper) ScreeningHistory(ctx context.Context, req *types.QueryScreeningHistoryRequest) (*types.QueryScreeningHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var screeningRecords []types.ScreeningRecord
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	screeningRecordStore := prefix.NewStore(store, types.KeyPrefix(types.ScreeningRecordKeyPrefix))
+
+	pageRes, err := query.Paginate(screeningRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var screeningRecord types.ScreeningRecord
+		if err := k.cdc.Unmarshal(value, &screeningRecord); err != nil {
+			return err
+		}
+
+		screeningRecords = append(screeningRecords, screeningRecord)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScreeningHistoryResponse{ScreeningRecord: screeningRecords, Pagination: pageRes}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/example/cosmos-multichain/screening"
+
+	"retail/x/screening/types"
+)
+
+var _ screening.Screener = Keeper{}
+
+// ListEntry lists a party and records the listing in the screening history
+func (k Keeper) ListEntry(ctx context.Context, kind, value, reason string) error {
+	if err := types.ValidateEntry(kind, value); err != nil {
+		return err
+	}
+	if _, found := k.GetScreeningEntry(ctx, value); found {
+		return errorsmod.Wrap(types.ErrEntryExists, value)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.SetScreeningEntry(ctx, types.ScreeningEntry{
+		Value:    value,
+		Kind:     kind,
+		Reason:   reason,
+		ListedAt: sdkCtx.BlockTime().Unix(),
+	})
+	k.AppendScreeningRecord(ctx, types.RecordActionListed, value, reason)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEntryListed,
+			sdk.NewAttribute(types.AttributeKeyKind, kind),
+			sdk.NewAttribute(types.AttributeKeyValue, value),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// DelistEntry delists a party and records the delisting in the screening history
+func (k Keeper) DelistEntry(ctx context.Context, value, reason string) error {
+	entry, found := k.GetScreeningEntry(ctx, value)
+	if !found {
+		return errorsmod.Wrap(types.ErrEntryNotFound, value)
+	}
+
+	k.RemoveScreeningEntry(ctx, value)
+	k.AppendScreeningRecord(ctx, types.RecordActionDelisted, value, reason)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEntryDelisted,
+			sdk.NewAttribute(types.AttributeKeyKind, entry.Kind),
+			sdk.NewAttribute(types.AttributeKeyValue, value),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// Journal returns the journal keeping the attempts blocked in the block,
+// which the module records at its end
+func (k Keeper) Journal() screening.Journal {
+	return k.journal
+}
+
+// Screener returns the screener of the transactions, packets and contract
+// calls of the chain, which records their blocked attempts at the end of the
+// block, out of their reverted state
+func (k Keeper) Screener() screening.Screener {
+	return k.journal.Defer(k)
+}
+
+// IsScreened reports whether party is listed by its address or by the hash
+// of its external identifier
+func (k Keeper) IsScreened(ctx context.Context, party string) bool {
+	if party == "" {
+		return false
+	}
+	if _, found := k.GetScreeningEntry(ctx, party); found {
+		return true
+	}
+	_, found := k.GetScreeningEntry(ctx, screening.HashIdentifier(party))
+	return found
+}
+
+// LogBlocked records an attempt blocked because of party in the screening
+// history. The record is written in the state of ctx, so the screener of the
+// chain defers it to the end of the block through the journal.
+func (k Keeper) LogBlocked(ctx context.Context, ref, party string) {
+	k.AppendScreeningRecord(ctx, types.RecordActionBlocked, party, ref)
+	k.Logger().Info("blocked screened party", "party", party, "ref", ref)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBlocked,
+			sdk.NewAttribute(types.AttributeKeyParty, party),
+			sdk.NewAttribute(types.AttributeKeyRef, ref),
+		),
+	)
+}
@@ -0,0 +1,14 @@
+package types
+
+// screening module event types
+const (
+	EventTypeEntryListed   = "screening_entry_listed"
+	EventTypeEntryDelisted = "screening_entry_delisted"
+	EventTypeBlocked       = "screening_blocked"
+
+	AttributeKeyKind   = "kind"
+	AttributeKeyValue  = "value"
+	AttributeKeyReason = "reason"
+	AttributeKeyRef    = "ref"
+	AttributeKeyParty  = "party"
+)
@@ -0,0 +1,50 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ScreeningEntryList:  []ScreeningEntry{},
+		ScreeningRecordList: []ScreeningRecord{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated index in screeningEntry
+	screeningEntryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ScreeningEntryList {
+		index := string(ScreeningEntryKey(elem.Value))
+		if _, ok := screeningEntryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for screeningEntry")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		screeningEntryIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in screeningRecord
+	screeningRecordIdMap := make(map[uint64]bool)
+	for _, elem := range gs.ScreeningRecordList {
+		if _, ok := screeningRecordIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for screeningRecord")
+		}
+		if elem.Id >= gs.ScreeningRecordCount {
+			return fmt.Errorf("screeningRecord id should be lower or equal than the last id")
+		}
+		screeningRecordIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
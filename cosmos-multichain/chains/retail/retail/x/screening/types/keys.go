@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "screening"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_screening"
+)
+
+var (
+	ParamsKey = []byte("p_screening")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
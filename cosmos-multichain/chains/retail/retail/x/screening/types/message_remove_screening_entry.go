@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRemoveScreeningEntry{}
+
+func NewMsgRemoveScreeningEntry(authority string, value string, reason string) *MsgRemoveScreeningEntry {
+	return &MsgRemoveScreeningEntry{
+		Authority: authority,
+		Value:     value,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgRemoveScreeningEntry) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.Value == "" {
+		return errorsmod.Wrap(ErrInvalidEntry, "value cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Package interchain holds the pieces shared by the industry chain contracts:
+// the IInterchainContract interface, a Router dispatching messages by
+// counterparty chain and message type, and a common error set.
+package interchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Chain IDs of the counterparties the contracts route messages for
+const (
+	ChainHub         = "hub"
+	ChainFinance     = "finance"
+	ChainHealthcare  = "healthcare"
+	ChainInsurance   = "insurance"
+	ChainRealEstate  = "realestate"
+	ChainGovernment  = "government"
+	ChainEcommerce   = "ecommerce"
+	ChainRetail      = "retail"
+	ChainSupplyChain = "supplychain"
+	ChainTelecom     = "telecom"
+	ChainEducation   = "education"
+)
+
+// IInterchainContract defines the base interface for cross-chain communication
+type IInterchainContract interface {
+	// ValidateInterchainData validates data coming from other chains
+	ValidateInterchainData(ctx sdk.Context, data []byte) error
+
+	// ProcessInterchainMessage processes messages from other chains
+	ProcessInterchainMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) error
+
+	// PrepareInterchainMessage prepares a message to be sent to another chain
+	PrepareInterchainMessage(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error)
+
+	// HandleCallback handles callbacks from other chains after cross-chain transactions
+	HandleCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error
+}
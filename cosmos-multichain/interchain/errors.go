@@ -0,0 +1,22 @@
+package interchain
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the errors shared by the chain contracts
+const Codespace = "interchain"
+
+// interchain contract sentinel errors
+var (
+	ErrUnsupportedChain       = sdkerrors.Register(Codespace, 1100, "unsupported counterparty chain")
+	ErrUnsupportedMessageType = sdkerrors.Register(Codespace, 1101, "unsupported interchain message type")
+	ErrInvalidData            = sdkerrors.Register(Codespace, 1102, "invalid interchain data")
+	ErrHeldForReview          = sdkerrors.Register(Codespace, 1103, "interchain message held for review")
+	ErrNoChannel              = sdkerrors.Register(Codespace, 1104, "no open interchain channel")
+	ErrInvalidPacket          = sdkerrors.Register(Codespace, 1105, "invalid interchain packet")
+	ErrInvalidChannel         = sdkerrors.Register(Codespace, 1106, "invalid interchain channel")
+	ErrUnknownCounterparty    = sdkerrors.Register(Codespace, 1107, "unknown counterparty chain")
+)
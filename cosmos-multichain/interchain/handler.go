@@ -0,0 +1,52 @@
+package interchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PacketQueuer queues the packets of a chain module for a counterparty chain
+type PacketQueuer interface {
+	QueuePacket(ctx sdk.Context, chain string, packet PacketData) error
+}
+
+var _ PacketHandler = ContractHandler{}
+
+// ContractHandler is the PacketHandler of a chain contract. The messages and
+// callbacks received are handed to the contract, the responses to its
+// messages are handed back to it as callbacks, and the packets that timed out
+// are queued again. Modules embed it in their keeper, overriding the handling
+// particular to their chain.
+type ContractHandler struct {
+	contract IInterchainContract
+	queue    PacketQueuer
+}
+
+func NewContractHandler(contract IInterchainContract, queue PacketQueuer) ContractHandler {
+	return ContractHandler{contract: contract, queue: queue}
+}
+
+// OnRecvMessage processes a message of a counterparty chain with the contract
+func (h ContractHandler) OnRecvMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) ([]byte, error) {
+	return nil, h.contract.ProcessInterchainMessage(ctx, sourceChain, messageType, message)
+}
+
+// OnRecvCallback hands a callback of a counterparty chain to the contract
+func (h ContractHandler) OnRecvCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	return h.contract.HandleCallback(ctx, sourceChain, messageType, response)
+}
+
+// OnAcknowledgement hands the response to a message to the contract. A
+// message acknowledged without a response needs no callback, and a packet the
+// counterparty rejected is left as it was; the rejection is reported in the
+// packet event.
+func (h ContractHandler) OnAcknowledgement(ctx sdk.Context, targetChain string, packet PacketData, response []byte, ackErr error) error {
+	if ackErr != nil || packet.Kind != PacketKindMessage || len(response) == 0 {
+		return ackErr
+	}
+	return h.contract.HandleCallback(ctx, targetChain, packet.MessageType, response)
+}
+
+// OnTimeout queues a packet the counterparty did not receive again
+func (h ContractHandler) OnTimeout(ctx sdk.Context, targetChain string, packet PacketData) error {
+	return h.queue.QueuePacket(ctx, targetChain, packet)
+}
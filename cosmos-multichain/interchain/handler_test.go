@@ -0,0 +1,48 @@
+package interchain_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+type callbackContract struct {
+	interchain.IInterchainContract
+	callbacks []string
+}
+
+func (c *callbackContract) HandleCallback(_ sdk.Context, sourceChain, messageType string, response []byte) error {
+	c.callbacks = append(c.callbacks, sourceChain+":"+messageType+":"+string(response))
+	return nil
+}
+
+type packetQueue struct {
+	queued []interchain.QueuedPacket
+}
+
+func (q *packetQueue) QueuePacket(_ sdk.Context, chain string, packet interchain.PacketData) error {
+	q.queued = append(q.queued, interchain.QueuedPacket{Chain: chain, Packet: packet})
+	return nil
+}
+
+func TestContractHandler(t *testing.T) {
+	contract := &callbackContract{}
+	queue := &packetQueue{}
+	handler := interchain.NewContractHandler(contract, queue)
+	ctx := sdk.Context{}
+	message := interchain.NewMessagePacket("claim", []byte("msg"))
+
+	require.NoError(t, handler.OnAcknowledgement(ctx, interchain.ChainInsurance, message, []byte("resp"), nil))
+	require.NoError(t, handler.OnAcknowledgement(ctx, interchain.ChainInsurance, message, nil, nil))
+	require.NoError(t, handler.OnAcknowledgement(ctx, interchain.ChainInsurance, interchain.NewCallbackPacket("claim", []byte("resp")), []byte("resp"), nil))
+	ackErr := errors.New("rejected")
+	require.ErrorIs(t, handler.OnAcknowledgement(ctx, interchain.ChainInsurance, message, []byte("resp"), ackErr), ackErr)
+	require.Equal(t, []string{"insurance:claim:resp"}, contract.callbacks)
+
+	require.NoError(t, handler.OnTimeout(ctx, interchain.ChainInsurance, message))
+	require.Equal(t, []interchain.QueuedPacket{{Chain: interchain.ChainInsurance, Packet: message}}, queue.queued)
+}
@@ -0,0 +1,285 @@
+package interchain
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrorstypes "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Events of the interchain port
+const (
+	EventTypePacket = "interchain_packet"
+
+	AttributeKeyKind        = "kind"
+	AttributeKeyMessageType = "message_type"
+	AttributeKeyChain       = "chain"
+	AttributeKeySuccess     = "success"
+	AttributeKeyError       = "error"
+)
+
+// PacketHandler handles the packets of the interchain port on behalf of a
+// chain contract
+type PacketHandler interface {
+	// OnRecvMessage processes a message received from sourceChain and returns
+	// the response it is acknowledged with
+	OnRecvMessage(ctx sdk.Context, sourceChain, messageType string, message []byte) ([]byte, error)
+
+	// OnRecvCallback handles a callback received from sourceChain
+	OnRecvCallback(ctx sdk.Context, sourceChain, messageType string, response []byte) error
+
+	// OnAcknowledgement handles the acknowledgement of a packet sent to
+	// targetChain: the response to a message, or the error the packet was
+	// rejected with
+	OnAcknowledgement(ctx sdk.Context, targetChain string, packet PacketData, response []byte, ackErr error) error
+
+	// OnTimeout handles a packet targetChain did not receive in time
+	OnTimeout(ctx sdk.Context, targetChain string, packet PacketData) error
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface of the interchain port. Channels
+// are unordered, and are opened with the counterparty chains the port
+// resolves.
+type IBCModule struct {
+	port    Port
+	handler PacketHandler
+}
+
+// NewIBCModule creates a new IBCModule given the port of a module and its
+// packet handler
+func NewIBCModule(port Port, handler PacketHandler) IBCModule {
+	return IBCModule{port: port, handler: handler}
+}
+
+// checkChannel checks a channel handshake on the port
+func (im IBCModule) checkChannel(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, counterparty channeltypes.Counterparty) error {
+	if portID != PortID || counterparty.PortId != PortID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "interchain channels are opened between %s ports", PortID)
+	}
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(ErrInvalidChannel, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	_, err := im.port.ConnectionChain(ctx, connectionHops)
+	return err
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if version == "" {
+		version = Version
+	}
+	if version != Version {
+		return "", sdkerrors.Wrapf(ErrInvalidChannel, "got version %s, expected %s", version, Version)
+	}
+	if err := im.checkChannel(ctx, order, connectionHops, portID, counterparty); err != nil {
+		return "", err
+	}
+	if err := im.port.ClaimChannel(ctx, chanCap, channelID); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if counterpartyVersion != Version {
+		return "", sdkerrors.Wrapf(ErrInvalidChannel, "invalid counterparty version: got %s, expected %s", counterpartyVersion, Version)
+	}
+	if err := im.checkChannel(ctx, order, connectionHops, portID, counterparty); err != nil {
+		return "", err
+	}
+	if err := im.port.ClaimChannel(ctx, chanCap, channelID); err != nil {
+		return "", err
+	}
+	return Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidChannel, "invalid counterparty version: %s, expected %s", counterpartyVersion, Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for channels
+	return sdkerrors.Wrap(sdkerrorstypes.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket hands a message or a callback to the contract. A message is
+// acknowledged with the response of the contract, and a failure with an error
+// acknowledgement, which reverts the state written for the packet.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := UnmarshalPacketData(modulePacket.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	sourceChain, err := im.port.ChannelChain(ctx, modulePacket.GetDestChannel())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	var response []byte
+	switch data.Kind {
+	case PacketKindMessage:
+		response, err = im.handler.OnRecvMessage(ctx, sourceChain, data.MessageType, data.Payload)
+	case PacketKindCallback:
+		err = im.handler.OnRecvCallback(ctx, sourceChain, data.MessageType, data.Payload)
+	}
+	emitPacketEvent(ctx, sourceChain, data, err)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ack, err := PacketAck{Response: response}.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrorstypes.ErrJSONMarshal, err.Error()))
+	}
+	return channeltypes.NewResultAcknowledgement(ack)
+}
+
+// OnAcknowledgementPacket hands the acknowledgement of a packet to the
+// contract. A contract failing to handle it is logged in an event; it does
+// not fail the relay of the acknowledgement.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrorstypes.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+	data, err := UnmarshalPacketData(modulePacket.GetData())
+	if err != nil {
+		return err
+	}
+	targetChain, err := im.port.ChannelChain(ctx, modulePacket.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	var result PacketAck
+	var ackErr error
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		if err := json.Unmarshal(resp.Result, &result); err != nil {
+			ackErr = sdkerrors.Wrapf(ErrInvalidPacket, "cannot unmarshal the acknowledgement result: %s", err)
+		}
+	case *channeltypes.Acknowledgement_Error:
+		ackErr = errors.New(resp.Error)
+	}
+
+	im.handle(ctx, targetChain, data, func(ctx sdk.Context) error {
+		return im.handler.OnAcknowledgement(ctx, targetChain, data, result.Response, ackErr)
+	})
+	return nil
+}
+
+// OnTimeoutPacket hands a packet the counterparty did not receive back to the
+// contract
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := UnmarshalPacketData(modulePacket.GetData())
+	if err != nil {
+		return err
+	}
+	targetChain, err := im.port.ChannelChain(ctx, modulePacket.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	im.handle(ctx, targetChain, data, func(ctx sdk.Context) error {
+		return im.handler.OnTimeout(ctx, targetChain, data)
+	})
+	return nil
+}
+
+// handle runs a handler in a cached context, written only when the handler
+// succeeds
+func (im IBCModule) handle(ctx sdk.Context, chain string, data PacketData, handler func(ctx sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	err := handler(cacheCtx)
+	if err == nil {
+		write()
+	}
+	emitPacketEvent(ctx, chain, data, err)
+}
+
+func emitPacketEvent(ctx sdk.Context, chain string, data PacketData, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyKind, data.Kind),
+		sdk.NewAttribute(AttributeKeyMessageType, data.MessageType),
+		sdk.NewAttribute(AttributeKeyChain, chain),
+		sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypePacket, attributes...))
+}
@@ -0,0 +1,105 @@
+package interchain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PortID is the port the chain contracts exchange their messages on
+	PortID = "interchain"
+
+	// Version is the version of the interchain channels
+	Version = "interchain-1"
+
+	// PacketTimeout is how long a counterparty chain has to receive a packet
+	PacketTimeout = 10 * time.Minute
+)
+
+// Kinds of interchain packets. A message is processed by the contract of the
+// counterparty chain, which acknowledges it with its response. A callback
+// carries the response to a message received earlier.
+const (
+	PacketKindMessage  = "message"
+	PacketKindCallback = "callback"
+)
+
+// PacketData is the data of the packets sent on the interchain port
+type PacketData struct {
+	Kind        string `json:"kind"`
+	MessageType string `json:"message_type"`
+	Payload     []byte `json:"payload"`
+}
+
+// NewMessagePacket returns the packet of a message prepared for a counterparty chain
+func NewMessagePacket(messageType string, message []byte) PacketData {
+	return PacketData{Kind: PacketKindMessage, MessageType: messageType, Payload: message}
+}
+
+// NewCallbackPacket returns the packet of a callback to a counterparty chain
+func NewCallbackPacket(messageType string, response []byte) PacketData {
+	return PacketData{Kind: PacketKindCallback, MessageType: messageType, Payload: response}
+}
+
+// ValidateBasic checks the kind and message type of a packet
+func (p PacketData) ValidateBasic() error {
+	if p.Kind != PacketKindMessage && p.Kind != PacketKindCallback {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "unknown packet kind %q", p.Kind)
+	}
+	if p.MessageType == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "message type is required")
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of a packet
+func (p PacketData) GetBytes() ([]byte, error) {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// UnmarshalPacketData decodes and validates the data of an interchain packet
+func UnmarshalPacketData(bz []byte) (PacketData, error) {
+	var p PacketData
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return p, sdkerrors.Wrapf(ErrInvalidPacket, "cannot unmarshal packet data: %s", err)
+	}
+	return p, p.ValidateBasic()
+}
+
+// PacketAck is the result acknowledging an interchain packet, the response
+// of the counterparty contract to a message
+type PacketAck struct {
+	Response []byte `json:"response"`
+}
+
+// GetBytes returns the sorted JSON encoding of an acknowledgement
+func (a PacketAck) GetBytes() ([]byte, error) {
+	bz, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// QueuedPacket is a packet waiting for an open channel with its chain
+type QueuedPacket struct {
+	Chain  string     `json:"chain"`
+	Packet PacketData `json:"packet"`
+}
+
+// ID identifies a queued packet by its chain and the hash of its data, so a
+// packet queued twice is sent once
+func (q QueuedPacket) ID() string {
+	bz, _ := q.Packet.GetBytes()
+	hash := sha256.Sum256(bz)
+	return q.Chain + "/" + hex.EncodeToString(hash[:])
+}
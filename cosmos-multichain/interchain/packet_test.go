@@ -0,0 +1,39 @@
+package interchain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+func TestPacketData(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		packet interchain.PacketData
+		err    error
+	}{
+		{desc: "message", packet: interchain.NewMessagePacket("claim_payout", []byte(`{"amount":"10"}`))},
+		{desc: "callback", packet: interchain.NewCallbackPacket("treatment_claim", []byte(`{"status":"PAID"}`))},
+		{desc: "empty payload", packet: interchain.NewCallbackPacket("treatment_claim", nil)},
+		{desc: "unknown kind", packet: interchain.PacketData{Kind: "query", MessageType: "claim"}, err: interchain.ErrInvalidPacket},
+		{desc: "no message type", packet: interchain.NewMessagePacket("", []byte(`{}`)), err: interchain.ErrInvalidPacket},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := tc.packet.GetBytes()
+			require.NoError(t, err)
+
+			packet, err := interchain.UnmarshalPacketData(bz)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.packet, packet)
+		})
+	}
+
+	_, err := interchain.UnmarshalPacketData([]byte("not json"))
+	require.ErrorIs(t, err, interchain.ErrInvalidPacket)
+}
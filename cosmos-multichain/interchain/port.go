@@ -0,0 +1,154 @@
+package interchain
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// CounterpartyResolver returns the chain, as routed by the contracts, of a
+// counterparty chain ID. Chains it does not know cannot open interchain
+// channels.
+type CounterpartyResolver func(ctx sdk.Context, chainID string) (chain string, found bool)
+
+// ChainAccount returns the account of a counterparty chain on this chain. The
+// payments a chain sends over its interchain channel are paid from it.
+func ChainAccount(chain string) sdk.AccAddress {
+	return address.Module(PortID, []byte(chain))
+}
+
+// Port is the interchain port of a chain module. The counterparty chain of a
+// channel is resolved from the tendermint light client of its connection, so
+// the port keeps no state of its own.
+type Port struct {
+	moduleName         string
+	ibcKeeperFn        func() *ibckeeper.Keeper
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper
+	counterparty       CounterpartyResolver
+}
+
+func NewPort(
+	moduleName string,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
+	counterparty CounterpartyResolver,
+) Port {
+	return Port{
+		moduleName:         moduleName,
+		ibcKeeperFn:        ibcKeeperFn,
+		capabilityScopedFn: capabilityScopedFn,
+		counterparty:       counterparty,
+	}
+}
+
+// enabled reports whether the module was wired with IBC. Keepers built in
+// unit tests are not.
+func (p Port) enabled() bool {
+	return p.ibcKeeperFn != nil && p.capabilityScopedFn != nil
+}
+
+func (p Port) scopedKeeper() capabilitykeeper.ScopedKeeper {
+	return p.capabilityScopedFn(p.moduleName)
+}
+
+// BindPort binds the interchain port to the module unless it already owns it
+func (p Port) BindPort(ctx sdk.Context) error {
+	if !p.enabled() {
+		return nil
+	}
+	if _, found := p.scopedKeeper().GetCapability(ctx, host.PortPath(PortID)); found {
+		return nil
+	}
+	portCap := p.ibcKeeperFn().PortKeeper.BindPort(ctx, PortID)
+	return p.scopedKeeper().ClaimCapability(ctx, portCap, host.PortPath(PortID))
+}
+
+// ClaimChannel claims the capability of a channel opened on the port, unless
+// the module already owns it after crossing hellos
+func (p Port) ClaimChannel(ctx sdk.Context, chanCap *capabilitytypes.Capability, channelID string) error {
+	name := host.ChannelCapabilityPath(PortID, channelID)
+	if p.scopedKeeper().AuthenticateCapability(ctx, chanCap, name) {
+		return nil
+	}
+	return p.scopedKeeper().ClaimCapability(ctx, chanCap, name)
+}
+
+// ConnectionChain resolves the counterparty chain behind the connection of a
+// channel
+func (p Port) ConnectionChain(ctx sdk.Context, connectionHops []string) (string, error) {
+	if len(connectionHops) != 1 {
+		return "", sdkerrors.Wrapf(channeltypes.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
+	}
+	ibcKeeper := p.ibcKeeperFn()
+
+	connection, found := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionHops[0])
+	if !found {
+		return "", sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
+	}
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return "", sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return "", sdkerrors.Wrapf(ErrUnknownCounterparty, "client %s is not a tendermint client", connection.ClientId)
+	}
+	chain, found := p.counterparty(ctx, tmClientState.ChainId)
+	if !found {
+		return "", sdkerrors.Wrap(ErrUnknownCounterparty, tmClientState.ChainId)
+	}
+	return chain, nil
+}
+
+// ChannelChain resolves the counterparty chain of a channel of the port
+func (p Port) ChannelChain(ctx sdk.Context, channelID string) (string, error) {
+	channel, found := p.ibcKeeperFn().ChannelKeeper.GetChannel(ctx, PortID, channelID)
+	if !found {
+		return "", sdkerrors.Wrap(channeltypes.ErrChannelNotFound, channelID)
+	}
+	return p.ConnectionChain(ctx, channel.ConnectionHops)
+}
+
+// channel returns the first open channel of the port with a chain
+func (p Port) channel(ctx sdk.Context, chain string) (string, error) {
+	for _, channel := range p.ibcKeeperFn().ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, PortID) {
+		if channel.PortId != PortID || channel.State != channeltypes.OPEN {
+			continue
+		}
+		if counterparty, err := p.ConnectionChain(ctx, channel.ConnectionHops); err == nil && counterparty == chain {
+			return channel.ChannelId, nil
+		}
+	}
+	return "", sdkerrors.Wrap(ErrNoChannel, chain)
+}
+
+// SendPacket sends a packet to a chain over an open channel of the port. It
+// fails with ErrNoChannel when there is none.
+func (p Port) SendPacket(ctx sdk.Context, chain string, packet PacketData) (uint64, error) {
+	if !p.enabled() {
+		return 0, sdkerrors.Wrap(ErrNoChannel, chain)
+	}
+	channelID, err := p.channel(ctx, chain)
+	if err != nil {
+		return 0, err
+	}
+	chanCap, found := p.scopedKeeper().GetCapability(ctx, host.ChannelCapabilityPath(PortID, channelID))
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	data, err := packet.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidPacket, "cannot marshal the packet: %s", err)
+	}
+
+	timeout := uint64(ctx.BlockTime().Add(PacketTimeout).UnixNano())
+	return p.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, chanCap, PortID, channelID, clienttypes.ZeroHeight(), timeout, data)
+}
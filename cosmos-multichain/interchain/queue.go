@@ -0,0 +1,115 @@
+package interchain
+
+import (
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordKindPacket is the contract record kind of the interchain packets
+// waiting to be sent
+const RecordKindPacket = "interchain_packet"
+
+// packetRecord is the contract record message of a chain, T
+type packetRecord[T any] interface {
+	*T
+	codec.ProtoMarshaler
+	GetData() []byte
+}
+
+// NewPacketRecord builds the contract record of a chain keeping a queued
+// packet, of kind RecordKindPacket
+type NewPacketRecord[T any] func(id string, data []byte, updatedAt int64) T
+
+// PacketQueue queues the packets of a chain module until a channel with their
+// chain is open. The packets are kept as contract records of kind
+// RecordKindPacket, keyed kind/id/ under the contract record prefix of the
+// module like its other records, so they are exported with them. T is the
+// contract record message of the module, built by NewPacketRecord.
+type PacketQueue[T any, R packetRecord[T]] struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	recordPrefix []byte
+	newRecord    NewPacketRecord[T]
+	port         Port
+	logger       log.Logger
+}
+
+func NewPacketQueue[T any, R packetRecord[T]](
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	recordPrefix []byte,
+	newRecord NewPacketRecord[T],
+	port Port,
+	logger log.Logger,
+) PacketQueue[T, R] {
+	return PacketQueue[T, R]{
+		cdc:          cdc,
+		storeService: storeService,
+		recordPrefix: recordPrefix,
+		newRecord:    newRecord,
+		port:         port,
+		logger:       logger,
+	}
+}
+
+func (q PacketQueue[T, R]) store(ctx sdk.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, append(append([]byte{}, q.recordPrefix...), RecordKindPacket+"/"...))
+}
+
+// QueuePacket queues a packet for a chain. The queued packets are sent at the
+// end of the block, or once a channel with their chain opens.
+func (q PacketQueue[T, R]) QueuePacket(ctx sdk.Context, chain string, packet PacketData) error {
+	if err := packet.ValidateBasic(); err != nil {
+		return err
+	}
+	queued := QueuedPacket{Chain: chain, Packet: packet}
+	data, err := json.Marshal(queued)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidPacket, "failed to marshal queued packet")
+	}
+	record := q.newRecord(queued.ID(), data, ctx.BlockTime().Unix())
+	q.store(ctx).Set([]byte(queued.ID()+"/"), q.cdc.MustMarshal(R(&record)))
+	return nil
+}
+
+// GetQueuedPackets returns the packets waiting to be sent
+func (q PacketQueue[T, R]) GetQueuedPackets(ctx sdk.Context) (list []QueuedPacket) {
+	iterator := storetypes.KVStorePrefixIterator(q.store(ctx), []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record T
+		q.cdc.MustUnmarshal(iterator.Value(), R(&record))
+		var queued QueuedPacket
+		if err := json.Unmarshal(R(&record).GetData(), &queued); err != nil {
+			panic(err)
+		}
+		list = append(list, queued)
+	}
+
+	return
+}
+
+// SendQueuedPackets sends the queued packets whose chain has an open channel
+func (q PacketQueue[T, R]) SendQueuedPackets(ctx sdk.Context) {
+	for _, queued := range q.GetQueuedPackets(ctx) {
+		if _, err := q.port.SendPacket(ctx, queued.Chain, queued.Packet); err != nil {
+			if !errors.Is(err, ErrNoChannel) {
+				q.logger.Error("failed to send interchain packet", "chain", queued.Chain, "message_type", queued.Packet.MessageType, "error", err)
+			}
+			continue
+		}
+		q.store(ctx).Delete([]byte(queued.ID() + "/"))
+	}
+}
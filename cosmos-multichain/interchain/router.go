@@ -0,0 +1,148 @@
+package interchain
+
+import (
+	"fmt"
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnyMessageType registers a route serving every message type of a chain
+// that has no route of its own
+const AnyMessageType = "*"
+
+type (
+	// ProcessHandler processes a message received from a counterparty chain
+	ProcessHandler func(ctx sdk.Context, message []byte) error
+
+	// PrepareHandler prepares a message to be sent to a counterparty chain
+	PrepareHandler func(ctx sdk.Context, data []byte) ([]byte, error)
+
+	// CallbackHandler handles the response of a counterparty chain
+	CallbackHandler func(ctx sdk.Context, response []byte) error
+)
+
+// Route holds the handlers of a counterparty chain and message type. A nil
+// handler falls back to the AnyMessageType route of the chain, and rejects
+// the message with ErrUnsupportedMessageType without one.
+type Route struct {
+	Process  ProcessHandler
+	Prepare  PrepareHandler
+	Callback CallbackHandler
+}
+
+type routeKey struct {
+	chainID     string
+	messageType string
+}
+
+// Router dispatches interchain messages to the route registered for the
+// counterparty chain and message type
+type Router struct {
+	routes map[routeKey]Route
+}
+
+// NewRouter returns an empty Router
+func NewRouter() *Router {
+	return &Router{routes: make(map[routeKey]Route)}
+}
+
+// AddRoute registers the route of a counterparty chain and message type.
+// It panics on an empty chain ID or message type and on duplicate routes.
+func (r *Router) AddRoute(chainID, messageType string, route Route) *Router {
+	if chainID == "" || messageType == "" {
+		panic("interchain route needs a chain ID and a message type")
+	}
+	key := routeKey{chainID: chainID, messageType: messageType}
+	if _, found := r.routes[key]; found {
+		panic(fmt.Sprintf("interchain route %s/%s has already been registered", chainID, messageType))
+	}
+	r.routes[key] = route
+	return r
+}
+
+// HasRoute reports whether a message of the given type can be routed for the chain
+func (r *Router) HasRoute(chainID, messageType string) bool {
+	_, err := r.lookup(chainID, messageType)
+	return err == nil
+}
+
+// Chains returns the sorted chain IDs with at least one route
+func (r *Router) Chains() []string {
+	seen := make(map[string]bool)
+	var chains []string
+	for key := range r.routes {
+		if !seen[key.chainID] {
+			seen[key.chainID] = true
+			chains = append(chains, key.chainID)
+		}
+	}
+	sort.Strings(chains)
+	return chains
+}
+
+// lookup returns the routes of a message type, its own route before the
+// AnyMessageType route of the chain
+func (r *Router) lookup(chainID, messageType string) ([]Route, error) {
+	var routes []Route
+	for _, key := range []routeKey{
+		{chainID: chainID, messageType: messageType},
+		{chainID: chainID, messageType: AnyMessageType},
+	} {
+		if route, found := r.routes[key]; found {
+			routes = append(routes, route)
+		}
+	}
+	if len(routes) > 0 {
+		return routes, nil
+	}
+	for key := range r.routes {
+		if key.chainID == chainID {
+			return nil, sdkerrors.Wrapf(ErrUnsupportedMessageType, "%s from %s", messageType, chainID)
+		}
+	}
+	return nil, sdkerrors.Wrap(ErrUnsupportedChain, chainID)
+}
+
+// Process routes a message received from sourceChain
+func (r *Router) Process(ctx sdk.Context, sourceChain, messageType string, message []byte) error {
+	routes, err := r.lookup(sourceChain, messageType)
+	if err != nil {
+		return err
+	}
+	for _, route := range routes {
+		if route.Process != nil {
+			return route.Process(ctx, message)
+		}
+	}
+	return sdkerrors.Wrapf(ErrUnsupportedMessageType, "%s is not accepted from %s", messageType, sourceChain)
+}
+
+// Prepare routes a message to be sent to targetChain
+func (r *Router) Prepare(ctx sdk.Context, targetChain, messageType string, data []byte) ([]byte, error) {
+	routes, err := r.lookup(targetChain, messageType)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes {
+		if route.Prepare != nil {
+			return route.Prepare(ctx, data)
+		}
+	}
+	return nil, sdkerrors.Wrapf(ErrUnsupportedMessageType, "%s is not sent to %s", messageType, targetChain)
+}
+
+// Callback routes a response received from sourceChain
+func (r *Router) Callback(ctx sdk.Context, sourceChain, messageType string, response []byte) error {
+	routes, err := r.lookup(sourceChain, messageType)
+	if err != nil {
+		return err
+	}
+	for _, route := range routes {
+		if route.Callback != nil {
+			return route.Callback(ctx, response)
+		}
+	}
+	return sdkerrors.Wrapf(ErrUnsupportedMessageType, "no callback for %s from %s", messageType, sourceChain)
+}
@@ -0,0 +1,71 @@
+package interchain_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/cosmos-multichain/interchain"
+)
+
+func TestRouter(t *testing.T) {
+	var processed []string
+	process := func(name string) interchain.ProcessHandler {
+		return func(_ sdk.Context, message []byte) error {
+			processed = append(processed, name+":"+string(message))
+			return nil
+		}
+	}
+	router := interchain.NewRouter().
+		AddRoute(interchain.ChainFinance, interchain.AnyMessageType, interchain.Route{
+			Process: process("finance"),
+			Prepare: func(_ sdk.Context, data []byte) ([]byte, error) { return append([]byte("to-finance:"), data...), nil },
+		}).
+		AddRoute(interchain.ChainFinance, "payment", interchain.Route{Process: process("payment")}).
+		AddRoute(interchain.ChainInsurance, "claim", interchain.Route{Process: process("claim")})
+	ctx := sdk.Context{}
+
+	require.Equal(t, []string{interchain.ChainFinance, interchain.ChainInsurance}, router.Chains())
+
+	for _, tc := range []struct {
+		desc        string
+		chain       string
+		messageType string
+		err         error
+	}{
+		{desc: "exact route", chain: interchain.ChainFinance, messageType: "payment"},
+		{desc: "any message type", chain: interchain.ChainFinance, messageType: "mortgage"},
+		{desc: "single message type", chain: interchain.ChainInsurance, messageType: "claim"},
+		{desc: "unknown message type", chain: interchain.ChainInsurance, messageType: "policy", err: interchain.ErrUnsupportedMessageType},
+		{desc: "unknown chain", chain: interchain.ChainRetail, messageType: "claim", err: interchain.ErrUnsupportedChain},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := router.Process(ctx, tc.chain, tc.messageType, []byte("msg"))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, router.HasRoute(tc.chain, tc.messageType))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, router.HasRoute(tc.chain, tc.messageType))
+		})
+	}
+	require.Equal(t, []string{"payment:msg", "finance:msg", "claim:msg"}, processed)
+
+	bz, err := router.Prepare(ctx, interchain.ChainFinance, "mortgage", []byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, "to-finance:msg", string(bz))
+
+	// a route without a handler falls back to the any message type route
+	bz, err = router.Prepare(ctx, interchain.ChainFinance, "payment", []byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, "to-finance:msg", string(bz))
+
+	// and rejects the message without a handler there
+	require.ErrorIs(t, router.Callback(ctx, interchain.ChainFinance, "payment", nil), interchain.ErrUnsupportedMessageType)
+	require.ErrorIs(t, router.Callback(ctx, interchain.ChainInsurance, "claim", nil), interchain.ErrUnsupportedMessageType)
+
+	require.Panics(t, func() { router.AddRoute(interchain.ChainInsurance, "claim", interchain.Route{}) })
+	require.Panics(t, func() { router.AddRoute("", "claim", interchain.Route{}) })
+}
@@ -0,0 +1,74 @@
+package screening
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// Decorator rejects the transactions signed by a screened party or moving
+// funds from or to one
+type Decorator struct {
+	screener Screener
+}
+
+func NewDecorator(screener Screener) Decorator {
+	return Decorator{screener: screener}
+}
+
+func (d Decorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	parties := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		parties = append(parties, sdk.AccAddress(signer).String())
+	}
+	parties = append(parties, Parties(tx.GetMsgs())...)
+
+	for _, party := range parties {
+		if d.screener.IsScreened(ctx, party) {
+			d.screener.LogBlocked(ctx, TxRef(ctx), party)
+			return ctx, errorsmod.Wrap(ErrScreened, party)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// Parties returns the senders and recipients of the funds moved by msgs,
+// including the messages executed on behalf of a granter
+func Parties(msgs []sdk.Msg) (parties []string) {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			parties = append(parties, msg.FromAddress, msg.ToAddress)
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				parties = append(parties, input.Address)
+			}
+			for _, output := range msg.Outputs {
+				parties = append(parties, output.Address)
+			}
+		case *ibctransfertypes.MsgTransfer:
+			parties = append(parties, msg.Sender, msg.Receiver)
+		case *authz.MsgExec:
+			// undecodable messages are rejected by the authz module
+			execMsgs, err := msg.GetMessages()
+			if err == nil {
+				parties = append(parties, Parties(execMsgs)...)
+			}
+		}
+	}
+	return parties
+}
@@ -0,0 +1,16 @@
+package screening
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the screening errors, distinct from the one
+// of the x/screening modules of the chains
+const Codespace = "sanctions"
+
+// screening sentinel errors
+var (
+	ErrScreened = sdkerrors.Register(Codespace, 1100, "party is on the screening list")
+)
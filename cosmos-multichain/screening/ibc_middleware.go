@@ -0,0 +1,91 @@
+package screening
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ porttypes.IBCModule        = IBCMiddleware{}
+	_ porttypes.UpgradableModule = IBCMiddleware{}
+)
+
+// IBCMiddleware wraps an ICS-20 transfer stack and acknowledges with an
+// error the inbound packets sent by or to a screened party
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	screener Screener
+}
+
+func NewIBCMiddleware(app porttypes.IBCModule, screener Screener) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, screener: screener}
+}
+
+// OnRecvPacket rejects the transfers from or to a screened party. Packets
+// that are not ICS-20 transfers are left to the wrapped application.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		for _, party := range []string{data.Sender, data.Receiver} {
+			if im.screener.IsScreened(ctx, party) {
+				im.screener.LogBlocked(ctx, PacketRef(packet), party)
+				return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrScreened, party))
+			}
+		}
+	}
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// PacketRef returns the reference of a received packet, its destination
+// port, channel and sequence
+func PacketRef(packet channeltypes.Packet) string {
+	return fmt.Sprintf("%s/%s/%d", packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+}
+
+// The channel upgrade callbacks are forwarded to the wrapped application
+
+func (im IBCMiddleware) upgradable() (porttypes.UpgradableModule, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return nil, errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs, nil
+}
+
+func (im IBCMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, err := im.upgradable()
+	if err != nil {
+		return "", err
+	}
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+func (im IBCMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, err := im.upgradable()
+	if err != nil {
+		return "", err
+	}
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, err := im.upgradable()
+	if err != nil {
+		return err
+	}
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	cbs, err := im.upgradable()
+	if err != nil {
+		panic(err)
+	}
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
@@ -0,0 +1,70 @@
+package screening
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Journal keeps the attempts blocked while a block executes until its end.
+// The state of a rejected transaction or packet is reverted, and the records
+// written in it with it, so the screeners defer their records of blocked
+// attempts to the journal, which writes them from the end blocker.
+//
+// Copies of a journal share its attempts. The journal is only written while
+// a block is finalized, which never runs concurrently.
+type Journal struct {
+	blocked *[]blockedAttempt
+}
+
+// blockedAttempt is an attempt blocked by screener, which records it
+type blockedAttempt struct {
+	screener   Screener
+	ref, party string
+}
+
+func NewJournal() Journal {
+	return Journal{blocked: new([]blockedAttempt)}
+}
+
+// Defer returns screener, its records of blocked attempts deferred to the
+// journal
+func (j Journal) Defer(screener Screener) Screener {
+	return deferredScreener{Screener: screener, journal: j}
+}
+
+// Reset forgets the attempts blocked by a former execution of the block,
+// like an optimistic execution that was aborted
+func (j Journal) Reset() {
+	*j.blocked = nil
+}
+
+// Flush records the attempts blocked in the block with the screeners that
+// blocked them
+func (j Journal) Flush(ctx context.Context) {
+	blocked := *j.blocked
+	*j.blocked = nil
+	for _, attempt := range blocked {
+		attempt.screener.LogBlocked(ctx, attempt.ref, attempt.party)
+	}
+}
+
+// Len returns the number of attempts waiting for the end of the block
+func (j Journal) Len() int {
+	return len(*j.blocked)
+}
+
+type deferredScreener struct {
+	Screener
+	journal Journal
+}
+
+// LogBlocked defers the record of the attempt to the end of the block. The
+// attempts blocked when checking, simulating or proposing transactions are
+// not recorded, as no state is committed.
+func (s deferredScreener) LogBlocked(ctx context.Context, ref, party string) {
+	if sdk.UnwrapSDKContext(ctx).ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	*s.journal.blocked = append(*s.journal.blocked, blockedAttempt{screener: s.Screener, ref: ref, party: party})
+}
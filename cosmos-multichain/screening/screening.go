@@ -0,0 +1,43 @@
+// Package screening keeps sanctioned parties away from the chains moving
+// funds. The chains list screened parties in their x/screening module, and
+// this package rejects the transactions and inbound ICS-20 packets touching
+// them.
+package screening
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Screener reports whether a party is screened and records the attempts
+// blocked because of it
+type Screener interface {
+	// IsScreened reports whether party is listed, either by its address or
+	// by the hash of its external identifier
+	IsScreened(ctx context.Context, party string) bool
+
+	// LogBlocked records that the transaction or packet ref was blocked
+	// because of party. The record is written in the state of ctx, which is
+	// reverted when the attempt is rejected: the screeners of the ante
+	// handler and of the middleware defer it to the end of the block through
+	// a Journal.
+	LogBlocked(ctx context.Context, ref, party string)
+}
+
+// HashIdentifier returns the hex encoded SHA-256 hash under which an
+// external identifier, like a customer ID or the address of an account on
+// another chain, is listed
+func HashIdentifier(identifier string) string {
+	sum := sha256.Sum256([]byte(identifier))
+	return hex.EncodeToString(sum[:])
+}
+
+// TxRef returns the reference of the transaction being executed, its hash
+func TxRef(ctx sdk.Context) string {
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
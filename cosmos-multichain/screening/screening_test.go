@@ -0,0 +1,167 @@
+package screening_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/example/cosmos-multichain/screening"
+)
+
+// listScreener screens the parties of a static list and keeps the blocked attempts
+type listScreener struct {
+	listed  map[string]bool
+	blocked []string
+}
+
+func newListScreener(parties ...string) *listScreener {
+	s := &listScreener{listed: map[string]bool{}}
+	for _, party := range parties {
+		s.listed[party] = true
+	}
+	return s
+}
+
+func (s *listScreener) IsScreened(_ context.Context, party string) bool {
+	return s.listed[party] || s.listed[screening.HashIdentifier(party)]
+}
+
+func (s *listScreener) LogBlocked(_ context.Context, ref, party string) {
+	s.blocked = append(s.blocked, party)
+}
+
+// recvModule is the application wrapped by the middleware, acknowledging every packet
+type recvModule struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *recvModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func TestDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	addrs := sims.CreateRandomAccounts(3)
+	signer, recipient, other := addrs[0], addrs[1], addrs[2]
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+
+	for _, tc := range []struct {
+		desc   string
+		listed []string
+		err    error
+	}{
+		{desc: "no screened party"},
+		{desc: "screened signer", listed: []string{signer.String()}, err: screening.ErrScreened},
+		{desc: "screened recipient", listed: []string{recipient.String()}, err: screening.ErrScreened},
+		{desc: "unrelated screened party", listed: []string{other.String()}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(signer, recipient, coins)))
+			screener := newListScreener(tc.listed...)
+
+			called := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+			_, err := screening.NewDecorator(screener).AnteHandle(sdk.Context{}, builder.GetTx(), false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, called)
+				require.Equal(t, tc.listed, screener.blocked)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+			require.Empty(t, screener.blocked)
+		})
+	}
+}
+
+func TestParties(t *testing.T) {
+	addrs := sims.CreateRandomAccounts(3)
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	exec := authz.NewMsgExec(addrs[2], []sdk.Msg{banktypes.NewMsgSend(addrs[0], addrs[1], coins)})
+
+	require.Equal(t,
+		[]string{addrs[0].String(), addrs[1].String(), addrs[1].String(), "cosmos1receiver"},
+		screening.Parties([]sdk.Msg{
+			&exec,
+			&ibctransfertypes.MsgTransfer{Sender: addrs[1].String(), Receiver: "cosmos1receiver"},
+		}),
+	)
+}
+
+func TestIBCMiddleware(t *testing.T) {
+	packet := func(sender, receiver string) channeltypes.Packet {
+		data := ibctransfertypes.NewFungibleTokenPacketData("stake", "10", sender, receiver, "")
+		return channeltypes.Packet{Sequence: 1, DestinationPort: "transfer", DestinationChannel: "channel-0", Data: data.GetBytes()}
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		packet  channeltypes.Packet
+		success bool
+	}{
+		{desc: "no screened party", packet: packet("sender", "receiver"), success: true},
+		{desc: "screened sender identifier", packet: packet("listed", "receiver")},
+		{desc: "screened receiver identifier", packet: packet("sender", "listed")},
+		{desc: "not a transfer", packet: channeltypes.Packet{Data: []byte("listed")}, success: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			app := &recvModule{}
+			screener := newListScreener(screening.HashIdentifier("listed"))
+
+			ack := screening.NewIBCMiddleware(app, screener).OnRecvPacket(sdk.Context{}, tc.packet, nil)
+			require.Equal(t, tc.success, ack.Success())
+			if tc.success {
+				require.Equal(t, 1, app.received)
+				require.Empty(t, screener.blocked)
+				return
+			}
+			require.Zero(t, app.received)
+			require.Equal(t, []string{"listed"}, screener.blocked)
+		})
+	}
+}
+
+func TestJournal(t *testing.T) {
+	journal := screening.NewJournal()
+	screener := newListScreener("listed")
+	deferred := journal.Defer(screener)
+	require.True(t, deferred.IsScreened(sdk.Context{}, "listed"))
+
+	// Checking and simulating transactions records nothing
+	deferred.LogBlocked(sdk.Context{}.WithExecMode(sdk.ExecModeCheck), "tx-1", "listed")
+	deferred.LogBlocked(sdk.Context{}.WithExecMode(sdk.ExecModeSimulate), "tx-2", "listed")
+	require.Zero(t, journal.Len())
+
+	finalize := sdk.Context{}.WithExecMode(sdk.ExecModeFinalize)
+	deferred.LogBlocked(finalize, "tx-3", "aborted")
+	journal.Reset()
+	deferred.LogBlocked(finalize, "tx-4", "listed")
+	require.Equal(t, 1, journal.Len())
+	require.Empty(t, screener.blocked)
+
+	// The attempts are recorded once the block ends
+	journal.Flush(finalize)
+	require.Equal(t, []string{"listed"}, screener.blocked)
+	require.Zero(t, journal.Len())
+	journal.Flush(finalize)
+	require.Equal(t, []string{"listed"}, screener.blocked)
+}